@@ -3,24 +3,34 @@ package commands
 import (
 	"github.com/spf13/cobra"
 
+	"github.com/argoproj/argo-events/controllers"
+	controllerscommon "github.com/argoproj/argo-events/controllers/common"
 	eventbuscmd "github.com/argoproj/argo-events/controllers/eventbus/cmd"
 	envpkg "github.com/argoproj/pkg/env"
 )
 
 func NewEventBusControllerCommand() *cobra.Command {
-	var (
-		namespaced       bool
-		managedNamespace string
-	)
+	var options eventbuscmd.Options
 
 	command := &cobra.Command{
 		Use:   "eventbus-controller",
 		Short: "Start an EventBus controller",
 		Run: func(cmd *cobra.Command, args []string) {
-			eventbuscmd.Start(namespaced, managedNamespace)
+			eventbuscmd.Start(options)
 		},
 	}
-	command.Flags().BoolVar(&namespaced, "namespaced", false, "Whether to run in namespaced scope, defaults to false.")
-	command.Flags().StringVar(&managedNamespace, "managed-namespace", envpkg.LookupEnvStringOr("NAMESPACE", "argo-events"), "The namespace that the controller watches when \"--namespaced\" is \"true\".")
+	command.Flags().BoolVar(&options.Namespaced, "namespaced", false, "Whether to run in namespaced scope, defaults to false.")
+	command.Flags().StringVar(&options.ManagedNamespace, "managed-namespace", envpkg.LookupEnvStringOr("NAMESPACE", "argo-events"), "The namespace that the controller watches when \"--namespaced\" is \"true\", or a comma separated list of namespaces.")
+	command.Flags().BoolVar(&options.LeaderElection, "leader-election", envpkg.LookupEnvStringOr("LEADER_ELECTION", "true") == "true", "Whether to enable leader election, defaults to true.")
+	command.Flags().StringVar(&options.LeaderElectionNamespace, "leader-election-namespace", envpkg.LookupEnvStringOr("LEADER_ELECTION_NAMESPACE", ""), "The namespace of the leader election lease, defaults to the namespace the controller runs in.")
+	command.Flags().StringVar(&options.MetricsAddr, "metrics-addr", envpkg.LookupEnvStringOr("METRICS_ADDR", eventbuscmd.DefaultMetricsAddr), "The address the metrics endpoint binds to, \"0\" disables it.")
+	command.Flags().StringVar(&options.HealthProbeAddr, "health-probe-addr", envpkg.LookupEnvStringOr("HEALTH_PROBE_ADDR", eventbuscmd.DefaultHealthProbeAddr), "The address the health probe endpoint binds to.")
+	command.Flags().DurationVar(&options.JetStreamLagPollInterval, "jetstream-lag-poll-interval", envpkg.LookupEnvDurationOr("JETSTREAM_LAG_POLL_INTERVAL", eventbuscmd.DefaultJetStreamLagPollInterval), "How often to poll the JetStream consumers for the pending messages metric, \"0\" disables it.")
+	command.Flags().DurationVar(&options.RateLimiterBaseDelay, "rate-limiter-base-delay", envpkg.LookupEnvDurationOr("RATE_LIMITER_BASE_DELAY", eventbuscmd.DefaultRateLimiterBaseDelay), "The delay of the first requeue of a failing EventBus, doubled on each failure.")
+	command.Flags().DurationVar(&options.RateLimiterMaxDelay, "rate-limiter-max-delay", envpkg.LookupEnvDurationOr("RATE_LIMITER_MAX_DELAY", eventbuscmd.DefaultRateLimiterMaxDelay), "The maximum delay of the requeues of a failing EventBus.")
+	command.Flags().DurationVar(&options.ResyncPeriod, "resync-period", envpkg.LookupEnvDurationOr("RESYNC_PERIOD", eventbuscmd.DefaultResyncPeriod), "How often to reconcile an EventBus without any change to correct the drift of its resources, plus a jitter of up to half of it, \"0\" disables it.")
+	command.Flags().DurationVar(&options.ReconcileStallThreshold, "reconcile-stall-threshold", envpkg.LookupEnvDurationOr("RECONCILE_STALL_THRESHOLD", controllerscommon.DefaultReconcileStallThreshold), "How long a reconciliation can run, without any other one completing, before the liveness check fails.")
+	command.Flags().StringVar(&options.ConfigPath, "config-path", envpkg.LookupEnvStringOr("CONFIG_PATH", controllers.DefaultConfigPath), "The directory of the configuration file, which is reloaded whenever it changes.")
+	command.Flags().StringVar(&options.ConfigName, "config-name", envpkg.LookupEnvStringOr("CONFIG_NAME", controllers.DefaultConfigName), "The name of the configuration file, either without its extension for a YAML file, or the full name of the file, e.g. \"events.yml\".")
 	return command
 }
@@ -0,0 +1,28 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewEventBusControllerCommandFlags(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		command := NewEventBusControllerCommand()
+		assert.NoError(t, command.ParseFlags([]string{}))
+		leaderElection, err := command.Flags().GetBool("leader-election")
+		assert.NoError(t, err)
+		assert.True(t, leaderElection)
+	})
+
+	t.Run("leader election", func(t *testing.T) {
+		command := NewEventBusControllerCommand()
+		assert.NoError(t, command.ParseFlags([]string{"--leader-election=false", "--leader-election-namespace=argo"}))
+		leaderElection, err := command.Flags().GetBool("leader-election")
+		assert.NoError(t, err)
+		assert.False(t, leaderElection)
+		ns, err := command.Flags().GetString("leader-election-namespace")
+		assert.NoError(t, err)
+		assert.Equal(t, "argo", ns)
+	})
+}
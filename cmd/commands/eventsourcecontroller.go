@@ -1,26 +1,31 @@
 package commands
 
 import (
+	"time"
+
 	"github.com/spf13/cobra"
 
+	controllerscommon "github.com/argoproj/argo-events/controllers/common"
 	eventsourcecmd "github.com/argoproj/argo-events/controllers/eventsource/cmd"
 	envpkg "github.com/argoproj/pkg/env"
 )
 
 func NewEventSourceControllerCommand() *cobra.Command {
 	var (
-		namespaced       bool
-		managedNamespace string
+		namespaced              bool
+		managedNamespace        string
+		reconcileStallThreshold time.Duration
 	)
 
 	command := &cobra.Command{
 		Use:   "eventsource-controller",
 		Short: "Start an EventSource controller",
 		Run: func(cmd *cobra.Command, args []string) {
-			eventsourcecmd.Start(namespaced, managedNamespace)
+			eventsourcecmd.Start(namespaced, managedNamespace, reconcileStallThreshold)
 		},
 	}
 	command.Flags().BoolVar(&namespaced, "namespaced", false, "Whether to run in namespaced scope, defaults to false.")
 	command.Flags().StringVar(&managedNamespace, "managed-namespace", envpkg.LookupEnvStringOr("NAMESPACE", "argo-events"), "The namespace that the controller watches when \"--namespaced\" is \"true\".")
+	command.Flags().DurationVar(&reconcileStallThreshold, "reconcile-stall-threshold", envpkg.LookupEnvDurationOr("RECONCILE_STALL_THRESHOLD", controllerscommon.DefaultReconcileStallThreshold), "How long a reconciliation can run, without any other one completing, before the liveness check fails.")
 	return command
 }
@@ -29,4 +29,5 @@ func init() {
 	rootCmd.AddCommand(NewEventSourceCommand())
 	rootCmd.AddCommand(NewSensorCommand())
 	rootCmd.AddCommand(NewWebhookCommand())
+	rootCmd.AddCommand(NewValidateConfigCommand())
 }
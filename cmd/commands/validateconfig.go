@@ -0,0 +1,101 @@
+package commands
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-events/controllers"
+)
+
+// DefaultControllerConfigPath is the path of the configuration file of the controllers
+const DefaultControllerConfigPath = controllers.DefaultConfigPath + "/" + controllers.DefaultConfigName + ".yaml"
+
+func NewValidateConfigCommand() *cobra.Command {
+	var path string
+
+	command := &cobra.Command{
+		Use:   "validate-config",
+		Short: "Validate a controller configuration file without starting the controller",
+		Long: `Validate a controller configuration file, e.g. in CI before rolling it out. The file is read as the
+controller reads it, with the environment variables expanded, and each NATS streaming and JetStream version
+is checked to have all its images and a valid semver version. The command exits with a non-zero status if
+the file has problems.`,
+		Args:          cobra.NoArgs,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return validateConfig(cmd.OutOrStdout(), path)
+		},
+	}
+	command.Flags().StringVar(&path, "config", DefaultControllerConfigPath, "The path of the controller configuration file.")
+	return command
+}
+
+// validateConfig prints a summary of the configuration file and its problems, and returns an error if it has any.
+func validateConfig(out io.Writer, path string) error {
+	config, err := controllers.ReadConfigFile(path)
+	if err != nil {
+		return fmt.Errorf("invalid configuration %s, %w", path, err)
+	}
+	fmt.Fprintf(out, "%s:\n", path)
+	printEventBusConfig(out, "eventBus", config.EventBus)
+	for _, name := range sortedKeys(config.Profiles) {
+		printEventBusConfig(out, "profiles."+name, config.Profiles[name])
+	}
+	problems := config.ValidateVersions()
+	if len(problems) == 0 {
+		fmt.Fprintln(out, "the configuration is valid")
+		return nil
+	}
+	fmt.Fprintln(out, "problems:")
+	for _, p := range problems {
+		fmt.Fprintf(out, "  - %v\n", p)
+	}
+	return fmt.Errorf("invalid configuration %s, %d problem(s) found", path, len(problems))
+}
+
+func printEventBusConfig(out io.Writer, name string, eb *controllers.EventBusConfig) {
+	if eb == nil {
+		fmt.Fprintf(out, "  %s: not configured\n", name)
+		return
+	}
+	fmt.Fprintf(out, "  %s:\n", name)
+	if eb.NATS != nil {
+		versions := make([]string, 0, len(eb.NATS.Versions))
+		for _, v := range eb.NATS.Versions {
+			versions = append(versions, v.Version)
+		}
+		printVersions(out, "nats", versions, eb.NATS.DisabledVersions, eb.NATS.Disabled)
+	}
+	if eb.JetStream != nil {
+		versions := make([]string, 0, len(eb.JetStream.Versions))
+		for _, v := range eb.JetStream.Versions {
+			versions = append(versions, v.Version)
+		}
+		printVersions(out, "jetstream", versions, eb.JetStream.DisabledVersions, false)
+	}
+}
+
+func printVersions(out io.Writer, bus string, versions, disabledVersions []string, disabled bool) {
+	line := fmt.Sprintf("    %s: %d version(s) [%s]", bus, len(versions), strings.Join(versions, ", "))
+	if len(disabledVersions) > 0 {
+		line += fmt.Sprintf(", disabled versions [%s]", strings.Join(disabledVersions, ", "))
+	}
+	if disabled {
+		line += ", disabled"
+	}
+	fmt.Fprintln(out, line)
+}
+
+func sortedKeys(profiles map[string]*controllers.EventBusConfig) []string {
+	result := make([]string, 0, len(profiles))
+	for name := range profiles {
+		result = append(result, name)
+	}
+	sort.Strings(result)
+	return result
+}
@@ -0,0 +1,54 @@
+package commands
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateConfig(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		var out bytes.Buffer
+		assert.NoError(t, validateConfig(&out, "testdata/valid-controller-config.yaml"))
+		assert.Contains(t, out.String(), "nats: 1 version(s) [0.22.1]")
+		assert.Contains(t, out.String(), "jetstream: 2 version(s) [2.7.4, 2.7.4-alpine], disabled versions [<2.7]")
+		assert.Contains(t, out.String(), "profiles.prod:\n    jetstream: 1 version(s) [2.8.1]")
+		assert.Contains(t, out.String(), "the configuration is valid")
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		var out bytes.Buffer
+		err := validateConfig(&out, "testdata/invalid-controller-config.yaml")
+		assert.EqualError(t, err, "invalid configuration testdata/invalid-controller-config.yaml, 4 problem(s) found")
+		assert.Contains(t, out.String(), `"eventBus.nats.versions[0].metricsExporterImage" of version "0.22.1" is not configured`)
+		assert.Contains(t, out.String(), `version "2.7.4" of "eventBus.jetstream.versions[1]" is duplicated`)
+		assert.Contains(t, out.String(), `version "latest" of "profiles.prod.jetstream.versions[0]" is not valid semver`)
+		assert.Contains(t, out.String(), `"profiles.prod.jetstream.versions[0].metricsExporterImage" of version "latest" is not configured`)
+	})
+
+	t.Run("malformed", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "controller-config.yaml")
+		assert.NoError(t, ioutil.WriteFile(path, []byte("eventBus:\n  persistence:\n    size: -1Gi\n"), 0600))
+		err := validateConfig(&bytes.Buffer{}, path)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid "eventBus.persistence"`)
+	})
+
+	t.Run("missing", func(t *testing.T) {
+		err := validateConfig(&bytes.Buffer{}, filepath.Join(t.TempDir(), "controller-config.yaml"))
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to load configuration file")
+	})
+}
+
+func TestNewValidateConfigCommand(t *testing.T) {
+	command := NewValidateConfigCommand()
+	var out bytes.Buffer
+	command.SetOut(&out)
+	command.SetArgs([]string{"--config", "testdata/invalid-controller-config.yaml"})
+	assert.Error(t, command.Execute())
+	assert.Contains(t, out.String(), "problems:")
+}
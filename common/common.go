@@ -33,6 +33,26 @@ const (
 	EnvImagePullPolicy = "IMAGE_PULL_POLICY"
 )
 
+// Secrets provider related
+const (
+	// EnvVarSecretsProvider is the env var to select the secrets provider, volume or vault
+	EnvVarSecretsProvider = "SECRETS_PROVIDER"
+	// EnvVarSecretsCacheTTL is the env var of the duration the secrets fetched from an external provider are cached
+	EnvVarSecretsCacheTTL = "SECRETS_CACHE_TTL"
+	// EnvVarVaultAddr is the env var of the address of the Vault server
+	EnvVarVaultAddr = "VAULT_ADDR"
+	// EnvVarVaultToken is the env var of the Vault token
+	EnvVarVaultToken = "VAULT_TOKEN"
+	// EnvVarVaultTokenPath is the env var of the path of a file holding the Vault token, e.g. written by the Vault agent
+	EnvVarVaultTokenPath = "VAULT_TOKEN_PATH"
+	// EnvVarVaultRole is the env var of the Vault role to log in with the Kubernetes auth method
+	EnvVarVaultRole = "VAULT_ROLE"
+	// EnvVarVaultAuthPath is the env var of the mount path of the Vault Kubernetes auth method
+	EnvVarVaultAuthPath = "VAULT_AUTH_PATH"
+	// EnvVarVaultSecretsPath is the env var of the Vault path the secrets are read under
+	EnvVarVaultSecretsPath = "VAULT_SECRETS_PATH"
+)
+
 // EventBus related
 const (
 	// EnvVarEventBusConfig refers to the eventbus config env
@@ -75,6 +95,8 @@ const (
 const (
 	// EnvVarSensorObject refers to the env of based64 encoded sensor spec
 	EnvVarSensorObject = "SENSOR_OBJECT"
+	// EnvVarSensorDrainTimeout refers to the env of the duration to wait for in-flight trigger executions on shutdown
+	EnvVarSensorDrainTimeout = "SENSOR_DRAIN_TIMEOUT"
 	// SensorNamespace is used to get namespace where sensors are deployed
 	SensorNamespace = "SENSOR_NAMESPACE"
 	// LabelSensorName is label for sensor name
@@ -0,0 +1,114 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/http/httpproxy"
+)
+
+// HTTPClientOptions configures the HTTP clients of the outbound calls
+type HTTPClientOptions struct {
+	// ProxyURL is the URL of the proxy to go through, overriding the HTTPS_PROXY and HTTP_PROXY
+	// environment variables. The hosts in NO_PROXY are reached directly either way.
+	ProxyURL string
+	// CABundle is the PEM encoded CA certificates to trust in addition to the system roots.
+	CABundle []byte
+	// TLSConfig is the TLS configuration of the client, e.g. with client certificates. It is
+	// cloned, and can't have root CAs along with a CA bundle.
+	TLSConfig *tls.Config
+	// Timeout of the requests, including reading the response body. None if zero.
+	Timeout time.Duration
+}
+
+// NewHTTPClient returns an HTTP client going through the proxy, trusting the CA bundle, and
+// timing out the requests as configured.
+func NewHTTPClient(opts HTTPClientOptions) (*http.Client, error) {
+	transport, err := NewHTTPTransport(opts)
+	if err != nil {
+		return nil, err
+	}
+	return &http.Client{Transport: transport, Timeout: opts.Timeout}, nil
+}
+
+// NewHTTPTransport returns the transport of NewHTTPClient, for the clients wrapping it, e.g. to authorize the requests.
+func NewHTTPTransport(opts HTTPClientOptions) (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	proxy, err := proxyFunc(opts.ProxyURL)
+	if err != nil {
+		return nil, err
+	}
+	transport.Proxy = proxy
+	tlsConfig, err := clientTLSConfig(opts.TLSConfig, opts.CABundle)
+	if err != nil {
+		return nil, err
+	}
+	transport.TLSClientConfig = tlsConfig
+	return transport, nil
+}
+
+// proxyFunc returns the proxy of the requests, from HTTPS_PROXY, HTTP_PROXY and NO_PROXY,
+// with the proxy URL taking precedence over the proxies of the environment if any.
+func proxyFunc(proxyURL string) (func(*http.Request) (*url.URL, error), error) {
+	config := httpproxy.FromEnvironment()
+	if proxyURL != "" {
+		proxy, err := url.Parse(proxyURL)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse the proxy url %q", proxyURL)
+		}
+		if proxy.Scheme == "" || proxy.Host == "" {
+			return nil, errors.Errorf("invalid proxy url %q, it must be an absolute url", proxyURL)
+		}
+		config.HTTPProxy = proxyURL
+		config.HTTPSProxy = proxyURL
+	}
+	proxy := config.ProxyFunc()
+	return func(req *http.Request) (*url.URL, error) {
+		return proxy(req.URL)
+	}, nil
+}
+
+// clientTLSConfig returns a clone of the TLS config trusting the CA bundle in addition to the system roots.
+func clientTLSConfig(config *tls.Config, caBundle []byte) (*tls.Config, error) {
+	if config != nil {
+		config = config.Clone()
+	}
+	if len(caBundle) == 0 {
+		return config, nil
+	}
+	if config == nil {
+		config = &tls.Config{}
+	}
+	if config.RootCAs != nil {
+		return nil, errors.New("the CA bundle can't be combined with the root CAs of the TLS config")
+	}
+	rootCAs, err := x509.SystemCertPool()
+	if err != nil || rootCAs == nil {
+		rootCAs = x509.NewCertPool()
+	}
+	if !rootCAs.AppendCertsFromPEM(caBundle) {
+		return nil, errors.New("no valid PEM encoded certificate found in the CA bundle")
+	}
+	config.RootCAs = rootCAs
+	return config, nil
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewHTTPClient(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	caBundle := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+	t.Run("custom CA bundle", func(t *testing.T) {
+		client, err := NewHTTPClient(HTTPClientOptions{CABundle: caBundle, Timeout: time.Minute})
+		assert.NoError(t, err)
+		assert.Equal(t, time.Minute, client.Timeout)
+		resp, err := client.Get(server.URL)
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("without CA bundle", func(t *testing.T) {
+		client, err := NewHTTPClient(HTTPClientOptions{})
+		assert.NoError(t, err)
+		_, err = client.Get(server.URL)
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid CA bundle", func(t *testing.T) {
+		_, err := NewHTTPClient(HTTPClientOptions{CABundle: []byte("not a certificate")})
+		assert.Error(t, err)
+	})
+
+	t.Run("TLS config", func(t *testing.T) {
+		config := &tls.Config{MinVersion: tls.VersionTLS12}
+		transport, err := NewHTTPTransport(HTTPClientOptions{CABundle: caBundle, TLSConfig: config})
+		assert.NoError(t, err)
+		assert.Equal(t, uint16(tls.VersionTLS12), transport.TLSClientConfig.MinVersion)
+		assert.NotNil(t, transport.TLSClientConfig.RootCAs)
+		// The TLS config of the caller is left as is
+		assert.Nil(t, config.RootCAs)
+
+		_, err = NewHTTPTransport(HTTPClientOptions{CABundle: caBundle, TLSConfig: &tls.Config{RootCAs: x509.NewCertPool()}})
+		assert.Error(t, err)
+	})
+}
+
+func TestNewHTTPTransportProxy(t *testing.T) {
+	proxyOf := func(t *testing.T, transport *http.Transport, target string) *url.URL {
+		t.Helper()
+		req, err := http.NewRequest(http.MethodGet, target, nil)
+		assert.NoError(t, err)
+		proxy, err := transport.Proxy(req)
+		assert.NoError(t, err)
+		return proxy
+	}
+
+	t.Run("environment", func(t *testing.T) {
+		t.Setenv("HTTPS_PROXY", "http://env-proxy.example.com:3128")
+		t.Setenv("NO_PROXY", "internal.example.com")
+		transport, err := NewHTTPTransport(HTTPClientOptions{})
+		assert.NoError(t, err)
+		assert.Equal(t, &url.URL{Scheme: "http", Host: "env-proxy.example.com:3128"}, proxyOf(t, transport, "https://cloudfunctions.googleapis.com"))
+		assert.Nil(t, proxyOf(t, transport, "https://internal.example.com"))
+	})
+
+	t.Run("override", func(t *testing.T) {
+		t.Setenv("HTTPS_PROXY", "http://env-proxy.example.com:3128")
+		t.Setenv("NO_PROXY", "internal.example.com")
+		transport, err := NewHTTPTransport(HTTPClientOptions{ProxyURL: "http://proxy.example.com:3128"})
+		assert.NoError(t, err)
+		assert.Equal(t, &url.URL{Scheme: "http", Host: "proxy.example.com:3128"}, proxyOf(t, transport, "https://cloudfunctions.googleapis.com"))
+		assert.Equal(t, &url.URL{Scheme: "http", Host: "proxy.example.com:3128"}, proxyOf(t, transport, "http://webhook.example.com"))
+		assert.Nil(t, proxyOf(t, transport, "https://internal.example.com"))
+	})
+
+	t.Run("invalid override", func(t *testing.T) {
+		_, err := NewHTTPTransport(HTTPClientOptions{ProxyURL: "proxy.example.com:3128"})
+		assert.Error(t, err)
+	})
+}
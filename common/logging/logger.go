@@ -37,7 +37,12 @@ const (
 	LabelPort            = "port"
 	LabelHTTPMethod      = "http-method"
 	LabelTime            = "time"
-	TimestampFormat      = "2006-01-02 15:04:05"
+	LabelSensorName      = "sensorName"
+	// LabelCorrelationID is the IDs of the events a trigger execution is triggered by, comma separated
+	LabelCorrelationID     = "correlationID"
+	LabelTriggeredBy       = "triggeredBy"
+	LabelTriggeredByEvents = "triggeredByEvents"
+	TimestampFormat        = "2006-01-02 15:04:05"
 )
 
 // NewArgoEventsLogger returns a new ArgoEventsLogger
@@ -50,7 +55,7 @@ func NewArgoEventsLogger() *zap.SugaredLogger {
 		config = zap.NewProductionConfig()
 	}
 	// Config customization goes here if any
-	config.OutputPaths = []string{"stdout"}
+	config.OutputPaths = []string{redactedStdout}
 	logger, err := config.Build()
 	if err != nil {
 		panic(err)
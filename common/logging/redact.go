@@ -0,0 +1,138 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// RedactedPlaceholder replaces the redacted values in the logs
+const RedactedPlaceholder = "******"
+
+// redactedStdout is the output of the loggers, stdout with the redacted values replaced
+const redactedStdout = "redacted://stdout"
+
+func init() {
+	if err := zap.RegisterSink("redacted", func(*url.URL) (zap.Sink, error) {
+		return redactingSink{WriteSyncer: NewRedactingWriteSyncer(os.Stdout)}, nil
+	}); err != nil {
+		panic(err)
+	}
+}
+
+var redactor = struct {
+	lock     sync.RWMutex
+	values   map[string]bool
+	replacer *strings.Replacer
+}{values: make(map[string]bool)}
+
+// Redact registers a value to be replaced by a placeholder in the logs, e.g. a secret value
+// resolved in a trigger payload. The JSON escaped forms of the value are also replaced, for
+// the value to be redacted from a payload logged as a string.
+func Redact(value string) {
+	if value == "" {
+		return
+	}
+	redactor.lock.Lock()
+	defer redactor.lock.Unlock()
+	if redactor.values[value] {
+		return
+	}
+	redactor.values[value] = true
+	forms := make(map[string]bool)
+	for v := range redactor.values {
+		forms[v] = true
+		escaped := v
+		// Escaped once in a JSON payload, and once more in a log entry.
+		for i := 0; i < 2; i++ {
+			escaped = escapeJSON(escaped)
+			forms[escaped] = true
+		}
+	}
+	sorted := make([]string, 0, len(forms))
+	for f := range forms {
+		sorted = append(sorted, f)
+	}
+	// The longest forms are replaced first, for a value not to be partially replaced.
+	sort.Slice(sorted, func(i, j int) bool { return len(sorted[i]) > len(sorted[j]) })
+	oldnew := make([]string, 0, 2*len(sorted))
+	for _, f := range sorted {
+		oldnew = append(oldnew, f, RedactedPlaceholder)
+	}
+	redactor.replacer = strings.NewReplacer(oldnew...)
+}
+
+// RedactString returns the string with the redacted values replaced by the placeholder
+func RedactString(s string) string {
+	redactor.lock.RLock()
+	defer redactor.lock.RUnlock()
+	if redactor.replacer == nil {
+		return s
+	}
+	return redactor.replacer.Replace(s)
+}
+
+func escapeJSON(s string) string {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(s); err != nil {
+		return s
+	}
+	// Trim the trailing newline and the quotes.
+	quoted := strings.TrimSuffix(buf.String(), "\n")
+	return quoted[1 : len(quoted)-1]
+}
+
+// NewRedactingWriteSyncer returns a write syncer replacing the redacted values in the log
+// entries written to ws, each entry being written at once.
+func NewRedactingWriteSyncer(ws zapcore.WriteSyncer) zapcore.WriteSyncer {
+	return &redactingWriteSyncer{ws: ws}
+}
+
+type redactingWriteSyncer struct {
+	ws zapcore.WriteSyncer
+}
+
+func (r *redactingWriteSyncer) Write(p []byte) (int, error) {
+	if _, err := r.ws.Write([]byte(RedactString(string(p)))); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func (r *redactingWriteSyncer) Sync() error {
+	return r.ws.Sync()
+}
+
+type redactingSink struct {
+	zapcore.WriteSyncer
+}
+
+func (redactingSink) Close() error {
+	// stdout is not closed
+	return nil
+}
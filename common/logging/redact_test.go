@@ -0,0 +1,48 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logging
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// newRedactingLogger returns a logger writing JSON entries to the buffer through the redaction
+func newRedactingLogger(buf *bytes.Buffer) *zap.SugaredLogger {
+	encoder := zapcore.NewJSONEncoder(zap.NewProductionEncoderConfig())
+	core := zapcore.NewCore(encoder, NewRedactingWriteSyncer(zapcore.AddSync(buf)), zapcore.DebugLevel)
+	return zap.New(core).Sugar()
+}
+
+func TestRedact(t *testing.T) {
+	Redact("")
+	assert.Equal(t, "unchanged", RedactString("unchanged"))
+
+	Redact(`s3cr3t"token`)
+	assert.Equal(t, "token: "+RedactedPlaceholder, RedactString(`token: s3cr3t"token`))
+	assert.Equal(t, `{"token":"`+RedactedPlaceholder+`"}`, RedactString(`{"token":"s3cr3t\"token"}`))
+
+	var buf bytes.Buffer
+	logger := newRedactingLogger(&buf)
+	logger.With("token", `s3cr3t"token`).Debugw("calling the function with s3cr3t\"token", "payload", `{"token":"s3cr3t\"token"}`)
+	assert.NotContains(t, buf.String(), "s3cr3t")
+	assert.Contains(t, buf.String(), RedactedPlaceholder)
+}
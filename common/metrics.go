@@ -0,0 +1,59 @@
+package common
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	crmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+)
+
+const (
+	triggerOutcomeSuccess = "success"
+	triggerOutcomeFailure = "failure"
+)
+
+var (
+	// Labels are limited to the sensor, trigger and outcome to keep the cardinality bounded.
+	triggerExecutions = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "argo_events",
+		Name:      "trigger_executions_total",
+		Help:      "How many times triggers have been executed, by outcome.",
+	}, []string{"sensor_name", "trigger_name", "trigger_type", "outcome"})
+
+	triggerExecutionDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "argo_events",
+		Name:      "trigger_execution_duration_seconds",
+		Help:      "Latency of trigger executions.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"sensor_name", "trigger_name", "trigger_type"})
+
+	triggerTokenRefreshFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "argo_events",
+		Name:      "trigger_token_refresh_failures_total",
+		Help:      "How many times triggers have failed to refresh their access token.",
+	}, []string{"sensor_name", "trigger_name", "trigger_type"})
+)
+
+func init() {
+	crmetrics.Registry.MustRegister(triggerExecutions, triggerExecutionDuration, triggerTokenRefreshFailures)
+}
+
+// ObserveTriggerExecution calls the execute function of a trigger, recording its outcome and latency.
+func ObserveTriggerExecution(sensorName, triggerName string, triggerType apicommon.TriggerType, execute func() (interface{}, error)) (interface{}, error) {
+	start := time.Now()
+	result, err := execute()
+	triggerExecutionDuration.WithLabelValues(sensorName, triggerName, string(triggerType)).Observe(time.Since(start).Seconds())
+	outcome := triggerOutcomeSuccess
+	if err != nil {
+		outcome = triggerOutcomeFailure
+	}
+	triggerExecutions.WithLabelValues(sensorName, triggerName, string(triggerType), outcome).Inc()
+	return result, err
+}
+
+// ObserveTokenRefreshFailure records a failure of a trigger to refresh its access token.
+func ObserveTokenRefreshFailure(sensorName, triggerName string, triggerType apicommon.TriggerType) {
+	triggerTokenRefreshFailures.WithLabelValues(sensorName, triggerName, string(triggerType)).Inc()
+}
@@ -0,0 +1,34 @@
+package common
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+)
+
+func TestObserveTriggerExecution(t *testing.T) {
+	result, err := ObserveTriggerExecution("test-sensor", "test-trigger", apicommon.GCPFunctionTrigger, func() (interface{}, error) {
+		return "ok", nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "ok", result)
+
+	_, err = ObserveTriggerExecution("test-sensor", "test-trigger", apicommon.GCPFunctionTrigger, func() (interface{}, error) {
+		return nil, fmt.Errorf("failed")
+	})
+	assert.Error(t, err)
+
+	assert.Equal(t, float64(1), testutil.ToFloat64(triggerExecutions.WithLabelValues("test-sensor", "test-trigger", string(apicommon.GCPFunctionTrigger), triggerOutcomeSuccess)))
+	assert.Equal(t, float64(1), testutil.ToFloat64(triggerExecutions.WithLabelValues("test-sensor", "test-trigger", string(apicommon.GCPFunctionTrigger), triggerOutcomeFailure)))
+	assert.Equal(t, 1, testutil.CollectAndCount(triggerExecutionDuration))
+}
+
+func TestObserveTokenRefreshFailure(t *testing.T) {
+	ObserveTokenRefreshFailure("test-sensor", "test-trigger", apicommon.GCPFunctionTrigger)
+	ObserveTokenRefreshFailure("test-sensor", "test-trigger", apicommon.GCPFunctionTrigger)
+	assert.Equal(t, float64(2), testutil.ToFloat64(triggerTokenRefreshFailures.WithLabelValues("test-sensor", "test-trigger", string(apicommon.GCPFunctionTrigger))))
+}
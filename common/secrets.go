@@ -0,0 +1,199 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// SecretsProviderVolume reads the Kubernetes secrets mounted in the pods, the default
+	SecretsProviderVolume = "volume"
+	// SecretsProviderVault reads the secrets from HashiCorp Vault
+	SecretsProviderVault = "vault"
+
+	// defaultSecretsCacheTTL is how long the secrets fetched from an external provider are cached by default
+	defaultSecretsCacheTTL = time.Minute
+)
+
+// SecretsProvider retrieves the values of the secrets referenced by the specs
+type SecretsProvider interface {
+	// GetSecret returns the value of the key of the secret
+	GetSecret(ctx context.Context, selector *v1.SecretKeySelector) (string, error)
+}
+
+var (
+	externalProviderOnce sync.Once
+	// externalProvider is the provider selected by the environment, nil for the default Kubernetes secrets
+	externalProvider    SecretsProvider
+	externalProviderErr error
+)
+
+// GetSecret retrieves the value of the secret from the secrets provider selected by the environment,
+// the secret volume mounted in the pod by default.
+func GetSecret(selector *v1.SecretKeySelector) (string, error) {
+	provider, err := getExternalSecretsProvider()
+	if err != nil {
+		return "", err
+	}
+	if provider == nil {
+		return GetSecretFromVolume(selector)
+	}
+	return provider.GetSecret(context.Background(), selector)
+}
+
+// getExternalSecretsProvider returns the provider selected by the environment, nil if the secrets are
+// the Kubernetes ones.
+func getExternalSecretsProvider() (SecretsProvider, error) {
+	externalProviderOnce.Do(func() {
+		externalProvider, externalProviderErr = newSecretsProviderFromEnv()
+	})
+	return externalProvider, externalProviderErr
+}
+
+// newSecretsProviderFromEnv builds the secrets provider selected by the environment, the fetched
+// secrets are cached to avoid a request per use.
+func newSecretsProviderFromEnv() (SecretsProvider, error) {
+	switch name := os.Getenv(EnvVarSecretsProvider); name {
+	case "", SecretsProviderVolume:
+		return nil, nil
+	case SecretsProviderVault:
+		ttl := defaultSecretsCacheTTL
+		if v := os.Getenv(EnvVarSecretsCacheTTL); v != "" {
+			d, err := time.ParseDuration(v)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid %s %q", EnvVarSecretsCacheTTL, v)
+			}
+			ttl = d
+		}
+		provider, err := NewVaultSecretsProvider(VaultConfig{
+			Addr:        os.Getenv(EnvVarVaultAddr),
+			Token:       os.Getenv(EnvVarVaultToken),
+			TokenPath:   os.Getenv(EnvVarVaultTokenPath),
+			Role:        os.Getenv(EnvVarVaultRole),
+			AuthPath:    os.Getenv(EnvVarVaultAuthPath),
+			SecretsPath: os.Getenv(EnvVarVaultSecretsPath),
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create the vault secrets provider")
+		}
+		return NewCachedSecretsProvider(provider, ttl), nil
+	default:
+		return nil, errors.Errorf("unknown secrets provider %q, supported providers: %s, %s", name, SecretsProviderVolume, SecretsProviderVault)
+	}
+}
+
+// UsesExternalSecretsProvider tells if the container env selects a secrets provider other than
+// the Kubernetes secrets, in which case the secrets are not mounted in the pod.
+func UsesExternalSecretsProvider(env []v1.EnvVar) bool {
+	for _, e := range env {
+		if e.Name == EnvVarSecretsProvider {
+			return e.Value != "" && e.Value != SecretsProviderVolume
+		}
+	}
+	return false
+}
+
+// volumeSecretsProvider reads the secrets mounted in the pods
+type volumeSecretsProvider struct{}
+
+// NewVolumeSecretsProvider returns a provider reading the secrets mounted in the pod
+func NewVolumeSecretsProvider() SecretsProvider {
+	return volumeSecretsProvider{}
+}
+
+func (volumeSecretsProvider) GetSecret(ctx context.Context, selector *v1.SecretKeySelector) (string, error) {
+	return GetSecretFromVolume(selector)
+}
+
+// kubernetesSecretsProvider reads the secrets through the Kubernetes API
+type kubernetesSecretsProvider struct {
+	client    kubernetes.Interface
+	namespace string
+}
+
+// NewKubernetesSecretsProvider returns a provider reading the secrets of the namespace through the Kubernetes API
+func NewKubernetesSecretsProvider(client kubernetes.Interface, namespace string) SecretsProvider {
+	return &kubernetesSecretsProvider{client: client, namespace: namespace}
+}
+
+func (p *kubernetesSecretsProvider) GetSecret(ctx context.Context, selector *v1.SecretKeySelector) (string, error) {
+	secret, err := p.client.CoreV1().Secrets(p.namespace).Get(ctx, selector.Name, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	val, ok := secret.Data[selector.Key]
+	if !ok {
+		return "", errors.Errorf("secret '%s' does not have the key '%s'", selector.Name, selector.Key)
+	}
+	return string(val), nil
+}
+
+// cachedSecretsProvider caches the secrets of another provider for a while
+type cachedSecretsProvider struct {
+	provider SecretsProvider
+	ttl      time.Duration
+	now      func() time.Time
+
+	lock    sync.Mutex
+	secrets map[string]cachedSecret
+}
+
+type cachedSecret struct {
+	value     string
+	expiresAt time.Time
+}
+
+// NewCachedSecretsProvider returns a provider caching the secrets retrieved by the provider for the ttl.
+// The failures are not cached.
+func NewCachedSecretsProvider(provider SecretsProvider, ttl time.Duration) SecretsProvider {
+	return &cachedSecretsProvider{
+		provider: provider,
+		ttl:      ttl,
+		now:      time.Now,
+		secrets:  make(map[string]cachedSecret),
+	}
+}
+
+func (p *cachedSecretsProvider) GetSecret(ctx context.Context, selector *v1.SecretKeySelector) (string, error) {
+	if selector == nil {
+		return "", errors.New("secret key selector is nil")
+	}
+	key := selector.Name + "/" + selector.Key
+	p.lock.Lock()
+	secret, ok := p.secrets[key]
+	p.lock.Unlock()
+	if ok && p.now().Before(secret.expiresAt) {
+		return secret.value, nil
+	}
+	value, err := p.provider.GetSecret(ctx, selector)
+	if err != nil {
+		return "", err
+	}
+	p.lock.Lock()
+	p.secrets[key] = cachedSecret{value: value, expiresAt: p.now().Add(p.ttl)}
+	p.lock.Unlock()
+	return value, nil
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+// fakeSecretsProvider counts the secrets it is asked for
+type fakeSecretsProvider struct {
+	value string
+	err   error
+	calls int
+}
+
+func (p *fakeSecretsProvider) GetSecret(ctx context.Context, selector *corev1.SecretKeySelector) (string, error) {
+	p.calls++
+	return p.value, p.err
+}
+
+func TestNewSecretsProviderFromEnv(t *testing.T) {
+	t.Run("default", func(t *testing.T) {
+		provider, err := newSecretsProviderFromEnv()
+		assert.NoError(t, err)
+		assert.Nil(t, provider)
+
+		t.Setenv(EnvVarSecretsProvider, SecretsProviderVolume)
+		provider, err = newSecretsProviderFromEnv()
+		assert.NoError(t, err)
+		assert.Nil(t, provider)
+	})
+
+	t.Run("vault", func(t *testing.T) {
+		t.Setenv(EnvVarSecretsProvider, SecretsProviderVault)
+		t.Setenv(EnvVarVaultAddr, "https://vault.vault:8200")
+		t.Setenv(EnvVarVaultRole, "argo-events")
+		t.Setenv(EnvVarVaultSecretsPath, "secret/data/argo-events")
+		t.Setenv(EnvVarSecretsCacheTTL, "30s")
+		provider, err := newSecretsProviderFromEnv()
+		assert.NoError(t, err)
+		cached, ok := provider.(*cachedSecretsProvider)
+		assert.True(t, ok)
+		assert.Equal(t, 30*time.Second, cached.ttl)
+		_, ok = cached.provider.(*vaultSecretsProvider)
+		assert.True(t, ok)
+	})
+
+	t.Run("vault without address", func(t *testing.T) {
+		t.Setenv(EnvVarSecretsProvider, SecretsProviderVault)
+		t.Setenv(EnvVarVaultRole, "argo-events")
+		t.Setenv(EnvVarVaultSecretsPath, "secret/data/argo-events")
+		_, err := newSecretsProviderFromEnv()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "vault address is not specified")
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		t.Setenv(EnvVarSecretsProvider, "fake")
+		_, err := newSecretsProviderFromEnv()
+		assert.Error(t, err)
+	})
+}
+
+func TestUsesExternalSecretsProvider(t *testing.T) {
+	assert.False(t, UsesExternalSecretsProvider(nil))
+	assert.False(t, UsesExternalSecretsProvider([]corev1.EnvVar{{Name: EnvVarSecretsProvider, Value: SecretsProviderVolume}}))
+	assert.True(t, UsesExternalSecretsProvider([]corev1.EnvVar{{Name: "FOO", Value: "bar"}, {Name: EnvVarSecretsProvider, Value: SecretsProviderVault}}))
+}
+
+func TestKubernetesSecretsProvider(t *testing.T) {
+	client := fake.NewSimpleClientset(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "fake-secret", Namespace: "fake"},
+		Data:       map[string][]byte{"token": []byte("fake-token")},
+	})
+	provider := NewKubernetesSecretsProvider(client, "fake")
+	value, err := provider.GetSecret(context.TODO(), &corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: "fake-secret"},
+		Key:                  "token",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "fake-token", value)
+
+	_, err = provider.GetSecret(context.TODO(), &corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: "fake-secret"},
+		Key:                  "password",
+	})
+	assert.Error(t, err)
+}
+
+func TestCachedSecretsProvider(t *testing.T) {
+	fakeProvider := &fakeSecretsProvider{value: "fake-token"}
+	provider := NewCachedSecretsProvider(fakeProvider, time.Minute).(*cachedSecretsProvider)
+	now := time.Now()
+	provider.now = func() time.Time { return now }
+	selector := &corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: "fake-secret"},
+		Key:                  "token",
+	}
+
+	for i := 0; i < 3; i++ {
+		value, err := provider.GetSecret(context.TODO(), selector)
+		assert.NoError(t, err)
+		assert.Equal(t, "fake-token", value)
+	}
+	assert.Equal(t, 1, fakeProvider.calls)
+
+	// Another key of the same secret is cached separately.
+	_, err := provider.GetSecret(context.TODO(), &corev1.SecretKeySelector{LocalObjectReference: selector.LocalObjectReference, Key: "password"})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, fakeProvider.calls)
+
+	now = now.Add(2 * time.Minute)
+	fakeProvider.value = "rotated-token"
+	value, err := provider.GetSecret(context.TODO(), selector)
+	assert.NoError(t, err)
+	assert.Equal(t, "rotated-token", value)
+	assert.Equal(t, 3, fakeProvider.calls)
+
+	// The failures are not cached.
+	now = now.Add(2 * time.Minute)
+	fakeProvider.err = errors.New("vault is sealed")
+	_, err = provider.GetSecret(context.TODO(), selector)
+	assert.Error(t, err)
+	_, err = provider.GetSecret(context.TODO(), selector)
+	assert.Error(t, err)
+	assert.Equal(t, 5, fakeProvider.calls)
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"sort"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.10.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// EnvVarExporterEndpoint is the env of the OTLP/HTTP endpoint to export the spans to, e.g. "http://otel-collector:4318".
+	// Tracing is disabled if it is not set.
+	EnvVarExporterEndpoint = "OTEL_EXPORTER_OTLP_ENDPOINT"
+
+	instrumentationName = "github.com/argoproj/argo-events"
+)
+
+// Span attributes
+var (
+	AttributeSensorName   = attribute.Key("argo_events.sensor_name")
+	AttributeTriggerName  = attribute.Key("argo_events.trigger_name")
+	AttributeTriggerType  = attribute.Key("argo_events.trigger_type")
+	AttributeOutcome      = attribute.Key("argo_events.outcome")
+	AttributeFunctionName = attribute.Key("faas.invoked_name")
+)
+
+// propagator propagates the W3C trace context, carried by the traceparent and tracestate
+// CloudEvents extensions in the events.
+var propagator = propagation.TraceContext{}
+
+// Init exports the spans to the OTLP endpoint if configured, and returns the function to flush
+// and stop the exporter. Without an endpoint, the global tracer provider is left as a no-op.
+func Init(ctx context.Context, serviceName string) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagator)
+	if os.Getenv(EnvVarExporterEndpoint) == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+	// The exporter reads the endpoint and the other OTEL_EXPORTER_OTLP_* settings from the env.
+	exporter, err := otlptracehttp.New(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create the OTLP trace exporter")
+	}
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceNameKey.String(serviceName)))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create the trace resource")
+	}
+	provider := sdktrace.NewTracerProvider(sdktrace.WithBatcher(exporter), sdktrace.WithResource(res))
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
+
+// Tracer returns the tracer of Argo Events
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// ExtractFromEvents returns a context carrying the trace context propagated with the events,
+// from the first event in the order of the dependency names that has one.
+func ExtractFromEvents(ctx context.Context, events map[string]cloudevents.Event) context.Context {
+	depNames := make([]string, 0, len(events))
+	for depName := range events {
+		depNames = append(depNames, depName)
+	}
+	sort.Strings(depNames)
+	for _, depName := range depNames {
+		carrier := propagation.MapCarrier{}
+		for k, v := range events[depName].Extensions() {
+			if s, ok := v.(string); ok {
+				carrier[k] = s
+			}
+		}
+		if extracted := propagator.Extract(ctx, carrier); trace.SpanContextFromContext(extracted).IsValid() {
+			return extracted
+		}
+	}
+	return ctx
+}
+
+// InjectHeaders injects the trace context in the outbound HTTP request headers.
+func InjectHeaders(ctx context.Context, header http.Header) {
+	propagator.Inject(ctx, propagation.HeaderCarrier(header))
+}
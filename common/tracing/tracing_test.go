@@ -0,0 +1,69 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"testing"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const fakeTraceParent = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+
+func TestInit(t *testing.T) {
+	os.Unsetenv(EnvVarExporterEndpoint)
+	shutdown, err := Init(context.Background(), "test")
+	assert.NoError(t, err)
+	assert.NoError(t, shutdown(context.Background()))
+	_, span := Tracer().Start(context.Background(), "test")
+	defer span.End()
+	assert.False(t, span.IsRecording())
+}
+
+func TestExtractFromEvents(t *testing.T) {
+	withoutTrace := cloudevents.NewEvent()
+	withTrace := cloudevents.NewEvent()
+	withTrace.SetExtension("traceparent", fakeTraceParent)
+
+	ctx := ExtractFromEvents(context.Background(), map[string]cloudevents.Event{"a": withoutTrace})
+	assert.False(t, trace.SpanContextFromContext(ctx).IsValid())
+
+	ctx = ExtractFromEvents(context.Background(), map[string]cloudevents.Event{"a": withoutTrace, "b": withTrace})
+	spanCtx := trace.SpanContextFromContext(ctx)
+	assert.True(t, spanCtx.IsValid())
+	assert.True(t, spanCtx.IsRemote())
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", spanCtx.TraceID().String())
+}
+
+func TestInjectHeaders(t *testing.T) {
+	withTrace := cloudevents.NewEvent()
+	withTrace.SetExtension("traceparent", fakeTraceParent)
+	ctx := ExtractFromEvents(context.Background(), map[string]cloudevents.Event{"a": withTrace})
+
+	header := http.Header{}
+	InjectHeaders(ctx, header)
+	assert.Equal(t, fakeTraceParent, header.Get("traceparent"))
+
+	header = http.Header{}
+	InjectHeaders(context.Background(), header)
+	assert.Empty(t, header.Get("traceparent"))
+}
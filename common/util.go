@@ -113,17 +113,17 @@ func ErrEventSourceTypeMismatch(eventSourceType string) string {
 	return fmt.Sprintf("event source is not type of %s", eventSourceType)
 }
 
-// GetSecretValue retrieves the secret value from the secret in namespace with name and key
+// GetSecretValue retrieves the secret value from the secret in namespace with name and key,
+// through the Kubernetes API unless another secrets provider is selected by the environment
 func GetSecretValue(ctx context.Context, client kubernetes.Interface, namespace string, selector *v1.SecretKeySelector) (string, error) {
-	secret, err := client.CoreV1().Secrets(namespace).Get(ctx, selector.Name, metav1.GetOptions{})
+	provider, err := getExternalSecretsProvider()
 	if err != nil {
 		return "", err
 	}
-	val, ok := secret.Data[selector.Key]
-	if !ok {
-		return "", errors.Errorf("secret '%s' does not have the key '%s'", selector.Name, selector.Key)
+	if provider == nil {
+		provider = NewKubernetesSecretsProvider(client, namespace)
 	}
-	return string(val), nil
+	return provider.GetSecret(ctx, selector)
 }
 
 // GetEnvFromSecret retrieves the value of envFrom.secretRef
@@ -278,6 +278,37 @@ func GetTLSConfig(config *apicommon.TLSConfig) (*tls.Config, error) {
 	return c, nil
 }
 
+// NewClientTLSConfig returns a tls configuration presenting the PEM encoded client certificate, and verifying
+// the server with the CA certificate if any, or the system roots otherwise.
+// The minimum version is "1.2" or "1.3", the default of the tls package if empty.
+func NewClientTLSConfig(caCert, clientCert, clientKey []byte, insecureSkipVerify bool, minVersion string) (*tls.Config, error) {
+	cert, err := tls.X509KeyPair(clientCert, clientKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load client cert key pair")
+	}
+	c := &tls.Config{
+		Certificates:       []tls.Certificate{cert},
+		InsecureSkipVerify: insecureSkipVerify,
+	}
+	if len(caCert) > 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caCert) {
+			return nil, errors.New("no valid PEM encoded certificate found in the ca cert")
+		}
+		c.RootCAs = pool
+	}
+	switch minVersion {
+	case "":
+	case "1.2":
+		c.MinVersion = tls.VersionTLS12
+	case "1.3":
+		c.MinVersion = tls.VersionTLS13
+	default:
+		return nil, errors.Errorf("unsupported tls min version %q", minVersion)
+	}
+	return c, nil
+}
+
 // VolumesFromSecretsOrConfigMaps builds volumes and volumeMounts spec based on
 // the obj and its children's secretKeyselector or configMapKeySelector
 func VolumesFromSecretsOrConfigMaps(obj interface{}, t reflect.Type) ([]v1.Volume, []v1.VolumeMount) {
@@ -17,9 +17,18 @@ limitations under the License.
 package common
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
 	"net/http"
 	"strings"
 	"testing"
+	"time"
 
 	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
 	"github.com/stretchr/testify/assert"
@@ -276,3 +285,44 @@ func TestSliceContains(t *testing.T) {
 	assert.True(t, SliceContains([]string{"*", "hello", "*"}, "*"))
 	assert.False(t, SliceContains([]string{"hello", "world"}, "*"))
 }
+
+// fakeCertificate returns a PEM encoded self-signed certificate and its key
+func fakeCertificate(t *testing.T) ([]byte, []byte) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "eventbus"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NoError(t, err)
+	keyDer, err := x509.MarshalPKCS8PrivateKey(key)
+	assert.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDer})
+}
+
+func TestNewClientTLSConfig(t *testing.T) {
+	cert, key := fakeCertificate(t)
+
+	c, err := NewClientTLSConfig(cert, cert, key, false, "1.3")
+	assert.NoError(t, err)
+	assert.Len(t, c.Certificates, 1)
+	assert.NotNil(t, c.RootCAs)
+	assert.Equal(t, uint16(tls.VersionTLS13), c.MinVersion)
+
+	c, err = NewClientTLSConfig(nil, cert, key, true, "")
+	assert.NoError(t, err)
+	assert.Nil(t, c.RootCAs)
+	assert.True(t, c.InsecureSkipVerify)
+	assert.Equal(t, uint16(0), c.MinVersion)
+
+	_, err = NewClientTLSConfig(nil, cert, nil, false, "")
+	assert.Error(t, err)
+	_, err = NewClientTLSConfig([]byte("not a certificate"), cert, key, false, "")
+	assert.Error(t, err)
+	_, err = NewClientTLSConfig(nil, cert, key, false, "1.1")
+	assert.Error(t, err)
+}
@@ -0,0 +1,236 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	v1 "k8s.io/api/core/v1"
+)
+
+const (
+	// serviceAccountTokenPath is the path of the token of the service account of the pod
+	serviceAccountTokenPath = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+	// defaultVaultAuthPath is the default mount path of the Vault Kubernetes auth method
+	defaultVaultAuthPath = "kubernetes"
+	// vaultRequestTimeout is the timeout of the requests to Vault
+	vaultRequestTimeout = 10 * time.Second
+)
+
+// VaultConfig is the configuration of the Vault secrets provider
+type VaultConfig struct {
+	// Addr is the address of the Vault server, e.g. https://vault.vault:8200
+	Addr string
+	// Token authenticates the requests, it takes precedence over TokenPath and Role
+	Token string
+	// TokenPath is the path of a file holding the token, e.g. written by the Vault agent.
+	// The file is read again when the token is rejected. It takes precedence over Role.
+	TokenPath string
+	// Role is the role to log in as with the Kubernetes auth method, using the token of
+	// the service account of the pod
+	Role string
+	// AuthPath is the mount path of the Kubernetes auth method, defaults to kubernetes
+	AuthPath string
+	// SecretsPath is the path the secrets are read under, e.g. "secret/data/argo-events" for
+	// a KV version 2 engine mounted at secret. A secret is read at <SecretsPath>/<secret name>.
+	SecretsPath string
+}
+
+// vaultSecretsProvider reads the secrets from Vault
+type vaultSecretsProvider struct {
+	config                  VaultConfig
+	client                  *http.Client
+	serviceAccountTokenPath string
+
+	// lock guards the token, which is renewed once rejected
+	lock  sync.Mutex
+	token string
+}
+
+// NewVaultSecretsProvider returns a provider reading the secrets from Vault, the values of the keys
+// of a secret being the fields of the data at its path.
+func NewVaultSecretsProvider(config VaultConfig) (SecretsProvider, error) {
+	if config.Addr == "" {
+		return nil, errors.New("vault address is not specified")
+	}
+	if config.SecretsPath == "" {
+		return nil, errors.New("vault secrets path is not specified")
+	}
+	if config.Token == "" && config.TokenPath == "" && config.Role == "" {
+		return nil, errors.New("one of the vault token, token path or role must be specified")
+	}
+	if config.AuthPath == "" {
+		config.AuthPath = defaultVaultAuthPath
+	}
+	return &vaultSecretsProvider{
+		config:                  config,
+		client:                  &http.Client{Timeout: vaultRequestTimeout},
+		serviceAccountTokenPath: serviceAccountTokenPath,
+	}, nil
+}
+
+func (p *vaultSecretsProvider) GetSecret(ctx context.Context, selector *v1.SecretKeySelector) (string, error) {
+	if selector == nil {
+		return "", errors.New("secret key selector is nil")
+	}
+	data, err := p.read(ctx, strings.Trim(p.config.SecretsPath, "/")+"/"+selector.Name)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to read secret '%s' from vault", selector.Name)
+	}
+	val, ok := data[selector.Key]
+	if !ok {
+		return "", errors.Errorf("secret '%s' does not have the key '%s'", selector.Name, selector.Key)
+	}
+	s, ok := val.(string)
+	if !ok {
+		return "", errors.Errorf("the key '%s' of secret '%s' is not a string", selector.Key, selector.Name)
+	}
+	return s, nil
+}
+
+// read returns the data at the path, the token is renewed once if it is rejected.
+func (p *vaultSecretsProvider) read(ctx context.Context, path string) (map[string]interface{}, error) {
+	token, err := p.getToken(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+	body, status, err := p.do(ctx, http.MethodGet, "/v1/"+path, token, nil)
+	if err == nil && status == http.StatusForbidden && p.config.Token == "" {
+		if token, err = p.getToken(ctx, true); err != nil {
+			return nil, err
+		}
+		body, status, err = p.do(ctx, http.MethodGet, "/v1/"+path, token, nil)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK {
+		return nil, vaultError(status, body)
+	}
+	var response struct {
+		Data map[string]interface{} `json:"data"`
+	}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, errors.Wrap(err, "failed to parse the vault response")
+	}
+	// The KV version 2 engine nests the data along with its metadata.
+	if data, ok := response.Data["data"].(map[string]interface{}); ok {
+		if _, ok := response.Data["metadata"]; ok {
+			return data, nil
+		}
+	}
+	return response.Data, nil
+}
+
+// getToken returns the token to authenticate with, read or logged in for again if renew is true.
+func (p *vaultSecretsProvider) getToken(ctx context.Context, renew bool) (string, error) {
+	if p.config.Token != "" {
+		return p.config.Token, nil
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if p.token != "" && !renew {
+		return p.token, nil
+	}
+	if p.config.TokenPath != "" {
+		data, err := ioutil.ReadFile(p.config.TokenPath)
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to read the vault token file %s", p.config.TokenPath)
+		}
+		p.token = strings.TrimSpace(string(data))
+		return p.token, nil
+	}
+	token, err := p.login(ctx)
+	if err != nil {
+		return "", err
+	}
+	p.token = token
+	return token, nil
+}
+
+// login logs in with the Kubernetes auth method and returns the client token.
+func (p *vaultSecretsProvider) login(ctx context.Context) (string, error) {
+	jwt, err := ioutil.ReadFile(p.serviceAccountTokenPath)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to read the service account token")
+	}
+	payload, err := json.Marshal(map[string]string{
+		"role": p.config.Role,
+		"jwt":  strings.TrimSpace(string(jwt)),
+	})
+	if err != nil {
+		return "", err
+	}
+	body, status, err := p.do(ctx, http.MethodPost, "/v1/auth/"+strings.Trim(p.config.AuthPath, "/")+"/login", "", payload)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to log in to vault")
+	}
+	if status != http.StatusOK {
+		return "", errors.Wrap(vaultError(status, body), "failed to log in to vault")
+	}
+	var response struct {
+		Auth struct {
+			ClientToken string `json:"client_token"`
+		} `json:"auth"`
+	}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return "", errors.Wrap(err, "failed to parse the vault login response")
+	}
+	if response.Auth.ClientToken == "" {
+		return "", errors.New("no client token in the vault login response")
+	}
+	return response.Auth.ClientToken, nil
+}
+
+// do sends the request to Vault and returns the body and the status code of the response.
+func (p *vaultSecretsProvider) do(ctx context.Context, method, path, token string, payload []byte) ([]byte, int, error) {
+	req, err := http.NewRequestWithContext(ctx, method, strings.TrimSuffix(p.config.Addr, "/")+path, bytes.NewReader(payload))
+	if err != nil {
+		return nil, 0, err
+	}
+	if token != "" {
+		req.Header.Set("X-Vault-Token", token)
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "failed to read the vault response")
+	}
+	return body, resp.StatusCode, nil
+}
+
+// vaultError returns the error of a failed Vault request. Only the error messages of the response
+// are kept, never its data.
+func vaultError(status int, body []byte) error {
+	var response struct {
+		Errors []string `json:"errors"`
+	}
+	_ = json.Unmarshal(body, &response)
+	return errors.Errorf("vault responded with status %d, errors: %q", status, response.Errors)
+}
@@ -0,0 +1,171 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+)
+
+var vaultSelector = &corev1.SecretKeySelector{
+	LocalObjectReference: corev1.LocalObjectReference{Name: "gcp-credentials"},
+	Key:                  "key.json",
+}
+
+// fakeVault serves a KV version 2 secret to the requests with the token, and logs in with the
+// Kubernetes auth method.
+func fakeVault(t *testing.T, token string, logins *int32) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/auth/kubernetes/login", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(logins, 1)
+		var body map[string]string
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if body["role"] != "argo-events" || body["jwt"] != "fake-jwt" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"errors":["invalid role or jwt"]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"auth":{"client_token":"` + token + `"}}`))
+	})
+	mux.HandleFunc("/v1/secret/data/argo-events/gcp-credentials", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != token {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"errors":["permission denied"]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":{"data":{"key.json":"{\"type\":\"service_account\"}"},"metadata":{"version":3}}}`))
+	})
+	mux.HandleFunc("/v1/kv/argo-events/gcp-credentials", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{"key.json":"{\"type\":\"service_account\"}"}}`))
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestNewVaultSecretsProvider(t *testing.T) {
+	_, err := NewVaultSecretsProvider(VaultConfig{SecretsPath: "secret/data/argo-events", Token: "fake"})
+	assert.Error(t, err)
+	_, err = NewVaultSecretsProvider(VaultConfig{Addr: "https://vault.vault:8200", Token: "fake"})
+	assert.Error(t, err)
+	_, err = NewVaultSecretsProvider(VaultConfig{Addr: "https://vault.vault:8200", SecretsPath: "secret/data/argo-events"})
+	assert.Error(t, err)
+
+	provider, err := NewVaultSecretsProvider(VaultConfig{Addr: "https://vault.vault:8200", SecretsPath: "secret/data/argo-events", Role: "argo-events"})
+	assert.NoError(t, err)
+	assert.Equal(t, "kubernetes", provider.(*vaultSecretsProvider).config.AuthPath)
+}
+
+func TestVaultSecretsProvider_GetSecret(t *testing.T) {
+	t.Run("token", func(t *testing.T) {
+		var logins int32
+		server := fakeVault(t, "fake-token", &logins)
+		provider, err := NewVaultSecretsProvider(VaultConfig{Addr: server.URL, Token: "fake-token", SecretsPath: "/secret/data/argo-events/"})
+		assert.NoError(t, err)
+		value, err := provider.GetSecret(context.TODO(), vaultSelector)
+		assert.NoError(t, err)
+		assert.Equal(t, `{"type":"service_account"}`, value)
+
+		_, err = provider.GetSecret(context.TODO(), &corev1.SecretKeySelector{LocalObjectReference: vaultSelector.LocalObjectReference, Key: "fake"})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "does not have the key 'fake'")
+	})
+
+	t.Run("kv version 1", func(t *testing.T) {
+		var logins int32
+		server := fakeVault(t, "fake-token", &logins)
+		provider, err := NewVaultSecretsProvider(VaultConfig{Addr: server.URL, Token: "fake-token", SecretsPath: "kv/argo-events"})
+		assert.NoError(t, err)
+		value, err := provider.GetSecret(context.TODO(), vaultSelector)
+		assert.NoError(t, err)
+		assert.Equal(t, `{"type":"service_account"}`, value)
+	})
+
+	t.Run("rejected token", func(t *testing.T) {
+		var logins int32
+		server := fakeVault(t, "fake-token", &logins)
+		provider, err := NewVaultSecretsProvider(VaultConfig{Addr: server.URL, Token: "other-token", SecretsPath: "secret/data/argo-events"})
+		assert.NoError(t, err)
+		_, err = provider.GetSecret(context.TODO(), vaultSelector)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "permission denied")
+	})
+
+	t.Run("token file renewed once rejected", func(t *testing.T) {
+		var logins int32
+		server := fakeVault(t, "fake-token", &logins)
+		tokenPath := filepath.Join(t.TempDir(), "token")
+		assert.NoError(t, ioutil.WriteFile(tokenPath, []byte("expired-token\n"), 0600))
+		provider, err := NewVaultSecretsProvider(VaultConfig{Addr: server.URL, TokenPath: tokenPath, SecretsPath: "secret/data/argo-events"})
+		assert.NoError(t, err)
+		_, err = provider.GetSecret(context.TODO(), vaultSelector)
+		assert.Error(t, err)
+
+		// The agent has written a new token.
+		assert.NoError(t, ioutil.WriteFile(tokenPath, []byte("fake-token\n"), 0600))
+		value, err := provider.GetSecret(context.TODO(), vaultSelector)
+		assert.NoError(t, err)
+		assert.Equal(t, `{"type":"service_account"}`, value)
+	})
+
+	t.Run("kubernetes auth", func(t *testing.T) {
+		var logins int32
+		server := fakeVault(t, "fake-token", &logins)
+		provider, err := NewVaultSecretsProvider(VaultConfig{Addr: server.URL, Role: "argo-events", SecretsPath: "secret/data/argo-events"})
+		assert.NoError(t, err)
+		jwtPath := filepath.Join(t.TempDir(), "token")
+		assert.NoError(t, ioutil.WriteFile(jwtPath, []byte("fake-jwt"), 0600))
+		provider.(*vaultSecretsProvider).serviceAccountTokenPath = jwtPath
+
+		for i := 0; i < 2; i++ {
+			value, err := provider.GetSecret(context.TODO(), vaultSelector)
+			assert.NoError(t, err)
+			assert.Equal(t, `{"type":"service_account"}`, value)
+		}
+		assert.Equal(t, int32(1), atomic.LoadInt32(&logins))
+
+		// An expired token is renewed by logging in again.
+		provider.(*vaultSecretsProvider).token = "expired-token"
+		_, err = provider.GetSecret(context.TODO(), vaultSelector)
+		assert.NoError(t, err)
+		assert.Equal(t, int32(2), atomic.LoadInt32(&logins))
+	})
+
+	t.Run("kubernetes auth failure", func(t *testing.T) {
+		var logins int32
+		server := fakeVault(t, "fake-token", &logins)
+		provider, err := NewVaultSecretsProvider(VaultConfig{Addr: server.URL, Role: "other-role", SecretsPath: "secret/data/argo-events"})
+		assert.NoError(t, err)
+		jwtPath := filepath.Join(t.TempDir(), "token")
+		assert.NoError(t, ioutil.WriteFile(jwtPath, []byte("fake-jwt"), 0600))
+		provider.(*vaultSecretsProvider).serviceAccountTokenPath = jwtPath
+		_, err = provider.GetSecret(context.TODO(), vaultSelector)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to log in to vault")
+		assert.Contains(t, err.Error(), "invalid role or jwt")
+	})
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"crypto/sha256"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/pkg/errors"
+	v1 "k8s.io/api/core/v1"
+)
+
+// watchFileDebounce is the period within which the events of a watched file are coalesced, a single
+// write usually fires several events, the first ones possibly seeing the file truncated.
+const watchFileDebounce = 100 * time.Millisecond
+
+// WatchFile calls onChange with the content of the file each time it changes, until the context is done.
+// The directory of the file is watched rather than the file itself, since the kubelet updates the mounted
+// secrets and configmaps by swapping a symlink in their directory, without writing to the file. The events
+// which don't change the content, and the failures to read the file while it is replaced, are ignored.
+func WatchFile(ctx context.Context, path string, onChange func(content []byte)) error {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read file %s", path)
+	}
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return errors.Wrap(err, "failed to create the file watcher")
+	}
+	if err := watcher.Add(filepath.Dir(path)); err != nil {
+		_ = watcher.Close()
+		return errors.Wrapf(err, "failed to watch the directory of file %s", path)
+	}
+	revision := sha256.Sum256(content)
+	go func() {
+		defer watcher.Close()
+		timer := time.NewTimer(watchFileDebounce)
+		timer.Stop()
+		defer timer.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case _, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				timer.Reset(watchFileDebounce)
+			case <-timer.C:
+				content, err := ioutil.ReadFile(path)
+				if err != nil {
+					continue
+				}
+				if r := sha256.Sum256(content); r != revision {
+					revision = r
+					onChange(content)
+				}
+			case _, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+// WatchSecretVolume is the variant of GetSecretFromVolume calling onChange with the value of the mounted
+// secret each time it is rotated, until the context is done.
+func WatchSecretVolume(ctx context.Context, selector *v1.SecretKeySelector, onChange func(value string)) error {
+	filePath, err := GetSecretVolumePath(selector)
+	if err != nil {
+		return err
+	}
+	return WatchFile(ctx, filePath, func(content []byte) {
+		onChange(strings.TrimSuffix(string(content), "\n"))
+	})
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// changes records the contents a watched file changed to
+type changes struct {
+	lock     sync.Mutex
+	contents []string
+}
+
+func (c *changes) add(content []byte) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.contents = append(c.contents, string(content))
+}
+
+func (c *changes) get() []string {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return append([]string{}, c.contents...)
+}
+
+func TestWatchFile(t *testing.T) {
+	t.Run("file rewritten", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "key.json")
+		assert.NoError(t, ioutil.WriteFile(path, []byte("v1"), 0600))
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		c := &changes{}
+		assert.NoError(t, WatchFile(ctx, path, c.add))
+
+		// The same content is not a change.
+		assert.NoError(t, ioutil.WriteFile(path, []byte("v1"), 0600))
+		assert.NoError(t, ioutil.WriteFile(path, []byte("v2"), 0600))
+		assert.Eventually(t, func() bool {
+			return len(c.get()) == 1
+		}, 5*time.Second, 10*time.Millisecond)
+		assert.Equal(t, []string{"v2"}, c.get())
+	})
+
+	t.Run("symlink swapped as by the kubelet", func(t *testing.T) {
+		dir := t.TempDir()
+		for _, version := range []string{"v1", "v2"} {
+			assert.NoError(t, os.Mkdir(filepath.Join(dir, version), 0700))
+			assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, version, "key.json"), []byte(version), 0600))
+		}
+		assert.NoError(t, os.Symlink("v1", filepath.Join(dir, "..data")))
+		assert.NoError(t, os.Symlink(filepath.Join("..data", "key.json"), filepath.Join(dir, "key.json")))
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		c := &changes{}
+		assert.NoError(t, WatchFile(ctx, filepath.Join(dir, "key.json"), c.add))
+
+		assert.NoError(t, os.Symlink("v2", filepath.Join(dir, "..data_tmp")))
+		assert.NoError(t, os.Rename(filepath.Join(dir, "..data_tmp"), filepath.Join(dir, "..data")))
+		assert.Eventually(t, func() bool {
+			return len(c.get()) == 1
+		}, 5*time.Second, 10*time.Millisecond)
+		assert.Equal(t, []string{"v2"}, c.get())
+	})
+
+	t.Run("stops once the context is done", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "key.json")
+		assert.NoError(t, ioutil.WriteFile(path, []byte("v1"), 0600))
+		ctx, cancel := context.WithCancel(context.Background())
+		c := &changes{}
+		assert.NoError(t, WatchFile(ctx, path, c.add))
+		cancel()
+		time.Sleep(50 * time.Millisecond)
+		assert.NoError(t, ioutil.WriteFile(path, []byte("v2"), 0600))
+		time.Sleep(100 * time.Millisecond)
+		assert.Empty(t, c.get())
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		err := WatchFile(context.Background(), filepath.Join(t.TempDir(), "missing"), func([]byte) {})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,112 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/healthz"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// DefaultReconcileStallThreshold is the default time a reconciliation can run before the controller is reported
+// stalled by its liveness check
+const DefaultReconcileStallThreshold = 10 * time.Minute
+
+// cacheSyncTimeout is how long the readiness check waits for the informer caches to sync, less than the
+// default timeout of the probes
+const cacheSyncTimeout = 500 * time.Millisecond
+
+// cacheSyncer is the part of the cache of the controller manager the readiness check uses
+type cacheSyncer interface {
+	WaitForCacheSync(ctx context.Context) bool
+}
+
+// CacheSyncCheck returns a readiness check which fails until the informer caches of the controller manager are
+// started and synced, while the controller would reconcile from stale or missing objects.
+func CacheSyncCheck(cache cacheSyncer) healthz.Checker {
+	return func(req *http.Request) error {
+		ctx, cancel := context.WithTimeout(req.Context(), cacheSyncTimeout)
+		defer cancel()
+		if !cache.WaitForCacheSync(ctx) {
+			return errors.New("the informer caches are not synced")
+		}
+		return nil
+	}
+}
+
+// StallDetector tells if the reconciliations of a controller are stalled, which is the case once a reconciliation
+// has been in progress for longer than the threshold without any reconciliation completing since, e.g. stuck on a
+// call without a deadline. An idle controller, or a replica standing by for the leadership, is never stalled. A
+// reconciliation failing on an object, e.g. of an invalid spec, completes and is retried with a backoff, it is not
+// a stall.
+type StallDetector struct {
+	lock      sync.Mutex
+	threshold time.Duration
+	// inFlight is the number of reconciliations in progress
+	inFlight int
+	// waitingSince is when a reconciliation started without any reconciliation completing since, zero if none
+	waitingSince time.Time
+	// lastCompleted is when the last reconciliation completed, zero if none did
+	lastCompleted time.Time
+	now           func() time.Time
+}
+
+// NewStallDetector returns a stall detector of the threshold, the default one if it is not positive.
+func NewStallDetector(threshold time.Duration) *StallDetector {
+	if threshold <= 0 {
+		threshold = DefaultReconcileStallThreshold
+	}
+	return &StallDetector{threshold: threshold, now: time.Now}
+}
+
+// Wrap returns the reconciler reporting its reconciliations to the detector
+func (d *StallDetector) Wrap(reconciler reconcile.Reconciler) reconcile.Reconciler {
+	return reconcile.Func(func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+		d.started()
+		defer d.completed()
+		return reconciler.Reconcile(ctx, req)
+	})
+}
+
+func (d *StallDetector) started() {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.inFlight++
+	if d.waitingSince.IsZero() {
+		d.waitingSince = d.now()
+	}
+}
+
+func (d *StallDetector) completed() {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.inFlight--
+	d.lastCompleted = d.now()
+	if d.inFlight > 0 {
+		// The other reconciliations in progress are given the whole threshold again.
+		d.waitingSince = d.lastCompleted
+	} else {
+		d.waitingSince = time.Time{}
+	}
+}
+
+// Check is the liveness check of the controller, failing once its reconciliations are stalled
+func (d *StallDetector) Check(_ *http.Request) error {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	if d.waitingSince.IsZero() {
+		return nil
+	}
+	if waiting := d.now().Sub(d.waitingSince); waiting > d.threshold {
+		last := "never"
+		if !d.lastCompleted.IsZero() {
+			last = d.lastCompleted.UTC().Format(time.RFC3339)
+		}
+		return fmt.Errorf("the reconciliations are stalled, %d in progress and none completed for %v, the last one completed %s", d.inFlight, waiting.Round(time.Second), last)
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+type fakeCache struct {
+	synced bool
+}
+
+func (c *fakeCache) WaitForCacheSync(_ context.Context) bool {
+	return c.synced
+}
+
+func TestCacheSyncCheck(t *testing.T) {
+	cache := &fakeCache{}
+	check := CacheSyncCheck(cache)
+	err := check(httptest.NewRequest("GET", "/readyz", nil))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "not synced")
+
+	cache.synced = true
+	assert.NoError(t, check(httptest.NewRequest("GET", "/readyz", nil)))
+}
+
+func TestStallDetector(t *testing.T) {
+	now := time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC)
+	newDetector := func() *StallDetector {
+		d := NewStallDetector(time.Minute)
+		d.now = func() time.Time { return now }
+		return d
+	}
+	req := httptest.NewRequest("GET", "/healthz", nil)
+
+	t.Run("idle", func(t *testing.T) {
+		d := newDetector()
+		now = now.Add(time.Hour)
+		assert.NoError(t, d.Check(req))
+	})
+
+	t.Run("stuck reconciliation", func(t *testing.T) {
+		d := newDetector()
+		d.started()
+		now = now.Add(time.Minute)
+		assert.NoError(t, d.Check(req))
+		now = now.Add(time.Second)
+		err := d.Check(req)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "1 in progress and none completed for 1m1s, the last one completed never")
+
+		// The controller recovers once the reconciliation completes.
+		d.completed()
+		assert.NoError(t, d.Check(req))
+		now = now.Add(time.Hour)
+		assert.NoError(t, d.Check(req))
+	})
+
+	t.Run("reconciliations completing", func(t *testing.T) {
+		d := newDetector()
+		for i := 0; i < 5; i++ {
+			d.started()
+			now = now.Add(30 * time.Second)
+			d.completed()
+			assert.NoError(t, d.Check(req))
+		}
+	})
+
+	t.Run("concurrent reconciliations", func(t *testing.T) {
+		d := newDetector()
+		d.started()
+		now = now.Add(50 * time.Second)
+		d.started()
+		d.completed()
+		// The other one is given the whole threshold again.
+		now = now.Add(50 * time.Second)
+		assert.NoError(t, d.Check(req))
+		now = now.Add(11 * time.Second)
+		err := d.Check(req)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "1 in progress")
+		assert.Contains(t, err.Error(), now.Add(-61*time.Second).Format(time.RFC3339))
+	})
+
+	t.Run("wrapped reconciler", func(t *testing.T) {
+		d := newDetector()
+		errFailed := errors.New("failed")
+		_, err := d.Wrap(reconcile.Func(func(_ context.Context, _ reconcile.Request) (reconcile.Result, error) {
+			assert.Equal(t, 1, d.inFlight)
+			now = now.Add(2 * time.Minute)
+			assert.Error(t, d.Check(req))
+			return reconcile.Result{}, errFailed
+		})).Reconcile(context.TODO(), reconcile.Request{})
+		assert.Equal(t, errFailed, err)
+		// A failed reconciliation completed, it is not a stall.
+		assert.Equal(t, 0, d.inFlight)
+		assert.NoError(t, d.Check(req))
+	})
+
+	t.Run("default threshold", func(t *testing.T) {
+		assert.Equal(t, DefaultReconcileStallThreshold, NewStallDetector(0).threshold)
+	})
+}
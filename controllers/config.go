@@ -1,24 +1,115 @@
 package controllers
 
 import (
+	"errors"
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
 	"strings"
+	"sync"
+	"time"
 
+	"github.com/Masterminds/semver/v3"
 	"github.com/fsnotify/fsnotify"
+	"github.com/mitchellh/mapstructure"
 	"github.com/spf13/viper"
+	corev1 "k8s.io/api/core/v1"
+	apiresource "k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/validation"
 )
 
+// configReloadDebounce is the period within which config file change events are coalesced into one reload
+const configReloadDebounce = 500 * time.Millisecond
+
+// envVarRegex matches the environment variables in the configuration file, "${VAR}" or "${VAR:-default}",
+// and the escaped "$$".
+var envVarRegex = regexp.MustCompile(`\$\$|\$\{([A-Za-z_][A-Za-z0-9_]*)(:-([^}]*))?\}`)
+
 type GlobalConfig struct {
 	EventBus *EventBusConfig `json:"eventBus"`
+	// Profiles are the named EventBus configurations the EventBuses can select with their
+	// config profile, instead of the default one above, e.g. "dev", "staging" and "prod".
+	Profiles map[string]*EventBusConfig `json:"profiles"`
+
+	// lock guards the config, which is swapped on reload by a separate goroutine
+	lock           sync.RWMutex
+	reloadHandlers []func(*GlobalConfig)
 }
 
 type EventBusConfig struct {
 	NATS      *NatsStreamingConfig `json:"nats"`
 	JetStream *JetStreamConfig     `json:"jetstream"`
+	// ImageRegistry, if specified, is the registry the EventBus images are pulled from instead of
+	// the ones in their names, e.g. a mirror "registry.example.com/mirror".
+	ImageRegistry string `json:"imageRegistry"`
+	// ImagePullSecrets are added to the pods of the EventBuses, e.g. to pull the images from the mirror.
+	ImagePullSecrets []corev1.LocalObjectReference `json:"imagePullSecrets"`
+	// TLS, if specified, enables mutual TLS between the NATS and JetStream EventBuses and their clients.
+	TLS *EventBusTLSConfig `json:"tls"`
+	// Persistence holds the defaults of the volumes of the EventBuses with persistence.
+	Persistence *EventBusPersistenceConfig `json:"persistence"`
+	// Resources holds the default resource requirements of the containers of the EventBuses.
+	Resources *EventBusResourcesConfig `json:"resources"`
+	// AdoptAnnotation is the annotation a pre-existing StatefulSet carries, with the name of an EventBus as
+	// value, for the EventBus to take it over. Defaults to "eventbus.argoproj.io/adopt".
+	AdoptAnnotation string `json:"adoptAnnotation"`
+}
+
+// DefaultAdoptAnnotation is the default annotation of the StatefulSets the EventBuses can adopt
+const DefaultAdoptAnnotation = "eventbus.argoproj.io/adopt"
+
+// EventBusResourcesConfig holds the default resource requirements of the containers of the native
+// NATS and JetStream EventBuses, used for the resources their container templates don't specify.
+type EventBusResourcesConfig struct {
+	// Main is the default of the NATS streaming and JetStream server containers.
+	Main *ContainerResourcesConfig `json:"main"`
+	// Metrics is the default of the metrics exporter containers.
+	Metrics *ContainerResourcesConfig `json:"metrics"`
+}
+
+// ContainerResourcesConfig holds the requests and the limits of a container, keyed by the resource
+// names, e.g. "cpu" and "memory", with the quantities as strings, e.g. "100m" and "256Mi".
+type ContainerResourcesConfig struct {
+	Requests map[string]string `json:"requests"`
+	Limits   map[string]string `json:"limits"`
+}
+
+// EventBusPersistenceConfig holds the defaults of the volumes of the EventBuses, used for the
+// fields the persistence of an EventBus doesn't specify.
+type EventBusPersistenceConfig struct {
+	// StorageClassName is the default storage class of the volumes.
+	StorageClassName string `json:"storageClassName"`
+	// Size is the default size of the volumes, e.g. "50Gi".
+	Size string `json:"size"`
+}
+
+// EventBusTLSConfig holds the certificates the NATS and JetStream EventBuses and their clients
+// authenticate each other with. The secrets are looked up in the namespace of each EventBus.
+type EventBusTLSConfig struct {
+	// CACertSecret is the CA certificate the servers and the clients verify each other with,
+	// the system roots are used if not specified.
+	CACertSecret *corev1.SecretKeySelector `json:"caCertSecret"`
+	// CertSecret is the certificate presented by both the servers and the clients.
+	CertSecret *corev1.SecretKeySelector `json:"certSecret"`
+	// KeySecret is the private key of the certificate.
+	KeySecret *corev1.SecretKeySelector `json:"keySecret"`
+	// InsecureSkipVerify skips the verification of the server certificates by the clients.
+	InsecureSkipVerify bool `json:"insecureSkipVerify"`
+	// MinVersion is the minimum TLS version, "1.2" or "1.3". Defaults to the one of the servers and the clients.
+	MinVersion string `json:"minVersion"`
 }
 
 type NatsStreamingConfig struct {
-	Versions []NatsStreamingVersion `json:"versions"`
+	// Disabled disables the NATS streaming EventBuses, native and exotic, e.g. to only allow JetStream ones.
+	Disabled bool `json:"disabled"`
+	// DisabledVersions are the versions which can't be installed, exact versions or semver ranges
+	// of version families, e.g. "0.22.x".
+	DisabledVersions []string               `json:"disabledVersions"`
+	Versions         []NatsStreamingVersion `json:"versions"`
 }
 
 type NatsStreamingVersion struct {
@@ -28,8 +119,11 @@ type NatsStreamingVersion struct {
 }
 
 type JetStreamConfig struct {
-	Settings string             `json:"settings"`
-	Versions []JetStreamVersion `json:"versions"`
+	Settings string `json:"settings"`
+	// DisabledVersions are the versions which can't be installed, exact versions or semver ranges
+	// of version families, e.g. "<2.9".
+	DisabledVersions []string           `json:"disabledVersions"`
+	Versions         []JetStreamVersion `json:"versions"`
 }
 
 type JetStreamVersion struct {
@@ -40,78 +134,859 @@ type JetStreamVersion struct {
 	StartCommand         string `json:"startCommand"`
 }
 
-func (g *GlobalConfig) supportedNatsStreamingVersions() []string {
+// validateImages returns an error naming the first image of the version which is not configured,
+// for the EventBus to fail to reconcile rather than its pods to fail to start.
+func (v *NatsStreamingVersion) validateImages() error {
+	switch {
+	case v.NatsStreamingImage == "":
+		return missingImageError("nats", v.Version, "natsStreamingImage")
+	case v.MetricsExporterImage == "":
+		return missingImageError("nats", v.Version, "metricsExporterImage")
+	}
+	return nil
+}
+
+// validateImages returns an error naming the first image of the version which is not configured,
+// for the EventBus to fail to reconcile rather than its pods to fail to start. The metrics exporter
+// image is only required if the metrics are enabled.
+func (v *JetStreamVersion) validateImages(metricsEnabled bool) error {
+	switch {
+	case v.NatsImage == "":
+		return missingImageError("jetstream", v.Version, "natsImage")
+	case v.ConfigReloaderImage == "":
+		return missingImageError("jetstream", v.Version, "configReloaderImage")
+	case metricsEnabled && v.MetricsExporterImage == "":
+		return missingImageError("jetstream", v.Version, "metricsExporterImage")
+	}
+	return nil
+}
+
+func missingImageError(bus, version, field string) error {
+	reason := VersionReasonNatsStreamingImageMissing
+	if bus == "jetstream" {
+		reason = VersionReasonJetStreamImageMissing
+	}
+	return &VersionError{Reason: reason, Err: fmt.Errorf("\"eventBus.%s.versions[].%s\" of version %q is not configured", bus, field, version)}
+}
+
+// The reasons of the version errors, the EventBuses failing to deploy are marked with them.
+const (
+	VersionReasonNatsStreamingDisabled           = "NatsStreamingDisabled"
+	VersionReasonNatsStreamingVersionDisabled    = "NatsStreamingVersionDisabled"
+	VersionReasonNatsStreamingVersionNotFound    = "NatsStreamingVersionNotFound"
+	VersionReasonNatsStreamingVersionUnsupported = "NatsStreamingVersionUnsupported"
+	VersionReasonNatsStreamingImageMissing       = "NatsStreamingImageMissing"
+	VersionReasonJetStreamVersionDisabled        = "JetStreamVersionDisabled"
+	VersionReasonJetStreamVersionNotFound        = "JetStreamVersionNotFound"
+	VersionReasonJetStreamVersionUnsupported     = "JetStreamVersionUnsupported"
+	VersionReasonJetStreamImageMissing           = "JetStreamImageMissing"
+)
+
+// VersionError is an error resolving the version of an EventBus from the configuration
+type VersionError struct {
+	// Reason is a short, machine understandable reason of the error, e.g. "JetStreamVersionUnsupported"
+	Reason string
+	Err    error
+}
+
+func (e *VersionError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *VersionError) Unwrap() error {
+	return e.Err
+}
+
+// VersionErrorReason returns the reason of the version error wrapped by the error, if any.
+func VersionErrorReason(err error) (string, bool) {
+	var versionErr *VersionError
+	if errors.As(err, &versionErr) {
+		return versionErr.Reason, true
+	}
+	return "", false
+}
+
+// Validate checks the EventBus configuration
+func (eb *EventBusConfig) Validate() error {
+	if eb == nil {
+		return nil
+	}
+	if eb.TLS != nil {
+		if err := eb.TLS.validate(); err != nil {
+			return fmt.Errorf("invalid \"eventBus.tls\", %w", err)
+		}
+	}
+	if eb.Persistence != nil {
+		if err := eb.Persistence.validate(); err != nil {
+			return fmt.Errorf("invalid \"eventBus.persistence\", %w", err)
+		}
+	}
+	if eb.Resources != nil {
+		if err := eb.Resources.Main.validate(); err != nil {
+			return fmt.Errorf("invalid \"eventBus.resources.main\", %w", err)
+		}
+		if err := eb.Resources.Metrics.validate(); err != nil {
+			return fmt.Errorf("invalid \"eventBus.resources.metrics\", %w", err)
+		}
+	}
+	if eb.AdoptAnnotation != "" {
+		if errs := validation.IsQualifiedName(eb.AdoptAnnotation); len(errs) > 0 {
+			return fmt.Errorf("invalid \"eventBus.adoptAnnotation\", %s", strings.Join(errs, ", "))
+		}
+	}
+	if eb.NATS != nil {
+		if err := validateDisabledVersions(eb.NATS.DisabledVersions); err != nil {
+			return fmt.Errorf("invalid \"eventBus.nats.disabledVersions\", %w", err)
+		}
+	}
+	if eb.JetStream != nil {
+		if err := validateDisabledVersions(eb.JetStream.DisabledVersions); err != nil {
+			return fmt.Errorf("invalid \"eventBus.jetstream.disabledVersions\", %w", err)
+		}
+	}
+	return nil
+}
+
+func validateDisabledVersions(versions []string) error {
+	for _, v := range versions {
+		if !IsVersionConstraint(v) {
+			continue
+		}
+		if _, err := semver.NewConstraint(v); err != nil {
+			return fmt.Errorf("invalid version range %q, %w", v, err)
+		}
+	}
+	return nil
+}
+
+// ValidateVersions returns the problems of the NATS streaming and JetStream versions of the configuration
+// and of its profiles, which otherwise only fail the EventBuses using them once deployed: the versions
+// missing an image, the versions which are not valid semver and the duplicated ones.
+func (g *GlobalConfig) ValidateVersions() []error {
+	g.lock.RLock()
+	defer g.lock.RUnlock()
+	result := g.EventBus.validateVersions("eventBus")
+	for _, name := range profileNames(g.Profiles) {
+		result = append(result, g.Profiles[name].validateVersions("profiles."+name)...)
+	}
+	return result
+}
+
+func (eb *EventBusConfig) validateVersions(path string) []error {
+	if eb == nil {
+		return nil
+	}
+	var result []error
+	if eb.NATS != nil {
+		seen := make(map[string]bool)
+		for i, v := range eb.NATS.Versions {
+			field := fmt.Sprintf("%s.nats.versions[%d]", path, i)
+			result = append(result, validateVersion(field, v.Version, seen)...)
+			result = append(result, missingImages(field, v.Version, map[string]string{
+				"natsStreamingImage":   v.NatsStreamingImage,
+				"metricsExporterImage": v.MetricsExporterImage,
+			})...)
+		}
+	}
+	if eb.JetStream != nil {
+		seen := make(map[string]bool)
+		for i, v := range eb.JetStream.Versions {
+			field := fmt.Sprintf("%s.jetstream.versions[%d]", path, i)
+			result = append(result, validateVersion(field, v.Version, seen)...)
+			// The metrics exporter image is required by the EventBuses with metrics, the default.
+			result = append(result, missingImages(field, v.Version, map[string]string{
+				"natsImage":            v.NatsImage,
+				"configReloaderImage":  v.ConfigReloaderImage,
+				"metricsExporterImage": v.MetricsExporterImage,
+			})...)
+		}
+	}
+	return result
+}
+
+// validateVersion returns the problems of the version of a versions entry, the versions already seen
+// in the same list are duplicated.
+func validateVersion(field, version string, seen map[string]bool) []error {
+	if version == "" {
+		return []error{fmt.Errorf("\"%s.version\" is not configured", field)}
+	}
+	var result []error
+	if seen[version] {
+		result = append(result, fmt.Errorf("version %q of %q is duplicated", version, field))
+	}
+	seen[version] = true
+	if _, err := semver.NewVersion(version); err != nil {
+		result = append(result, fmt.Errorf("version %q of %q is not valid semver, %w", version, field, err))
+	}
+	return result
+}
+
+// missingImages returns a problem for each image of the versions entry which is not configured.
+func missingImages(field, version string, images map[string]string) []error {
+	names := make([]string, 0, len(images))
+	for name := range images {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var result []error
+	for _, name := range names {
+		if images[name] == "" {
+			result = append(result, fmt.Errorf("\"%s.%s\" of version %q is not configured", field, name, version))
+		}
+	}
+	return result
+}
+
+// disabledBy returns the entry of the disabled versions matching the version, if any. The entries are
+// either exact versions or semver ranges, which don't match the versions which are not valid semver.
+func disabledBy(version string, disabled []string) (string, bool) {
+	for _, d := range disabled {
+		if !IsVersionConstraint(d) {
+			if d == version {
+				return d, true
+			}
+			continue
+		}
+		v, err := semver.NewVersion(version)
+		if err != nil {
+			continue
+		}
+		// The ranges are validated when the configuration is loaded.
+		if c, err := semver.NewConstraint(d); err == nil && c.Check(v) {
+			return d, true
+		}
+	}
+	return "", false
+}
+
+func (p *EventBusPersistenceConfig) validate() error {
+	if p.Size == "" {
+		return nil
+	}
+	size, err := apiresource.ParseQuantity(p.Size)
+	if err != nil {
+		return fmt.Errorf("invalid size %q, %w", p.Size, err)
+	}
+	if size.Sign() <= 0 {
+		return fmt.Errorf("size %q must be positive", p.Size)
+	}
+	return nil
+}
+
+func (r *ContainerResourcesConfig) validate() error {
+	if r == nil {
+		return nil
+	}
+	if _, err := parseResourceList(r.Requests); err != nil {
+		return fmt.Errorf("invalid requests, %w", err)
+	}
+	if _, err := parseResourceList(r.Limits); err != nil {
+		return fmt.Errorf("invalid limits, %w", err)
+	}
+	return nil
+}
+
+func parseResourceList(resources map[string]string) (corev1.ResourceList, error) {
+	result := corev1.ResourceList{}
+	for name, value := range resources {
+		q, err := apiresource.ParseQuantity(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid quantity %q of %q, %w", value, name, err)
+		}
+		if q.Sign() < 0 {
+			return nil, fmt.Errorf("quantity %q of %q must not be negative", value, name)
+		}
+		result[corev1.ResourceName(name)] = q
+	}
+	return result, nil
+}
+
+func (t *EventBusTLSConfig) validate() error {
+	if !isSecretKeySelectorSet(t.CertSecret) || !isSecretKeySelectorSet(t.KeySecret) {
+		return fmt.Errorf("both \"certSecret\" and \"keySecret\" are required")
+	}
+	if t.CACertSecret != nil && !isSecretKeySelectorSet(t.CACertSecret) {
+		return fmt.Errorf("\"caCertSecret\" requires a name and a key")
+	}
+	switch t.MinVersion {
+	case "", "1.2", "1.3":
+	default:
+		return fmt.Errorf("unsupported min version %q, it must be \"1.2\" or \"1.3\"", t.MinVersion)
+	}
+	return nil
+}
+
+func isSecretKeySelectorSet(s *corev1.SecretKeySelector) bool {
+	return s != nil && s.Name != "" && s.Key != ""
+}
+
+// GetEventBusConfig returns the current EventBus configuration. A reload
+// replaces the whole object rather than mutating it, so the result must be
+// treated as read only.
+func (g *GlobalConfig) GetEventBusConfig() *EventBusConfig {
+	g.lock.RLock()
+	defer g.lock.RUnlock()
+	return g.EventBus
+}
+
+// GetProfile returns the configuration of the named profile, the configuration itself if the name is
+// empty. The profile names are case insensitive, as the keys of the configuration file. The returned
+// configuration of a profile is not reloaded, it must be looked up again after a reload.
+func (g *GlobalConfig) GetProfile(name string) (*GlobalConfig, error) {
+	if name == "" {
+		return g, nil
+	}
+	g.lock.RLock()
+	defer g.lock.RUnlock()
+	eb, ok := g.Profiles[strings.ToLower(name)]
+	if !ok {
+		return nil, fmt.Errorf("config profile %q not found, available profiles: %q", name, strings.Join(profileNames(g.Profiles), ","))
+	}
+	return &GlobalConfig{EventBus: eb}, nil
+}
+
+func profileNames(profiles map[string]*EventBusConfig) []string {
+	result := make([]string, 0, len(profiles))
+	for name := range profiles {
+		result = append(result, name)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// GetImage returns the name of the image in the image registry if any, keeping its repository
+// path and its tag or digest, e.g. "nats:2.8.1" is pulled as "registry.example.com/mirror/nats:2.8.1".
+func (eb *EventBusConfig) GetImage(image string) string {
+	if eb == nil || eb.ImageRegistry == "" || image == "" {
+		return image
+	}
+	return strings.TrimSuffix(eb.ImageRegistry, "/") + "/" + trimImageRegistry(image)
+}
+
+// GetImagePullSecrets returns the image pull secrets of an EventBus, followed by the configured
+// ones it doesn't already have.
+func (eb *EventBusConfig) GetImagePullSecrets(secrets []corev1.LocalObjectReference) []corev1.LocalObjectReference {
+	if eb == nil || len(eb.ImagePullSecrets) == 0 {
+		return secrets
+	}
+	result := append([]corev1.LocalObjectReference{}, secrets...)
+	for _, s := range eb.ImagePullSecrets {
+		found := false
+		for _, r := range result {
+			if r.Name == s.Name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			result = append(result, s)
+		}
+	}
+	return result
+}
+
+// GetTLS returns the TLS configuration of the EventBuses if any
+func (eb *EventBusConfig) GetTLS() *EventBusTLSConfig {
+	if eb == nil {
+		return nil
+	}
+	return eb.TLS
+}
+
+// GetAdoptAnnotation returns the annotation of the StatefulSets the EventBuses can adopt
+func (eb *EventBusConfig) GetAdoptAnnotation() string {
+	if eb == nil || eb.AdoptAnnotation == "" {
+		return DefaultAdoptAnnotation
+	}
+	return eb.AdoptAnnotation
+}
+
+// GetStorageClassName returns the storage class of the volumes of an EventBus, the default one
+// if the EventBus doesn't specify one.
+func (eb *EventBusConfig) GetStorageClassName(storageClassName *string) *string {
+	if storageClassName != nil || eb == nil || eb.Persistence == nil || eb.Persistence.StorageClassName == "" {
+		return storageClassName
+	}
+	result := eb.Persistence.StorageClassName
+	return &result
+}
+
+// GetVolumeSize returns the size of the volumes of an EventBus, the default one if the EventBus
+// doesn't specify one, and the given fallback if there is no default either.
+func (eb *EventBusConfig) GetVolumeSize(size *apiresource.Quantity, fallback string) apiresource.Quantity {
+	if size != nil {
+		return *size
+	}
+	if eb != nil && eb.Persistence != nil && eb.Persistence.Size != "" {
+		// The size is validated when the configuration is loaded.
+		if q, err := apiresource.ParseQuantity(eb.Persistence.Size); err == nil {
+			return q
+		}
+	}
+	return apiresource.MustParse(fallback)
+}
+
+// GetMainResources returns the resource requirements of the server container of an EventBus, with the
+// defaults for the resources it doesn't specify.
+func (eb *EventBusConfig) GetMainResources(resources corev1.ResourceRequirements) corev1.ResourceRequirements {
+	if eb == nil || eb.Resources == nil {
+		return resources
+	}
+	return mergeResources(resources, eb.Resources.Main)
+}
+
+// GetMetricsResources returns the resource requirements of the metrics exporter container of an EventBus,
+// with the defaults for the resources it doesn't specify.
+func (eb *EventBusConfig) GetMetricsResources(resources corev1.ResourceRequirements) corev1.ResourceRequirements {
+	if eb == nil || eb.Resources == nil {
+		return resources
+	}
+	return mergeResources(resources, eb.Resources.Metrics)
+}
+
+// mergeResources adds the default requests and limits of the resources the requirements don't specify. A
+// default request is not added for a resource with a limit, which Kubernetes defaults the request to, and
+// a default limit is not added for a resource requested above it, for the requirements to remain valid.
+func mergeResources(resources corev1.ResourceRequirements, defaults *ContainerResourcesConfig) corev1.ResourceRequirements {
+	if defaults == nil {
+		return resources
+	}
+	// The quantities are validated when the configuration is loaded.
+	defaultRequests, _ := parseResourceList(defaults.Requests)
+	defaultLimits, _ := parseResourceList(defaults.Limits)
+	result := *resources.DeepCopy()
+	for name, q := range defaultRequests {
+		if _, ok := result.Requests[name]; ok {
+			continue
+		}
+		if _, ok := result.Limits[name]; ok {
+			continue
+		}
+		if result.Requests == nil {
+			result.Requests = corev1.ResourceList{}
+		}
+		result.Requests[name] = q
+	}
+	for name, q := range defaultLimits {
+		if _, ok := result.Limits[name]; ok {
+			continue
+		}
+		if request, ok := result.Requests[name]; ok && request.Cmp(q) > 0 {
+			continue
+		}
+		if result.Limits == nil {
+			result.Limits = corev1.ResourceList{}
+		}
+		result.Limits[name] = q
+	}
+	return result
+}
+
+// trimImageRegistry removes the registry from the name of the image. As in the Docker image
+// references, the first component of the name is a registry if it contains a "." or a ":"
+// (a port), or if it is "localhost".
+func trimImageRegistry(image string) string {
+	i := strings.Index(image, "/")
+	if i < 0 {
+		return image
+	}
+	if first := image[:i]; strings.ContainsAny(first, ".:") || first == "localhost" {
+		return image[i+1:]
+	}
+	return image
+}
+
+// supportedNatsStreamingVersions returns the configured NATS streaming versions which are not disabled
+func supportedNatsStreamingVersions(eb *EventBusConfig) []string {
 	result := []string{}
-	if g.EventBus == nil || g.EventBus.NATS == nil {
+	if eb == nil || eb.NATS == nil || eb.NATS.Disabled {
 		return result
 	}
-	for _, v := range g.EventBus.NATS.Versions {
-		result = append(result, v.Version)
+	for _, v := range eb.NATS.Versions {
+		if _, disabled := disabledBy(v.Version, eb.NATS.DisabledVersions); !disabled {
+			result = append(result, v.Version)
+		}
 	}
 	return result
 }
 
-func (g *GlobalConfig) supportedJetStreamVersions() []string {
+// supportedJetStreamVersions returns the configured JetStream versions which are not disabled
+func supportedJetStreamVersions(eb *EventBusConfig) []string {
 	result := []string{}
-	if g.EventBus == nil || g.EventBus.JetStream == nil {
+	if eb == nil || eb.JetStream == nil {
 		return result
 	}
-	for _, v := range g.EventBus.JetStream.Versions {
-		result = append(result, v.Version)
+	for _, v := range eb.JetStream.Versions {
+		if _, disabled := disabledBy(v.Version, eb.JetStream.DisabledVersions); !disabled {
+			result = append(result, v.Version)
+		}
 	}
 	return result
 }
 
+// SupportedNatsStreamingVersions returns the configured NATS streaming versions
+func (g *GlobalConfig) SupportedNatsStreamingVersions() []string {
+	return supportedNatsStreamingVersions(g.GetEventBusConfig())
+}
+
+// SupportedJetStreamVersions returns the configured JetStream versions
+func (g *GlobalConfig) SupportedJetStreamVersions() []string {
+	return supportedJetStreamVersions(g.GetEventBusConfig())
+}
+
+// CheckNatsStreamingAllowed returns an error if the NATS streaming EventBuses, or the version of
+// NATS streaming if any, are disabled by the configuration.
+func (g *GlobalConfig) CheckNatsStreamingAllowed(version string) error {
+	eb := g.GetEventBusConfig()
+	if eb == nil || eb.NATS == nil {
+		return nil
+	}
+	if eb.NATS.Disabled {
+		return &VersionError{Reason: VersionReasonNatsStreamingDisabled, Err: fmt.Errorf("nats streaming eventbuses are disabled by \"eventBus.nats.disabled\" in the controller configuration, use a jetstream eventbus instead")}
+	}
+	if d, disabled := disabledBy(version, eb.NATS.DisabledVersions); version != "" && disabled {
+		return &VersionError{Reason: VersionReasonNatsStreamingVersionDisabled, Err: fmt.Errorf("nats streaming version %q is disabled by %q of \"eventBus.nats.disabledVersions\" in the controller configuration, use a jetstream eventbus instead", version, d)}
+	}
+	return nil
+}
+
+// CheckJetStreamAllowed returns an error if the JetStream version is disabled by the configuration.
+func (g *GlobalConfig) CheckJetStreamAllowed(version string) error {
+	eb := g.GetEventBusConfig()
+	if eb == nil || eb.JetStream == nil {
+		return nil
+	}
+	if d, disabled := disabledBy(version, eb.JetStream.DisabledVersions); disabled {
+		return &VersionError{Reason: VersionReasonJetStreamVersionDisabled, Err: fmt.Errorf("jetstream version %q is disabled by %q of \"eventBus.jetstream.disabledVersions\" in the controller configuration, supported versions: %q", version, d, strings.Join(supportedJetStreamVersions(eb), ","))}
+	}
+	return nil
+}
+
 func (g *GlobalConfig) GetNatsStreamingVersion(version string) (*NatsStreamingVersion, error) {
-	if g.EventBus == nil || g.EventBus.NATS == nil {
-		return nil, fmt.Errorf("\"eventBus.nats\" not found in the configuration")
+	if err := g.CheckNatsStreamingAllowed(version); err != nil {
+		return nil, err
 	}
-	if len(g.EventBus.NATS.Versions) == 0 {
-		return nil, fmt.Errorf("nats streaming version configuration not found")
+	eb := g.GetEventBusConfig()
+	if eb == nil || eb.NATS == nil {
+		return nil, &VersionError{Reason: VersionReasonNatsStreamingVersionNotFound, Err: fmt.Errorf("\"eventBus.nats\" not found in the configuration")}
 	}
-	for _, r := range g.EventBus.NATS.Versions {
+	if len(eb.NATS.Versions) == 0 {
+		return nil, &VersionError{Reason: VersionReasonNatsStreamingVersionNotFound, Err: fmt.Errorf("nats streaming version configuration not found")}
+	}
+	for _, r := range eb.NATS.Versions {
 		if r.Version == version {
+			if err := r.validateImages(); err != nil {
+				return nil, err
+			}
 			return &r, nil
 		}
 	}
-	return nil, fmt.Errorf("unsupported version %q, supported versions: %q", version, strings.Join(g.supportedNatsStreamingVersions(), ","))
+	return nil, &VersionError{Reason: VersionReasonNatsStreamingVersionUnsupported, Err: unsupportedVersionError(version, supportedNatsStreamingVersions(eb))}
 }
 
-func (g *GlobalConfig) GetJetStreamVersion(version string) (*JetStreamVersion, error) {
-	if g.EventBus == nil || g.EventBus.JetStream == nil {
-		return nil, fmt.Errorf("\"eventBus.jetstream\" not found in the configuration")
+// GetJetStreamVersion returns the configuration of a JetStream version, with the metrics exporter image
+// required if the metrics of the EventBus are enabled.
+func (g *GlobalConfig) GetJetStreamVersion(version string, metricsEnabled bool) (*JetStreamVersion, error) {
+	if err := g.CheckJetStreamAllowed(version); err != nil {
+		return nil, err
+	}
+	eb := g.GetEventBusConfig()
+	if eb == nil || eb.JetStream == nil {
+		return nil, &VersionError{Reason: VersionReasonJetStreamVersionNotFound, Err: fmt.Errorf("\"eventBus.jetstream\" not found in the configuration")}
 	}
-	if len(g.EventBus.JetStream.Versions) == 0 {
-		return nil, fmt.Errorf("jetstream version configuration not found")
+	if len(eb.JetStream.Versions) == 0 {
+		return nil, &VersionError{Reason: VersionReasonJetStreamVersionNotFound, Err: fmt.Errorf("jetstream version configuration not found")}
 	}
-	for _, r := range g.EventBus.JetStream.Versions {
+	for _, r := range eb.JetStream.Versions {
 		if r.Version == version {
+			if err := r.validateImages(metricsEnabled); err != nil {
+				return nil, err
+			}
 			return &r, nil
 		}
 	}
-	return nil, fmt.Errorf("unsupported version %q, supported versions: %q", version, strings.Join(g.supportedJetStreamVersions(), ","))
+	return nil, &VersionError{Reason: VersionReasonJetStreamVersionUnsupported, Err: unsupportedVersionError(version, supportedJetStreamVersions(eb))}
+}
+
+// ResolveJetStreamVersion returns the highest configured JetStream version
+// satisfying the semver constraint, e.g. ">=2.9.0 <2.10.0".
+// Configured versions which are not valid semver (e.g. "latest"), or which are disabled, are skipped.
+func (g *GlobalConfig) ResolveJetStreamVersion(constraint string, metricsEnabled bool) (*JetStreamVersion, error) {
+	eb := g.GetEventBusConfig()
+	if eb == nil || eb.JetStream == nil {
+		return nil, &VersionError{Reason: VersionReasonJetStreamVersionNotFound, Err: fmt.Errorf("\"eventBus.jetstream\" not found in the configuration")}
+	}
+	if len(eb.JetStream.Versions) == 0 {
+		return nil, &VersionError{Reason: VersionReasonJetStreamVersionNotFound, Err: fmt.Errorf("jetstream version configuration not found")}
+	}
+	c, err := semver.NewConstraint(constraint)
+	if err != nil {
+		return nil, &VersionError{Reason: VersionReasonJetStreamVersionUnsupported, Err: fmt.Errorf("invalid version constraint %q, %w", constraint, err)}
+	}
+	var result *JetStreamVersion
+	var highest *semver.Version
+	for i, r := range eb.JetStream.Versions {
+		v, err := semver.NewVersion(r.Version)
+		if err != nil {
+			continue
+		}
+		if _, disabled := disabledBy(r.Version, eb.JetStream.DisabledVersions); disabled {
+			continue
+		}
+		if c.Check(v) && (highest == nil || v.GreaterThan(highest)) {
+			highest = v
+			result = &eb.JetStream.Versions[i]
+		}
+	}
+	if result == nil {
+		return nil, &VersionError{Reason: VersionReasonJetStreamVersionUnsupported, Err: fmt.Errorf("no version satisfies constraint %q, supported versions: %q", constraint, strings.Join(supportedJetStreamVersions(eb), ","))}
+	}
+	r := *result
+	if err := r.validateImages(metricsEnabled); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// IsVersionConstraint tells if the version looks like a semver range rather than an exact version.
+func IsVersionConstraint(version string) bool {
+	return strings.ContainsAny(version, "<>=~^*, |") || strings.Contains(strings.ToLower(version), ".x")
+}
+
+// unsupportedVersionError returns an error listing the supported versions,
+// with a suggestion of the closest one to what was asked for.
+func unsupportedVersionError(version string, supported []string) error {
+	if len(supported) == 0 {
+		return fmt.Errorf("unsupported version %q, supported versions: %q", version, strings.Join(supported, ","))
+	}
+	return fmt.Errorf("unsupported version %q, supported versions: %q, did you mean %q?", version, strings.Join(supported, ","), closestVersion(version, supported))
+}
+
+// closestVersion returns the candidate with the smallest edit distance to the version.
+func closestVersion(version string, candidates []string) string {
+	result := ""
+	minDistance := -1
+	for _, c := range candidates {
+		if d := levenshtein(version, c); minDistance < 0 || d < minDistance {
+			minDistance = d
+			result = c
+		}
+	}
+	return result
+}
+
+func levenshtein(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = prev[j] + 1
+			if curr[j-1]+1 < curr[j] {
+				curr[j] = curr[j-1] + 1
+			}
+			if prev[j-1]+cost < curr[j] {
+				curr[j] = prev[j-1] + cost
+			}
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
+
+// OnReload registers a handler which is called after the configuration file
+// is reloaded with changes.
+func (g *GlobalConfig) OnReload(handler func(*GlobalConfig)) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	g.reloadHandlers = append(g.reloadHandlers, handler)
+}
+
+// reload unmarshals the configuration and notifies the reload handlers if it has changed.
+func (g *GlobalConfig) reload(v *viper.Viper) error {
+	newConfig := &GlobalConfig{}
+	if err := unmarshal(v, newConfig); err != nil {
+		return err
+	}
+	// Swap in the freshly built config instead of unmarshalling in place,
+	// so readers holding the previous one never see a partial update.
+	g.lock.Lock()
+	if reflect.DeepEqual(g.EventBus, newConfig.EventBus) && reflect.DeepEqual(g.Profiles, newConfig.Profiles) {
+		g.lock.Unlock()
+		return nil
+	}
+	g.EventBus = newConfig.EventBus
+	g.Profiles = newConfig.Profiles
+	handlers := append([]func(*GlobalConfig){}, g.reloadHandlers...)
+	g.lock.Unlock()
+	for _, h := range handlers {
+		h(g)
+	}
+	return nil
+}
+
+// unmarshal decodes the configuration, with the embedded structs of the Kubernetes types
+// squashed as they are inlined in their JSON, and validates it.
+func unmarshal(v *viper.Viper, g *GlobalConfig) error {
+	if err := v.Unmarshal(g, func(c *mapstructure.DecoderConfig) { c.Squash = true }); err != nil {
+		return err
+	}
+	if err := g.EventBus.Validate(); err != nil {
+		return err
+	}
+	for _, name := range profileNames(g.Profiles) {
+		if err := g.Profiles[name].Validate(); err != nil {
+			return fmt.Errorf("invalid profile %q, %w", name, err)
+		}
+	}
+	return nil
+}
+
+// expandEnv replaces the environment variables in the configuration, "${VAR}" by the value of VAR and
+// "${VAR:-default}" by the default if VAR is unset or empty. A variable without default which is not
+// set is an error. "$$" is a literal "$", e.g. "$${VAR}" is kept as "${VAR}", and a "$" followed by
+// anything else is kept as is.
+func expandEnv(config string) (string, error) {
+	var missing []string
+	result := envVarRegex.ReplaceAllStringFunc(config, func(match string) string {
+		if match == "$$" {
+			return "$"
+		}
+		groups := envVarRegex.FindStringSubmatch(match)
+		name, hasDefault, defaultValue := groups[1], groups[2] != "", groups[3]
+		value, ok := os.LookupEnv(name)
+		if hasDefault && value == "" {
+			return defaultValue
+		}
+		if !ok {
+			missing = append(missing, name)
+		}
+		return value
+	})
+	if len(missing) > 0 {
+		return "", fmt.Errorf("environment variables %q are not set", strings.Join(missing, ","))
+	}
+	return result, nil
+}
+
+// readConfig reads the configuration file found by the viper instance, with the environment
+// variables expanded.
+func readConfig(v *viper.Viper) error {
+	b, err := ioutil.ReadFile(v.ConfigFileUsed())
+	if err != nil {
+		return err
+	}
+	config, err := expandEnv(string(b))
+	if err != nil {
+		return err
+	}
+	return v.ReadConfig(strings.NewReader(config))
 }
 
-func LoadConfig(onErrorReloading func(error)) (*GlobalConfig, error) {
+// debouncer runs the latest function passed to trigger once no other call has been made within the period.
+type debouncer struct {
+	period time.Duration
+	lock   sync.Mutex
+	timer  *time.Timer
+}
+
+func (d *debouncer) trigger(f func()) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	if d.timer != nil {
+		d.timer.Stop()
+	}
+	d.timer = time.AfterFunc(d.period, f)
+}
+
+const (
+	// DefaultConfigPath is the directory of the configuration file of the controllers
+	DefaultConfigPath = "/etc/argo-events"
+	// DefaultConfigName is the name of the configuration file of the controllers, without its extension
+	DefaultConfigName = "controller-config"
+)
+
+// LoadConfig reads the configuration file of the name in the directory of the path, DefaultConfigName and
+// DefaultConfigPath if they are empty, and reloads it whenever it changes. The name is either the one of a
+// YAML file without its extension, or the full name of the file, e.g. "events.yml".
+func LoadConfig(path, name string, onErrorReloading func(error)) (*GlobalConfig, error) {
+	if path == "" {
+		path = DefaultConfigPath
+	}
+	if name == "" {
+		name = DefaultConfigName
+	}
 	v := viper.New()
-	v.SetConfigName("controller-config")
 	v.SetConfigType("yaml")
-	v.AddConfigPath("/etc/argo-events")
-	err := v.ReadInConfig()
-	if err != nil {
-		return nil, fmt.Errorf("failed to load configuration file. %w", err)
+	if ext := filepath.Ext(name); ext == ".yaml" || ext == ".yml" {
+		v.SetConfigFile(filepath.Join(path, name))
+	} else {
+		v.SetConfigName(name)
+		v.AddConfigPath(path)
 	}
-	r := &GlobalConfig{}
-	err = v.Unmarshal(r)
+	r, err := loadConfig(v)
 	if err != nil {
-		return nil, fmt.Errorf("failed unmarshal configuration file. %w", err)
+		return nil, err
 	}
+	// The watching viper instance is only used to get notified of the changes, it reads the file as is
+	// from its own goroutine. Each reload reads the file into a viper instance of its own instead.
+	file := v.ConfigFileUsed()
+	// reloadLock keeps the reloads in the order of the changes, the debounced ones may overlap.
+	var reloadLock sync.Mutex
+	// fsnotify usually fires several events for a single edit
+	d := &debouncer{period: configReloadDebounce}
 	v.WatchConfig()
 	v.OnConfigChange(func(e fsnotify.Event) {
-		err = v.Unmarshal(r)
-		if err != nil {
-			onErrorReloading(err)
-		}
+		d.trigger(func() {
+			reloadLock.Lock()
+			defer reloadLock.Unlock()
+			if err := r.reloadFile(file); err != nil {
+				onErrorReloading(err)
+			}
+		})
 	})
 	return r, nil
 }
+
+// reloadFile reads the configuration file into a new viper instance, expands the environment variables in
+// it rather than in the watching one, and swaps the configuration in if it has changed.
+func (g *GlobalConfig) reloadFile(path string) error {
+	v := viper.New()
+	v.SetConfigFile(path)
+	v.SetConfigType("yaml")
+	if err := readConfig(v); err != nil {
+		return fmt.Errorf("failed to expand the environment variables of the configuration file. %w", err)
+	}
+	return g.reload(v)
+}
+
+// ReadConfigFile reads the configuration file at the path as LoadConfig does, without watching it, e.g. to
+// validate it ahead of a rollout.
+func ReadConfigFile(path string) (*GlobalConfig, error) {
+	v := viper.New()
+	v.SetConfigFile(path)
+	v.SetConfigType("yaml")
+	return loadConfig(v)
+}
+
+// loadConfig reads, expands and validates the configuration file found by the viper instance.
+func loadConfig(v *viper.Viper) (*GlobalConfig, error) {
+	if err := v.ReadInConfig(); err != nil {
+		return nil, fmt.Errorf("failed to load configuration file. %w", err)
+	}
+	if err := readConfig(v); err != nil {
+		return nil, fmt.Errorf("failed to expand the environment variables of the configuration file. %w", err)
+	}
+	r := &GlobalConfig{}
+	if err := unmarshal(v, r); err != nil {
+		return nil, fmt.Errorf("failed unmarshal configuration file. %w", err)
+	}
+	return r, nil
+}
@@ -0,0 +1,806 @@
+package controllers
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	apiresource "k8s.io/apimachinery/pkg/api/resource"
+)
+
+var testConfig = &GlobalConfig{
+	EventBus: &EventBusConfig{
+		NATS: &NatsStreamingConfig{
+			Versions: []NatsStreamingVersion{
+				{Version: "0.22.1", NatsStreamingImage: "nats-streaming:0.22.1", MetricsExporterImage: "prometheus-nats-exporter:0.8.0"},
+			},
+		},
+		JetStream: &JetStreamConfig{
+			Versions: []JetStreamVersion{
+				{Version: "2.7.3", NatsImage: "nats:2.7.3", ConfigReloaderImage: "nats-server-config-reloader:0.6.3", MetricsExporterImage: "prometheus-nats-exporter:0.9.1"},
+				{Version: "2.8.1", NatsImage: "nats:2.8.1", ConfigReloaderImage: "nats-server-config-reloader:0.6.3", MetricsExporterImage: "prometheus-nats-exporter:0.9.1"},
+				{Version: "latest", NatsImage: "nats:latest", ConfigReloaderImage: "nats-server-config-reloader:0.6.3", MetricsExporterImage: "prometheus-nats-exporter:0.9.1"},
+			},
+		},
+	},
+}
+
+func TestGetJetStreamVersion(t *testing.T) {
+	t.Run("supported version", func(t *testing.T) {
+		v, err := testConfig.GetJetStreamVersion("2.8.1", true)
+		assert.NoError(t, err)
+		assert.Equal(t, "nats:2.8.1", v.NatsImage)
+	})
+
+	t.Run("unsupported version suggests the closest one", func(t *testing.T) {
+		_, err := testConfig.GetJetStreamVersion("2.8.11", true)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "did you mean \"2.8.1\"?")
+	})
+
+	t.Run("no versions configured", func(t *testing.T) {
+		c := &GlobalConfig{EventBus: &EventBusConfig{JetStream: &JetStreamConfig{}}}
+		_, err := c.GetJetStreamVersion("2.8.1", true)
+		assert.Error(t, err)
+		assert.NotContains(t, err.Error(), "did you mean")
+	})
+
+	t.Run("blank metrics exporter image", func(t *testing.T) {
+		c := &GlobalConfig{EventBus: &EventBusConfig{JetStream: &JetStreamConfig{
+			Versions: []JetStreamVersion{{Version: "2.8.1", NatsImage: "nats:2.8.1", ConfigReloaderImage: "nats-server-config-reloader:0.6.3"}},
+		}}}
+		_, err := c.GetJetStreamVersion("2.8.1", true)
+		assert.EqualError(t, err, `"eventBus.jetstream.versions[].metricsExporterImage" of version "2.8.1" is not configured`)
+
+		_, err = c.ResolveJetStreamVersion("~2.8", true)
+		assert.EqualError(t, err, `"eventBus.jetstream.versions[].metricsExporterImage" of version "2.8.1" is not configured`)
+
+		t.Run("metrics disabled", func(t *testing.T) {
+			v, err := c.GetJetStreamVersion("2.8.1", false)
+			assert.NoError(t, err)
+			assert.Equal(t, "nats:2.8.1", v.NatsImage)
+			_, err = c.ResolveJetStreamVersion("~2.8", false)
+			assert.NoError(t, err)
+		})
+	})
+}
+
+func TestGetNatsStreamingVersion(t *testing.T) {
+	v, err := testConfig.GetNatsStreamingVersion("0.22.1")
+	assert.NoError(t, err)
+	assert.Equal(t, "nats-streaming:0.22.1", v.NatsStreamingImage)
+
+	_, err = testConfig.GetNatsStreamingVersion("0.2.1")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "did you mean \"0.22.1\"?")
+
+	c := &GlobalConfig{EventBus: &EventBusConfig{NATS: &NatsStreamingConfig{
+		Versions: []NatsStreamingVersion{{Version: "0.22.1", NatsStreamingImage: "nats-streaming:0.22.1"}},
+	}}}
+	_, err = c.GetNatsStreamingVersion("0.22.1")
+	assert.EqualError(t, err, `"eventBus.nats.versions[].metricsExporterImage" of version "0.22.1" is not configured`)
+}
+
+func TestGetImage(t *testing.T) {
+	t.Run("no image registry", func(t *testing.T) {
+		var eb *EventBusConfig
+		assert.Equal(t, "nats:2.8.1", eb.GetImage("nats:2.8.1"))
+		eb = &EventBusConfig{}
+		assert.Equal(t, "nats:2.8.1", eb.GetImage("nats:2.8.1"))
+	})
+
+	t.Run("image registry", func(t *testing.T) {
+		eb := &EventBusConfig{ImageRegistry: "registry.example.com/mirror/"}
+		tests := map[string]string{
+			"nats:2.8.1":                              "registry.example.com/mirror/nats:2.8.1",
+			"nats":                                    "registry.example.com/mirror/nats",
+			"natsio/prometheus-nats-exporter:0.9.1":   "registry.example.com/mirror/natsio/prometheus-nats-exporter:0.9.1",
+			"docker.io/library/nats:2.8.1":            "registry.example.com/mirror/library/nats:2.8.1",
+			"localhost/nats:2.8.1":                    "registry.example.com/mirror/nats:2.8.1",
+			"registry.local:5000/nats:2.8.1":          "registry.example.com/mirror/nats:2.8.1",
+			"nats@sha256:5f1f1d07d4b8f1e0a3c3":        "registry.example.com/mirror/nats@sha256:5f1f1d07d4b8f1e0a3c3",
+			"quay.io/nats/nats:2.8.1@sha256:5f1f1d07": "registry.example.com/mirror/nats/nats:2.8.1@sha256:5f1f1d07",
+		}
+		for image, expected := range tests {
+			assert.Equal(t, expected, eb.GetImage(image), image)
+		}
+		assert.Equal(t, "", eb.GetImage(""))
+	})
+}
+
+func TestGetImagePullSecrets(t *testing.T) {
+	secrets := []corev1.LocalObjectReference{{Name: "eventbus-secret"}, {Name: "mirror-secret"}}
+	var eb *EventBusConfig
+	assert.Equal(t, secrets, eb.GetImagePullSecrets(secrets))
+	eb = &EventBusConfig{}
+	assert.Nil(t, eb.GetImagePullSecrets(nil))
+
+	eb = &EventBusConfig{ImagePullSecrets: []corev1.LocalObjectReference{{Name: "mirror-secret"}, {Name: "other-secret"}}}
+	assert.Equal(t, []corev1.LocalObjectReference{{Name: "eventbus-secret"}, {Name: "mirror-secret"}, {Name: "other-secret"}}, eb.GetImagePullSecrets(secrets))
+	assert.Len(t, secrets, 2)
+	assert.Equal(t, eb.ImagePullSecrets, eb.GetImagePullSecrets(nil))
+}
+
+func TestGetAdoptAnnotation(t *testing.T) {
+	var eb *EventBusConfig
+	assert.Equal(t, DefaultAdoptAnnotation, eb.GetAdoptAnnotation())
+	eb = &EventBusConfig{AdoptAnnotation: "example.com/adopt"}
+	assert.Equal(t, "example.com/adopt", eb.GetAdoptAnnotation())
+	assert.NoError(t, eb.Validate())
+
+	eb.AdoptAnnotation = "example.com/adopt/eventbus"
+	err := eb.Validate()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid \"eventBus.adoptAnnotation\"")
+}
+
+func TestLevenshtein(t *testing.T) {
+	assert.Equal(t, 0, levenshtein("2.9.1", "2.9.1"))
+	assert.Equal(t, 1, levenshtein("2.9.15", "2.9.1"))
+	assert.Equal(t, 3, levenshtein("kitten", "sitting"))
+	assert.Equal(t, 5, levenshtein("", "2.9.1"))
+}
+
+func TestResolveJetStreamVersion(t *testing.T) {
+	t.Run("highest matching version", func(t *testing.T) {
+		v, err := testConfig.ResolveJetStreamVersion(">=2.7.0 <2.9.0", true)
+		assert.NoError(t, err)
+		assert.Equal(t, "2.8.1", v.Version)
+	})
+
+	t.Run("tilde range", func(t *testing.T) {
+		v, err := testConfig.ResolveJetStreamVersion("~2.7", true)
+		assert.NoError(t, err)
+		assert.Equal(t, "2.7.3", v.Version)
+	})
+
+	t.Run("no matching version", func(t *testing.T) {
+		_, err := testConfig.ResolveJetStreamVersion(">=2.9.0", true)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "2.7.3,2.8.1,latest")
+	})
+
+	t.Run("invalid constraint", func(t *testing.T) {
+		_, err := testConfig.ResolveJetStreamVersion(">=abc", true)
+		assert.Error(t, err)
+	})
+}
+
+func TestDisabledVersions(t *testing.T) {
+	c := &GlobalConfig{EventBus: &EventBusConfig{
+		NATS: &NatsStreamingConfig{
+			DisabledVersions: []string{"0.22.x"},
+			Versions:         testConfig.EventBus.NATS.Versions,
+		},
+		JetStream: &JetStreamConfig{
+			DisabledVersions: []string{"<2.8", "latest"},
+			Versions:         testConfig.EventBus.JetStream.Versions,
+		},
+	}}
+
+	t.Run("supported versions", func(t *testing.T) {
+		assert.Empty(t, c.SupportedNatsStreamingVersions())
+		assert.Equal(t, []string{"2.8.1"}, c.SupportedJetStreamVersions())
+	})
+
+	t.Run("get version", func(t *testing.T) {
+		_, err := c.GetNatsStreamingVersion("0.22.1")
+		assert.EqualError(t, err, `nats streaming version "0.22.1" is disabled by "0.22.x" of "eventBus.nats.disabledVersions" in the controller configuration, use a jetstream eventbus instead`)
+		_, err = c.GetJetStreamVersion("2.7.3", true)
+		assert.EqualError(t, err, `jetstream version "2.7.3" is disabled by "<2.8" of "eventBus.jetstream.disabledVersions" in the controller configuration, supported versions: "2.8.1"`)
+		_, err = c.GetJetStreamVersion("latest", true)
+		assert.Error(t, err)
+		v, err := c.GetJetStreamVersion("2.8.1", true)
+		assert.NoError(t, err)
+		assert.Equal(t, "2.8.1", v.Version)
+	})
+
+	t.Run("resolve version", func(t *testing.T) {
+		_, err := c.ResolveJetStreamVersion("~2.7", true)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `supported versions: "2.8.1"`)
+		v, err := c.ResolveJetStreamVersion(">=2.7.0", true)
+		assert.NoError(t, err)
+		assert.Equal(t, "2.8.1", v.Version)
+	})
+
+	t.Run("disabled nats streaming", func(t *testing.T) {
+		c := &GlobalConfig{EventBus: &EventBusConfig{NATS: &NatsStreamingConfig{Disabled: true, Versions: testConfig.EventBus.NATS.Versions}}}
+		assert.Empty(t, c.SupportedNatsStreamingVersions())
+		assert.Error(t, c.CheckNatsStreamingAllowed(""))
+		_, err := c.GetNatsStreamingVersion("0.22.1")
+		assert.Contains(t, err.Error(), `disabled by "eventBus.nats.disabled"`)
+		assert.NoError(t, testConfig.CheckNatsStreamingAllowed("0.22.1"))
+	})
+
+	t.Run("invalid range", func(t *testing.T) {
+		eb := &EventBusConfig{JetStream: &JetStreamConfig{DisabledVersions: []string{"<abc"}}}
+		err := eb.Validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid "eventBus.jetstream.disabledVersions"`)
+	})
+}
+
+func TestVersionErrorReason(t *testing.T) {
+	c := &GlobalConfig{EventBus: &EventBusConfig{
+		NATS: &NatsStreamingConfig{
+			DisabledVersions: []string{"0.22.x"},
+			Versions:         testConfig.EventBus.NATS.Versions,
+		},
+		JetStream: &JetStreamConfig{
+			DisabledVersions: []string{"<2.8"},
+			Versions: append(testConfig.EventBus.JetStream.Versions,
+				JetStreamVersion{Version: "2.9.0", ConfigReloaderImage: "nats-server-config-reloader:0.6.3", MetricsExporterImage: "prometheus-nats-exporter:0.9.1"}),
+		},
+	}}
+
+	_, err := c.GetNatsStreamingVersion("0.22.1")
+	reason, ok := VersionErrorReason(fmt.Errorf("failed to get nats streaming version, err: %w", err))
+	assert.True(t, ok)
+	assert.Equal(t, VersionReasonNatsStreamingVersionDisabled, reason)
+
+	_, err = c.GetJetStreamVersion("2.7.3", true)
+	reason, _ = VersionErrorReason(err)
+	assert.Equal(t, VersionReasonJetStreamVersionDisabled, reason)
+
+	_, err = c.GetJetStreamVersion("3.0.0", true)
+	reason, _ = VersionErrorReason(err)
+	assert.Equal(t, VersionReasonJetStreamVersionUnsupported, reason)
+
+	_, err = c.GetJetStreamVersion("2.9.0", true)
+	reason, _ = VersionErrorReason(err)
+	assert.Equal(t, VersionReasonJetStreamImageMissing, reason)
+
+	_, err = (&GlobalConfig{EventBus: &EventBusConfig{}}).GetJetStreamVersion("2.8.1", true)
+	reason, _ = VersionErrorReason(err)
+	assert.Equal(t, VersionReasonJetStreamVersionNotFound, reason)
+
+	_, ok = VersionErrorReason(fmt.Errorf("failed"))
+	assert.False(t, ok)
+}
+
+func TestIsVersionConstraint(t *testing.T) {
+	assert.False(t, IsVersionConstraint("2.8.1"))
+	assert.False(t, IsVersionConstraint("latest"))
+	assert.True(t, IsVersionConstraint(">=2.9.0 <2.10.0"))
+	assert.True(t, IsVersionConstraint("~2.9"))
+	assert.True(t, IsVersionConstraint("2.9.x"))
+}
+
+func TestReload(t *testing.T) {
+	v := viper.New()
+	v.SetConfigType("yaml")
+	err := v.ReadConfig(bytes.NewBufferString(`
+eventBus:
+  jetstream:
+    versions:
+    - version: 2.8.1
+      natsImage: nats:2.8.1
+`))
+	assert.NoError(t, err)
+	c := &GlobalConfig{}
+	assert.NoError(t, v.Unmarshal(c))
+	var reloaded []string
+	c.OnReload(func(g *GlobalConfig) {
+		reloaded = g.SupportedJetStreamVersions()
+	})
+
+	t.Run("unchanged config does not notify", func(t *testing.T) {
+		assert.NoError(t, c.reload(v))
+		assert.Nil(t, reloaded)
+	})
+
+	t.Run("changed config notifies", func(t *testing.T) {
+		err := v.ReadConfig(bytes.NewBufferString(`
+eventBus:
+  jetstream:
+    versions:
+    - version: 2.8.1
+      natsImage: nats:2.8.1
+    - version: 2.9.0
+      natsImage: nats:2.9.0
+      configReloaderImage: nats-server-config-reloader:0.6.3
+      metricsExporterImage: prometheus-nats-exporter:0.9.1
+`))
+		assert.NoError(t, err)
+		assert.NoError(t, c.reload(v))
+		assert.Equal(t, []string{"2.8.1", "2.9.0"}, reloaded)
+		v, err := c.GetJetStreamVersion("2.9.0", true)
+		assert.NoError(t, err)
+		assert.Equal(t, "nats:2.9.0", v.NatsImage)
+	})
+}
+
+func TestDebouncer(t *testing.T) {
+	d := &debouncer{period: 50 * time.Millisecond}
+	var count int32
+	for i := 0; i < 5; i++ {
+		d.trigger(func() { atomic.AddInt32(&count, 1) })
+	}
+	assert.Eventually(t, func() bool { return atomic.LoadInt32(&count) == 1 }, time.Second, 10*time.Millisecond)
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&count))
+}
+
+func TestReloadConcurrentReads(t *testing.T) {
+	v := viper.New()
+	v.SetConfigType("yaml")
+	c := &GlobalConfig{EventBus: &EventBusConfig{JetStream: &JetStreamConfig{
+		Versions: []JetStreamVersion{{Version: "2.8.1", NatsImage: "nats:2.8.1", ConfigReloaderImage: "nats-server-config-reloader:0.6.3", MetricsExporterImage: "prometheus-nats-exporter:0.9.1"}},
+	}}}
+	stop := make(chan struct{})
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+				}
+				v, err := c.GetJetStreamVersion("2.8.1", true)
+				assert.NoError(t, err)
+				assert.Equal(t, "nats:2.8.1", v.NatsImage)
+				_ = c.SupportedJetStreamVersions()
+			}
+		}()
+	}
+	for i := 0; i < 50; i++ {
+		err := v.ReadConfig(bytes.NewBufferString(fmt.Sprintf(`
+eventBus:
+  jetstream:
+    settings: "max_payload: %d"
+    versions:
+    - version: 2.8.1
+      natsImage: nats:2.8.1
+      configReloaderImage: nats-server-config-reloader:0.6.3
+      metricsExporterImage: prometheus-nats-exporter:0.9.1
+    - version: 2.9.%d
+      natsImage: nats:2.9.%d
+`, i, i, i)))
+		assert.NoError(t, err)
+		assert.NoError(t, c.reload(v))
+	}
+	close(stop)
+	wg.Wait()
+	assert.Equal(t, []string{"2.8.1", "2.9.49"}, c.SupportedJetStreamVersions())
+}
+
+func TestValidateEventBusTLSConfig(t *testing.T) {
+	var eb *EventBusConfig
+	assert.NoError(t, eb.Validate())
+	assert.NoError(t, (&EventBusConfig{}).Validate())
+
+	cert := &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "eventbus-tls"}, Key: "tls.crt"}
+	key := &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "eventbus-tls"}, Key: "tls.key"}
+	eb = &EventBusConfig{TLS: &EventBusTLSConfig{CertSecret: cert, KeySecret: key, MinVersion: "1.3"}}
+	assert.NoError(t, eb.Validate())
+
+	eb = &EventBusConfig{TLS: &EventBusTLSConfig{CertSecret: cert}}
+	err := eb.Validate()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "both \"certSecret\" and \"keySecret\" are required")
+
+	eb = &EventBusConfig{TLS: &EventBusTLSConfig{CertSecret: cert, KeySecret: &corev1.SecretKeySelector{Key: "tls.key"}}}
+	assert.Error(t, eb.Validate())
+
+	eb = &EventBusConfig{TLS: &EventBusTLSConfig{CertSecret: cert, KeySecret: key, CACertSecret: &corev1.SecretKeySelector{}}}
+	assert.Error(t, eb.Validate())
+
+	eb = &EventBusConfig{TLS: &EventBusTLSConfig{CertSecret: cert, KeySecret: key, MinVersion: "1.1"}}
+	err = eb.Validate()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "unsupported min version \"1.1\"")
+}
+
+func TestGetProfile(t *testing.T) {
+	v := viper.New()
+	v.SetConfigType("yaml")
+	err := v.ReadConfig(bytes.NewBufferString(`
+eventBus:
+  imageRegistry: registry.example.com/default
+profiles:
+  Prod:
+    imageRegistry: registry.example.com/prod
+  dev:
+    imageRegistry: registry.example.com/dev
+`))
+	assert.NoError(t, err)
+	c := &GlobalConfig{}
+	assert.NoError(t, unmarshal(v, c))
+
+	t.Run("default", func(t *testing.T) {
+		p, err := c.GetProfile("")
+		assert.NoError(t, err)
+		assert.Equal(t, "registry.example.com/default", p.GetEventBusConfig().ImageRegistry)
+	})
+
+	t.Run("named", func(t *testing.T) {
+		p, err := c.GetProfile("dev")
+		assert.NoError(t, err)
+		assert.Equal(t, "registry.example.com/dev", p.GetEventBusConfig().ImageRegistry)
+		// The names are case insensitive.
+		p, err = c.GetProfile("prod")
+		assert.NoError(t, err)
+		assert.Equal(t, "registry.example.com/prod", p.GetEventBusConfig().ImageRegistry)
+		p, err = c.GetProfile("PROD")
+		assert.NoError(t, err)
+		assert.Equal(t, "registry.example.com/prod", p.GetEventBusConfig().ImageRegistry)
+	})
+
+	t.Run("missing", func(t *testing.T) {
+		_, err := c.GetProfile("staging")
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "config profile \"staging\" not found, available profiles: \"dev,prod\"")
+	})
+
+	t.Run("changed profile notifies", func(t *testing.T) {
+		var notified bool
+		c.OnReload(func(*GlobalConfig) { notified = true })
+		err := v.ReadConfig(bytes.NewBufferString(`
+eventBus:
+  imageRegistry: registry.example.com/default
+profiles:
+  prod:
+    imageRegistry: registry.example.com/prod-mirror
+  dev:
+    imageRegistry: registry.example.com/dev
+`))
+		assert.NoError(t, err)
+		assert.NoError(t, c.reload(v))
+		assert.True(t, notified)
+		p, err := c.GetProfile("prod")
+		assert.NoError(t, err)
+		assert.Equal(t, "registry.example.com/prod-mirror", p.GetEventBusConfig().ImageRegistry)
+	})
+
+	t.Run("invalid profile is not reloaded", func(t *testing.T) {
+		err := v.ReadConfig(bytes.NewBufferString(`
+profiles:
+  prod:
+    persistence:
+      size: 50GB!
+`))
+		assert.NoError(t, err)
+		err = c.reload(v)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid profile \"prod\"")
+		assert.Equal(t, "registry.example.com/default", c.GetEventBusConfig().ImageRegistry)
+	})
+}
+
+func TestUnmarshalEventBusTLSConfig(t *testing.T) {
+	v := viper.New()
+	v.SetConfigType("yaml")
+	err := v.ReadConfig(bytes.NewBufferString(`
+eventBus:
+  tls:
+    caCertSecret:
+      name: eventbus-tls
+      key: ca.crt
+    certSecret:
+      name: eventbus-tls
+      key: tls.crt
+    keySecret:
+      name: eventbus-tls
+      key: tls.key
+    minVersion: "1.3"
+`))
+	assert.NoError(t, err)
+	c := &GlobalConfig{}
+	assert.NoError(t, unmarshal(v, c))
+	assert.Equal(t, &EventBusTLSConfig{
+		CACertSecret: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "eventbus-tls"}, Key: "ca.crt"},
+		CertSecret:   &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "eventbus-tls"}, Key: "tls.crt"},
+		KeySecret:    &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "eventbus-tls"}, Key: "tls.key"},
+		MinVersion:   "1.3",
+	}, c.EventBus.TLS)
+
+	t.Run("invalid config is not reloaded", func(t *testing.T) {
+		err := v.ReadConfig(bytes.NewBufferString(`
+eventBus:
+  tls:
+    certSecret:
+      name: eventbus-tls
+      key: tls.crt
+`))
+		assert.NoError(t, err)
+		assert.Error(t, c.reload(v))
+		assert.Equal(t, "tls.key", c.GetEventBusConfig().TLS.KeySecret.Key)
+	})
+}
+
+func TestEventBusPersistenceConfig(t *testing.T) {
+	t.Run("validate", func(t *testing.T) {
+		assert.NoError(t, (&EventBusConfig{Persistence: &EventBusPersistenceConfig{}}).Validate())
+		assert.NoError(t, (&EventBusConfig{Persistence: &EventBusPersistenceConfig{StorageClassName: "standard", Size: "50Gi"}}).Validate())
+
+		err := (&EventBusConfig{Persistence: &EventBusPersistenceConfig{Size: "50GB!"}}).Validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid \"eventBus.persistence\", invalid size \"50GB!\"")
+
+		err = (&EventBusConfig{Persistence: &EventBusPersistenceConfig{Size: "0"}}).Validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "must be positive")
+	})
+
+	t.Run("defaults", func(t *testing.T) {
+		var eb *EventBusConfig
+		assert.Nil(t, eb.GetStorageClassName(nil))
+		assert.Equal(t, apiresource.MustParse("10Gi"), eb.GetVolumeSize(nil, "10Gi"))
+
+		eb = &EventBusConfig{Persistence: &EventBusPersistenceConfig{StorageClassName: "standard", Size: "50Gi"}}
+		assert.Equal(t, "standard", *eb.GetStorageClassName(nil))
+		assert.Equal(t, apiresource.MustParse("50Gi"), eb.GetVolumeSize(nil, "10Gi"))
+
+		st := "fast"
+		size := apiresource.MustParse("5Gi")
+		assert.Equal(t, "fast", *eb.GetStorageClassName(&st))
+		assert.Equal(t, size, eb.GetVolumeSize(&size, "10Gi"))
+	})
+
+	t.Run("unmarshal", func(t *testing.T) {
+		v := viper.New()
+		v.SetConfigType("yaml")
+		err := v.ReadConfig(bytes.NewBufferString(`
+eventBus:
+  persistence:
+    storageClassName: standard
+    size: 50Gi
+`))
+		assert.NoError(t, err)
+		c := &GlobalConfig{}
+		assert.NoError(t, unmarshal(v, c))
+		assert.Equal(t, &EventBusPersistenceConfig{StorageClassName: "standard", Size: "50Gi"}, c.EventBus.Persistence)
+	})
+}
+
+func TestEventBusResourcesConfig(t *testing.T) {
+	t.Run("validate", func(t *testing.T) {
+		assert.NoError(t, (&EventBusConfig{Resources: &EventBusResourcesConfig{}}).Validate())
+		assert.NoError(t, (&EventBusConfig{Resources: &EventBusResourcesConfig{
+			Main: &ContainerResourcesConfig{Requests: map[string]string{"cpu": "100m", "memory": "256Mi"}},
+		}}).Validate())
+
+		err := (&EventBusConfig{Resources: &EventBusResourcesConfig{
+			Main: &ContainerResourcesConfig{Requests: map[string]string{"cpu": "lots"}},
+		}}).Validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid \"eventBus.resources.main\", invalid requests, invalid quantity \"lots\" of \"cpu\"")
+
+		err = (&EventBusConfig{Resources: &EventBusResourcesConfig{
+			Metrics: &ContainerResourcesConfig{Limits: map[string]string{"memory": "-1Gi"}},
+		}}).Validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid \"eventBus.resources.metrics\", invalid limits")
+	})
+
+	t.Run("defaults", func(t *testing.T) {
+		var eb *EventBusConfig
+		assert.Equal(t, corev1.ResourceRequirements{}, eb.GetMainResources(corev1.ResourceRequirements{}))
+
+		eb = &EventBusConfig{Resources: &EventBusResourcesConfig{
+			Main: &ContainerResourcesConfig{
+				Requests: map[string]string{"cpu": "100m", "memory": "256Mi"},
+				Limits:   map[string]string{"cpu": "1", "memory": "1Gi"},
+			},
+		}}
+		assert.Equal(t, corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceCPU: apiresource.MustParse("100m"), corev1.ResourceMemory: apiresource.MustParse("256Mi")},
+			Limits:   corev1.ResourceList{corev1.ResourceCPU: apiresource.MustParse("1"), corev1.ResourceMemory: apiresource.MustParse("1Gi")},
+		}, eb.GetMainResources(corev1.ResourceRequirements{}))
+		assert.Equal(t, corev1.ResourceRequirements{}, eb.GetMetricsResources(corev1.ResourceRequirements{}))
+
+		// The requirements of the EventBus win, and the defaults don't make them invalid.
+		assert.Equal(t, corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceCPU: apiresource.MustParse("2")},
+			Limits:   corev1.ResourceList{corev1.ResourceMemory: apiresource.MustParse("128Mi")},
+		}, eb.GetMainResources(corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceCPU: apiresource.MustParse("2")},
+			Limits:   corev1.ResourceList{corev1.ResourceMemory: apiresource.MustParse("128Mi")},
+		}))
+	})
+
+	t.Run("unmarshal", func(t *testing.T) {
+		v := viper.New()
+		v.SetConfigType("yaml")
+		err := v.ReadConfig(bytes.NewBufferString(`
+eventBus:
+  resources:
+    main:
+      requests:
+        cpu: 100m
+      limits:
+        memory: 1Gi
+    metrics:
+      requests:
+        cpu: 10m
+`))
+		assert.NoError(t, err)
+		c := &GlobalConfig{}
+		assert.NoError(t, unmarshal(v, c))
+		assert.Equal(t, &EventBusResourcesConfig{
+			Main:    &ContainerResourcesConfig{Requests: map[string]string{"cpu": "100m"}, Limits: map[string]string{"memory": "1Gi"}},
+			Metrics: &ContainerResourcesConfig{Requests: map[string]string{"cpu": "10m"}},
+		}, c.EventBus.Resources)
+	})
+}
+
+func TestExpandEnv(t *testing.T) {
+	t.Setenv("TEST_IMAGE_REGISTRY", "registry.example.com/mirror")
+	t.Setenv("TEST_EMPTY", "")
+
+	t.Run("present", func(t *testing.T) {
+		result, err := expandEnv("imageRegistry: ${TEST_IMAGE_REGISTRY}\nsize: ${TEST_IMAGE_REGISTRY:-docker.io}")
+		assert.NoError(t, err)
+		assert.Equal(t, "imageRegistry: registry.example.com/mirror\nsize: registry.example.com/mirror", result)
+	})
+
+	t.Run("absent with default", func(t *testing.T) {
+		result, err := expandEnv("size: ${TEST_ABSENT:-50Gi}\nstorageClassName: ${TEST_EMPTY:-standard}\nimageRegistry: ${TEST_ABSENT:-}")
+		assert.NoError(t, err)
+		assert.Equal(t, "size: 50Gi\nstorageClassName: standard\nimageRegistry: ", result)
+	})
+
+	t.Run("absent without default", func(t *testing.T) {
+		_, err := expandEnv("size: ${TEST_ABSENT}\nstorageClassName: ${TEST_OTHER_ABSENT}")
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "TEST_ABSENT,TEST_OTHER_ABSENT")
+
+		result, err := expandEnv("storageClassName: ${TEST_EMPTY}")
+		assert.NoError(t, err)
+		assert.Equal(t, "storageClassName: ", result)
+	})
+
+	t.Run("literal dollars", func(t *testing.T) {
+		result, err := expandEnv(`settings: "max_payload: $$1MB, ${TEST_ABSENT:-$5}, $${TEST_ABSENT}, $TEST_ABSENT, ^a$"`)
+		assert.NoError(t, err)
+		assert.Equal(t, `settings: "max_payload: $1MB, $5, ${TEST_ABSENT}, $TEST_ABSENT, ^a$"`, result)
+	})
+}
+
+func TestReadConfig(t *testing.T) {
+	t.Setenv("TEST_NATS_IMAGE", "nats:2.8.1")
+	path := filepath.Join(t.TempDir(), "controller-config.yaml")
+	assert.NoError(t, ioutil.WriteFile(path, []byte(`
+eventBus:
+  imageRegistry: ${TEST_IMAGE_REGISTRY:-registry.example.com}
+  jetstream:
+    versions:
+    - version: 2.8.1
+      natsImage: ${TEST_NATS_IMAGE}
+`), 0600))
+	v := viper.New()
+	v.SetConfigFile(path)
+	v.SetConfigType("yaml")
+	assert.NoError(t, readConfig(v))
+	c := &GlobalConfig{}
+	assert.NoError(t, unmarshal(v, c))
+	assert.Equal(t, "registry.example.com", c.EventBus.ImageRegistry)
+	assert.Equal(t, "nats:2.8.1", c.EventBus.JetStream.Versions[0].NatsImage)
+
+	t.Run("reload", func(t *testing.T) {
+		t.Setenv("TEST_NATS_IMAGE", "nats:2.8.2")
+		assert.NoError(t, c.reloadFile(path))
+		assert.Equal(t, "nats:2.8.2", c.GetEventBusConfig().JetStream.Versions[0].NatsImage)
+		// The variables are expanded in the viper instance of the reload, the other one is left as is.
+		previous := &GlobalConfig{}
+		assert.NoError(t, unmarshal(v, previous))
+		assert.Equal(t, "nats:2.8.1", previous.EventBus.JetStream.Versions[0].NatsImage)
+	})
+
+	t.Run("reload without a variable", func(t *testing.T) {
+		assert.NoError(t, ioutil.WriteFile(path, []byte("eventBus:\n  imageRegistry: ${TEST_ABSENT}\n"), 0600))
+		err := c.reloadFile(path)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to expand the environment variables")
+		assert.Equal(t, "nats:2.8.2", c.GetEventBusConfig().JetStream.Versions[0].NatsImage)
+	})
+
+	t.Run("absent without default", func(t *testing.T) {
+		assert.NoError(t, ioutil.WriteFile(path, []byte("eventBus:\n  imageRegistry: ${TEST_ABSENT}\n"), 0600))
+		assert.Error(t, readConfig(v))
+	})
+}
+
+func TestReloadFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "controller-config.yaml")
+	assert.NoError(t, ioutil.WriteFile(path, []byte("eventBus:\n  imageRegistry: registry.example.com\n"), 0600))
+	c, err := ReadConfigFile(path)
+	assert.NoError(t, err)
+	var reloaded int
+	c.OnReload(func(*GlobalConfig) { reloaded++ })
+
+	assert.NoError(t, ioutil.WriteFile(path, []byte("eventBus:\n  imageRegistry: mirror.example.com\n"), 0600))
+	assert.NoError(t, c.reloadFile(path))
+	assert.Equal(t, "mirror.example.com", c.GetEventBusConfig().ImageRegistry)
+	assert.Equal(t, 1, reloaded)
+
+	t.Run("invalid config is not swapped in", func(t *testing.T) {
+		assert.NoError(t, ioutil.WriteFile(path, []byte("eventBus:\n  imageRegistry: [\n"), 0600))
+		assert.Error(t, c.reloadFile(path))
+		assert.Equal(t, "mirror.example.com", c.GetEventBusConfig().ImageRegistry)
+		assert.Equal(t, 1, reloaded)
+	})
+}
+
+func TestValidateVersions(t *testing.T) {
+	assert.Empty(t, (&GlobalConfig{}).ValidateVersions())
+
+	c := &GlobalConfig{
+		EventBus: &EventBusConfig{
+			NATS: &NatsStreamingConfig{Versions: []NatsStreamingVersion{
+				{Version: "0.22.1", NatsStreamingImage: "nats-streaming:0.22.1", MetricsExporterImage: "prometheus-nats-exporter:0.8.0"},
+				{NatsStreamingImage: "nats-streaming:0.22.1"},
+			}},
+			JetStream: testConfig.EventBus.JetStream,
+		},
+		Profiles: map[string]*EventBusConfig{
+			"prod": {JetStream: &JetStreamConfig{Versions: []JetStreamVersion{
+				{Version: "2.8.1", NatsImage: "nats:2.8.1", ConfigReloaderImage: "nats-server-config-reloader:0.6.3", MetricsExporterImage: "prometheus-nats-exporter:0.9.1"},
+				{Version: "2.8.1", ConfigReloaderImage: "nats-server-config-reloader:0.6.3", MetricsExporterImage: "prometheus-nats-exporter:0.9.1"},
+			}}},
+		},
+	}
+	var problems []string
+	for _, err := range c.ValidateVersions() {
+		problems = append(problems, err.Error())
+	}
+	assert.Len(t, problems, 5)
+	assert.Equal(t, `"eventBus.nats.versions[1].version" is not configured`, problems[0])
+	assert.Equal(t, `"eventBus.nats.versions[1].metricsExporterImage" of version "" is not configured`, problems[1])
+	assert.Contains(t, problems[2], `version "latest" of "eventBus.jetstream.versions[2]" is not valid semver`)
+	assert.Equal(t, `version "2.8.1" of "profiles.prod.jetstream.versions[1]" is duplicated`, problems[3])
+	assert.Equal(t, `"profiles.prod.jetstream.versions[1].natsImage" of version "2.8.1" is not configured`, problems[4])
+}
+
+func TestReadConfigFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "controller-config.yaml")
+	assert.NoError(t, ioutil.WriteFile(path, []byte("eventBus:\n  imageRegistry: registry.example.com\n"), 0600))
+	c, err := ReadConfigFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, "registry.example.com", c.GetEventBusConfig().ImageRegistry)
+
+	_, err = ReadConfigFile(filepath.Join(t.TempDir(), "absent.yaml"))
+	assert.Error(t, err)
+}
+
+func TestLoadConfig(t *testing.T) {
+	t.Run("name without extension", func(t *testing.T) {
+		dir := t.TempDir()
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "my-config.yaml"), []byte("eventBus:\n  imageRegistry: registry.example.com\n"), 0600))
+		c, err := LoadConfig(dir, "my-config", func(err error) { t.Error(err) })
+		assert.NoError(t, err)
+		assert.Equal(t, "registry.example.com", c.GetEventBusConfig().ImageRegistry)
+	})
+
+	t.Run("full name is watched", func(t *testing.T) {
+		dir := t.TempDir()
+		path := filepath.Join(dir, "events.yml")
+		assert.NoError(t, ioutil.WriteFile(path, []byte("eventBus:\n  imageRegistry: registry.example.com\n"), 0600))
+		c, err := LoadConfig(dir, "events.yml", func(err error) { t.Error(err) })
+		assert.NoError(t, err)
+		assert.Equal(t, "registry.example.com", c.GetEventBusConfig().ImageRegistry)
+
+		var reloaded int32
+		c.OnReload(func(*GlobalConfig) { atomic.StoreInt32(&reloaded, 1) })
+		assert.NoError(t, ioutil.WriteFile(path, []byte("eventBus:\n  imageRegistry: mirror.example.com\n"), 0600))
+		assert.Eventually(t, func() bool { return atomic.LoadInt32(&reloaded) == 1 }, 5*time.Second, 50*time.Millisecond)
+		assert.Equal(t, "mirror.example.com", c.GetEventBusConfig().ImageRegistry)
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		_, err := LoadConfig(t.TempDir(), "controller-config", nil)
+		assert.Error(t, err)
+	})
+}
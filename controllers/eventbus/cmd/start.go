@@ -2,15 +2,23 @@ package cmd
 
 import (
 	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
 
 	"go.uber.org/zap"
+	"golang.org/x/time/rate"
 	appv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
-	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/manager/signals"
+	crmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
@@ -18,39 +26,102 @@ import (
 	"github.com/argoproj/argo-events/common"
 	"github.com/argoproj/argo-events/common/logging"
 	"github.com/argoproj/argo-events/controllers"
+	controllerscommon "github.com/argoproj/argo-events/controllers/common"
 	"github.com/argoproj/argo-events/controllers/eventbus"
 	eventbusv1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
 	eventsourcev1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
 	sensorv1alpha1 "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
 )
 
-func Start(namespaced bool, managedNamespace string) {
+// leaderElectionID is the name of the lease held by the leader
+const leaderElectionID = "argo-events-" + eventbus.ControllerName
+
+// Options holds the options to start the eventbus controller
+type Options struct {
+	// Namespaced tells if the controller only watches the managed namespaces
+	Namespaced bool
+	// ManagedNamespace is the namespace, or comma separated list of namespaces, watched in namespaced scope
+	ManagedNamespace string
+	// LeaderElection enables leader election, so that only one replica reconciles at a time
+	LeaderElection bool
+	// LeaderElectionNamespace is the namespace of the leader election lease,
+	// defaults to the namespace the controller runs in
+	LeaderElectionNamespace string
+	// MetricsAddr is the address the metrics endpoint binds to, "0" disables it
+	MetricsAddr string
+	// HealthProbeAddr is the address the health probe endpoint binds to
+	HealthProbeAddr string
+	// JetStreamLagPollInterval is how often the consumers of the JetStream EventBuses are polled
+	// for the pending messages metric, 0 disables it
+	JetStreamLagPollInterval time.Duration
+	// RateLimiterBaseDelay is the delay of the first requeue of a failing EventBus, doubled on each failure
+	RateLimiterBaseDelay time.Duration
+	// RateLimiterMaxDelay is the maximum delay of the requeues of a failing EventBus
+	RateLimiterMaxDelay time.Duration
+	// ResyncPeriod is how often an EventBus is reconciled without any change, with a jitter, 0 disables it
+	ResyncPeriod time.Duration
+	// ReconcileStallThreshold is how long a reconciliation can run, without any other one completing,
+	// before the liveness check fails
+	ReconcileStallThreshold time.Duration
+	// ConfigPath is the directory of the configuration file, defaults to controllers.DefaultConfigPath
+	ConfigPath string
+	// ConfigName is the name of the configuration file, defaults to controllers.DefaultConfigName
+	ConfigName string
+}
+
+// DefaultMetricsAddr is the default address the metrics endpoint binds to
+var DefaultMetricsAddr = fmt.Sprintf(":%d", common.ControllerMetricsPort)
+
+// DefaultHealthProbeAddr is the default address the health probe endpoint binds to
+const DefaultHealthProbeAddr = ":8081"
+
+// DefaultJetStreamLagPollInterval is the default interval of polling the JetStream consumers
+const DefaultJetStreamLagPollInterval = 30 * time.Second
+
+const (
+	// DefaultRateLimiterBaseDelay is the default delay of the first requeue of a failing EventBus
+	DefaultRateLimiterBaseDelay = 5 * time.Millisecond
+	// DefaultRateLimiterMaxDelay is the default maximum delay of the requeues of a failing EventBus
+	DefaultRateLimiterMaxDelay = 1000 * time.Second
+	// rateLimiterJitter is the maximum jitter added to the requeue delays, as a factor of the delays
+	rateLimiterJitter = 0.1
+)
+
+// DefaultResyncPeriod is the default period of the reconciliations of an EventBus without any change
+const DefaultResyncPeriod = 10 * time.Minute
+
+func Start(options Options) {
 	logger := logging.NewArgoEventsLogger().Named(eventbus.ControllerName)
-	config, err := controllers.LoadConfig(func(err error) {
+	config, err := controllers.LoadConfig(options.ConfigPath, options.ConfigName, func(err error) {
 		logger.Errorf("Failed to reload global configuration file", zap.Error(err))
 	})
 	if err != nil {
 		logger.Fatalw("Failed to load global configuration file", zap.Error(err))
 	}
-	opts := ctrl.Options{
-		MetricsBindAddress:     fmt.Sprintf(":%d", common.ControllerMetricsPort),
-		HealthProbeBindAddress: ":8081",
+	config.OnReload(func(c *controllers.GlobalConfig) {
+		logger.Infow("Global configuration reloaded", "natsStreamingVersions", c.SupportedNatsStreamingVersions(), "jetStreamVersions", c.SupportedJetStreamVersions())
+	})
+	opts, err := managerOptions(options)
+	if err != nil {
+		logger.Fatalw("invalid controller options", zap.Error(err))
 	}
-	if namespaced {
-		opts.Namespace = managedNamespace
+	ctrlOpts, err := controllerOptions(options)
+	if err != nil {
+		logger.Fatalw("invalid controller options", zap.Error(err))
 	}
 	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), opts)
 	if err != nil {
 		logger.Fatalw("unable to get a controller-runtime manager", zap.Error(err))
 	}
 
-	// Readyness probe
-	if err := mgr.AddReadyzCheck("readiness", healthz.Ping); err != nil {
+	// Readyness probe, ready once the informer caches are synced
+	if err := mgr.AddReadyzCheck("readiness", controllerscommon.CacheSyncCheck(mgr.GetCache())); err != nil {
 		logger.Fatalw("unable add a readiness check", zap.Error(err))
 	}
 
-	// Liveness probe
-	if err := mgr.AddHealthzCheck("liveness", healthz.Ping); err != nil {
+	// Liveness probe, failing once the reconciliations are stalled
+	stallDetector := controllerscommon.NewStallDetector(options.ReconcileStallThreshold)
+	if err := mgr.AddHealthzCheck("liveness", stallDetector.Check); err != nil {
 		logger.Fatalw("unable add a health check", zap.Error(err))
 	}
 
@@ -66,10 +137,9 @@ func Start(namespaced bool, managedNamespace string) {
 		logger.Fatalw("unable to add Sensor scheme", zap.Error(err))
 	}
 
-	// A controller with DefaultControllerRateLimiter
-	c, err := controller.New(eventbus.ControllerName, mgr, controller.Options{
-		Reconciler: eventbus.NewReconciler(mgr.GetClient(), mgr.GetScheme(), config, logger),
-	})
+	// A controller with a jittered DefaultControllerRateLimiter
+	ctrlOpts.Reconciler = stallDetector.Wrap(eventbus.NewReconciler(mgr.GetClient(), mgr.GetScheme(), config, options.ResyncPeriod, logger))
+	c, err := controller.New(eventbus.ControllerName, mgr, ctrlOpts)
 	if err != nil {
 		logger.Fatalw("unable to set up individual controller", zap.Error(err))
 	}
@@ -103,8 +173,118 @@ func Start(namespaced bool, managedNamespace string) {
 		logger.Fatalw("unable to watch Services", zap.Error(err))
 	}
 
+	// Poll the JetStream consumers, only on the leader, for the pending messages metric
+	if options.JetStreamLagPollInterval > 0 {
+		poller := eventbus.NewConsumerLagPoller(mgr.GetClient(), options.JetStreamLagPollInterval, logger)
+		if err := crmetrics.Registry.Register(poller); err != nil {
+			logger.Fatalw("unable to register the JetStream consumer lag metrics", zap.Error(err))
+		}
+		if err := mgr.Add(poller); err != nil {
+			logger.Fatalw("unable to add the JetStream consumer lag poller", zap.Error(err))
+		}
+	}
+
 	logger.Infow("starting eventbus controller", "version", argoevents.GetVersion())
 	if err := mgr.Start(signals.SetupSignalHandler()); err != nil {
 		logger.Fatalw("unable to run eventbus controller", zap.Error(err))
 	}
 }
+
+// managerOptions returns the options of the controller manager. In namespaced scope, the
+// managed namespace can be a comma separated list, in which case a multi-namespace cache is used.
+func managerOptions(options Options) (ctrl.Options, error) {
+	metricsAddr := options.MetricsAddr
+	if metricsAddr == "" {
+		metricsAddr = DefaultMetricsAddr
+	}
+	if metricsAddr != "0" {
+		if err := validateBindAddress(metricsAddr); err != nil {
+			return ctrl.Options{}, fmt.Errorf("invalid metrics address, %w", err)
+		}
+	}
+	healthProbeAddr := options.HealthProbeAddr
+	if healthProbeAddr == "" {
+		healthProbeAddr = DefaultHealthProbeAddr
+	}
+	if err := validateBindAddress(healthProbeAddr); err != nil {
+		return ctrl.Options{}, fmt.Errorf("invalid health probe address, %w", err)
+	}
+	opts := ctrl.Options{
+		MetricsBindAddress:     metricsAddr,
+		HealthProbeBindAddress: healthProbeAddr,
+		// The probes are served before the leadership is acquired,
+		// so a replica standing by is still reported healthy.
+		LeaderElection:                options.LeaderElection,
+		LeaderElectionID:              leaderElectionID,
+		LeaderElectionNamespace:       options.LeaderElectionNamespace,
+		LeaderElectionReleaseOnCancel: true,
+	}
+	if !options.Namespaced {
+		return opts, nil
+	}
+	namespaces := []string{}
+	for _, ns := range strings.Split(options.ManagedNamespace, ",") {
+		if ns = strings.TrimSpace(ns); ns != "" {
+			namespaces = append(namespaces, ns)
+		}
+	}
+	switch len(namespaces) {
+	case 0:
+		return opts, fmt.Errorf("at least one managed namespace is required in namespaced scope")
+	case 1:
+		opts.Namespace = namespaces[0]
+	default:
+		opts.NewCache = cache.MultiNamespacedCacheBuilder(namespaces)
+	}
+	return opts, nil
+}
+
+// controllerOptions returns the options of the controller, less the reconciler. The rate limiter is the
+// DefaultControllerRateLimiter, with configurable delays and a jitter added to them, so that the EventBus
+// objects failing together, e.g. after a config reload, are not requeued all at once.
+func controllerOptions(options Options) (controller.Options, error) {
+	baseDelay := options.RateLimiterBaseDelay
+	if baseDelay == 0 {
+		baseDelay = DefaultRateLimiterBaseDelay
+	}
+	maxDelay := options.RateLimiterMaxDelay
+	if maxDelay == 0 {
+		maxDelay = DefaultRateLimiterMaxDelay
+	}
+	if baseDelay < 0 || maxDelay < baseDelay {
+		return controller.Options{}, fmt.Errorf("invalid rate limiter delays, the base delay %v must be positive and less than the max delay %v", baseDelay, maxDelay)
+	}
+	return controller.Options{
+		RateLimiter: workqueue.NewMaxOfRateLimiter(
+			&jitteredRateLimiter{
+				RateLimiter: workqueue.NewItemExponentialFailureRateLimiter(baseDelay, maxDelay),
+				maxFactor:   rateLimiterJitter,
+			},
+			// 10 qps, 100 bucket size, the overall rate limit of the default controller rate limiter
+			&workqueue.BucketRateLimiter{Limiter: rate.NewLimiter(rate.Limit(10), 100)},
+		),
+	}, nil
+}
+
+// jitteredRateLimiter adds a random jitter to the delays of a rate limiter
+type jitteredRateLimiter struct {
+	workqueue.RateLimiter
+	maxFactor float64
+}
+
+// When returns the delay of the rate limiter, plus up to maxFactor of it
+func (r *jitteredRateLimiter) When(item interface{}) time.Duration {
+	return wait.Jitter(r.RateLimiter.When(item), r.maxFactor)
+}
+
+// validateBindAddress validates an address in the form of "host:port", where the host is optional.
+func validateBindAddress(addr string) error {
+	_, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return err
+	}
+	if p, err := strconv.Atoi(port); err != nil || p < 0 || p > 65535 {
+		return fmt.Errorf("invalid port %q in address %q", port, addr)
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package cmd
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-events/common"
+)
+
+func TestManagerOptions(t *testing.T) {
+	t.Run("cluster scope", func(t *testing.T) {
+		opts, err := managerOptions(Options{ManagedNamespace: "argo-events"})
+		assert.NoError(t, err)
+		assert.Equal(t, "", opts.Namespace)
+		assert.Nil(t, opts.NewCache)
+		assert.Equal(t, fmt.Sprintf(":%d", common.ControllerMetricsPort), opts.MetricsBindAddress)
+	})
+
+	t.Run("single namespace", func(t *testing.T) {
+		opts, err := managerOptions(Options{Namespaced: true, ManagedNamespace: "argo-events"})
+		assert.NoError(t, err)
+		assert.Equal(t, "argo-events", opts.Namespace)
+		assert.Nil(t, opts.NewCache)
+	})
+
+	t.Run("multiple namespaces", func(t *testing.T) {
+		opts, err := managerOptions(Options{Namespaced: true, ManagedNamespace: "tenant-a, tenant-b"})
+		assert.NoError(t, err)
+		assert.Equal(t, "", opts.Namespace)
+		assert.NotNil(t, opts.NewCache)
+	})
+
+	t.Run("no namespace", func(t *testing.T) {
+		_, err := managerOptions(Options{Namespaced: true, ManagedNamespace: " , "})
+		assert.Error(t, err)
+	})
+
+	t.Run("leader election", func(t *testing.T) {
+		opts, err := managerOptions(Options{LeaderElection: true, LeaderElectionNamespace: "argo-events"})
+		assert.NoError(t, err)
+		assert.True(t, opts.LeaderElection)
+		assert.Equal(t, "argo-events-eventbus-controller", opts.LeaderElectionID)
+		assert.Equal(t, "argo-events", opts.LeaderElectionNamespace)
+
+		opts, err = managerOptions(Options{})
+		assert.NoError(t, err)
+		assert.False(t, opts.LeaderElection)
+	})
+
+	t.Run("bind addresses", func(t *testing.T) {
+		opts, err := managerOptions(Options{MetricsAddr: "127.0.0.1:9090", HealthProbeAddr: ":9091"})
+		assert.NoError(t, err)
+		assert.Equal(t, "127.0.0.1:9090", opts.MetricsBindAddress)
+		assert.Equal(t, ":9091", opts.HealthProbeBindAddress)
+
+		opts, err = managerOptions(Options{MetricsAddr: "0"})
+		assert.NoError(t, err)
+		assert.Equal(t, "0", opts.MetricsBindAddress)
+		assert.Equal(t, DefaultHealthProbeAddr, opts.HealthProbeBindAddress)
+
+		_, err = managerOptions(Options{MetricsAddr: "9090"})
+		assert.Error(t, err)
+		_, err = managerOptions(Options{HealthProbeAddr: ":http-probe"})
+		assert.Error(t, err)
+		_, err = managerOptions(Options{HealthProbeAddr: ":70000"})
+		assert.Error(t, err)
+	})
+}
+
+func TestControllerOptions(t *testing.T) {
+	t.Run("default rate limiter", func(t *testing.T) {
+		opts, err := controllerOptions(Options{})
+		assert.NoError(t, err)
+		assert.NotNil(t, opts.RateLimiter)
+		delay := opts.RateLimiter.When("eventbus")
+		assert.GreaterOrEqual(t, delay, DefaultRateLimiterBaseDelay)
+		assert.LessOrEqual(t, delay, time.Duration(float64(DefaultRateLimiterBaseDelay)*(1+rateLimiterJitter)))
+	})
+
+	t.Run("configured rate limiter", func(t *testing.T) {
+		opts, err := controllerOptions(Options{RateLimiterBaseDelay: time.Second, RateLimiterMaxDelay: 3 * time.Second})
+		assert.NoError(t, err)
+		assert.NotNil(t, opts.RateLimiter)
+		for _, base := range []time.Duration{time.Second, 2 * time.Second, 3 * time.Second, 3 * time.Second} {
+			delay := opts.RateLimiter.When("eventbus")
+			assert.GreaterOrEqual(t, delay, base)
+			assert.LessOrEqual(t, delay, time.Duration(float64(base)*(1+rateLimiterJitter)))
+		}
+		assert.Equal(t, 4, opts.RateLimiter.NumRequeues("eventbus"))
+		opts.RateLimiter.Forget("eventbus")
+		assert.Equal(t, 0, opts.RateLimiter.NumRequeues("eventbus"))
+	})
+
+	t.Run("invalid delays", func(t *testing.T) {
+		_, err := controllerOptions(Options{RateLimiterBaseDelay: time.Minute, RateLimiterMaxDelay: time.Second})
+		assert.Error(t, err)
+	})
+}
@@ -2,11 +2,13 @@ package eventbus
 
 import (
 	"context"
+	"time"
 
 	"go.uber.org/zap"
 	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -22,6 +24,14 @@ const (
 	ControllerName = "eventbus-controller"
 
 	finalizerName = ControllerName
+
+	// migrationRequeueInterval is how often an EventBus migrating to JetStream, or rolling out its
+	// StatefulSet, is checked for readiness, the StatefulSet status changes don't trigger reconciliations.
+	migrationRequeueInterval = 10 * time.Second
+
+	// resyncJitter is the maximum jitter added to the resync period, as a factor of the period, so that
+	// the EventBus objects reconciled together, e.g. on start, are not resynced all at once.
+	resyncJitter = 0.5
 )
 
 type reconciler struct {
@@ -29,12 +39,15 @@ type reconciler struct {
 	scheme *runtime.Scheme
 
 	config *controllers.GlobalConfig
-	logger *zap.SugaredLogger
+	// resyncPeriod is how often an EventBus is reconciled without any change, to correct the drift
+	// of its resources, 0 disables it.
+	resyncPeriod time.Duration
+	logger       *zap.SugaredLogger
 }
 
 // NewReconciler returns a new reconciler
-func NewReconciler(client client.Client, scheme *runtime.Scheme, config *controllers.GlobalConfig, logger *zap.SugaredLogger) reconcile.Reconciler {
-	return &reconciler{client: client, scheme: scheme, config: config, logger: logger}
+func NewReconciler(client client.Client, scheme *runtime.Scheme, config *controllers.GlobalConfig, resyncPeriod time.Duration, logger *zap.SugaredLogger) reconcile.Reconciler {
+	return &reconciler{client: client, scheme: scheme, config: config, resyncPeriod: resyncPeriod, logger: logger}
 }
 
 func (r *reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -53,6 +66,7 @@ func (r *reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	if reconcileErr != nil {
 		log.Errorw("reconcile error", zap.Error(reconcileErr))
 	}
+	busCopy.Status.RecordTransitions(eventBus.Status.Conditions)
 	if r.needsUpdate(eventBus, busCopy) {
 		if err := r.client.Update(ctx, busCopy); err != nil {
 			return reconcile.Result{}, err
@@ -61,6 +75,12 @@ func (r *reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	if err := r.client.Status().Update(ctx, busCopy); err != nil {
 		return reconcile.Result{}, err
 	}
+	if reconcileErr == nil && (busCopy.Status.IsMigrating() || busCopy.Status.IsProgressing()) {
+		return ctrl.Result{RequeueAfter: migrationRequeueInterval}, nil
+	}
+	if reconcileErr == nil && r.resyncPeriod > 0 && busCopy.DeletionTimestamp.IsZero() {
+		return ctrl.Result{RequeueAfter: wait.Jitter(r.resyncPeriod, resyncJitter)}, nil
+	}
 	return ctrl.Result{}, reconcileErr
 }
 
@@ -86,10 +106,20 @@ func (r *reconciler) reconcile(ctx context.Context, eventBus *v1alpha1.EventBus)
 		log.Errorw("validation failed", zap.Error(err))
 		eventBus.Status.MarkNotConfigured("InvalidSpec", err.Error())
 		return err
-	} else {
-		eventBus.Status.MarkConfigured()
 	}
-	return installer.Install(ctx, eventBus, r.client, r.config, log)
+	config, err := r.config.GetProfile(eventBus.Spec.ConfigProfile)
+	if err != nil {
+		log.Errorw("failed to get the config profile", zap.Error(err))
+		eventBus.Status.MarkNotConfigured("InvalidConfigProfile", err.Error())
+		return err
+	}
+	if err := validateAllowed(eventBus, config); err != nil {
+		log.Errorw("the eventbus is disabled by the controller configuration", zap.Error(err))
+		eventBus.Status.MarkNotConfigured("Disabled", err.Error())
+		return err
+	}
+	eventBus.Status.MarkConfigured()
+	return installer.Install(ctx, eventBus, r.client, config, log)
 }
 
 func (r *reconciler) needsUpdate(old, new *v1alpha1.EventBus) bool {
@@ -3,6 +3,7 @@ package eventbus
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap/zaptest"
@@ -11,6 +12,8 @@ import (
 	apiresource "k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
@@ -121,6 +124,96 @@ func TestReconcileNative(t *testing.T) {
 	})
 }
 
+func TestReconcileConfigProfile(t *testing.T) {
+	config := &controllers.GlobalConfig{
+		Profiles: map[string]*controllers.EventBusConfig{"prod": fakeConfig.EventBus},
+	}
+	newReconciler := func(t *testing.T) *reconciler {
+		return &reconciler{
+			client: fake.NewClientBuilder().Build(),
+			scheme: scheme.Scheme,
+			config: config,
+			logger: zaptest.NewLogger(t).Sugar(),
+		}
+	}
+
+	t.Run("existing profile", func(t *testing.T) {
+		testBus := nativeBus.DeepCopy()
+		testBus.Spec.ConfigProfile = "prod"
+		err := newReconciler(t).reconcile(context.TODO(), testBus)
+		assert.NoError(t, err)
+		assert.True(t, testBus.Status.IsReady())
+	})
+
+	t.Run("missing profile", func(t *testing.T) {
+		testBus := nativeBus.DeepCopy()
+		testBus.Spec.ConfigProfile = "staging"
+		err := newReconciler(t).reconcile(context.TODO(), testBus)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "config profile \"staging\" not found")
+		assert.False(t, testBus.Status.IsReady())
+	})
+}
+
+func TestReconcileDisabled(t *testing.T) {
+	newReconciler := func(t *testing.T, eb *controllers.EventBusConfig) *reconciler {
+		return &reconciler{
+			client: fake.NewClientBuilder().Build(),
+			scheme: scheme.Scheme,
+			config: &controllers.GlobalConfig{EventBus: eb},
+			logger: zaptest.NewLogger(t).Sugar(),
+		}
+	}
+
+	t.Run("disabled nats streaming", func(t *testing.T) {
+		eb := *fakeConfig.EventBus
+		eb.NATS = &controllers.NatsStreamingConfig{Disabled: true, Versions: fakeConfig.EventBus.NATS.Versions}
+		for _, bus := range []*v1alpha1.EventBus{nativeBus, exoticBus} {
+			testBus := bus.DeepCopy()
+			err := newReconciler(t, &eb).reconcile(context.TODO(), testBus)
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), "nats streaming eventbuses are disabled by \"eventBus.nats.disabled\"")
+			assert.Contains(t, err.Error(), "use a jetstream eventbus instead")
+			condition := testBus.Status.GetCondition(v1alpha1.EventBusConditionConfigured)
+			assert.Equal(t, "Disabled", condition.Reason)
+			assert.False(t, testBus.Status.IsReady())
+		}
+	})
+
+	t.Run("disabled nats streaming version family", func(t *testing.T) {
+		eb := *fakeConfig.EventBus
+		eb.NATS = &controllers.NatsStreamingConfig{DisabledVersions: []string{"0.22.x"}, Versions: fakeConfig.EventBus.NATS.Versions}
+		testBus := nativeBus.DeepCopy()
+		err := newReconciler(t, &eb).reconcile(context.TODO(), testBus)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "nats streaming version \"0.22.1\" is disabled by \"0.22.x\"")
+		assert.False(t, testBus.Status.IsReady())
+	})
+
+	t.Run("disabled jetstream version", func(t *testing.T) {
+		eb := *fakeConfig.EventBus
+		eb.JetStream = &controllers.JetStreamConfig{DisabledVersions: []string{"testVersion"}, Versions: fakeConfig.EventBus.JetStream.Versions}
+		testBus := nativeBus.DeepCopy()
+		testBus.Spec.NATS = nil
+		testBus.Spec.JetStream = &v1alpha1.JetStreamBus{Version: "testVersion"}
+		err := newReconciler(t, &eb).reconcile(context.TODO(), testBus)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "jetstream version \"testVersion\" is disabled")
+		condition := testBus.Status.GetCondition(v1alpha1.EventBusConditionConfigured)
+		assert.Equal(t, "Disabled", condition.Reason)
+	})
+
+	t.Run("jetstream allowed", func(t *testing.T) {
+		eb := *fakeConfig.EventBus
+		eb.NATS = &controllers.NatsStreamingConfig{Disabled: true}
+		testBus := nativeBus.DeepCopy()
+		testBus.Spec.NATS = nil
+		testBus.Spec.JetStream = &v1alpha1.JetStreamBus{Version: "testVersion"}
+		err := newReconciler(t, &eb).reconcile(context.TODO(), testBus)
+		assert.NoError(t, err)
+	})
+}
+
 func TestReconcileExotic(t *testing.T) {
 	t.Run("native nats exotic", func(t *testing.T) {
 		testBus := exoticBus.DeepCopy()
@@ -139,6 +232,89 @@ func TestReconcileExotic(t *testing.T) {
 	})
 }
 
+func TestReconcileResync(t *testing.T) {
+	reconcileBus := func(t *testing.T, resyncPeriod time.Duration) ctrl.Result {
+		testBus := nativeBus.DeepCopy()
+		cl := fake.NewClientBuilder().WithObjects(testBus).Build()
+		r := &reconciler{
+			client:       cl,
+			scheme:       scheme.Scheme,
+			config:       fakeConfig,
+			resyncPeriod: resyncPeriod,
+			logger:       zaptest.NewLogger(t).Sugar(),
+		}
+		req := ctrl.Request{NamespacedName: client.ObjectKeyFromObject(testBus)}
+		result, err := r.Reconcile(context.TODO(), req)
+		assert.NoError(t, err)
+		// The StatefulSet is rolling out its replicas until they are ready.
+		assert.Equal(t, migrationRequeueInterval, result.RequeueAfter)
+		markStatefulSetsReady(t, cl)
+		result, err = r.Reconcile(context.TODO(), req)
+		assert.NoError(t, err)
+		return result
+	}
+
+	t.Run("requeues after the resync period with a jitter", func(t *testing.T) {
+		result := reconcileBus(t, time.Minute)
+		assert.GreaterOrEqual(t, result.RequeueAfter, time.Minute)
+		assert.Less(t, result.RequeueAfter, time.Minute+time.Duration(resyncJitter*float64(time.Minute)))
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		result := reconcileBus(t, 0)
+		assert.Equal(t, time.Duration(0), result.RequeueAfter)
+	})
+}
+
+func TestReconcileConditionHistory(t *testing.T) {
+	testBus := nativeBus.DeepCopy()
+	cl := fake.NewClientBuilder().WithObjects(testBus).Build()
+	r := &reconciler{
+		client: cl,
+		scheme: scheme.Scheme,
+		config: fakeConfig,
+		logger: zaptest.NewLogger(t).Sugar(),
+	}
+	req := ctrl.Request{NamespacedName: client.ObjectKeyFromObject(testBus)}
+	getBus := func() *v1alpha1.EventBus {
+		bus := &v1alpha1.EventBus{}
+		assert.NoError(t, cl.Get(context.TODO(), req.NamespacedName, bus))
+		return bus
+	}
+
+	_, err := r.Reconcile(context.TODO(), req)
+	assert.NoError(t, err)
+	bus := getBus()
+	assert.True(t, bus.Status.IsProgressing())
+	assert.Len(t, bus.Status.ConditionHistory, 2)
+
+	// Nothing changed, no transition is recorded.
+	_, err = r.Reconcile(context.TODO(), req)
+	assert.NoError(t, err)
+	assert.Len(t, getBus().Status.ConditionHistory, 2)
+
+	markStatefulSetsReady(t, cl)
+	_, err = r.Reconcile(context.TODO(), req)
+	assert.NoError(t, err)
+	bus = getBus()
+	assert.False(t, bus.Status.IsProgressing())
+	assert.Len(t, bus.Status.ConditionHistory, 3)
+	latest := bus.Status.ConditionHistory[2]
+	assert.Equal(t, v1alpha1.EventBusConditionDeployed, latest.Type)
+	assert.Equal(t, "Succeeded", latest.Reason)
+}
+
+func markStatefulSetsReady(t *testing.T, cl client.Client) {
+	t.Helper()
+	list := &appv1.StatefulSetList{}
+	assert.NoError(t, cl.List(context.TODO(), list))
+	for _, ss := range list.Items {
+		ss.Status.ObservedGeneration = ss.Generation
+		ss.Status.ReadyReplicas = *ss.Spec.Replicas
+		assert.NoError(t, cl.Update(context.TODO(), &ss))
+	}
+}
+
 func TestNeedsUpdate(t *testing.T) {
 	t.Run("needs update", func(t *testing.T) {
 		testBus := nativeBus.DeepCopy()
@@ -2,9 +2,12 @@ package installer
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
+	appv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/argoproj/argo-events/common"
@@ -34,7 +37,15 @@ func Install(ctx context.Context, eventBus *v1alpha1.EventBus, client client.Cli
 		logger.Errorw("installation error", zap.Error(err))
 		return err
 	}
+	busConfig.Compression = eventBus.Spec.Compression
 	eventBus.Status.Config = *busConfig
+	if eventBus.Spec.JetStream != nil {
+		if err := migrateFromNATSStreaming(ctx, eventBus, client, logger); err != nil {
+			logger.Errorw("failed to migrate from nats streaming", zap.Error(err))
+			eventBus.Status.MarkDeployFailed("MigrationFailed", err.Error())
+			return err
+		}
+	}
 	return nil
 }
 
@@ -52,6 +63,77 @@ func getInstaller(eventBus *v1alpha1.EventBus, client client.Client, config *con
 	return nil, errors.New("invalid eventbus spec")
 }
 
+// markDeployed marks the EventBus deployed, or progressing while its StatefulSet is rolling out its replicas.
+func markDeployed(ctx context.Context, c client.Client, eventBus *v1alpha1.EventBus, statefulSetName, message string) error {
+	ss := &appv1.StatefulSet{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: eventBus.Namespace, Name: statefulSetName}, ss); err != nil {
+		return fmt.Errorf("failed to get statefulset %s, err: %w", statefulSetName, err)
+	}
+	if rolledOut, progress := statefulSetProgress(ss); !rolledOut {
+		eventBus.Status.MarkProgressing(progress)
+		return nil
+	}
+	eventBus.Status.MarkDeployed("Succeeded", message)
+	return nil
+}
+
+// statefulSetProgress tells if the StatefulSet has rolled out all its replicas, and describes the progress
+// of the rollout otherwise.
+func statefulSetProgress(ss *appv1.StatefulSet) (bool, string) {
+	if ss.Status.ObservedGeneration < ss.Generation {
+		return false, fmt.Sprintf("StatefulSet %s is rolling out a new spec", ss.Name)
+	}
+	replicas := int32(1)
+	if ss.Spec.Replicas != nil {
+		replicas = *ss.Spec.Replicas
+	}
+	if ss.Status.ReadyReplicas < replicas {
+		return false, fmt.Sprintf("%d of %d replicas of StatefulSet %s are ready", ss.Status.ReadyReplicas, replicas, ss.Name)
+	}
+	return true, ""
+}
+
+// errAdoptionDenied is returned for a StatefulSet of the name of the one of an EventBus which the EventBus
+// doesn't own and can't adopt
+var errAdoptionDenied = errors.New("statefulset adoption denied")
+
+// adoptStatefulSet takes over a pre-existing StatefulSet, e.g. the one of a NATS deployment migrated to an
+// EventBus, if it carries the adopt annotation with the name of the EventBus and has no other controller. The
+// EventBus becomes its controller and its labels are added, its spec is reconciled afterwards. The StatefulSets
+// already controlled by the EventBus are returned as is.
+func adoptStatefulSet(ctx context.Context, c client.Client, eventBus *v1alpha1.EventBus, ss *appv1.StatefulSet, annotation string, labels map[string]string, logger *zap.SugaredLogger) error {
+	if metav1.IsControlledBy(ss, eventBus) {
+		return nil
+	}
+	if owner := metav1.GetControllerOf(ss); owner != nil {
+		return fmt.Errorf("%w, statefulset %s is controlled by %s %s", errAdoptionDenied, ss.Name, owner.Kind, owner.Name)
+	}
+	if value, ok := ss.Annotations[annotation]; !ok || value != eventBus.Name {
+		return fmt.Errorf("%w, statefulset %s already exists without the annotation %s=%s", errAdoptionDenied, ss.Name, annotation, eventBus.Name)
+	}
+	ss.OwnerReferences = append(ss.OwnerReferences, *metav1.NewControllerRef(eventBus.GetObjectMeta(), v1alpha1.SchemaGroupVersionKind))
+	if ss.Labels == nil {
+		ss.Labels = map[string]string{}
+	}
+	for k, v := range labels {
+		ss.Labels[k] = v
+	}
+	if err := c.Update(ctx, ss); err != nil {
+		return fmt.Errorf("failed to adopt statefulset %s, err: %w", ss.Name, err)
+	}
+	logger.Infow("adopted the existing statefulset", "statefulsetName", ss.Name)
+	return nil
+}
+
+// adoptionReason returns the reason of the status of an EventBus failing to adopt a StatefulSet, if the error
+// is an adoption denial
+func adoptionReason(err error) (string, bool) {
+	if errors.Is(err, errAdoptionDenied) {
+		return "StatefulSetAdoptionDenied", true
+	}
+	return "", false
+}
+
 func getLabels(bus *v1alpha1.EventBus) map[string]string {
 	return map[string]string{
 		"controller":          "eventbus-controller",
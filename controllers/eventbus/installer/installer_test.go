@@ -6,12 +6,14 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap/zaptest"
+	appv1 "k8s.io/api/apps/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/scheme"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	"github.com/argoproj/argo-events/controllers"
+	"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
 	eventsourcev1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
 	sensorv1alpha1 "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
 )
@@ -41,6 +43,7 @@ var (
 						NatsImage:            testJetStreamImage,
 						ConfigReloaderImage:  testJSReloaderImage,
 						MetricsExporterImage: testJetStreamExporterImage,
+						StartCommand:         "nats-server",
 					},
 				},
 			},
@@ -72,6 +75,76 @@ func TestGetInstaller(t *testing.T) {
 	})
 }
 
+func TestAdoptStatefulSet(t *testing.T) {
+	bus := testNatsEventBus.DeepCopy()
+	bus.UID = "bus-uid"
+	newStatefulSet := func() *appv1.StatefulSet {
+		return &appv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   testNamespace,
+				Name:        generateStatefulSetName(bus),
+				Labels:      map[string]string{"app": "nats"},
+				Annotations: map[string]string{controllers.DefaultAdoptAnnotation: bus.Name},
+			},
+		}
+	}
+	ctx := context.Background()
+
+	t.Run("adopt allowed", func(t *testing.T) {
+		ss := newStatefulSet()
+		cl := fake.NewClientBuilder().WithObjects(ss).Build()
+		assert.NoError(t, adoptStatefulSet(ctx, cl, bus, ss, controllers.DefaultAdoptAnnotation, testLabels, zaptest.NewLogger(t).Sugar()))
+		result := &appv1.StatefulSet{}
+		assert.NoError(t, cl.Get(ctx, client.ObjectKeyFromObject(ss), result))
+		assert.True(t, metav1.IsControlledBy(result, bus))
+		assert.Equal(t, map[string]string{"app": "nats", "controller": "test-controller"}, result.Labels)
+
+		// Adopting it again is a no-op.
+		assert.NoError(t, adoptStatefulSet(ctx, cl, bus, result, controllers.DefaultAdoptAnnotation, testLabels, zaptest.NewLogger(t).Sugar()))
+		assert.Len(t, result.OwnerReferences, 1)
+	})
+
+	t.Run("adopt denied", func(t *testing.T) {
+		tests := map[string]func(ss *appv1.StatefulSet){
+			"without annotation": func(ss *appv1.StatefulSet) {
+				ss.Annotations = nil
+			},
+			"annotated for another eventbus": func(ss *appv1.StatefulSet) {
+				ss.Annotations[controllers.DefaultAdoptAnnotation] = "other"
+			},
+			"controlled by another owner": func(ss *appv1.StatefulSet) {
+				ss.OwnerReferences = []metav1.OwnerReference{
+					*metav1.NewControllerRef(&metav1.ObjectMeta{Name: "other", UID: "other-uid"}, appv1.SchemeGroupVersion.WithKind("Deployment")),
+				}
+			},
+		}
+		for name, mutate := range tests {
+			t.Run(name, func(t *testing.T) {
+				ss := newStatefulSet()
+				mutate(ss)
+				cl := fake.NewClientBuilder().WithObjects(ss).Build()
+				err := adoptStatefulSet(ctx, cl, bus, ss, controllers.DefaultAdoptAnnotation, testLabels, zaptest.NewLogger(t).Sugar())
+				assert.Error(t, err)
+				reason, ok := adoptionReason(err)
+				assert.True(t, ok)
+				assert.Equal(t, "StatefulSetAdoptionDenied", reason)
+				result := &appv1.StatefulSet{}
+				assert.NoError(t, cl.Get(ctx, client.ObjectKeyFromObject(ss), result))
+				assert.False(t, metav1.IsControlledBy(result, bus))
+				assert.Equal(t, map[string]string{"app": "nats"}, result.Labels)
+			})
+		}
+	})
+
+	t.Run("custom annotation", func(t *testing.T) {
+		ss := newStatefulSet()
+		cl := fake.NewClientBuilder().WithObjects(ss).Build()
+		err := adoptStatefulSet(ctx, cl, bus, ss, "example.com/adopt", testLabels, zaptest.NewLogger(t).Sugar())
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "without the annotation example.com/adopt=test-name")
+	})
+}
+
 func init() {
 	_ = eventsourcev1alpha1.AddToScheme(scheme.Scheme)
 	_ = sensorv1alpha1.AddToScheme(scheme.Scheme)
@@ -163,9 +236,11 @@ func TestInstall(t *testing.T) {
 
 	t.Run("test nats install ok", func(t *testing.T) {
 		testObj := testNatsEventBus.DeepCopy()
+		testObj.Spec.Compression = v1alpha1.CompressionZstd
 		err := Install(ctx, testObj, cl, fakeConfig, zaptest.NewLogger(t).Sugar())
 		assert.NoError(t, err)
 		assert.True(t, testObj.Status.IsReady())
+		assert.Equal(t, v1alpha1.CompressionZstd, testObj.Status.Config.Compression)
 		assert.NotNil(t, testObj.Status.Config.NATS)
 		assert.NotEmpty(t, testObj.Status.Config.NATS.URL)
 		assert.NotNil(t, testObj.Status.Config.NATS.Auth)
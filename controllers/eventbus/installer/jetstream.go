@@ -5,15 +5,18 @@ import (
 	"context"
 	"embed"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 	"text/template"
+	"time"
 
+	"github.com/nats-io/nats-server/v2/conf"
 	"go.uber.org/zap"
 	appv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
-	apiresource "k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -29,6 +32,9 @@ const (
 	jsClusterPort = int32(6222)
 	jsMonitorPort = int32(8222)
 	jsMetricsPort = int32(7777)
+
+	// mount path of the config volume of the nats servers
+	jsConfigDir = "/etc/nats-config"
 )
 
 var (
@@ -75,10 +81,21 @@ func (r *jetStreamInstaller) Install(ctx context.Context) (*v1alpha1.BusConfig,
 	}
 	if err := r.createStatefulSet(ctx); err != nil {
 		r.logger.Errorw("failed to create jetstream StatefulSet", zap.Error(err))
+		reason, ok := controllers.VersionErrorReason(err)
+		if !ok {
+			reason, ok = adoptionReason(err)
+		}
+		if !ok {
+			reason = "JetStreamStatefulSetFailed"
+		}
+		r.eventBus.Status.MarkDeployFailed(reason, err.Error())
+		return nil, err
+	}
+	if err := markDeployed(ctx, r.client, r.eventBus, generateJetStreamStatefulSetName(r.eventBus), "JetStream is deployed"); err != nil {
+		r.logger.Errorw("failed to check the rollout of the jetstream StatefulSet", zap.Error(err))
 		r.eventBus.Status.MarkDeployFailed("JetStreamStatefulSetFailed", err.Error())
 		return nil, err
 	}
-	r.eventBus.Status.MarkDeployed("Succeeded", "JetStream is deployed")
 	return &v1alpha1.BusConfig{
 		JetStream: &v1alpha1.JetStreamConfig{
 			URL: fmt.Sprintf("nats://%s.%s.svc.cluster.local:%s", generateJetStreamServiceName(r.eventBus), r.eventBus.Namespace, strconv.Itoa(int(jsClientPort))),
@@ -90,19 +107,23 @@ func (r *jetStreamInstaller) Install(ctx context.Context) (*v1alpha1.BusConfig,
 					Key: common.JetStreamClientAuthSecretKey,
 				},
 			},
+			TLS: busTLSConfig(r.config.GetEventBusConfig().GetTLS()),
 		},
 	}, nil
 }
 
 // buildJetStreamService builds a Service for Jet Stream
 func (r *jetStreamInstaller) buildJetStreamServiceSpec() corev1.ServiceSpec {
+	ports := []corev1.ServicePort{
+		{Name: "tcp-client", Port: jsClientPort},
+		{Name: "cluster", Port: jsClusterPort},
+	}
+	if r.eventBus.Spec.JetStream.IsMetricsEnabled() {
+		ports = append(ports, corev1.ServicePort{Name: "metrics", Port: jsMetricsPort})
+	}
+	ports = append(ports, corev1.ServicePort{Name: "monitor", Port: jsMonitorPort})
 	return corev1.ServiceSpec{
-		Ports: []corev1.ServicePort{
-			{Name: "tcp-client", Port: jsClientPort},
-			{Name: "cluster", Port: jsClusterPort},
-			{Name: "metrics", Port: jsMetricsPort},
-			{Name: "monitor", Port: jsMonitorPort},
-		},
+		Ports:                    ports,
 		Type:                     corev1.ServiceTypeClusterIP,
 		ClusterIP:                corev1.ClusterIPNone,
 		PublishNotReadyAddresses: true,
@@ -151,11 +172,20 @@ func (r *jetStreamInstaller) createService(ctx context.Context) error {
 }
 
 func (r *jetStreamInstaller) createStatefulSet(ctx context.Context) error {
-	jsVersion, err := r.config.GetJetStreamVersion(r.eventBus.Spec.JetStream.Version)
+	var jsVersion *controllers.JetStreamVersion
+	var err error
+	if version := r.eventBus.Spec.JetStream.Version; controllers.IsVersionConstraint(version) {
+		jsVersion, err = r.config.ResolveJetStreamVersion(version, r.eventBus.Spec.JetStream.IsMetricsEnabled())
+	} else {
+		jsVersion, err = r.config.GetJetStreamVersion(version, r.eventBus.Spec.JetStream.IsMetricsEnabled())
+	}
 	if err != nil {
 		return fmt.Errorf("failed to get jetstream version, err: %w", err)
 	}
-	spec := r.buildStatefulSetSpec(jsVersion)
+	spec, err := r.buildStatefulSetSpec(jsVersion)
+	if err != nil {
+		return fmt.Errorf("failed to build jetstream statefulset spec, err: %w", err)
+	}
 	hash := common.MustHash(spec)
 	obj := &appv1.StatefulSet{
 		ObjectMeta: metav1.ObjectMeta{
@@ -183,8 +213,19 @@ func (r *jetStreamInstaller) createStatefulSet(ctx context.Context) error {
 			return fmt.Errorf("failed to check if jetstream statefulset is existing, err: %w", err)
 		}
 	}
-	if old.GetAnnotations()[common.AnnotationResourceSpecHash] != hash {
+	if err := adoptStatefulSet(ctx, r.client, r.eventBus, old, r.config.GetEventBusConfig().GetAdoptAnnotation(), r.labels, r.logger); err != nil {
+		return err
+	}
+	// The hash only tells if the expected spec changed, the spec is compared as well for the changes
+	// made to the StatefulSet out of band to be reverted. The fields the spec doesn't set, e.g. the
+	// ones defaulted by the API server, are not compared.
+	drifted := !equality.Semantic.DeepDerivative(spec.Template, old.Spec.Template) || !equality.Semantic.DeepDerivative(spec.Replicas, old.Spec.Replicas)
+	if old.GetAnnotations()[common.AnnotationResourceSpecHash] != hash || drifted {
+		if old.Annotations == nil {
+			old.Annotations = map[string]string{}
+		}
 		old.Annotations[common.AnnotationResourceSpecHash] = hash
+		spec.VolumeClaimTemplates = keepVolumeClaimTemplates(old.Spec.VolumeClaimTemplates, spec.VolumeClaimTemplates)
 		old.Spec = spec
 		if err := r.client.Update(ctx, old); err != nil {
 			return fmt.Errorf("failed to update jetstream statefulset, err: %w", err)
@@ -194,8 +235,26 @@ func (r *jetStreamInstaller) createStatefulSet(ctx context.Context) error {
 	return nil
 }
 
-func (r *jetStreamInstaller) buildStatefulSetSpec(jsVersion *controllers.JetStreamVersion) appv1.StatefulSetSpec {
+// keepVolumeClaimTemplates returns the existing volume claim templates of a StatefulSet if the
+// expected ones only differ in their specs, e.g. their size, as they can't be updated.
+func keepVolumeClaimTemplates(existing, expected []corev1.PersistentVolumeClaim) []corev1.PersistentVolumeClaim {
+	if len(existing) != len(expected) {
+		return expected
+	}
+	for i := range existing {
+		if existing[i].Name != expected[i].Name {
+			return expected
+		}
+	}
+	return existing
+}
+
+func (r *jetStreamInstaller) buildStatefulSetSpec(jsVersion *controllers.JetStreamVersion) (appv1.StatefulSetSpec, error) {
 	js := r.eventBus.Spec.JetStream
+	startCommand, err := r.buildStartCommand(jsVersion)
+	if err != nil {
+		return appv1.StatefulSetSpec{}, err
+	}
 	replicas := int32(js.GetReplicas())
 	podTemplateLabels := make(map[string]string)
 	if js.Metadata != nil &&
@@ -209,18 +268,25 @@ func (r *jetStreamInstaller) buildStatefulSetSpec(jsVersion *controllers.JetStre
 	}
 	var jsContainerPullPolicy, reloaderContainerPullPolicy, metricsContainerPullPolicy corev1.PullPolicy
 	var jsContainerSecurityContext, reloaderContainerSecurityContext, metricsContainerSecurityContext *corev1.SecurityContext
+	var jsContainerResources, reloaderContainerResources, metricsContainerResources corev1.ResourceRequirements
 	if js.ContainerTemplate != nil {
 		jsContainerPullPolicy = js.ContainerTemplate.ImagePullPolicy
 		jsContainerSecurityContext = js.ContainerTemplate.SecurityContext
+		jsContainerResources = js.ContainerTemplate.Resources
 	}
 	if js.ReloaderContainerTemplate != nil {
 		reloaderContainerPullPolicy = js.ReloaderContainerTemplate.ImagePullPolicy
 		reloaderContainerSecurityContext = js.ReloaderContainerTemplate.SecurityContext
+		reloaderContainerResources = js.ReloaderContainerTemplate.Resources
 	}
 	if js.MetricsContainerTemplate != nil {
 		metricsContainerPullPolicy = js.MetricsContainerTemplate.ImagePullPolicy
 		metricsContainerSecurityContext = js.MetricsContainerTemplate.SecurityContext
+		metricsContainerResources = js.MetricsContainerTemplate.Resources
 	}
+	ebConfig := r.config.GetEventBusConfig()
+	jsContainerResources = ebConfig.GetMainResources(jsContainerResources)
+	metricsContainerResources = ebConfig.GetMetricsResources(metricsContainerResources)
 	shareProcessNamespace := true
 	terminationGracePeriodSeconds := int64(60)
 	spec := appv1.StatefulSetSpec{
@@ -238,7 +304,7 @@ func (r *jetStreamInstaller) buildStatefulSetSpec(jsVersion *controllers.JetStre
 				NodeSelector:                  js.NodeSelector,
 				Tolerations:                   js.Tolerations,
 				SecurityContext:               js.SecurityContext,
-				ImagePullSecrets:              js.ImagePullSecrets,
+				ImagePullSecrets:              ebConfig.GetImagePullSecrets(js.ImagePullSecrets),
 				PriorityClassName:             js.PriorityClassName,
 				Priority:                      js.Priority,
 				ServiceAccountName:            js.ServiceAccountName,
@@ -251,7 +317,7 @@ func (r *jetStreamInstaller) buildStatefulSetSpec(jsVersion *controllers.JetStre
 						Name: "config-volume",
 						VolumeSource: corev1.VolumeSource{
 							Projected: &corev1.ProjectedVolumeSource{
-								Sources: []corev1.VolumeProjection{
+								Sources: append([]corev1.VolumeProjection{
 									{
 										ConfigMap: &corev1.ConfigMapProjection{
 											LocalObjectReference: corev1.LocalObjectReference{
@@ -278,7 +344,7 @@ func (r *jetStreamInstaller) buildStatefulSetSpec(jsVersion *controllers.JetStre
 											},
 										},
 									},
-								},
+								}, tlsVolumeProjections(ebConfig.GetTLS())...),
 							},
 						},
 					},
@@ -286,14 +352,15 @@ func (r *jetStreamInstaller) buildStatefulSetSpec(jsVersion *controllers.JetStre
 				Containers: []corev1.Container{
 					{
 						Name:            "main",
-						Image:           jsVersion.NatsImage,
+						Image:           ebConfig.GetImage(jsVersion.NatsImage),
 						ImagePullPolicy: jsContainerPullPolicy,
+						Resources:       jsContainerResources,
 						Ports: []corev1.ContainerPort{
 							{Name: "client", ContainerPort: jsClientPort},
 							{Name: "cluster", ContainerPort: jsClusterPort},
 							{Name: "monitor", ContainerPort: jsMonitorPort},
 						},
-						Command: []string{jsVersion.StartCommand, "--config", "/etc/nats-config/nats-js.conf"},
+						Command: append(startCommand, "--config", "/etc/nats-config/nats-js.conf"),
 						Args:    js.StartArgs,
 						Env: []corev1.EnvVar{
 							{Name: "POD_NAME", ValueFrom: &corev1.EnvVarSource{FieldRef: &corev1.ObjectFieldSelector{FieldPath: "metadata.name"}}},
@@ -302,7 +369,7 @@ func (r *jetStreamInstaller) buildStatefulSetSpec(jsVersion *controllers.JetStre
 							{Name: "CLUSTER_ADVERTISE", Value: "$(POD_NAME)." + generateJetStreamServiceName(r.eventBus) + ".$(POD_NAMESPACE).svc.cluster.local"},
 						},
 						VolumeMounts: []corev1.VolumeMount{
-							{Name: "config-volume", MountPath: "/etc/nats-config"},
+							{Name: "config-volume", MountPath: jsConfigDir},
 							{Name: "pid", MountPath: "/var/run/nats"},
 						},
 						SecurityContext: jsContainerSecurityContext,
@@ -331,52 +398,47 @@ func (r *jetStreamInstaller) buildStatefulSetSpec(jsVersion *controllers.JetStre
 						Lifecycle: &corev1.Lifecycle{
 							PreStop: &corev1.LifecycleHandler{
 								Exec: &corev1.ExecAction{
-									Command: []string{jsVersion.StartCommand, "-sl=ldm=/var/run/nats/nats.pid"},
+									Command: []string{startCommand[0], "-sl=ldm=/var/run/nats/nats.pid"},
 								},
 							},
 						},
 					},
 					{
 						Name:            "reloader",
-						Image:           jsVersion.ConfigReloaderImage,
+						Image:           ebConfig.GetImage(jsVersion.ConfigReloaderImage),
 						ImagePullPolicy: reloaderContainerPullPolicy,
 						SecurityContext: reloaderContainerSecurityContext,
+						Resources:       reloaderContainerResources,
 						Command:         []string{"nats-server-config-reloader", "-pid", "/var/run/nats/nats.pid", "-config", "/etc/nats-config/nats-js.conf"},
 						VolumeMounts: []corev1.VolumeMount{
-							{Name: "config-volume", MountPath: "/etc/nats-config"},
+							{Name: "config-volume", MountPath: jsConfigDir},
 							{Name: "pid", MountPath: "/var/run/nats"},
 						},
 					},
-					{
-						Name:            "metrics",
-						Image:           jsVersion.MetricsExporterImage,
-						ImagePullPolicy: metricsContainerPullPolicy,
-						Ports: []corev1.ContainerPort{
-							{Name: "metrics", ContainerPort: jsMetricsPort},
-						},
-						Args:            []string{"-connz", "-routez", "-subz", "-varz", "-prefix=nats", "-use_internal_server_id", "-jsz=all", fmt.Sprintf("http://localhost:%s", strconv.Itoa(int(jsMonitorPort)))},
-						SecurityContext: metricsContainerSecurityContext,
-					},
 				},
 			},
 		},
 	}
+	if js.IsMetricsEnabled() {
+		spec.Template.Spec.Containers = append(spec.Template.Spec.Containers, corev1.Container{
+			Name:            "metrics",
+			Image:           ebConfig.GetImage(jsVersion.MetricsExporterImage),
+			ImagePullPolicy: metricsContainerPullPolicy,
+			Ports: []corev1.ContainerPort{
+				{Name: "metrics", ContainerPort: jsMetricsPort},
+			},
+			Args:            []string{"-connz", "-routez", "-subz", "-varz", "-prefix=nats", "-use_internal_server_id", "-jsz=all", fmt.Sprintf("http://localhost:%s", strconv.Itoa(int(jsMonitorPort)))},
+			SecurityContext: metricsContainerSecurityContext,
+			Resources:       metricsContainerResources,
+		})
+	}
 	if js.Metadata != nil {
 		spec.Template.SetAnnotations(js.Metadata.Annotations)
 	}
-	if js.ContainerTemplate != nil {
-		spec.Template.Spec.Containers[0].Resources = js.ContainerTemplate.Resources
-	}
-	if js.MetricsContainerTemplate != nil {
-		spec.Template.Spec.Containers[1].Resources = js.MetricsContainerTemplate.Resources
-	}
 	if js.Persistence != nil {
 		volMode := corev1.PersistentVolumeFilesystem
 		// Default volume size
-		volSize := apiresource.MustParse("20Gi")
-		if js.Persistence.VolumeSize != nil {
-			volSize = *js.Persistence.VolumeSize
-		}
+		volSize := ebConfig.GetVolumeSize(js.Persistence.VolumeSize, "20Gi")
 		// Default to ReadWriteOnce
 		accessMode := corev1.ReadWriteOnce
 		if js.Persistence.AccessMode != nil {
@@ -392,7 +454,7 @@ func (r *jetStreamInstaller) buildStatefulSetSpec(jsVersion *controllers.JetStre
 						accessMode,
 					},
 					VolumeMode:       &volMode,
-					StorageClassName: js.Persistence.StorageClassName,
+					StorageClassName: ebConfig.GetStorageClassName(js.Persistence.StorageClassName),
 					Resources: corev1.ResourceRequirements{
 						Requests: corev1.ResourceList{
 							corev1.ResourceStorage: volSize,
@@ -415,7 +477,40 @@ func (r *jetStreamInstaller) buildStatefulSetSpec(jsVersion *controllers.JetStre
 		volumeMounts = append(volumeMounts, corev1.VolumeMount{Name: emptyDirVolName, MountPath: "/data/jetstream"})
 		spec.Template.Spec.Containers[0].VolumeMounts = volumeMounts
 	}
-	return spec
+	return spec, nil
+}
+
+// buildStartCommand returns the start command of the version followed by the start command args
+// of the EventBus, with the cluster name and the number of replicas templated into them.
+func (r *jetStreamInstaller) buildStartCommand(jsVersion *controllers.JetStreamVersion) ([]string, error) {
+	data := struct {
+		ClusterName string
+		Replicas    int
+	}{
+		ClusterName: r.eventBus.Name,
+		Replicas:    r.eventBus.Spec.JetStream.GetReplicas(),
+	}
+	var command []string
+	for i, arg := range append([]string{jsVersion.StartCommand}, r.eventBus.Spec.JetStream.StartCommandArgs...) {
+		tpl, err := template.New("startCommand").Option("missingkey=error").Parse(arg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse jetstream start command %q, error: %w", arg, err)
+		}
+		var output bytes.Buffer
+		if err := tpl.Execute(&output, data); err != nil {
+			return nil, fmt.Errorf("failed to render jetstream start command %q, error: %w", arg, err)
+		}
+		rendered := strings.TrimSpace(output.String())
+		if rendered == "" {
+			if i == 0 {
+				return nil, fmt.Errorf("the start command of jetstream version %q is empty", jsVersion.Version)
+			}
+			// Args rendered to nothing, e.g. by a conditional, are dropped
+			continue
+		}
+		command = append(command, rendered)
+	}
+	return command, nil
 }
 
 func (r *jetStreamInstaller) createAuthSecrets(ctx context.Context) error {
@@ -525,9 +620,9 @@ func (r *jetStreamInstaller) createConfigMap(ctx context.Context) error {
 	for j := 0; j < replicas; j++ {
 		routes = append(routes, fmt.Sprintf("nats://%s-%s.%s.%s.svc.cluster.local:%s", ssName, strconv.Itoa(j), svcName, r.eventBus.Namespace, strconv.Itoa(int(jsClusterPort))))
 	}
-	settings := r.config.EventBus.JetStream.Settings
-	if x := r.eventBus.Spec.JetStream.Settings; x != nil {
-		settings = *x
+	settings, err := mergeJetStreamSettings(r.config.GetEventBusConfig().JetStream.Settings, r.eventBus.Spec.JetStream.Settings)
+	if err != nil {
+		return err
 	}
 
 	confTpl := template.Must(template.ParseFS(jetStremAssets, "assets/jetstream/nats.conf"))
@@ -539,6 +634,7 @@ func (r *jetStreamInstaller) createConfigMap(ctx context.Context) error {
 		ClientPort  string
 		Routes      string
 		Settings    string
+		TLS         string
 	}{
 		ClusterName: r.eventBus.Name,
 		MonitorPort: strconv.Itoa(int(jsMonitorPort)),
@@ -546,6 +642,7 @@ func (r *jetStreamInstaller) createConfigMap(ctx context.Context) error {
 		ClientPort:  strconv.Itoa(int(jsClientPort)),
 		Routes:      strings.Join(routes, ","),
 		Settings:    settings,
+		TLS:         serverTLSConfig(r.config.GetEventBusConfig().GetTLS(), jsConfigDir),
 	}); err != nil {
 		return fmt.Errorf("failed to parse nats config template, error: %w", err)
 	}
@@ -649,3 +746,86 @@ func generateJetStreamConfigMapName(eventBus *v1alpha1.EventBus) string {
 func generateJetStreamPVCName(eventBus *v1alpha1.EventBus) string {
 	return fmt.Sprintf("eventbus-%s-js-vol", eventBus.Name)
 }
+
+// mergeJetStreamSettings merges the JetStream settings of the EventBus on top of the global ones,
+// the settings of the EventBus winning on conflicting keys. The global settings are kept as they
+// are if the EventBus doesn't override them.
+func mergeJetStreamSettings(global string, override *string) (string, error) {
+	globalSettings, err := conf.Parse(global)
+	if err != nil {
+		return "", fmt.Errorf("invalid global JetStream settings, %w", err)
+	}
+	if override == nil {
+		return global, nil
+	}
+	overrideSettings, err := conf.Parse(*override)
+	if err != nil {
+		return "", fmt.Errorf("invalid JetStream settings, %w", err)
+	}
+	for k, v := range overrideSettings {
+		globalSettings[k] = v
+	}
+	var buf strings.Builder
+	if err := writeNATSConfig(&buf, globalSettings, ""); err != nil {
+		return "", fmt.Errorf("failed to render the merged JetStream settings, %w", err)
+	}
+	merged := buf.String()
+	if _, err := conf.Parse(merged); err != nil {
+		return "", fmt.Errorf("invalid merged JetStream settings, %w", err)
+	}
+	return merged, nil
+}
+
+// writeNATSConfig writes the parsed NATS config, sorted by key.
+func writeNATSConfig(buf *strings.Builder, settings map[string]interface{}, indent string) error {
+	keys := make([]string, 0, len(settings))
+	for k := range settings {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if m, ok := settings[k].(map[string]interface{}); ok {
+			buf.WriteString(indent + k + " {\n")
+			if err := writeNATSConfig(buf, m, indent+"  "); err != nil {
+				return err
+			}
+			buf.WriteString(indent + "}\n")
+			continue
+		}
+		v, err := formatNATSConfigValue(settings[k])
+		if err != nil {
+			return fmt.Errorf("%s: %w", k, err)
+		}
+		buf.WriteString(indent + k + ": " + v + "\n")
+	}
+	return nil
+}
+
+// formatNATSConfigValue formats a value of the parsed NATS config. The sizes, e.g. 1G, are parsed
+// as numbers of bytes.
+func formatNATSConfigValue(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case string:
+		return strconv.Quote(v), nil
+	case int64:
+		return strconv.FormatInt(v, 10), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case time.Time:
+		return v.UTC().Format(time.RFC3339), nil
+	case []interface{}:
+		items := make([]string, 0, len(v))
+		for _, item := range v {
+			s, err := formatNATSConfigValue(item)
+			if err != nil {
+				return "", err
+			}
+			items = append(items, s)
+		}
+		return "[" + strings.Join(items, ", ") + "]", nil
+	default:
+		return "", fmt.Errorf("unsupported value %v of type %T", value, value)
+	}
+}
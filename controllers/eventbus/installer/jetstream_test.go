@@ -5,13 +5,16 @@ import (
 	"testing"
 
 	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/controllers"
 	"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap/zaptest"
 	appv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	apiresource "k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
@@ -49,6 +52,62 @@ func TestJetStreamBadInstallation(t *testing.T) {
 	})
 }
 
+func TestJetStreamInstallConditions(t *testing.T) {
+	t.Run("unsupported version", func(t *testing.T) {
+		testObj := testJetStreamEventBus.DeepCopy()
+		testObj.Spec.JetStream.Version = "0.0.1"
+		installer := &jetStreamInstaller{
+			client:   fake.NewClientBuilder().Build(),
+			eventBus: testObj,
+			config:   fakeConfig,
+			labels:   testLabels,
+			logger:   zaptest.NewLogger(t).Sugar(),
+		}
+		_, err := installer.Install(context.TODO())
+		assert.Error(t, err)
+		c := testObj.Status.GetCondition(v1alpha1.EventBusConditionDeployed)
+		assert.NotNil(t, c)
+		assert.True(t, c.IsFalse())
+		assert.Equal(t, controllers.VersionReasonJetStreamVersionUnsupported, c.Reason)
+	})
+
+	t.Run("statefulset progressing", func(t *testing.T) {
+		testObj := testJetStreamEventBus.DeepCopy()
+		installer := &jetStreamInstaller{
+			client:   fake.NewClientBuilder().Build(),
+			eventBus: testObj,
+			config:   fakeConfig,
+			labels:   testLabels,
+			logger:   zaptest.NewLogger(t).Sugar(),
+		}
+		_, err := installer.Install(context.TODO())
+		assert.NoError(t, err)
+		assert.True(t, testObj.Status.IsProgressing())
+		assert.Contains(t, testObj.Status.GetCondition(v1alpha1.EventBusConditionDeployed).Message, "replicas")
+	})
+}
+
+func TestStatefulSetProgress(t *testing.T) {
+	ss := &appv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Generation: 2},
+		Spec:       appv1.StatefulSetSpec{Replicas: pointer.Int32(3)},
+	}
+	ss.Status.ObservedGeneration = 1
+	done, message := statefulSetProgress(ss)
+	assert.False(t, done)
+	assert.Equal(t, "StatefulSet test is rolling out a new spec", message)
+
+	ss.Status.ObservedGeneration = 2
+	ss.Status.ReadyReplicas = 1
+	done, message = statefulSetProgress(ss)
+	assert.False(t, done)
+	assert.Equal(t, "1 of 3 replicas of StatefulSet test are ready", message)
+
+	ss.Status.ReadyReplicas = 3
+	done, _ = statefulSetProgress(ss)
+	assert.True(t, done)
+}
+
 func TestJetStreamGenerateNames(t *testing.T) {
 	n := generateJetStreamStatefulSetName(testJetStreamEventBus)
 	assert.Equal(t, "eventbus-"+testJetStreamEventBus.Name+"-js", n)
@@ -91,6 +150,21 @@ func TestJetStreamCreateObjects(t *testing.T) {
 		assert.True(t, len(sts.Spec.Template.Spec.Volumes) > 1)
 	})
 
+	t.Run("test revert sts drift", func(t *testing.T) {
+		testObj := testJetStreamEventBus.DeepCopy()
+		i.eventBus = testObj
+		key := types.NamespacedName{Namespace: testObj.Namespace, Name: generateJetStreamStatefulSetName(testObj)}
+		sts := &appv1.StatefulSet{}
+		assert.NoError(t, cl.Get(ctx, key, sts))
+		sts.Spec.Template.Spec.Containers[0].Image = "nats:edited"
+		assert.NoError(t, cl.Update(ctx, sts))
+
+		assert.NoError(t, i.createStatefulSet(ctx))
+		sts = &appv1.StatefulSet{}
+		assert.NoError(t, cl.Get(ctx, key, sts))
+		assert.Equal(t, testJetStreamImage, sts.Spec.Template.Spec.Containers[0].Image)
+	})
+
 	t.Run("test create svc", func(t *testing.T) {
 		testObj := testJetStreamEventBus.DeepCopy()
 		i.eventBus = testObj
@@ -131,6 +205,109 @@ func TestJetStreamCreateObjects(t *testing.T) {
 		assert.Equal(t, 1, len(c.Data))
 		assert.Contains(t, c.Annotations, common.AnnotationResourceSpecHash)
 	})
+
+	t.Run("test create configmap with settings", func(t *testing.T) {
+		testObj := testJetStreamEventBus.DeepCopy()
+		testObj.Name = "with-settings"
+		settings := "max_file_store: 10GB"
+		testObj.Spec.JetStream.Settings = &settings
+		i.eventBus = testObj
+		err := i.createConfigMap(ctx)
+		assert.NoError(t, err)
+		c := &corev1.ConfigMap{}
+		err = cl.Get(ctx, types.NamespacedName{Namespace: testObj.Namespace, Name: generateJetStreamConfigMapName(testObj)}, c)
+		assert.NoError(t, err)
+		assert.Contains(t, c.Data[common.JetStreamConfigMapKey], "max_file_store: 10737418240")
+
+		invalid := "max_file_store: {"
+		testObj.Spec.JetStream.Settings = &invalid
+		assert.Error(t, i.createConfigMap(ctx))
+	})
+}
+
+func TestJetStreamAdoptStatefulSet(t *testing.T) {
+	existing := func(annotations map[string]string) *appv1.StatefulSet {
+		return &appv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   testNamespace,
+				Name:        generateJetStreamStatefulSetName(testJetStreamEventBus),
+				Annotations: annotations,
+			},
+			Spec: appv1.StatefulSetSpec{
+				Selector: &metav1.LabelSelector{MatchLabels: testLabels},
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "main", Image: "nats:migrated"}}},
+				},
+			},
+		}
+	}
+
+	t.Run("adopt allowed", func(t *testing.T) {
+		testObj := testJetStreamEventBus.DeepCopy()
+		cl := fake.NewClientBuilder().WithObjects(existing(map[string]string{controllers.DefaultAdoptAnnotation: testObj.Name})).Build()
+		i := &jetStreamInstaller{client: cl, eventBus: testObj, config: fakeConfig, labels: testLabels, logger: zaptest.NewLogger(t).Sugar()}
+		assert.NoError(t, i.createStatefulSet(context.TODO()))
+		sts := &appv1.StatefulSet{}
+		assert.NoError(t, cl.Get(context.TODO(), types.NamespacedName{Namespace: testNamespace, Name: generateJetStreamStatefulSetName(testObj)}, sts))
+		assert.True(t, metav1.IsControlledBy(sts, testObj))
+		assert.Equal(t, "test-controller", sts.Labels["controller"])
+		assert.Equal(t, testJetStreamImage, sts.Spec.Template.Spec.Containers[0].Image)
+	})
+
+	t.Run("adopt denied", func(t *testing.T) {
+		testObj := testJetStreamEventBus.DeepCopy()
+		cl := fake.NewClientBuilder().WithObjects(existing(map[string]string{controllers.DefaultAdoptAnnotation: "other"})).Build()
+		i := &jetStreamInstaller{client: cl, eventBus: testObj, config: fakeConfig, labels: testLabels, logger: zaptest.NewLogger(t).Sugar()}
+		_, err := i.Install(context.TODO())
+		assert.Error(t, err)
+		c := testObj.Status.GetCondition(v1alpha1.EventBusConditionDeployed)
+		assert.NotNil(t, c)
+		assert.Equal(t, "StatefulSetAdoptionDenied", c.Reason)
+		sts := &appv1.StatefulSet{}
+		assert.NoError(t, cl.Get(context.TODO(), types.NamespacedName{Namespace: testNamespace, Name: generateJetStreamStatefulSetName(testObj)}, sts))
+		assert.Empty(t, sts.OwnerReferences)
+		assert.Equal(t, "nats:migrated", sts.Spec.Template.Spec.Containers[0].Image)
+	})
+}
+
+func TestMergeJetStreamSettings(t *testing.T) {
+	global := `# the defaults
+max_memory_store: -1
+max_file_store: 1TB
+`
+	t.Run("global settings", func(t *testing.T) {
+		settings, err := mergeJetStreamSettings(global, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, global, settings)
+	})
+
+	t.Run("override", func(t *testing.T) {
+		override := "max_file_store: 10GB\nmax_outstanding_catchup: 64MB"
+		settings, err := mergeJetStreamSettings(global, &override)
+		assert.NoError(t, err)
+		assert.Equal(t, "max_file_store: 10737418240\nmax_memory_store: -1\nmax_outstanding_catchup: 67108864\n", settings)
+	})
+
+	t.Run("nested and string values", func(t *testing.T) {
+		override := `domain: "tenant-a"
+limits {
+  max_ack_pending: 1000
+}`
+		settings, err := mergeJetStreamSettings("", &override)
+		assert.NoError(t, err)
+		assert.Equal(t, "domain: \"tenant-a\"\nlimits {\n  max_ack_pending: 1000\n}\n", settings)
+	})
+
+	t.Run("invalid settings", func(t *testing.T) {
+		override := "max_file_store: {"
+		_, err := mergeJetStreamSettings(global, &override)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid JetStream settings")
+
+		_, err = mergeJetStreamSettings("max_file_store: {", nil)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid global JetStream settings")
+	})
 }
 
 func TestBuildJetStreamStatefulSetSpec(t *testing.T) {
@@ -144,7 +321,8 @@ func TestBuildJetStreamStatefulSetSpec(t *testing.T) {
 	}
 
 	t.Run("without persistence", func(t *testing.T) {
-		s := i.buildStatefulSetSpec(&fakeConfig.EventBus.JetStream.Versions[0])
+		s, err := i.buildStatefulSetSpec(&fakeConfig.EventBus.JetStream.Versions[0])
+		assert.NoError(t, err)
 		assert.Equal(t, int32(3), *s.Replicas)
 		assert.Equal(t, generateJetStreamServiceName(testJetStreamEventBus), s.ServiceName)
 		assert.Equal(t, testJetStreamImage, s.Template.Spec.Containers[0].Image)
@@ -166,11 +344,169 @@ func TestBuildJetStreamStatefulSetSpec(t *testing.T) {
 				StorageClassName: &st,
 			},
 		}
-		s := i.buildStatefulSetSpec(&fakeConfig.EventBus.JetStream.Versions[0])
+		s, err := i.buildStatefulSetSpec(&fakeConfig.EventBus.JetStream.Versions[0])
+		assert.NoError(t, err)
 		assert.True(t, len(s.VolumeClaimTemplates) > 0)
+		assert.Equal(t, apiresource.MustParse("20Gi"), s.VolumeClaimTemplates[0].Spec.Resources.Requests[corev1.ResourceStorage])
+	})
+
+	t.Run("with persistence defaults", func(t *testing.T) {
+		eb := *fakeConfig.EventBus
+		eb.Persistence = &controllers.EventBusPersistenceConfig{StorageClassName: "standard", Size: "100Gi"}
+		i.config = &controllers.GlobalConfig{EventBus: &eb}
+		defer func() { i.config = fakeConfig }()
+		i.eventBus.Spec.JetStream = &v1alpha1.JetStreamBus{
+			Persistence: &v1alpha1.PersistenceStrategy{},
+		}
+		s, err := i.buildStatefulSetSpec(&fakeConfig.EventBus.JetStream.Versions[0])
+		assert.NoError(t, err)
+		assert.Len(t, s.VolumeClaimTemplates, 1)
+		pvc := s.VolumeClaimTemplates[0]
+		assert.Equal(t, generateJetStreamPVCName(i.eventBus), pvc.Name)
+		assert.Equal(t, "standard", *pvc.Spec.StorageClassName)
+		assert.Equal(t, apiresource.MustParse("100Gi"), pvc.Spec.Resources.Requests[corev1.ResourceStorage])
+		assert.Equal(t, []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce}, pvc.Spec.AccessModes)
+	})
+
+	t.Run("with resource defaults", func(t *testing.T) {
+		eb := *fakeConfig.EventBus
+		eb.Resources = &controllers.EventBusResourcesConfig{
+			Main: &controllers.ContainerResourcesConfig{
+				Requests: map[string]string{"cpu": "100m", "memory": "256Mi"},
+				Limits:   map[string]string{"memory": "1Gi"},
+			},
+			Metrics: &controllers.ContainerResourcesConfig{
+				Requests: map[string]string{"cpu": "10m"},
+			},
+		}
+		i.config = &controllers.GlobalConfig{EventBus: &eb}
+		defer func() { i.config = fakeConfig }()
+
+		i.eventBus.Spec.JetStream = &v1alpha1.JetStreamBus{}
+		s, err := i.buildStatefulSetSpec(&fakeConfig.EventBus.JetStream.Versions[0])
+		assert.NoError(t, err)
+		main := s.Template.Spec.Containers[0].Resources
+		assert.Equal(t, apiresource.MustParse("100m"), main.Requests[corev1.ResourceCPU])
+		assert.Equal(t, apiresource.MustParse("256Mi"), main.Requests[corev1.ResourceMemory])
+		assert.Equal(t, apiresource.MustParse("1Gi"), main.Limits[corev1.ResourceMemory])
+		assert.Empty(t, s.Template.Spec.Containers[1].Resources)
+		assert.Equal(t, "metrics", s.Template.Spec.Containers[2].Name)
+		assert.Equal(t, apiresource.MustParse("10m"), s.Template.Spec.Containers[2].Resources.Requests[corev1.ResourceCPU])
+
+		i.eventBus.Spec.JetStream = &v1alpha1.JetStreamBus{
+			ContainerTemplate: &v1alpha1.ContainerTemplate{
+				Resources: corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceCPU: apiresource.MustParse("2")},
+					Limits:   corev1.ResourceList{corev1.ResourceMemory: apiresource.MustParse("4Gi")},
+				},
+			},
+		}
+		s, err = i.buildStatefulSetSpec(&fakeConfig.EventBus.JetStream.Versions[0])
+		assert.NoError(t, err)
+		main = s.Template.Spec.Containers[0].Resources
+		assert.Equal(t, apiresource.MustParse("2"), main.Requests[corev1.ResourceCPU])
+		assert.Equal(t, apiresource.MustParse("4Gi"), main.Limits[corev1.ResourceMemory])
+		// The memory limit of the spec defaults the request, the default request is not added.
+		_, ok := main.Requests[corev1.ResourceMemory]
+		assert.False(t, ok)
+	})
+
+	t.Run("with image registry", func(t *testing.T) {
+		eb := *fakeConfig.EventBus
+		config := &controllers.GlobalConfig{EventBus: &eb}
+		config.EventBus.ImageRegistry = "registry.example.com"
+		config.EventBus.ImagePullSecrets = []corev1.LocalObjectReference{{Name: "mirror-secret"}}
+		i.config = config
+		i.eventBus.Spec.JetStream = &v1alpha1.JetStreamBus{
+			ImagePullSecrets: []corev1.LocalObjectReference{{Name: "eventbus-secret"}},
+		}
+		s, err := i.buildStatefulSetSpec(&fakeConfig.EventBus.JetStream.Versions[0])
+		assert.NoError(t, err)
+		assert.Equal(t, "registry.example.com/"+testJetStreamImage, s.Template.Spec.Containers[0].Image)
+		assert.Equal(t, "registry.example.com/"+testJSReloaderImage, s.Template.Spec.Containers[1].Image)
+		assert.Equal(t, "registry.example.com/"+testJetStreamExporterImage, s.Template.Spec.Containers[2].Image)
+		assert.Equal(t, []corev1.LocalObjectReference{{Name: "eventbus-secret"}, {Name: "mirror-secret"}}, s.Template.Spec.ImagePullSecrets)
+	})
+
+	t.Run("with start command args", func(t *testing.T) {
+		i.config = fakeConfig
+		i.eventBus.Spec.JetStream = &v1alpha1.JetStreamBus{
+			Replicas:         pointer.Int32(3),
+			StartCommandArgs: []string{"--cluster_name={{ .ClusterName }}", "{{ if gt .Replicas 1 }}--js{{ end }}", "{{ if gt .Replicas 3 }}--debug{{ end }}"},
+		}
+		s, err := i.buildStatefulSetSpec(&fakeConfig.EventBus.JetStream.Versions[0])
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"nats-server", "--cluster_name=" + testJetStreamEventBus.Name, "--js", "--config", "/etc/nats-config/nats-js.conf"}, s.Template.Spec.Containers[0].Command)
+		assert.Equal(t, []string{"nats-server", "-sl=ldm=/var/run/nats/nats.pid"}, s.Template.Spec.Containers[0].Lifecycle.PreStop.Exec.Command)
+	})
+
+	t.Run("with bad start command", func(t *testing.T) {
+		i.eventBus.Spec.JetStream = &v1alpha1.JetStreamBus{StartCommandArgs: []string{"--cluster_name={{ .Unknown }}"}}
+		_, err := i.buildStatefulSetSpec(&fakeConfig.EventBus.JetStream.Versions[0])
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to render jetstream start command")
+
+		i.eventBus.Spec.JetStream = &v1alpha1.JetStreamBus{}
+		_, err = i.buildStatefulSetSpec(&controllers.JetStreamVersion{Version: "2.7.3", StartCommand: " "})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "the start command of jetstream version \"2.7.3\" is empty")
+	})
+}
+
+func TestBuildJetStreamMetrics(t *testing.T) {
+	newInstaller := func(enabled *bool) *jetStreamInstaller {
+		eventBus := testJetStreamEventBus.DeepCopy()
+		eventBus.Spec.JetStream.Metrics = &v1alpha1.JetStreamMetrics{Enabled: enabled}
+		return &jetStreamInstaller{
+			client:   fake.NewClientBuilder().Build(),
+			eventBus: eventBus,
+			config:   fakeConfig,
+			labels:   testLabels,
+			logger:   zaptest.NewLogger(t).Sugar(),
+		}
+	}
+
+	t.Run("enabled", func(t *testing.T) {
+		enabled := true
+		i := newInstaller(&enabled)
+		s, err := i.buildStatefulSetSpec(&fakeConfig.EventBus.JetStream.Versions[0])
+		assert.NoError(t, err)
+		assert.Equal(t, 3, len(s.Template.Spec.Containers))
+		assert.Equal(t, "metrics", s.Template.Spec.Containers[2].Name)
+		assert.Equal(t, testJetStreamExporterImage, s.Template.Spec.Containers[2].Image)
+		spec := i.buildJetStreamServiceSpec()
+		assert.Equal(t, []string{"tcp-client", "cluster", "metrics", "monitor"}, servicePortNames(spec))
+
+		i = newInstaller(nil)
+		s, err = i.buildStatefulSetSpec(&fakeConfig.EventBus.JetStream.Versions[0])
+		assert.NoError(t, err)
+		assert.Equal(t, 3, len(s.Template.Spec.Containers))
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		enabled := false
+		i := newInstaller(&enabled)
+		jsVersion := fakeConfig.EventBus.JetStream.Versions[0]
+		jsVersion.MetricsExporterImage = ""
+		s, err := i.buildStatefulSetSpec(&jsVersion)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(s.Template.Spec.Containers))
+		for _, c := range s.Template.Spec.Containers {
+			assert.NotEqual(t, "metrics", c.Name)
+		}
+		spec := i.buildJetStreamServiceSpec()
+		assert.Equal(t, []string{"tcp-client", "cluster", "monitor"}, servicePortNames(spec))
 	})
 }
 
+func servicePortNames(spec corev1.ServiceSpec) []string {
+	names := []string{}
+	for _, p := range spec.Ports {
+		names = append(names, p.Name)
+	}
+	return names
+}
+
 func TestJetStreamGetServiceSpec(t *testing.T) {
 	cl := fake.NewClientBuilder().Build()
 	i := &jetStreamInstaller{
@@ -195,3 +531,20 @@ func Test_JSBufferGetReplicas(t *testing.T) {
 	s.Replicas = &two
 	assert.Equal(t, 3, s.GetReplicas())
 }
+
+func TestKeepVolumeClaimTemplates(t *testing.T) {
+	pvc := func(name, size string) corev1.PersistentVolumeClaim {
+		return corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: corev1.PersistentVolumeClaimSpec{
+				Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{corev1.ResourceStorage: apiresource.MustParse(size)}},
+			},
+		}
+	}
+	existing := []corev1.PersistentVolumeClaim{pvc("vol", "20Gi")}
+	assert.Equal(t, existing, keepVolumeClaimTemplates(existing, []corev1.PersistentVolumeClaim{pvc("vol", "50Gi")}))
+	expected := []corev1.PersistentVolumeClaim{pvc("other", "50Gi")}
+	assert.Equal(t, expected, keepVolumeClaimTemplates(existing, expected))
+	assert.Nil(t, keepVolumeClaimTemplates(existing, nil))
+	assert.Equal(t, existing, keepVolumeClaimTemplates(nil, existing))
+}
@@ -0,0 +1,86 @@
+package installer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+	appv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
+)
+
+// migrateFromNATSStreaming tears down the native NATS streaming resources left behind by an EventBus
+// whose spec flipped from "nats" to "jetstream". With the WaitForReady migration policy, the teardown
+// is held back, and the EventBus marked as migrating, until all the JetStream replicas are ready.
+func migrateFromNATSStreaming(ctx context.Context, eventBus *v1alpha1.EventBus, c client.Client, logger *zap.SugaredLogger) error {
+	stan := &appv1.StatefulSet{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: eventBus.Namespace, Name: generateStatefulSetName(eventBus)}, stan); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to check if nats streaming statefulset is existing, err: %w", err)
+	}
+	if !metav1.IsControlledBy(stan, eventBus) {
+		return nil
+	}
+	if eventBus.Spec.MigrationPolicy == v1alpha1.MigrationPolicyWaitForReady {
+		ready, err := isJetStreamReady(ctx, eventBus, c)
+		if err != nil {
+			return err
+		}
+		if !ready {
+			logger.Info("waiting for jetstream to be ready before tearing down nats streaming")
+			eventBus.Status.MarkMigrating("Waiting for JetStream to be ready before tearing down NATS streaming")
+			return nil
+		}
+	}
+	objs := []client.Object{
+		stan,
+		&corev1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: eventBus.Namespace, Name: generateServiceName(eventBus)}},
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: eventBus.Namespace, Name: generateConfigMapName(eventBus)}},
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: eventBus.Namespace, Name: generateServerAuthSecretName(eventBus)}},
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: eventBus.Namespace, Name: generateClientAuthSecretName(eventBus)}},
+	}
+	for _, obj := range objs {
+		if err := c.Delete(ctx, obj); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete nats streaming %s, err: %w", obj.GetName(), err)
+		}
+	}
+	// The PVCs of NATS streaming have the same labels as the JetStream ones, tell them apart by name
+	pvcl := &corev1.PersistentVolumeClaimList{}
+	if err := c.List(ctx, pvcl, &client.ListOptions{
+		Namespace:     eventBus.Namespace,
+		LabelSelector: labelSelector(getLabels(eventBus)),
+	}); err != nil {
+		return fmt.Errorf("failed to get nats streaming PVCs, err: %w", err)
+	}
+	for _, pvc := range pvcl.Items {
+		if !strings.HasPrefix(pvc.Name, generatePVCName(eventBus)+"-") {
+			continue
+		}
+		if err := c.Delete(ctx, &pvc); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete nats streaming pvc %s, err: %w", pvc.Name, err)
+		}
+	}
+	logger.Info("tore down nats streaming after the migration to jetstream")
+	return nil
+}
+
+// isJetStreamReady tells if the JetStream StatefulSet of the EventBus has rolled out all its replicas
+func isJetStreamReady(ctx context.Context, eventBus *v1alpha1.EventBus, c client.Client) (bool, error) {
+	ss := &appv1.StatefulSet{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: eventBus.Namespace, Name: generateJetStreamStatefulSetName(eventBus)}, ss); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to get jetstream statefulset, err: %w", err)
+	}
+	ready, _ := statefulSetProgress(ss)
+	return ready, nil
+}
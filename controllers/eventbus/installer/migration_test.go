@@ -0,0 +1,94 @@
+package installer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+	appv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
+)
+
+func TestMigrateFromNATSStreaming(t *testing.T) {
+	ctx := context.TODO()
+	pvc := func(eventBus *v1alpha1.EventBus, name string) *corev1.PersistentVolumeClaim {
+		return &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Namespace: eventBus.Namespace, Name: name, Labels: getLabels(eventBus)},
+		}
+	}
+	// flip installs NATS streaming, and flips the spec of the EventBus to JetStream
+	flip := func(t *testing.T, policy v1alpha1.MigrationPolicy) (client.Client, *v1alpha1.EventBus) {
+		eventBus := testNatsEventBus.DeepCopy()
+		cl := fake.NewClientBuilder().Build()
+		err := Install(ctx, eventBus, cl, fakeConfig, zaptest.NewLogger(t).Sugar())
+		assert.NoError(t, err)
+		err = cl.Create(ctx, pvc(eventBus, generatePVCName(eventBus)+"-"+generateStatefulSetName(eventBus)+"-0"))
+		assert.NoError(t, err)
+		err = cl.Create(ctx, pvc(eventBus, generateJetStreamPVCName(eventBus)+"-"+generateJetStreamStatefulSetName(eventBus)+"-0"))
+		assert.NoError(t, err)
+		eventBus.Spec.NATS = nil
+		eventBus.Spec.JetStream = &v1alpha1.JetStreamBus{Version: "2.7.3"}
+		eventBus.Spec.MigrationPolicy = policy
+		return cl, eventBus
+	}
+	exists := func(cl client.Client, name string, obj client.Object) bool {
+		err := cl.Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: name}, obj)
+		if apierrors.IsNotFound(err) {
+			return false
+		}
+		assert.NoError(t, err)
+		return true
+	}
+
+	t.Run("immediate", func(t *testing.T) {
+		cl, eventBus := flip(t, "")
+		err := Install(ctx, eventBus, cl, fakeConfig, zaptest.NewLogger(t).Sugar())
+		assert.NoError(t, err)
+		assert.False(t, eventBus.Status.IsMigrating())
+		assert.NotNil(t, eventBus.Status.Config.JetStream)
+		assert.False(t, exists(cl, generateStatefulSetName(eventBus), &appv1.StatefulSet{}))
+		assert.False(t, exists(cl, generateServiceName(eventBus), &corev1.Service{}))
+		assert.False(t, exists(cl, generateConfigMapName(eventBus), &corev1.ConfigMap{}))
+		assert.False(t, exists(cl, generateServerAuthSecretName(eventBus), &corev1.Secret{}))
+		assert.False(t, exists(cl, generateClientAuthSecretName(eventBus), &corev1.Secret{}))
+		assert.False(t, exists(cl, generatePVCName(eventBus)+"-"+generateStatefulSetName(eventBus)+"-0", &corev1.PersistentVolumeClaim{}))
+		assert.True(t, exists(cl, generateJetStreamPVCName(eventBus)+"-"+generateJetStreamStatefulSetName(eventBus)+"-0", &corev1.PersistentVolumeClaim{}))
+		assert.True(t, exists(cl, generateJetStreamStatefulSetName(eventBus), &appv1.StatefulSet{}))
+	})
+
+	t.Run("wait for ready", func(t *testing.T) {
+		cl, eventBus := flip(t, v1alpha1.MigrationPolicyWaitForReady)
+		err := Install(ctx, eventBus, cl, fakeConfig, zaptest.NewLogger(t).Sugar())
+		assert.NoError(t, err)
+		assert.True(t, eventBus.Status.IsMigrating())
+		assert.True(t, exists(cl, generateStatefulSetName(eventBus), &appv1.StatefulSet{}))
+		assert.True(t, exists(cl, generatePVCName(eventBus)+"-"+generateStatefulSetName(eventBus)+"-0", &corev1.PersistentVolumeClaim{}))
+
+		js := &appv1.StatefulSet{}
+		assert.True(t, exists(cl, generateJetStreamStatefulSetName(eventBus), js))
+		js.Status.ReadyReplicas = *js.Spec.Replicas
+		err = cl.Status().Update(ctx, js)
+		assert.NoError(t, err)
+		err = Install(ctx, eventBus, cl, fakeConfig, zaptest.NewLogger(t).Sugar())
+		assert.NoError(t, err)
+		assert.False(t, eventBus.Status.IsMigrating())
+		assert.False(t, exists(cl, generateStatefulSetName(eventBus), &appv1.StatefulSet{}))
+		assert.False(t, exists(cl, generatePVCName(eventBus)+"-"+generateStatefulSetName(eventBus)+"-0", &corev1.PersistentVolumeClaim{}))
+	})
+
+	t.Run("not migrating", func(t *testing.T) {
+		eventBus := testJetStreamEventBus.DeepCopy()
+		eventBus.Spec.MigrationPolicy = v1alpha1.MigrationPolicyWaitForReady
+		cl := fake.NewClientBuilder().Build()
+		err := Install(ctx, eventBus, cl, fakeConfig, zaptest.NewLogger(t).Sugar())
+		assert.NoError(t, err)
+		assert.False(t, eventBus.Status.IsMigrating())
+	})
+}
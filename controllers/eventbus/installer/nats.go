@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"path"
 	"strconv"
 	"strings"
 	"time"
@@ -38,6 +39,8 @@ const (
 	serverAuthSecretKey = "auth"
 	// key of stan.conf in the configmap
 	configMapKey = "stan-config"
+	// mount path of the config volume of nats streaming
+	stanConfigDir = "/etc/stan-config"
 
 	// default nats streaming version to be installed
 	defaultNatsStreamingVersion = "0.22.1"
@@ -92,13 +95,19 @@ func (i *natsInstaller) Install(ctx context.Context) (*v1alpha1.BusConfig, error
 	if err := i.createStatefulSet(ctx, svc.Name, cm.Name, serverAuthSecret.Name); err != nil {
 		return nil, err
 	}
-	i.eventBus.Status.MarkDeployed("Succeeded", "NATS is deployed")
+	if err := markDeployed(ctx, i.client, i.eventBus, generateStatefulSetName(i.eventBus), "NATS is deployed"); err != nil {
+		i.logger.Errorw("failed to check the rollout of the statefulset", zap.Error(err))
+		i.eventBus.Status.MarkDeployFailed("GetStatefulSetFailed", "Failed to get the statefulset")
+		return nil, err
+	}
+	ebConfig := i.config.GetEventBusConfig()
 	clusterID := generateClusterID(i.eventBus)
 	busConfig := &v1alpha1.BusConfig{
 		NATS: &v1alpha1.NATSConfig{
 			URL:       fmt.Sprintf("nats://%s:%s", generateServiceName(i.eventBus), strconv.Itoa(int(clientPort))),
 			ClusterID: &clusterID,
 			Auth:      authStrategy,
+			TLS:       busTLSConfig(ebConfig.GetTLS()),
 		},
 	}
 	if *authStrategy != v1alpha1.AuthStrategyNone {
@@ -370,10 +379,19 @@ func (i *natsInstaller) createStatefulSet(ctx context.Context, serviceName, conf
 	}
 	expectedSs, err := i.buildStatefulSet(serviceName, configmapName, authSecretName)
 	if err != nil {
-		i.eventBus.Status.MarkDeployFailed("BuildStatefulSetFailed", "Failed to build a statefulset spec")
+		if reason, ok := controllers.VersionErrorReason(err); ok {
+			i.eventBus.Status.MarkDeployFailed(reason, err.Error())
+		} else {
+			i.eventBus.Status.MarkDeployFailed("BuildStatefulSetFailed", "Failed to build a statefulset spec")
+		}
 		log.Errorw("error building statefulset spec", zap.Error(err))
 		return err
 	}
+	if ss == nil {
+		if ss, err = i.adoptStatefulSet(ctx, expectedSs); err != nil {
+			return err
+		}
+	}
 	if ss != nil {
 		if ss.Annotations != nil && ss.Annotations[common.AnnotationResourceSpecHash] == expectedSs.Annotations[common.AnnotationResourceSpecHash] {
 			return nil
@@ -497,8 +515,10 @@ func (i *natsInstaller) buildConfigMap() (*corev1.ConfigMap, error) {
 		peers = append(peers, fmt.Sprintf("\"%s-%s\"", ssName, strconv.Itoa(j)))
 		routes = append(routes, fmt.Sprintf("nats://%s-%s.%s.%s.svc:%s", ssName, strconv.Itoa(j), svcName, i.eventBus.Namespace, strconv.Itoa(int(clusterPort))))
 	}
+	tls := i.config.GetEventBusConfig().GetTLS()
 	conf := fmt.Sprintf(`http: %s
 include ./auth.conf
+%s
 cluster {
   port: %s
   routes: [%s]
@@ -519,13 +539,14 @@ streaming {
 	raft_lease_timeout: "%s"
 	raft_commit_timeout: "%s"
   }
+  %s
   store_limits {
     max_age: %s
 	max_msgs: %v
 	max_bytes: %s
 	max_subs: %v
   }
-}`, strconv.Itoa(int(monitorPort)), strconv.Itoa(int(clusterPort)), strings.Join(routes, ","), maxPayload, clusterID, strings.Join(peers, ","), raftHeartbeatTimeout, raftElectionTimeout, raftLeaseTimeout, raftCommitTimeout, maxAge, maxMsgs, maxBytes, maxSubs)
+}`, strconv.Itoa(int(monitorPort)), serverTLSConfig(tls, stanConfigDir), strconv.Itoa(int(clusterPort)), strings.Join(routes, ","), maxPayload, clusterID, strings.Join(peers, ","), raftHeartbeatTimeout, raftElectionTimeout, raftLeaseTimeout, raftCommitTimeout, streamingTLSConfig(tls, stanConfigDir), maxAge, maxMsgs, maxBytes, maxSubs)
 	cm := &corev1.ConfigMap{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace: i.eventBus.Namespace,
@@ -616,6 +637,7 @@ func (i *natsInstaller) buildStatefulSetSpec(serviceName, configmapName, authSec
 	if err != nil {
 		return nil, fmt.Errorf("failed to get nats streaming version, err: %w", err)
 	}
+	ebConfig := i.config.GetEventBusConfig()
 	// Streaming requires minimal size 3.
 	replicas := i.eventBus.Spec.NATS.Native.Replicas
 	if replicas < 3 {
@@ -623,17 +645,19 @@ func (i *natsInstaller) buildStatefulSetSpec(serviceName, configmapName, authSec
 	}
 	var stanContainerPullPolicy, metricsContainerPullPolicy corev1.PullPolicy
 	var stanContainerSecurityContext, metricsContainerSecurityContext *corev1.SecurityContext
-	stanContainerResources := corev1.ResourceRequirements{
-		Requests: corev1.ResourceList{
-			corev1.ResourceCPU: apiresource.MustParse("0"),
-		},
-	}
+	stanContainerResources := corev1.ResourceRequirements{}
 	containerTmpl := i.eventBus.Spec.NATS.Native.ContainerTemplate
 	if containerTmpl != nil {
 		stanContainerResources = containerTmpl.Resources
 		stanContainerPullPolicy = containerTmpl.ImagePullPolicy
 		stanContainerSecurityContext = containerTmpl.SecurityContext
 	}
+	stanContainerResources = ebConfig.GetMainResources(stanContainerResources)
+	if containerTmpl == nil && len(stanContainerResources.Requests) == 0 && len(stanContainerResources.Limits) == 0 {
+		stanContainerResources.Requests = corev1.ResourceList{
+			corev1.ResourceCPU: apiresource.MustParse("0"),
+		}
+	}
 	metricsContainerResources := corev1.ResourceRequirements{}
 	metricsContainerTmpl := i.eventBus.Spec.NATS.Native.MetricsContainerTemplate
 	if metricsContainerTmpl != nil {
@@ -641,6 +665,7 @@ func (i *natsInstaller) buildStatefulSetSpec(serviceName, configmapName, authSec
 		metricsContainerPullPolicy = metricsContainerTmpl.ImagePullPolicy
 		metricsContainerSecurityContext = metricsContainerTmpl.SecurityContext
 	}
+	metricsContainerResources = ebConfig.GetMetricsResources(metricsContainerResources)
 
 	podTemplateLabels := make(map[string]string)
 	if i.eventBus.Spec.NATS.Native.Metadata != nil &&
@@ -666,7 +691,7 @@ func (i *natsInstaller) buildStatefulSetSpec(serviceName, configmapName, authSec
 				NodeSelector:       i.eventBus.Spec.NATS.Native.NodeSelector,
 				Tolerations:        i.eventBus.Spec.NATS.Native.Tolerations,
 				SecurityContext:    i.eventBus.Spec.NATS.Native.SecurityContext,
-				ImagePullSecrets:   i.eventBus.Spec.NATS.Native.ImagePullSecrets,
+				ImagePullSecrets:   ebConfig.GetImagePullSecrets(i.eventBus.Spec.NATS.Native.ImagePullSecrets),
 				ServiceAccountName: i.eventBus.Spec.NATS.Native.ServiceAccountName,
 				PriorityClassName:  i.eventBus.Spec.NATS.Native.PriorityClassName,
 				Priority:           i.eventBus.Spec.NATS.Native.Priority,
@@ -676,7 +701,7 @@ func (i *natsInstaller) buildStatefulSetSpec(serviceName, configmapName, authSec
 						Name: "config-volume",
 						VolumeSource: corev1.VolumeSource{
 							Projected: &corev1.ProjectedVolumeSource{
-								Sources: []corev1.VolumeProjection{
+								Sources: append([]corev1.VolumeProjection{
 									{
 										ConfigMap: &corev1.ConfigMapProjection{
 											LocalObjectReference: corev1.LocalObjectReference{
@@ -703,7 +728,7 @@ func (i *natsInstaller) buildStatefulSetSpec(serviceName, configmapName, authSec
 											},
 										},
 									},
-								},
+								}, tlsVolumeProjections(ebConfig.GetTLS())...),
 							},
 						},
 					},
@@ -711,21 +736,21 @@ func (i *natsInstaller) buildStatefulSetSpec(serviceName, configmapName, authSec
 				Containers: []corev1.Container{
 					{
 						Name:            "stan",
-						Image:           natsStreamingVersion.NatsStreamingImage,
+						Image:           ebConfig.GetImage(natsStreamingVersion.NatsStreamingImage),
 						ImagePullPolicy: stanContainerPullPolicy,
 						Ports: []corev1.ContainerPort{
 							{Name: "client", ContainerPort: clientPort},
 							{Name: "cluster", ContainerPort: clusterPort},
 							{Name: "monitor", ContainerPort: monitorPort},
 						},
-						Command: []string{"/nats-streaming-server", "-sc", "/etc/stan-config/stan.conf"},
+						Command: []string{"/nats-streaming-server", "-sc", path.Join(stanConfigDir, "stan.conf")},
 						Env: []corev1.EnvVar{
 							{Name: "POD_NAME", ValueFrom: &corev1.EnvVarSource{FieldRef: &corev1.ObjectFieldSelector{FieldPath: "metadata.name"}}},
 							{Name: "POD_NAMESPACE", ValueFrom: &corev1.EnvVarSource{FieldRef: &corev1.ObjectFieldSelector{FieldPath: "metadata.namespace"}}},
 							{Name: "CLUSTER_ADVERTISE", Value: "$(POD_NAME)." + generateServiceName(i.eventBus) + ".$(POD_NAMESPACE).svc"},
 						},
 						VolumeMounts: []corev1.VolumeMount{
-							{Name: "config-volume", MountPath: "/etc/stan-config"},
+							{Name: "config-volume", MountPath: stanConfigDir},
 						},
 						Resources:       stanContainerResources,
 						SecurityContext: stanContainerSecurityContext,
@@ -742,7 +767,7 @@ func (i *natsInstaller) buildStatefulSetSpec(serviceName, configmapName, authSec
 					},
 					{
 						Name:            "metrics",
-						Image:           natsStreamingVersion.MetricsExporterImage,
+						Image:           ebConfig.GetImage(natsStreamingVersion.MetricsExporterImage),
 						ImagePullPolicy: metricsContainerPullPolicy,
 						Ports: []corev1.ContainerPort{
 							{Name: "metrics", ContainerPort: common.EventBusMetricsPort},
@@ -762,10 +787,7 @@ func (i *natsInstaller) buildStatefulSetSpec(serviceName, configmapName, authSec
 		volMode := corev1.PersistentVolumeFilesystem
 		pvcName := generatePVCName(i.eventBus)
 		// Default volume size
-		volSize := apiresource.MustParse("10Gi")
-		if i.eventBus.Spec.NATS.Native.Persistence.VolumeSize != nil {
-			volSize = *i.eventBus.Spec.NATS.Native.Persistence.VolumeSize
-		}
+		volSize := ebConfig.GetVolumeSize(i.eventBus.Spec.NATS.Native.Persistence.VolumeSize, "10Gi")
 		// Default to ReadWriteOnce
 		accessMode := corev1.ReadWriteOnce
 		if i.eventBus.Spec.NATS.Native.Persistence.AccessMode != nil {
@@ -781,7 +803,7 @@ func (i *natsInstaller) buildStatefulSetSpec(serviceName, configmapName, authSec
 						accessMode,
 					},
 					VolumeMode:       &volMode,
-					StorageClassName: i.eventBus.Spec.NATS.Native.Persistence.StorageClassName,
+					StorageClassName: ebConfig.GetStorageClassName(i.eventBus.Spec.NATS.Native.Persistence.StorageClassName),
 					Resources: corev1.ResourceRequirements{
 						Requests: corev1.ResourceList{
 							corev1.ResourceStorage: volSize,
@@ -893,6 +915,38 @@ func (i *natsInstaller) getStatefulSet(ctx context.Context) (*appv1.StatefulSet,
 	return nil, apierrors.NewNotFound(schema.GroupResource{}, "")
 }
 
+// adoptStatefulSet takes over the existing StatefulSet of the name of the expected one if it carries the adopt
+// annotation, and returns nil if there is none. The adopted StatefulSet is kept as is, rather than replaced,
+// until the spec of the EventBus changes.
+func (i *natsInstaller) adoptStatefulSet(ctx context.Context, expectedSs *appv1.StatefulSet) (*appv1.StatefulSet, error) {
+	ss := &appv1.StatefulSet{}
+	if err := i.client.Get(ctx, client.ObjectKeyFromObject(expectedSs), ss); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		i.eventBus.Status.MarkDeployFailed("GetStatefulSetFailed", "Failed to get existing statefulset")
+		i.logger.Errorw("error getting existing statefulset", zap.Error(err))
+		return nil, err
+	}
+	if metav1.IsControlledBy(ss, i.eventBus) {
+		return ss, nil
+	}
+	if ss.Annotations != nil {
+		// The adopted StatefulSet is kept as is rather than replaced, its hash is only saved once adopted.
+		ss.Annotations[common.AnnotationResourceSpecHash] = expectedSs.Annotations[common.AnnotationResourceSpecHash]
+	}
+	if err := adoptStatefulSet(ctx, i.client, i.eventBus, ss, i.config.GetEventBusConfig().GetAdoptAnnotation(), i.mergeEventBusLabels(i.labels), i.logger); err != nil {
+		reason, ok := adoptionReason(err)
+		if !ok {
+			reason = "AdoptStatefulSetFailed"
+		}
+		i.eventBus.Status.MarkDeployFailed(reason, err.Error())
+		i.logger.Errorw("error adopting an existing statefulset", zap.Error(err))
+		return nil, err
+	}
+	return ss, nil
+}
+
 // get PVCs created by streaming statefulset
 // they have same labels as the statefulset
 func (i *natsInstaller) getPVCs(ctx context.Context, labels map[string]string) ([]corev1.PersistentVolumeClaim, error) {
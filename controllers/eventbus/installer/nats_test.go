@@ -11,12 +11,15 @@ import (
 	"go.uber.org/zap/zaptest"
 	appv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	apiresource "k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/scheme"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	"github.com/argoproj/argo-events/common"
 	"github.com/argoproj/argo-events/common/logging"
+	"github.com/argoproj/argo-events/controllers"
 	"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
 )
 
@@ -170,6 +173,59 @@ func TestInstallationAuthtoken(t *testing.T) {
 	})
 }
 
+func TestInstallationAdoptStatefulSet(t *testing.T) {
+	existing := func(annotations map[string]string) *appv1.StatefulSet {
+		return &appv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   testNamespace,
+				Name:        generateStatefulSetName(testNatsEventBus),
+				Annotations: annotations,
+			},
+			Spec: appv1.StatefulSetSpec{
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "stan", Image: "nats-streaming:migrated"}}},
+				},
+			},
+		}
+	}
+
+	t.Run("adopt allowed", func(t *testing.T) {
+		testObj := testNatsEventBus.DeepCopy()
+		cl := fake.NewClientBuilder().WithObjects(existing(map[string]string{controllers.DefaultAdoptAnnotation: testObj.Name})).Build()
+		installer := NewNATSInstaller(cl, testObj, fakeConfig, testLabels, zaptest.NewLogger(t).Sugar())
+		_, err := installer.Install(context.TODO())
+		assert.NoError(t, err)
+		// The adopted statefulset is kept rather than recreated.
+		for i := 0; i < 2; i++ {
+			ssList := &appv1.StatefulSetList{}
+			assert.NoError(t, cl.List(context.TODO(), ssList, &client.ListOptions{Namespace: testNamespace}))
+			assert.Len(t, ssList.Items, 1)
+			ss := ssList.Items[0]
+			assert.True(t, metav1.IsControlledBy(&ss, testObj))
+			assert.Equal(t, "test-controller", ss.Labels["controller"])
+			assert.Equal(t, "nats-streaming:migrated", ss.Spec.Template.Spec.Containers[0].Image)
+			assert.Contains(t, ss.Annotations, common.AnnotationResourceSpecHash)
+			_, err = installer.Install(context.TODO())
+			assert.NoError(t, err)
+		}
+	})
+
+	t.Run("adopt denied", func(t *testing.T) {
+		testObj := testNatsEventBus.DeepCopy()
+		cl := fake.NewClientBuilder().WithObjects(existing(nil)).Build()
+		installer := NewNATSInstaller(cl, testObj, fakeConfig, testLabels, zaptest.NewLogger(t).Sugar())
+		_, err := installer.Install(context.TODO())
+		assert.Error(t, err)
+		c := testObj.Status.GetCondition(v1alpha1.EventBusConditionDeployed)
+		assert.NotNil(t, c)
+		assert.Equal(t, "StatefulSetAdoptionDenied", c.Reason)
+		ss := &appv1.StatefulSet{}
+		assert.NoError(t, cl.Get(context.TODO(), client.ObjectKey{Namespace: testNamespace, Name: generateStatefulSetName(testObj)}, ss))
+		assert.Empty(t, ss.OwnerReferences)
+		assert.Equal(t, "nats-streaming:migrated", ss.Spec.Template.Spec.Containers[0].Image)
+	})
+}
+
 func TestInstallationAuthNone(t *testing.T) {
 	t.Run("auth none installation", func(t *testing.T) {
 		cl := fake.NewClientBuilder().Build()
@@ -221,6 +277,65 @@ func TestBuildPersistStatefulSetSpec(t *testing.T) {
 		assert.True(t, len(ss.Spec.VolumeClaimTemplates) > 0)
 	})
 
+	t.Run("installation with persistence defaults", func(t *testing.T) {
+		eb := *fakeConfig.EventBus
+		eb.Persistence = &controllers.EventBusPersistenceConfig{StorageClassName: "standard", Size: "50Gi"}
+		installer := &natsInstaller{
+			client:   fake.NewClientBuilder().Build(),
+			eventBus: testEventBusPersist.DeepCopy(),
+			config:   &controllers.GlobalConfig{EventBus: &eb},
+			labels:   testLabels,
+			logger:   logging.NewArgoEventsLogger(),
+		}
+		ss, err := installer.buildStatefulSet("svcName", "cmName", "secretName")
+		assert.NoError(t, err)
+		assert.Len(t, ss.Spec.VolumeClaimTemplates, 1)
+		pvc := ss.Spec.VolumeClaimTemplates[0]
+		assert.Equal(t, "standard", *pvc.Spec.StorageClassName)
+		assert.Equal(t, apiresource.MustParse("50Gi"), pvc.Spec.Resources.Requests[corev1.ResourceStorage])
+
+		// The persistence of the EventBus takes precedence over the defaults.
+		st := "fast"
+		size := apiresource.MustParse("5Gi")
+		installer.eventBus.Spec.NATS.Native.Persistence = &v1alpha1.PersistenceStrategy{StorageClassName: &st, VolumeSize: &size}
+		ss, err = installer.buildStatefulSet("svcName", "cmName", "secretName")
+		assert.NoError(t, err)
+		pvc = ss.Spec.VolumeClaimTemplates[0]
+		assert.Equal(t, "fast", *pvc.Spec.StorageClassName)
+		assert.Equal(t, size, pvc.Spec.Resources.Requests[corev1.ResourceStorage])
+	})
+
+	t.Run("installation with resource defaults", func(t *testing.T) {
+		eb := *fakeConfig.EventBus
+		eb.Resources = &controllers.EventBusResourcesConfig{
+			Main:    &controllers.ContainerResourcesConfig{Requests: map[string]string{"cpu": "100m"}, Limits: map[string]string{"memory": "1Gi"}},
+			Metrics: &controllers.ContainerResourcesConfig{Limits: map[string]string{"memory": "64Mi"}},
+		}
+		installer := &natsInstaller{
+			client:   fake.NewClientBuilder().Build(),
+			eventBus: testNatsEventBus.DeepCopy(),
+			config:   &controllers.GlobalConfig{EventBus: &eb},
+			labels:   testLabels,
+			logger:   logging.NewArgoEventsLogger(),
+		}
+		ss, err := installer.buildStatefulSet("svcName", "cmName", "secretName")
+		assert.NoError(t, err)
+		stan := ss.Spec.Template.Spec.Containers[0].Resources
+		assert.Equal(t, apiresource.MustParse("100m"), stan.Requests[corev1.ResourceCPU])
+		assert.Equal(t, apiresource.MustParse("1Gi"), stan.Limits[corev1.ResourceMemory])
+		assert.Equal(t, apiresource.MustParse("64Mi"), ss.Spec.Template.Spec.Containers[1].Resources.Limits[corev1.ResourceMemory])
+
+		// The container template of the EventBus takes precedence over the defaults.
+		installer.eventBus.Spec.NATS.Native.ContainerTemplate = &v1alpha1.ContainerTemplate{
+			Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{corev1.ResourceCPU: apiresource.MustParse("1")}},
+		}
+		ss, err = installer.buildStatefulSet("svcName", "cmName", "secretName")
+		assert.NoError(t, err)
+		stan = ss.Spec.Template.Spec.Containers[0].Resources
+		assert.Equal(t, apiresource.MustParse("1"), stan.Requests[corev1.ResourceCPU])
+		assert.Equal(t, apiresource.MustParse("1Gi"), stan.Limits[corev1.ResourceMemory])
+	})
+
 	t.Run("installation with image pull secrets", func(t *testing.T) {
 		cl := fake.NewClientBuilder().Build()
 		installer := &natsInstaller{
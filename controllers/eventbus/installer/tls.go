@@ -0,0 +1,102 @@
+package installer
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/argoproj/argo-events/controllers"
+	"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
+)
+
+// Paths of the TLS certificates in the config volume of the servers
+const (
+	tlsCACertPath = "tls/ca.crt"
+	tlsCertPath   = "tls/tls.crt"
+	tlsKeyPath    = "tls/tls.key"
+)
+
+// tlsVolumeProjections returns the projections of the TLS secrets into the config volume of the servers
+func tlsVolumeProjections(tls *controllers.EventBusTLSConfig) []corev1.VolumeProjection {
+	if tls == nil {
+		return nil
+	}
+	result := []corev1.VolumeProjection{
+		secretProjection(tls.CertSecret, tlsCertPath),
+		secretProjection(tls.KeySecret, tlsKeyPath),
+	}
+	if tls.CACertSecret != nil {
+		result = append(result, secretProjection(tls.CACertSecret, tlsCACertPath))
+	}
+	return result
+}
+
+func secretProjection(secret *corev1.SecretKeySelector, path string) corev1.VolumeProjection {
+	return corev1.VolumeProjection{
+		Secret: &corev1.SecretProjection{
+			LocalObjectReference: secret.LocalObjectReference,
+			Items:                []corev1.KeyToPath{{Key: secret.Key, Path: path}},
+		},
+	}
+}
+
+// serverTLSConfig renders the tls block of the server config, with the clients required to
+// present a certificate, e.g.
+//
+//	tls {
+//	  cert_file: "/etc/nats-config/tls/tls.crt"
+//	  key_file: "/etc/nats-config/tls/tls.key"
+//	  ca_file: "/etc/nats-config/tls/ca.crt"
+//	  verify: true
+//	}
+func serverTLSConfig(tls *controllers.EventBusTLSConfig, configDir string) string {
+	if tls == nil {
+		return ""
+	}
+	var b strings.Builder
+	b.WriteString("tls {\n")
+	fmt.Fprintf(&b, "  cert_file: %q\n", path.Join(configDir, tlsCertPath))
+	fmt.Fprintf(&b, "  key_file: %q\n", path.Join(configDir, tlsKeyPath))
+	if tls.CACertSecret != nil {
+		fmt.Fprintf(&b, "  ca_file: %q\n", path.Join(configDir, tlsCACertPath))
+	}
+	b.WriteString("  verify: true\n")
+	if tls.MinVersion != "" {
+		fmt.Fprintf(&b, "  min_version: %q\n", tls.MinVersion)
+	}
+	b.WriteString("}")
+	return b.String()
+}
+
+// streamingTLSConfig renders the tls block of the streaming config, the streaming server connecting
+// to its embedded NATS server as a client.
+func streamingTLSConfig(tls *controllers.EventBusTLSConfig, configDir string) string {
+	if tls == nil {
+		return ""
+	}
+	var b strings.Builder
+	b.WriteString("tls {\n")
+	fmt.Fprintf(&b, "    client_cert: %q\n", path.Join(configDir, tlsCertPath))
+	fmt.Fprintf(&b, "    client_key: %q\n", path.Join(configDir, tlsKeyPath))
+	if tls.CACertSecret != nil {
+		fmt.Fprintf(&b, "    client_ca: %q\n", path.Join(configDir, tlsCACertPath))
+	}
+	b.WriteString("  }")
+	return b.String()
+}
+
+// busTLSConfig returns the TLS configuration of the clients
+func busTLSConfig(tls *controllers.EventBusTLSConfig) *v1alpha1.BusTLSConfig {
+	if tls == nil {
+		return nil
+	}
+	return &v1alpha1.BusTLSConfig{
+		CACertSecret:       tls.CACertSecret.DeepCopy(),
+		CertSecret:         tls.CertSecret.DeepCopy(),
+		KeySecret:          tls.KeySecret.DeepCopy(),
+		InsecureSkipVerify: tls.InsecureSkipVerify,
+		MinVersion:         tls.MinVersion,
+	}
+}
@@ -0,0 +1,143 @@
+package installer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/controllers"
+	"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
+)
+
+var testTLSConfig = &controllers.EventBusTLSConfig{
+	CACertSecret: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "eventbus-ca"}, Key: "ca.crt"},
+	CertSecret:   &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "eventbus-tls"}, Key: "tls.crt"},
+	KeySecret:    &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "eventbus-tls"}, Key: "tls.key"},
+	MinVersion:   "1.3",
+}
+
+// tlsFakeConfig returns the fake config with mutual TLS enabled
+func tlsFakeConfig() *controllers.GlobalConfig {
+	eb := *fakeConfig.EventBus
+	eb.TLS = testTLSConfig
+	return &controllers.GlobalConfig{EventBus: &eb}
+}
+
+func TestServerTLSConfig(t *testing.T) {
+	assert.Equal(t, "", serverTLSConfig(nil, jsConfigDir))
+	assert.Equal(t, `tls {
+  cert_file: "/etc/nats-config/tls/tls.crt"
+  key_file: "/etc/nats-config/tls/tls.key"
+  ca_file: "/etc/nats-config/tls/ca.crt"
+  verify: true
+  min_version: "1.3"
+}`, serverTLSConfig(testTLSConfig, jsConfigDir))
+
+	withoutCA := *testTLSConfig
+	withoutCA.CACertSecret = nil
+	withoutCA.MinVersion = ""
+	assert.Equal(t, `tls {
+  cert_file: "/etc/nats-config/tls/tls.crt"
+  key_file: "/etc/nats-config/tls/tls.key"
+  verify: true
+}`, serverTLSConfig(&withoutCA, jsConfigDir))
+}
+
+func TestTLSVolumeProjections(t *testing.T) {
+	assert.Nil(t, tlsVolumeProjections(nil))
+	projections := tlsVolumeProjections(testTLSConfig)
+	assert.Equal(t, []corev1.VolumeProjection{
+		{Secret: &corev1.SecretProjection{LocalObjectReference: corev1.LocalObjectReference{Name: "eventbus-tls"}, Items: []corev1.KeyToPath{{Key: "tls.crt", Path: tlsCertPath}}}},
+		{Secret: &corev1.SecretProjection{LocalObjectReference: corev1.LocalObjectReference{Name: "eventbus-tls"}, Items: []corev1.KeyToPath{{Key: "tls.key", Path: tlsKeyPath}}}},
+		{Secret: &corev1.SecretProjection{LocalObjectReference: corev1.LocalObjectReference{Name: "eventbus-ca"}, Items: []corev1.KeyToPath{{Key: "ca.crt", Path: tlsCACertPath}}}},
+	}, projections)
+}
+
+func TestNATSInstallerTLS(t *testing.T) {
+	installer := &natsInstaller{
+		client:   fake.NewClientBuilder().Build(),
+		eventBus: testNatsEventBus,
+		config:   tlsFakeConfig(),
+		labels:   testLabels,
+		logger:   zaptest.NewLogger(t).Sugar(),
+	}
+
+	t.Run("renders the server config", func(t *testing.T) {
+		cm, err := installer.buildConfigMap()
+		assert.NoError(t, err)
+		conf := cm.Data[configMapKey]
+		assert.Contains(t, conf, serverTLSConfig(testTLSConfig, stanConfigDir))
+		assert.Contains(t, conf, `client_cert: "/etc/stan-config/tls/tls.crt"`)
+		assert.Contains(t, conf, `client_key: "/etc/stan-config/tls/tls.key"`)
+		assert.Contains(t, conf, `client_ca: "/etc/stan-config/tls/ca.crt"`)
+
+		installer.config = fakeConfig
+		cm, err = installer.buildConfigMap()
+		assert.NoError(t, err)
+		assert.NotContains(t, cm.Data[configMapKey], "tls {")
+		installer.config = tlsFakeConfig()
+	})
+
+	t.Run("mounts the certificates", func(t *testing.T) {
+		ss, err := installer.buildStatefulSet("svcName", "cmName", "secretName")
+		assert.NoError(t, err)
+		sources := ss.Spec.Template.Spec.Volumes[0].Projected.Sources
+		assert.Len(t, sources, 5)
+		assert.Equal(t, tlsVolumeProjections(testTLSConfig), sources[2:])
+	})
+
+	t.Run("publishes the client config", func(t *testing.T) {
+		busConfig, err := installer.Install(context.TODO())
+		assert.NoError(t, err)
+		assert.Equal(t, &v1alpha1.BusTLSConfig{
+			CACertSecret: testTLSConfig.CACertSecret,
+			CertSecret:   testTLSConfig.CertSecret,
+			KeySecret:    testTLSConfig.KeySecret,
+			MinVersion:   "1.3",
+		}, busConfig.NATS.TLS)
+	})
+}
+
+func TestJetStreamInstallerTLS(t *testing.T) {
+	cl := fake.NewClientBuilder().Build()
+	i := &jetStreamInstaller{
+		client:   cl,
+		eventBus: testJetStreamEventBus.DeepCopy(),
+		config:   tlsFakeConfig(),
+		labels:   testLabels,
+		logger:   zaptest.NewLogger(t).Sugar(),
+	}
+
+	t.Run("renders the server config", func(t *testing.T) {
+		assert.NoError(t, i.createConfigMap(context.TODO()))
+		c := &corev1.ConfigMap{}
+		assert.NoError(t, cl.Get(context.TODO(), types.NamespacedName{Namespace: testNamespace, Name: generateJetStreamConfigMapName(i.eventBus)}, c))
+		assert.Contains(t, c.Data[common.JetStreamConfigMapKey], serverTLSConfig(testTLSConfig, jsConfigDir))
+	})
+
+	t.Run("mounts the certificates", func(t *testing.T) {
+		s, err := i.buildStatefulSetSpec(&fakeConfig.EventBus.JetStream.Versions[0])
+		assert.NoError(t, err)
+		var sources []corev1.VolumeProjection
+		for _, v := range s.Template.Spec.Volumes {
+			if v.Name == "config-volume" {
+				sources = v.Projected.Sources
+			}
+		}
+		assert.Equal(t, tlsVolumeProjections(testTLSConfig), sources[2:])
+	})
+
+	t.Run("publishes the client config", func(t *testing.T) {
+		busConfig, err := i.Install(context.TODO())
+		assert.NoError(t, err)
+		assert.NotNil(t, busConfig.JetStream.TLS)
+		assert.Equal(t, testTLSConfig.CertSecret, busConfig.JetStream.TLS.CertSecret)
+		assert.Equal(t, "1.3", busConfig.JetStream.TLS.MinVersion)
+	})
+}
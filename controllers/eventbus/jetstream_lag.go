@@ -0,0 +1,206 @@
+package eventbus
+
+import (
+	"context"
+	"crypto/tls"
+	"sync"
+	"time"
+
+	nats "github.com/nats-io/nats.go"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
+)
+
+// jetStreamRequestTimeout is the timeout of the connection and the requests to JetStream
+const jetStreamRequestTimeout = 10 * time.Second
+
+// consumerPending is the number of messages of a stream a consumer has not received yet
+type consumerPending struct {
+	stream   string
+	consumer string
+	pending  uint64
+}
+
+// consumerLister lists the pending messages of the consumers of the JetStream at the url,
+// connecting with TLS if the tls config is not nil
+type consumerLister func(ctx context.Context, url, token string, tlsConfig *tls.Config) ([]consumerPending, error)
+
+// ConsumerLagPoller periodically polls the consumers of the JetStream EventBuses, and exposes
+// the number of messages pending for each of them, i.e. how far behind the sensors are.
+type ConsumerLagPoller struct {
+	client   client.Client
+	interval time.Duration
+	logger   *zap.SugaredLogger
+	list     consumerLister
+	desc     *prometheus.Desc
+
+	lock sync.Mutex
+	// pending holds the consumers of each EventBus as of the last successful poll
+	pending map[types.NamespacedName][]consumerPending
+}
+
+// NewConsumerLagPoller returns a poller of the consumers of the JetStream EventBuses, to be added
+// to the manager and registered with the metrics registry.
+func NewConsumerLagPoller(client client.Client, interval time.Duration, logger *zap.SugaredLogger) *ConsumerLagPoller {
+	return &ConsumerLagPoller{
+		client:   client,
+		interval: interval,
+		logger:   logger,
+		list:     listJetStreamConsumers,
+		desc: prometheus.NewDesc("argo_events_jetstream_consumer_pending",
+			"How many messages of the JetStream stream are pending for the consumer.",
+			[]string{"namespace", "eventbus_name", "stream", "consumer"}, nil),
+		pending: make(map[types.NamespacedName][]consumerPending),
+	}
+}
+
+// Start polls the consumers until the context is done, it only runs on the leader.
+func (p *ConsumerLagPoller) Start(ctx context.Context) error {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		p.poll(ctx)
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// poll polls the consumers of all the JetStream EventBuses. The failures are logged and the
+// metrics of the EventBus are dropped until it is polled successfully again.
+func (p *ConsumerLagPoller) poll(ctx context.Context) {
+	ebl := &v1alpha1.EventBusList{}
+	if err := p.client.List(ctx, ebl); err != nil {
+		p.logger.Errorw("failed to list EventBus objects to poll the JetStream consumers", zap.Error(err))
+		return
+	}
+	pending := make(map[types.NamespacedName][]consumerPending)
+	for _, eb := range ebl.Items {
+		js := eb.Status.Config.JetStream
+		if js == nil || js.URL == "" {
+			continue
+		}
+		key := types.NamespacedName{Namespace: eb.Namespace, Name: eb.Name}
+		consumers, err := p.pollEventBus(ctx, eb.Namespace, js)
+		if err != nil {
+			p.logger.Warnw("failed to poll the JetStream consumers", zap.String("namespace", eb.Namespace), zap.String("eventbus", eb.Name), zap.Error(err))
+			continue
+		}
+		pending[key] = consumers
+	}
+	p.lock.Lock()
+	p.pending = pending
+	p.lock.Unlock()
+}
+
+// pollEventBus lists the consumers of the JetStream of an EventBus, authenticated with its client token
+// and its client certificate if any.
+func (p *ConsumerLagPoller) pollEventBus(ctx context.Context, namespace string, js *v1alpha1.JetStreamConfig) ([]consumerPending, error) {
+	var token string
+	if js.Auth != nil && js.Auth.Token != nil {
+		v, err := p.getSecretValue(ctx, namespace, js.Auth.Token)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get the JetStream auth secret")
+		}
+		token = string(v)
+	}
+	var tlsConfig *tls.Config
+	if js.TLS != nil {
+		c, err := p.getTLSConfig(ctx, namespace, js.TLS)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get the JetStream tls config")
+		}
+		tlsConfig = c
+	}
+	ctx, cancel := context.WithTimeout(ctx, jetStreamRequestTimeout)
+	defer cancel()
+	return p.list(ctx, js.URL, token, tlsConfig)
+}
+
+// getTLSConfig returns the tls config of the clients of an EventBus
+func (p *ConsumerLagPoller) getTLSConfig(ctx context.Context, namespace string, config *v1alpha1.BusTLSConfig) (*tls.Config, error) {
+	var caCert []byte
+	if config.CACertSecret != nil {
+		v, err := p.getSecretValue(ctx, namespace, config.CACertSecret)
+		if err != nil {
+			return nil, err
+		}
+		caCert = v
+	}
+	cert, err := p.getSecretValue(ctx, namespace, config.CertSecret)
+	if err != nil {
+		return nil, err
+	}
+	key, err := p.getSecretValue(ctx, namespace, config.KeySecret)
+	if err != nil {
+		return nil, err
+	}
+	return common.NewClientTLSConfig(caCert, cert, key, config.InsecureSkipVerify, config.MinVersion)
+}
+
+func (p *ConsumerLagPoller) getSecretValue(ctx context.Context, namespace string, selector *corev1.SecretKeySelector) ([]byte, error) {
+	secret := &corev1.Secret{}
+	if err := p.client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: selector.Name}, secret); err != nil {
+		return nil, err
+	}
+	v, ok := secret.Data[selector.Key]
+	if !ok {
+		return nil, errors.Errorf("secret %s does not have the key %s", selector.Name, selector.Key)
+	}
+	return v, nil
+}
+
+// Describe implements prometheus.Collector
+func (p *ConsumerLagPoller) Describe(ch chan<- *prometheus.Desc) {
+	ch <- p.desc
+}
+
+// Collect implements prometheus.Collector, reporting the consumers as of the last poll
+func (p *ConsumerLagPoller) Collect(ch chan<- prometheus.Metric) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	for key, consumers := range p.pending {
+		for _, c := range consumers {
+			ch <- prometheus.MustNewConstMetric(p.desc, prometheus.GaugeValue, float64(c.pending), key.Namespace, key.Name, c.stream, c.consumer)
+		}
+	}
+}
+
+// listJetStreamConsumers connects to the JetStream, and lists the consumers of all its streams.
+func listJetStreamConsumers(ctx context.Context, url, token string, tlsConfig *tls.Config) ([]consumerPending, error) {
+	opts := []nats.Option{nats.NoReconnect(), nats.Timeout(jetStreamRequestTimeout)}
+	if token != "" {
+		opts = append(opts, nats.Token(token))
+	}
+	if tlsConfig != nil {
+		opts = append(opts, nats.Secure(tlsConfig))
+	}
+	nc, err := nats.Connect(url, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to connect to JetStream")
+	}
+	defer nc.Close()
+	js, err := nc.JetStream(nats.Context(ctx))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get the JetStream context")
+	}
+	var result []consumerPending
+	for stream := range js.StreamNames() {
+		for info := range js.ConsumersInfo(stream) {
+			result = append(result, consumerPending{stream: stream, consumer: info.Name, pending: info.NumPending})
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, errors.Wrap(err, "timed out listing the JetStream consumers")
+	}
+	return result, nil
+}
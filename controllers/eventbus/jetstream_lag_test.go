@@ -0,0 +1,169 @@
+package eventbus
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
+)
+
+var (
+	jetStreamBus = &v1alpha1.EventBus{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNamespace,
+			Name:      testBusName,
+		},
+		Status: v1alpha1.EventBusStatus{
+			Config: v1alpha1.BusConfig{
+				JetStream: &v1alpha1.JetStreamConfig{
+					URL: "nats://eventbus-test-bus-js-svc.testNamespace.svc.cluster.local:4222",
+					Auth: &v1alpha1.JetStreamAuth{
+						Token: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "eventbus-test-bus-js-client-auth"},
+							Key:                  "client-auth",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	jetStreamAuthSecret = &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNamespace,
+			Name:      "eventbus-test-bus-js-client-auth",
+		},
+		Data: map[string][]byte{"client-auth": []byte("fake-token")},
+	}
+)
+
+func TestConsumerLagPoller(t *testing.T) {
+	t.Run("exposes the pending messages", func(t *testing.T) {
+		cl := fake.NewClientBuilder().WithObjects(jetStreamBus.DeepCopy(), nativeBus.DeepCopy(), jetStreamAuthSecret.DeepCopy()).Build()
+		p := NewConsumerLagPoller(cl, time.Minute, zaptest.NewLogger(t).Sugar())
+		var token string
+		p.list = func(ctx context.Context, url, t string, tlsConfig *tls.Config) ([]consumerPending, error) {
+			token = t
+			return []consumerPending{
+				{stream: "default", consumer: "sensor-a", pending: 3},
+				{stream: "default", consumer: "sensor-b", pending: 0},
+			}, nil
+		}
+		p.poll(context.TODO())
+		assert.Equal(t, "fake-token", token)
+		expected := `
+# HELP argo_events_jetstream_consumer_pending How many messages of the JetStream stream are pending for the consumer.
+# TYPE argo_events_jetstream_consumer_pending gauge
+argo_events_jetstream_consumer_pending{consumer="sensor-a",eventbus_name="test-bus",namespace="testNamespace",stream="default"} 3
+argo_events_jetstream_consumer_pending{consumer="sensor-b",eventbus_name="test-bus",namespace="testNamespace",stream="default"} 0
+`
+		assert.NoError(t, testutil.CollectAndCompare(p, strings.NewReader(expected)))
+	})
+
+	t.Run("tolerates failures", func(t *testing.T) {
+		cl := fake.NewClientBuilder().WithObjects(jetStreamBus.DeepCopy(), jetStreamAuthSecret.DeepCopy()).Build()
+		p := NewConsumerLagPoller(cl, time.Minute, zaptest.NewLogger(t).Sugar())
+		p.list = func(ctx context.Context, url, token string, tlsConfig *tls.Config) ([]consumerPending, error) {
+			return []consumerPending{{stream: "default", consumer: "sensor-a", pending: 3}}, nil
+		}
+		p.poll(context.TODO())
+		assert.Equal(t, 1, testutil.CollectAndCount(p))
+
+		// The metrics of the EventBus are dropped while it can't be polled.
+		p.list = func(ctx context.Context, url, token string, tlsConfig *tls.Config) ([]consumerPending, error) {
+			return nil, errors.New("connection refused")
+		}
+		p.poll(context.TODO())
+		assert.Equal(t, 0, testutil.CollectAndCount(p))
+	})
+
+	t.Run("missing auth secret", func(t *testing.T) {
+		cl := fake.NewClientBuilder().WithObjects(jetStreamBus.DeepCopy()).Build()
+		p := NewConsumerLagPoller(cl, time.Minute, zaptest.NewLogger(t).Sugar())
+		called := false
+		p.list = func(ctx context.Context, url, token string, tlsConfig *tls.Config) ([]consumerPending, error) {
+			called = true
+			return nil, nil
+		}
+		p.poll(context.TODO())
+		assert.False(t, called)
+		assert.Equal(t, 0, testutil.CollectAndCount(p))
+	})
+
+	t.Run("connects with the client certificate", func(t *testing.T) {
+		cert, key := fakeCertificate(t)
+		bus := jetStreamBus.DeepCopy()
+		bus.Status.Config.JetStream.TLS = &v1alpha1.BusTLSConfig{
+			CACertSecret: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "eventbus-tls"}, Key: "ca.crt"},
+			CertSecret:   &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "eventbus-tls"}, Key: "tls.crt"},
+			KeySecret:    &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "eventbus-tls"}, Key: "tls.key"},
+			MinVersion:   "1.3",
+		}
+		tlsSecret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "eventbus-tls"},
+			Data:       map[string][]byte{"ca.crt": cert, "tls.crt": cert, "tls.key": key},
+		}
+		cl := fake.NewClientBuilder().WithObjects(bus, jetStreamAuthSecret.DeepCopy(), tlsSecret).Build()
+		p := NewConsumerLagPoller(cl, time.Minute, zaptest.NewLogger(t).Sugar())
+		var config *tls.Config
+		p.list = func(ctx context.Context, url, token string, tlsConfig *tls.Config) ([]consumerPending, error) {
+			config = tlsConfig
+			return nil, nil
+		}
+		p.poll(context.TODO())
+		assert.NotNil(t, config)
+		assert.Len(t, config.Certificates, 1)
+		assert.NotNil(t, config.RootCAs)
+		assert.Equal(t, uint16(tls.VersionTLS13), config.MinVersion)
+
+		// The EventBus is not polled without its client certificate
+		assert.NoError(t, cl.Delete(context.TODO(), tlsSecret))
+		config = nil
+		p.poll(context.TODO())
+		assert.Nil(t, config)
+	})
+
+	t.Run("stops with the context", func(t *testing.T) {
+		cl := fake.NewClientBuilder().Build()
+		p := NewConsumerLagPoller(cl, time.Millisecond, zaptest.NewLogger(t).Sugar())
+		ctx, cancel := context.WithTimeout(context.TODO(), 20*time.Millisecond)
+		defer cancel()
+		assert.NoError(t, p.Start(ctx))
+	})
+}
+
+// fakeCertificate returns a PEM encoded self-signed certificate and its key
+func fakeCertificate(t *testing.T) ([]byte, []byte) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "eventbus"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NoError(t, err)
+	keyDer, err := x509.MarshalPKCS8PrivateKey(key)
+	assert.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDer})
+}
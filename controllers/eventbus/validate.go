@@ -2,14 +2,31 @@ package eventbus
 
 import (
 	"fmt"
+	"text/template"
 
+	"github.com/nats-io/nats-server/v2/conf"
+
+	"github.com/argoproj/argo-events/controllers"
 	"github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
 )
 
 // ValidateEventBus accepts an EventBus and performs validation against it
 func ValidateEventBus(eb *v1alpha1.EventBus) error {
-	if eb.Spec.NATS == nil && eb.Spec.JetStream == nil {
-		return fmt.Errorf("invalid spec: either \"nats\" or \"jststream\" needs to be specified")
+	busTypes := 0
+	for _, specified := range []bool{eb.Spec.NATS != nil, eb.Spec.JetStream != nil, eb.Spec.Kafka != nil} {
+		if specified {
+			busTypes++
+		}
+	}
+	if busTypes == 0 {
+		return fmt.Errorf("invalid spec: either \"nats\", \"jststream\" or \"kafka\" needs to be specified")
+	}
+	if busTypes > 1 {
+		return fmt.Errorf("invalid spec: only one of \"nats\", \"jststream\" and \"kafka\" can be specified")
+	}
+	if eb.Spec.Kafka != nil {
+		// The event sources and the sensors have no driver to connect to a Kafka EventBus yet.
+		return fmt.Errorf("invalid spec: \"kafka\" eventbus is not supported yet, the event sources and sensors can't connect to it")
 	}
 	if x := eb.Spec.NATS; x != nil {
 		if x.Native != nil && x.Exotic != nil {
@@ -35,6 +52,38 @@ func ValidateEventBus(eb *v1alpha1.EventBus) error {
 		if x.Replicas != nil && *x.Replicas < 3 {
 			return fmt.Errorf("invalid spec: a jetstream eventbus requires at least 3 replicas")
 		}
+		if x.Settings != nil {
+			if _, err := conf.Parse(*x.Settings); err != nil {
+				return fmt.Errorf("invalid spec: \"spec.jetstream.settings\" is not a valid NATS config, %w", err)
+			}
+		}
+		for _, arg := range x.StartCommandArgs {
+			if _, err := template.New("startCommand").Parse(arg); err != nil {
+				return fmt.Errorf("invalid spec: \"spec.jetstream.startCommandArgs\" has an invalid template %q, %w", arg, err)
+			}
+		}
+	}
+	switch eb.Spec.MigrationPolicy {
+	case "", v1alpha1.MigrationPolicyImmediate, v1alpha1.MigrationPolicyWaitForReady:
+	default:
+		return fmt.Errorf("invalid spec: unsupported \"spec.migrationPolicy\" %q", eb.Spec.MigrationPolicy)
+	}
+	switch eb.Spec.Compression {
+	case "", v1alpha1.CompressionNone, v1alpha1.CompressionGzip, v1alpha1.CompressionSnappy, v1alpha1.CompressionZstd:
+	default:
+		return fmt.Errorf("invalid spec: unsupported \"spec.compression\" %q, expected \"none\", \"gzip\", \"snappy\" or \"zstd\"", eb.Spec.Compression)
+	}
+	return nil
+}
+
+// validateAllowed checks the EventBus is not disabled by the controller configuration. The version
+// of a native NATS EventBus is checked when it is installed, and so are the ranges of JetStream versions.
+func validateAllowed(eb *v1alpha1.EventBus, config *controllers.GlobalConfig) error {
+	if eb.Spec.NATS != nil {
+		return config.CheckNatsStreamingAllowed("")
+	}
+	if x := eb.Spec.JetStream; x != nil && !controllers.IsVersionConstraint(x.Version) {
+		return config.CheckJetStreamAllowed(x.Version)
 	}
 	return nil
 }
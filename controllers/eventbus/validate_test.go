@@ -38,6 +38,18 @@ var (
 			},
 		},
 	}
+
+	testKafkaEventBus = &v1alpha1.EventBus{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test-ns",
+			Name:      common.DefaultEventBusName,
+		},
+		Spec: v1alpha1.EventBusSpec{
+			Kafka: &v1alpha1.KafkaBus{
+				URL: "kafka-0:9092,kafka-1:9092",
+			},
+		},
+	}
 )
 
 func TestValidate(t *testing.T) {
@@ -109,4 +121,63 @@ func TestValidate(t *testing.T) {
 		err = ValidateEventBus(eb)
 		assert.NoError(t, err)
 	})
+
+	t.Run("test js eventbus settings", func(t *testing.T) {
+		eb := testJetStreamEventBus.DeepCopy()
+		eb.Spec.JetStream.Settings = pointer.String("max_file_store: {")
+		err := ValidateEventBus(eb)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "\"spec.jetstream.settings\" is not a valid NATS config")
+		eb.Spec.JetStream.Settings = pointer.String("max_file_store: 10G")
+		err = ValidateEventBus(eb)
+		assert.NoError(t, err)
+	})
+
+	t.Run("test js eventbus start command args", func(t *testing.T) {
+		eb := testJetStreamEventBus.DeepCopy()
+		eb.Spec.JetStream.StartCommandArgs = []string{"--cluster_name={{ .ClusterName"}
+		err := ValidateEventBus(eb)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "\"spec.jetstream.startCommandArgs\" has an invalid template")
+		eb.Spec.JetStream.StartCommandArgs = []string{"--cluster_name={{ .ClusterName }}"}
+		err = ValidateEventBus(eb)
+		assert.NoError(t, err)
+	})
+
+	t.Run("test js eventbus migration policy", func(t *testing.T) {
+		eb := testJetStreamEventBus.DeepCopy()
+		eb.Spec.MigrationPolicy = "Eventually"
+		err := ValidateEventBus(eb)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "unsupported \"spec.migrationPolicy\"")
+		eb.Spec.MigrationPolicy = v1alpha1.MigrationPolicyWaitForReady
+		err = ValidateEventBus(eb)
+		assert.NoError(t, err)
+	})
+
+	t.Run("test eventbus compression", func(t *testing.T) {
+		eb := testNatsEventBus.DeepCopy()
+		eb.Spec.Compression = "lz4"
+		err := ValidateEventBus(eb)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "unsupported \"spec.compression\"")
+		for _, codec := range []v1alpha1.CompressionCodec{v1alpha1.CompressionNone, v1alpha1.CompressionGzip, v1alpha1.CompressionSnappy, v1alpha1.CompressionZstd} {
+			eb.Spec.Compression = codec
+			assert.NoError(t, ValidateEventBus(eb))
+		}
+	})
+
+	t.Run("test kafka eventbus", func(t *testing.T) {
+		err := ValidateEventBus(testKafkaEventBus)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "\"kafka\" eventbus is not supported yet")
+	})
+
+	t.Run("test mixed eventbus types", func(t *testing.T) {
+		eb := testKafkaEventBus.DeepCopy()
+		eb.Spec.JetStream = testJetStreamEventBus.Spec.JetStream.DeepCopy()
+		err := ValidateEventBus(eb)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid spec: only one of")
+	})
 }
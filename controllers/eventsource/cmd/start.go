@@ -3,6 +3,7 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"time"
 
 	"go.uber.org/zap"
 	appv1 "k8s.io/api/apps/v1"
@@ -10,7 +11,6 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
-	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/manager/signals"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/source"
@@ -18,6 +18,7 @@ import (
 	argoevents "github.com/argoproj/argo-events"
 	"github.com/argoproj/argo-events/common"
 	"github.com/argoproj/argo-events/common/logging"
+	controllerscommon "github.com/argoproj/argo-events/controllers/common"
 	"github.com/argoproj/argo-events/controllers/eventsource"
 	eventbusv1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
 	eventsourcev1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
@@ -27,7 +28,7 @@ const (
 	eventSourceImageEnvVar = "EVENTSOURCE_IMAGE"
 )
 
-func Start(namespaced bool, managedNamespace string) {
+func Start(namespaced bool, managedNamespace string, reconcileStallThreshold time.Duration) {
 	logger := logging.NewArgoEventsLogger().Named(eventsource.ControllerName)
 	eventSourceImage, defined := os.LookupEnv(eventSourceImageEnvVar)
 	if !defined {
@@ -45,16 +46,7 @@ func Start(namespaced bool, managedNamespace string) {
 		logger.Fatalw("unable to get a controller-runtime manager", zap.Error(err))
 	}
 
-	// Readyness probe
-	if err := mgr.AddReadyzCheck("readiness", healthz.Ping); err != nil {
-		logger.Fatalw("unable add a readiness check", zap.Error(err))
-	}
-
-	// Liveness probe
-	if err := mgr.AddHealthzCheck("liveness", healthz.Ping); err != nil {
-		logger.Fatalw("unable add a health check", zap.Error(err))
-	}
-
+	ctrlOpts.Reconciler = stallDetector.Wrap(eventbus.NewReconciler(mgr.GetClient(), mgr.GetScheme(), config, options.ResyncPeriod, logger))
 	if err := eventsourcev1alpha1.AddToScheme(mgr.GetScheme()); err != nil {
 		logger.Fatalw("unable to add EventSource scheme", zap.Error(err))
 	}
@@ -65,7 +57,7 @@ func Start(namespaced bool, managedNamespace string) {
 
 	// A controller with DefaultControllerRateLimiter
 	c, err := controller.New(eventsource.ControllerName, mgr, controller.Options{
-		Reconciler: eventsource.NewReconciler(mgr.GetClient(), mgr.GetScheme(), eventSourceImage, logger),
+		Reconciler: stallDetector.Wrap(eventsource.NewReconciler(mgr.GetClient(), mgr.GetScheme(), eventSourceImage, logger)),
 	})
 	if err != nil {
 		logger.Fatalw("unable to set up individual controller", zap.Error(err))
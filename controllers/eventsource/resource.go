@@ -248,7 +248,13 @@ func buildDeployment(args *AdaptorArgs, eventBus *eventbusv1alpha1.EventBus) (*a
 	if len(oldVolMounts) > 0 {
 		volMounts = append(volMounts, oldVolMounts...)
 	}
-	volSecrets, volSecretMounts := common.VolumesFromSecretsOrConfigMaps(eventSourceCopy, common.SecretKeySelectorType)
+	// The secrets are not mounted if they are retrieved from another provider, they may not exist in the cluster.
+	// The TLS secrets of the event bus are always mounted, they are in the namespace of the event bus.
+	secretsObjs := []interface{}{eventBus.Status.Config.NATS.TLS}
+	if !common.UsesExternalSecretsProvider(deploymentSpec.Template.Spec.Containers[0].Env) {
+		secretsObjs = []interface{}{eventSourceCopy, eventBus.Status.Config.NATS.TLS}
+	}
+	volSecrets, volSecretMounts := common.VolumesFromSecretsOrConfigMaps(secretsObjs, common.SecretKeySelectorType)
 	if len(volSecrets) > 0 {
 		vols = append(vols, volSecrets...)
 	}
@@ -3,13 +3,13 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"time"
 
 	"go.uber.org/zap"
 	appv1 "k8s.io/api/apps/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
-	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/manager/signals"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/source"
@@ -17,6 +17,7 @@ import (
 	argoevents "github.com/argoproj/argo-events"
 	"github.com/argoproj/argo-events/common"
 	"github.com/argoproj/argo-events/common/logging"
+	controllerscommon "github.com/argoproj/argo-events/controllers/common"
 	"github.com/argoproj/argo-events/controllers/sensor"
 	eventbusv1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
 	sensorv1alpha1 "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
@@ -26,7 +27,7 @@ const (
 	sensorImageEnvVar = "SENSOR_IMAGE"
 )
 
-func Start(namespaced bool, managedNamespace string) {
+func Start(namespaced bool, managedNamespace string, reconcileStallThreshold time.Duration) {
 	logger := logging.NewArgoEventsLogger().Named(sensor.ControllerName)
 	sensorImage, defined := os.LookupEnv(sensorImageEnvVar)
 	if !defined {
@@ -44,16 +45,7 @@ func Start(namespaced bool, managedNamespace string) {
 		logger.Fatalw("unable to get a controller-runtime manager", zap.Error(err))
 	}
 
-	// Readyness probe
-	if err := mgr.AddReadyzCheck("readiness", healthz.Ping); err != nil {
-		logger.Fatalw("unable add a readiness check", zap.Error(err))
-	}
-
-	// Liveness probe
-	if err := mgr.AddHealthzCheck("liveness", healthz.Ping); err != nil {
-		logger.Fatalw("unable add a health check", zap.Error(err))
-	}
-
+	ctrlOpts.Reconciler = stallDetector.Wrap(eventbus.NewReconciler(mgr.GetClient(), mgr.GetScheme(), config, options.ResyncPeriod, logger))
 	if err := sensorv1alpha1.AddToScheme(mgr.GetScheme()); err != nil {
 		logger.Fatalw("unable to add Sensor scheme", zap.Error(err))
 	}
@@ -64,7 +56,7 @@ func Start(namespaced bool, managedNamespace string) {
 
 	// A controller with DefaultControllerRateLimiter
 	c, err := controller.New(sensor.ControllerName, mgr, controller.Options{
-		Reconciler: sensor.NewReconciler(mgr.GetClient(), mgr.GetScheme(), sensorImage, logger),
+		Reconciler: stallDetector.Wrap(sensor.NewReconciler(mgr.GetClient(), mgr.GetScheme(), sensorImage, logger)),
 	})
 	if err != nil {
 		logger.Fatalw("unable to set up individual controller", zap.Error(err))
@@ -32,6 +32,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/argoproj/argo-events/common"
@@ -214,7 +215,13 @@ func buildDeployment(args *AdaptorArgs, eventBus *eventbusv1alpha1.EventBus) (*a
 	if len(oldVolMounts) > 0 {
 		volMounts = append(volMounts, oldVolMounts...)
 	}
-	volSecrets, volSecretMounts := common.VolumesFromSecretsOrConfigMaps(sensorCopy, common.SecretKeySelectorType)
+	// The secrets are not mounted if they are retrieved from another provider, they may not exist in the cluster.
+	// The TLS secrets of the event bus are always mounted, they are in the namespace of the event bus.
+	secretsObjs := []interface{}{eventBus.Status.Config.NATS.TLS}
+	if !common.UsesExternalSecretsProvider(deploymentSpec.Template.Spec.Containers[0].Env) {
+		secretsObjs = []interface{}{sensorCopy, eventBus.Status.Config.NATS.TLS}
+	}
+	volSecrets, volSecretMounts := common.VolumesFromSecretsOrConfigMaps(secretsObjs, common.SecretKeySelectorType)
 	if len(volSecrets) > 0 {
 		vols = append(vols, volSecrets...)
 	}
@@ -255,6 +262,16 @@ func buildDeploymentSpec(args *AdaptorArgs) (*appv1.DeploymentSpec, error) {
 		Ports: []corev1.ContainerPort{
 			{Name: "metrics", ContainerPort: common.SensorMetricsPort},
 		},
+		ReadinessProbe: &corev1.Probe{
+			ProbeHandler: corev1.ProbeHandler{
+				HTTPGet: &corev1.HTTPGetAction{
+					Path: "/ready",
+					Port: intstr.FromInt(common.SensorMetricsPort),
+				},
+			},
+			PeriodSeconds:  10,
+			TimeoutSeconds: 5,
+		},
 	}
 	if args.Sensor.Spec.Template != nil && args.Sensor.Spec.Template.Container != nil {
 		if err := mergo.Merge(&sensorContainer, args.Sensor.Spec.Template.Container, mergo.WithOverride); err != nil {
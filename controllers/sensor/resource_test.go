@@ -152,6 +152,61 @@ func Test_BuildDeployment(t *testing.T) {
 		assert.True(t, len(deployment.Spec.Template.Spec.ImagePullSecrets) > 0)
 		assert.Equal(t, deployment.Spec.Template.Spec.PriorityClassName, "test-class")
 	})
+
+	t.Run("test build with an external secrets provider", func(t *testing.T) {
+		sensor := sensorObj.DeepCopy()
+		sensor.Spec.Triggers[0].Template = &v1alpha1.TriggerTemplate{
+			Name: "fake-trigger",
+			GCPCloudFunction: &v1alpha1.GCPCloudFunctionTrigger{
+				FunctionName: "projects/fake-project/locations/us-central1/functions/fake-function",
+				CredentialsSecret: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "gcp-credentials"},
+					Key:                  "key.json",
+				},
+			},
+		}
+		args := &AdaptorArgs{
+			Image:  testImage,
+			Sensor: sensor,
+			Labels: testLabels,
+		}
+		hasSecretVolume := func(volumes []corev1.Volume) bool {
+			for _, vol := range volumes {
+				if vol.Secret != nil && vol.Secret.SecretName == "gcp-credentials" {
+					return true
+				}
+			}
+			return false
+		}
+		deployment, err := buildDeployment(args, fakeEventBus)
+		assert.Nil(t, err)
+		assert.True(t, hasSecretVolume(deployment.Spec.Template.Spec.Volumes))
+
+		sensor.Spec.Template.Container.Env = []corev1.EnvVar{{Name: common.EnvVarSecretsProvider, Value: common.SecretsProviderVault}}
+		deployment, err = buildDeployment(args, fakeEventBus)
+		assert.Nil(t, err)
+		assert.False(t, hasSecretVolume(deployment.Spec.Template.Spec.Volumes))
+	})
+	t.Run("test build with eventbus tls", func(t *testing.T) {
+		eventBus := fakeEventBus.DeepCopy()
+		eventBus.Status.Config.NATS.TLS = &eventbusv1alpha1.BusTLSConfig{
+			CertSecret: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "eventbus-tls"}, Key: "tls.crt"},
+			KeySecret:  &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "eventbus-tls"}, Key: "tls.key"},
+		}
+		sensor := sensorObj.DeepCopy()
+		// The TLS secrets are mounted whatever the secrets provider
+		sensor.Spec.Template.Container.Env = []corev1.EnvVar{{Name: common.EnvVarSecretsProvider, Value: common.SecretsProviderVault}}
+		args := &AdaptorArgs{
+			Image:  testImage,
+			Sensor: sensor,
+			Labels: testLabels,
+		}
+		deployment, err := buildDeployment(args, eventBus)
+		assert.Nil(t, err)
+		vol, mount := common.GenerateSecretVolumeSpecs(eventBus.Status.Config.NATS.TLS.CertSecret)
+		assert.Contains(t, deployment.Spec.Template.Spec.Volumes, vol)
+		assert.Contains(t, deployment.Spec.Template.Spec.Containers[0].VolumeMounts, mount)
+	})
 }
 
 func TestResourceReconcile(t *testing.T) {
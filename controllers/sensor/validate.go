@@ -17,17 +17,33 @@ limitations under the License.
 package sensor
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"text/template"
 	"time"
 
+	sprig "github.com/Masterminds/sprig/v3"
+	"github.com/gobwas/glob"
 	"github.com/pkg/errors"
 	cronlib "github.com/robfig/cron/v3"
 
 	"github.com/argoproj/argo-events/common"
 	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	sensordependencies "github.com/argoproj/argo-events/sensors/dependencies"
+	"github.com/argoproj/argo-events/sensors/policy"
+	sensortriggers "github.com/argoproj/argo-events/sensors/triggers"
+	"github.com/argoproj/argo-events/sensors/triggers/bus"
+	gcpcloudfunction "github.com/argoproj/argo-events/sensors/triggers/gcp-cloud-function"
+	"github.com/argoproj/argo-events/sensors/triggers/pushgateway"
 )
 
+// validBucketName matches the valid names of the JetStream key-value buckets
+var validBucketName = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
 // ValidateSensor accepts a sensor and performs validation against it
 // we return an error so that it can be logged as a message on the sensor status
 // the error is ignored by the operation context as subsequent re-queues would produce the same error.
@@ -37,12 +53,60 @@ func ValidateSensor(s *v1alpha1.Sensor) error {
 		s.Status.MarkDependenciesNotProvided("InvalidDependencies", err.Error())
 		return err
 	}
+	if err := validateSensitiveFields(s.Spec.SensitiveFields); err != nil {
+		err = errors.Wrap(err, "invalid sensitive fields")
+		s.Status.MarkDependenciesNotProvided("InvalidSensitiveFields", err.Error())
+		return err
+	}
 	s.Status.MarkDependenciesProvided()
 	err := validateTriggers(s.Spec.Triggers)
 	if err != nil {
 		s.Status.MarkTriggersNotProvided("InvalidTriggers", err.Error())
 		return err
 	}
+	if err := validateBusTriggerLoops(s.Spec.Dependencies, s.Spec.Triggers); err != nil {
+		s.Status.MarkTriggersNotProvided("InvalidTriggers", err.Error())
+		return err
+	}
+	if err := validateIdempotency(s.Spec.Idempotency); err != nil {
+		err = errors.Wrap(err, "invalid idempotency")
+		s.Status.MarkTriggersNotProvided("InvalidIdempotency", err.Error())
+		return err
+	}
+	if err := validateDeadLetter(s.Spec.DeadLetter); err != nil {
+		err = errors.Wrap(err, "invalid dead letter")
+		s.Status.MarkTriggersNotProvided("InvalidDeadLetter", err.Error())
+		return err
+	}
+	if s.Spec.TriggerConcurrency < 0 {
+		err := errors.New("trigger concurrency can't be negative")
+		s.Status.MarkTriggersNotProvided("InvalidTriggerConcurrency", err.Error())
+		return err
+	}
+	if s.Spec.MaxInFlightEvents < 0 {
+		err := errors.New("max in-flight events can't be negative")
+		s.Status.MarkTriggersNotProvided("InvalidMaxInFlightEvents", err.Error())
+		return err
+	}
+	if s.Spec.PartitionKey != "" {
+		if _, err := sensortriggers.NewPartitionKey(s.Spec.PartitionKey); err != nil {
+			err = errors.Wrapf(err, "invalid partition key %q", s.Spec.PartitionKey)
+			s.Status.MarkTriggersNotProvided("InvalidPartitionKey", err.Error())
+			return err
+		}
+	}
+	if s.Spec.MaxActivePartitions < 0 {
+		err := errors.New("max active partitions can't be negative")
+		s.Status.MarkTriggersNotProvided("InvalidMaxActivePartitions", err.Error())
+		return err
+	}
+	switch s.Spec.DeliverySemantics {
+	case "", v1alpha1.DeliverySemanticsAtLeastOnce, v1alpha1.DeliverySemanticsAtMostOnce:
+	default:
+		err := errors.Errorf("unknown delivery semantics %q, expected %s or %s", s.Spec.DeliverySemantics, v1alpha1.DeliverySemanticsAtLeastOnce, v1alpha1.DeliverySemanticsAtMostOnce)
+		s.Status.MarkTriggersNotProvided("InvalidDeliverySemantics", err.Error())
+		return err
+	}
 	s.Status.MarkTriggersProvided()
 	return nil
 }
@@ -66,10 +130,245 @@ func validateTriggers(triggers []v1alpha1.Trigger) error {
 		if err := validateTriggerPolicy(&trigger); err != nil {
 			return err
 		}
+		if err := validateRateLimit(trigger.RateLimit); err != nil {
+			return errors.Wrapf(err, "trigger %s has an invalid rate limit", trigger.Template.Name)
+		}
+		if err := validateDedupe(trigger.Dedupe); err != nil {
+			return errors.Wrapf(err, "trigger %s has an invalid dedupe", trigger.Template.Name)
+		}
+		if err := validateCircuitBreaker(trigger.CircuitBreaker); err != nil {
+			return errors.Wrapf(err, "trigger %s has an invalid circuit breaker", trigger.Template.Name)
+		}
+		if err := validateRedelivery(trigger.Redelivery); err != nil {
+			return errors.Wrapf(err, "trigger %s has an invalid redelivery", trigger.Template.Name)
+		}
 		if err := validateTriggerTemplateParameters(&trigger); err != nil {
 			return err
 		}
 	}
+	if err := validateCanaryGroups(triggers); err != nil {
+		return err
+	}
+	if _, err := sensortriggers.NewGraph(triggers); err != nil {
+		return errors.Wrap(err, "invalid trigger dependencies")
+	}
+	return nil
+}
+
+// validateCanaryGroups validates the weights of the triggers of the canary groups
+func validateCanaryGroups(triggers []v1alpha1.Trigger) error {
+	totals := make(map[string]int64)
+	conditions := make(map[string]string)
+	for _, trigger := range triggers {
+		if trigger.Weight < 0 {
+			return errors.Errorf("trigger %s has a negative weight", trigger.Template.Name)
+		}
+		if trigger.CanaryGroup == "" {
+			if trigger.Weight != 0 {
+				return errors.Errorf("trigger %s has a weight but no canary group", trigger.Template.Name)
+			}
+			continue
+		}
+		// The triggers of a group select the same one for the events only if they are triggered by the same events
+		if c, ok := conditions[trigger.CanaryGroup]; ok && c != trigger.Template.Conditions {
+			return errors.Errorf("the triggers of the canary group %s must have the same conditions", trigger.CanaryGroup)
+		}
+		conditions[trigger.CanaryGroup] = trigger.Template.Conditions
+		totals[trigger.CanaryGroup] += int64(trigger.Weight)
+	}
+	for group, total := range totals {
+		if total == 0 {
+			return errors.Errorf("the triggers of the canary group %s have no weight", group)
+		}
+	}
+	return nil
+}
+
+// validateRateLimit validates the rate limit of a trigger
+func validateRateLimit(rateLimit *v1alpha1.RateLimit) error {
+	if rateLimit == nil {
+		return nil
+	}
+	switch rateLimit.Unit {
+	case "", v1alpha1.Second, v1alpha1.Minute, v1alpha1.Hour:
+	default:
+		return errors.Errorf("unknown unit %q", rateLimit.Unit)
+	}
+	if rateLimit.Burst < 0 {
+		return errors.New("burst can't be negative")
+	}
+	switch rateLimit.Overflow {
+	case "", v1alpha1.RateLimitOverflowBlock, v1alpha1.RateLimitOverflowDrop:
+	default:
+		return errors.Errorf("unknown overflow policy %q", rateLimit.Overflow)
+	}
+	return nil
+}
+
+// validateDedupe validates the dedupe of a trigger
+func validateDedupe(dedupe *v1alpha1.TriggerDedupe) error {
+	if dedupe == nil {
+		return nil
+	}
+	if dedupe.Key.DependencyName == "" {
+		return errors.New("key dependency name can't be empty")
+	}
+	if dedupe.TTL != "" {
+		ttl, err := time.ParseDuration(dedupe.TTL)
+		if err != nil {
+			return errors.Wrapf(err, "failed to parse the ttl %s", dedupe.TTL)
+		}
+		if ttl <= 0 {
+			return errors.New("ttl must be positive")
+		}
+	}
+	if dedupe.MaxKeys < 0 {
+		return errors.New("max keys can't be negative")
+	}
+	return nil
+}
+
+// validateRedelivery validates the redelivery backoff of a trigger
+func validateRedelivery(redelivery *v1alpha1.TriggerRedelivery) error {
+	if redelivery == nil {
+		return nil
+	}
+	if redelivery.Attempts < 0 {
+		return errors.New("attempts can't be negative")
+	}
+	if redelivery.Initial != "" {
+		initial, err := time.ParseDuration(redelivery.Initial)
+		if err != nil {
+			return errors.Wrapf(err, "failed to parse the initial delay %s", redelivery.Initial)
+		}
+		if initial <= 0 {
+			return errors.New("initial delay must be positive")
+		}
+	}
+	if redelivery.Max != "" {
+		max, err := time.ParseDuration(redelivery.Max)
+		if err != nil {
+			return errors.Wrapf(err, "failed to parse the max delay %s", redelivery.Max)
+		}
+		if max <= 0 {
+			return errors.New("max delay must be positive")
+		}
+	}
+	if redelivery.GetInitial() > redelivery.GetMax() {
+		return errors.New("initial delay can't be greater than the max delay")
+	}
+	if redelivery.Multiplier != nil {
+		multiplier, err := redelivery.Multiplier.Float64()
+		if err != nil {
+			return errors.Wrapf(err, "failed to parse the multiplier %s", string(redelivery.Multiplier.Value))
+		}
+		if multiplier < 1 {
+			return errors.New("multiplier can't be less than 1")
+		}
+	}
+	return nil
+}
+
+// validateCircuitBreaker validates the circuit breaker of a trigger
+func validateCircuitBreaker(circuitBreaker *v1alpha1.TriggerCircuitBreaker) error {
+	if circuitBreaker == nil {
+		return nil
+	}
+	if circuitBreaker.FailureThreshold < 0 {
+		return errors.New("failure threshold can't be negative")
+	}
+	if circuitBreaker.Window != "" {
+		window, err := time.ParseDuration(circuitBreaker.Window)
+		if err != nil {
+			return errors.Wrapf(err, "failed to parse the window %s", circuitBreaker.Window)
+		}
+		if window <= 0 {
+			return errors.New("window must be positive")
+		}
+	}
+	if circuitBreaker.Cooldown != "" {
+		cooldown, err := time.ParseDuration(circuitBreaker.Cooldown)
+		if err != nil {
+			return errors.Wrapf(err, "failed to parse the cooldown %s", circuitBreaker.Cooldown)
+		}
+		if cooldown <= 0 {
+			return errors.New("cooldown must be positive")
+		}
+	}
+	return nil
+}
+
+// validateIdempotency validates the idempotency of a sensor
+func validateIdempotency(idempotency *v1alpha1.Idempotency) error {
+	if idempotency == nil {
+		return nil
+	}
+	if idempotency.TTL != "" {
+		ttl, err := time.ParseDuration(idempotency.TTL)
+		if err != nil {
+			return errors.Wrapf(err, "failed to parse the ttl %s", idempotency.TTL)
+		}
+		if ttl <= 0 {
+			return errors.New("ttl must be positive")
+		}
+	}
+	if js := idempotency.JetStream; js != nil {
+		if js.URL == "" {
+			return errors.New("jetstream url can't be empty")
+		}
+		if js.Bucket != "" && !validBucketName.MatchString(js.Bucket) {
+			return errors.Errorf("invalid jetstream bucket name %s, only letters, digits, - and _ are allowed", js.Bucket)
+		}
+	}
+	return nil
+}
+
+// validateDeadLetter validates the dead letter sink of a sensor
+func validateDeadLetter(deadLetter *v1alpha1.DeadLetter) error {
+	if deadLetter == nil {
+		return nil
+	}
+	sinks := 0
+	if eb := deadLetter.EventBus; eb != nil {
+		sinks++
+		if eb.Subject == "" {
+			return errors.New("eventbus subject can't be empty")
+		}
+	}
+	if h := deadLetter.HTTP; h != nil {
+		sinks++
+		u, err := url.Parse(h.URL)
+		if err != nil {
+			return errors.Wrapf(err, "failed to parse the http url %s", h.URL)
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return errors.Errorf("invalid http url %s, the scheme must be http or https", h.URL)
+		}
+		if h.Timeout != "" {
+			timeout, err := time.ParseDuration(h.Timeout)
+			if err != nil {
+				return errors.Wrapf(err, "failed to parse the http timeout %s", h.Timeout)
+			}
+			if timeout <= 0 {
+				return errors.New("http timeout must be positive")
+			}
+		}
+	}
+	if js := deadLetter.JetStream; js != nil {
+		sinks++
+		if js.URL == "" {
+			return errors.New("jetstream url can't be empty")
+		}
+		if js.Subject == "" {
+			return errors.New("jetstream subject can't be empty")
+		}
+		if js.Stream != "" && !validBucketName.MatchString(js.Stream) {
+			return errors.Errorf("invalid jetstream stream name %s, only letters, digits, - and _ are allowed", js.Stream)
+		}
+	}
+	if sinks != 1 {
+		return errors.New("exactly one of eventBus, http and jetStream must be specified")
+	}
 	return nil
 }
 
@@ -95,6 +394,26 @@ func validateTriggerTemplate(template *v1alpha1.TriggerTemplate) error {
 			}
 		}
 	}
+	if types := setTriggerTypes(template); len(types) > 1 {
+		return errors.Errorf("trigger template %s sets several trigger types %s, only one can be set", template.Name, strings.Join(types, ", "))
+	}
+	if template.Timeout != "" {
+		timeout, err := time.ParseDuration(template.Timeout)
+		if err != nil {
+			return errors.Wrapf(err, "failed to parse the timeout %s", template.Timeout)
+		}
+		if timeout <= 0 {
+			return errors.New("timeout must be positive")
+		}
+	}
+	if template.Condition != "" {
+		if _, err := sensortriggers.NewCondition(template.Condition); err != nil {
+			return errors.Wrapf(err, "invalid condition %q", template.Condition)
+		}
+	}
+	if err := sensortriggers.ValidateCloudEvent(template.CloudEvent); err != nil {
+		return errors.Wrapf(err, "invalid cloud event")
+	}
 	if template.K8s != nil {
 		if err := validateK8STrigger(template.K8s); err != nil {
 			return errors.Wrapf(err, "trigger for template %s is invalid", template.Name)
@@ -115,11 +434,36 @@ func validateTriggerTemplate(template *v1alpha1.TriggerTemplate) error {
 			return errors.Wrapf(err, "template %s is invalid", template.Name)
 		}
 	}
+	if template.GCPCloudFunction != nil {
+		if err := validateGCPCloudFunctionTrigger(template.GCPCloudFunction); err != nil {
+			return errors.Wrapf(err, "template %s is invalid", template.Name)
+		}
+	}
+	if template.RedisStream != nil {
+		if err := validateRedisStreamTrigger(template.RedisStream); err != nil {
+			return errors.Wrapf(err, "template %s is invalid", template.Name)
+		}
+	}
+	if template.AWSSQS != nil {
+		if err := validateAWSSQSTrigger(template.AWSSQS); err != nil {
+			return errors.Wrapf(err, "template %s is invalid", template.Name)
+		}
+	}
 	if template.Kafka != nil {
 		if err := validateKafkaTrigger(template.Kafka); err != nil {
 			return errors.Wrapf(err, "template %s is invalid", template.Name)
 		}
 	}
+	if template.Pushgateway != nil {
+		if err := validatePushgatewayTrigger(template.Pushgateway); err != nil {
+			return errors.Wrapf(err, "template %s is invalid", template.Name)
+		}
+	}
+	if template.Bus != nil {
+		if err := validateBusTrigger(template.Bus); err != nil {
+			return errors.Wrapf(err, "template %s is invalid", template.Name)
+		}
+	}
 	if template.NATS != nil {
 		if err := validateNATSTrigger(template.NATS); err != nil {
 			return errors.Wrapf(err, "template %s is invalid", template.Name)
@@ -140,9 +484,47 @@ func validateTriggerTemplate(template *v1alpha1.TriggerTemplate) error {
 			return errors.Wrapf(err, "template %s is invalid", template.Name)
 		}
 	}
+	if template.Log != nil {
+		if err := validateLogTrigger(template.Log); err != nil {
+			return errors.Wrapf(err, "template %s is invalid", template.Name)
+		}
+	}
 	return nil
 }
 
+// setTriggerTypes returns the fields of the trigger types set in the template
+func setTriggerTypes(template *v1alpha1.TriggerTemplate) []string {
+	fields := []struct {
+		name string
+		set  bool
+	}{
+		{"k8s", template.K8s != nil},
+		{"argoWorkflow", template.ArgoWorkflow != nil},
+		{"http", template.HTTP != nil},
+		{"awsLambda", template.AWSLambda != nil},
+		{"custom", template.CustomTrigger != nil},
+		{"kafka", template.Kafka != nil},
+		{"nats", template.NATS != nil},
+		{"slack", template.Slack != nil},
+		{"openWhisk", template.OpenWhisk != nil},
+		{"log", template.Log != nil},
+		{"azureEventHubs", template.AzureEventHubs != nil},
+		{"pulsar", template.Pulsar != nil},
+		{"gcpCloudFunction", template.GCPCloudFunction != nil},
+		{"redisStream", template.RedisStream != nil},
+		{"awsSQS", template.AWSSQS != nil},
+		{"pushgateway", template.Pushgateway != nil},
+		{"bus", template.Bus != nil},
+	}
+	var types []string
+	for _, field := range fields {
+		if field.set {
+			types = append(types, field.name)
+		}
+	}
+	return types
+}
+
 // validateK8STrigger validates a kubernetes trigger
 func validateK8STrigger(trigger *v1alpha1.StandardK8STrigger) error {
 	if trigger == nil {
@@ -207,6 +589,17 @@ func validateHTTPTrigger(trigger *v1alpha1.HTTPTrigger) error {
 			return errors.New("only GET, DELETE, PATCH, POST and PUT methods are supported")
 		}
 	}
+	if trigger.MaxResponseSize < 0 {
+		return errors.New("max response size can't be negative")
+	}
+	if trigger.RetryStrategy != nil {
+		if _, err := common.Convert2WaitBackoff(trigger.RetryStrategy); err != nil {
+			return errors.Wrap(err, "invalid retry strategy")
+		}
+	}
+	if _, err := policy.ParseStatusRanges(trigger.RetryOn); err != nil {
+		return errors.Wrap(err, "invalid retry statuses")
+	}
 	if trigger.Parameters != nil {
 		for i, parameter := range trigger.Parameters {
 			if err := validateTriggerParameter(&parameter); err != nil {
@@ -291,6 +684,178 @@ func validateAWSLambdaTrigger(trigger *v1alpha1.AWSLambdaTrigger) error {
 	return nil
 }
 
+// validateGCPCloudFunctionTrigger validates the GCP Cloud Function trigger
+func validateGCPCloudFunctionTrigger(trigger *v1alpha1.GCPCloudFunctionTrigger) error {
+	if trigger == nil {
+		return errors.New("gcp cloud function trigger can't be nil")
+	}
+	if trigger.FunctionName == "" {
+		return errors.New("function name is not specified")
+	}
+	switch trigger.Encoding {
+	case "", v1alpha1.GCPCloudFunctionEncodingNone, v1alpha1.GCPCloudFunctionEncodingBase64, v1alpha1.GCPCloudFunctionEncodingGzip:
+	default:
+		return errors.Errorf("unknown payload encoding %q", trigger.Encoding)
+	}
+	if trigger.ProxyURL != "" {
+		u, err := url.Parse(trigger.ProxyURL)
+		if err != nil {
+			return errors.Wrap(err, "invalid proxy url")
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return errors.Errorf("invalid proxy url %q, the scheme must be http or https", trigger.ProxyURL)
+		}
+	}
+	if err := gcpcloudfunction.ValidateTrigger(trigger); err != nil {
+		return err
+	}
+	if trigger.Parameters != nil {
+		for i, parameter := range trigger.Parameters {
+			if err := validateTriggerParameter(&parameter); err != nil {
+				return errors.Errorf("resource parameter index: %d. err: %+v", i, err)
+			}
+		}
+	}
+	if trigger.Payload != nil {
+		for i, p := range trigger.Payload {
+			if err := validateTriggerParameter(&p); err != nil {
+				return errors.Errorf("payload index: %d. err: %+v", i, err)
+			}
+		}
+	}
+	return nil
+}
+
+// validatePushgatewayTrigger validates the Pushgateway trigger
+func validatePushgatewayTrigger(trigger *v1alpha1.PushgatewayTrigger) error {
+	if trigger == nil {
+		return errors.New("pushgateway trigger can't be nil")
+	}
+	if err := pushgateway.ValidateTrigger(trigger); err != nil {
+		return err
+	}
+	if trigger.Parameters != nil {
+		for i, parameter := range trigger.Parameters {
+			if err := validateTriggerParameter(&parameter); err != nil {
+				return errors.Errorf("resource parameter index: %d. err: %+v", i, err)
+			}
+		}
+	}
+	return nil
+}
+
+// validateBusTrigger validates the bus trigger
+func validateBusTrigger(trigger *v1alpha1.BusTrigger) error {
+	if trigger == nil {
+		return errors.New("bus trigger can't be nil")
+	}
+	if err := bus.ValidateTrigger(trigger); err != nil {
+		return err
+	}
+	for i, p := range trigger.Payload {
+		if err := validateTriggerParameter(&p); err != nil {
+			return errors.Errorf("payload index: %d. err: %+v", i, err)
+		}
+	}
+	for i, parameter := range trigger.Parameters {
+		if err := validateTriggerParameter(&parameter); err != nil {
+			return errors.Errorf("resource parameter index: %d. err: %+v", i, err)
+		}
+	}
+	return nil
+}
+
+// validateBusTriggerLoops checks the bus triggers publishing to the subject of the sensor don't publish the
+// events of its own dependencies, which would trigger the sensor again and again. The names of the dependencies
+// are globs, and the names templated from the events are only known once resolved, so they are assumed to match.
+func validateBusTriggerLoops(dependencies []v1alpha1.EventDependency, triggers []v1alpha1.Trigger) error {
+	for _, trigger := range triggers {
+		if trigger.Template == nil || trigger.Template.Bus == nil || trigger.Template.Bus.Subject != "" {
+			continue
+		}
+		bt := trigger.Template.Bus
+		templated := bus.HasTemplatedNames(bt)
+		for _, dep := range dependencies {
+			if templated {
+				return errors.Errorf("bus trigger %s templates the names of the events it publishes, they may be the ones of dependency %s and trigger the sensor itself, set a subject to publish to", trigger.Template.Name, dep.Name)
+			}
+			eventSourceName, err := glob.Compile(dep.EventSourceName)
+			if err != nil {
+				return errors.Errorf("invalid event source name %q of dependency %s", dep.EventSourceName, dep.Name)
+			}
+			eventName, err := glob.Compile(dep.EventName)
+			if err != nil {
+				return errors.Errorf("invalid event name %q of dependency %s", dep.EventName, dep.Name)
+			}
+			if eventSourceName.Match(bt.EventSourceName) && eventName.Match(bt.EventName) {
+				return errors.Errorf("bus trigger %s publishes the events of dependency %s, it would trigger the sensor itself", trigger.Template.Name, dep.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// validateRedisStreamTrigger validates the Redis stream trigger
+func validateRedisStreamTrigger(trigger *v1alpha1.RedisStreamTrigger) error {
+	if trigger == nil {
+		return errors.New("redis stream trigger can't be nil")
+	}
+	if trigger.HostAddress == "" {
+		return errors.New("host address is not specified")
+	}
+	if trigger.Stream == "" {
+		return errors.New("stream is not specified")
+	}
+	if trigger.MaxLen < 0 {
+		return errors.New("maxLen can't be negative")
+	}
+	if trigger.Payload == nil {
+		return errors.New("payload parameters are not specified")
+	}
+	if trigger.Parameters != nil {
+		for i, parameter := range trigger.Parameters {
+			if err := validateTriggerParameter(&parameter); err != nil {
+				return errors.Errorf("resource parameter index: %d. err: %+v", i, err)
+			}
+		}
+	}
+	for i, p := range trigger.Payload {
+		if err := validateTriggerParameter(&p); err != nil {
+			return errors.Errorf("payload index: %d. err: %+v", i, err)
+		}
+	}
+	return nil
+}
+
+// validateAWSSQSTrigger validates the AWS SQS trigger
+func validateAWSSQSTrigger(trigger *v1alpha1.AWSSQSTrigger) error {
+	if trigger == nil {
+		return errors.New("aws sqs trigger can't be nil")
+	}
+	if trigger.QueueURL == "" {
+		return errors.New("queue url is not specified")
+	}
+	if trigger.Region == "" {
+		return errors.New("region is not specified")
+	}
+	if trigger.Payload == nil {
+		return errors.New("payload parameters are not specified")
+	}
+	if trigger.Parameters != nil {
+		for i, parameter := range trigger.Parameters {
+			if err := validateTriggerParameter(&parameter); err != nil {
+				return errors.Errorf("resource parameter index: %d. err: %+v", i, err)
+			}
+		}
+	}
+	for i, p := range trigger.Payload {
+		if err := validateTriggerParameter(&p); err != nil {
+			return errors.Errorf("payload index: %d. err: %+v", i, err)
+		}
+	}
+	return nil
+}
+
 // validateKafkaTrigger validates the kafka trigger.
 func validateKafkaTrigger(trigger *v1alpha1.KafkaTrigger) error {
 	if trigger == nil {
@@ -305,6 +870,14 @@ func validateKafkaTrigger(trigger *v1alpha1.KafkaTrigger) error {
 	if trigger.Topic == "" {
 		return errors.New("topic must not be empty")
 	}
+	if trigger.Idempotent && trigger.RequiredAcks != 0 && trigger.RequiredAcks != -1 {
+		return errors.New("the idempotent producer requires the acknowledgement of all the in-sync replicas, requiredAcks -1")
+	}
+	if trigger.PartitioningKeyTemplate != "" {
+		if _, err := template.New("partitioningKey").Funcs(sprig.HermeticTxtFuncMap()).Parse(trigger.PartitioningKeyTemplate); err != nil {
+			return errors.Wrap(err, "partitioning key template is invalid")
+		}
+	}
 	if trigger.Payload != nil {
 		for i, p := range trigger.Payload {
 			if err := validateTriggerParameter(&p); err != nil {
@@ -347,6 +920,12 @@ func validateSlackTrigger(trigger *v1alpha1.SlackTrigger) error {
 	if trigger.SlackToken == nil {
 		return errors.New("slack token can't be empty")
 	}
+	if trigger.Blocks != "" {
+		var blocks []map[string]interface{}
+		if err := json.Unmarshal([]byte(trigger.Blocks), &blocks); err != nil {
+			return errors.Wrap(err, "slack blocks must be a JSON array of objects")
+		}
+	}
 	if trigger.Parameters != nil {
 		for i, parameter := range trigger.Parameters {
 			if err := validateTriggerParameter(&parameter); err != nil {
@@ -357,6 +936,26 @@ func validateSlackTrigger(trigger *v1alpha1.SlackTrigger) error {
 	return nil
 }
 
+// validateLogTrigger validates the log trigger
+func validateLogTrigger(trigger *v1alpha1.LogTrigger) error {
+	switch trigger.Level {
+	case "", v1alpha1.LogLevelDebug, v1alpha1.LogLevelInfo, v1alpha1.LogLevelWarn, v1alpha1.LogLevelError:
+	default:
+		return errors.Errorf("unknown log level %s", trigger.Level)
+	}
+	for i, p := range trigger.Payload {
+		if err := validateTriggerParameter(&p); err != nil {
+			return errors.Errorf("payload index: %d. err: %+v", i, err)
+		}
+	}
+	for i, parameter := range trigger.Parameters {
+		if err := validateTriggerParameter(&parameter); err != nil {
+			return errors.Errorf("resource parameter index: %d. err: %+v", i, err)
+		}
+	}
+	return nil
+}
+
 // validateCustomTrigger validates the custom trigger.
 func validateCustomTrigger(trigger *v1alpha1.CustomTrigger) error {
 	if trigger == nil {
@@ -397,11 +996,20 @@ func validateTriggerTemplateParameters(trigger *v1alpha1.Trigger) error {
 
 // validateTriggerParameter validates a trigger parameter
 func validateTriggerParameter(parameter *v1alpha1.TriggerParameter) error {
-	if parameter.Src == nil {
-		return errors.Errorf("parameter source can't be empty")
-	}
-	if parameter.Src.DependencyName == "" {
-		return errors.Errorf("parameter dependency name can't be empty")
+	if parameter.Template != "" {
+		if _, err := template.New("parameter").Funcs(sprig.HermeticTxtFuncMap()).Parse(parameter.Template); err != nil {
+			return errors.Wrap(err, "parameter template is invalid")
+		}
+	} else {
+		if parameter.Src == nil {
+			return errors.Errorf("parameter source can't be empty")
+		}
+		if parameter.Src.DependencyName == "" && parameter.Src.Env == "" && parameter.Src.Secret == nil {
+			return errors.Errorf("parameter dependency name can't be empty")
+		}
+		if s := parameter.Src.Secret; s != nil && (s.Name == "" || s.Key == "") {
+			return errors.Errorf("parameter secret requires a name and a key")
+		}
 	}
 	if parameter.Dest == "" {
 		return errors.Errorf("parameter destination can't be empty")
@@ -443,6 +1051,14 @@ func validateDependencies(eventDependencies []v1alpha1.EventDependency) error {
 		}
 		comboKeys[comboKey] = true
 
+		// The names can be globs, e.g. a prefix such as "orders-*", to match several event sources or events.
+		if _, err := glob.Compile(dep.EventSourceName); err != nil {
+			return errors.Wrapf(err, "invalid event source name pattern %q", dep.EventSourceName)
+		}
+		if _, err := glob.Compile(dep.EventName); err != nil {
+			return errors.Wrapf(err, "invalid event name pattern %q", dep.EventName)
+		}
+
 		if err := validateEventFilter(dep.Filters); err != nil {
 			return err
 		}
@@ -450,6 +1066,26 @@ func validateDependencies(eventDependencies []v1alpha1.EventDependency) error {
 		if err := validateLogicalOperator(dep.FiltersLogicalOperator); err != nil {
 			return err
 		}
+
+		if dep.Schema != "" {
+			if _, err := sensordependencies.CompileSchema(dep.Schema); err != nil {
+				return errors.Wrapf(err, "invalid schema of event dependency %s", dep.Name)
+			}
+		}
+
+		if err := validateSensitiveFields(dep.SensitiveFields); err != nil {
+			return errors.Wrapf(err, "invalid sensitive fields of event dependency %s", dep.Name)
+		}
+	}
+	return nil
+}
+
+// validateSensitiveFields validates the paths of the fields masked in the logs of the events
+func validateSensitiveFields(paths []string) error {
+	for _, path := range paths {
+		if strings.TrimSpace(path) == "" {
+			return errors.New("the path of a sensitive field can't be empty")
+		}
 	}
 	return nil
 }
@@ -610,6 +1246,9 @@ func validateTriggerPolicy(trigger *v1alpha1.Trigger) error {
 	if trigger.Template.AWSLambda != nil {
 		return validateStatusPolicy(trigger.Policy.Status)
 	}
+	if trigger.Template.GCPCloudFunction != nil {
+		return validateStatusPolicy(trigger.Policy.Status)
+	}
 	return nil
 }
 
@@ -625,12 +1264,15 @@ func validateK8sTriggerPolicy(policy *v1alpha1.K8SResourcePolicy) error {
 }
 
 // validateStatusPolicy validates a http trigger policy
-func validateStatusPolicy(policy *v1alpha1.StatusPolicy) error {
-	if policy == nil {
+func validateStatusPolicy(statusPolicy *v1alpha1.StatusPolicy) error {
+	if statusPolicy == nil {
 		return nil
 	}
-	if policy.Allow == nil {
+	if statusPolicy.Allow == nil && statusPolicy.AllowRanges == nil {
 		return errors.New("list of allowed response status is not specified")
 	}
+	if _, err := policy.ParseStatusRanges(statusPolicy.AllowRanges); err != nil {
+		return errors.Wrap(err, "invalid allowed response status")
+	}
 	return nil
 }
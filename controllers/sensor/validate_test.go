@@ -22,6 +22,7 @@ import (
 	"strings"
 	"testing"
 
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
 	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
 	"github.com/ghodss/yaml"
 	"github.com/stretchr/testify/assert"
@@ -63,6 +64,34 @@ func TestValidDependencies(t *testing.T) {
 		assert.Equal(t, true, strings.Contains(err.Error(), "more than once"))
 	})
 
+	t.Run("test glob names", func(t *testing.T) {
+		sObj := sensorObj.DeepCopy()
+		sObj.Spec.Dependencies = append(sObj.Spec.Dependencies, v1alpha1.EventDependency{
+			Name:            "fake-dep2",
+			EventSourceName: "fake-*",
+			EventName:       "fake-{one,two}",
+		})
+		err := ValidateSensor(sObj)
+		assert.Nil(t, err)
+
+		sObj.Spec.Dependencies[len(sObj.Spec.Dependencies)-1].EventName = "fake-[one"
+		err = ValidateSensor(sObj)
+		assert.NotNil(t, err)
+		assert.Equal(t, true, strings.Contains(err.Error(), "invalid event name pattern"))
+	})
+
+	t.Run("test sensitive fields", func(t *testing.T) {
+		sObj := sensorObj.DeepCopy()
+		sObj.Spec.SensitiveFields = []string{"body.password"}
+		sObj.Spec.Dependencies[0].SensitiveFields = []string{"body.user.ssn"}
+		assert.Nil(t, ValidateSensor(sObj))
+
+		sObj.Spec.Dependencies[0].SensitiveFields = []string{" "}
+		err := ValidateSensor(sObj)
+		assert.NotNil(t, err)
+		assert.Equal(t, true, strings.Contains(err.Error(), "invalid sensitive fields"))
+	})
+
 	t.Run("test empty event source name", func(t *testing.T) {
 		sObj := sensorObj.DeepCopy()
 		sObj.Spec.Dependencies = append(sObj.Spec.Dependencies, v1alpha1.EventDependency{
@@ -95,6 +124,21 @@ func TestValidDependencies(t *testing.T) {
 		assert.NotNil(t, err)
 		assert.Equal(t, true, strings.Contains(err.Error(), "must define a name"))
 	})
+
+	t.Run("test valid schema", func(t *testing.T) {
+		sObj := sensorObj.DeepCopy()
+		sObj.Spec.Dependencies[0].Schema = "type: object\nrequired:\n  - orderId\n"
+		err := ValidateSensor(sObj)
+		assert.Nil(t, err)
+	})
+
+	t.Run("test invalid schema", func(t *testing.T) {
+		sObj := sensorObj.DeepCopy()
+		sObj.Spec.Dependencies[0].Schema = `{"type": "unknown"}`
+		err := ValidateSensor(sObj)
+		assert.NotNil(t, err)
+		assert.Equal(t, true, strings.Contains(err.Error(), "invalid schema of event dependency"))
+	})
 }
 
 func TestValidateLogicalOperator(t *testing.T) {
@@ -453,6 +497,125 @@ func TestValidTriggers(t *testing.T) {
 		assert.Equal(t, true, strings.Contains(err.Error(), "duplicate trigger name:"))
 	})
 
+	t.Run("invalid rate limit", func(t *testing.T) {
+		triggers := []v1alpha1.Trigger{
+			{
+				Template: &v1alpha1.TriggerTemplate{
+					Name: "fake-trigger",
+					K8s: &v1alpha1.StandardK8STrigger{
+						Operation: "create",
+						Source:    &v1alpha1.ArtifactLocation{},
+					},
+				},
+				RateLimit: &v1alpha1.RateLimit{
+					RequestsPerUnit: 10,
+					Overflow:        "Queue",
+				},
+			},
+		}
+		err := validateTriggers(triggers)
+		assert.NotNil(t, err)
+		assert.Equal(t, true, strings.Contains(err.Error(), "unknown overflow policy"))
+
+		triggers[0].RateLimit = &v1alpha1.RateLimit{RequestsPerUnit: 10, Burst: 5, Overflow: v1alpha1.RateLimitOverflowDrop}
+		assert.Nil(t, validateTriggers(triggers))
+	})
+
+	t.Run("invalid dedupe", func(t *testing.T) {
+		triggers := []v1alpha1.Trigger{
+			{
+				Template: &v1alpha1.TriggerTemplate{
+					Name: "fake-trigger",
+					K8s: &v1alpha1.StandardK8STrigger{
+						Operation: "create",
+						Source:    &v1alpha1.ArtifactLocation{},
+					},
+				},
+				Dedupe: &v1alpha1.TriggerDedupe{
+					Key: v1alpha1.TriggerParameterSource{DependencyName: "dep", DataKey: "body.id"},
+					TTL: "-1m",
+				},
+			},
+		}
+		err := validateTriggers(triggers)
+		assert.NotNil(t, err)
+		assert.Equal(t, true, strings.Contains(err.Error(), "ttl must be positive"))
+
+		triggers[0].Dedupe.Key.DependencyName = ""
+		triggers[0].Dedupe.TTL = "10m"
+		err = validateTriggers(triggers)
+		assert.NotNil(t, err)
+		assert.Equal(t, true, strings.Contains(err.Error(), "key dependency name can't be empty"))
+
+		triggers[0].Dedupe.Key.DependencyName = "dep"
+		assert.Nil(t, validateTriggers(triggers))
+	})
+
+	t.Run("invalid circuit breaker", func(t *testing.T) {
+		triggers := []v1alpha1.Trigger{
+			{
+				Template: &v1alpha1.TriggerTemplate{
+					Name: "fake-trigger",
+					K8s: &v1alpha1.StandardK8STrigger{
+						Operation: "create",
+						Source:    &v1alpha1.ArtifactLocation{},
+					},
+				},
+				CircuitBreaker: &v1alpha1.TriggerCircuitBreaker{
+					FailureThreshold: -1,
+				},
+			},
+		}
+		err := validateTriggers(triggers)
+		assert.NotNil(t, err)
+		assert.Equal(t, true, strings.Contains(err.Error(), "failure threshold can't be negative"))
+
+		triggers[0].CircuitBreaker.FailureThreshold = 3
+		triggers[0].CircuitBreaker.Window = "1x"
+		err = validateTriggers(triggers)
+		assert.NotNil(t, err)
+		assert.Equal(t, true, strings.Contains(err.Error(), "failed to parse the window"))
+
+		triggers[0].CircuitBreaker.Window = "1m"
+		triggers[0].CircuitBreaker.Cooldown = "0s"
+		err = validateTriggers(triggers)
+		assert.NotNil(t, err)
+		assert.Equal(t, true, strings.Contains(err.Error(), "cooldown must be positive"))
+
+		triggers[0].CircuitBreaker.Cooldown = "2m"
+		assert.Nil(t, validateTriggers(triggers))
+	})
+
+	t.Run("invalid redelivery", func(t *testing.T) {
+		multiplier := apicommon.NewAmount("0.5")
+		triggers := []v1alpha1.Trigger{
+			{
+				Template: &v1alpha1.TriggerTemplate{
+					Name: "fake-trigger",
+					K8s: &v1alpha1.StandardK8STrigger{
+						Operation: "create",
+						Source:    &v1alpha1.ArtifactLocation{},
+					},
+				},
+				Redelivery: &v1alpha1.TriggerRedelivery{
+					Initial:    "10m",
+					Multiplier: &multiplier,
+				},
+			},
+		}
+		err := validateTriggers(triggers)
+		assert.NotNil(t, err)
+		assert.Equal(t, true, strings.Contains(err.Error(), "initial delay can't be greater than the max delay"))
+
+		triggers[0].Redelivery.Max = "1h"
+		err = validateTriggers(triggers)
+		assert.NotNil(t, err)
+		assert.Equal(t, true, strings.Contains(err.Error(), "multiplier can't be less than 1"))
+
+		multiplier = apicommon.NewAmount("1.5")
+		assert.Nil(t, validateTriggers(triggers))
+	})
+
 	t.Run("empty trigger template", func(t *testing.T) {
 		triggers := []v1alpha1.Trigger{
 			{
@@ -514,4 +677,517 @@ func TestValidTriggers(t *testing.T) {
 		assert.NotNil(t, err)
 		assert.Equal(t, true, strings.Contains(err.Error(), "invalid timezone"))
 	})
+
+	t.Run("invalid condition", func(t *testing.T) {
+		triggers := []v1alpha1.Trigger{
+			{
+				Template: &v1alpha1.TriggerTemplate{
+					Name:      "fake-trigger",
+					Condition: `events["dep"].body.amount >`,
+					K8s: &v1alpha1.StandardK8STrigger{
+						Operation: "create",
+						Source:    &v1alpha1.ArtifactLocation{},
+					},
+				},
+			},
+		}
+		err := validateTriggers(triggers)
+		assert.NotNil(t, err)
+		assert.Equal(t, true, strings.Contains(err.Error(), "invalid condition"))
+	})
+
+	t.Run("several trigger types", func(t *testing.T) {
+		triggers := []v1alpha1.Trigger{
+			{
+				Template: &v1alpha1.TriggerTemplate{
+					Name: "fake-trigger",
+					K8s: &v1alpha1.StandardK8STrigger{
+						Operation: "create",
+						Source:    &v1alpha1.ArtifactLocation{},
+					},
+					Log: &v1alpha1.LogTrigger{},
+				},
+			},
+		}
+		err := validateTriggers(triggers)
+		assert.NotNil(t, err)
+		assert.Equal(t, true, strings.Contains(err.Error(), "sets several trigger types k8s, log"))
+	})
+
+	t.Run("invalid timeout", func(t *testing.T) {
+		triggers := []v1alpha1.Trigger{
+			{
+				Template: &v1alpha1.TriggerTemplate{
+					Name:    "fake-trigger",
+					Timeout: "30",
+					K8s: &v1alpha1.StandardK8STrigger{
+						Operation: "create",
+						Source:    &v1alpha1.ArtifactLocation{},
+					},
+				},
+			},
+		}
+		err := validateTriggers(triggers)
+		assert.NotNil(t, err)
+		assert.Equal(t, true, strings.Contains(err.Error(), "failed to parse the timeout"))
+
+		triggers[0].Template.Timeout = "-30s"
+		err = validateTriggers(triggers)
+		assert.NotNil(t, err)
+		assert.Equal(t, true, strings.Contains(err.Error(), "timeout must be positive"))
+	})
+
+	t.Run("invalid cloud event extension", func(t *testing.T) {
+		triggers := []v1alpha1.Trigger{
+			{
+				Template: &v1alpha1.TriggerTemplate{
+					Name:       "fake-trigger",
+					CloudEvent: &v1alpha1.CloudEventEnvelope{Extensions: map[string]string{"Tenant-ID": "acme"}},
+					K8s: &v1alpha1.StandardK8STrigger{
+						Operation: "create",
+						Source:    &v1alpha1.ArtifactLocation{},
+					},
+				},
+			},
+		}
+		err := validateTriggers(triggers)
+		assert.NotNil(t, err)
+		assert.Equal(t, true, strings.Contains(err.Error(), "invalid cloud event"))
+	})
+
+	t.Run("invalid gcp cloud function name", func(t *testing.T) {
+		triggers := []v1alpha1.Trigger{
+			{
+				Template: &v1alpha1.TriggerTemplate{
+					Name: "fake-trigger",
+					GCPCloudFunction: &v1alpha1.GCPCloudFunctionTrigger{
+						FunctionName: "fake-function",
+						Payload:      []v1alpha1.TriggerParameter{},
+					},
+				},
+			},
+		}
+		err := validateTriggers(triggers)
+		assert.NotNil(t, err)
+		assert.Equal(t, true, strings.Contains(err.Error(), "invalid function name"))
+
+		// The function name templated by a parameter is only checked at runtime.
+		triggers[0].Template.GCPCloudFunction.Parameters = []v1alpha1.TriggerParameter{
+			{
+				Src: &v1alpha1.TriggerParameterSource{
+					DependencyName: "fake-dep",
+					DataKey:        "body.function",
+				},
+				Dest: "functionName",
+			},
+		}
+		assert.Nil(t, validateTriggers(triggers))
+	})
+
+	t.Run("invalid gcp cloud function proxy url", func(t *testing.T) {
+		triggers := []v1alpha1.Trigger{
+			{
+				Template: &v1alpha1.TriggerTemplate{
+					Name: "fake-trigger",
+					GCPCloudFunction: &v1alpha1.GCPCloudFunctionTrigger{
+						FunctionName: "projects/fake-project/locations/us-central1/functions/fake-function",
+						ProxyURL:     "socks5://proxy.example.com:1080",
+						Payload:      []v1alpha1.TriggerParameter{},
+					},
+				},
+			},
+		}
+		err := validateTriggers(triggers)
+		assert.NotNil(t, err)
+		assert.Equal(t, true, strings.Contains(err.Error(), "the scheme must be http or https"))
+	})
+
+	t.Run("invalid aws sqs trigger", func(t *testing.T) {
+		triggers := []v1alpha1.Trigger{
+			{
+				Template: &v1alpha1.TriggerTemplate{
+					Name: "fake-trigger",
+					AWSSQS: &v1alpha1.AWSSQSTrigger{
+						Region:  "us-east-1",
+						Payload: []v1alpha1.TriggerParameter{},
+					},
+				},
+			},
+		}
+		err := validateTriggers(triggers)
+		assert.NotNil(t, err)
+		assert.Equal(t, true, strings.Contains(err.Error(), "queue url is not specified"))
+
+		triggers[0].Template.AWSSQS.QueueURL = "https://sqs.us-east-1.amazonaws.com/123456789012/fake-queue.fifo"
+		assert.Nil(t, validateTriggers(triggers))
+	})
+
+	t.Run("invalid pushgateway trigger", func(t *testing.T) {
+		triggers := []v1alpha1.Trigger{
+			{
+				Template: &v1alpha1.TriggerTemplate{
+					Name: "fake-trigger",
+					Pushgateway: &v1alpha1.PushgatewayTrigger{
+						URL: "http://pushgateway:9091",
+						Job: "fake-job",
+						Metrics: []v1alpha1.PushgatewayMetric{
+							{Name: "fake-metric", Value: "1"},
+						},
+					},
+				},
+			},
+		}
+		err := validateTriggers(triggers)
+		assert.NotNil(t, err)
+		assert.Equal(t, true, strings.Contains(err.Error(), `invalid metric name "fake-metric"`))
+
+		triggers[0].Template.Pushgateway.Metrics[0].Name = "fake_metric"
+		assert.Nil(t, validateTriggers(triggers))
+	})
+
+	t.Run("invalid payload template", func(t *testing.T) {
+		triggers := []v1alpha1.Trigger{
+			{
+				Template: &v1alpha1.TriggerTemplate{
+					Name: "fake-trigger",
+					GCPCloudFunction: &v1alpha1.GCPCloudFunctionTrigger{
+						FunctionName: "projects/fake-project/locations/us-central1/functions/fake-function",
+						Payload: []v1alpha1.TriggerParameter{
+							{
+								Template: "{{ .input.body.id | upper }",
+								Dest:     "id",
+							},
+						},
+					},
+				},
+			},
+		}
+		err := validateTriggers(triggers)
+		assert.NotNil(t, err)
+		assert.Equal(t, true, strings.Contains(err.Error(), "parameter template is invalid"))
+
+		triggers[0].Template.GCPCloudFunction.Payload[0].Template = "{{ .input.body.id | upper }}"
+		err = validateTriggers(triggers)
+		assert.Nil(t, err)
+	})
+
+	t.Run("invalid canary group", func(t *testing.T) {
+		newTrigger := func(name string, weight int32) v1alpha1.Trigger {
+			return v1alpha1.Trigger{
+				Template: &v1alpha1.TriggerTemplate{
+					Name: name,
+					Log:  &v1alpha1.LogTrigger{},
+				},
+				CanaryGroup: "fake-group",
+				Weight:      weight,
+			}
+		}
+		triggers := []v1alpha1.Trigger{newTrigger("stable", 90), newTrigger("canary", 10)}
+		assert.Nil(t, validateTriggers(triggers))
+
+		triggers[1].Weight = -10
+		err := validateTriggers(triggers)
+		assert.NotNil(t, err)
+		assert.Equal(t, true, strings.Contains(err.Error(), "negative weight"))
+
+		triggers = []v1alpha1.Trigger{newTrigger("stable", 0), newTrigger("canary", 0)}
+		err = validateTriggers(triggers)
+		assert.NotNil(t, err)
+		assert.Equal(t, true, strings.Contains(err.Error(), "have no weight"))
+
+		triggers = []v1alpha1.Trigger{newTrigger("stable", 90), newTrigger("canary", 10)}
+		triggers[1].CanaryGroup = ""
+		err = validateTriggers(triggers)
+		assert.NotNil(t, err)
+		assert.Equal(t, true, strings.Contains(err.Error(), "no canary group"))
+
+		triggers = []v1alpha1.Trigger{newTrigger("stable", 90), newTrigger("canary", 10)}
+		triggers[1].Template.Conditions = "dep-a"
+		err = validateTriggers(triggers)
+		assert.NotNil(t, err)
+		assert.Equal(t, true, strings.Contains(err.Error(), "same conditions"))
+	})
+
+	t.Run("trigger dependencies", func(t *testing.T) {
+		newTrigger := func(name string, dependsOn ...string) v1alpha1.Trigger {
+			return v1alpha1.Trigger{
+				Template: &v1alpha1.TriggerTemplate{
+					Name: name,
+					Log:  &v1alpha1.LogTrigger{},
+				},
+				DependsOn: dependsOn,
+			}
+		}
+		triggers := []v1alpha1.Trigger{newTrigger("a"), newTrigger("b", "a"), newTrigger("c", "a", "b")}
+		assert.Nil(t, validateTriggers(triggers))
+
+		triggers = []v1alpha1.Trigger{newTrigger("a", "c"), newTrigger("b", "a"), newTrigger("c", "b")}
+		err := validateTriggers(triggers)
+		assert.NotNil(t, err)
+		assert.Equal(t, true, strings.Contains(err.Error(), "cycle"))
+
+		triggers = []v1alpha1.Trigger{newTrigger("a"), newTrigger("b", "unknown")}
+		err = validateTriggers(triggers)
+		assert.NotNil(t, err)
+		assert.Equal(t, true, strings.Contains(err.Error(), "doesn't exist"))
+	})
+}
+
+func TestValidateIdempotency(t *testing.T) {
+	assert.Nil(t, validateIdempotency(nil))
+	assert.Nil(t, validateIdempotency(&v1alpha1.Idempotency{}))
+	assert.Nil(t, validateIdempotency(&v1alpha1.Idempotency{
+		TTL:       "1h",
+		JetStream: &v1alpha1.JetStreamIdempotencyStore{URL: "nats://nats:4222", Bucket: "fake_bucket-1"},
+	}))
+
+	err := validateIdempotency(&v1alpha1.Idempotency{TTL: "-1h"})
+	assert.NotNil(t, err)
+	assert.Equal(t, true, strings.Contains(err.Error(), "ttl must be positive"))
+
+	err = validateIdempotency(&v1alpha1.Idempotency{JetStream: &v1alpha1.JetStreamIdempotencyStore{}})
+	assert.NotNil(t, err)
+	assert.Equal(t, true, strings.Contains(err.Error(), "url can't be empty"))
+
+	err = validateIdempotency(&v1alpha1.Idempotency{JetStream: &v1alpha1.JetStreamIdempotencyStore{URL: "nats://nats:4222", Bucket: "fake.bucket"}})
+	assert.NotNil(t, err)
+	assert.Equal(t, true, strings.Contains(err.Error(), "invalid jetstream bucket name"))
+}
+
+func TestValidateDeadLetter(t *testing.T) {
+	assert.Nil(t, validateDeadLetter(nil))
+	assert.Nil(t, validateDeadLetter(&v1alpha1.DeadLetter{EventBus: &v1alpha1.EventBusDeadLetterSink{Subject: "dead-letters"}}))
+	assert.Nil(t, validateDeadLetter(&v1alpha1.DeadLetter{HTTP: &v1alpha1.HTTPDeadLetterSink{URL: "https://example.com/dlq", Timeout: "5s"}}))
+	assert.Nil(t, validateDeadLetter(&v1alpha1.DeadLetter{JetStream: &v1alpha1.JetStreamDeadLetterSink{URL: "nats://nats:4222", Subject: "dlq.sensor", Stream: "dlq"}}))
+
+	err := validateDeadLetter(&v1alpha1.DeadLetter{})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "exactly one of")
+
+	err = validateDeadLetter(&v1alpha1.DeadLetter{
+		EventBus: &v1alpha1.EventBusDeadLetterSink{Subject: "dead-letters"},
+		HTTP:     &v1alpha1.HTTPDeadLetterSink{URL: "https://example.com/dlq"},
+	})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "exactly one of")
+
+	err = validateDeadLetter(&v1alpha1.DeadLetter{EventBus: &v1alpha1.EventBusDeadLetterSink{}})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "subject can't be empty")
+
+	err = validateDeadLetter(&v1alpha1.DeadLetter{HTTP: &v1alpha1.HTTPDeadLetterSink{URL: "example.com/dlq"}})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "the scheme must be http or https")
+
+	err = validateDeadLetter(&v1alpha1.DeadLetter{HTTP: &v1alpha1.HTTPDeadLetterSink{URL: "https://example.com/dlq", Timeout: "0s"}})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "timeout must be positive")
+
+	err = validateDeadLetter(&v1alpha1.DeadLetter{JetStream: &v1alpha1.JetStreamDeadLetterSink{URL: "nats://nats:4222"}})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "jetstream subject can't be empty")
+
+	err = validateDeadLetter(&v1alpha1.DeadLetter{JetStream: &v1alpha1.JetStreamDeadLetterSink{URL: "nats://nats:4222", Subject: "dlq", Stream: "dlq.stream"}})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "invalid jetstream stream name")
+}
+
+func TestValidateTriggerConcurrency(t *testing.T) {
+	sensor := &v1alpha1.Sensor{
+		Spec: v1alpha1.SensorSpec{
+			Dependencies: []v1alpha1.EventDependency{{Name: "dep", EventSourceName: "webhook", EventName: "example"}},
+			Triggers: []v1alpha1.Trigger{
+				{Template: &v1alpha1.TriggerTemplate{Name: "fake-trigger", Log: &v1alpha1.LogTrigger{}}},
+			},
+			TriggerConcurrency: 10,
+		},
+	}
+	assert.NoError(t, ValidateSensor(sensor))
+
+	sensor.Spec.TriggerConcurrency = -1
+	err := ValidateSensor(sensor)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "trigger concurrency can't be negative")
+}
+
+func TestValidateBusTrigger(t *testing.T) {
+	sensor := &v1alpha1.Sensor{
+		Spec: v1alpha1.SensorSpec{
+			Dependencies: []v1alpha1.EventDependency{{Name: "dep", EventSourceName: "webhook", EventName: "example"}},
+			Triggers: []v1alpha1.Trigger{
+				{
+					Template: &v1alpha1.TriggerTemplate{
+						Name: "fake-trigger",
+						Bus: &v1alpha1.BusTrigger{
+							EventSourceName: "orders",
+							EventName:       "order-paid",
+							Payload: []v1alpha1.TriggerParameter{
+								{Src: &v1alpha1.TriggerParameterSource{DependencyName: "dep", DataKey: "order"}, Dest: "order"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	assert.NoError(t, ValidateSensor(sensor))
+
+	t.Run("invalid trigger", func(t *testing.T) {
+		s := sensor.DeepCopy()
+		s.Spec.Triggers[0].Template.Bus.EventName = ""
+		err := ValidateSensor(s)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "event name is not specified")
+	})
+
+	t.Run("triggering the sensor itself", func(t *testing.T) {
+		s := sensor.DeepCopy()
+		s.Spec.Triggers[0].Template.Bus.EventSourceName = "webhook"
+		s.Spec.Triggers[0].Template.Bus.EventName = "example"
+		err := ValidateSensor(s)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "it would trigger the sensor itself")
+
+		// The sensors subscribed to another subject are triggered instead.
+		s.Spec.Triggers[0].Template.Bus.Subject = "eventbus-downstream"
+		assert.NoError(t, ValidateSensor(s))
+	})
+
+	t.Run("triggering the sensor itself by a glob", func(t *testing.T) {
+		s := sensor.DeepCopy()
+		s.Spec.Dependencies[0].EventName = "order-*"
+		s.Spec.Dependencies[0].EventSourceName = "orders"
+		err := ValidateSensor(s)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "it would trigger the sensor itself")
+	})
+
+	t.Run("templated event name", func(t *testing.T) {
+		s := sensor.DeepCopy()
+		s.Spec.Triggers[0].Template.Bus.Parameters = []v1alpha1.TriggerParameter{
+			{Src: &v1alpha1.TriggerParameterSource{DependencyName: "dep", DataKey: "name"}, Dest: "eventName"},
+		}
+		err := ValidateSensor(s)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "may be the ones of dependency dep")
+
+		s.Spec.Triggers[0].Template.Bus.Subject = "eventbus-downstream"
+		assert.NoError(t, ValidateSensor(s))
+	})
+}
+
+func TestValidateMaxInFlightEvents(t *testing.T) {
+	sensor := &v1alpha1.Sensor{
+		Spec: v1alpha1.SensorSpec{
+			Dependencies: []v1alpha1.EventDependency{{Name: "dep", EventSourceName: "webhook", EventName: "example"}},
+			Triggers: []v1alpha1.Trigger{
+				{Template: &v1alpha1.TriggerTemplate{Name: "fake-trigger", Log: &v1alpha1.LogTrigger{}}},
+			},
+			MaxInFlightEvents: 100,
+		},
+	}
+	assert.NoError(t, ValidateSensor(sensor))
+
+	sensor.Spec.MaxInFlightEvents = -1
+	err := ValidateSensor(sensor)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "max in-flight events can't be negative")
+}
+
+func TestValidatePartitionKey(t *testing.T) {
+	sensor := &v1alpha1.Sensor{
+		Spec: v1alpha1.SensorSpec{
+			Dependencies: []v1alpha1.EventDependency{{Name: "dep", EventSourceName: "webhook", EventName: "example"}},
+			Triggers: []v1alpha1.Trigger{
+				{Template: &v1alpha1.TriggerTemplate{Name: "fake-trigger", Log: &v1alpha1.LogTrigger{}}},
+			},
+			PartitionKey:        `events["dep"].body.customerId`,
+			MaxActivePartitions: 100,
+		},
+	}
+	assert.NoError(t, ValidateSensor(sensor))
+
+	sensor.Spec.PartitionKey = `events["dep"].body.customerId ==`
+	err := ValidateSensor(sensor)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid partition key")
+
+	sensor.Spec.PartitionKey = ""
+	sensor.Spec.MaxActivePartitions = -1
+	err = ValidateSensor(sensor)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "max active partitions can't be negative")
+}
+
+func TestValidateDeliverySemantics(t *testing.T) {
+	sensor := &v1alpha1.Sensor{
+		Spec: v1alpha1.SensorSpec{
+			Dependencies: []v1alpha1.EventDependency{{Name: "dep", EventSourceName: "webhook", EventName: "example"}},
+			Triggers: []v1alpha1.Trigger{
+				{Template: &v1alpha1.TriggerTemplate{Name: "fake-trigger", Log: &v1alpha1.LogTrigger{}}},
+			},
+		},
+	}
+	for _, semantics := range []v1alpha1.DeliverySemantics{"", v1alpha1.DeliverySemanticsAtLeastOnce, v1alpha1.DeliverySemanticsAtMostOnce} {
+		sensor.Spec.DeliverySemantics = semantics
+		assert.NoError(t, ValidateSensor(sensor))
+	}
+
+	sensor.Spec.DeliverySemantics = "ExactlyOnce"
+	err := ValidateSensor(sensor)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown delivery semantics")
+}
+
+func TestValidateHTTPTriggerRetries(t *testing.T) {
+	trigger := &v1alpha1.HTTPTrigger{URL: "https://example.com", RetryStrategy: &apicommon.Backoff{Steps: 3}, RetryOn: []string{"429", "5xx"}}
+	assert.NoError(t, validateHTTPTrigger(trigger))
+
+	trigger.RetryOn = []string{"5xx", "600"}
+	err := validateHTTPTrigger(trigger)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid retry statuses")
+
+	factor := apicommon.NewAmount("abc")
+	trigger.RetryOn = nil
+	trigger.RetryStrategy = &apicommon.Backoff{Factor: &factor}
+	err = validateHTTPTrigger(trigger)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid retry strategy")
+}
+
+func TestValidateStatusPolicy(t *testing.T) {
+	assert.NoError(t, validateStatusPolicy(nil))
+	assert.NoError(t, validateStatusPolicy(&v1alpha1.StatusPolicy{Allow: []int32{200}}))
+	assert.NoError(t, validateStatusPolicy(&v1alpha1.StatusPolicy{AllowRanges: []string{"2xx", "404", "300-304"}}))
+
+	err := validateStatusPolicy(&v1alpha1.StatusPolicy{})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "list of allowed response status is not specified")
+
+	err = validateStatusPolicy(&v1alpha1.StatusPolicy{AllowRanges: []string{"204-200"}})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid allowed response status")
+}
+
+func TestValidateKafkaTrigger(t *testing.T) {
+	trigger := &v1alpha1.KafkaTrigger{
+		URL:                     "kafka:9092",
+		Topic:                   "orders",
+		Payload:                 []v1alpha1.TriggerParameter{{Src: &v1alpha1.TriggerParameterSource{DependencyName: "dep", DataKey: "body"}, Dest: "body"}},
+		Idempotent:              true,
+		PartitioningKeyTemplate: "{{ .dep.customerId }}",
+	}
+	assert.NoError(t, validateKafkaTrigger(trigger))
+
+	trigger.RequiredAcks = 1
+	err := validateKafkaTrigger(trigger)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "requires the acknowledgement of all the in-sync replicas")
+
+	trigger.RequiredAcks = -1
+	trigger.PartitioningKeyTemplate = "{{ .dep.customerId"
+	err = validateKafkaTrigger(trigger)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "partitioning key template is invalid")
 }
@@ -0,0 +1,127 @@
+package driver
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"sync"
+
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+	"github.com/pkg/errors"
+
+	eventbusv1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
+)
+
+// The header bytes of the compressed messages, telling the codec they are compressed with. The uncompressed
+// messages are JSON documents, which never start with any of them, and are published as is for the subscribers
+// not decompressing the messages yet to keep reading them.
+const (
+	headerGzip   byte = 0x01
+	headerSnappy byte = 0x02
+	headerZstd   byte = 0x03
+)
+
+// maxDecompressedSize is the max size of a decompressed message, way above the max payload of the bus, for a
+// corrupted or malicious message not to exhaust the memory of the subscriber.
+const maxDecompressedSize = 64 * 1024 * 1024
+
+var (
+	zstdOnce    sync.Once
+	zstdEncoder *zstd.Encoder
+	zstdDecoder *zstd.Decoder
+	zstdErr     error
+)
+
+// zstdCodec returns the zstd encoder and decoder, shared by the connections since their EncodeAll and DecodeAll
+// can be called concurrently.
+func zstdCodec() (*zstd.Encoder, *zstd.Decoder, error) {
+	zstdOnce.Do(func() {
+		zstdEncoder, zstdErr = zstd.NewWriter(nil)
+		if zstdErr != nil {
+			return
+		}
+		zstdDecoder, zstdErr = zstd.NewReader(nil, zstd.WithDecoderMaxMemory(maxDecompressedSize), zstd.WithDecoderConcurrency(0))
+	})
+	return zstdEncoder, zstdDecoder, zstdErr
+}
+
+// Compress compresses the message with the codec, prefixed with the header byte of the codec. The message is
+// returned as is without a codec, or with "none".
+func Compress(codec eventbusv1alpha1.CompressionCodec, message []byte) ([]byte, error) {
+	switch codec {
+	case "", eventbusv1alpha1.CompressionNone:
+		return message, nil
+	case eventbusv1alpha1.CompressionGzip:
+		var buf bytes.Buffer
+		buf.WriteByte(headerGzip)
+		w := gzip.NewWriter(&buf)
+		if _, err := w.Write(message); err != nil {
+			return nil, errors.Wrap(err, "failed to compress the message with gzip")
+		}
+		if err := w.Close(); err != nil {
+			return nil, errors.Wrap(err, "failed to compress the message with gzip")
+		}
+		return buf.Bytes(), nil
+	case eventbusv1alpha1.CompressionSnappy:
+		return append([]byte{headerSnappy}, snappy.Encode(nil, message)...), nil
+	case eventbusv1alpha1.CompressionZstd:
+		encoder, _, err := zstdCodec()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create the zstd encoder")
+		}
+		return encoder.EncodeAll(message, []byte{headerZstd}), nil
+	default:
+		return nil, errors.Errorf("unsupported compression codec %q", codec)
+	}
+}
+
+// Decompress returns the message decompressed with the codec of its header byte, whatever the codec the messages
+// are published with, or as is if it is not compressed.
+func Decompress(message []byte) ([]byte, error) {
+	if len(message) == 0 {
+		return message, nil
+	}
+	switch message[0] {
+	case headerGzip:
+		r, err := gzip.NewReader(bytes.NewReader(message[1:]))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to decompress the gzip message")
+		}
+		defer r.Close()
+		data, err := ioutil.ReadAll(io.LimitReader(r, maxDecompressedSize+1))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to decompress the gzip message")
+		}
+		if len(data) > maxDecompressedSize {
+			return nil, errors.Errorf("the decompressed message exceeds %d bytes", maxDecompressedSize)
+		}
+		return data, nil
+	case headerSnappy:
+		n, err := snappy.DecodedLen(message[1:])
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to decompress the snappy message")
+		}
+		if n > maxDecompressedSize {
+			return nil, errors.Errorf("the decompressed message exceeds %d bytes", maxDecompressedSize)
+		}
+		data, err := snappy.Decode(nil, message[1:])
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to decompress the snappy message")
+		}
+		return data, nil
+	case headerZstd:
+		_, decoder, err := zstdCodec()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create the zstd decoder")
+		}
+		data, err := decoder.DecodeAll(message[1:], nil)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to decompress the zstd message")
+		}
+		return data, nil
+	default:
+		return message, nil
+	}
+}
@@ -0,0 +1,124 @@
+package driver
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/stretchr/testify/assert"
+
+	eventbusv1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
+)
+
+var codecs = []eventbusv1alpha1.CompressionCodec{
+	eventbusv1alpha1.CompressionNone,
+	eventbusv1alpha1.CompressionGzip,
+	eventbusv1alpha1.CompressionSnappy,
+	eventbusv1alpha1.CompressionZstd,
+}
+
+// testMessage returns a message as published by an event source, with a body of about the size
+func testMessage(t testing.TB, size int) []byte {
+	event := cloudevents.NewEvent()
+	event.SetID("d2a3ffb2-7b40-4bd4-8f7c-8d6a6f4b2b9e")
+	event.SetSource("webhook")
+	event.SetSubject("example")
+	event.SetType("webhook")
+	event.SetTime(time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC))
+	var items []string
+	for i := 0; len(strings.Join(items, ",")) < size; i++ {
+		items = append(items, fmt.Sprintf(`{"id":%d,"name":"item-%d","status":"active","tags":["a","b"]}`, i, i))
+	}
+	if err := event.SetData(cloudevents.ApplicationJSON, json.RawMessage(`{"items":[`+strings.Join(items, ",")+`]}`)); err != nil {
+		t.Fatal(err)
+	}
+	message, err := json.Marshal(event)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return message
+}
+
+func TestCompression(t *testing.T) {
+	message := testMessage(t, 4096)
+
+	for _, codec := range codecs {
+		t.Run(string(codec), func(t *testing.T) {
+			compressed, err := Compress(codec, message)
+			assert.NoError(t, err)
+			if codec == eventbusv1alpha1.CompressionNone {
+				assert.Equal(t, message, compressed)
+			} else {
+				assert.Less(t, len(compressed), len(message))
+			}
+			decompressed, err := Decompress(compressed)
+			assert.NoError(t, err)
+			assert.Equal(t, message, decompressed)
+
+			var event cloudevents.Event
+			assert.NoError(t, json.Unmarshal(decompressed, &event))
+			assert.Equal(t, "webhook", event.Source())
+		})
+	}
+
+	t.Run("mixed messages", func(t *testing.T) {
+		// The messages of the codecs used while rolling out the compression are all decompressed.
+		for _, codec := range []eventbusv1alpha1.CompressionCodec{"", eventbusv1alpha1.CompressionZstd, eventbusv1alpha1.CompressionGzip, eventbusv1alpha1.CompressionNone} {
+			compressed, err := Compress(codec, message)
+			assert.NoError(t, err)
+			decompressed, err := Decompress(compressed)
+			assert.NoError(t, err)
+			assert.Equal(t, message, decompressed)
+		}
+	})
+
+	t.Run("empty message", func(t *testing.T) {
+		for _, codec := range codecs {
+			compressed, err := Compress(codec, nil)
+			assert.NoError(t, err)
+			decompressed, err := Decompress(compressed)
+			assert.NoError(t, err)
+			assert.Empty(t, decompressed)
+		}
+	})
+
+	t.Run("unsupported codec", func(t *testing.T) {
+		_, err := Compress("lz4", message)
+		assert.Error(t, err)
+	})
+
+	t.Run("corrupted message", func(t *testing.T) {
+		for _, header := range []byte{headerGzip, headerSnappy, headerZstd} {
+			_, err := Decompress([]byte{header, 'x', 'y', 'z'})
+			assert.Error(t, err)
+		}
+	})
+}
+
+// BenchmarkCompression reports the compressed size of the messages as a percentage of their size, e.g.
+// go test ./eventbus/driver -run XXX -bench Compression
+func BenchmarkCompression(b *testing.B) {
+	for _, size := range []int{512, 16 * 1024, 256 * 1024} {
+		message := testMessage(b, size)
+		for _, codec := range codecs {
+			b.Run(fmt.Sprintf("%s/%d", codec, size), func(b *testing.B) {
+				var compressed []byte
+				b.SetBytes(int64(len(message)))
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					var err error
+					if compressed, err = Compress(codec, message); err != nil {
+						b.Fatal(err)
+					}
+					if _, err := Decompress(compressed); err != nil {
+						b.Fatal(err)
+					}
+				}
+				b.ReportMetric(100*float64(len(compressed))/float64(len(message)), "%size")
+			})
+		}
+	}
+}
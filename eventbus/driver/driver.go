@@ -21,8 +21,10 @@ type Driver interface {
 	// Parameter - dependencyExpr, example: "(dep1 || dep2) && dep3"
 	// Parameter - dependencies, array of dependencies information
 	// Parameter - filter, a function used to filter the message
-	// Parameter - action, a function to be triggered after all conditions meet
-	SubscribeEventSources(ctx context.Context, conn Connection, group string, closeCh <-chan struct{}, resetConditionsCh <-chan struct{}, lastResetTime time.Time, dependencyExpr string, dependencies []Dependency, transform func(depName string, event cloudevents.Event) (*cloudevents.Event, error), filter func(string, cloudevents.Event) bool, action func(map[string]cloudevents.Event)) error
+	// Parameter - action, a function to be triggered after all conditions meet, given the function to call once it is done
+	// Parameter - action done, called with an error for the messages to be redelivered instead of acknowledged
+	// Parameter - atMostOnce, acknowledge the messages before the action instead of once it is done
+	SubscribeEventSources(ctx context.Context, conn Connection, group string, closeCh <-chan struct{}, resetConditionsCh <-chan struct{}, lastResetTime time.Time, dependencyExpr string, dependencies []Dependency, transform func(depName string, event cloudevents.Event) (*cloudevents.Event, error), filter func(string, cloudevents.Event) bool, action func(map[string]cloudevents.Event, func(error)), atMostOnce bool) error
 
 	// Publish a message
 	Publish(conn Connection, message []byte) error
@@ -35,6 +37,10 @@ type Connection interface {
 	IsClosed() bool
 
 	Publish(subject string, data []byte) error
+
+	// PublishAsync publishes without waiting for the acknowledgement, the ack handler is called once the
+	// message is acknowledged, with the error if it is not.
+	PublishAsync(subject string, data []byte, ackHandler func(err error)) error
 }
 
 // Auth contains the auth infor for event bus
@@ -2,6 +2,7 @@ package driver
 
 import (
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"strings"
 	"sync"
@@ -50,25 +51,38 @@ func (nsc *natsStreamingConnection) Publish(subject string, data []byte) error {
 	return nsc.stanConn.Publish(subject, data)
 }
 
+func (nsc *natsStreamingConnection) PublishAsync(subject string, data []byte, ackHandler func(err error)) error {
+	_, err := nsc.stanConn.PublishAsync(subject, data, func(_ string, err error) {
+		ackHandler(err)
+	})
+	return err
+}
+
 type natsStreaming struct {
 	url       string
 	auth      *Auth
+	tlsConfig *tls.Config
 	clusterID string
 	subject   string
 	clientID  string
+	// compression is the codec of the messages published
+	compression eventbusv1alpha1.CompressionCodec
 
 	logger *zap.SugaredLogger
 }
 
-// NewNATSStreaming returns a nats streaming driver
-func NewNATSStreaming(url, clusterID, subject, clientID string, auth *Auth, logger *zap.SugaredLogger) Driver {
+// NewNATSStreaming returns a nats streaming driver, connecting with TLS if the tls config is not nil, and
+// publishing the messages compressed with the compression codec
+func NewNATSStreaming(url, clusterID, subject, clientID string, auth *Auth, tlsConfig *tls.Config, compression eventbusv1alpha1.CompressionCodec, logger *zap.SugaredLogger) Driver {
 	return &natsStreaming{
-		url:       url,
-		clusterID: clusterID,
-		subject:   subject,
-		clientID:  clientID,
-		auth:      auth,
-		logger:    logger,
+		url:         url,
+		clusterID:   clusterID,
+		subject:     subject,
+		clientID:    clientID,
+		auth:        auth,
+		tlsConfig:   tlsConfig,
+		compression: compression,
+		logger:      logger,
 	}
 }
 
@@ -96,6 +110,9 @@ func (n *natsStreaming) Connect() (Connection, error) {
 	default:
 		return nil, errors.New("unsupported auth strategy")
 	}
+	if n.tlsConfig != nil {
+		opts = append(opts, nats.Secure(n.tlsConfig))
+	}
 	nc, err := nats.Connect(n.url, opts...)
 	if err != nil {
 		log.Errorw("Failed to connect to NATS server", zap.Error(err))
@@ -121,7 +138,11 @@ func (n *natsStreaming) Connect() (Connection, error) {
 }
 
 func (n *natsStreaming) Publish(conn Connection, message []byte) error {
-	return conn.Publish(n.subject, message)
+	data, err := Compress(n.compression, message)
+	if err != nil {
+		return err
+	}
+	return conn.Publish(n.subject, data)
 }
 
 // SubscribeEventSources is used to subscribe multiple event source dependencies
@@ -134,8 +155,10 @@ func (n *natsStreaming) Publish(conn Connection, message []byte) error {
 // Parameter - dependencyExpr, example: "(dep1 || dep2) && dep3"
 // Parameter - dependencies, array of dependencies information
 // Parameter - filter, a function used to filter the message
-// Parameter - action, a function to be triggered after all conditions meet
-func (n *natsStreaming) SubscribeEventSources(ctx context.Context, conn Connection, group string, closeCh <-chan struct{}, resetConditionsCh <-chan struct{}, lastResetTime time.Time, dependencyExpr string, dependencies []Dependency, transform func(depName string, event cloudevents.Event) (*cloudevents.Event, error), filter func(string, cloudevents.Event) bool, action func(map[string]cloudevents.Event)) error {
+// Parameter - action, a function to be triggered after all conditions meet, given the function to call once it is done
+// Parameter - action done, called with an error for the messages to be redelivered instead of acknowledged
+// Parameter - atMostOnce, acknowledge the messages before the action instead of once it is done
+func (n *natsStreaming) SubscribeEventSources(ctx context.Context, conn Connection, group string, closeCh <-chan struct{}, resetConditionsCh <-chan struct{}, lastResetTime time.Time, dependencyExpr string, dependencies []Dependency, transform func(depName string, event cloudevents.Event) (*cloudevents.Event, error), filter func(string, cloudevents.Event) bool, action func(map[string]cloudevents.Event, func(error)), atMostOnce bool) error {
 	log := n.logger.With("clientID", n.clientID)
 	msgHolder, err := newEventSourceMessageHolder(log, dependencyExpr, dependencies, lastResetTime)
 	if err != nil {
@@ -148,7 +171,7 @@ func (n *natsStreaming) SubscribeEventSources(ctx context.Context, conn Connecti
 	// use group name as durable name
 	durableName := group
 	sub, err := nsc.stanConn.QueueSubscribe(n.subject, group, func(m *stan.Msg) {
-		n.processEventSourceMsg(m, msgHolder, transform, filter, action, log)
+		n.processEventSourceMsg(m, msgHolder, transform, filter, action, atMostOnce, log)
 	}, stan.DurableName(durableName),
 		stan.SetManualAckMode(),
 		stan.StartAt(pb.StartPosition_NewOnly),
@@ -215,21 +238,21 @@ func (n *natsStreaming) SubscribeEventSources(ctx context.Context, conn Connecti
 	}
 }
 
-func (n *natsStreaming) processEventSourceMsg(m *stan.Msg, msgHolder *eventSourceMessageHolder, transform func(depName string, event cloudevents.Event) (*cloudevents.Event, error), filter func(dependencyName string, event cloudevents.Event) bool, action func(map[string]cloudevents.Event), log *zap.SugaredLogger) {
-	var event *cloudevents.Event
-	if err := json.Unmarshal(m.Data, &event); err != nil {
-		log.Errorf("Failed to convert to a cloudevent, discarding it... err: %v", err)
+func (n *natsStreaming) processEventSourceMsg(m *stan.Msg, msgHolder *eventSourceMessageHolder, transform func(depName string, event cloudevents.Event) (*cloudevents.Event, error), filter func(dependencyName string, event cloudevents.Event) bool, action func(map[string]cloudevents.Event, func(error)), atMostOnce bool, log *zap.SugaredLogger) {
+	data, err := Decompress(m.Data)
+	if err != nil {
+		log.Errorw("Failed to decompress the message, discarding it...", zap.Error(err))
 		_ = m.Ack()
 		return
 	}
-
-	depName, err := msgHolder.getDependencyName(event.Source(), event.Subject())
-	if err != nil {
-		log.Errorf("Failed to get the dependency name, discarding it... err: %v", err)
+	var event *cloudevents.Event
+	if err := json.Unmarshal(data, &event); err != nil {
+		log.Errorf("Failed to convert to a cloudevent, discarding it... err: %v", err)
 		_ = m.Ack()
 		return
 	}
 
+	depName := msgHolder.getDependencyName(event.Source(), event.Subject())
 	log.Debugf("New incoming Event Source Message, dependency name=%s", depName)
 
 	if depName == "" {
@@ -259,8 +282,17 @@ func (n *natsStreaming) processEventSourceMsg(m *stan.Msg, msgHolder *eventSourc
 		return
 	}
 
+	// The messages of the actions in progress are redelivered until the actions are done
+	if _, ok := msgHolder.inFlight.Load(event.ID()); ok {
+		log.Debugw("the actions of the message are in progress, not acknowledging it yet", "eventID", event.ID())
+		return
+	}
+
+	// The messages of the actions which didn't complete are processed again as they are redelivered
+	_, retried := msgHolder.retry.LoadAndDelete(event.ID())
+
 	// Acknowledge any old messages that occurred before the last reset (standard reset after trigger or conditional reset)
-	if m.Timestamp <= msgHolder.getLastResetTime().UnixNano() {
+	if !retried && m.Timestamp <= msgHolder.getLastResetTime().UnixNano() {
 		if depName != "" {
 			msgHolder.reset(depName)
 		}
@@ -335,10 +367,33 @@ func (n *natsStreaming) processEventSourceMsg(m *stan.Msg, msgHolder *eventSourc
 	}
 	log.Debugf("Triggering actions for client %s", n.clientID)
 
-	action(messages)
-
 	msgHolder.reset(depName)
-	msgHolder.ackAndCache(m, event.ID())
+	if atMostOnce {
+		// Acknowledge before the actions, for a crash during them not to get the message redelivered
+		msgHolder.ackAndCache(m, event.ID())
+		action(messages, func(error) {})
+		return
+	}
+
+	// Acknowledge once the actions are done, for a crash during them to get the message redelivered. The messages
+	// of the other dependencies are acknowledged as they are redelivered once the actions are done. The actions
+	// may be done from another goroutine, once the subscription moved on to other messages.
+	for _, v := range messages {
+		msgHolder.inFlight.Store(v.ID(), true)
+	}
+	action(messages, func(err error) {
+		for _, v := range messages {
+			if err != nil {
+				msgHolder.retry.Store(v.ID(), true)
+			}
+			msgHolder.inFlight.Delete(v.ID())
+		}
+		if err != nil {
+			log.Warnw("the actions didn't complete, not acknowledging the message for it to be redelivered", "eventID", event.ID(), zap.Error(err))
+			return
+		}
+		msgHolder.ackAndCache(m, event.ID())
+	})
 }
 
 // eventSourceMessage is used by messageHolder to hold the latest message
@@ -359,12 +414,18 @@ type eventSourceMessageHolder struct {
 	resetTimeout int64
 	expr         *govaluate.EvaluableExpression
 	depNames     []string
-	// Mapping of [eventSourceName + eventName]dependencyName
+	// Mapping of [eventSourceName + eventName]dependencyName, for the dependencies matching exact names
 	sourceDepMap map[string]string
-	parameters   map[string]interface{}
-	msgs         map[string]*eventSourceMessage
+	// Matchers of the dependencies matching names by globs, in the order of the dependencies
+	depMatchers []dependencyMatcher
+	parameters  map[string]interface{}
+	msgs        map[string]*eventSourceMessage
 	// A sync map used to cache the message IDs, it is used to guarantee Exact Once triggering
-	smap        *sync.Map
+	smap *sync.Map
+	// IDs of the messages whose actions are in progress, not acknowledged until the actions are done
+	inFlight *sync.Map
+	// IDs of the messages whose actions didn't complete, processed again when they are redelivered
+	retry       *sync.Map
 	lock        sync.RWMutex
 	timeoutLock sync.RWMutex
 
@@ -383,9 +444,17 @@ func newEventSourceMessageHolder(logger *zap.SugaredLogger, dependencyExpr strin
 	}
 
 	srcDepMap := make(map[string]string)
+	var depMatchers []dependencyMatcher
 	for _, d := range dependencies {
-		key := d.EventSourceName + "__" + d.EventName
-		srcDepMap[key] = d.Name
+		if !isGlob(d.EventSourceName) && !isGlob(d.EventName) {
+			srcDepMap[d.EventSourceName+"__"+d.EventName] = d.Name
+			continue
+		}
+		matcher, err := newDependencyMatcher(d)
+		if err != nil {
+			return nil, err
+		}
+		depMatchers = append(depMatchers, matcher)
 	}
 
 	parameters := make(map[string]interface{}, len(deps))
@@ -399,9 +468,12 @@ func newEventSourceMessageHolder(logger *zap.SugaredLogger, dependencyExpr strin
 		expr:          expression,
 		depNames:      deps,
 		sourceDepMap:  srcDepMap,
+		depMatchers:   depMatchers,
 		parameters:    parameters,
 		msgs:          msgs,
 		smap:          new(sync.Map),
+		inFlight:      new(sync.Map),
+		retry:         new(sync.Map),
 		lock:          sync.RWMutex{},
 		logger:        logger,
 	}, nil
@@ -440,17 +512,44 @@ func (mh *eventSourceMessageHolder) fullResetTimeout() bool {
 	return resetTimeout != 0 && time.Now().Unix() > resetTimeout
 }
 
-func (mh *eventSourceMessageHolder) getDependencyName(eventSourceName, eventName string) (string, error) {
-	for k, v := range mh.sourceDepMap {
-		sourceGlob, err := glob.Compile(k)
-		if err != nil {
-			return "", err
-		}
-		if sourceGlob.Match(eventSourceName + "__" + eventName) {
-			return v, nil
+// getDependencyName returns the name of the dependency the event belongs to, or an empty string if none.
+// The dependencies matching the exact names of the event source and the event take precedence over the
+// ones matching them by globs, which are tried in the order of the dependencies.
+func (mh *eventSourceMessageHolder) getDependencyName(eventSourceName, eventName string) string {
+	if depName, ok := mh.sourceDepMap[eventSourceName+"__"+eventName]; ok {
+		return depName
+	}
+	for _, m := range mh.depMatchers {
+		if m.eventSourceName.Match(eventSourceName) && m.eventName.Match(eventName) {
+			return m.name
 		}
 	}
-	return "", nil
+	return ""
+}
+
+// dependencyMatcher matches the events of a dependency by the globs of its event source and event names,
+// compiled once for all the messages.
+type dependencyMatcher struct {
+	name            string
+	eventSourceName glob.Glob
+	eventName       glob.Glob
+}
+
+func newDependencyMatcher(d Dependency) (dependencyMatcher, error) {
+	eventSourceName, err := glob.Compile(d.EventSourceName)
+	if err != nil {
+		return dependencyMatcher{}, errors.Wrapf(err, "invalid event source name %q of dependency %s", d.EventSourceName, d.Name)
+	}
+	eventName, err := glob.Compile(d.EventName)
+	if err != nil {
+		return dependencyMatcher{}, errors.Wrapf(err, "invalid event name %q of dependency %s", d.EventName, d.Name)
+	}
+	return dependencyMatcher{name: d.Name, eventSourceName: eventSourceName, eventName: eventName}, nil
+}
+
+// isGlob returns true if the name holds glob special characters, e.g. a prefix such as "orders-*".
+func isGlob(name string) bool {
+	return glob.QuoteMeta(name) != name
 }
 
 // Ack the stan message and cache the ID to make sure Exact Once triggering
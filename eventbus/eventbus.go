@@ -2,6 +2,7 @@ package eventbus
 
 import (
 	"context"
+	"crypto/tls"
 
 	"github.com/fsnotify/fsnotify"
 	"github.com/pkg/errors"
@@ -20,9 +21,11 @@ func GetDriver(ctx context.Context, eventBusConfig eventbusv1alpha1.BusConfig, s
 	logger := logging.FromContext(ctx)
 	var eventBusType apicommon.EventBusType
 	var eventBusAuth *eventbusv1alpha1.AuthStrategy
+	var eventBusTLS *eventbusv1alpha1.BusTLSConfig
 	if eventBusConfig.NATS != nil {
 		eventBusType = apicommon.EventBusNATS
 		eventBusAuth = eventBusConfig.NATS.Auth
+		eventBusTLS = eventBusConfig.NATS.TLS
 	} else {
 		return nil, errors.New("invalid event bus")
 	}
@@ -57,12 +60,42 @@ func GetDriver(ctx context.Context, eventBusConfig eventbusv1alpha1.BusConfig, s
 		}
 	}
 
+	var tlsConfig *tls.Config
+	if eventBusTLS != nil {
+		c, err := GetTLSConfig(eventBusTLS)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get the eventbus tls config")
+		}
+		tlsConfig = c
+	}
+
 	var dvr driver.Driver
 	switch eventBusType {
 	case apicommon.EventBusNATS:
-		dvr = driver.NewNATSStreaming(eventBusConfig.NATS.URL, *eventBusConfig.NATS.ClusterID, subject, clientID, auth, logger)
+		dvr = driver.NewNATSStreaming(eventBusConfig.NATS.URL, *eventBusConfig.NATS.ClusterID, subject, clientID, auth, tlsConfig, eventBusConfig.Compression, logger)
 	default:
 		return nil, errors.New("invalid eventbus type")
 	}
 	return dvr, nil
 }
+
+// GetTLSConfig returns the tls configuration of the connection to the eventbus, from the mounted secrets
+func GetTLSConfig(config *eventbusv1alpha1.BusTLSConfig) (*tls.Config, error) {
+	var caCert string
+	if config.CACertSecret != nil {
+		v, err := common.GetSecretFromVolume(config.CACertSecret)
+		if err != nil {
+			return nil, err
+		}
+		caCert = v
+	}
+	cert, err := common.GetSecretFromVolume(config.CertSecret)
+	if err != nil {
+		return nil, err
+	}
+	key, err := common.GetSecretFromVolume(config.KeySecret)
+	if err != nil {
+		return nil, err
+	}
+	return common.NewClientTLSConfig([]byte(caCert), []byte(cert), []byte(key), config.InsecureSkipVerify, config.MinVersion)
+}
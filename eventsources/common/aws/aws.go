@@ -45,11 +45,11 @@ func GetAWSCredFromEnvironment(access *corev1.SecretKeySelector, secret *corev1.
 
 // GetAWSCredFromVolume reads credential stored in mounted secret volume.
 func GetAWSCredFromVolume(access *corev1.SecretKeySelector, secret *corev1.SecretKeySelector) (*credentials.Credentials, error) {
-	accessKey, err := common.GetSecretFromVolume(access)
+	accessKey, err := common.GetSecret(access)
 	if err != nil {
 		return nil, errors.Wrap(err, "can not find access key")
 	}
-	secretKey, err := common.GetSecretFromVolume(secret)
+	secretKey, err := common.GetSecret(secret)
 	if err != nil {
 		return nil, errors.Wrap(err, "can not find secret key")
 	}
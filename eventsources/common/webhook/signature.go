@@ -0,0 +1,194 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+)
+
+const (
+	// SignatureAlgorithmSHA1 signs the requests with HMAC-SHA1, e.g. the legacy GitHub signatures
+	SignatureAlgorithmSHA1 = "sha1"
+	// SignatureAlgorithmSHA256 signs the requests with HMAC-SHA256
+	SignatureAlgorithmSHA256 = "sha256"
+
+	// defaultSignatureHeader is the header holding the signature if none is specified
+	defaultSignatureHeader = "X-Signature"
+	// defaultSignatureTolerance is how far the timestamp of a request can be if no tolerance is specified
+	defaultSignatureTolerance = 5 * time.Minute
+)
+
+var (
+	// ErrSignatureMissing is the error of a request with no signature
+	ErrSignatureMissing = errors.New("the request is not signed")
+	// ErrSignatureMismatch is the error of a request signed with another secret, or tampered with
+	ErrSignatureMismatch = errors.New("the signature of the request does not match")
+	// ErrTimestampOutsideTolerance is the error of a request signed too long ago, e.g. replayed, or in the future
+	ErrTimestampOutsideTolerance = errors.New("the timestamp of the request is outside of the tolerance")
+)
+
+// SignatureVerifier verifies the HMAC signatures of the webhook requests, shared by the event sources
+// receiving signed requests.
+type SignatureVerifier struct {
+	// Secret is the key the signatures are computed with
+	Secret []byte
+	// Algorithm is the hash function of the HMAC, sha1 or sha256
+	Algorithm string
+	// Header holding the hex encoded signature
+	Header string
+	// Prefix of the signature in the header, e.g. "sha256="
+	Prefix string
+	// TimestampHeader holding the Unix time in seconds the request was signed at, if any. The signature
+	// is then computed over "{timestamp}.{body}".
+	TimestampHeader string
+	// Tolerance is how far the timestamp can be from the time the request is received
+	Tolerance time.Duration
+	// now returns the current time, it is overridden by the tests
+	now func() time.Time
+}
+
+// NewSignatureVerifier returns the verifier of the signature of a webhook, signed with the secret.
+func NewSignatureVerifier(secret []byte, signature *v1alpha1.WebhookSignature) (*SignatureVerifier, error) {
+	if err := ValidateSignature(signature); err != nil {
+		return nil, err
+	}
+	v := &SignatureVerifier{
+		Secret:          secret,
+		Algorithm:       signature.Algorithm,
+		Header:          signature.Header,
+		Prefix:          signature.Prefix,
+		TimestampHeader: signature.TimestampHeader,
+		Tolerance:       defaultSignatureTolerance,
+	}
+	if v.Algorithm == "" {
+		v.Algorithm = SignatureAlgorithmSHA256
+	}
+	if v.Header == "" {
+		v.Header = defaultSignatureHeader
+	}
+	if signature.Tolerance != "" {
+		// Validated above.
+		v.Tolerance, _ = time.ParseDuration(signature.Tolerance)
+	}
+	return v, nil
+}
+
+// ValidateSignature validates the signature config of a webhook
+func ValidateSignature(signature *v1alpha1.WebhookSignature) error {
+	if signature == nil {
+		return nil
+	}
+	if signature.Secret == nil {
+		return errors.New("signature secret is required")
+	}
+	switch signature.Algorithm {
+	case "", SignatureAlgorithmSHA1, SignatureAlgorithmSHA256:
+	default:
+		return errors.Errorf("unsupported signature algorithm %q, it must be sha1 or sha256", signature.Algorithm)
+	}
+	if signature.Tolerance != "" {
+		tolerance, err := time.ParseDuration(signature.Tolerance)
+		if err != nil {
+			return errors.Wrapf(err, "failed to parse the signature tolerance %s", signature.Tolerance)
+		}
+		if tolerance <= 0 {
+			return errors.New("signature tolerance must be positive")
+		}
+	}
+	return nil
+}
+
+// Sign returns the signature of the body signed at the timestamp, as expected in the signature header.
+// The timestamp is ignored if the verifier has no timestamp header.
+func (v *SignatureVerifier) Sign(body []byte, timestamp string) string {
+	return v.Prefix + hex.EncodeToString(v.mac(body, timestamp))
+}
+
+// Verify returns an error if the signature in the headers isn't the signature of the body, or the timestamp
+// of the request is outside of the tolerance. The signatures are compared in constant time.
+func (v *SignatureVerifier) Verify(header http.Header, body []byte) error {
+	value := header.Get(v.Header)
+	if value == "" {
+		return ErrSignatureMissing
+	}
+	if !strings.HasPrefix(value, v.Prefix) {
+		return ErrSignatureMismatch
+	}
+	signature, err := hex.DecodeString(strings.TrimPrefix(value, v.Prefix))
+	if err != nil {
+		return ErrSignatureMismatch
+	}
+	var timestamp string
+	if v.TimestampHeader != "" {
+		timestamp = header.Get(v.TimestampHeader)
+		if err := v.checkTimestamp(timestamp); err != nil {
+			return err
+		}
+	}
+	if !hmac.Equal(signature, v.mac(body, timestamp)) {
+		return ErrSignatureMismatch
+	}
+	return nil
+}
+
+// checkTimestamp returns an error if the timestamp is missing or outside of the tolerance.
+func (v *SignatureVerifier) checkTimestamp(timestamp string) error {
+	if timestamp == "" {
+		return ErrSignatureMissing
+	}
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return errors.Wrapf(ErrSignatureMismatch, "invalid timestamp %q", timestamp)
+	}
+	now := time.Now
+	if v.now != nil {
+		now = v.now
+	}
+	delta := now().Sub(time.Unix(seconds, 0))
+	if delta < 0 {
+		delta = -delta
+	}
+	if delta > v.Tolerance {
+		return ErrTimestampOutsideTolerance
+	}
+	return nil
+}
+
+// mac returns the HMAC of the body, prefixed with the timestamp if the verifier has a timestamp header.
+func (v *SignatureVerifier) mac(body []byte, timestamp string) []byte {
+	newHash := sha256.New
+	if v.Algorithm == SignatureAlgorithmSHA1 {
+		newHash = sha1.New
+	}
+	mac := hmac.New(newHash, v.Secret)
+	if v.TimestampHeader != "" {
+		_, _ = mac.Write([]byte(timestamp + "."))
+	}
+	_, _ = mac.Write(body)
+	return mac.Sum(nil)
+}
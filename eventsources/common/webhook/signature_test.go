@@ -0,0 +1,99 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+)
+
+func TestSignatureVerifier_Verify(t *testing.T) {
+	t.Run("sha256", func(t *testing.T) {
+		// The example of the GitHub documentation on validating webhook deliveries.
+		v := &SignatureVerifier{Secret: []byte("It's a Secret to Everybody"), Algorithm: SignatureAlgorithmSHA256, Header: "X-Hub-Signature-256", Prefix: "sha256="}
+		header := http.Header{}
+		header.Set("X-Hub-Signature-256", "sha256=757107ea0eb2509fc211221cce984b8a37570b6d7586c22c46f4379c8b043e17")
+		assert.Nil(t, v.Verify(header, []byte("Hello, World!")))
+		assert.True(t, errors.Is(v.Verify(header, []byte("Hello, World?")), ErrSignatureMismatch))
+	})
+
+	t.Run("sha1", func(t *testing.T) {
+		// The test case 2 of RFC 2202.
+		v := &SignatureVerifier{Secret: []byte("Jefe"), Algorithm: SignatureAlgorithmSHA1, Header: "X-Signature"}
+		header := http.Header{}
+		header.Set("X-Signature", "effcdf6ae5eb2fa2d27416d5f184df9c259a7c79")
+		assert.Nil(t, v.Verify(header, []byte("what do ya want for nothing?")))
+	})
+
+	t.Run("missing or malformed signature", func(t *testing.T) {
+		v := &SignatureVerifier{Secret: []byte("secret"), Header: "X-Signature", Prefix: "sha256="}
+		assert.True(t, errors.Is(v.Verify(http.Header{}, []byte("body")), ErrSignatureMissing))
+		header := http.Header{}
+		header.Set("X-Signature", "sha1="+v.Sign([]byte("body"), ""))
+		assert.True(t, errors.Is(v.Verify(header, []byte("body")), ErrSignatureMismatch))
+		header.Set("X-Signature", "sha256=not-hex")
+		assert.True(t, errors.Is(v.Verify(header, []byte("body")), ErrSignatureMismatch))
+	})
+
+	t.Run("timestamp", func(t *testing.T) {
+		now := time.Unix(1650000000, 0)
+		v := &SignatureVerifier{Secret: []byte("secret"), Header: "X-Signature", TimestampHeader: "X-Timestamp", Tolerance: 5 * time.Minute, now: func() time.Time { return now }}
+		body := []byte(`{"hello":"world"}`)
+		sign := func(at time.Time) http.Header {
+			timestamp := strconv.FormatInt(at.Unix(), 10)
+			header := http.Header{}
+			header.Set("X-Timestamp", timestamp)
+			header.Set("X-Signature", v.Sign(body, timestamp))
+			return header
+		}
+		assert.Nil(t, v.Verify(sign(now.Add(-time.Minute)), body))
+		assert.True(t, errors.Is(v.Verify(sign(now.Add(-10*time.Minute)), body), ErrTimestampOutsideTolerance))
+		assert.True(t, errors.Is(v.Verify(sign(now.Add(10*time.Minute)), body), ErrTimestampOutsideTolerance))
+
+		// The timestamp is signed, it can't be refreshed to replay a request.
+		header := sign(now.Add(-10 * time.Minute))
+		header.Set("X-Timestamp", strconv.FormatInt(now.Unix(), 10))
+		assert.True(t, errors.Is(v.Verify(header, body), ErrSignatureMismatch))
+
+		header.Del("X-Timestamp")
+		assert.True(t, errors.Is(v.Verify(header, body), ErrSignatureMissing))
+	})
+}
+
+func TestNewSignatureVerifier(t *testing.T) {
+	secret := &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "webhook"}, Key: "secret"}
+	v, err := NewSignatureVerifier([]byte("secret"), &v1alpha1.WebhookSignature{Secret: secret})
+	assert.Nil(t, err)
+	assert.Equal(t, SignatureAlgorithmSHA256, v.Algorithm)
+	assert.Equal(t, "X-Signature", v.Header)
+	assert.Equal(t, 5*time.Minute, v.Tolerance)
+
+	_, err = NewSignatureVerifier([]byte("secret"), &v1alpha1.WebhookSignature{Secret: secret, Algorithm: "md5"})
+	assert.NotNil(t, err)
+	_, err = NewSignatureVerifier([]byte("secret"), &v1alpha1.WebhookSignature{Secret: secret, Tolerance: "-1m"})
+	assert.NotNil(t, err)
+	_, err = NewSignatureVerifier([]byte("secret"), &v1alpha1.WebhookSignature{})
+	assert.NotNil(t, err)
+}
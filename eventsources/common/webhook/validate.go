@@ -40,6 +40,9 @@ func ValidateWebhookContext(context *v1alpha1.WebhookContext) error {
 			return fmt.Errorf("failed to parse server port %s. err: %+v", context.Port, err)
 		}
 	}
+	if err := ValidateSignature(context.Signature); err != nil {
+		return fmt.Errorf("invalid signature: %w", err)
+	}
 	return nil
 }
 
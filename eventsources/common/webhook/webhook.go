@@ -17,8 +17,10 @@ limitations under the License.
 package webhook
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"strings"
 
@@ -123,7 +125,7 @@ func startServer(router Router, controller *Controller) {
 		r = r.Path(route.Context.Endpoint)
 		r.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
 			if route.Context.AuthSecret != nil {
-				token, err := common.GetSecretFromVolume(route.Context.AuthSecret)
+				token, err := common.GetSecret(route.Context.AuthSecret)
 				if err != nil {
 					route.Logger.Errorw("failed to get auth secret from volume", "error", err)
 					common.SendInternalErrorResponse(writer, "Error loading auth token")
@@ -141,6 +143,13 @@ func startServer(router Router, controller *Controller) {
 					return
 				}
 			}
+			if route.Context.Signature != nil {
+				if err := verifySignature(route.Context.Signature, request); err != nil {
+					route.Logger.Errorw("failed to verify the signature of the request", zap.Error(err))
+					common.SendResponse(writer, http.StatusUnauthorized, "Invalid Signature")
+					return
+				}
+			}
 			if request.Header.Get("Authorization") != "" {
 				// Auth secret stops here
 				request.Header.Set("Authorization", "*** Masked Auth Secret ***")
@@ -162,6 +171,24 @@ func startServer(router Router, controller *Controller) {
 	Lock.Unlock()
 }
 
+// verifySignature verifies the signature of the request, leaving its body to be read again by the route.
+func verifySignature(signature *v1alpha1.WebhookSignature, request *http.Request) error {
+	secret, err := common.GetSecret(signature.Secret)
+	if err != nil {
+		return fmt.Errorf("failed to get the signature secret from volume, %w", err)
+	}
+	verifier, err := NewSignatureVerifier([]byte(secret), signature)
+	if err != nil {
+		return err
+	}
+	body, err := ioutil.ReadAll(request.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read the request body, %w", err)
+	}
+	request.Body = ioutil.NopCloser(bytes.NewReader(body))
+	return verifier.Verify(request.Header, body)
+}
+
 // activateRoute activates a route to process incoming requests
 func activateRoute(router Router, controller *Controller) {
 	route := router.GetRoute()
@@ -47,6 +47,7 @@ import (
 	"github.com/argoproj/argo-events/eventsources/sources/slack"
 	"github.com/argoproj/argo-events/eventsources/sources/storagegrid"
 	"github.com/argoproj/argo-events/eventsources/sources/stripe"
+	"github.com/argoproj/argo-events/eventsources/sources/tcp"
 	"github.com/argoproj/argo-events/eventsources/sources/webhook"
 	eventsourcemetrics "github.com/argoproj/argo-events/metrics"
 	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
@@ -295,6 +296,16 @@ func GetEventingServers(eventSource *v1alpha1.EventSource, metrics *eventsourcem
 		}
 		result[apicommon.StripeEvent] = servers
 	}
+	if len(eventSource.Spec.TCP) != 0 {
+		servers := []EventingServer{}
+		for k, v := range eventSource.Spec.TCP {
+			if v.Filter != nil {
+				filters[k] = v.Filter
+			}
+			servers = append(servers, &tcp.EventListener{EventSourceName: eventSource.Name, EventName: k, TCPEventSource: v, Metrics: metrics})
+		}
+		result[apicommon.TCPEvent] = servers
+	}
 	if len(eventSource.Spec.Webhook) != 0 {
 		servers := []EventingServer{}
 		for k, v := range eventSource.Spec.Webhook {
@@ -83,11 +83,11 @@ func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byt
 			c.TLSClientConfig = tlsConfig
 		}
 		if amqpEventSource.Auth != nil {
-			username, err := common.GetSecretFromVolume(amqpEventSource.Auth.Username)
+			username, err := common.GetSecret(amqpEventSource.Auth.Username)
 			if err != nil {
 				return errors.Wrap(err, "username not found")
 			}
-			password, err := common.GetSecretFromVolume(amqpEventSource.Auth.Password)
+			password, err := common.GetSecret(amqpEventSource.Auth.Password)
 			if err != nil {
 				return errors.Wrap(err, "password not found")
 			}
@@ -99,7 +99,7 @@ func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byt
 		var err error
 		var url string
 		if amqpEventSource.URLSecret != nil {
-			url, err = common.GetSecretFromVolume(amqpEventSource.URLSecret)
+			url, err = common.GetSecret(amqpEventSource.URLSecret)
 			if err != nil {
 				return errors.Wrap(err, "urlSecret not found")
 			}
@@ -68,13 +68,13 @@ func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byt
 
 	hubEventSource := &el.AzureEventsHubEventSource
 	log.Info("retrieving the shared access key name...")
-	sharedAccessKeyName, err := common.GetSecretFromVolume(hubEventSource.SharedAccessKeyName)
+	sharedAccessKeyName, err := common.GetSecret(hubEventSource.SharedAccessKeyName)
 	if err != nil {
 		return errors.Wrapf(err, "failed to retrieve the shared access key name from secret %s", hubEventSource.SharedAccessKeyName.Name)
 	}
 
 	log.Info("retrieving the shared access key...")
-	sharedAccessKey, err := common.GetSecretFromVolume(hubEventSource.SharedAccessKey)
+	sharedAccessKey, err := common.GetSecret(hubEventSource.SharedAccessKey)
 	if err != nil {
 		return errors.Wrapf(err, "failed to retrieve the shared access key from secret %s", hubEventSource.SharedAccessKey.Name)
 	}
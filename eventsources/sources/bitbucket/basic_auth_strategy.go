@@ -29,12 +29,12 @@ type BasicAuthStrategy struct {
 }
 
 func NewBasicAuthStrategy(usernameSecret, passwordSecret *corev1.SecretKeySelector) (*BasicAuthStrategy, error) {
-	username, err := common.GetSecretFromVolume(usernameSecret)
+	username, err := common.GetSecret(usernameSecret)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to retrieve bitbucket username from secret")
 	}
 
-	password, err := common.GetSecretFromVolume(passwordSecret)
+	password, err := common.GetSecret(passwordSecret)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to retrieve bitbucket password from secret")
 	}
@@ -28,7 +28,7 @@ type OAuthTokenAuthStrategy struct {
 }
 
 func NewOAuthTokenAuthStrategy(oauthTokenSecret *corev1.SecretKeySelector) (*OAuthTokenAuthStrategy, error) {
-	token, err := common.GetSecretFromVolume(oauthTokenSecret)
+	token, err := common.GetSecret(oauthTokenSecret)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to retrieve bitbucket oauth token from secret")
 	}
@@ -124,7 +124,7 @@ func (router *Router) PostInactivate() error {
 	if bitbucketserverEventSource.DeleteHookOnFinish && len(router.hookIDs) > 0 {
 		logger.Info("deleting webhooks from bitbucket")
 
-		bitbucketToken, err := common.GetSecretFromVolume(bitbucketserverEventSource.AccessToken)
+		bitbucketToken, err := common.GetSecret(bitbucketserverEventSource.AccessToken)
 		if err != nil {
 			return errors.Errorf("failed to get bitbucketserver token. err: %+v", err)
 		}
@@ -182,7 +182,7 @@ func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byt
 	}
 
 	logger.Info("retrieving the access token credentials...")
-	bitbucketToken, err := common.GetSecretFromVolume(bitbucketserverEventSource.AccessToken)
+	bitbucketToken, err := common.GetSecret(bitbucketserverEventSource.AccessToken)
 	if err != nil {
 		return errors.Errorf("failed to get bitbucketserver token. err: %+v", err)
 	}
@@ -277,7 +277,7 @@ func (router *Router) CreateBitbucketWebhook(ctx context.Context, bitbucketConfi
 	}
 
 	logger.Info("retrieving the webhook secret...")
-	webhookSecret, err := common.GetSecretFromVolume(bitbucketserverEventSource.WebhookSecret)
+	webhookSecret, err := common.GetSecret(bitbucketserverEventSource.WebhookSecret)
 	if err != nil {
 		return errors.Errorf("failed to get bitbucketserver webhook secret. err: %+v", err)
 	}
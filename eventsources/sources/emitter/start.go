@@ -78,7 +78,7 @@ func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byt
 	options = append(options, emitter.WithBrokers(emitterEventSource.Broker), emitter.WithAutoReconnect(true))
 
 	if emitterEventSource.Username != nil {
-		username, err := common.GetSecretFromVolume(emitterEventSource.Username)
+		username, err := common.GetSecret(emitterEventSource.Username)
 		if err != nil {
 			return errors.Wrapf(err, "failed to retrieve the username from %s", emitterEventSource.Username.Name)
 		}
@@ -86,7 +86,7 @@ func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byt
 	}
 
 	if emitterEventSource.Password != nil {
-		password, err := common.GetSecretFromVolume(emitterEventSource.Password)
+		password, err := common.GetSecret(emitterEventSource.Password)
 		if err != nil {
 			return errors.Wrapf(err, "failed to retrieve the password from %s", emitterEventSource.Password.Name)
 		}
@@ -0,0 +1,97 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package file
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+)
+
+// offsets holds the positions the files are read up to, saved in the offset file if any
+type offsets struct {
+	path      string
+	positions map[string]int64
+}
+
+// loadOffsets loads the positions saved in the offset file, none if the file doesn't exist yet
+func loadOffsets(path string) (*offsets, error) {
+	o := &offsets{path: path, positions: make(map[string]int64)}
+	if path == "" {
+		return o, nil
+	}
+	content, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return o, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read the offset file %s", path)
+	}
+	if len(content) == 0 {
+		return o, nil
+	}
+	if err := json.Unmarshal(content, &o.positions); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse the offset file %s", path)
+	}
+	return o, nil
+}
+
+func (o *offsets) get(name string) (int64, bool) {
+	position, ok := o.positions[name]
+	return position, ok
+}
+
+func (o *offsets) set(name string, position int64) error {
+	o.positions[name] = position
+	return o.save()
+}
+
+func (o *offsets) delete(name string) error {
+	if _, ok := o.positions[name]; !ok {
+		return nil
+	}
+	delete(o.positions, name)
+	return o.save()
+}
+
+// save writes the positions to a temporary file renamed over the offset file, for it not to be left
+// partially written.
+func (o *offsets) save() error {
+	if o.path == "" {
+		return nil
+	}
+	content, err := json.Marshal(o.positions)
+	if err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(o.path), filepath.Base(o.path)+".tmp")
+	if err != nil {
+		return errors.Wrapf(err, "failed to create the offset file %s", o.path)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(content); err != nil {
+		tmp.Close()
+		return errors.Wrapf(err, "failed to write the offset file %s", o.path)
+	}
+	if err := tmp.Close(); err != nil {
+		return errors.Wrapf(err, "failed to write the offset file %s", o.path)
+	}
+	return os.Rename(tmp.Name(), o.path)
+}
@@ -67,7 +67,12 @@ func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byt
 	defer sources.Recover(el.GetEventName())
 
 	fileEventSource := &el.FileEventSource
-	if fileEventSource.Polling {
+	if fileEventSource.Tail != nil {
+		if err := el.listenTail(ctx, dispatch, log); err != nil {
+			log.Error("failed to tail the files", zap.Error(err))
+			return err
+		}
+	} else if fileEventSource.Polling {
 		if err := el.listenEventsPolling(ctx, dispatch, log); err != nil {
 			log.Error("failed to listen to events", zap.Error(err))
 			return err
@@ -0,0 +1,314 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package file
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	eventsourcecommon "github.com/argoproj/argo-events/eventsources/common"
+	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+)
+
+// TailEvent is the payload of the events emitted from the content of the files
+type TailEvent struct {
+	// Name is the path of the file
+	Name string `json:"name"`
+	// Offset is the position of the content in the file
+	Offset int64 `json:"offset"`
+	// Body is the line or the file, as is if it is JSON, as a JSON string otherwise
+	Body json.RawMessage `json:"body"`
+	// Metadata is the metadata of the event source
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// tailer emits the content of the watched files, from the positions saved in the offset file
+type tailer struct {
+	config     *v1alpha1.FileTailConfig
+	directory  string
+	path       string
+	pathRegexp *regexp.Regexp
+	metadata   map[string]string
+	offsets    *offsets
+	dispatch   func(event *TailEvent) error
+	logger     *zap.SugaredLogger
+}
+
+func newTailer(fileEventSource *v1alpha1.FileEventSource, dispatch func(event *TailEvent) error, logger *zap.SugaredLogger) (*tailer, error) {
+	t := &tailer{
+		config:    fileEventSource.Tail,
+		directory: filepath.Clean(fileEventSource.WatchPathConfig.Directory),
+		path:      fileEventSource.WatchPathConfig.Path,
+		metadata:  fileEventSource.Metadata,
+		dispatch:  dispatch,
+		logger:    logger,
+	}
+	if fileEventSource.WatchPathConfig.PathRegexp != "" {
+		r, err := regexp.Compile(fileEventSource.WatchPathConfig.PathRegexp)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to compile the path regexp %s", fileEventSource.WatchPathConfig.PathRegexp)
+		}
+		t.pathRegexp = r
+	}
+	o, err := loadOffsets(t.config.OffsetFile)
+	if err != nil {
+		return nil, err
+	}
+	t.offsets = o
+	return t, nil
+}
+
+// matches tells if the file is watched
+func (t *tailer) matches(name string) bool {
+	if offsetFile := t.config.OffsetFile; offsetFile != "" && strings.HasPrefix(name, filepath.Clean(offsetFile)) {
+		// The offset file may be on the watched volume.
+		return false
+	}
+	rel, err := filepath.Rel(t.directory, name)
+	if err != nil || filepath.Dir(rel) != "." {
+		return false
+	}
+	if t.path != "" {
+		return rel == t.path
+	}
+	if t.config.Glob != "" {
+		// The glob is validated with the event source.
+		if ok, _ := filepath.Match(t.config.Glob, rel); !ok {
+			return false
+		}
+	}
+	return t.pathRegexp == nil || t.pathRegexp.MatchString(rel)
+}
+
+// scan emits the content of the files already in the directory, written while the event source wasn't running
+func (t *tailer) scan() error {
+	infos, err := ioutil.ReadDir(t.directory)
+	if err != nil {
+		return errors.Wrapf(err, "failed to list the directory %s", t.directory)
+	}
+	for _, info := range infos {
+		name := filepath.Join(t.directory, info.Name())
+		if info.IsDir() || !t.matches(name) {
+			continue
+		}
+		if err := t.process(name); err != nil {
+			t.logger.Errorw("failed to emit the content of a file", zap.String("file", name), zap.Error(err))
+		}
+	}
+	return nil
+}
+
+// process emits the content of the file written since its saved position
+func (t *tailer) process(name string) error {
+	if t.config.GetMode() == v1alpha1.FileTailModeFile {
+		return t.processFile(name)
+	}
+	return t.processLines(name)
+}
+
+// processFile emits the whole file, unless it was emitted already
+func (t *tailer) processFile(name string) error {
+	if _, ok := t.offsets.get(name); ok {
+		return nil
+	}
+	info, err := os.Stat(name)
+	if err != nil {
+		return errors.Wrapf(err, "failed to stat the file %s", name)
+	}
+	if info.Size() > t.config.GetMaxEventSize() {
+		t.logger.Warnw("skipping a file exceeding the max event size", zap.String("file", name), zap.Int64("size", info.Size()))
+		return t.offsets.set(name, info.Size())
+	}
+	content, err := ioutil.ReadFile(name)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read the file %s", name)
+	}
+	if err := t.dispatch(&TailEvent{Name: name, Body: tailBody(content), Metadata: t.metadata}); err != nil {
+		return err
+	}
+	return t.offsets.set(name, int64(len(content)))
+}
+
+// processLines emits the complete lines appended to the file since its saved position. A file shorter than
+// its position was truncated, and is read from its start.
+func (t *tailer) processLines(name string) error {
+	f, err := os.Open(name)
+	if err != nil {
+		return errors.Wrapf(err, "failed to open the file %s", name)
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return errors.Wrapf(err, "failed to stat the file %s", name)
+	}
+	offset, _ := t.offsets.get(name)
+	if info.Size() < offset {
+		t.logger.Infow("the file was truncated, reading it from its start", zap.String("file", name))
+		offset = 0
+	}
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		return errors.Wrapf(err, "failed to seek the file %s", name)
+	}
+	start := offset
+	defer func() {
+		if offset != start {
+			if err := t.offsets.set(name, offset); err != nil {
+				t.logger.Errorw("failed to save the offset of a file", zap.String("file", name), zap.Error(err))
+			}
+		}
+	}()
+	r := bufio.NewReader(f)
+	for {
+		line, size, oversized, err := readLine(r, t.config.GetMaxEventSize())
+		if err == io.EOF {
+			// An incomplete line is read again once complete.
+			return nil
+		}
+		if err != nil {
+			return errors.Wrapf(err, "failed to read the file %s", name)
+		}
+		switch {
+		case oversized:
+			t.logger.Warnw("skipping a line exceeding the max event size", zap.String("file", name), zap.Int64("offset", offset), zap.Int64("size", size))
+		case len(line) > 0:
+			if err := t.dispatch(&TailEvent{Name: name, Offset: offset, Body: tailBody(line), Metadata: t.metadata}); err != nil {
+				return err
+			}
+		}
+		offset += size
+	}
+}
+
+// forget drops the position of a removed or renamed file
+func (t *tailer) forget(name string) {
+	if err := t.offsets.delete(name); err != nil {
+		t.logger.Errorw("failed to save the offsets", zap.String("file", name), zap.Error(err))
+	}
+}
+
+// readLine reads a line of at most max bytes, without its line ending, and returns the number of bytes read.
+// The bytes of a bigger line are read but not returned. io.EOF is returned for a line without a newline.
+func readLine(r *bufio.Reader, max int64) ([]byte, int64, bool, error) {
+	var line []byte
+	var size int64
+	oversized := false
+	for {
+		chunk, err := r.ReadSlice('\n')
+		size += int64(len(chunk))
+		if !oversized {
+			line = append(line, chunk...)
+			if int64(len(bytes.TrimRight(line, "\r\n"))) > max {
+				oversized = true
+				line = nil
+			}
+		}
+		switch err {
+		case nil:
+			if oversized {
+				return nil, size, true, nil
+			}
+			return bytes.TrimRight(line, "\r\n"), size, false, nil
+		case bufio.ErrBufferFull:
+			continue
+		default:
+			return nil, size, oversized, err
+		}
+	}
+}
+
+// tailBody returns the content as is if it is JSON, as a JSON string otherwise
+func tailBody(content []byte) json.RawMessage {
+	if json.Valid(content) {
+		return content
+	}
+	result, _ := json.Marshal(string(content))
+	return result
+}
+
+// listenTail emits the content of the watched files
+func (el *EventListener) listenTail(ctx context.Context, dispatch func([]byte, ...eventsourcecommon.Options) error, log *zap.SugaredLogger) error {
+	fileEventSource := &el.FileEventSource
+	t, err := newTailer(fileEventSource, func(event *TailEvent) error {
+		defer func(start time.Time) {
+			el.Metrics.EventProcessingDuration(el.GetEventSourceName(), el.GetEventName(), float64(time.Since(start)/time.Millisecond))
+		}(time.Now())
+		payload, err := json.Marshal(event)
+		if err != nil {
+			return errors.Wrap(err, "failed to marshal the tail event")
+		}
+		if err := dispatch(payload); err != nil {
+			el.Metrics.EventProcessingFailed(el.GetEventSourceName(), el.GetEventName())
+			return errors.Wrap(err, "failed to dispatch a tail event")
+		}
+		return nil
+	}, log)
+	if err != nil {
+		return errors.Wrapf(err, "failed to set up the tail of %s", el.GetEventName())
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return errors.Wrapf(err, "failed to set up a file watcher for %s", el.GetEventName())
+	}
+	defer watcher.Close()
+	// The directory is watched for the files which are created or rotated.
+	if err := watcher.Add(t.directory); err != nil {
+		return errors.Wrapf(err, "failed to add directory %s to the watcher for %s", t.directory, el.GetEventName())
+	}
+	if err := t.scan(); err != nil {
+		return err
+	}
+
+	log.Infow("tailing the files...", zap.String("directory", t.directory), zap.String("mode", string(fileEventSource.Tail.GetMode())))
+	for {
+		select {
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return errors.Errorf("fs watcher stopped for %s", el.GetEventName())
+			}
+			if !t.matches(event.Name) {
+				continue
+			}
+			switch {
+			case event.Op&(fsnotify.Remove|fsnotify.Rename) != 0:
+				t.forget(event.Name)
+			case event.Op&(fsnotify.Create|fsnotify.Write) != 0:
+				if err := t.process(event.Name); err != nil {
+					log.Errorw("failed to emit the content of a file", zap.String("file", event.Name), zap.Error(err))
+				}
+			}
+		case err := <-watcher.Errors:
+			return errors.Wrapf(err, "failed to process %s", el.GetEventName())
+		case <-ctx.Done():
+			log.Info("event source has been stopped")
+			return nil
+		}
+	}
+}
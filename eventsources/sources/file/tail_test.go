@@ -0,0 +1,157 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package file
+
+import (
+	"bufio"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-events/common/logging"
+	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+)
+
+func newTestTailer(t *testing.T, fileEventSource *v1alpha1.FileEventSource) (*tailer, *[]*TailEvent) {
+	t.Helper()
+	events := &[]*TailEvent{}
+	tl, err := newTailer(fileEventSource, func(event *TailEvent) error {
+		*events = append(*events, event)
+		return nil
+	}, logging.NewArgoEventsLogger())
+	assert.Nil(t, err)
+	return tl, events
+}
+
+func appendFile(t *testing.T, name, content string) {
+	t.Helper()
+	f, err := os.OpenFile(name, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	assert.Nil(t, err)
+	_, err = f.WriteString(content)
+	assert.Nil(t, err)
+	assert.Nil(t, f.Close())
+}
+
+func TestTailer_Lines(t *testing.T) {
+	dir := t.TempDir()
+	offsetFile := filepath.Join(dir, "offsets.json")
+	source := &v1alpha1.FileEventSource{
+		WatchPathConfig: v1alpha1.WatchPathConfig{Directory: dir},
+		Tail:            &v1alpha1.FileTailConfig{Glob: "*.log", OffsetFile: offsetFile, MaxEventSize: 16},
+		Metadata:        map[string]string{"env": "test"},
+	}
+	name := filepath.Join(dir, "events.log")
+	tl, events := newTestTailer(t, source)
+
+	appendFile(t, name, "{\"id\": 1}\nplain\n\n"+strings.Repeat("x", 17)+"\npart")
+	assert.Nil(t, tl.process(name))
+	assert.Len(t, *events, 2)
+	assert.JSONEq(t, `{"id": 1}`, string((*events)[0].Body))
+	assert.Equal(t, int64(0), (*events)[0].Offset)
+	assert.Equal(t, `"plain"`, string((*events)[1].Body))
+	assert.Equal(t, int64(10), (*events)[1].Offset)
+	assert.Equal(t, map[string]string{"env": "test"}, (*events)[1].Metadata)
+
+	// The incomplete line is emitted once complete.
+	appendFile(t, name, "ial\n")
+	assert.Nil(t, tl.process(name))
+	assert.Len(t, *events, 3)
+	assert.Equal(t, `"partial"`, string((*events)[2].Body))
+
+	// The lines emitted before the restart are not emitted again.
+	restarted, restartedEvents := newTestTailer(t, source)
+	appendFile(t, name, "after\n")
+	assert.Nil(t, restarted.scan())
+	assert.Len(t, *restartedEvents, 1)
+	assert.Equal(t, `"after"`, string((*restartedEvents)[0].Body))
+
+	// A truncated file is read from its start.
+	assert.Nil(t, ioutil.WriteFile(name, []byte("new\n"), 0600))
+	assert.Nil(t, restarted.process(name))
+	assert.Len(t, *restartedEvents, 2)
+	assert.Equal(t, `"new"`, string((*restartedEvents)[1].Body))
+}
+
+func TestTailer_Files(t *testing.T) {
+	dir := t.TempDir()
+	source := &v1alpha1.FileEventSource{
+		WatchPathConfig: v1alpha1.WatchPathConfig{Directory: dir},
+		Tail:            &v1alpha1.FileTailConfig{Mode: v1alpha1.FileTailModeFile, MaxEventSize: 32},
+	}
+	tl, events := newTestTailer(t, source)
+
+	small := filepath.Join(dir, "small.json")
+	assert.Nil(t, ioutil.WriteFile(small, []byte(`{"line": 1}`+"\n"+`{"line": 2}`), 0600))
+	big := filepath.Join(dir, "big.txt")
+	assert.Nil(t, ioutil.WriteFile(big, []byte(strings.Repeat("x", 33)), 0600))
+
+	assert.Nil(t, tl.scan())
+	assert.Len(t, *events, 1)
+	assert.Equal(t, small, (*events)[0].Name)
+	assert.Equal(t, "\""+`{\"line\": 1}\n{\"line\": 2}`+"\"", string((*events)[0].Body))
+
+	// A file is emitted once, until it is removed.
+	assert.Nil(t, tl.process(small))
+	assert.Len(t, *events, 1)
+	tl.forget(small)
+	assert.Nil(t, tl.process(small))
+	assert.Len(t, *events, 2)
+}
+
+func TestTailer_Matches(t *testing.T) {
+	dir := t.TempDir()
+	tl, _ := newTestTailer(t, &v1alpha1.FileEventSource{
+		WatchPathConfig: v1alpha1.WatchPathConfig{Directory: dir, PathRegexp: "^app"},
+		Tail:            &v1alpha1.FileTailConfig{Glob: "*.log", OffsetFile: filepath.Join(dir, "app-offsets.log")},
+	})
+	assert.True(t, tl.matches(filepath.Join(dir, "app.log")))
+	assert.False(t, tl.matches(filepath.Join(dir, "app.txt")))
+	assert.False(t, tl.matches(filepath.Join(dir, "other.log")))
+	assert.False(t, tl.matches(filepath.Join(dir, "sub", "app.log")))
+	assert.False(t, tl.matches(filepath.Join(dir, "app-offsets.log")))
+	assert.False(t, tl.matches(filepath.Join(dir, "app-offsets.log.tmp123")))
+
+	tl, _ = newTestTailer(t, &v1alpha1.FileEventSource{
+		WatchPathConfig: v1alpha1.WatchPathConfig{Directory: dir, Path: "events.log"},
+		Tail:            &v1alpha1.FileTailConfig{},
+	})
+	assert.True(t, tl.matches(filepath.Join(dir, "events.log")))
+	assert.False(t, tl.matches(filepath.Join(dir, "other.log")))
+}
+
+func TestReadLine(t *testing.T) {
+	r := bufio.NewReaderSize(strings.NewReader("short\r\n"+strings.Repeat("x", 40)+"\nend"), 16)
+	line, size, oversized, err := readLine(r, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, "short", string(line))
+	assert.Equal(t, int64(7), size)
+	assert.False(t, oversized)
+
+	line, size, oversized, err = readLine(r, 10)
+	assert.Nil(t, err)
+	assert.Nil(t, line)
+	assert.Equal(t, int64(41), size)
+	assert.True(t, oversized)
+
+	_, _, _, err = readLine(r, 10)
+	assert.Equal(t, io.EOF, err)
+}
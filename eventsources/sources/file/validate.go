@@ -19,6 +19,10 @@ package file
 import (
 	"context"
 	"fmt"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
 
 	"github.com/argoproj/argo-events/common"
 	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
@@ -33,9 +37,51 @@ func validate(fileEventSource *v1alpha1.FileEventSource) error {
 	if fileEventSource == nil {
 		return common.ErrNilEventSource
 	}
+	if fileEventSource.Tail != nil {
+		return validateTail(fileEventSource)
+	}
 	if fileEventSource.EventType == "" {
 		return fmt.Errorf("type must be specified")
 	}
 	err := fileEventSource.WatchPathConfig.Validate()
 	return err
 }
+
+func validateTail(fileEventSource *v1alpha1.FileEventSource) error {
+	if fileEventSource.Polling {
+		return fmt.Errorf("tail is not supported with polling")
+	}
+	c := fileEventSource.WatchPathConfig
+	if c.Directory == "" {
+		return fmt.Errorf("directory is required")
+	}
+	if !path.IsAbs(c.Directory) {
+		return fmt.Errorf("directory must be an absolute file path")
+	}
+	if c.Path != "" && (path.IsAbs(c.Path) || strings.Contains(c.Path, "/")) {
+		return fmt.Errorf("path must be the name of a file of the directory")
+	}
+	if c.PathRegexp != "" {
+		if _, err := regexp.Compile(c.PathRegexp); err != nil {
+			return fmt.Errorf("invalid path regexp, %w", err)
+		}
+	}
+	tail := fileEventSource.Tail
+	switch tail.GetMode() {
+	case v1alpha1.FileTailModeLine, v1alpha1.FileTailModeFile:
+	default:
+		return fmt.Errorf("unknown tail mode %q, it must be line or file", tail.Mode)
+	}
+	if tail.Glob != "" {
+		if _, err := filepath.Match(tail.Glob, ""); err != nil {
+			return fmt.Errorf("invalid glob %q, %w", tail.Glob, err)
+		}
+	}
+	if tail.OffsetFile != "" && !path.IsAbs(tail.OffsetFile) {
+		return fmt.Errorf("offset file must be an absolute file path")
+	}
+	if tail.MaxEventSize < 0 {
+		return fmt.Errorf("max event size can't be negative")
+	}
+	return nil
+}
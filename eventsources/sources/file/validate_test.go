@@ -52,3 +52,37 @@ func TestValidateEventSource(t *testing.T) {
 		assert.NoError(t, err)
 	}
 }
+
+func TestValidateTail(t *testing.T) {
+	source := func() *v1alpha1.FileEventSource {
+		return &v1alpha1.FileEventSource{
+			WatchPathConfig: v1alpha1.WatchPathConfig{Directory: "/data"},
+			Tail:            &v1alpha1.FileTailConfig{Glob: "*.log", OffsetFile: "/data/offsets.json"},
+		}
+	}
+	assert.NoError(t, validate(source()))
+
+	s := source()
+	s.Tail.Mode = "block"
+	assert.EqualError(t, validate(s), `unknown tail mode "block", it must be line or file`)
+
+	s = source()
+	s.Tail.Glob = "[a-"
+	assert.Error(t, validate(s))
+
+	s = source()
+	s.Tail.OffsetFile = "offsets.json"
+	assert.EqualError(t, validate(s), "offset file must be an absolute file path")
+
+	s = source()
+	s.WatchPathConfig.Directory = "data"
+	assert.EqualError(t, validate(s), "directory must be an absolute file path")
+
+	s = source()
+	s.WatchPathConfig.Path = "logs/events.log"
+	assert.EqualError(t, validate(s), "path must be the name of a file of the directory")
+
+	s = source()
+	s.Polling = true
+	assert.EqualError(t, validate(s), "tail is not supported with polling")
+}
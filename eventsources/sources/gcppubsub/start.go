@@ -206,7 +206,7 @@ func (el *EventListener) prepareSubscription(ctx context.Context, logger *zap.Su
 	opts := make([]option.ClientOption, 0, 1)
 	if secret := el.PubSubEventSource.CredentialSecret; secret != nil {
 		logger.Debug("using credentials from secret")
-		jsonCred, err := common.GetSecretFromVolume(secret)
+		jsonCred, err := common.GetSecret(secret)
 		if err != nil {
 			return nil, nil, errors.Wrap(err, "could not find credentials")
 		}
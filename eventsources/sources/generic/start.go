@@ -134,7 +134,7 @@ func (el *EventListener) connect() (Eventing_StartEventSourceClient, error) {
 	client := NewEventingClient(el.conn)
 	ctx := context.Background()
 	if el.GenericEventSource.AuthSecret != nil {
-		token, err := common.GetSecretFromVolume(el.GenericEventSource.AuthSecret)
+		token, err := common.GetSecret(el.GenericEventSource.AuthSecret)
 		if err != nil {
 			return nil, err
 		}
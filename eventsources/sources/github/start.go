@@ -54,7 +54,7 @@ func init() {
 
 // getCredentials retrieves credentials for GitHub connection
 func (router *Router) getCredentials(keySelector *corev1.SecretKeySelector) (*cred, error) {
-	token, err := common.GetSecretFromVolume(keySelector)
+	token, err := common.GetSecret(keySelector)
 	if err != nil {
 		return nil, errors.Wrap(err, "secret not found")
 	}
@@ -196,7 +196,7 @@ func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byt
 		}
 
 		if gitlabEventSource.SecretToken != nil {
-			token, err := common.GetSecretFromVolume(gitlabEventSource.SecretToken)
+			token, err := common.GetSecret(gitlabEventSource.SecretToken)
 			if err != nil {
 				return errors.Errorf("failed to retrieve secret token. err: %+v", err)
 			}
@@ -204,7 +204,7 @@ func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byt
 			router.secretToken = token
 		}
 
-		accessToken, err := common.GetSecretFromVolume(gitlabEventSource.AccessToken)
+		accessToken, err := common.GetSecret(gitlabEventSource.AccessToken)
 		if err != nil {
 			return errors.Errorf("failed to get gitlab credentials. err: %+v", err)
 		}
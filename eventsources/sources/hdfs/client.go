@@ -51,7 +51,7 @@ func getConfigMapKey(selector *corev1.ConfigMapKeySelector) (string, error) {
 }
 
 func getSecretKey(selector *corev1.SecretKeySelector) ([]byte, error) {
-	result, err := common.GetSecretFromVolume(selector)
+	result, err := common.GetSecret(selector)
 	if err != nil {
 		return nil, errors.Wrap(err, "secret value not injected")
 	}
@@ -285,14 +285,14 @@ func getSaramaConfig(kafkaEventSource *v1alpha1.KafkaEventSource, log *zap.Sugar
 			config.Net.SASL.SCRAMClientGeneratorFunc = func() sarama.SCRAMClient { return &XDGSCRAMClient{HashGeneratorFcn: SHA256} }
 		}
 
-		user, err := common.GetSecretFromVolume(kafkaEventSource.SASL.UserSecret)
+		user, err := common.GetSecret(kafkaEventSource.SASL.UserSecret)
 		if err != nil {
 			log.Errorf("Error getting user value from secret: %v", err)
 			return nil, err
 		}
 		config.Net.SASL.User = user
 
-		password, err := common.GetSecretFromVolume(kafkaEventSource.SASL.PasswordSecret)
+		password, err := common.GetSecret(kafkaEventSource.SASL.PasswordSecret)
 		if err != nil {
 			log.Errorf("Error getting password value from secret: %v", err)
 			return nil, err
@@ -71,11 +71,11 @@ func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byt
 	minioEventSource := &el.MinioEventSource
 
 	log.Info("retrieving access and secret key...")
-	accessKey, err := common.GetSecretFromVolume(minioEventSource.AccessKey)
+	accessKey, err := common.GetSecret(minioEventSource.AccessKey)
 	if err != nil {
 		return errors.Wrapf(err, "failed to get the access key for event source %s", el.GetEventName())
 	}
-	secretKey, err := common.GetSecretFromVolume(minioEventSource.SecretKey)
+	secretKey, err := common.GetSecret(minioEventSource.SecretKey)
 	if err != nil {
 		return errors.Wrapf(err, "failed to retrieve the secret key for event source %s", el.GetEventName())
 	}
@@ -78,17 +78,17 @@ func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byt
 	if natsEventSource.Auth != nil {
 		switch {
 		case natsEventSource.Auth.Basic != nil:
-			username, err := common.GetSecretFromVolume(natsEventSource.Auth.Basic.Username)
+			username, err := common.GetSecret(natsEventSource.Auth.Basic.Username)
 			if err != nil {
 				return err
 			}
-			password, err := common.GetSecretFromVolume(natsEventSource.Auth.Basic.Password)
+			password, err := common.GetSecret(natsEventSource.Auth.Basic.Password)
 			if err != nil {
 				return err
 			}
 			opt = append(opt, natslib.UserInfo(username, password))
 		case natsEventSource.Auth.Token != nil:
-			token, err := common.GetSecretFromVolume(natsEventSource.Auth.Token)
+			token, err := common.GetSecret(natsEventSource.Auth.Token)
 			if err != nil {
 				return err
 			}
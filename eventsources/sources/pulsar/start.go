@@ -99,7 +99,7 @@ func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byt
 	}
 
 	if pulsarEventSource.AuthTokenSecret != nil {
-		token, err := common.GetSecretFromVolume(pulsarEventSource.AuthTokenSecret)
+		token, err := common.GetSecret(pulsarEventSource.AuthTokenSecret)
 		if err != nil {
 			log.Errorw("failed to get AuthTokenSecret from the volume", "error", err)
 			return err
@@ -74,7 +74,7 @@ func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byt
 
 	log.Info("retrieving password if it has been configured...")
 	if redisEventSource.Password != nil {
-		password, err := common.GetSecretFromVolume(redisEventSource.Password)
+		password, err := common.GetSecret(redisEventSource.Password)
 		if err != nil {
 			return errors.Wrapf(err, "failed to find the secret password %s", redisEventSource.Password.Name)
 		}
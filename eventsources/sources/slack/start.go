@@ -321,13 +321,13 @@ func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byt
 
 	slackEventSource := &el.SlackEventSource
 	log.Info("retrieving the slack token...")
-	token, err := common.GetSecretFromVolume(slackEventSource.Token)
+	token, err := common.GetSecret(slackEventSource.Token)
 	if err != nil {
 		return errors.Wrap(err, "failed to retrieve the token")
 	}
 
 	log.Info("retrieving the signing secret...")
-	signingSecret, err := common.GetSecretFromVolume(slackEventSource.SigningSecret)
+	signingSecret, err := common.GetSecret(slackEventSource.SigningSecret)
 	if err != nil {
 		return errors.Wrap(err, "failed to retrieve the signing secret")
 	}
@@ -212,7 +212,7 @@ func (router *Router) PostActivate() error {
 	eventSource := router.storageGridEventSource
 	route := router.route
 
-	authToken, err := common.GetSecretFromVolume(eventSource.AuthToken)
+	authToken, err := common.GetSecret(eventSource.AuthToken)
 	if err != nil {
 		return errors.Wrap(err, "AuthToken not found")
 	}
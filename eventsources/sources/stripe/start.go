@@ -150,7 +150,7 @@ func (rc *Router) PostActivate() error {
 		)
 		logger.Info("registering a new webhook")
 
-		apiKey, err := common.GetSecretFromVolume(stripeEventSource.APIKey)
+		apiKey, err := common.GetSecret(stripeEventSource.APIKey)
 		if err != nil {
 			return errors.Wrap(err, "APIKey not found")
 		}
@@ -0,0 +1,215 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tcp
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/argoproj/argo-events/common/logging"
+	eventsourcecommon "github.com/argoproj/argo-events/eventsources/common"
+	"github.com/argoproj/argo-events/eventsources/sources"
+	metrics "github.com/argoproj/argo-events/metrics"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	"github.com/argoproj/argo-events/pkg/apis/events"
+	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+)
+
+// defaultMaxLineLength is the maximum length of a line if not specified
+const defaultMaxLineLength = 1024 * 1024
+
+// EventListener implements Eventing for the TCP event source
+type EventListener struct {
+	EventSourceName string
+	EventName       string
+	TCPEventSource  v1alpha1.TCPEventSource
+	Metrics         *metrics.Metrics
+}
+
+// GetEventSourceName returns name of event source
+func (el *EventListener) GetEventSourceName() string {
+	return el.EventSourceName
+}
+
+// GetEventName returns name of event
+func (el *EventListener) GetEventName() string {
+	return el.EventName
+}
+
+// GetEventSourceType return type of event server
+func (el *EventListener) GetEventSourceType() apicommon.EventSourceType {
+	return apicommon.TCPEvent
+}
+
+// StartListening accepts the connections on the TCP port, and dispatches every line received as an event
+func (el *EventListener) StartListening(ctx context.Context, dispatch func([]byte, ...eventsourcecommon.Options) error) error {
+	log := logging.FromContext(ctx).
+		With(logging.LabelEventSourceType, el.GetEventSourceType(), logging.LabelEventName, el.GetEventName())
+	log.Info("started processing the TCP event source...")
+	defer sources.Recover(el.GetEventName())
+
+	tcpEventSource := &el.TCPEventSource
+
+	var readTimeout time.Duration
+	if tcpEventSource.ReadTimeout != "" {
+		var err error
+		readTimeout, err = time.ParseDuration(tcpEventSource.ReadTimeout)
+		if err != nil {
+			return errors.Wrapf(err, "failed to parse the read timeout %s", tcpEventSource.ReadTimeout)
+		}
+	}
+
+	listener, err := net.Listen("tcp", fmt.Sprintf(":%s", tcpEventSource.Port))
+	if err != nil {
+		return errors.Wrapf(err, "failed to listen on port %s", tcpEventSource.Port)
+	}
+	log.Infof("listening for connections on port %s...", tcpEventSource.Port)
+
+	go func() {
+		<-ctx.Done()
+		if err := listener.Close(); err != nil {
+			log.Errorw("failed to close the listener", zap.Error(err))
+		}
+	}()
+
+	wg := &sync.WaitGroup{}
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				log.Info("waiting for the open connections to be closed...")
+				wg.Wait()
+				log.Infof("TCP event source on port %s is stopped", tcpEventSource.Port)
+				return nil
+			}
+			wg.Wait()
+			return errors.Wrap(err, "failed to accept a connection")
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			el.handleConnection(ctx, conn, readTimeout, dispatch, log)
+		}()
+	}
+}
+
+// handleConnection reads the lines sent on the connection until it is closed by the client, goes idle
+// for longer than the read timeout, sends a line longer than the max line length, or the context is done.
+func (el *EventListener) handleConnection(ctx context.Context, conn net.Conn, readTimeout time.Duration, dispatch func([]byte, ...eventsourcecommon.Options) error, log *zap.SugaredLogger) {
+	remoteAddr := conn.RemoteAddr().String()
+	log = log.With("remoteAddr", remoteAddr)
+	log.Info("accepted a connection")
+
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			// Unblock the pending read, the lines already received are still dispatched.
+			_ = conn.Close()
+		case <-done:
+		}
+	}()
+	defer conn.Close()
+
+	maxLineLength := defaultMaxLineLength
+	if el.TCPEventSource.MaxLineLength > 0 {
+		maxLineLength = int(el.TCPEventSource.MaxLineLength)
+	}
+	// One extra byte for the line delimiter, the initial buffer must not exceed it as it
+	// raises the max token size of the scanner.
+	bufferSize := maxLineLength + 1
+	initialBufferSize := bufio.MaxScanTokenSize / 16
+	if initialBufferSize > bufferSize {
+		initialBufferSize = bufferSize
+	}
+	scanner := bufio.NewScanner(conn)
+	scanner.Buffer(make([]byte, 0, initialBufferSize), bufferSize)
+
+	for {
+		if readTimeout > 0 {
+			if err := conn.SetReadDeadline(time.Now().Add(readTimeout)); err != nil {
+				log.Errorw("failed to set the read deadline, closing the connection", zap.Error(err))
+				return
+			}
+		}
+		if !scanner.Scan() {
+			break
+		}
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+		if err := el.handleOne(remoteAddr, line, dispatch, log); err != nil {
+			log.Errorw("failed to process a line", zap.Error(err))
+			el.Metrics.EventProcessingFailed(el.GetEventSourceName(), el.GetEventName())
+		}
+	}
+
+	switch err := scanner.Err(); {
+	case err == nil, ctx.Err() != nil:
+		log.Info("connection closed")
+	case errors.Is(err, bufio.ErrTooLong):
+		log.Warnf("line exceeds the max length of %d bytes, closing the connection", maxLineLength)
+	case isTimeout(err):
+		log.Infof("no line received for %v, closing the connection", readTimeout)
+	default:
+		log.Errorw("failed to read from the connection", zap.Error(err))
+	}
+}
+
+func (el *EventListener) handleOne(remoteAddr string, line []byte, dispatch func([]byte, ...eventsourcecommon.Options) error, log *zap.SugaredLogger) error {
+	defer func(start time.Time) {
+		el.Metrics.EventProcessingDuration(el.GetEventSourceName(), el.GetEventName(), float64(time.Since(start)/time.Millisecond))
+	}(time.Now())
+
+	body := json.RawMessage(line)
+	if !json.Valid(line) {
+		encoded, err := json.Marshal(string(line))
+		if err != nil {
+			return errors.Wrap(err, "failed to encode the line")
+		}
+		body = encoded
+	}
+	eventData := &events.TCPEventData{
+		RemoteAddr: remoteAddr,
+		Body:       &body,
+		Metadata:   el.TCPEventSource.Metadata,
+	}
+	eventBody, err := json.Marshal(eventData)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal the event data, rejecting the event...")
+	}
+	log.Debug("dispatching the event on the data channel...")
+	if err = dispatch(eventBody); err != nil {
+		return errors.Wrap(err, "failed to dispatch a TCP event")
+	}
+	return nil
+}
+
+func isTimeout(err error) bool {
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tcp
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-events/common/logging"
+	eventsourcecommon "github.com/argoproj/argo-events/eventsources/common"
+	"github.com/argoproj/argo-events/metrics"
+	"github.com/argoproj/argo-events/pkg/apis/events"
+	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+)
+
+type fakeDispatcher struct {
+	lock   sync.Mutex
+	events []events.TCPEventData
+}
+
+func (d *fakeDispatcher) dispatch(data []byte, opts ...eventsourcecommon.Options) error {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	var eventData events.TCPEventData
+	if err := json.Unmarshal(data, &eventData); err != nil {
+		return err
+	}
+	d.events = append(d.events, eventData)
+	return nil
+}
+
+func (d *fakeDispatcher) bodies() []string {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	var bodies []string
+	for _, e := range d.events {
+		bodies = append(bodies, string(*e.Body))
+	}
+	return bodies
+}
+
+func newTestListener(eventSource v1alpha1.TCPEventSource) *EventListener {
+	return &EventListener{
+		EventSourceName: "fake-eventsource",
+		EventName:       "fake-event",
+		TCPEventSource:  eventSource,
+		Metrics:         metrics.NewMetrics("fake"),
+	}
+}
+
+func TestHandleConnection(t *testing.T) {
+	logger := logging.NewArgoEventsLogger()
+
+	t.Run("dispatch lines", func(t *testing.T) {
+		el := newTestListener(v1alpha1.TCPEventSource{Port: "12000", Metadata: map[string]string{"foo": "bar"}})
+		d := &fakeDispatcher{}
+		server, client := net.Pipe()
+		done := make(chan struct{})
+		go func() {
+			el.handleConnection(context.Background(), server, 0, d.dispatch, logger)
+			close(done)
+		}()
+		_, err := client.Write([]byte("{\"a\":1}\n\nplain text\r\n[1,2]"))
+		assert.NoError(t, err)
+		assert.NoError(t, client.Close())
+		<-done
+
+		assert.Equal(t, []string{`{"a":1}`, `"plain text"`, `[1,2]`}, d.bodies())
+		assert.Equal(t, "pipe", d.events[0].RemoteAddr)
+		assert.Equal(t, map[string]string{"foo": "bar"}, d.events[0].Metadata)
+	})
+
+	t.Run("line too long", func(t *testing.T) {
+		el := newTestListener(v1alpha1.TCPEventSource{Port: "12000", MaxLineLength: 8})
+		d := &fakeDispatcher{}
+		server, client := net.Pipe()
+		done := make(chan struct{})
+		go func() {
+			el.handleConnection(context.Background(), server, 0, d.dispatch, logger)
+			close(done)
+		}()
+		go func() {
+			_, _ = client.Write([]byte("12345678\n" + strings.Repeat("x", 100) + "\n"))
+		}()
+		<-done
+		_ = client.Close()
+
+		assert.Equal(t, []string{"12345678"}, d.bodies())
+	})
+
+	t.Run("read timeout", func(t *testing.T) {
+		el := newTestListener(v1alpha1.TCPEventSource{Port: "12000"})
+		d := &fakeDispatcher{}
+		server, client := net.Pipe()
+		defer client.Close()
+		done := make(chan struct{})
+		go func() {
+			el.handleConnection(context.Background(), server, 50*time.Millisecond, d.dispatch, logger)
+			close(done)
+		}()
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+			t.Fatal("connection not closed after the read timeout")
+		}
+		assert.Empty(t, d.bodies())
+	})
+
+	t.Run("context cancelled", func(t *testing.T) {
+		el := newTestListener(v1alpha1.TCPEventSource{Port: "12000"})
+		d := &fakeDispatcher{}
+		server, client := net.Pipe()
+		defer client.Close()
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan struct{})
+		go func() {
+			el.handleConnection(ctx, server, 0, d.dispatch, logger)
+			close(done)
+		}()
+		_, err := client.Write([]byte("1\n"))
+		assert.NoError(t, err)
+		cancel()
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+			t.Fatal("connection not closed after the context is cancelled")
+		}
+		assert.Equal(t, []string{"1"}, d.bodies())
+	})
+}
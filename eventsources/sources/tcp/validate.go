@@ -0,0 +1,58 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tcp
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+)
+
+// ValidateEventSource validates the TCP event source
+func (el *EventListener) ValidateEventSource(ctx context.Context) error {
+	return validate(&el.TCPEventSource)
+}
+
+func validate(eventSource *v1alpha1.TCPEventSource) error {
+	if eventSource == nil {
+		return common.ErrNilEventSource
+	}
+	if eventSource.Port == "" {
+		return errors.New("port must be specified")
+	}
+	if port, err := strconv.Atoi(eventSource.Port); err != nil || port < 1 || port > 65535 {
+		return errors.Errorf("invalid port %s", eventSource.Port)
+	}
+	if eventSource.MaxLineLength < 0 {
+		return errors.New("max line length can't be negative")
+	}
+	if eventSource.ReadTimeout != "" {
+		readTimeout, err := time.ParseDuration(eventSource.ReadTimeout)
+		if err != nil {
+			return errors.Wrapf(err, "failed to parse the read timeout %s", eventSource.ReadTimeout)
+		}
+		if readTimeout < 0 {
+			return errors.New("read timeout can't be negative")
+		}
+	}
+	return nil
+}
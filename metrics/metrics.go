@@ -7,6 +7,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"go.uber.org/zap"
+	crmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 
 	"github.com/argoproj/argo-events/common/logging"
 )
@@ -32,6 +33,19 @@ type Metrics struct {
 	actionTriggered         *prometheus.CounterVec
 	actionFailed            *prometheus.CounterVec
 	actionDuration          *prometheus.SummaryVec
+	actionRateLimited       *prometheus.CounterVec
+	actionDeduplicated      *prometheus.CounterVec
+	actionSkipped           *prometheus.CounterVec
+	actionCircuitBroken     *prometheus.CounterVec
+	actionCircuitState      *prometheus.GaugeVec
+	actionInFlight          *prometheus.GaugeVec
+	actionEventsInFlight    *prometheus.GaugeVec
+	actionSchemaRejected    *prometheus.CounterVec
+	actionRedelivered       *prometheus.CounterVec
+	actionDeadLettered      *prometheus.CounterVec
+	sensorEventsReceived    *prometheus.CounterVec
+	sensorEventsProcessed   *prometheus.CounterVec
+	sensorEventLag          *prometheus.GaugeVec
 }
 
 // NewMetrics returns a Metrics instance
@@ -102,6 +116,110 @@ func NewMetrics(namespace string) *Metrics {
 				labelNamespace: namespace,
 			},
 		}, []string{labelSensorName, labelTriggerName}),
+		actionRateLimited: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: prefix,
+			Name:      "action_rate_limited_total",
+			Help:      "How many actions have been dropped by the trigger rate limit. https://argoproj.github.io/argo-events/metrics/#argo_events_action_rate_limited_total",
+			ConstLabels: prometheus.Labels{
+				labelNamespace: namespace,
+			},
+		}, []string{labelSensorName, labelTriggerName}),
+		actionDeduplicated: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: prefix,
+			Name:      "action_deduplicated_total",
+			Help:      "How many actions have been skipped as duplicates by the trigger dedupe or the idempotency of the sensor. https://argoproj.github.io/argo-events/metrics/#argo_events_action_deduplicated_total",
+			ConstLabels: prometheus.Labels{
+				labelNamespace: namespace,
+			},
+		}, []string{labelSensorName, labelTriggerName}),
+		actionSkipped: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: prefix,
+			Name:      "action_skipped_total",
+			Help:      "How many actions have been skipped by the trigger condition or the canary group. https://argoproj.github.io/argo-events/metrics/#argo_events_action_skipped_total",
+			ConstLabels: prometheus.Labels{
+				labelNamespace: namespace,
+			},
+		}, []string{labelSensorName, labelTriggerName}),
+		actionCircuitBroken: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: prefix,
+			Name:      "action_circuit_broken_total",
+			Help:      "How many actions have been failed fast by the open trigger circuit breaker. https://argoproj.github.io/argo-events/metrics/#argo_events_action_circuit_broken_total",
+			ConstLabels: prometheus.Labels{
+				labelNamespace: namespace,
+			},
+		}, []string{labelSensorName, labelTriggerName}),
+		actionCircuitState: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: prefix,
+			Name:      "action_circuit_state",
+			Help:      "State of the trigger circuit breaker, 0 for closed, 1 for open and 2 for half-open. https://argoproj.github.io/argo-events/metrics/#argo_events_action_circuit_state",
+			ConstLabels: prometheus.Labels{
+				labelNamespace: namespace,
+			},
+		}, []string{labelSensorName, labelTriggerName}),
+		actionInFlight: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: prefix,
+			Name:      "action_in_flight",
+			Help:      "How many actions are currently being executed. https://argoproj.github.io/argo-events/metrics/#argo_events_action_in_flight",
+			ConstLabels: prometheus.Labels{
+				labelNamespace: namespace,
+			},
+		}, []string{labelSensorName, labelTriggerName}),
+		actionEventsInFlight: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: prefix,
+			Name:      "action_events_in_flight",
+			Help:      "How many events the actions currently being executed have been triggered by. https://argoproj.github.io/argo-events/metrics/#argo_events_action_events_in_flight",
+			ConstLabels: prometheus.Labels{
+				labelNamespace: namespace,
+			},
+		}, []string{labelSensorName}),
+		actionSchemaRejected: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: prefix,
+			Name:      "action_schema_rejected_total",
+			Help:      "How many events have been rejected by the schema of a dependency before triggering the actions. https://argoproj.github.io/argo-events/metrics/#argo_events_action_schema_rejected_total",
+			ConstLabels: prometheus.Labels{
+				labelNamespace: namespace,
+			},
+		}, []string{labelSensorName, labelTriggerName}),
+		actionRedelivered: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: prefix,
+			Name:      "action_redelivered_total",
+			Help:      "How many times the events of failed actions have been redelivered to them. https://argoproj.github.io/argo-events/metrics/#argo_events_action_redelivered_total",
+			ConstLabels: prometheus.Labels{
+				labelNamespace: namespace,
+			},
+		}, []string{labelSensorName, labelTriggerName}),
+		actionDeadLettered: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: prefix,
+			Name:      "action_dead_lettered_total",
+			Help:      "How many times the events of failed actions have been forwarded to the dead letter sink of the sensor. https://argoproj.github.io/argo-events/metrics/#argo_events_action_dead_lettered_total",
+			ConstLabels: prometheus.Labels{
+				labelNamespace: namespace,
+			},
+		}, []string{labelSensorName, labelTriggerName}),
+		sensorEventsReceived: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: prefix,
+			Name:      "sensor_events_received_total",
+			Help:      "How many events the triggers of a Sensor have received from the EventBus. https://argoproj.github.io/argo-events/metrics/#argo_events_sensor_events_received_total",
+			ConstLabels: prometheus.Labels{
+				labelNamespace: namespace,
+			},
+		}, []string{labelSensorName, labelTriggerName}),
+		sensorEventsProcessed: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: prefix,
+			Name:      "sensor_events_processed_total",
+			Help:      "How many events the actions of a Sensor have been executed for, whatever their outcome. https://argoproj.github.io/argo-events/metrics/#argo_events_sensor_events_processed_total",
+			ConstLabels: prometheus.Labels{
+				labelNamespace: namespace,
+			},
+		}, []string{labelSensorName, labelTriggerName}),
+		sensorEventLag: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: prefix,
+			Name:      "sensor_event_processing_lag_seconds",
+			Help:      "Time between the oldest event of the last action a trigger started executing and its start. https://argoproj.github.io/argo-events/metrics/#argo_events_sensor_event_processing_lag_seconds",
+			ConstLabels: prometheus.Labels{
+				labelNamespace: namespace,
+			},
+		}, []string{labelSensorName, labelTriggerName}),
 	}
 }
 
@@ -114,6 +232,19 @@ func (m *Metrics) Collect(ch chan<- prometheus.Metric) {
 	m.actionTriggered.Collect(ch)
 	m.actionFailed.Collect(ch)
 	m.actionDuration.Collect(ch)
+	m.actionRateLimited.Collect(ch)
+	m.actionDeduplicated.Collect(ch)
+	m.actionSkipped.Collect(ch)
+	m.actionCircuitBroken.Collect(ch)
+	m.actionCircuitState.Collect(ch)
+	m.actionInFlight.Collect(ch)
+	m.actionEventsInFlight.Collect(ch)
+	m.actionSchemaRejected.Collect(ch)
+	m.actionRedelivered.Collect(ch)
+	m.actionDeadLettered.Collect(ch)
+	m.sensorEventsReceived.Collect(ch)
+	m.sensorEventsProcessed.Collect(ch)
+	m.sensorEventLag.Collect(ch)
 }
 
 func (m *Metrics) Describe(ch chan<- *prometheus.Desc) {
@@ -125,6 +256,19 @@ func (m *Metrics) Describe(ch chan<- *prometheus.Desc) {
 	m.actionTriggered.Describe(ch)
 	m.actionFailed.Describe(ch)
 	m.actionDuration.Describe(ch)
+	m.actionRateLimited.Describe(ch)
+	m.actionDeduplicated.Describe(ch)
+	m.actionSkipped.Describe(ch)
+	m.actionCircuitBroken.Describe(ch)
+	m.actionCircuitState.Describe(ch)
+	m.actionInFlight.Describe(ch)
+	m.actionEventsInFlight.Describe(ch)
+	m.actionSchemaRejected.Describe(ch)
+	m.actionRedelivered.Describe(ch)
+	m.actionDeadLettered.Describe(ch)
+	m.sensorEventsReceived.Describe(ch)
+	m.sensorEventsProcessed.Describe(ch)
+	m.sensorEventLag.Describe(ch)
 }
 
 func (m *Metrics) IncRunningServices(eventSourceName string) {
@@ -163,12 +307,72 @@ func (m *Metrics) ActionDuration(sensorName, triggerName string, num float64) {
 	m.actionDuration.WithLabelValues(sensorName, triggerName).Observe(num)
 }
 
+func (m *Metrics) ActionRateLimited(sensorName, triggerName string) {
+	m.actionRateLimited.WithLabelValues(sensorName, triggerName).Inc()
+}
+
+func (m *Metrics) ActionDeduplicated(sensorName, triggerName string) {
+	m.actionDeduplicated.WithLabelValues(sensorName, triggerName).Inc()
+}
+
+func (m *Metrics) ActionSkipped(sensorName, triggerName string) {
+	m.actionSkipped.WithLabelValues(sensorName, triggerName).Inc()
+}
+
+func (m *Metrics) ActionCircuitBroken(sensorName, triggerName string) {
+	m.actionCircuitBroken.WithLabelValues(sensorName, triggerName).Inc()
+}
+
+func (m *Metrics) ActionCircuitState(sensorName, triggerName string, state float64) {
+	m.actionCircuitState.WithLabelValues(sensorName, triggerName).Set(state)
+}
+
+func (m *Metrics) IncActionInFlight(sensorName, triggerName string) {
+	m.actionInFlight.WithLabelValues(sensorName, triggerName).Inc()
+}
+
+func (m *Metrics) DecActionInFlight(sensorName, triggerName string) {
+	m.actionInFlight.WithLabelValues(sensorName, triggerName).Dec()
+}
+
+// AddEventsInFlight adds the events of an action starting, or removes them once it completed with a negative count.
+func (m *Metrics) AddEventsInFlight(sensorName string, events int) {
+	m.actionEventsInFlight.WithLabelValues(sensorName).Add(float64(events))
+}
+
+func (m *Metrics) ActionSchemaRejected(sensorName, triggerName string) {
+	m.actionSchemaRejected.WithLabelValues(sensorName, triggerName).Inc()
+}
+
+func (m *Metrics) ActionRedelivered(sensorName, triggerName string) {
+	m.actionRedelivered.WithLabelValues(sensorName, triggerName).Inc()
+}
+
+func (m *Metrics) ActionDeadLettered(sensorName, triggerName string) {
+	m.actionDeadLettered.WithLabelValues(sensorName, triggerName).Inc()
+}
+
+func (m *Metrics) SensorEventReceived(sensorName, triggerName string) {
+	m.sensorEventsReceived.WithLabelValues(sensorName, triggerName).Inc()
+}
+
+// SensorEventsProcessed counts the events of an action once it completed.
+func (m *Metrics) SensorEventsProcessed(sensorName, triggerName string, events int) {
+	m.sensorEventsProcessed.WithLabelValues(sensorName, triggerName).Add(float64(events))
+}
+
+// SensorEventLag sets the processing lag of a trigger, in seconds.
+func (m *Metrics) SensorEventLag(sensorName, triggerName string, lag float64) {
+	m.sensorEventLag.WithLabelValues(sensorName, triggerName).Set(lag)
+}
+
 // Run starts a metrics server
 func (m *Metrics) Run(ctx context.Context, addr string) {
 	log := logging.FromContext(ctx)
 	metricsRegistry := prometheus.NewRegistry()
 	metricsRegistry.MustRegister(m)
-	http.Handle("/metrics", promhttp.HandlerFor(metricsRegistry, promhttp.HandlerOpts{}))
+	// Also serve the metrics registered with controller-runtime, e.g. the trigger execution metrics.
+	http.Handle("/metrics", promhttp.HandlerFor(prometheus.Gatherers{metricsRegistry, crmetrics.Registry}, promhttp.HandlerOpts{}))
 	log.Info("starting metrics server")
 	if err := http.ListenAndServe(addr, nil); err != nil {
 		log.Fatalw("failed to start metrics server", zap.Error(err))
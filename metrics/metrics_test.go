@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/argoproj/argo-events/common/logging"
@@ -21,3 +22,40 @@ func TestRun(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, resp.StatusCode, 200)
 }
+
+func TestSensorMetrics(t *testing.T) {
+	m := NewMetrics("test-ns")
+	registry := prometheus.NewRegistry()
+	assert.Nil(t, registry.Register(m))
+
+	m.SensorEventReceived("test-sensor", "test-trigger")
+	m.SensorEventReceived("test-sensor", "test-trigger")
+	m.SensorEventsProcessed("test-sensor", "test-trigger", 2)
+	m.SensorEventLag("test-sensor", "test-trigger", 1.5)
+	m.ActionTriggered("test-sensor", "test-trigger")
+
+	families, err := registry.Gather()
+	assert.Nil(t, err)
+	values := make(map[string]float64)
+	for _, family := range families {
+		for _, metric := range family.GetMetric() {
+			labels := make(map[string]string)
+			for _, label := range metric.GetLabel() {
+				labels[label.GetName()] = label.GetValue()
+			}
+			assert.Equal(t, map[string]string{"namespace": "test-ns", "sensor_name": "test-sensor", "trigger_name": "test-trigger"}, labels, family.GetName())
+			switch {
+			case metric.GetCounter() != nil:
+				values[family.GetName()] = metric.GetCounter().GetValue()
+			case metric.GetGauge() != nil:
+				values[family.GetName()] = metric.GetGauge().GetValue()
+			}
+		}
+	}
+	assert.Equal(t, map[string]float64{
+		"argo_events_sensor_events_received_total":        2,
+		"argo_events_sensor_events_processed_total":       2,
+		"argo_events_sensor_event_processing_lag_seconds": 1.5,
+		"argo_events_action_triggered_total":              1,
+	}, values)
+}
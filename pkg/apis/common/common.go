@@ -52,6 +52,7 @@ var (
 	GenericEvent         EventSourceType = "generic"
 	BitbucketServerEvent EventSourceType = "bitbucketserver"
 	BitbucketEvent       EventSourceType = "bitbucket"
+	TCPEvent             EventSourceType = "tcp"
 )
 
 var (
@@ -95,6 +96,11 @@ var (
 	SlackTrigger          TriggerType = "Slack"
 	K8sTrigger            TriggerType = "Kubernetes"
 	AzureEventHubsTrigger TriggerType = "AzureEventHubs"
+	GCPFunctionTrigger    TriggerType = "GCPCloudFunction"
+	RedisStreamTrigger    TriggerType = "RedisStream"
+	AWSSQSTrigger         TriggerType = "AWSSQS"
+	PushgatewayTrigger    TriggerType = "Pushgateway"
+	BusTrigger            TriggerType = "Bus"
 )
 
 // EventBusType is the type of event bus
@@ -102,7 +108,8 @@ type EventBusType string
 
 // possible event bus types
 var (
-	EventBusNATS EventBusType = "nats"
+	EventBusNATS  EventBusType = "nats"
+	EventBusKafka EventBusType = "kafka"
 )
 
 // BasicAuth contains the reference to K8s secrets that holds the username and password
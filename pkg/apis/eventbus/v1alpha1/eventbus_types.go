@@ -1,6 +1,7 @@
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/argoproj/argo-events/pkg/apis/common"
@@ -36,13 +37,57 @@ type EventBusSpec struct {
 	NATS *NATSBus `json:"nats,omitempty" protobuf:"bytes,1,opt,name=nats"`
 	// +optional
 	JetStream *JetStreamBus `json:"jetstream,omitempty" protobuf:"bytes,2,opt,name=jetstream"`
+	// Kafka eventbus, using an existing Kafka cluster
+	// +optional
+	Kafka *KafkaBus `json:"kafka,omitempty" protobuf:"bytes,3,opt,name=kafka"`
+	// MigrationPolicy is when the native NATS streaming resources are torn down once the spec
+	// flips from "nats" to "jetstream", defaults to "Immediate".
+	// +optional
+	MigrationPolicy MigrationPolicy `json:"migrationPolicy,omitempty" protobuf:"bytes,4,opt,name=migrationPolicy,casttype=MigrationPolicy"`
+	// ConfigProfile is the name of the profile of the controller configuration the EventBus is
+	// installed with, e.g. "prod". Defaults to the top-level "eventBus" configuration.
+	// +optional
+	ConfigProfile string `json:"configProfile,omitempty" protobuf:"bytes,5,opt,name=configProfile"`
+	// Compression is the codec the event sources compress the messages they publish with, "gzip",
+	// "snappy" or "zstd". The sensors decompress the messages whatever the codec they were published
+	// with, so the codec can be changed while the messages of the previous one are still on the bus.
+	// Defaults to "none".
+	// +optional
+	Compression CompressionCodec `json:"compression,omitempty" protobuf:"bytes,6,opt,name=compression,casttype=CompressionCodec"`
 }
 
+// MigrationPolicy is the policy of the migration of an EventBus from NATS streaming to JetStream
+type MigrationPolicy string
+
+// possible values of MigrationPolicy
+const (
+	// MigrationPolicyImmediate tears down NATS streaming as soon as JetStream is deployed
+	MigrationPolicyImmediate MigrationPolicy = "Immediate"
+	// MigrationPolicyWaitForReady tears down NATS streaming once all the JetStream replicas are ready
+	MigrationPolicyWaitForReady MigrationPolicy = "WaitForReady"
+)
+
+// CompressionCodec is the codec of the compression of the messages published to an EventBus
+type CompressionCodec string
+
+// possible values of CompressionCodec
+const (
+	CompressionNone   CompressionCodec = "none"
+	CompressionGzip   CompressionCodec = "gzip"
+	CompressionSnappy CompressionCodec = "snappy"
+	CompressionZstd   CompressionCodec = "zstd"
+)
+
 // EventBusStatus holds the status of the eventbus resource
 type EventBusStatus struct {
 	common.Status `json:",inline" protobuf:"bytes,1,opt,name=status"`
 	// Config holds the fininalized configuration of EventBus
 	Config BusConfig `json:"config,omitempty" protobuf:"bytes,2,opt,name=config"`
+	// ConditionHistory holds the latest transitions of the conditions, oldest first, for
+	// the story of the EventBus to be told by its status. At most MaxConditionHistory
+	// transitions are kept.
+	// +optional
+	ConditionHistory []common.Condition `json:"conditionHistory,omitempty" protobuf:"bytes,3,rep,name=conditionHistory"`
 }
 
 // BusConfig has the finalized configuration for EventBus
@@ -51,6 +96,29 @@ type BusConfig struct {
 	NATS *NATSConfig `json:"nats,omitempty" protobuf:"bytes,1,opt,name=nats"`
 	// +optional
 	JetStream *JetStreamConfig `json:"jetstream,omitempty" protobuf:"bytes,2,opt,name=jetstream"`
+	// +optional
+	Kafka *KafkaBus `json:"kafka,omitempty" protobuf:"bytes,3,opt,name=kafka"`
+	// Compression is the codec the messages are published with
+	// +optional
+	Compression CompressionCodec `json:"compression,omitempty" protobuf:"bytes,4,opt,name=compression,casttype=CompressionCodec"`
+}
+
+// BusTLSConfig is the TLS configuration the clients connect to the EventBus with, the
+// server requiring them to present the certificate.
+type BusTLSConfig struct {
+	// Secret for the CA certificate verifying the server
+	// +optional
+	CACertSecret *corev1.SecretKeySelector `json:"caCertSecret,omitempty" protobuf:"bytes,1,opt,name=caCertSecret"`
+	// Secret for the client certificate
+	CertSecret *corev1.SecretKeySelector `json:"certSecret,omitempty" protobuf:"bytes,2,opt,name=certSecret"`
+	// Secret for the private key of the client certificate
+	KeySecret *corev1.SecretKeySelector `json:"keySecret,omitempty" protobuf:"bytes,3,opt,name=keySecret"`
+	// If true, skips the verification of the server certificate
+	// +optional
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty" protobuf:"varint,4,opt,name=insecureSkipVerify"`
+	// Minimum TLS version, "1.2" or "1.3"
+	// +optional
+	MinVersion string `json:"minVersion,omitempty" protobuf:"bytes,5,opt,name=minVersion"`
 }
 
 const (
@@ -60,6 +128,17 @@ const (
 	// EventBusConditionConfigured has the status True when the EventBus
 	// has its configuration ready.
 	EventBusConditionConfigured common.ConditionType = "Configured"
+
+	// EventBusReasonMigrating is the reason of the Deployed condition while
+	// the EventBus is being migrated from NATS streaming to JetStream.
+	EventBusReasonMigrating = "Migrating"
+	// EventBusReasonStatefulSetProgressing is the reason of the Deployed condition
+	// while the StatefulSet of the EventBus is rolling out its replicas.
+	EventBusReasonStatefulSetProgressing = "StatefulSetProgressing"
+
+	// MaxConditionHistory is the maximum number of transitions kept in the
+	// condition history of an EventBus.
+	MaxConditionHistory = 20
 )
 
 // InitConditions sets conditions to Unknown state.
@@ -82,6 +161,53 @@ func (s *EventBusStatus) MarkDeployFailed(reason, message string) {
 	s.MarkFalse(EventBusConditionDeployed, reason, message)
 }
 
+// MarkMigrating set the bus is being migrated from NATS streaming to JetStream
+func (s *EventBusStatus) MarkMigrating(message string) {
+	s.MarkUnknown(EventBusConditionDeployed, EventBusReasonMigrating, message)
+}
+
+// IsMigrating returns true if the bus is being migrated from NATS streaming to JetStream
+func (s *EventBusStatus) IsMigrating() bool {
+	c := s.GetCondition(EventBusConditionDeployed)
+	return c != nil && c.IsUnknown() && c.Reason == EventBusReasonMigrating
+}
+
+// MarkProgressing set the bus is deployed, while its StatefulSet is still rolling out its replicas.
+// The bus stays ready meanwhile, the clients of the ready replicas are served.
+func (s *EventBusStatus) MarkProgressing(message string) {
+	s.MarkTrueWithReason(EventBusConditionDeployed, EventBusReasonStatefulSetProgressing, message)
+}
+
+// IsProgressing returns true if the StatefulSet of the bus is rolling out its replicas
+func (s *EventBusStatus) IsProgressing() bool {
+	c := s.GetCondition(EventBusConditionDeployed)
+	return c != nil && c.IsTrue() && c.Reason == EventBusReasonStatefulSetProgressing
+}
+
+// RecordTransitions compares the conditions with the previous ones, the conditions being reset and marked
+// again on each reconciliation. The conditions whose status and reason didn't change keep their previous
+// transition time, the other ones are appended to the condition history, dropping the oldest transitions
+// beyond MaxConditionHistory.
+func (s *EventBusStatus) RecordTransitions(previous []common.Condition) {
+	for i, c := range s.Conditions {
+		var before *common.Condition
+		for j := range previous {
+			if previous[j].Type == c.Type {
+				before = &previous[j]
+				break
+			}
+		}
+		if before != nil && before.Status == c.Status && before.Reason == c.Reason {
+			s.Conditions[i].LastTransitionTime = before.LastTransitionTime
+			continue
+		}
+		s.ConditionHistory = append(s.ConditionHistory, c)
+	}
+	if n := len(s.ConditionHistory); n > MaxConditionHistory {
+		s.ConditionHistory = append([]common.Condition(nil), s.ConditionHistory[n-MaxConditionHistory:]...)
+	}
+}
+
 // MarkConfigured set the bus configuration has been done.
 func (s *EventBusStatus) MarkConfigured() {
 	s.MarkTrue(EventBusConditionConfigured)
@@ -2,10 +2,12 @@ package v1alpha1
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/argoproj/argo-events/pkg/apis/common"
 )
@@ -167,3 +169,98 @@ func TestEventBusStatusGetCondition(t *testing.T) {
 		})
 	}
 }
+
+func TestEventBusStatusIsMigrating(t *testing.T) {
+	s := &EventBusStatus{}
+	s.InitConditions()
+	if s.IsMigrating() {
+		t.Error("expected not migrating once initialized")
+	}
+	s.MarkMigrating("test")
+	if !s.IsMigrating() {
+		t.Error("expected migrating")
+	}
+	s.MarkDeployed("test", "test")
+	if s.IsMigrating() {
+		t.Error("expected not migrating once deployed")
+	}
+}
+
+func TestEventBusStatusIsProgressing(t *testing.T) {
+	s := &EventBusStatus{}
+	s.InitConditions()
+	s.MarkProgressing("1 of 3 replicas are ready")
+	s.MarkConfigured()
+	if !s.IsProgressing() {
+		t.Error("expected progressing")
+	}
+	if !s.IsReady() {
+		t.Error("expected ready while progressing")
+	}
+	s.MarkDeployed("test", "test")
+	if s.IsProgressing() {
+		t.Error("expected not progressing once deployed")
+	}
+}
+
+func TestEventBusStatusRecordTransitions(t *testing.T) {
+	before := metav1.NewTime(time.Now().Add(-time.Hour))
+	previous := []common.Condition{
+		{Type: EventBusConditionDeployed, Status: corev1.ConditionTrue, Reason: "Succeeded", LastTransitionTime: before},
+		{Type: EventBusConditionConfigured, Status: corev1.ConditionTrue, LastTransitionTime: before},
+	}
+
+	t.Run("unchanged conditions", func(t *testing.T) {
+		s := &EventBusStatus{}
+		s.InitConditions()
+		s.MarkDeployed("Succeeded", "deployed")
+		s.MarkConfigured()
+		s.RecordTransitions(previous)
+		for _, c := range s.Conditions {
+			if !c.LastTransitionTime.Equal(&before) {
+				t.Errorf("expected the transition time of %s to be kept", c.Type)
+			}
+		}
+		if len(s.ConditionHistory) != 0 {
+			t.Errorf("expected no transition, got %d", len(s.ConditionHistory))
+		}
+	})
+
+	t.Run("changed conditions", func(t *testing.T) {
+		s := &EventBusStatus{}
+		s.InitConditions()
+		s.MarkProgressing("rolling out")
+		s.MarkConfigured()
+		s.RecordTransitions(previous)
+		if len(s.ConditionHistory) != 1 {
+			t.Fatalf("expected 1 transition, got %d", len(s.ConditionHistory))
+		}
+		if s.ConditionHistory[0].Reason != EventBusReasonStatefulSetProgressing {
+			t.Errorf("unexpected reason %s", s.ConditionHistory[0].Reason)
+		}
+		if s.GetCondition(EventBusConditionDeployed).LastTransitionTime.Equal(&before) {
+			t.Error("expected a new transition time")
+		}
+	})
+
+	t.Run("bounded history", func(t *testing.T) {
+		s := &EventBusStatus{}
+		for i := 0; i < MaxConditionHistory+5; i++ {
+			last := s.DeepCopy().Conditions
+			s.InitConditions()
+			if i%2 == 0 {
+				s.MarkDeployFailed("Failed", "failed")
+			} else {
+				s.MarkDeployed("Succeeded", "deployed")
+			}
+			s.MarkConfigured()
+			s.RecordTransitions(last)
+		}
+		if len(s.ConditionHistory) != MaxConditionHistory {
+			t.Fatalf("expected %d transitions, got %d", MaxConditionHistory, len(s.ConditionHistory))
+		}
+		if latest := s.ConditionHistory[MaxConditionHistory-1]; latest.Reason != "Failed" {
+			t.Errorf("expected the latest transition last, got %s", latest.Reason)
+		}
+	})
+}
@@ -76,10 +76,38 @@ func (m *BusConfig) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_BusConfig proto.InternalMessageInfo
 
+func (m *BusTLSConfig) Reset()      { *m = BusTLSConfig{} }
+func (*BusTLSConfig) ProtoMessage() {}
+func (*BusTLSConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_871e47633eb7aad4, []int{1}
+}
+func (m *BusTLSConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BusTLSConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *BusTLSConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BusTLSConfig.Merge(m, src)
+}
+func (m *BusTLSConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *BusTLSConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_BusTLSConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BusTLSConfig proto.InternalMessageInfo
+
 func (m *ContainerTemplate) Reset()      { *m = ContainerTemplate{} }
 func (*ContainerTemplate) ProtoMessage() {}
 func (*ContainerTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_871e47633eb7aad4, []int{1}
+	return fileDescriptor_871e47633eb7aad4, []int{2}
 }
 func (m *ContainerTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -107,7 +135,7 @@ var xxx_messageInfo_ContainerTemplate proto.InternalMessageInfo
 func (m *EventBus) Reset()      { *m = EventBus{} }
 func (*EventBus) ProtoMessage() {}
 func (*EventBus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_871e47633eb7aad4, []int{2}
+	return fileDescriptor_871e47633eb7aad4, []int{3}
 }
 func (m *EventBus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -135,7 +163,7 @@ var xxx_messageInfo_EventBus proto.InternalMessageInfo
 func (m *EventBusList) Reset()      { *m = EventBusList{} }
 func (*EventBusList) ProtoMessage() {}
 func (*EventBusList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_871e47633eb7aad4, []int{3}
+	return fileDescriptor_871e47633eb7aad4, []int{4}
 }
 func (m *EventBusList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -163,7 +191,7 @@ var xxx_messageInfo_EventBusList proto.InternalMessageInfo
 func (m *EventBusSpec) Reset()      { *m = EventBusSpec{} }
 func (*EventBusSpec) ProtoMessage() {}
 func (*EventBusSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_871e47633eb7aad4, []int{4}
+	return fileDescriptor_871e47633eb7aad4, []int{5}
 }
 func (m *EventBusSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -191,7 +219,7 @@ var xxx_messageInfo_EventBusSpec proto.InternalMessageInfo
 func (m *EventBusStatus) Reset()      { *m = EventBusStatus{} }
 func (*EventBusStatus) ProtoMessage() {}
 func (*EventBusStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_871e47633eb7aad4, []int{5}
+	return fileDescriptor_871e47633eb7aad4, []int{6}
 }
 func (m *EventBusStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -219,7 +247,7 @@ var xxx_messageInfo_EventBusStatus proto.InternalMessageInfo
 func (m *JetStreamAuth) Reset()      { *m = JetStreamAuth{} }
 func (*JetStreamAuth) ProtoMessage() {}
 func (*JetStreamAuth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_871e47633eb7aad4, []int{6}
+	return fileDescriptor_871e47633eb7aad4, []int{7}
 }
 func (m *JetStreamAuth) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -247,7 +275,7 @@ var xxx_messageInfo_JetStreamAuth proto.InternalMessageInfo
 func (m *JetStreamBus) Reset()      { *m = JetStreamBus{} }
 func (*JetStreamBus) ProtoMessage() {}
 func (*JetStreamBus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_871e47633eb7aad4, []int{7}
+	return fileDescriptor_871e47633eb7aad4, []int{8}
 }
 func (m *JetStreamBus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -275,7 +303,7 @@ var xxx_messageInfo_JetStreamBus proto.InternalMessageInfo
 func (m *JetStreamConfig) Reset()      { *m = JetStreamConfig{} }
 func (*JetStreamConfig) ProtoMessage() {}
 func (*JetStreamConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_871e47633eb7aad4, []int{8}
+	return fileDescriptor_871e47633eb7aad4, []int{9}
 }
 func (m *JetStreamConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -300,10 +328,66 @@ func (m *JetStreamConfig) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_JetStreamConfig proto.InternalMessageInfo
 
+func (m *JetStreamMetrics) Reset()      { *m = JetStreamMetrics{} }
+func (*JetStreamMetrics) ProtoMessage() {}
+func (*JetStreamMetrics) Descriptor() ([]byte, []int) {
+	return fileDescriptor_871e47633eb7aad4, []int{10}
+}
+func (m *JetStreamMetrics) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JetStreamMetrics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *JetStreamMetrics) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JetStreamMetrics.Merge(m, src)
+}
+func (m *JetStreamMetrics) XXX_Size() int {
+	return m.Size()
+}
+func (m *JetStreamMetrics) XXX_DiscardUnknown() {
+	xxx_messageInfo_JetStreamMetrics.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JetStreamMetrics proto.InternalMessageInfo
+
+func (m *KafkaBus) Reset()      { *m = KafkaBus{} }
+func (*KafkaBus) ProtoMessage() {}
+func (*KafkaBus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_871e47633eb7aad4, []int{11}
+}
+func (m *KafkaBus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KafkaBus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *KafkaBus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KafkaBus.Merge(m, src)
+}
+func (m *KafkaBus) XXX_Size() int {
+	return m.Size()
+}
+func (m *KafkaBus) XXX_DiscardUnknown() {
+	xxx_messageInfo_KafkaBus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KafkaBus proto.InternalMessageInfo
+
 func (m *NATSBus) Reset()      { *m = NATSBus{} }
 func (*NATSBus) ProtoMessage() {}
 func (*NATSBus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_871e47633eb7aad4, []int{9}
+	return fileDescriptor_871e47633eb7aad4, []int{12}
 }
 func (m *NATSBus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -331,7 +415,7 @@ var xxx_messageInfo_NATSBus proto.InternalMessageInfo
 func (m *NATSConfig) Reset()      { *m = NATSConfig{} }
 func (*NATSConfig) ProtoMessage() {}
 func (*NATSConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_871e47633eb7aad4, []int{10}
+	return fileDescriptor_871e47633eb7aad4, []int{13}
 }
 func (m *NATSConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -359,7 +443,7 @@ var xxx_messageInfo_NATSConfig proto.InternalMessageInfo
 func (m *NativeStrategy) Reset()      { *m = NativeStrategy{} }
 func (*NativeStrategy) ProtoMessage() {}
 func (*NativeStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_871e47633eb7aad4, []int{11}
+	return fileDescriptor_871e47633eb7aad4, []int{14}
 }
 func (m *NativeStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -387,7 +471,7 @@ var xxx_messageInfo_NativeStrategy proto.InternalMessageInfo
 func (m *PersistenceStrategy) Reset()      { *m = PersistenceStrategy{} }
 func (*PersistenceStrategy) ProtoMessage() {}
 func (*PersistenceStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_871e47633eb7aad4, []int{12}
+	return fileDescriptor_871e47633eb7aad4, []int{15}
 }
 func (m *PersistenceStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -414,6 +498,7 @@ var xxx_messageInfo_PersistenceStrategy proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*BusConfig)(nil), "github.com.argoproj.argo_events.pkg.apis.eventbus.v1alpha1.BusConfig")
+	proto.RegisterType((*BusTLSConfig)(nil), "github.com.argoproj.argo_events.pkg.apis.eventbus.v1alpha1.BusTLSConfig")
 	proto.RegisterType((*ContainerTemplate)(nil), "github.com.argoproj.argo_events.pkg.apis.eventbus.v1alpha1.ContainerTemplate")
 	proto.RegisterType((*EventBus)(nil), "github.com.argoproj.argo_events.pkg.apis.eventbus.v1alpha1.EventBus")
 	proto.RegisterType((*EventBusList)(nil), "github.com.argoproj.argo_events.pkg.apis.eventbus.v1alpha1.EventBusList")
@@ -423,6 +508,8 @@ func init() {
 	proto.RegisterType((*JetStreamBus)(nil), "github.com.argoproj.argo_events.pkg.apis.eventbus.v1alpha1.JetStreamBus")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.eventbus.v1alpha1.JetStreamBus.NodeSelectorEntry")
 	proto.RegisterType((*JetStreamConfig)(nil), "github.com.argoproj.argo_events.pkg.apis.eventbus.v1alpha1.JetStreamConfig")
+	proto.RegisterType((*JetStreamMetrics)(nil), "github.com.argoproj.argo_events.pkg.apis.eventbus.v1alpha1.JetStreamMetrics")
+	proto.RegisterType((*KafkaBus)(nil), "github.com.argoproj.argo_events.pkg.apis.eventbus.v1alpha1.KafkaBus")
 	proto.RegisterType((*NATSBus)(nil), "github.com.argoproj.argo_events.pkg.apis.eventbus.v1alpha1.NATSBus")
 	proto.RegisterType((*NATSConfig)(nil), "github.com.argoproj.argo_events.pkg.apis.eventbus.v1alpha1.NATSConfig")
 	proto.RegisterType((*NativeStrategy)(nil), "github.com.argoproj.argo_events.pkg.apis.eventbus.v1alpha1.NativeStrategy")
@@ -435,121 +522,148 @@ func init() {
 }
 
 var fileDescriptor_871e47633eb7aad4 = []byte{
-	// 1811 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0x4f, 0x6f, 0x1b, 0xc7,
-	0x15, 0xd7, 0x8a, 0x14, 0x45, 0x0e, 0x29, 0x51, 0x1c, 0x29, 0xcd, 0x5a, 0x88, 0x49, 0x83, 0x45,
-	0x0a, 0x15, 0xb1, 0x97, 0x75, 0x50, 0xb4, 0x6e, 0x2e, 0x2e, 0x57, 0x91, 0x6b, 0x39, 0xa2, 0xa3,
-	0x0e, 0x15, 0x17, 0x4d, 0x83, 0xba, 0xa3, 0xd5, 0x88, 0x5a, 0x89, 0xbb, 0xc3, 0xee, 0xcc, 0x12,
-	0x62, 0x4f, 0x45, 0x3f, 0x41, 0x50, 0x14, 0x45, 0x7b, 0xe8, 0xb9, 0x40, 0x3f, 0x40, 0x3f, 0x83,
-	0x0f, 0x3d, 0xe4, 0xd6, 0x9c, 0x88, 0x98, 0x41, 0x81, 0x02, 0xfd, 0x06, 0x3e, 0x15, 0x33, 0x3b,
-	0xfb, 0x87, 0xdc, 0x55, 0xac, 0x98, 0x74, 0x83, 0x9e, 0xc4, 0x79, 0xef, 0xcd, 0xef, 0xfd, 0xd9,
-	0xb7, 0xef, 0xfd, 0x56, 0xe0, 0x51, 0xcf, 0xe6, 0x67, 0xfe, 0xb1, 0x61, 0x51, 0xa7, 0x85, 0xbd,
-	0x1e, 0x1d, 0x78, 0xf4, 0x5c, 0xfe, 0xb8, 0x43, 0x86, 0xc4, 0xe5, 0xac, 0x35, 0xb8, 0xe8, 0xb5,
-	0xf0, 0xc0, 0x66, 0x2d, 0x79, 0x3e, 0xf6, 0x59, 0x6b, 0x78, 0x17, 0xf7, 0x07, 0x67, 0xf8, 0x6e,
-	0xab, 0x47, 0x5c, 0xe2, 0x61, 0x4e, 0x4e, 0x8c, 0x81, 0x47, 0x39, 0x85, 0xef, 0xc5, 0x58, 0x46,
-	0x88, 0x25, 0x7f, 0x3c, 0x0d, 0xb0, 0x8c, 0xc1, 0x45, 0xcf, 0x10, 0x58, 0x46, 0x88, 0x65, 0x84,
-	0x58, 0xdb, 0xf7, 0xaf, 0x1d, 0x87, 0x45, 0x1d, 0x87, 0xba, 0xb3, 0xce, 0xb7, 0xef, 0x24, 0x00,
-	0x7a, 0xb4, 0x47, 0x5b, 0x52, 0x7c, 0xec, 0x9f, 0xca, 0x93, 0x3c, 0xc8, 0x5f, 0xca, 0xbc, 0x79,
-	0x71, 0x8f, 0x19, 0x36, 0x15, 0x90, 0x2d, 0x8b, 0x7a, 0xa4, 0x35, 0x4c, 0xe5, 0xb3, 0xfd, 0xfd,
-	0xd8, 0xc6, 0xc1, 0xd6, 0x99, 0xed, 0x12, 0x6f, 0x14, 0xc6, 0xd1, 0xf2, 0x08, 0xa3, 0xbe, 0x67,
-	0x91, 0xaf, 0x75, 0x8b, 0xb5, 0x1c, 0xc2, 0x71, 0x96, 0xaf, 0xd6, 0x55, 0xb7, 0x3c, 0xdf, 0xe5,
-	0xb6, 0x93, 0x76, 0xf3, 0x83, 0x97, 0x5d, 0x60, 0xd6, 0x19, 0x71, 0xf0, 0xec, 0xbd, 0xe6, 0x7f,
-	0x34, 0x50, 0x32, 0x7d, 0xb6, 0x4b, 0xdd, 0x53, 0xbb, 0x07, 0x4f, 0x40, 0xde, 0xc5, 0x9c, 0xe9,
-	0xda, 0x2d, 0x6d, 0xa7, 0xfc, 0xee, 0x03, 0xe3, 0xd5, 0x9f, 0xa0, 0xf1, 0xb8, 0x7d, 0xd4, 0x0d,
-	0x50, 0xcd, 0xe2, 0x64, 0xdc, 0xc8, 0x8b, 0x33, 0x92, 0xe8, 0xf0, 0x12, 0x94, 0xce, 0x09, 0x67,
-	0xdc, 0x23, 0xd8, 0xd1, 0x97, 0xa5, 0xab, 0x0f, 0xe6, 0x71, 0xf5, 0x88, 0xf0, 0xae, 0x04, 0x53,
-	0xfe, 0xd6, 0x26, 0xe3, 0x46, 0x29, 0x12, 0xa2, 0xd8, 0x59, 0xf3, 0xef, 0xcb, 0xa0, 0xb6, 0x4b,
-	0x5d, 0x8e, 0x45, 0x7d, 0x8e, 0x88, 0x33, 0xe8, 0x63, 0x4e, 0xe0, 0xcf, 0x41, 0x29, 0x7c, 0x7c,
-	0x61, 0xea, 0x3b, 0x46, 0x50, 0x4f, 0xe1, 0xd2, 0x10, 0x0d, 0x61, 0x0c, 0xef, 0x1a, 0x48, 0x19,
-	0x21, 0xf2, 0x6b, 0xdf, 0xf6, 0x88, 0x23, 0xe2, 0x32, 0x6b, 0xcf, 0xc6, 0x8d, 0x25, 0xe1, 0x30,
-	0xd4, 0x32, 0x14, 0xa3, 0xc1, 0x63, 0x50, 0xb5, 0x1d, 0xdc, 0x23, 0x87, 0x7e, 0xbf, 0x7f, 0x48,
-	0xfb, 0xb6, 0x35, 0x92, 0x09, 0x97, 0xcc, 0x7b, 0xea, 0x5a, 0x75, 0x7f, 0x5a, 0xfd, 0x62, 0xdc,
-	0xb8, 0x99, 0xee, 0x45, 0x23, 0x36, 0x40, 0xb3, 0x80, 0xc2, 0x07, 0x23, 0x96, 0xef, 0xd9, 0x7c,
-	0x24, 0x72, 0x23, 0x97, 0x5c, 0xcf, 0xc9, 0x24, 0xbe, 0x9d, 0x95, 0x44, 0x77, 0xda, 0xd4, 0xdc,
-	0x14, 0x41, 0xcc, 0x08, 0xd1, 0x2c, 0x60, 0xf3, 0x1f, 0xcb, 0xa0, 0xb8, 0x27, 0x0a, 0x6f, 0xfa,
-	0x0c, 0xfe, 0x0a, 0x14, 0x45, 0xdf, 0x9e, 0x60, 0x8e, 0x55, 0xb9, 0xbe, 0x97, 0xf0, 0x14, 0xb5,
-	0x5f, 0xfc, 0xc8, 0x84, 0xb5, 0xf0, 0xfd, 0xe1, 0xf1, 0x39, 0xb1, 0x78, 0x87, 0x70, 0x6c, 0x42,
-	0x95, 0x3f, 0x88, 0x65, 0x28, 0x42, 0x85, 0xe7, 0x20, 0xcf, 0x06, 0xc4, 0x52, 0xcd, 0xf1, 0x70,
-	0x9e, 0xe6, 0x08, 0xa3, 0xee, 0x0e, 0x88, 0x65, 0x56, 0x94, 0xd7, 0xbc, 0x38, 0x21, 0xe9, 0x03,
-	0x7a, 0xa0, 0xc0, 0x38, 0xe6, 0x3e, 0x53, 0x55, 0x7b, 0xb4, 0x10, 0x6f, 0x12, 0xd1, 0x5c, 0x57,
-	0xfe, 0x0a, 0xc1, 0x19, 0x29, 0x4f, 0xcd, 0x7f, 0x6a, 0xa0, 0x12, 0x9a, 0x1e, 0xd8, 0x8c, 0xc3,
-	0x4f, 0x52, 0x25, 0x35, 0xae, 0x57, 0x52, 0x71, 0x5b, 0x16, 0x74, 0x43, 0xb9, 0x2a, 0x86, 0x92,
-	0x44, 0x39, 0x6d, 0xb0, 0x62, 0x73, 0xe2, 0x30, 0x7d, 0xf9, 0x56, 0x6e, 0xa7, 0xfc, 0xee, 0xfb,
-	0x8b, 0xc8, 0xd0, 0x5c, 0x53, 0x0e, 0x57, 0xf6, 0x05, 0x34, 0x0a, 0x3c, 0x34, 0xff, 0x9d, 0xc8,
-	0x4c, 0x14, 0x19, 0xe2, 0xa9, 0x91, 0xb2, 0x3b, 0xef, 0x48, 0x11, 0x9e, 0x67, 0xe7, 0x89, 0x9f,
-	0x9e, 0x27, 0x0f, 0x17, 0x32, 0x4f, 0x64, 0x9a, 0x57, 0x0e, 0x93, 0x2f, 0x34, 0xb0, 0x3e, 0xfd,
-	0xbc, 0xe1, 0xd3, 0xa8, 0x97, 0x82, 0x74, 0x7f, 0x78, 0xfd, 0x30, 0x82, 0x3d, 0x66, 0x7c, 0x75,
-	0xe3, 0x40, 0x07, 0x14, 0x2c, 0x39, 0xe4, 0x54, 0x9e, 0x7b, 0xf3, 0xe4, 0x19, 0xcd, 0xfd, 0xd8,
-	0x5d, 0x70, 0x46, 0xca, 0x49, 0xf3, 0x67, 0x60, 0x2d, 0x4a, 0xbd, 0xed, 0xf3, 0x33, 0xf8, 0x00,
-	0xac, 0x70, 0x7a, 0x41, 0x5c, 0x95, 0xdf, 0xdb, 0x57, 0x4c, 0x18, 0x8f, 0xf0, 0x0f, 0xc8, 0xa8,
-	0x4b, 0xfa, 0xc4, 0xe2, 0xd4, 0x33, 0x4b, 0xa2, 0x4d, 0x8e, 0xc4, 0x3d, 0x14, 0x5c, 0x6f, 0xfe,
-	0x65, 0x0d, 0x54, 0x92, 0x65, 0x86, 0xdf, 0x05, 0xab, 0x43, 0xe2, 0x31, 0x9b, 0x06, 0xd0, 0x25,
-	0xb3, 0xaa, 0x42, 0x5a, 0x7d, 0x12, 0x88, 0x51, 0xa8, 0x87, 0x3b, 0xa0, 0xe8, 0x91, 0x41, 0xdf,
-	0xb6, 0x30, 0x93, 0x55, 0x58, 0x31, 0x2b, 0xa2, 0xef, 0x91, 0x92, 0xa1, 0x48, 0x0b, 0x7f, 0xaf,
-	0x81, 0x9a, 0x35, 0x3b, 0xee, 0xd5, 0x6b, 0xde, 0x99, 0xa7, 0x72, 0xa9, 0x1d, 0x62, 0xbe, 0x31,
-	0x19, 0x37, 0xd2, 0xab, 0x05, 0xa5, 0xdd, 0xc3, 0xbf, 0x69, 0xe0, 0x86, 0x47, 0xfa, 0x14, 0x9f,
-	0x10, 0x2f, 0x75, 0x41, 0xcf, 0xbf, 0x8e, 0xe0, 0x6e, 0x4e, 0xc6, 0x8d, 0x1b, 0xe8, 0x2a, 0x9f,
-	0xe8, 0xea, 0x70, 0xe0, 0x5f, 0x35, 0xa0, 0x3b, 0x84, 0x7b, 0xb6, 0xc5, 0xd2, 0xb1, 0xae, 0xbc,
-	0x8e, 0x58, 0xdf, 0x9a, 0x8c, 0x1b, 0x7a, 0xe7, 0x0a, 0x97, 0xe8, 0xca, 0x60, 0xe0, 0xef, 0x34,
-	0x50, 0x1e, 0x88, 0x0e, 0x61, 0x9c, 0xb8, 0x16, 0xd1, 0x0b, 0x32, 0xb8, 0x0f, 0xe7, 0x09, 0xee,
-	0x30, 0x86, 0xeb, 0x72, 0x41, 0x9a, 0x7a, 0x23, 0xb3, 0x3a, 0x19, 0x37, 0xca, 0x09, 0x05, 0x4a,
-	0x3a, 0x85, 0x56, 0x62, 0x8c, 0xaf, 0xca, 0x00, 0x7e, 0xf4, 0xb5, 0x27, 0x40, 0x47, 0x01, 0x04,
-	0x5d, 0x1d, 0x9e, 0x12, 0xd3, 0xfc, 0x0f, 0x1a, 0xa8, 0xb8, 0xf4, 0x84, 0x84, 0xaf, 0x97, 0x5e,
-	0x94, 0x53, 0xfd, 0xe3, 0x45, 0x8d, 0x3c, 0xe3, 0x71, 0x02, 0x7c, 0xcf, 0xe5, 0xde, 0xc8, 0xdc,
-	0x52, 0x2f, 0x63, 0x25, 0xa9, 0x42, 0x53, 0x51, 0xc0, 0x8f, 0x40, 0x99, 0xd3, 0xbe, 0x20, 0x97,
-	0x36, 0x75, 0x99, 0x5e, 0x92, 0x41, 0xd5, 0xb3, 0x06, 0xc4, 0x51, 0x64, 0x66, 0x6e, 0x2a, 0xe0,
-	0x72, 0x2c, 0x63, 0x28, 0x89, 0x03, 0x49, 0x9a, 0xdd, 0x00, 0x59, 0xd9, 0xef, 0x64, 0x41, 0x1f,
-	0xd2, 0x93, 0x57, 0x22, 0x38, 0xd0, 0x05, 0x1b, 0x11, 0xaf, 0x0a, 0x06, 0x18, 0xd3, 0xcb, 0x32,
-	0x85, 0x4c, 0x2a, 0x78, 0x40, 0x2d, 0xdc, 0x0f, 0xa8, 0x0b, 0x22, 0xa7, 0xc4, 0x13, 0x4f, 0xdf,
-	0xd4, 0x55, 0x32, 0x1b, 0xfb, 0x33, 0x48, 0x28, 0x85, 0x0d, 0x7f, 0x02, 0x6a, 0x03, 0xcf, 0xa6,
-	0x32, 0x84, 0x3e, 0x66, 0xec, 0x31, 0x76, 0x88, 0x5e, 0x91, 0x93, 0xef, 0x86, 0x82, 0xa9, 0x1d,
-	0xce, 0x1a, 0xa0, 0xf4, 0x1d, 0x31, 0x0d, 0x43, 0xa1, 0xbe, 0x16, 0x4f, 0xc3, 0xf0, 0x2e, 0x8a,
-	0xb4, 0xf0, 0x01, 0x28, 0xe2, 0xd3, 0x53, 0xdb, 0x15, 0x96, 0xeb, 0xb2, 0x84, 0x6f, 0x65, 0xa5,
-	0xd6, 0x56, 0x36, 0x01, 0x4e, 0x78, 0x42, 0xd1, 0x5d, 0xf8, 0x08, 0x40, 0x46, 0xbc, 0xa1, 0x6d,
-	0x91, 0xb6, 0x65, 0x51, 0xdf, 0xe5, 0x32, 0xf6, 0xaa, 0x8c, 0x7d, 0x5b, 0xc5, 0x0e, 0xbb, 0x29,
-	0x0b, 0x94, 0x71, 0x4b, 0x44, 0xcf, 0x08, 0xe7, 0xb6, 0xdb, 0x63, 0xfa, 0x86, 0x44, 0x90, 0x5e,
-	0xbb, 0x4a, 0x86, 0x22, 0x2d, 0x7c, 0x07, 0x94, 0x18, 0xc7, 0x1e, 0x6f, 0x7b, 0x3d, 0xa6, 0xd7,
-	0x6e, 0xe5, 0x76, 0x4a, 0xc1, 0x6a, 0xee, 0x86, 0x42, 0x14, 0xeb, 0xb7, 0xef, 0x83, 0x5a, 0xaa,
-	0x89, 0xe1, 0x06, 0xc8, 0x5d, 0x90, 0x51, 0xb0, 0x5e, 0x90, 0xf8, 0x09, 0xb7, 0xc0, 0xca, 0x10,
-	0xf7, 0x7d, 0x12, 0x70, 0x72, 0x14, 0x1c, 0xde, 0x5b, 0xbe, 0xa7, 0x35, 0xff, 0xac, 0x81, 0xea,
-	0xcc, 0x67, 0x05, 0xbc, 0x09, 0x72, 0xbe, 0xd7, 0x57, 0xeb, 0xa9, 0xac, 0x12, 0xcd, 0x7d, 0x84,
-	0x0e, 0x90, 0x90, 0xc3, 0x1e, 0xc8, 0x63, 0x9f, 0x9f, 0xa9, 0xc5, 0xbc, 0xbf, 0x90, 0xb7, 0x51,
-	0xec, 0xdc, 0x80, 0xee, 0x88, 0x5f, 0x48, 0x3a, 0x68, 0xfe, 0x4b, 0x03, 0xab, 0x8a, 0x0a, 0x41,
-	0x17, 0x14, 0x5c, 0xcc, 0xed, 0x21, 0x51, 0x0b, 0x79, 0x2e, 0xf2, 0xfa, 0x58, 0x22, 0x45, 0xa3,
-	0x0e, 0x08, 0x42, 0x10, 0xc8, 0x90, 0xf2, 0x02, 0xcf, 0x41, 0x81, 0x5c, 0x52, 0x6e, 0x87, 0xd4,
-	0x7c, 0x51, 0x9f, 0x88, 0xd2, 0xd7, 0x9e, 0x44, 0x46, 0xca, 0x43, 0xf3, 0x4b, 0x0d, 0x80, 0xd8,
-	0xe4, 0x65, 0xe5, 0x7f, 0x07, 0x94, 0xac, 0xbe, 0xcf, 0x38, 0xf1, 0xf6, 0xdf, 0x57, 0xdf, 0x58,
-	0xb2, 0x3f, 0x76, 0x43, 0x21, 0x8a, 0xf5, 0xf0, 0xb6, 0x7a, 0x56, 0x39, 0x69, 0xa7, 0x87, 0x05,
-	0x7e, 0x31, 0x6e, 0x54, 0xc4, 0xdf, 0xb0, 0x04, 0x41, 0xc1, 0xe1, 0x2f, 0x40, 0x05, 0x5b, 0x16,
-	0x61, 0x2c, 0x78, 0x79, 0xd5, 0x8e, 0xbe, 0x26, 0xf7, 0xd9, 0x10, 0x63, 0xb3, 0x9d, 0xb8, 0x8e,
-	0xa6, 0xc0, 0x9a, 0x9f, 0x56, 0xc1, 0xfa, 0x74, 0xe1, 0xe1, 0xed, 0x04, 0xc1, 0xd1, 0xe4, 0x2b,
-	0x1d, 0x91, 0xfb, 0x0c, 0x92, 0x73, 0x3b, 0xd1, 0x77, 0x2f, 0xcf, 0x65, 0x76, 0x4d, 0xe6, 0xbe,
-	0x89, 0x35, 0x99, 0xcd, 0xcb, 0xf2, 0xdf, 0x2c, 0x2f, 0xfb, 0xff, 0xa1, 0x3a, 0x7f, 0x9c, 0x25,
-	0x00, 0x05, 0xb9, 0xa8, 0x3e, 0x59, 0xdc, 0xbb, 0xbf, 0x18, 0x0a, 0xb0, 0xba, 0x20, 0x0a, 0x90,
-	0x64, 0x55, 0xc5, 0xd7, 0xc5, 0xaa, 0x32, 0x78, 0x46, 0xe9, 0x35, 0xf0, 0x8c, 0x26, 0x28, 0x38,
-	0xf8, 0xb2, 0xdd, 0x23, 0x92, 0xc5, 0x94, 0x82, 0xc1, 0xd7, 0x91, 0x12, 0xa4, 0x34, 0xff, 0x73,
-	0x2e, 0x92, 0xbd, 0xd0, 0x2b, 0xaf, 0xb4, 0xd0, 0x33, 0x79, 0xcd, 0xda, 0x9c, 0xbc, 0x66, 0xfd,
-	0xda, 0xbc, 0xa6, 0x3a, 0x07, 0xaf, 0x79, 0x1b, 0xac, 0x3a, 0xf8, 0xb2, 0xc3, 0x14, 0x15, 0xc9,
-	0x9b, 0x65, 0xf1, 0xf9, 0xd9, 0x09, 0x44, 0x28, 0xd4, 0x89, 0xc0, 0x1c, 0x7c, 0x69, 0x8e, 0x38,
-	0x11, 0x3c, 0x24, 0xa2, 0x2c, 0x1d, 0x25, 0x43, 0x91, 0x56, 0x01, 0x76, 0xfd, 0x63, 0xa6, 0xc3,
-	0x29, 0x40, 0x21, 0x42, 0xa1, 0x0e, 0x1a, 0x00, 0x38, 0xf8, 0xf2, 0x10, 0x8f, 0xc4, 0x47, 0x98,
-	0xbe, 0x29, 0x21, 0xd7, 0x27, 0xe3, 0x06, 0xe8, 0x44, 0x52, 0x94, 0xb0, 0x80, 0x07, 0x60, 0xcb,
-	0xc3, 0xa7, 0xfc, 0x21, 0xc1, 0x1e, 0x3f, 0x26, 0x98, 0x1f, 0xd9, 0x0e, 0xa1, 0x3e, 0xd7, 0xb7,
-	0xa2, 0x05, 0xb0, 0x85, 0x32, 0xf4, 0x28, 0xf3, 0x16, 0xdc, 0x07, 0x9b, 0x42, 0xbe, 0x27, 0x5e,
-	0x61, 0x9b, 0xba, 0x21, 0xd8, 0x1b, 0x12, 0xec, 0xcd, 0xc9, 0xb8, 0xb1, 0x89, 0xd2, 0x6a, 0x94,
-	0x75, 0x07, 0xfe, 0x18, 0x6c, 0x08, 0xf1, 0x01, 0xc1, 0x8c, 0x84, 0x38, 0xdf, 0x92, 0x38, 0x5b,
-	0xa2, 0x13, 0xd1, 0x8c, 0x0e, 0xa5, 0xac, 0xe1, 0x2e, 0xa8, 0x09, 0xd9, 0x2e, 0x75, 0x1c, 0x3b,
-	0xca, 0xeb, 0x4d, 0x09, 0x21, 0x07, 0x39, 0x9a, 0x55, 0xa2, 0xb4, 0xfd, 0xfc, 0xe4, 0xef, 0x4f,
-	0xcb, 0x60, 0x33, 0x63, 0xa9, 0x89, 0xfc, 0x18, 0xa7, 0x1e, 0xee, 0x91, 0xb8, 0xb5, 0xb5, 0x38,
-	0xbf, 0xee, 0x8c, 0x0e, 0xa5, 0xac, 0xe1, 0x53, 0x00, 0x82, 0xe5, 0xdf, 0xa1, 0x27, 0xca, 0xb1,
-	0x79, 0x5f, 0x3c, 0xea, 0x76, 0x24, 0x7d, 0x31, 0x6e, 0xdc, 0xc9, 0xfa, 0x07, 0x70, 0x18, 0x0f,
-	0x7f, 0x42, 0xfb, 0xbe, 0x43, 0xe2, 0x0b, 0x28, 0x01, 0x09, 0x7f, 0x09, 0xc0, 0x50, 0xea, 0xbb,
-	0xf6, 0x6f, 0xc2, 0xe5, 0xfe, 0x95, 0xff, 0x49, 0x34, 0xc2, 0xff, 0x55, 0x1b, 0x3f, 0xf5, 0xb1,
-	0xcb, 0xc5, 0xfb, 0x21, 0x7b, 0xef, 0x49, 0x84, 0x82, 0x12, 0x88, 0xa6, 0xf1, 0xec, 0x79, 0x7d,
-	0xe9, 0xb3, 0xe7, 0xf5, 0xa5, 0xcf, 0x9f, 0xd7, 0x97, 0x7e, 0x3b, 0xa9, 0x6b, 0xcf, 0x26, 0x75,
-	0xed, 0xb3, 0x49, 0x5d, 0xfb, 0x7c, 0x52, 0xd7, 0xbe, 0x98, 0xd4, 0xb5, 0x4f, 0xbf, 0xac, 0x2f,
-	0x7d, 0x5c, 0x0c, 0xd7, 0xca, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xc9, 0x53, 0xf9, 0xb2, 0x50,
-	0x1a, 0x00, 0x00,
+	// 2241 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0x4f, 0x6f, 0xdb, 0xc8,
+	0x15, 0x0f, 0x2d, 0xc9, 0x96, 0xc6, 0xb2, 0x2d, 0x8f, 0xbd, 0x5d, 0x26, 0xd8, 0x48, 0x86, 0x8a,
+	0xb4, 0x69, 0x37, 0xa1, 0x9a, 0xa0, 0x7f, 0xb2, 0xd9, 0x43, 0x2a, 0x6a, 0x9d, 0xc6, 0x89, 0x95,
+	0x75, 0x47, 0x4e, 0x8a, 0xfd, 0x83, 0xa6, 0x63, 0x6a, 0x24, 0x33, 0x16, 0x49, 0x95, 0x33, 0x34,
+	0xac, 0x9e, 0x16, 0xfd, 0x04, 0x8b, 0xa2, 0x58, 0xf4, 0xd2, 0x73, 0x81, 0x02, 0xbd, 0xf6, 0xd6,
+	0x7b, 0x0e, 0x3d, 0xec, 0xa1, 0x40, 0xf7, 0x24, 0x34, 0x5a, 0xf4, 0x4b, 0xe4, 0x50, 0x14, 0x33,
+	0x9c, 0x21, 0x69, 0x52, 0xde, 0xc4, 0x91, 0xdd, 0xa0, 0x27, 0x6b, 0xde, 0x9b, 0xf7, 0x7b, 0x33,
+	0x6f, 0xe6, 0xbd, 0xf7, 0x1b, 0x1a, 0xdc, 0xef, 0xdb, 0x6c, 0x3f, 0xd8, 0x33, 0x2c, 0xcf, 0x69,
+	0x60, 0xbf, 0xef, 0x0d, 0x7d, 0xef, 0xa9, 0xf8, 0x71, 0x9d, 0x1c, 0x12, 0x97, 0xd1, 0xc6, 0xf0,
+	0xa0, 0xdf, 0xc0, 0x43, 0x9b, 0x36, 0xc4, 0x78, 0x2f, 0xa0, 0x8d, 0xc3, 0x1b, 0x78, 0x30, 0xdc,
+	0xc7, 0x37, 0x1a, 0x7d, 0xe2, 0x12, 0x1f, 0x33, 0xd2, 0x35, 0x86, 0xbe, 0xc7, 0x3c, 0x78, 0x3b,
+	0xc6, 0x32, 0x14, 0x96, 0xf8, 0xf1, 0x24, 0xc4, 0x32, 0x86, 0x07, 0x7d, 0x83, 0x63, 0x19, 0x0a,
+	0xcb, 0x50, 0x58, 0x97, 0xee, 0xbc, 0xf2, 0x3a, 0x2c, 0xcf, 0x71, 0x3c, 0x37, 0xed, 0xfc, 0xd2,
+	0xf5, 0x04, 0x40, 0xdf, 0xeb, 0x7b, 0x0d, 0x21, 0xde, 0x0b, 0x7a, 0x62, 0x24, 0x06, 0xe2, 0x97,
+	0x9c, 0x5e, 0x3f, 0xb8, 0x45, 0x0d, 0xdb, 0xe3, 0x90, 0x0d, 0xcb, 0xf3, 0x49, 0xe3, 0x30, 0xb3,
+	0x9f, 0x4b, 0x3f, 0x8c, 0xe7, 0x38, 0xd8, 0xda, 0xb7, 0x5d, 0xe2, 0x8f, 0xd4, 0x3a, 0x1a, 0x3e,
+	0xa1, 0x5e, 0xe0, 0x5b, 0xe4, 0x54, 0x56, 0xb4, 0xe1, 0x10, 0x86, 0xa7, 0xf9, 0x6a, 0x9c, 0x64,
+	0xe5, 0x07, 0x2e, 0xb3, 0x9d, 0xac, 0x9b, 0x1f, 0xbf, 0xcc, 0x80, 0x5a, 0xfb, 0xc4, 0xc1, 0x69,
+	0xbb, 0xfa, 0x1f, 0x73, 0xa0, 0x64, 0x06, 0xb4, 0xe5, 0xb9, 0x3d, 0xbb, 0x0f, 0xbb, 0x20, 0xef,
+	0x62, 0x46, 0x75, 0x6d, 0x43, 0xbb, 0xba, 0x78, 0xf3, 0xae, 0xf1, 0xfa, 0x27, 0x68, 0x3c, 0x6c,
+	0xee, 0x76, 0x42, 0x54, 0xb3, 0x38, 0x19, 0xd7, 0xf2, 0x7c, 0x8c, 0x04, 0x3a, 0x3c, 0x02, 0xa5,
+	0xa7, 0x84, 0x51, 0xe6, 0x13, 0xec, 0xe8, 0x73, 0xc2, 0xd5, 0x83, 0x59, 0x5c, 0xdd, 0x27, 0xac,
+	0x23, 0xc0, 0xa4, 0xbf, 0xa5, 0xc9, 0xb8, 0x56, 0x8a, 0x84, 0x28, 0x76, 0x06, 0x09, 0x28, 0x1c,
+	0xe0, 0xde, 0x01, 0xd6, 0x73, 0xc2, 0xeb, 0x07, 0xb3, 0x78, 0x7d, 0xc0, 0x81, 0xcc, 0x80, 0x9a,
+	0xa5, 0xc9, 0xb8, 0x56, 0x10, 0x23, 0x14, 0xa2, 0xc3, 0x2d, 0xb0, 0x68, 0x79, 0xce, 0xd0, 0x27,
+	0x94, 0xda, 0x9e, 0xab, 0xe7, 0x37, 0xb4, 0xab, 0x25, 0xf3, 0xbb, 0xcf, 0xc6, 0xb5, 0x0b, 0x93,
+	0x71, 0x6d, 0xb1, 0x15, 0xab, 0x5e, 0x8c, 0x6b, 0x95, 0xc4, 0xb0, 0xe5, 0x75, 0x89, 0x85, 0x92,
+	0xb6, 0xf5, 0x2f, 0x72, 0xa0, 0x6c, 0x06, 0x74, 0x77, 0x5b, 0x06, 0x13, 0x7e, 0x02, 0xca, 0x16,
+	0x6e, 0x11, 0x9f, 0x75, 0x88, 0xe5, 0x13, 0x26, 0x8f, 0xea, 0x8a, 0x11, 0x9e, 0x3f, 0x5f, 0xac,
+	0xc1, 0x2f, 0xb0, 0x71, 0x78, 0xc3, 0x08, 0x67, 0x3c, 0x20, 0xa3, 0x0e, 0x19, 0x10, 0x8b, 0x79,
+	0xbe, 0x59, 0x99, 0x8c, 0x6b, 0xe5, 0x56, 0x33, 0x36, 0x47, 0xc7, 0xc0, 0xe0, 0x23, 0x00, 0xac,
+	0x18, 0x7a, 0xee, 0x34, 0xd0, 0xcb, 0x93, 0x71, 0x0d, 0x24, 0x80, 0x13, 0x40, 0x10, 0x81, 0xd2,
+	0x01, 0x19, 0x85, 0x03, 0x3d, 0x77, 0x1a, 0x54, 0x71, 0x94, 0x0f, 0x94, 0x2d, 0x8a, 0x61, 0xe0,
+	0x7d, 0x00, 0x6d, 0x97, 0x12, 0x2b, 0xf0, 0x49, 0xe7, 0xc0, 0x1e, 0x3e, 0x26, 0xbe, 0xdd, 0x1b,
+	0x89, 0x50, 0x17, 0xcd, 0x4b, 0x32, 0xd4, 0x70, 0x2b, 0x33, 0x03, 0x4d, 0xb1, 0x82, 0x37, 0x01,
+	0x70, 0x6c, 0xf7, 0x31, 0xf1, 0xc5, 0x71, 0x15, 0xc4, 0x71, 0x41, 0x89, 0x01, 0xda, 0x91, 0x06,
+	0x25, 0x66, 0xd5, 0xff, 0x3a, 0x07, 0x56, 0x5b, 0x9e, 0xcb, 0x30, 0x4f, 0xb5, 0x5d, 0xe2, 0x0c,
+	0x07, 0x98, 0x11, 0xf8, 0x11, 0x28, 0xa9, 0x4a, 0xa0, 0xb2, 0xe8, 0xea, 0xb4, 0x9d, 0x22, 0x39,
+	0x09, 0x91, 0x5f, 0x07, 0xb6, 0x4f, 0x1c, 0x7e, 0xd9, 0xcc, 0x55, 0xe9, 0xb2, 0xa4, 0xb4, 0x14,
+	0xc5, 0x68, 0x70, 0x0f, 0xac, 0xd8, 0x0e, 0xee, 0x93, 0x9d, 0x60, 0x30, 0xd8, 0xf1, 0x06, 0xb6,
+	0x35, 0x12, 0x07, 0x54, 0x32, 0x6f, 0x49, 0xb3, 0x95, 0xad, 0xe3, 0xea, 0x17, 0xe3, 0xda, 0xe5,
+	0x6c, 0x59, 0x33, 0xe2, 0x09, 0x28, 0x0d, 0xc8, 0x7d, 0x88, 0xe0, 0xd8, 0x6c, 0xc4, 0xf7, 0x46,
+	0x8e, 0xd4, 0x71, 0x7d, 0xfb, 0x84, 0xe3, 0x4a, 0x4e, 0x35, 0xd7, 0xf8, 0x22, 0x52, 0x42, 0x94,
+	0x06, 0xac, 0xff, 0x7d, 0x0e, 0x14, 0x37, 0x79, 0x36, 0x99, 0x01, 0x85, 0xbf, 0x02, 0x45, 0x5e,
+	0x02, 0xbb, 0x98, 0x61, 0x19, 0xae, 0x1f, 0x24, 0x3c, 0x45, 0x95, 0x2c, 0xce, 0x43, 0x3e, 0x9b,
+	0xfb, 0xfe, 0x70, 0xef, 0x29, 0xb1, 0x58, 0x9b, 0x30, 0x1c, 0x9f, 0x54, 0x2c, 0x43, 0x11, 0x2a,
+	0x7c, 0x0a, 0xf2, 0x74, 0x48, 0x2c, 0x79, 0x99, 0xef, 0xcd, 0x92, 0xf1, 0x6a, 0xd5, 0x9d, 0x21,
+	0xb1, 0xcc, 0xb2, 0xf4, 0x9a, 0xe7, 0x23, 0x24, 0x7c, 0x40, 0x1f, 0xcc, 0x53, 0x86, 0x59, 0x40,
+	0x65, 0xd4, 0xee, 0x9f, 0x89, 0x37, 0x81, 0x68, 0x2e, 0x4b, 0x7f, 0xf3, 0xe1, 0x18, 0x49, 0x4f,
+	0xf5, 0x7f, 0x6a, 0xa0, 0xac, 0xa6, 0x6e, 0xdb, 0x94, 0xc1, 0x4f, 0x33, 0x21, 0x35, 0x5e, 0x2d,
+	0xa4, 0xdc, 0x5a, 0x04, 0xb4, 0x22, 0x5d, 0x15, 0x95, 0x24, 0x11, 0x4e, 0x1b, 0x14, 0x6c, 0x46,
+	0x1c, 0xaa, 0xcf, 0x6d, 0xe4, 0x66, 0xad, 0xa0, 0x6a, 0xd9, 0xe6, 0x92, 0x74, 0x58, 0xd8, 0xe2,
+	0xd0, 0x28, 0xf4, 0x50, 0xff, 0x4b, 0x3e, 0xde, 0x19, 0x0f, 0x32, 0xc4, 0xc7, 0xba, 0x53, 0x6b,
+	0xd6, 0xee, 0xc4, 0x3d, 0xa7, 0x5b, 0x53, 0x90, 0x6d, 0x4d, 0xf7, 0xce, 0xa4, 0x35, 0x89, 0x6d,
+	0xbe, 0xe9, 0xbe, 0xb4, 0x0b, 0x56, 0x1c, 0xbb, 0xef, 0x63, 0x66, 0x7b, 0xae, 0x2c, 0x21, 0x61,
+	0x6f, 0xfa, 0xbe, 0x2a, 0x21, 0xed, 0xe3, 0xea, 0x17, 0x59, 0x11, 0x4a, 0x43, 0xc0, 0xf7, 0xc1,
+	0x92, 0x25, 0x7a, 0xd3, 0x8e, 0xef, 0xf5, 0xec, 0x01, 0x91, 0x05, 0xf4, 0x2d, 0x89, 0xb9, 0xd4,
+	0x4a, 0x2a, 0xd1, 0xf1, 0xb9, 0xe9, 0x56, 0x39, 0x3f, 0x43, 0xab, 0xfc, 0x7a, 0x0e, 0x2c, 0x1f,
+	0x4f, 0x1a, 0xf8, 0x24, 0x4a, 0xc8, 0xf0, 0xce, 0xfc, 0xe4, 0xd5, 0x03, 0x1b, 0xf2, 0x4a, 0xe3,
+	0x9b, 0xb3, 0x0f, 0x3a, 0x60, 0x3e, 0xdc, 0x8f, 0xbc, 0x2c, 0x9b, 0xb3, 0x9c, 0x5c, 0xc4, 0xc3,
+	0x62, 0x77, 0xe1, 0x18, 0x49, 0x27, 0xf0, 0x33, 0x0d, 0x54, 0x2c, 0xcf, 0xed, 0xda, 0x3c, 0xfc,
+	0xf7, 0x6c, 0xca, 0x3c, 0x7f, 0xa4, 0xe7, 0x44, 0x26, 0xde, 0x3e, 0xf5, 0xd6, 0x5a, 0x0a, 0xc8,
+	0xd4, 0xa5, 0xbb, 0x4a, 0x2b, 0x85, 0x8d, 0x32, 0xde, 0xea, 0xbf, 0x00, 0x4b, 0xd1, 0x15, 0x6e,
+	0x06, 0x6c, 0x1f, 0xde, 0x05, 0x05, 0xe6, 0x1d, 0x10, 0xf7, 0x74, 0x4c, 0x44, 0x5c, 0xce, 0x5d,
+	0x6e, 0x87, 0x42, 0xf3, 0xfa, 0x3f, 0x96, 0x41, 0x39, 0x99, 0x2e, 0xf0, 0x7b, 0x60, 0xe1, 0x50,
+	0xb6, 0x64, 0x4d, 0x5c, 0x8b, 0x15, 0xb9, 0xcc, 0x05, 0xd5, 0x8f, 0x95, 0x1e, 0x5e, 0x05, 0x45,
+	0x9f, 0x0c, 0x07, 0xb6, 0x85, 0xa9, 0x38, 0x88, 0x82, 0x59, 0xe6, 0xf5, 0x0b, 0x49, 0x19, 0x8a,
+	0xb4, 0xf0, 0x77, 0x1a, 0x58, 0xb5, 0xd2, 0x6d, 0x5b, 0xa6, 0x5d, 0x7b, 0x96, 0xc3, 0xcb, 0x70,
+	0x01, 0xf3, 0xad, 0xc9, 0xb8, 0x96, 0xa5, 0x08, 0x28, 0xeb, 0x1e, 0xfe, 0x59, 0x03, 0x17, 0x7d,
+	0x32, 0xf0, 0x70, 0x97, 0xf8, 0x19, 0x03, 0x3d, 0x7f, 0x1e, 0x8b, 0xbb, 0x3c, 0x19, 0xd7, 0x2e,
+	0xa2, 0x93, 0x7c, 0xa2, 0x93, 0x97, 0x03, 0xff, 0xa4, 0x01, 0xdd, 0x21, 0xcc, 0xb7, 0x2d, 0x9a,
+	0x5d, 0x6b, 0xe1, 0x3c, 0xd6, 0xfa, 0xce, 0x64, 0x5c, 0xd3, 0xdb, 0x27, 0xb8, 0x44, 0x27, 0x2e,
+	0x06, 0xfe, 0x56, 0x03, 0x8b, 0x43, 0x7e, 0x43, 0x28, 0x23, 0xae, 0x45, 0x44, 0x71, 0x59, 0xbc,
+	0xf9, 0xe1, 0x2c, 0x8b, 0xdb, 0x89, 0xe1, 0x3a, 0xcc, 0xc7, 0x8c, 0xf4, 0x47, 0xe6, 0x0a, 0xaf,
+	0x54, 0x09, 0x05, 0x4a, 0x3a, 0x85, 0x56, 0xa2, 0x1d, 0x2f, 0x88, 0x05, 0xbc, 0x77, 0xea, 0x4c,
+	0x6d, 0x4b, 0x80, 0xf0, 0x56, 0xab, 0x51, 0xa2, 0x2b, 0xff, 0x5e, 0x03, 0x65, 0xd7, 0xeb, 0x12,
+	0x95, 0x5e, 0x7a, 0x51, 0xd4, 0x84, 0x8f, 0xcf, 0xaa, 0x75, 0x19, 0x0f, 0x13, 0xe0, 0x9b, 0x2e,
+	0xf3, 0x47, 0xe6, 0xba, 0x4c, 0xc6, 0x72, 0x52, 0x85, 0x8e, 0xad, 0x02, 0x3e, 0x02, 0x8b, 0xcc,
+	0x1b, 0x90, 0xb0, 0x5b, 0x50, 0xbd, 0x24, 0x16, 0x55, 0x9d, 0x56, 0x20, 0x76, 0xa3, 0x69, 0xe6,
+	0x9a, 0x2a, 0xfe, 0xb1, 0x8c, 0xa2, 0x24, 0x0e, 0x24, 0x59, 0x96, 0x0a, 0x44, 0x64, 0xbf, 0x33,
+	0x0d, 0x7a, 0xc7, 0xeb, 0xbe, 0x16, 0x51, 0x85, 0x2e, 0xa8, 0x44, 0xfc, 0x38, 0x2c, 0x60, 0x54,
+	0x5f, 0xdc, 0xc8, 0x9d, 0x44, 0xe9, 0xb7, 0x3d, 0x0b, 0x0f, 0x42, 0x0a, 0x8a, 0x48, 0x8f, 0xf8,
+	0xfc, 0xf4, 0xe3, 0xca, 0xba, 0x95, 0x42, 0x42, 0x19, 0x6c, 0xf8, 0x33, 0xb0, 0x3a, 0xf4, 0x6d,
+	0x4f, 0x2c, 0x61, 0x80, 0x29, 0x7d, 0x88, 0x1d, 0xa2, 0x97, 0x45, 0xe5, 0xbb, 0x28, 0x61, 0x56,
+	0x77, 0xd2, 0x13, 0x50, 0xd6, 0x86, 0x57, 0x43, 0x25, 0xd4, 0x97, 0xe2, 0x6a, 0xa8, 0x6c, 0x51,
+	0xa4, 0x85, 0x77, 0x41, 0x11, 0xf7, 0x7a, 0xb6, 0xcb, 0x67, 0x2e, 0x8b, 0x10, 0xbe, 0x33, 0x6d,
+	0x6b, 0x4d, 0x39, 0x27, 0xc4, 0x51, 0x23, 0x14, 0xd9, 0xf2, 0xc7, 0x18, 0x25, 0xfe, 0xa1, 0x6d,
+	0x91, 0xa6, 0x65, 0x79, 0x81, 0xcb, 0xc4, 0xda, 0x57, 0xc4, 0xda, 0xa3, 0xc7, 0x58, 0x27, 0x33,
+	0x03, 0x4d, 0xb1, 0xe2, 0xab, 0xa7, 0x84, 0x31, 0xdb, 0xed, 0x53, 0xbd, 0x22, 0x10, 0x84, 0xd7,
+	0x8e, 0x94, 0xa1, 0x48, 0x0b, 0xdf, 0x05, 0x25, 0xca, 0xb0, 0xcf, 0x9a, 0x7e, 0x9f, 0xea, 0xab,
+	0x1b, 0xb9, 0xab, 0xa5, 0x90, 0x62, 0x75, 0x94, 0x10, 0xc5, 0x7a, 0xf8, 0x53, 0x50, 0x11, 0x83,
+	0x96, 0xe7, 0x38, 0xd8, 0xed, 0x0a, 0x1b, 0x28, 0x6c, 0xd6, 0xf9, 0xf9, 0x74, 0x52, 0x3a, 0x94,
+	0x99, 0x0d, 0x29, 0x58, 0x90, 0xa5, 0x46, 0x5f, 0x13, 0xb1, 0xda, 0x3e, 0x93, 0xf4, 0x92, 0x85,
+	0xcd, 0x5c, 0xe4, 0x9d, 0x4d, 0x0e, 0x90, 0xf2, 0x74, 0xe9, 0x0e, 0x58, 0xcd, 0xe4, 0x1e, 0xac,
+	0x80, 0xdc, 0x01, 0x19, 0x85, 0x5d, 0x11, 0xf1, 0x9f, 0x70, 0x1d, 0x14, 0x0e, 0xf1, 0x20, 0x20,
+	0xe1, 0x93, 0x10, 0x85, 0x83, 0xdb, 0x73, 0xb7, 0xb4, 0xfa, 0x7f, 0x34, 0xb0, 0x92, 0xfa, 0x40,
+	0x02, 0x2f, 0x83, 0x5c, 0xe0, 0x0f, 0x64, 0x57, 0x5d, 0x94, 0xe7, 0x93, 0x7b, 0x84, 0xb6, 0x11,
+	0x97, 0xc3, 0x3e, 0xc8, 0xe3, 0x80, 0xed, 0x4b, 0x4a, 0xb3, 0x75, 0x26, 0xbb, 0xe4, 0x54, 0x21,
+	0x64, 0xdb, 0xfc, 0x17, 0x12, 0x0e, 0xa0, 0x05, 0x72, 0x6c, 0xa0, 0x1e, 0x4b, 0xf7, 0x66, 0xa4,
+	0x4e, 0xd1, 0x27, 0x12, 0x73, 0x81, 0xef, 0x66, 0x77, 0xbb, 0x83, 0x38, 0x7a, 0xfd, 0x3d, 0x50,
+	0x49, 0xc7, 0x1a, 0x5e, 0x01, 0x0b, 0xc4, 0xc5, 0x7b, 0x03, 0xd2, 0x15, 0x41, 0x28, 0x86, 0xc1,
+	0xdf, 0x0c, 0x45, 0x48, 0xe9, 0xea, 0x7f, 0x9b, 0x03, 0x45, 0x45, 0xa7, 0x5f, 0x16, 0xb4, 0x1f,
+	0xf1, 0x5a, 0x37, 0xb4, 0xad, 0x1d, 0x9f, 0xf4, 0xec, 0x23, 0xf9, 0x34, 0x4f, 0xd4, 0xb2, 0x48,
+	0x85, 0x92, 0xf3, 0x92, 0x24, 0x27, 0xf7, 0x12, 0x92, 0xf3, 0x28, 0x8c, 0x56, 0x48, 0x07, 0x4e,
+	0x4f, 0xf7, 0x4e, 0x88, 0x0f, 0xfc, 0x08, 0xe4, 0x29, 0xa6, 0x03, 0xd9, 0xba, 0xdf, 0x3f, 0x3d,
+	0x43, 0x6e, 0x76, 0xb6, 0x93, 0x1f, 0xfa, 0xf8, 0x18, 0x09, 0xc8, 0xfa, 0xbf, 0x35, 0xb0, 0x20,
+	0x5f, 0x5a, 0xd0, 0x05, 0xf3, 0x2e, 0x66, 0xf6, 0x21, 0xd1, 0xb5, 0xd9, 0xdf, 0xc6, 0x0f, 0x05,
+	0x52, 0xd4, 0x81, 0x01, 0xa7, 0xca, 0xa1, 0x0c, 0x49, 0x2f, 0xf0, 0x29, 0x98, 0x27, 0x47, 0x1e,
+	0xb3, 0xd5, 0xcb, 0xff, 0xac, 0x3e, 0x66, 0x0a, 0x5f, 0x9b, 0x02, 0x19, 0x49, 0x0f, 0xf5, 0x67,
+	0x73, 0x00, 0xc4, 0x53, 0x5e, 0x76, 0x53, 0xde, 0x05, 0x25, 0x6b, 0x10, 0x50, 0x46, 0xfc, 0xad,
+	0x0f, 0xe4, 0x3d, 0x11, 0x65, 0xab, 0xa5, 0x84, 0x28, 0xd6, 0xc3, 0x6b, 0x32, 0x17, 0xc3, 0xcb,
+	0xa1, 0xab, 0x04, 0x7a, 0x31, 0xae, 0x95, 0xf9, 0x5f, 0x15, 0x02, 0x99, 0x50, 0x9f, 0x80, 0x32,
+	0xb6, 0x2c, 0x42, 0xa9, 0xfc, 0xd6, 0x96, 0x3f, 0xf5, 0xc7, 0xc1, 0x66, 0xc2, 0x1c, 0x1d, 0x03,
+	0x53, 0xd9, 0x5a, 0x38, 0xd7, 0x6c, 0xfd, 0x7c, 0x05, 0x2c, 0x1f, 0x3f, 0x5d, 0x78, 0x2d, 0x41,
+	0xee, 0x35, 0xd1, 0xce, 0xa2, 0x0f, 0x14, 0x53, 0x08, 0xfe, 0xb5, 0x44, 0xf1, 0x7a, 0x79, 0xc0,
+	0xd2, 0x14, 0x31, 0xf7, 0x26, 0x28, 0xe2, 0xf4, 0x37, 0x49, 0xfe, 0xcd, 0xbe, 0x49, 0xfe, 0x7f,
+	0x68, 0xfe, 0x17, 0x69, 0xf2, 0x3b, 0x2f, 0x48, 0xda, 0xa7, 0x67, 0x57, 0x60, 0xce, 0x86, 0xfe,
+	0x2e, 0x9c, 0x11, 0xfd, 0x4d, 0xbe, 0x28, 0x8a, 0xe7, 0xf5, 0xa2, 0x98, 0xc2, 0xb1, 0x4b, 0xe7,
+	0xc0, 0xb1, 0xeb, 0x60, 0xde, 0xc1, 0x47, 0xcd, 0x3e, 0x11, 0x0c, 0xbe, 0x14, 0x56, 0xd7, 0xb6,
+	0x90, 0x20, 0xa9, 0xf9, 0x9f, 0xf3, 0xf0, 0xe9, 0x64, 0xb6, 0xfc, 0x5a, 0x64, 0x76, 0x2a, 0xa7,
+	0x5f, 0x9a, 0x91, 0xd3, 0x2f, 0xbf, 0x32, 0xa7, 0x5f, 0x99, 0x81, 0xd3, 0x5f, 0x01, 0x0b, 0x0e,
+	0x3e, 0x6a, 0x53, 0x49, 0xc3, 0xf3, 0x92, 0xa0, 0x86, 0x22, 0xa4, 0x74, 0x7c, 0x61, 0x0e, 0x3e,
+	0x32, 0x47, 0x8c, 0x70, 0x0e, 0x1e, 0xd1, 0xf5, 0xb6, 0x94, 0xa1, 0x48, 0x2b, 0x01, 0x3b, 0xc1,
+	0x1e, 0x27, 0xde, 0x49, 0x40, 0x2e, 0x42, 0x4a, 0x07, 0x0d, 0x00, 0x1c, 0x7c, 0xb4, 0x83, 0x47,
+	0xfc, 0x03, 0x84, 0x60, 0xda, 0xa5, 0xf0, 0x9f, 0x4b, 0xed, 0x48, 0x8a, 0x12, 0x33, 0xe0, 0x36,
+	0x58, 0xf7, 0x71, 0x8f, 0xdd, 0x23, 0xd8, 0x67, 0x7b, 0x04, 0xb3, 0x5d, 0xdb, 0x21, 0x5e, 0xc0,
+	0xf4, 0xf5, 0xa8, 0x01, 0xac, 0xa3, 0x29, 0x7a, 0x34, 0xd5, 0x0a, 0x6e, 0x81, 0x35, 0x2e, 0xdf,
+	0xe4, 0x29, 0x6c, 0x7b, 0xae, 0x02, 0x7b, 0x4b, 0x80, 0xbd, 0x3d, 0x19, 0xd7, 0xd6, 0x50, 0x56,
+	0x8d, 0xa6, 0xd9, 0xf0, 0x17, 0x07, 0x17, 0x6f, 0x13, 0x4c, 0x89, 0xc2, 0xf9, 0xd6, 0x86, 0xa6,
+	0x5e, 0x1c, 0x28, 0xa5, 0x43, 0x99, 0xd9, 0xb0, 0x05, 0x56, 0xb9, 0x8c, 0x3f, 0x42, 0xec, 0x68,
+	0x5f, 0x6f, 0x87, 0x5f, 0x57, 0xf9, 0xcd, 0x41, 0x69, 0x25, 0xca, 0xce, 0x9f, 0xfd, 0x05, 0xf1,
+	0x87, 0x39, 0xb0, 0x36, 0xa5, 0xa9, 0x85, 0x2f, 0x2a, 0xcf, 0xc7, 0x7d, 0x12, 0x5f, 0x6d, 0x2d,
+	0xde, 0x5f, 0x27, 0xa5, 0x43, 0x99, 0xd9, 0xf0, 0x09, 0x00, 0x21, 0xc3, 0x68, 0x7b, 0x5d, 0xe9,
+	0xd8, 0xbc, 0xc3, 0x8f, 0xba, 0x19, 0x49, 0x5f, 0x8c, 0x6b, 0xd7, 0xa7, 0xfd, 0x13, 0x4b, 0xad,
+	0x87, 0x3d, 0xf6, 0x06, 0x81, 0x43, 0x62, 0x03, 0x94, 0x80, 0x84, 0xbf, 0x04, 0xe0, 0x50, 0xe8,
+	0x3b, 0xf6, 0x6f, 0x54, 0x73, 0xff, 0xc6, 0xff, 0x86, 0x18, 0xea, 0xff, 0x6d, 0xc6, 0xcf, 0x03,
+	0xec, 0x32, 0x9e, 0x1f, 0xe2, 0xee, 0x3d, 0x8e, 0x50, 0x50, 0x02, 0xd1, 0x34, 0x9e, 0x3d, 0xaf,
+	0x5e, 0xf8, 0xf2, 0x79, 0xf5, 0xc2, 0x57, 0xcf, 0xab, 0x17, 0x3e, 0x9b, 0x54, 0xb5, 0x67, 0x93,
+	0xaa, 0xf6, 0xe5, 0xa4, 0xaa, 0x7d, 0x35, 0xa9, 0x6a, 0xff, 0x9a, 0x54, 0xb5, 0xcf, 0xbf, 0xae,
+	0x5e, 0xf8, 0xb8, 0xa8, 0xda, 0xca, 0x7f, 0x07, 0x00, 0xa0, 0x2c, 0x3a, 0xdd, 0x5f, 0x21, 0x00,
+	0x00,
 }
 
 func (m *BusConfig) Marshal() (dAtA []byte, err error) {
@@ -572,6 +686,23 @@ func (m *BusConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.Compression)
+	copy(dAtA[i:], m.Compression)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Compression)))
+	i--
+	dAtA[i] = 0x22
+	if m.Kafka != nil {
+		{
+			size, err := m.Kafka.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.JetStream != nil {
 		{
 			size, err := m.JetStream.MarshalToSizedBuffer(dAtA[:i])
@@ -599,6 +730,78 @@ func (m *BusConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BusTLSConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BusTLSConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BusTLSConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.MinVersion)
+	copy(dAtA[i:], m.MinVersion)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.MinVersion)))
+	i--
+	dAtA[i] = 0x2a
+	i--
+	if m.InsecureSkipVerify {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x20
+	if m.KeySecret != nil {
+		{
+			size, err := m.KeySecret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CertSecret != nil {
+		{
+			size, err := m.CertSecret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CACertSecret != nil {
+		{
+			size, err := m.CACertSecret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ContainerTemplate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -769,6 +972,33 @@ func (m *EventBusSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.Compression)
+	copy(dAtA[i:], m.Compression)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Compression)))
+	i--
+	dAtA[i] = 0x32
+	i -= len(m.ConfigProfile)
+	copy(dAtA[i:], m.ConfigProfile)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ConfigProfile)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.MigrationPolicy)
+	copy(dAtA[i:], m.MigrationPolicy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.MigrationPolicy)))
+	i--
+	dAtA[i] = 0x22
+	if m.Kafka != nil {
+		{
+			size, err := m.Kafka.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.JetStream != nil {
 		{
 			size, err := m.JetStream.MarshalToSizedBuffer(dAtA[:i])
@@ -816,6 +1046,20 @@ func (m *EventBusStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ConditionHistory) > 0 {
+		for iNdEx := len(m.ConditionHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConditionHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -894,6 +1138,31 @@ func (m *JetStreamBus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Metrics != nil {
+		{
+			size, err := m.Metrics.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.StartCommandArgs) > 0 {
+		for iNdEx := len(m.StartCommandArgs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.StartCommandArgs[iNdEx])
+			copy(dAtA[i:], m.StartCommandArgs[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.StartCommandArgs[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
 	if len(m.StartArgs) > 0 {
 		for iNdEx := len(m.StartArgs) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.StartArgs[iNdEx])
@@ -1098,6 +1367,18 @@ func (m *JetStreamConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TLS != nil {
+		{
+			size, err := m.TLS.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Auth != nil {
 		{
 			size, err := m.Auth.MarshalToSizedBuffer(dAtA[:i])
@@ -1118,7 +1399,7 @@ func (m *JetStreamConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *NATSBus) Marshal() (dAtA []byte, err error) {
+func (m *JetStreamMetrics) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1128,44 +1409,30 @@ func (m *NATSBus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *NATSBus) MarshalTo(dAtA []byte) (int, error) {
+func (m *JetStreamMetrics) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *NATSBus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JetStreamMetrics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Exotic != nil {
-		{
-			size, err := m.Exotic.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
+	if m.Enabled != nil {
 		i--
-		dAtA[i] = 0x12
-	}
-	if m.Native != nil {
-		{
-			size, err := m.Native.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		if *m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *NATSConfig) Marshal() (dAtA []byte, err error) {
+func (m *KafkaBus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1175,19 +1442,19 @@ func (m *NATSConfig) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *NATSConfig) MarshalTo(dAtA []byte) (int, error) {
+func (m *KafkaBus) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *NATSConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *KafkaBus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.AccessSecret != nil {
+	if m.SASL != nil {
 		{
-			size, err := m.AccessSecret.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.SASL.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -1195,22 +1462,30 @@ func (m *NATSConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x22
-	}
-	if m.Auth != nil {
-		i -= len(*m.Auth)
-		copy(dAtA[i:], *m.Auth)
-		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.Auth)))
-		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x2a
 	}
-	if m.ClusterID != nil {
-		i -= len(*m.ClusterID)
-		copy(dAtA[i:], *m.ClusterID)
-		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.ClusterID)))
+	if m.TLS != nil {
+		{
+			size, err := m.TLS.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x22
 	}
+	i -= len(m.Version)
+	copy(dAtA[i:], m.Version)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Version)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.TopicPrefix)
+	copy(dAtA[i:], m.TopicPrefix)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TopicPrefix)))
+	i--
+	dAtA[i] = 0x12
 	i -= len(m.URL)
 	copy(dAtA[i:], m.URL)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
@@ -1219,7 +1494,7 @@ func (m *NATSConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *NativeStrategy) Marshal() (dAtA []byte, err error) {
+func (m *NATSBus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1229,22 +1504,135 @@ func (m *NativeStrategy) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *NativeStrategy) MarshalTo(dAtA []byte) (int, error) {
+func (m *NATSBus) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *NativeStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *NATSBus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.RaftCommitTimeout != nil {
-		i -= len(*m.RaftCommitTimeout)
-		copy(dAtA[i:], *m.RaftCommitTimeout)
-		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.RaftCommitTimeout)))
-		i--
-		dAtA[i] = 0x1
+	if m.Exotic != nil {
+		{
+			size, err := m.Exotic.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Native != nil {
+		{
+			size, err := m.Native.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NATSConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NATSConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NATSConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TLS != nil {
+		{
+			size, err := m.TLS.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.AccessSecret != nil {
+		{
+			size, err := m.AccessSecret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Auth != nil {
+		i -= len(*m.Auth)
+		copy(dAtA[i:], *m.Auth)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.Auth)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ClusterID != nil {
+		i -= len(*m.ClusterID)
+		copy(dAtA[i:], *m.ClusterID)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.ClusterID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	i -= len(m.URL)
+	copy(dAtA[i:], m.URL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *NativeStrategy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NativeStrategy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NativeStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RaftCommitTimeout != nil {
+		i -= len(*m.RaftCommitTimeout)
+		copy(dAtA[i:], *m.RaftCommitTimeout)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.RaftCommitTimeout)))
+		i--
+		dAtA[i] = 0x1
 		i--
 		dAtA[i] = 0xba
 	}
@@ -1540,6 +1928,36 @@ func (m *BusConfig) Size() (n int) {
 		l = m.JetStream.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Kafka != nil {
+		l = m.Kafka.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Compression)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *BusTLSConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CACertSecret != nil {
+		l = m.CACertSecret.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.CertSecret != nil {
+		l = m.CertSecret.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.KeySecret != nil {
+		l = m.KeySecret.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 2
+	l = len(m.MinVersion)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -1606,6 +2024,16 @@ func (m *EventBusSpec) Size() (n int) {
 		l = m.JetStream.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Kafka != nil {
+		l = m.Kafka.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.MigrationPolicy)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ConfigProfile)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Compression)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -1619,6 +2047,12 @@ func (m *EventBusStatus) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = m.Config.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.ConditionHistory) > 0 {
+		for _, e := range m.ConditionHistory {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1711,6 +2145,16 @@ func (m *JetStreamBus) Size() (n int) {
 			n += 2 + l + sovGenerated(uint64(l))
 		}
 	}
+	if len(m.StartCommandArgs) > 0 {
+		for _, s := range m.StartCommandArgs {
+			l = len(s)
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.Metrics != nil {
+		l = m.Metrics.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -1726,6 +2170,45 @@ func (m *JetStreamConfig) Size() (n int) {
 		l = m.Auth.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.TLS != nil {
+		l = m.TLS.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *JetStreamMetrics) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled != nil {
+		n += 2
+	}
+	return n
+}
+
+func (m *KafkaBus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.TopicPrefix)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Version)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.TLS != nil {
+		l = m.TLS.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.SASL != nil {
+		l = m.SASL.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -1766,6 +2249,10 @@ func (m *NATSConfig) Size() (n int) {
 		l = m.AccessSecret.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.TLS != nil {
+		l = m.TLS.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -1902,6 +2389,22 @@ func (this *BusConfig) String() string {
 	s := strings.Join([]string{`&BusConfig{`,
 		`NATS:` + strings.Replace(this.NATS.String(), "NATSConfig", "NATSConfig", 1) + `,`,
 		`JetStream:` + strings.Replace(this.JetStream.String(), "JetStreamConfig", "JetStreamConfig", 1) + `,`,
+		`Kafka:` + strings.Replace(this.Kafka.String(), "KafkaBus", "KafkaBus", 1) + `,`,
+		`Compression:` + fmt.Sprintf("%v", this.Compression) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BusTLSConfig) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BusTLSConfig{`,
+		`CACertSecret:` + strings.Replace(fmt.Sprintf("%v", this.CACertSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`CertSecret:` + strings.Replace(fmt.Sprintf("%v", this.CertSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`KeySecret:` + strings.Replace(fmt.Sprintf("%v", this.KeySecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`InsecureSkipVerify:` + fmt.Sprintf("%v", this.InsecureSkipVerify) + `,`,
+		`MinVersion:` + fmt.Sprintf("%v", this.MinVersion) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1953,6 +2456,10 @@ func (this *EventBusSpec) String() string {
 	s := strings.Join([]string{`&EventBusSpec{`,
 		`NATS:` + strings.Replace(this.NATS.String(), "NATSBus", "NATSBus", 1) + `,`,
 		`JetStream:` + strings.Replace(this.JetStream.String(), "JetStreamBus", "JetStreamBus", 1) + `,`,
+		`Kafka:` + strings.Replace(this.Kafka.String(), "KafkaBus", "KafkaBus", 1) + `,`,
+		`MigrationPolicy:` + fmt.Sprintf("%v", this.MigrationPolicy) + `,`,
+		`ConfigProfile:` + fmt.Sprintf("%v", this.ConfigProfile) + `,`,
+		`Compression:` + fmt.Sprintf("%v", this.Compression) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1961,9 +2468,15 @@ func (this *EventBusStatus) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForConditionHistory := "[]Condition{"
+	for _, f := range this.ConditionHistory {
+		repeatedStringForConditionHistory += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForConditionHistory += "}"
 	s := strings.Join([]string{`&EventBusStatus{`,
 		`Status:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Status), "Status", "common.Status", 1), `&`, ``, 1) + `,`,
 		`Config:` + strings.Replace(strings.Replace(this.Config.String(), "BusConfig", "BusConfig", 1), `&`, ``, 1) + `,`,
+		`ConditionHistory:` + repeatedStringForConditionHistory + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2020,6 +2533,8 @@ func (this *JetStreamBus) String() string {
 		`ServiceAccountName:` + fmt.Sprintf("%v", this.ServiceAccountName) + `,`,
 		`Settings:` + valueToStringGenerated(this.Settings) + `,`,
 		`StartArgs:` + fmt.Sprintf("%v", this.StartArgs) + `,`,
+		`StartCommandArgs:` + fmt.Sprintf("%v", this.StartCommandArgs) + `,`,
+		`Metrics:` + strings.Replace(this.Metrics.String(), "JetStreamMetrics", "JetStreamMetrics", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2031,6 +2546,31 @@ func (this *JetStreamConfig) String() string {
 	s := strings.Join([]string{`&JetStreamConfig{`,
 		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
 		`Auth:` + strings.Replace(this.Auth.String(), "JetStreamAuth", "JetStreamAuth", 1) + `,`,
+		`TLS:` + strings.Replace(this.TLS.String(), "BusTLSConfig", "BusTLSConfig", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JetStreamMetrics) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JetStreamMetrics{`,
+		`Enabled:` + valueToStringGenerated(this.Enabled) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *KafkaBus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&KafkaBus{`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`TopicPrefix:` + fmt.Sprintf("%v", this.TopicPrefix) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`TLS:` + strings.Replace(fmt.Sprintf("%v", this.TLS), "TLSConfig", "common.TLSConfig", 1) + `,`,
+		`SASL:` + strings.Replace(fmt.Sprintf("%v", this.SASL), "SASLConfig", "common.SASLConfig", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2055,6 +2595,7 @@ func (this *NATSConfig) String() string {
 		`ClusterID:` + valueToStringGenerated(this.ClusterID) + `,`,
 		`Auth:` + valueToStringGenerated(this.Auth) + `,`,
 		`AccessSecret:` + strings.Replace(fmt.Sprintf("%v", this.AccessSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`TLS:` + strings.Replace(this.TLS.String(), "BusTLSConfig", "BusTLSConfig", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2232,41 +2773,109 @@ func (m *BusConfig) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kafka", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ContainerTemplate) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			if m.Kafka == nil {
+				m.Kafka = &KafkaBus{}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if err := m.Kafka.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			b := dAtA[iNdEx]
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compression", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Compression = CompressionCodec(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BusTLSConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
 			iNdEx++
 			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
@@ -2276,15 +2885,15 @@ func (m *ContainerTemplate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContainerTemplate: wiretype end group for non-group")
+			return fmt.Errorf("proto: BusTLSConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContainerTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BusTLSConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CACertSecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2311,15 +2920,18 @@ func (m *ContainerTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Resources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.CACertSecret == nil {
+				m.CACertSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.CACertSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ImagePullPolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CertSecret", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -2329,27 +2941,31 @@ func (m *ContainerTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ImagePullPolicy = k8s_io_api_core_v1.PullPolicy(dAtA[iNdEx:postIndex])
+			if m.CertSecret == nil {
+				m.CertSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.CertSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContext", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeySecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2376,13 +2992,65 @@ func (m *ContainerTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SecurityContext == nil {
-				m.SecurityContext = &v1.SecurityContext{}
+			if m.KeySecret == nil {
+				m.KeySecret = &v1.SecretKeySelector{}
 			}
-			if err := m.SecurityContext.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.KeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsecureSkipVerify", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InsecureSkipVerify = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -2404,7 +3072,7 @@ func (m *ContainerTemplate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventBus) Unmarshal(dAtA []byte) error {
+func (m *ContainerTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2427,15 +3095,15 @@ func (m *EventBus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventBus: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContainerTemplate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventBus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContainerTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2462,15 +3130,15 @@ func (m *EventBus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Resources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ImagePullPolicy", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -2480,28 +3148,27 @@ func (m *EventBus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ImagePullPolicy = k8s_io_api_core_v1.PullPolicy(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContext", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2528,7 +3195,10 @@ func (m *EventBus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.SecurityContext == nil {
+				m.SecurityContext = &v1.SecurityContext{}
+			}
+			if err := m.SecurityContext.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2553,7 +3223,7 @@ func (m *EventBus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventBusList) Unmarshal(dAtA []byte) error {
+func (m *EventBus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2576,15 +3246,15 @@ func (m *EventBusList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventBusList: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventBus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventBusList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventBus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2611,13 +3281,13 @@ func (m *EventBusList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2644,8 +3314,40 @@ func (m *EventBusList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, EventBus{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2670,7 +3372,7 @@ func (m *EventBusList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventBusSpec) Unmarshal(dAtA []byte) error {
+func (m *EventBusList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2693,15 +3395,15 @@ func (m *EventBusSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventBusSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventBusList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventBusSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventBusList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NATS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2728,16 +3430,13 @@ func (m *EventBusSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NATS == nil {
-				m.NATS = &NATSBus{}
-			}
-			if err := m.NATS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JetStream", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2764,10 +3463,8 @@ func (m *EventBusSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.JetStream == nil {
-				m.JetStream = &JetStreamBus{}
-			}
-			if err := m.JetStream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, EventBus{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2792,7 +3489,7 @@ func (m *EventBusSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventBusStatus) Unmarshal(dAtA []byte) error {
+func (m *EventBusSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2815,15 +3512,15 @@ func (m *EventBusStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventBusStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventBusSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventBusStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventBusSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NATS", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2850,13 +3547,16 @@ func (m *EventBusStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.NATS == nil {
+				m.NATS = &NATSBus{}
+			}
+			if err := m.NATS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JetStream", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2883,11 +3583,296 @@ func (m *EventBusStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.JetStream == nil {
+				m.JetStream = &JetStreamBus{}
+			}
+			if err := m.JetStream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kafka", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Kafka == nil {
+				m.Kafka = &KafkaBus{}
+			}
+			if err := m.Kafka.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrationPolicy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MigrationPolicy = MigrationPolicy(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigProfile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfigProfile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compression", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Compression = CompressionCodec(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBusStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBusStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBusStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConditionHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConditionHistory = append(m.ConditionHistory, common.Condition{})
+			if err := m.ConditionHistory[len(m.ConditionHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
 			if err != nil {
@@ -3540,9 +4525,260 @@ func (m *JetStreamBus) Unmarshal(dAtA []byte) error {
 			m.Priority = &v
 		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Affinity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Affinity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Affinity == nil {
+				m.Affinity = &v1.Affinity{}
+			}
+			if err := m.Affinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceAccountName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Settings", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Settings = &s
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartArgs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartArgs = append(m.StartArgs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartCommandArgs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartCommandArgs = append(m.StartCommandArgs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metrics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metrics == nil {
+				m.Metrics = &JetStreamMetrics{}
+			}
+			if err := m.Metrics.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JetStreamConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JetStreamConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JetStreamConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3552,33 +4788,29 @@ func (m *JetStreamBus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Affinity == nil {
-				m.Affinity = &v1.Affinity{}
-			}
-			if err := m.Affinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 15:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3588,29 +4820,33 @@ func (m *JetStreamBus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServiceAccountName = string(dAtA[iNdEx:postIndex])
+			if m.Auth == nil {
+				m.Auth = &JetStreamAuth{}
+			}
+			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 16:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Settings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3620,30 +4856,83 @@ func (m *JetStreamBus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Settings = &s
+			if m.TLS == nil {
+				m.TLS = &BusTLSConfig{}
+			}
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 17:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartArgs", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JetStreamMetrics) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JetStreamMetrics: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JetStreamMetrics: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3653,24 +4942,13 @@ func (m *JetStreamBus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.StartArgs = append(m.StartArgs, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
+			b := bool(v != 0)
+			m.Enabled = &b
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -3692,7 +4970,7 @@ func (m *JetStreamBus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JetStreamConfig) Unmarshal(dAtA []byte) error {
+func (m *KafkaBus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3715,10 +4993,10 @@ func (m *JetStreamConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JetStreamConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: KafkaBus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JetStreamConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KafkaBus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3755,7 +5033,71 @@ func (m *JetStreamConfig) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TopicPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3782,10 +5124,46 @@ func (m *JetStreamConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Auth == nil {
-				m.Auth = &JetStreamAuth{}
+			if m.TLS == nil {
+				m.TLS = &common.TLSConfig{}
 			}
-			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SASL", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SASL == nil {
+				m.SASL = &common.SASLConfig{}
+			}
+			if err := m.SASL.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4095,6 +5473,42 @@ func (m *NATSConfig) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TLS == nil {
+				m.TLS = &BusTLSConfig{}
+			}
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -69,7 +69,8 @@ type JetStreamBus struct {
 	// ServiceAccountName to apply to the StatefulSet
 	// +optional
 	ServiceAccountName string `json:"serviceAccountName,omitempty" protobuf:"bytes,15,opt,name=serviceAccountName"`
-	// JetStream configuration, if not specified, global settings in controller-config will be used.
+	// JetStream configuration, merged on top of the global settings in controller-config, the settings
+	// specified here winning on conflicting keys. If not specified, the global settings are used.
 	// See https://docs.nats.io/running-a-nats-service/configuration#jetstream.
 	// Only configure "max_memory_store" or "max_file_store", do not set "store_dir" as it has been hardcoded.
 	// +optional
@@ -78,6 +79,30 @@ type JetStreamBus struct {
 	// Check https://docs.nats.io/ for all the available arguments.
 	// +optional
 	StartArgs []string `json:"startArgs,omitempty" protobuf:"bytes,17,rep,name=startArgs"`
+	// Optional arguments appended to the start command of the JetStream version, e.g. "--jetstream" tuning flags.
+	// The arguments, as well as the start command itself, are Go templates where "{{ .ClusterName }}" and
+	// "{{ .Replicas }}" are replaced with the name of the cluster and its number of replicas.
+	// +optional
+	StartCommandArgs []string `json:"startCommandArgs,omitempty" protobuf:"bytes,18,rep,name=startCommandArgs"`
+	// Metrics configures the metrics exporter sidecar of the JetStream pods
+	// +optional
+	Metrics *JetStreamMetrics `json:"metrics,omitempty" protobuf:"bytes,19,opt,name=metrics"`
+}
+
+// JetStreamMetrics configures the metrics exporter sidecar
+type JetStreamMetrics struct {
+	// Enabled tells whether to run the metrics exporter sidecar, defaults to true.
+	// Disable it if the metrics of NATS are scraped otherwise, e.g. from the monitor port.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty" protobuf:"varint,1,opt,name=enabled"`
+}
+
+// IsMetricsEnabled tells whether the metrics exporter sidecar is enabled
+func (j JetStreamBus) IsMetricsEnabled() bool {
+	if j.Metrics == nil || j.Metrics.Enabled == nil {
+		return true
+	}
+	return *j.Metrics.Enabled
 }
 
 func (j JetStreamBus) GetReplicas() int {
@@ -94,6 +119,9 @@ type JetStreamConfig struct {
 	// JetStream (Nats) URL
 	URL  string         `json:"url,omitempty" protobuf:"bytes,1,opt,name=url"`
 	Auth *JetStreamAuth `json:"auth,omitempty" protobuf:"bytes,2,opt,name=auth"`
+	// TLS configuration of the connections to JetStream
+	// +optional
+	TLS *BusTLSConfig `json:"tls,omitempty" protobuf:"bytes,3,opt,name=tls"`
 }
 
 type JetStreamAuth struct {
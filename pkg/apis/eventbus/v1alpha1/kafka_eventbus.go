@@ -0,0 +1,24 @@
+package v1alpha1
+
+import (
+	"github.com/argoproj/argo-events/pkg/apis/common"
+)
+
+// KafkaBus holds the information of an existing Kafka cluster used as EventBus
+type KafkaBus struct {
+	// URL to the Kafka brokers, multiple URLs separated by comma
+	URL string `json:"url,omitempty" protobuf:"bytes,1,opt,name=url"`
+	// TopicPrefix is prepended to the names of the topics used by the EventBus,
+	// if not specified, global settings in controller-config will be used.
+	// +optional
+	TopicPrefix string `json:"topicPrefix,omitempty" protobuf:"bytes,2,opt,name=topicPrefix"`
+	// Kafka version, sarama defaults to the oldest supported stable version
+	// +optional
+	Version string `json:"version,omitempty" protobuf:"bytes,3,opt,name=version"`
+	// TLS configuration for the Kafka client.
+	// +optional
+	TLS *common.TLSConfig `json:"tls,omitempty" protobuf:"bytes,4,opt,name=tls"`
+	// SASL configuration for the Kafka client
+	// +optional
+	SASL *common.SASLConfig `json:"sasl,omitempty" protobuf:"bytes,5,opt,name=sasl"`
+}
@@ -119,4 +119,7 @@ type NATSConfig struct {
 	// Secret for auth
 	// +optional
 	AccessSecret *corev1.SecretKeySelector `json:"accessSecret,omitempty" protobuf:"bytes,4,opt,name=accessSecret"`
+	// TLS configuration of the connections to NATS
+	// +optional
+	TLS *BusTLSConfig `json:"tls,omitempty" protobuf:"bytes,5,opt,name=tls"`
 }
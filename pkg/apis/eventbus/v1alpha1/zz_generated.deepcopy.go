@@ -40,6 +40,11 @@ func (in *BusConfig) DeepCopyInto(out *BusConfig) {
 		*out = new(JetStreamConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Kafka != nil {
+		in, out := &in.Kafka, &out.Kafka
+		*out = new(KafkaBus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -53,6 +58,37 @@ func (in *BusConfig) DeepCopy() *BusConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BusTLSConfig) DeepCopyInto(out *BusTLSConfig) {
+	*out = *in
+	if in.CACertSecret != nil {
+		in, out := &in.CACertSecret, &out.CACertSecret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CertSecret != nil {
+		in, out := &in.CertSecret, &out.CertSecret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KeySecret != nil {
+		in, out := &in.KeySecret, &out.KeySecret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BusTLSConfig.
+func (in *BusTLSConfig) DeepCopy() *BusTLSConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(BusTLSConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ContainerTemplate) DeepCopyInto(out *ContainerTemplate) {
 	*out = *in
@@ -149,6 +185,11 @@ func (in *EventBusSpec) DeepCopyInto(out *EventBusSpec) {
 		*out = new(JetStreamBus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Kafka != nil {
+		in, out := &in.Kafka, &out.Kafka
+		*out = new(KafkaBus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -167,6 +208,13 @@ func (in *EventBusStatus) DeepCopyInto(out *EventBusStatus) {
 	*out = *in
 	in.Status.DeepCopyInto(&out.Status)
 	in.Config.DeepCopyInto(&out.Config)
+	if in.ConditionHistory != nil {
+		in, out := &in.ConditionHistory, &out.ConditionHistory
+		*out = make([]common.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -278,6 +326,16 @@ func (in *JetStreamBus) DeepCopyInto(out *JetStreamBus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.StartCommandArgs != nil {
+		in, out := &in.StartCommandArgs, &out.StartCommandArgs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = new(JetStreamMetrics)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -299,6 +357,11 @@ func (in *JetStreamConfig) DeepCopyInto(out *JetStreamConfig) {
 		*out = new(JetStreamAuth)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(BusTLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -312,6 +375,53 @@ func (in *JetStreamConfig) DeepCopy() *JetStreamConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JetStreamMetrics) DeepCopyInto(out *JetStreamMetrics) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JetStreamMetrics.
+func (in *JetStreamMetrics) DeepCopy() *JetStreamMetrics {
+	if in == nil {
+		return nil
+	}
+	out := new(JetStreamMetrics)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaBus) DeepCopyInto(out *KafkaBus) {
+	*out = *in
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(common.TLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SASL != nil {
+		in, out := &in.SASL, &out.SASL
+		*out = new(common.SASLConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaBus.
+func (in *KafkaBus) DeepCopy() *KafkaBus {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaBus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NATSBus) DeepCopyInto(out *NATSBus) {
 	*out = *in
@@ -356,6 +466,11 @@ func (in *NATSConfig) DeepCopyInto(out *NATSConfig) {
 		*out = new(v1.SecretKeySelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(BusTLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -254,6 +254,16 @@ type RedisStreamEventData struct {
 	Metadata map[string]string `json:"metadata,omitempty"`
 }
 
+// TCPEventData represents the event data generated by the TCP eventsource.
+type TCPEventData struct {
+	// RemoteAddr is the address of the client the line was received from.
+	RemoteAddr string `json:"remoteAddr"`
+	// Body is the line received. It is kept as is if it is valid JSON, and encoded as a JSON string otherwise.
+	Body *json.RawMessage `json:"body"`
+	// Metadata holds the user defined metadata which will passed along the event payload.
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
 // ResourceEventData represents the event data generated by the Resource eventsource.
 type ResourceEventData struct {
 	// EventType of the type of the event.
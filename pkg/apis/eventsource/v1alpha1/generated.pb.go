@@ -662,10 +662,38 @@ func (m *FileEventSource) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_FileEventSource proto.InternalMessageInfo
 
+func (m *FileTailConfig) Reset()      { *m = FileTailConfig{} }
+func (*FileTailConfig) ProtoMessage() {}
+func (*FileTailConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9ac5d6cd016403b, []int{22}
+}
+func (m *FileTailConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FileTailConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *FileTailConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FileTailConfig.Merge(m, src)
+}
+func (m *FileTailConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *FileTailConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_FileTailConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FileTailConfig proto.InternalMessageInfo
+
 func (m *GenericEventSource) Reset()      { *m = GenericEventSource{} }
 func (*GenericEventSource) ProtoMessage() {}
 func (*GenericEventSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{22}
+	return fileDescriptor_c9ac5d6cd016403b, []int{23}
 }
 func (m *GenericEventSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -693,7 +721,7 @@ var xxx_messageInfo_GenericEventSource proto.InternalMessageInfo
 func (m *GithubAppCreds) Reset()      { *m = GithubAppCreds{} }
 func (*GithubAppCreds) ProtoMessage() {}
 func (*GithubAppCreds) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{23}
+	return fileDescriptor_c9ac5d6cd016403b, []int{24}
 }
 func (m *GithubAppCreds) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -721,7 +749,7 @@ var xxx_messageInfo_GithubAppCreds proto.InternalMessageInfo
 func (m *GithubEventSource) Reset()      { *m = GithubEventSource{} }
 func (*GithubEventSource) ProtoMessage() {}
 func (*GithubEventSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{24}
+	return fileDescriptor_c9ac5d6cd016403b, []int{25}
 }
 func (m *GithubEventSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -749,7 +777,7 @@ var xxx_messageInfo_GithubEventSource proto.InternalMessageInfo
 func (m *GitlabEventSource) Reset()      { *m = GitlabEventSource{} }
 func (*GitlabEventSource) ProtoMessage() {}
 func (*GitlabEventSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{25}
+	return fileDescriptor_c9ac5d6cd016403b, []int{26}
 }
 func (m *GitlabEventSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -777,7 +805,7 @@ var xxx_messageInfo_GitlabEventSource proto.InternalMessageInfo
 func (m *HDFSEventSource) Reset()      { *m = HDFSEventSource{} }
 func (*HDFSEventSource) ProtoMessage() {}
 func (*HDFSEventSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{26}
+	return fileDescriptor_c9ac5d6cd016403b, []int{27}
 }
 func (m *HDFSEventSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -805,7 +833,7 @@ var xxx_messageInfo_HDFSEventSource proto.InternalMessageInfo
 func (m *KafkaConsumerGroup) Reset()      { *m = KafkaConsumerGroup{} }
 func (*KafkaConsumerGroup) ProtoMessage() {}
 func (*KafkaConsumerGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{27}
+	return fileDescriptor_c9ac5d6cd016403b, []int{28}
 }
 func (m *KafkaConsumerGroup) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -833,7 +861,7 @@ var xxx_messageInfo_KafkaConsumerGroup proto.InternalMessageInfo
 func (m *KafkaEventSource) Reset()      { *m = KafkaEventSource{} }
 func (*KafkaEventSource) ProtoMessage() {}
 func (*KafkaEventSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{28}
+	return fileDescriptor_c9ac5d6cd016403b, []int{29}
 }
 func (m *KafkaEventSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -861,7 +889,7 @@ var xxx_messageInfo_KafkaEventSource proto.InternalMessageInfo
 func (m *MQTTEventSource) Reset()      { *m = MQTTEventSource{} }
 func (*MQTTEventSource) ProtoMessage() {}
 func (*MQTTEventSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{29}
+	return fileDescriptor_c9ac5d6cd016403b, []int{30}
 }
 func (m *MQTTEventSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -889,7 +917,7 @@ var xxx_messageInfo_MQTTEventSource proto.InternalMessageInfo
 func (m *NATSAuth) Reset()      { *m = NATSAuth{} }
 func (*NATSAuth) ProtoMessage() {}
 func (*NATSAuth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{30}
+	return fileDescriptor_c9ac5d6cd016403b, []int{31}
 }
 func (m *NATSAuth) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -917,7 +945,7 @@ var xxx_messageInfo_NATSAuth proto.InternalMessageInfo
 func (m *NATSEventsSource) Reset()      { *m = NATSEventsSource{} }
 func (*NATSEventsSource) ProtoMessage() {}
 func (*NATSEventsSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{31}
+	return fileDescriptor_c9ac5d6cd016403b, []int{32}
 }
 func (m *NATSEventsSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -945,7 +973,7 @@ var xxx_messageInfo_NATSEventsSource proto.InternalMessageInfo
 func (m *NSQEventSource) Reset()      { *m = NSQEventSource{} }
 func (*NSQEventSource) ProtoMessage() {}
 func (*NSQEventSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{32}
+	return fileDescriptor_c9ac5d6cd016403b, []int{33}
 }
 func (m *NSQEventSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -973,7 +1001,7 @@ var xxx_messageInfo_NSQEventSource proto.InternalMessageInfo
 func (m *OwnedRepositories) Reset()      { *m = OwnedRepositories{} }
 func (*OwnedRepositories) ProtoMessage() {}
 func (*OwnedRepositories) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{33}
+	return fileDescriptor_c9ac5d6cd016403b, []int{34}
 }
 func (m *OwnedRepositories) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1001,7 +1029,7 @@ var xxx_messageInfo_OwnedRepositories proto.InternalMessageInfo
 func (m *PubSubEventSource) Reset()      { *m = PubSubEventSource{} }
 func (*PubSubEventSource) ProtoMessage() {}
 func (*PubSubEventSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{34}
+	return fileDescriptor_c9ac5d6cd016403b, []int{35}
 }
 func (m *PubSubEventSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1029,7 +1057,7 @@ var xxx_messageInfo_PubSubEventSource proto.InternalMessageInfo
 func (m *PulsarEventSource) Reset()      { *m = PulsarEventSource{} }
 func (*PulsarEventSource) ProtoMessage() {}
 func (*PulsarEventSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{35}
+	return fileDescriptor_c9ac5d6cd016403b, []int{36}
 }
 func (m *PulsarEventSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1057,7 +1085,7 @@ var xxx_messageInfo_PulsarEventSource proto.InternalMessageInfo
 func (m *RedisEventSource) Reset()      { *m = RedisEventSource{} }
 func (*RedisEventSource) ProtoMessage() {}
 func (*RedisEventSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{36}
+	return fileDescriptor_c9ac5d6cd016403b, []int{37}
 }
 func (m *RedisEventSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1085,7 +1113,7 @@ var xxx_messageInfo_RedisEventSource proto.InternalMessageInfo
 func (m *RedisStreamEventSource) Reset()      { *m = RedisStreamEventSource{} }
 func (*RedisStreamEventSource) ProtoMessage() {}
 func (*RedisStreamEventSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{37}
+	return fileDescriptor_c9ac5d6cd016403b, []int{38}
 }
 func (m *RedisStreamEventSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1113,7 +1141,7 @@ var xxx_messageInfo_RedisStreamEventSource proto.InternalMessageInfo
 func (m *ResourceEventSource) Reset()      { *m = ResourceEventSource{} }
 func (*ResourceEventSource) ProtoMessage() {}
 func (*ResourceEventSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{38}
+	return fileDescriptor_c9ac5d6cd016403b, []int{39}
 }
 func (m *ResourceEventSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1141,7 +1169,7 @@ var xxx_messageInfo_ResourceEventSource proto.InternalMessageInfo
 func (m *ResourceFilter) Reset()      { *m = ResourceFilter{} }
 func (*ResourceFilter) ProtoMessage() {}
 func (*ResourceFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{39}
+	return fileDescriptor_c9ac5d6cd016403b, []int{40}
 }
 func (m *ResourceFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1169,7 +1197,7 @@ var xxx_messageInfo_ResourceFilter proto.InternalMessageInfo
 func (m *SNSEventSource) Reset()      { *m = SNSEventSource{} }
 func (*SNSEventSource) ProtoMessage() {}
 func (*SNSEventSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{40}
+	return fileDescriptor_c9ac5d6cd016403b, []int{41}
 }
 func (m *SNSEventSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1197,7 +1225,7 @@ var xxx_messageInfo_SNSEventSource proto.InternalMessageInfo
 func (m *SQSEventSource) Reset()      { *m = SQSEventSource{} }
 func (*SQSEventSource) ProtoMessage() {}
 func (*SQSEventSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{41}
+	return fileDescriptor_c9ac5d6cd016403b, []int{42}
 }
 func (m *SQSEventSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1225,7 +1253,7 @@ var xxx_messageInfo_SQSEventSource proto.InternalMessageInfo
 func (m *Selector) Reset()      { *m = Selector{} }
 func (*Selector) ProtoMessage() {}
 func (*Selector) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{42}
+	return fileDescriptor_c9ac5d6cd016403b, []int{43}
 }
 func (m *Selector) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1253,7 +1281,7 @@ var xxx_messageInfo_Selector proto.InternalMessageInfo
 func (m *Service) Reset()      { *m = Service{} }
 func (*Service) ProtoMessage() {}
 func (*Service) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{43}
+	return fileDescriptor_c9ac5d6cd016403b, []int{44}
 }
 func (m *Service) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1281,7 +1309,7 @@ var xxx_messageInfo_Service proto.InternalMessageInfo
 func (m *SlackEventSource) Reset()      { *m = SlackEventSource{} }
 func (*SlackEventSource) ProtoMessage() {}
 func (*SlackEventSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{44}
+	return fileDescriptor_c9ac5d6cd016403b, []int{45}
 }
 func (m *SlackEventSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1309,7 +1337,7 @@ var xxx_messageInfo_SlackEventSource proto.InternalMessageInfo
 func (m *StorageGridEventSource) Reset()      { *m = StorageGridEventSource{} }
 func (*StorageGridEventSource) ProtoMessage() {}
 func (*StorageGridEventSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{45}
+	return fileDescriptor_c9ac5d6cd016403b, []int{46}
 }
 func (m *StorageGridEventSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1337,7 +1365,7 @@ var xxx_messageInfo_StorageGridEventSource proto.InternalMessageInfo
 func (m *StorageGridFilter) Reset()      { *m = StorageGridFilter{} }
 func (*StorageGridFilter) ProtoMessage() {}
 func (*StorageGridFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{46}
+	return fileDescriptor_c9ac5d6cd016403b, []int{47}
 }
 func (m *StorageGridFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1365,7 +1393,7 @@ var xxx_messageInfo_StorageGridFilter proto.InternalMessageInfo
 func (m *StripeEventSource) Reset()      { *m = StripeEventSource{} }
 func (*StripeEventSource) ProtoMessage() {}
 func (*StripeEventSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{47}
+	return fileDescriptor_c9ac5d6cd016403b, []int{48}
 }
 func (m *StripeEventSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1390,10 +1418,38 @@ func (m *StripeEventSource) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_StripeEventSource proto.InternalMessageInfo
 
+func (m *TCPEventSource) Reset()      { *m = TCPEventSource{} }
+func (*TCPEventSource) ProtoMessage() {}
+func (*TCPEventSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9ac5d6cd016403b, []int{49}
+}
+func (m *TCPEventSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TCPEventSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *TCPEventSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TCPEventSource.Merge(m, src)
+}
+func (m *TCPEventSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *TCPEventSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_TCPEventSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TCPEventSource proto.InternalMessageInfo
+
 func (m *Template) Reset()      { *m = Template{} }
 func (*Template) ProtoMessage() {}
 func (*Template) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{48}
+	return fileDescriptor_c9ac5d6cd016403b, []int{50}
 }
 func (m *Template) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1421,7 +1477,7 @@ var xxx_messageInfo_Template proto.InternalMessageInfo
 func (m *WatchPathConfig) Reset()      { *m = WatchPathConfig{} }
 func (*WatchPathConfig) ProtoMessage() {}
 func (*WatchPathConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{49}
+	return fileDescriptor_c9ac5d6cd016403b, []int{51}
 }
 func (m *WatchPathConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1449,7 +1505,7 @@ var xxx_messageInfo_WatchPathConfig proto.InternalMessageInfo
 func (m *WebhookContext) Reset()      { *m = WebhookContext{} }
 func (*WebhookContext) ProtoMessage() {}
 func (*WebhookContext) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9ac5d6cd016403b, []int{50}
+	return fileDescriptor_c9ac5d6cd016403b, []int{52}
 }
 func (m *WebhookContext) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1474,6 +1530,34 @@ func (m *WebhookContext) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_WebhookContext proto.InternalMessageInfo
 
+func (m *WebhookSignature) Reset()      { *m = WebhookSignature{} }
+func (*WebhookSignature) ProtoMessage() {}
+func (*WebhookSignature) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9ac5d6cd016403b, []int{53}
+}
+func (m *WebhookSignature) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WebhookSignature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *WebhookSignature) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WebhookSignature.Merge(m, src)
+}
+func (m *WebhookSignature) XXX_Size() int {
+	return m.Size()
+}
+func (m *WebhookSignature) XXX_DiscardUnknown() {
+	xxx_messageInfo_WebhookSignature.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WebhookSignature proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*AMQPConsumeConfig)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.AMQPConsumeConfig")
 	proto.RegisterType((*AMQPEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.AMQPEventSource")
@@ -1527,10 +1611,12 @@ func init() {
 	proto.RegisterMapType((map[string]SQSEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EventSourceSpec.SqsEntry")
 	proto.RegisterMapType((map[string]StorageGridEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EventSourceSpec.StorageGridEntry")
 	proto.RegisterMapType((map[string]StripeEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EventSourceSpec.StripeEntry")
+	proto.RegisterMapType((map[string]TCPEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EventSourceSpec.TcpEntry")
 	proto.RegisterMapType((map[string]WebhookContext)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EventSourceSpec.WebhookEntry")
 	proto.RegisterType((*EventSourceStatus)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.EventSourceStatus")
 	proto.RegisterType((*FileEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.FileEventSource")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.FileEventSource.MetadataEntry")
+	proto.RegisterType((*FileTailConfig)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.FileTailConfig")
 	proto.RegisterType((*GenericEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.GenericEventSource")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.GenericEventSource.MetadataEntry")
 	proto.RegisterType((*GithubAppCreds)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.GithubAppCreds")
@@ -1575,11 +1661,14 @@ func init() {
 	proto.RegisterType((*StorageGridFilter)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.StorageGridFilter")
 	proto.RegisterType((*StripeEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.StripeEventSource")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.StripeEventSource.MetadataEntry")
+	proto.RegisterType((*TCPEventSource)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.TCPEventSource")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.TCPEventSource.MetadataEntry")
 	proto.RegisterType((*Template)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.Template")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.Template.NodeSelectorEntry")
 	proto.RegisterType((*WatchPathConfig)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.WatchPathConfig")
 	proto.RegisterType((*WebhookContext)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.WebhookContext")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.WebhookContext.MetadataEntry")
+	proto.RegisterType((*WebhookSignature)(nil), "github.com.argoproj.argo_events.pkg.apis.eventsource.v1alpha1.WebhookSignature")
 }
 
 func init() {
@@ -1587,407 +1676,424 @@ func init() {
 }
 
 var fileDescriptor_c9ac5d6cd016403b = []byte{
-	// 6390 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x5d, 0x6c, 0x24, 0xc7,
-	0x71, 0xb0, 0x86, 0xdc, 0x5d, 0xee, 0x16, 0xff, 0x8e, 0x7d, 0xa7, 0xd3, 0x8a, 0xb6, 0xee, 0x0e,
-	0x2b, 0xf8, 0x70, 0xfa, 0x3e, 0x99, 0x17, 0x29, 0x71, 0x2c, 0x5b, 0xb6, 0x8c, 0xe5, 0xcf, 0x51,
-	0xd4, 0x91, 0x3c, 0xb2, 0x96, 0xa7, 0x1f, 0xcb, 0x96, 0x3c, 0x3b, 0xdb, 0x5c, 0x8e, 0x39, 0x3b,
-	0xb3, 0x9c, 0x99, 0xe5, 0x1d, 0x0f, 0x88, 0x6d, 0x18, 0x48, 0x62, 0x4b, 0xfe, 0x53, 0x12, 0x3b,
-	0x01, 0x02, 0xbf, 0x24, 0x81, 0x81, 0x20, 0x4f, 0x79, 0x71, 0x9e, 0x03, 0x04, 0x89, 0x83, 0xe4,
-	0xc1, 0x79, 0x33, 0x6c, 0xe0, 0x60, 0x5f, 0x80, 0x3c, 0x25, 0x0f, 0x41, 0x9e, 0x12, 0xe4, 0x21,
-	0xe8, 0x9f, 0xe9, 0xe9, 0x99, 0x1d, 0xf2, 0xb8, 0xe4, 0xec, 0x5d, 0x28, 0xe4, 0x6d, 0xb7, 0xaa,
-	0xba, 0xaa, 0x66, 0xba, 0xba, 0xaa, 0xab, 0xbb, 0xab, 0x07, 0xd6, 0xda, 0x76, 0xb8, 0xd3, 0x6b,
-	0xce, 0x59, 0x5e, 0xe7, 0xba, 0xe9, 0xb7, 0xbd, 0xae, 0xef, 0x7d, 0x99, 0xff, 0xf8, 0x38, 0xdd,
-	0xa7, 0x6e, 0x18, 0x5c, 0xef, 0xee, 0xb6, 0xaf, 0x9b, 0x5d, 0x3b, 0xb8, 0x2e, 0xfe, 0x7b, 0x3d,
-	0xdf, 0xa2, 0xd7, 0xf7, 0x5f, 0x30, 0x9d, 0xee, 0x8e, 0xf9, 0xc2, 0xf5, 0x36, 0x75, 0xa9, 0x6f,
-	0x86, 0xb4, 0x35, 0xd7, 0xf5, 0xbd, 0xd0, 0x23, 0x9f, 0x8d, 0xd9, 0xcd, 0x45, 0xec, 0xf8, 0x8f,
-	0x77, 0x45, 0xf3, 0xb9, 0xee, 0x6e, 0x7b, 0x8e, 0xb1, 0x9b, 0xd3, 0xd8, 0xcd, 0x45, 0xec, 0x66,
-	0x3f, 0x77, 0x6c, 0x6d, 0x2c, 0xaf, 0xd3, 0xf1, 0xdc, 0xb4, 0xfc, 0xd9, 0x8f, 0x6b, 0x0c, 0xda,
-	0x5e, 0xdb, 0xbb, 0xce, 0xc1, 0xcd, 0xde, 0x36, 0xff, 0xc7, 0xff, 0xf0, 0x5f, 0x92, 0xbc, 0xb6,
-	0xfb, 0x52, 0x30, 0x67, 0x7b, 0x8c, 0xe5, 0x75, 0xcb, 0xf3, 0xd9, 0x83, 0xf5, 0xb1, 0xfc, 0x8d,
-	0x98, 0xa6, 0x63, 0x5a, 0x3b, 0xb6, 0x4b, 0xfd, 0x83, 0x58, 0x8f, 0x0e, 0x0d, 0xcd, 0xac, 0x56,
-	0xd7, 0x0f, 0x6b, 0xe5, 0xf7, 0xdc, 0xd0, 0xee, 0xd0, 0xbe, 0x06, 0xbf, 0xf9, 0xb0, 0x06, 0x81,
-	0xb5, 0x43, 0x3b, 0x66, 0xba, 0x5d, 0xed, 0x3f, 0x0d, 0x98, 0xa9, 0xaf, 0x6d, 0x6e, 0x2c, 0x78,
-	0x6e, 0xd0, 0xeb, 0xd0, 0x05, 0xcf, 0xdd, 0xb6, 0xdb, 0xe4, 0x13, 0x30, 0x6e, 0x09, 0x80, 0xbf,
-	0x65, 0xb6, 0xab, 0xc6, 0x15, 0xe3, 0x5a, 0x65, 0xfe, 0xfc, 0x4f, 0xee, 0x5f, 0x7e, 0xe2, 0xc1,
-	0xfd, 0xcb, 0xe3, 0x0b, 0x31, 0x0a, 0x75, 0x3a, 0xf2, 0x1c, 0x8c, 0x99, 0xbd, 0xd0, 0xab, 0x5b,
-	0xbb, 0xd5, 0x91, 0x2b, 0xc6, 0xb5, 0xf2, 0xfc, 0xb4, 0x6c, 0x32, 0x56, 0x17, 0x60, 0x8c, 0xf0,
-	0xe4, 0x3a, 0x54, 0xe8, 0x5d, 0xcb, 0xe9, 0x05, 0xf6, 0x3e, 0xad, 0x8e, 0x72, 0xe2, 0x19, 0x49,
-	0x5c, 0x59, 0x8a, 0x10, 0x18, 0xd3, 0x30, 0xde, 0xae, 0xb7, 0xea, 0x59, 0xa6, 0x53, 0x2d, 0x24,
-	0x79, 0xaf, 0x0b, 0x30, 0x46, 0x78, 0x72, 0x15, 0x4a, 0xae, 0xf7, 0x86, 0x69, 0x87, 0xd5, 0x22,
-	0xa7, 0x9c, 0x92, 0x94, 0xa5, 0x75, 0x0e, 0x45, 0x89, 0xad, 0xfd, 0xeb, 0x38, 0x4c, 0xb3, 0x67,
-	0x5f, 0x62, 0xc6, 0xd1, 0xe0, 0xb6, 0x44, 0x9e, 0x81, 0xd1, 0x9e, 0xef, 0xc8, 0x27, 0x1e, 0x97,
-	0x0d, 0x47, 0x6f, 0xe3, 0x2a, 0x32, 0x38, 0x79, 0x09, 0x26, 0xe8, 0x5d, 0x6b, 0xc7, 0x74, 0xdb,
-	0x74, 0xdd, 0xec, 0x50, 0xfe, 0x98, 0x95, 0xf9, 0x0b, 0x92, 0x6e, 0x62, 0x49, 0xc3, 0x61, 0x82,
-	0x52, 0x6f, 0xb9, 0x75, 0xd0, 0x15, 0xcf, 0x9c, 0xd1, 0x92, 0xe1, 0x30, 0x41, 0x49, 0x5e, 0x04,
-	0xf0, 0xbd, 0x5e, 0x68, 0xbb, 0xed, 0x9b, 0xf4, 0x80, 0x3f, 0x7c, 0x65, 0x9e, 0xc8, 0x76, 0x80,
-	0x0a, 0x83, 0x1a, 0x15, 0xf9, 0x2d, 0x98, 0xb1, 0x3c, 0xd7, 0xa5, 0x56, 0x68, 0x7b, 0xee, 0xbc,
-	0x69, 0xed, 0x7a, 0xdb, 0xdb, 0xfc, 0x6d, 0x8c, 0xbf, 0xf8, 0xd2, 0xdc, 0xb1, 0x07, 0x99, 0x18,
-	0x25, 0x73, 0xb2, 0xfd, 0xfc, 0x93, 0x0f, 0xee, 0x5f, 0x9e, 0x59, 0x48, 0xb3, 0xc5, 0x7e, 0x49,
-	0xe4, 0x79, 0x28, 0x7f, 0x39, 0xf0, 0xdc, 0x79, 0xaf, 0x75, 0x50, 0x2d, 0xf1, 0x3e, 0x38, 0x27,
-	0x15, 0x2e, 0xbf, 0xd6, 0xb8, 0xb5, 0xce, 0xe0, 0xa8, 0x28, 0xc8, 0x6d, 0x18, 0x0d, 0x9d, 0xa0,
-	0x3a, 0xc6, 0xd5, 0xfb, 0xf4, 0xc0, 0xea, 0x6d, 0xad, 0x36, 0x84, 0xd9, 0xce, 0x8f, 0xb1, 0xbe,
-	0xda, 0x5a, 0x6d, 0x20, 0xe3, 0x47, 0xde, 0x33, 0xa0, 0xcc, 0xc6, 0x57, 0xcb, 0x0c, 0xcd, 0x6a,
-	0xf9, 0xca, 0xe8, 0xb5, 0xf1, 0x17, 0xbf, 0x30, 0x77, 0x2a, 0x07, 0x33, 0x97, 0xb2, 0x96, 0xb9,
-	0x35, 0xc9, 0x7e, 0xc9, 0x0d, 0xfd, 0x83, 0xf8, 0x19, 0x23, 0x30, 0x2a, 0xf9, 0xe4, 0x0f, 0x0d,
-	0x98, 0x8e, 0x7a, 0x75, 0x91, 0x5a, 0x8e, 0xe9, 0xd3, 0x6a, 0x85, 0x3f, 0xf0, 0x9b, 0x79, 0xe8,
-	0x94, 0xe4, 0x2c, 0x5f, 0xc7, 0xf9, 0x07, 0xf7, 0x2f, 0x4f, 0xa7, 0x50, 0x98, 0xd6, 0x82, 0xbc,
-	0x6f, 0xc0, 0xc4, 0x5e, 0x8f, 0xf6, 0x94, 0x5a, 0xc0, 0xd5, 0xba, 0x9d, 0x83, 0x5a, 0x9b, 0x1a,
-	0x5b, 0xa9, 0xd3, 0x39, 0x66, 0xec, 0x3a, 0x1c, 0x13, 0xc2, 0xc9, 0x57, 0xa1, 0xc2, 0xff, 0xcf,
-	0xdb, 0x6e, 0xab, 0x3a, 0xce, 0x35, 0xc1, 0xbc, 0x34, 0x61, 0x3c, 0xa5, 0x1a, 0x93, 0xcc, 0xcf,
-	0x28, 0x20, 0xc6, 0x32, 0xc9, 0x1d, 0x18, 0x93, 0x2e, 0xad, 0x3a, 0xc1, 0xc5, 0x6f, 0xe4, 0x20,
-	0x3e, 0xe1, 0x5d, 0xe7, 0xc7, 0x99, 0xd7, 0x92, 0x20, 0x8c, 0xa4, 0x91, 0x37, 0xa1, 0x60, 0xf6,
-	0xc2, 0x9d, 0xea, 0xe4, 0x09, 0x87, 0xc1, 0xbc, 0x19, 0xd8, 0x56, 0xbd, 0x17, 0xee, 0xcc, 0x97,
-	0x1f, 0xdc, 0xbf, 0x5c, 0x60, 0xbf, 0x90, 0x73, 0x24, 0x08, 0x95, 0x9e, 0xef, 0x34, 0xa8, 0xe5,
-	0xd3, 0xb0, 0x3a, 0xc5, 0xd9, 0x7f, 0x6c, 0x4e, 0xc4, 0x0b, 0xc6, 0x61, 0x8e, 0x85, 0xae, 0xb9,
-	0xfd, 0x17, 0xe6, 0x04, 0xc5, 0x4d, 0x7a, 0xd0, 0xa0, 0x0e, 0xb5, 0x42, 0xcf, 0x17, 0xaf, 0xe9,
-	0x36, 0xae, 0x0a, 0x0c, 0xc6, 0x6c, 0x48, 0x08, 0xa5, 0x6d, 0xdb, 0x09, 0xa9, 0x5f, 0x9d, 0xce,
-	0xe5, 0x2d, 0x69, 0xa3, 0xea, 0x06, 0xe7, 0x3b, 0x0f, 0xcc, 0x63, 0x8b, 0xdf, 0x28, 0x65, 0xcd,
-	0xbe, 0x0c, 0x93, 0x89, 0x21, 0x47, 0xce, 0xc1, 0xe8, 0x2e, 0x3d, 0x10, 0xee, 0x1a, 0xd9, 0x4f,
-	0x72, 0x01, 0x8a, 0xfb, 0xa6, 0xd3, 0x93, 0xae, 0x19, 0xc5, 0x9f, 0x4f, 0x8f, 0xbc, 0x64, 0xd4,
-	0x7e, 0x6a, 0xc0, 0xd3, 0x87, 0x0e, 0x16, 0x16, 0x5f, 0x5a, 0x3d, 0xdf, 0x6c, 0x3a, 0x94, 0x73,
-	0xd3, 0xe2, 0xcb, 0xa2, 0x00, 0x63, 0x84, 0x67, 0x0e, 0x99, 0x85, 0xb1, 0x45, 0xea, 0xd0, 0x90,
-	0xca, 0x48, 0xa7, 0x1c, 0x72, 0x5d, 0x61, 0x50, 0xa3, 0x62, 0x1e, 0xd1, 0x76, 0x43, 0xea, 0xbb,
-	0xa6, 0x23, 0xc3, 0x9d, 0xf2, 0x16, 0x2b, 0x12, 0x8e, 0x8a, 0x42, 0x8b, 0x60, 0x85, 0x23, 0x23,
-	0xd8, 0x67, 0xe1, 0x7c, 0x86, 0x75, 0x6b, 0xcd, 0x8d, 0x23, 0x9b, 0xff, 0xe9, 0x08, 0x5c, 0xcc,
-	0x1e, 0xa7, 0xe4, 0x0a, 0x14, 0x5c, 0x16, 0xe0, 0x44, 0x20, 0x9c, 0x90, 0x0c, 0x0a, 0x3c, 0xb0,
-	0x71, 0x8c, 0xfe, 0xc2, 0x46, 0x06, 0x7a, 0x61, 0xa3, 0xc7, 0x7a, 0x61, 0x89, 0x09, 0x42, 0xe1,
-	0x18, 0x13, 0x84, 0x63, 0x46, 0x7d, 0xc6, 0xd8, 0xf4, 0xdb, 0xbd, 0x0e, 0x33, 0x42, 0x1e, 0x9c,
-	0x2a, 0x31, 0xe3, 0x7a, 0x84, 0xc0, 0x98, 0xa6, 0xf6, 0x5e, 0x11, 0x9e, 0xae, 0xdf, 0xeb, 0xf9,
-	0x94, 0xdb, 0x68, 0xf0, 0x6a, 0xaf, 0xa9, 0x4f, 0x18, 0xae, 0x40, 0x61, 0x7b, 0xaf, 0xe5, 0xa6,
-	0x5f, 0xd4, 0x8d, 0xcd, 0xc5, 0x75, 0xe4, 0x18, 0xd2, 0x85, 0xf3, 0xc1, 0x8e, 0xe9, 0xd3, 0x56,
-	0xdd, 0xb2, 0x68, 0x10, 0xdc, 0xa4, 0x07, 0x6a, 0xea, 0x70, 0xec, 0x81, 0xf8, 0xd4, 0x83, 0xfb,
-	0x97, 0xcf, 0x37, 0xfa, 0xb9, 0x60, 0x16, 0x6b, 0xd2, 0x82, 0xe9, 0x14, 0x98, 0xbf, 0xf4, 0x63,
-	0x4b, 0xe3, 0x81, 0x23, 0x25, 0x0d, 0xd3, 0x2c, 0x99, 0x01, 0xec, 0xf4, 0x9a, 0xfc, 0x59, 0xc4,
-	0xa4, 0x44, 0x19, 0xc0, 0xab, 0x02, 0x8c, 0x11, 0x9e, 0xfc, 0x81, 0x1e, 0x8a, 0x8b, 0x3c, 0x14,
-	0x6f, 0x9f, 0xd6, 0xad, 0x1e, 0xd6, 0x23, 0x03, 0x04, 0xe5, 0xd8, 0x89, 0x95, 0xce, 0x8a, 0x13,
-	0xfb, 0xb9, 0x01, 0x93, 0xf3, 0x76, 0xd8, 0xec, 0x59, 0xbb, 0x34, 0x64, 0x3e, 0x9e, 0xf8, 0x50,
-	0x6c, 0x32, 0xd7, 0xcf, 0xdb, 0x8f, 0xbf, 0xb8, 0x79, 0xca, 0x67, 0x50, 0xcc, 0xe3, 0x78, 0x52,
-	0x79, 0x70, 0xff, 0x72, 0x91, 0xff, 0x45, 0x21, 0x8a, 0xdc, 0x06, 0xf0, 0x58, 0x68, 0xd9, 0xf2,
-	0x76, 0xa9, 0x3b, 0x98, 0x25, 0x4f, 0xb1, 0x31, 0x7f, 0xab, 0x1e, 0x35, 0x46, 0x8d, 0x51, 0xed,
-	0xc7, 0x06, 0x90, 0x7e, 0xf9, 0xe4, 0x16, 0x94, 0x7b, 0x01, 0x73, 0x8c, 0xd2, 0x1f, 0x1d, 0x5b,
-	0xd6, 0x04, 0xeb, 0xf7, 0xdb, 0xb2, 0x29, 0x2a, 0x26, 0x8c, 0x61, 0xd7, 0x0c, 0x82, 0x3b, 0x9e,
-	0xdf, 0x1a, 0x4c, 0x79, 0xce, 0x70, 0x43, 0x36, 0x45, 0xc5, 0xa4, 0xf6, 0xb7, 0x25, 0xb8, 0xa0,
-	0x14, 0xd7, 0xbd, 0xc3, 0x6b, 0x40, 0x5a, 0xdc, 0x9f, 0xbd, 0xea, 0x79, 0xbb, 0xb7, 0xdc, 0x1b,
-	0xb6, 0x6b, 0x07, 0x3b, 0xd2, 0x2b, 0xcf, 0x4a, 0xcb, 0x24, 0x8b, 0x7d, 0x14, 0x98, 0xd1, 0x8a,
-	0x7c, 0x57, 0x1f, 0x44, 0x23, 0x7c, 0x10, 0x99, 0x79, 0x75, 0xf6, 0x49, 0xc7, 0xcf, 0xd8, 0x1d,
-	0xda, 0xdc, 0xf1, 0xbc, 0x5d, 0xe9, 0x5f, 0xd6, 0x4e, 0xa9, 0xcf, 0x1b, 0x82, 0xdb, 0x82, 0xe7,
-	0x86, 0xf4, 0x6e, 0x28, 0x26, 0x4a, 0x12, 0x86, 0x91, 0x28, 0xf2, 0x65, 0x39, 0x51, 0x2a, 0x70,
-	0x91, 0xab, 0x79, 0xbd, 0x82, 0xcc, 0xa9, 0x53, 0x0d, 0x4a, 0xa2, 0x15, 0xf7, 0x5a, 0x15, 0x31,
-	0x9e, 0x85, 0xd7, 0x41, 0x89, 0x21, 0xcf, 0x42, 0xd1, 0xbb, 0xe3, 0x4a, 0x27, 0x52, 0x99, 0x9f,
-	0x94, 0x2f, 0xac, 0x78, 0x8b, 0x01, 0x51, 0xe0, 0x58, 0x08, 0x64, 0x8a, 0x51, 0x8b, 0xd9, 0x13,
-	0x4f, 0x75, 0xb4, 0x24, 0x6e, 0x43, 0x61, 0x50, 0xa3, 0x22, 0xaf, 0xc0, 0x94, 0x4f, 0xbb, 0x5e,
-	0x60, 0x87, 0x9e, 0x7f, 0xd0, 0x70, 0x7a, 0xed, 0x6a, 0x99, 0xb7, 0xbb, 0x28, 0xdb, 0x4d, 0x61,
-	0x02, 0x8b, 0x29, 0x6a, 0xcd, 0xbd, 0x55, 0xce, 0x8a, 0x7b, 0xfb, 0xef, 0x32, 0xcc, 0xaa, 0x1e,
-	0x69, 0x50, 0x7f, 0x9f, 0xfa, 0xfa, 0x70, 0xd2, 0x0c, 0xce, 0x78, 0x74, 0x06, 0xf7, 0x99, 0x44,
-	0xdf, 0x89, 0x94, 0xff, 0xa3, 0xb2, 0x0f, 0x2e, 0x2c, 0xd2, 0xae, 0x4f, 0x2d, 0x33, 0xa4, 0xad,
-	0x43, 0x7a, 0xf1, 0xd5, 0xbe, 0x5e, 0x14, 0xa9, 0xff, 0x15, 0xc9, 0xa1, 0x1a, 0x73, 0x78, 0x48,
-	0x7f, 0xfe, 0x9e, 0x01, 0x13, 0x0a, 0x64, 0xd3, 0xa0, 0x5a, 0xe0, 0x4e, 0xe0, 0xcd, 0xbc, 0x46,
-	0x80, 0x78, 0xdf, 0xb1, 0x12, 0xf1, 0xea, 0x04, 0x6a, 0x52, 0x31, 0xa1, 0xc3, 0xb1, 0x46, 0xc8,
-	0x9b, 0x30, 0x6e, 0xf2, 0x69, 0x83, 0x88, 0x17, 0xa5, 0x41, 0x5c, 0xee, 0xf4, 0x83, 0xfb, 0x97,
-	0xc7, 0xeb, 0x71, 0x6b, 0xd4, 0x59, 0x91, 0x77, 0x60, 0x52, 0xf6, 0x92, 0x4c, 0x6f, 0xc6, 0x06,
-	0xe1, 0x3d, 0xf3, 0xe0, 0xfe, 0xe5, 0xc9, 0x37, 0xf4, 0xf6, 0x98, 0x64, 0x47, 0x5e, 0x87, 0x8b,
-	0xcd, 0xe8, 0xf5, 0x04, 0xfc, 0xf5, 0xcc, 0x9b, 0x01, 0xbd, 0x8d, 0xab, 0x72, 0x28, 0x5e, 0x92,
-	0x6f, 0xe8, 0x62, 0xea, 0x25, 0x4a, 0x2a, 0x3c, 0xa4, 0xf5, 0x21, 0x71, 0xa1, 0x72, 0xa2, 0xb8,
-	0xf0, 0x7d, 0x3d, 0x2e, 0x00, 0x37, 0x89, 0x76, 0xbe, 0x26, 0x71, 0xda, 0xd9, 0xd5, 0xf8, 0x59,
-	0x71, 0x3f, 0xdf, 0x35, 0xe0, 0xe9, 0x43, 0x87, 0x43, 0xca, 0x87, 0x1b, 0x27, 0xf4, 0xe1, 0x23,
-	0x83, 0xf8, 0xf0, 0xda, 0x9f, 0x15, 0xe1, 0xfc, 0x82, 0xe9, 0x50, 0xb7, 0x65, 0x26, 0x3c, 0xe1,
-	0xf3, 0x50, 0x0e, 0xac, 0x1d, 0xda, 0xea, 0x39, 0x51, 0x8e, 0xa6, 0xba, 0xa2, 0x21, 0xe1, 0xa8,
-	0x28, 0x54, 0xf6, 0xb9, 0x6f, 0x3a, 0x52, 0x7e, 0x32, 0xfb, 0xdc, 0x57, 0xd9, 0xe7, 0xbe, 0xe9,
-	0x90, 0x4f, 0xc3, 0x94, 0x4c, 0xab, 0x3c, 0x77, 0xd1, 0x0c, 0x69, 0x50, 0x1d, 0xe5, 0x43, 0x9b,
-	0x30, 0x7d, 0x97, 0x12, 0x18, 0x4c, 0x51, 0x32, 0x49, 0xa1, 0xdd, 0xa1, 0xf7, 0x3c, 0x37, 0xca,
-	0x0a, 0x94, 0xa4, 0x2d, 0x09, 0x47, 0x45, 0x41, 0xbe, 0xd3, 0x9f, 0x17, 0x7c, 0xe9, 0x94, 0x56,
-	0x92, 0xf1, 0xb2, 0x06, 0xb0, 0xd9, 0xaf, 0x1b, 0x30, 0xde, 0xa5, 0x7e, 0x60, 0x07, 0x21, 0x75,
-	0x2d, 0x2a, 0x5d, 0xd5, 0xad, 0x3c, 0x2c, 0x77, 0x23, 0x66, 0x2b, 0x9c, 0x9a, 0x06, 0x40, 0x5d,
-	0xa8, 0x36, 0x70, 0xca, 0x67, 0x65, 0xe0, 0xdc, 0x85, 0x0b, 0x0b, 0x66, 0x68, 0xed, 0xf4, 0xba,
-	0x62, 0xfd, 0xa0, 0xe7, 0x9b, 0xa1, 0xed, 0xb9, 0x2c, 0x47, 0xa4, 0xae, 0xd9, 0x74, 0x68, 0x2b,
-	0xbd, 0xaa, 0xb2, 0x24, 0xc0, 0x18, 0xe1, 0xc9, 0x27, 0x60, 0xbc, 0x63, 0xde, 0x5d, 0x94, 0x2d,
-	0xa5, 0x99, 0xaa, 0x3d, 0x87, 0xb5, 0x18, 0x85, 0x3a, 0x5d, 0xed, 0x2b, 0x70, 0x41, 0x88, 0x5c,
-	0x33, 0xbb, 0xda, 0x1b, 0x3d, 0xc6, 0x02, 0xc6, 0x22, 0x9c, 0xb3, 0x7c, 0x6a, 0x86, 0x74, 0x65,
-	0x7b, 0xdd, 0x0b, 0x97, 0xee, 0xda, 0x41, 0x28, 0x57, 0x32, 0xaa, 0x92, 0xfa, 0xdc, 0x42, 0x0a,
-	0x8f, 0x7d, 0x2d, 0x6a, 0xdf, 0x1b, 0x03, 0xb2, 0xd4, 0xb1, 0xc3, 0x30, 0x39, 0x53, 0xb9, 0x0a,
-	0xa5, 0xa6, 0xef, 0xed, 0x52, 0x5f, 0x2a, 0xa0, 0x56, 0x23, 0xe6, 0x39, 0x14, 0x25, 0x96, 0xf9,
-	0x14, 0x6b, 0xc7, 0x74, 0x5d, 0xea, 0xc4, 0x73, 0x0b, 0xe5, 0x53, 0x16, 0x14, 0x06, 0x35, 0x2a,
-	0xbe, 0x3b, 0x23, 0xfe, 0xf1, 0xe4, 0x7b, 0x34, 0xb5, 0x3b, 0x13, 0xa3, 0x50, 0xa7, 0x4b, 0xa4,
-	0x51, 0x85, 0xbc, 0xd3, 0xa8, 0x62, 0x0e, 0x69, 0x54, 0xf6, 0xae, 0x45, 0xe9, 0xb1, 0xec, 0x5a,
-	0x8c, 0x1d, 0x77, 0xd7, 0xa2, 0x9c, 0xf3, 0xae, 0xc5, 0xb7, 0x75, 0x97, 0x58, 0xe1, 0x2e, 0xf1,
-	0xdd, 0xd3, 0x8e, 0xff, 0x3e, 0xf3, 0x3c, 0x51, 0x14, 0x87, 0xb3, 0xe2, 0x8c, 0x3e, 0x18, 0x81,
-	0x73, 0x69, 0x97, 0x4b, 0xee, 0xc1, 0x98, 0x25, 0x3c, 0x94, 0x4c, 0x1d, 0x1a, 0xa7, 0x0e, 0x34,
-	0xfd, 0xfe, 0x4e, 0x2e, 0xed, 0x0b, 0x0c, 0x46, 0x02, 0xc9, 0xd7, 0x0c, 0xa8, 0x58, 0x91, 0x93,
-	0x92, 0x2b, 0x0e, 0xa7, 0x16, 0x9f, 0xe1, 0xf4, 0xc4, 0x7a, 0xbd, 0xc2, 0x60, 0x2c, 0xb4, 0xf6,
-	0x8b, 0x11, 0x18, 0xd7, 0xfd, 0xd3, 0x97, 0x34, 0x2b, 0x13, 0xef, 0xe3, 0xd7, 0xb4, 0xb1, 0xab,
-	0xb6, 0x90, 0x63, 0x25, 0x18, 0x35, 0x1b, 0xcd, 0xb7, 0x9a, 0x6c, 0x6a, 0xc3, 0x3a, 0x27, 0xf6,
-	0x53, 0x31, 0x4c, 0x33, 0x9c, 0x2e, 0x14, 0x82, 0x2e, 0xb5, 0xe4, 0xe3, 0xae, 0xe7, 0x67, 0x36,
-	0x8d, 0x2e, 0xb5, 0x62, 0x87, 0xce, 0xfe, 0x21, 0x97, 0x44, 0xee, 0x42, 0x29, 0x08, 0xcd, 0xb0,
-	0x17, 0xc8, 0xd5, 0x88, 0x1c, 0x4d, 0xb5, 0xc1, 0xf9, 0xc6, 0x5e, 0x5c, 0xfc, 0x47, 0x29, 0xaf,
-	0xb6, 0x0c, 0x33, 0x7d, 0x76, 0xcd, 0x5c, 0x3b, 0xbd, 0xdb, 0xf5, 0x69, 0xc0, 0x66, 0x47, 0xe9,
-	0xe9, 0xe2, 0x92, 0xc2, 0xa0, 0x46, 0x55, 0xfb, 0xa5, 0x01, 0xd3, 0x1a, 0xa7, 0x55, 0x3b, 0x08,
-	0xc9, 0x17, 0xfa, 0xba, 0x6a, 0xee, 0x78, 0x5d, 0xc5, 0x5a, 0xf3, 0x8e, 0x52, 0xe3, 0x3b, 0x82,
-	0x68, 0xdd, 0xe4, 0x41, 0xd1, 0x0e, 0x69, 0x27, 0x90, 0x2b, 0x4a, 0xaf, 0xe5, 0xf7, 0xce, 0xe2,
-	0x95, 0x90, 0x15, 0x26, 0x00, 0x85, 0x9c, 0xda, 0x37, 0x3e, 0x9b, 0x78, 0x44, 0xd6, 0x7f, 0x7c,
-	0x73, 0x9c, 0x81, 0xe6, 0x7b, 0xc1, 0x7a, 0x1c, 0xb4, 0xe3, 0xcd, 0x71, 0x0d, 0x87, 0x09, 0x4a,
-	0xb2, 0x07, 0xe5, 0x90, 0x76, 0xba, 0x8e, 0x19, 0x46, 0x2b, 0xea, 0xcb, 0xa7, 0x7c, 0x82, 0x2d,
-	0xc9, 0x4e, 0x44, 0xa9, 0xe8, 0x1f, 0x2a, 0x31, 0xa4, 0x03, 0x63, 0x2c, 0x99, 0xb3, 0x2d, 0x2a,
-	0xed, 0xec, 0xc6, 0x29, 0x25, 0x36, 0x04, 0x37, 0xe1, 0x3c, 0xe4, 0x1f, 0x8c, 0x64, 0x90, 0xaf,
-	0x40, 0xb1, 0x63, 0xbb, 0xb6, 0x27, 0xb3, 0xfd, 0xb7, 0xf2, 0x1d, 0x48, 0x73, 0x6b, 0x8c, 0xb7,
-	0x08, 0x03, 0xaa, 0xbf, 0x38, 0x0c, 0x85, 0x58, 0xbe, 0x8d, 0x6e, 0xc9, 0x49, 0xb5, 0x9c, 0xa3,
-	0x7f, 0x21, 0x67, 0x1d, 0xd4, 0x9c, 0x3d, 0x19, 0x8d, 0x22, 0x30, 0x2a, 0xf9, 0xe4, 0x1e, 0x14,
-	0xb6, 0x6d, 0x87, 0xcd, 0xcb, 0xf3, 0x58, 0xf9, 0x48, 0xeb, 0x71, 0xc3, 0x76, 0xa8, 0xd0, 0x21,
-	0xde, 0xc7, 0xb1, 0x1d, 0x8a, 0x5c, 0x26, 0x7f, 0x11, 0x3e, 0x15, 0x3c, 0xaa, 0x63, 0x43, 0x79,
-	0x11, 0x28, 0xd9, 0xa7, 0x5e, 0x44, 0x04, 0x46, 0x25, 0x9f, 0xfc, 0x8e, 0x11, 0x2f, 0x85, 0x89,
-	0xb3, 0x0d, 0x6f, 0xe7, 0xac, 0x8b, 0x5c, 0x17, 0x11, 0xaa, 0xa8, 0x69, 0x7b, 0xdf, 0xe2, 0xd8,
-	0x3d, 0x28, 0x98, 0x9d, 0xbd, 0xae, 0x9c, 0xaa, 0xe4, 0xdd, 0x23, 0xf5, 0xce, 0x5e, 0x37, 0xd5,
-	0x23, 0xf5, 0xb5, 0xcd, 0x0d, 0xe4, 0x32, 0xd9, 0xd0, 0xd8, 0x35, 0xb7, 0x77, 0xa3, 0x55, 0x8f,
-	0xbc, 0x87, 0xc6, 0x4d, 0xc6, 0x3b, 0x35, 0x34, 0x38, 0x0c, 0x85, 0x58, 0xf6, 0xec, 0x9d, 0xbd,
-	0x30, 0xac, 0x8e, 0x0f, 0xe5, 0xd9, 0xd7, 0xf6, 0xc2, 0x30, 0xf5, 0xec, 0x6b, 0x9b, 0x5b, 0x5b,
-	0xc8, 0x65, 0x32, 0xd9, 0xae, 0x19, 0x06, 0xd5, 0x89, 0xa1, 0xc8, 0x5e, 0x37, 0xc3, 0x20, 0x25,
-	0x7b, 0xbd, 0xbe, 0xd5, 0x40, 0x2e, 0x93, 0xec, 0xc3, 0x68, 0xe0, 0x06, 0xd5, 0x49, 0x2e, 0xfa,
-	0x8d, 0x9c, 0x45, 0x37, 0x5c, 0x29, 0x59, 0x9d, 0xbe, 0x6a, 0xac, 0x37, 0x90, 0x09, 0xe4, 0x72,
-	0xf7, 0x82, 0xea, 0xd4, 0x70, 0xe4, 0xee, 0xf5, 0xc9, 0xdd, 0x64, 0x72, 0xf7, 0x02, 0xf2, 0x75,
-	0x03, 0x4a, 0xdd, 0x5e, 0xb3, 0xd1, 0x6b, 0x56, 0xa7, 0xb9, 0xec, 0xcf, 0xe7, 0x2c, 0x7b, 0x83,
-	0x33, 0x17, 0xe2, 0xd5, 0x1c, 0x43, 0x00, 0x51, 0x4a, 0xe6, 0x4a, 0x08, 0xa9, 0xd5, 0x73, 0x43,
-	0x51, 0x62, 0x99, 0x73, 0x4b, 0x29, 0x21, 0x80, 0x28, 0x25, 0x47, 0x4a, 0x38, 0x66, 0xb3, 0x3a,
-	0x33, 0x2c, 0x25, 0x1c, 0x33, 0x43, 0x09, 0xc7, 0x14, 0x4a, 0x38, 0x66, 0x93, 0x99, 0xfe, 0x4e,
-	0x6b, 0x3b, 0xa8, 0x92, 0xa1, 0x98, 0xfe, 0xab, 0xad, 0xed, 0xb4, 0xe9, 0xbf, 0xba, 0x78, 0xa3,
-	0x81, 0x5c, 0x26, 0x73, 0x39, 0x81, 0x63, 0x5a, 0xbb, 0xd5, 0xf3, 0x43, 0x71, 0x39, 0x0d, 0xc6,
-	0x3b, 0xe5, 0x72, 0x38, 0x0c, 0x85, 0x58, 0xf2, 0x03, 0x03, 0xc6, 0x83, 0xd0, 0xf3, 0xcd, 0x36,
-	0x5d, 0xf6, 0xed, 0x56, 0xf5, 0x42, 0x3e, 0x19, 0x62, 0x5a, 0x8d, 0x58, 0x82, 0x50, 0x46, 0xad,
-	0x2e, 0x68, 0x18, 0xd4, 0x15, 0x21, 0x7f, 0x62, 0xc0, 0x94, 0x99, 0xd8, 0x93, 0xaf, 0x3e, 0xc9,
-	0x75, 0x6b, 0xe6, 0x1d, 0x12, 0x92, 0x1b, 0xff, 0x5c, 0x3d, 0xb5, 0x9a, 0x9a, 0x44, 0x62, 0x4a,
-	0x23, 0x6e, 0xbe, 0x41, 0xe8, 0xdb, 0x5d, 0x5a, 0xbd, 0x38, 0x14, 0xf3, 0x6d, 0x70, 0xe6, 0x29,
-	0xf3, 0x15, 0x40, 0x94, 0x92, 0x79, 0xe8, 0xa6, 0x22, 0x25, 0xaf, 0x3e, 0x35, 0x94, 0xd0, 0x1d,
-	0x25, 0xfc, 0xc9, 0xd0, 0x2d, 0xa1, 0x18, 0x09, 0x67, 0xb6, 0xec, 0xd3, 0x96, 0x1d, 0x54, 0xab,
-	0x43, 0xb1, 0x65, 0x64, 0xbc, 0x53, 0xb6, 0xcc, 0x61, 0x28, 0xc4, 0x32, 0x77, 0xee, 0x06, 0x7b,
-	0xd5, 0xa7, 0x87, 0xe2, 0xce, 0xd7, 0x83, 0xbd, 0x94, 0x3b, 0x5f, 0x6f, 0x6c, 0x22, 0x13, 0x28,
-	0xdd, 0xb9, 0x13, 0x98, 0x7e, 0x75, 0x76, 0x48, 0xee, 0x9c, 0x31, 0xef, 0x73, 0xe7, 0x0c, 0x88,
-	0x52, 0x32, 0xb7, 0x02, 0x7e, 0x18, 0xdb, 0xb6, 0xaa, 0x1f, 0x19, 0x8a, 0x15, 0x2c, 0x0b, 0xee,
-	0x29, 0x2b, 0x90, 0x50, 0x8c, 0x84, 0x93, 0x6b, 0x6c, 0x56, 0xdb, 0x75, 0x6c, 0xcb, 0x0c, 0xaa,
-	0x1f, 0xbd, 0x62, 0x5c, 0x2b, 0x8a, 0xc4, 0x07, 0x25, 0x0c, 0x15, 0x96, 0xfc, 0xc8, 0x80, 0xe9,
-	0xd4, 0x7e, 0x56, 0xf5, 0x19, 0xae, 0xba, 0x95, 0xb3, 0xea, 0xf3, 0x49, 0x29, 0xe2, 0x11, 0x9e,
-	0x92, 0x8f, 0x30, 0x9d, 0xde, 0xa1, 0x49, 0x2b, 0x45, 0xbe, 0x63, 0x40, 0x45, 0xc1, 0xaa, 0x97,
-	0xb8, 0x8a, 0x5f, 0x1c, 0x96, 0x8a, 0x42, 0x39, 0x75, 0x84, 0x4c, 0xc1, 0x31, 0x56, 0x81, 0x7b,
-	0x6d, 0x6e, 0xf3, 0x8d, 0xd0, 0xa7, 0x66, 0xa7, 0x7a, 0x79, 0x28, 0x5e, 0x1b, 0x63, 0x09, 0x29,
-	0xaf, 0xad, 0x61, 0x50, 0x57, 0x64, 0xb6, 0x07, 0x10, 0x27, 0x80, 0x19, 0x8b, 0x6c, 0x9b, 0xfa,
-	0x22, 0xdb, 0xf8, 0x8b, 0x2f, 0x0f, 0xbc, 0xcc, 0xd9, 0xf8, 0xf5, 0xba, 0x1f, 0xda, 0xdb, 0xa6,
-	0x15, 0x6a, 0x2b, 0x74, 0xb3, 0xdf, 0x35, 0x60, 0x32, 0x91, 0xf4, 0x65, 0x88, 0xde, 0x49, 0x8a,
-	0xc6, 0xfc, 0xf7, 0x85, 0x74, 0x8d, 0x7e, 0xd7, 0x80, 0x8a, 0x4a, 0xff, 0x32, 0xb4, 0x69, 0x25,
-	0xb5, 0x39, 0xed, 0x72, 0x16, 0x17, 0x95, 0xad, 0x09, 0x7b, 0x37, 0x89, 0x3c, 0x70, 0xf8, 0xef,
-	0x46, 0x89, 0xcb, 0xd6, 0xe8, 0x9b, 0x06, 0x4c, 0xe8, 0xd9, 0x60, 0x86, 0x42, 0x56, 0x52, 0xa1,
-	0x7c, 0x8f, 0x65, 0xa4, 0xfb, 0x49, 0x25, 0x85, 0xc3, 0xef, 0xa7, 0xd4, 0x81, 0xff, 0xd4, 0x5b,
-	0x81, 0x38, 0x43, 0xcc, 0x50, 0x85, 0x26, 0x55, 0x39, 0xed, 0x26, 0xa2, 0x90, 0x75, 0xb8, 0xf5,
-	0xaa, 0x74, 0x71, 0xf8, 0x6f, 0x85, 0xa5, 0xa1, 0x87, 0x68, 0xf2, 0x0d, 0x03, 0x2a, 0x2a, 0x79,
-	0x1c, 0xfe, 0x4b, 0x61, 0x49, 0xa9, 0x98, 0xde, 0xf5, 0xab, 0xf2, 0xdb, 0x06, 0x94, 0xa3, 0x64,
-	0x72, 0xf8, 0x26, 0xdb, 0x58, 0x6f, 0x1c, 0xf2, 0x4a, 0xb8, 0x1e, 0x7b, 0x8f, 0x4c, 0x8f, 0xcd,
-	0xc3, 0xf4, 0x78, 0xdf, 0x80, 0x71, 0x2d, 0xd1, 0xcc, 0x50, 0x65, 0x3b, 0xa9, 0xca, 0x69, 0xd7,
-	0xcf, 0xa5, 0xb0, 0xc3, 0xb5, 0xd1, 0x32, 0xce, 0xe1, 0x6b, 0x23, 0x85, 0x1d, 0xa9, 0x4d, 0x94,
-	0x7a, 0x3e, 0x12, 0x6d, 0x98, 0xb0, 0xc3, 0x87, 0xb3, 0x4a, 0x43, 0x87, 0x3f, 0x9c, 0x59, 0x7a,
-	0x7b, 0x84, 0x93, 0x8b, 0x73, 0xd2, 0xe1, 0x8f, 0x67, 0x21, 0x2b, 0x5b, 0x97, 0xef, 0x1b, 0x70,
-	0x2e, 0x9d, 0x98, 0x66, 0x68, 0xb4, 0x9b, 0xd4, 0xe8, 0xb4, 0x75, 0x4c, 0xba, 0xc4, 0x6c, 0xbd,
-	0xfe, 0xd8, 0x80, 0xf3, 0x19, 0x49, 0x69, 0x86, 0x6a, 0x6e, 0x52, 0xb5, 0x37, 0x87, 0x75, 0x04,
-	0x3e, 0x6d, 0xd9, 0x5a, 0x56, 0x3a, 0x7c, 0xcb, 0x96, 0xc2, 0xb2, 0xb5, 0xf9, 0xb6, 0x01, 0x13,
-	0x7a, 0x76, 0x9a, 0xa1, 0x4e, 0x3b, 0xa9, 0xce, 0x66, 0xee, 0x9b, 0xdf, 0x69, 0xfb, 0x8e, 0xf3,
-	0xd4, 0xe1, 0xdb, 0xb7, 0x90, 0x75, 0x78, 0x9c, 0x88, 0xb2, 0xd6, 0xe1, 0xc7, 0x89, 0xf5, 0xc6,
-	0xe6, 0x91, 0x71, 0x42, 0x65, 0xb0, 0x8f, 0x22, 0x4e, 0x70, 0x61, 0x87, 0x5b, 0x8c, 0x9e, 0xc9,
-	0x0e, 0xdf, 0x62, 0x22, 0x69, 0xd9, 0xfa, 0xfc, 0xd0, 0xd0, 0x8e, 0xfa, 0x6b, 0xe9, 0x69, 0x86,
-	0x5e, 0x5e, 0x52, 0xaf, 0xb7, 0x86, 0x76, 0x28, 0x53, 0xd7, 0xef, 0x03, 0x03, 0xa6, 0x92, 0xb9,
-	0x69, 0x86, 0x66, 0x76, 0x52, 0xb3, 0xc6, 0x10, 0xca, 0x08, 0xd2, 0x9e, 0x3b, 0x9d, 0x9c, 0x0e,
-	0xdf, 0x73, 0xeb, 0x12, 0x33, 0xf5, 0xaa, 0x85, 0x89, 0x6d, 0x7b, 0xb1, 0xa7, 0x4f, 0xde, 0x55,
-	0xa7, 0x08, 0xc4, 0x66, 0xfb, 0x27, 0x07, 0xcf, 0x79, 0x8f, 0x3e, 0x2c, 0xf0, 0xd7, 0x05, 0x98,
-	0x4e, 0xe5, 0x7f, 0xbc, 0xda, 0x8d, 0xfd, 0xe5, 0xa5, 0xe1, 0x46, 0xb2, 0x28, 0x6d, 0x29, 0x42,
-	0x60, 0x4c, 0x43, 0x3e, 0x30, 0x60, 0xfa, 0x8e, 0x19, 0x5a, 0x3b, 0x1b, 0x66, 0xb8, 0x23, 0x4e,
-	0x7c, 0xe4, 0x34, 0x1b, 0x78, 0x23, 0xc9, 0x35, 0x5e, 0x76, 0x49, 0x21, 0x30, 0x2d, 0x9f, 0x3c,
-	0x07, 0x63, 0x5d, 0xcf, 0x71, 0x6c, 0xb7, 0x2d, 0x6b, 0xfc, 0xd4, 0xa2, 0xd3, 0x86, 0x00, 0x63,
-	0x84, 0x4f, 0xd6, 0x66, 0x17, 0x72, 0xd9, 0x4b, 0x4d, 0xbd, 0xd2, 0x13, 0x1d, 0x71, 0x2a, 0x9e,
-	0x95, 0x23, 0x4e, 0xff, 0x54, 0x00, 0xd2, 0xef, 0xa7, 0x1e, 0x76, 0x7b, 0xc1, 0x55, 0x28, 0x59,
-	0xb1, 0xa9, 0x68, 0x87, 0x12, 0x65, 0x8f, 0x4a, 0xac, 0x38, 0x2e, 0x1c, 0x50, 0xab, 0xe7, 0xd3,
-	0xfe, 0x62, 0x55, 0x01, 0x47, 0x45, 0x91, 0x38, 0x36, 0x57, 0x78, 0xe8, 0xb1, 0xb9, 0x6f, 0xf7,
-	0x1f, 0xf9, 0x7d, 0x37, 0x77, 0x87, 0x3d, 0x40, 0xe7, 0xdf, 0xe6, 0xb5, 0xa9, 0x3b, 0xb2, 0x7c,
-	0xa0, 0x34, 0x70, 0x29, 0x5b, 0x5d, 0x35, 0x46, 0x8d, 0x91, 0x66, 0x53, 0x63, 0x67, 0xc5, 0xa6,
-	0xfe, 0xd1, 0x80, 0x29, 0x91, 0x24, 0xd5, 0xbb, 0xdd, 0x05, 0x9f, 0xb6, 0x02, 0xf6, 0x72, 0xba,
-	0xbe, 0xbd, 0x6f, 0x86, 0x34, 0x3a, 0xf1, 0x3e, 0xd8, 0xcb, 0xd9, 0x50, 0x8d, 0x51, 0x63, 0x44,
-	0x9e, 0x85, 0xa2, 0xd9, 0xed, 0xae, 0x2c, 0x72, 0x1d, 0x46, 0xe3, 0xdd, 0x81, 0x3a, 0x03, 0xa2,
-	0xc0, 0x91, 0x57, 0x60, 0xca, 0x76, 0x83, 0xd0, 0x74, 0x1c, 0x7e, 0xb4, 0x6e, 0x65, 0x91, 0x9b,
-	0xe2, 0x68, 0xbc, 0xd7, 0xb3, 0x92, 0xc0, 0x62, 0x8a, 0xba, 0xf6, 0x37, 0xe3, 0x30, 0xd3, 0x97,
-	0xf3, 0x91, 0x59, 0x18, 0xb1, 0xc5, 0x59, 0xe4, 0xd1, 0x79, 0x90, 0x9c, 0x46, 0x56, 0x16, 0x71,
-	0xc4, 0x6e, 0xe9, 0xd5, 0x45, 0x23, 0x8f, 0xae, 0xba, 0xe8, 0xe3, 0x51, 0xf9, 0x98, 0x38, 0xc7,
-	0xab, 0xdc, 0x6d, 0x5c, 0x16, 0x94, 0x28, 0x24, 0xfb, 0x0c, 0x40, 0x5c, 0x22, 0x20, 0x8f, 0xd8,
-	0x67, 0x14, 0x23, 0xc5, 0x65, 0x05, 0xa8, 0xd1, 0x1f, 0xab, 0x5a, 0xe7, 0x16, 0x94, 0xcd, 0xae,
-	0x7d, 0x82, 0x52, 0x1d, 0xbe, 0x6f, 0x50, 0xdf, 0x58, 0x11, 0x75, 0x3a, 0x8a, 0xc9, 0xd0, 0x8b,
-	0x74, 0x74, 0x77, 0x55, 0x7e, 0xa8, 0xbb, 0xba, 0x0a, 0x25, 0xd3, 0x0a, 0xed, 0x7d, 0x2a, 0xcb,
-	0x6d, 0x94, 0x13, 0xac, 0x73, 0x28, 0x4a, 0xac, 0xbc, 0x03, 0x27, 0x8c, 0x82, 0x32, 0xf4, 0xdd,
-	0x81, 0x13, 0xa1, 0x50, 0xa7, 0x23, 0x2f, 0xc3, 0xa4, 0x30, 0x9a, 0xa8, 0x50, 0x68, 0x9c, 0x37,
-	0x7c, 0x52, 0x36, 0x9c, 0x5c, 0xd6, 0x91, 0x98, 0xa4, 0x25, 0x75, 0x98, 0x16, 0x80, 0xdb, 0x5d,
-	0xc7, 0x33, 0x5b, 0xac, 0xf9, 0x44, 0xd2, 0x2a, 0x96, 0x93, 0x68, 0x4c, 0xd3, 0x1f, 0x52, 0x59,
-	0x34, 0x79, 0xa2, 0xca, 0xa2, 0x6f, 0xe9, 0xbe, 0x5a, 0x9c, 0xba, 0x78, 0x27, 0xef, 0x55, 0x98,
-	0x01, 0x5c, 0xf5, 0x7b, 0xe9, 0xfa, 0x37, 0x71, 0x18, 0xe3, 0xb4, 0xae, 0x95, 0x0d, 0xaf, 0x96,
-	0x5e, 0xe1, 0x76, 0xac, 0xba, 0xb7, 0x4f, 0xc2, 0xa4, 0xe7, 0xb7, 0x4d, 0xd7, 0xbe, 0xc7, 0x1d,
-	0x4e, 0xc0, 0x0f, 0x65, 0x54, 0x84, 0xb5, 0xde, 0xd2, 0x11, 0x98, 0xa4, 0x23, 0xf7, 0xa0, 0xd2,
-	0x8e, 0xbc, 0x6c, 0x75, 0x26, 0x17, 0x3f, 0x93, 0xf4, 0xda, 0xe2, 0x14, 0xb0, 0x82, 0x61, 0x2c,
-	0x4e, 0x8b, 0x4a, 0xe4, 0xac, 0x44, 0xa5, 0x7f, 0x19, 0xe3, 0x6e, 0x3c, 0xb9, 0x58, 0xf6, 0x98,
-	0x0a, 0x41, 0x3f, 0x05, 0x15, 0x59, 0xda, 0x25, 0x63, 0x57, 0x65, 0xfe, 0x23, 0xd2, 0x54, 0xce,
-	0xf7, 0xd5, 0x81, 0xae, 0x2c, 0x62, 0x4c, 0xad, 0x39, 0xde, 0xd1, 0xe3, 0x96, 0x49, 0x16, 0xf2,
-	0x2b, 0x93, 0x6c, 0xc0, 0x93, 0xa2, 0xcc, 0xa6, 0xd1, 0x58, 0x7d, 0x9d, 0xfa, 0xf6, 0xb6, 0x6d,
-	0x89, 0x2a, 0x1b, 0x71, 0x55, 0xc6, 0x33, 0xf2, 0x21, 0x9e, 0x5c, 0xca, 0x22, 0xc2, 0xec, 0xb6,
-	0xd2, 0xd3, 0x39, 0xa6, 0xf2, 0x74, 0xa5, 0x3e, 0x4f, 0x17, 0x23, 0x31, 0x49, 0x7b, 0x88, 0x9b,
-	0x2a, 0x9f, 0xde, 0x4d, 0x55, 0xf2, 0x72, 0x53, 0x49, 0x8b, 0x1b, 0xc0, 0x4d, 0x5d, 0x83, 0xb2,
-	0xec, 0xf7, 0x80, 0x1f, 0x4c, 0xac, 0xc8, 0x7a, 0x17, 0x09, 0x43, 0x85, 0x65, 0x1d, 0x1e, 0xf0,
-	0x9e, 0x14, 0x1d, 0x3e, 0x3e, 0x70, 0x87, 0x37, 0xe2, 0xd6, 0xa8, 0xb3, 0xd2, 0x06, 0xfa, 0xc4,
-	0x59, 0x19, 0xe8, 0x3f, 0xac, 0xc0, 0x74, 0x6a, 0x25, 0x3a, 0x33, 0xcb, 0x35, 0x1e, 0x73, 0x96,
-	0x7b, 0x05, 0x0a, 0x21, 0x9b, 0x10, 0x8c, 0x24, 0x0b, 0xcb, 0xf8, 0x4c, 0x80, 0x63, 0xd8, 0xc0,
-	0xb0, 0x76, 0xa8, 0xb5, 0x1b, 0x95, 0x56, 0xca, 0x99, 0x9d, 0x1a, 0x18, 0x0b, 0x3a, 0x12, 0x93,
-	0xb4, 0xe4, 0xff, 0x43, 0xc5, 0x6c, 0xb5, 0x7c, 0x1a, 0x04, 0xb2, 0xc0, 0xbb, 0x22, 0xfc, 0x79,
-	0x3d, 0x02, 0x62, 0x8c, 0x67, 0x33, 0x9f, 0x9d, 0xd6, 0x76, 0x70, 0x3b, 0x90, 0xb9, 0xab, 0x56,
-	0x6d, 0xc9, 0x5e, 0x25, 0x83, 0xa3, 0xa2, 0x20, 0x2d, 0x98, 0xde, 0xf5, 0x9b, 0x0b, 0x0b, 0xa6,
-	0xb5, 0x43, 0x4f, 0x92, 0xef, 0xf0, 0x6b, 0x61, 0x6e, 0x26, 0x39, 0x60, 0x9a, 0xa5, 0x94, 0x72,
-	0x93, 0x1e, 0x84, 0x66, 0xf3, 0x24, 0xf3, 0xbd, 0x48, 0x8a, 0xce, 0x01, 0xd3, 0x2c, 0xd9, 0xec,
-	0x6c, 0xd7, 0x6f, 0x46, 0x45, 0x69, 0xb2, 0x1a, 0x5b, 0xcd, 0xce, 0x6e, 0xc6, 0x28, 0xd4, 0xe9,
-	0xd8, 0x0b, 0xdb, 0xf5, 0x9b, 0x48, 0x4d, 0xa7, 0xc3, 0xa7, 0x7f, 0xda, 0x0b, 0xbb, 0x29, 0xe1,
-	0xa8, 0x28, 0x48, 0x17, 0x08, 0x7b, 0x3a, 0xde, 0xef, 0xaa, 0xaa, 0x46, 0xd6, 0x41, 0x5d, 0xcb,
-	0x7a, 0x1a, 0x45, 0xa4, 0x3f, 0xd0, 0x45, 0xe6, 0xca, 0x6e, 0xf6, 0xf1, 0xc1, 0x0c, 0xde, 0xe4,
-	0x2d, 0x78, 0x6a, 0xd7, 0x6f, 0xca, 0x1a, 0x80, 0x0d, 0xdf, 0x76, 0x2d, 0xbb, 0x6b, 0x8a, 0x32,
-	0x3f, 0x31, 0x8f, 0xbc, 0x2c, 0xd5, 0x7d, 0xea, 0x66, 0x36, 0x19, 0x1e, 0xd6, 0x3e, 0xb9, 0xe4,
-	0x32, 0x91, 0xcb, 0x92, 0x4b, 0x6a, 0xb8, 0x9e, 0x68, 0xc9, 0x65, 0xf2, 0xac, 0xf8, 0xa7, 0x1f,
-	0x1b, 0x40, 0xf8, 0x1e, 0x7c, 0x74, 0xfd, 0xe5, 0xb2, 0xef, 0xf5, 0xba, 0xe4, 0x3a, 0x54, 0xda,
-	0xec, 0x87, 0x56, 0xb7, 0xa2, 0x56, 0xee, 0x96, 0x23, 0x04, 0xc6, 0x34, 0x2c, 0xff, 0xf0, 0x9c,
-	0x16, 0x55, 0xc5, 0xa6, 0x2a, 0xff, 0xb8, 0xc5, 0xa1, 0x28, 0xb1, 0x64, 0x19, 0x66, 0x7c, 0xda,
-	0x34, 0x1d, 0xd3, 0xb5, 0x68, 0x23, 0xf4, 0xcd, 0x90, 0xb6, 0x0f, 0xa4, 0x27, 0x79, 0x5a, 0x36,
-	0x99, 0xc1, 0x34, 0x01, 0xf6, 0xb7, 0xa9, 0xfd, 0x65, 0x19, 0xce, 0xa5, 0x0f, 0x0f, 0x3c, 0x6c,
-	0xa5, 0xe8, 0x3a, 0x54, 0xba, 0xa6, 0x1f, 0xda, 0x5a, 0x29, 0xae, 0x7a, 0xaa, 0x8d, 0x08, 0x81,
-	0x31, 0x0d, 0x4b, 0xe9, 0x43, 0xaf, 0x6b, 0x5b, 0x52, 0x43, 0x95, 0xd2, 0x6f, 0x31, 0x20, 0x0a,
-	0x5c, 0x76, 0x7d, 0x67, 0xe1, 0x91, 0xd5, 0x77, 0xca, 0x8a, 0xcd, 0x62, 0xce, 0x15, 0x9b, 0x83,
-	0x5d, 0x76, 0xf9, 0xbe, 0x3e, 0x0c, 0xc7, 0x72, 0x39, 0x9a, 0x96, 0xee, 0xdc, 0xc1, 0x52, 0xaa,
-	0x49, 0x4b, 0xb7, 0x67, 0x59, 0xcf, 0xba, 0x99, 0x87, 0x4a, 0x89, 0x81, 0x22, 0x32, 0xa3, 0x04,
-	0x08, 0x93, 0xa2, 0xc9, 0x06, 0x5c, 0x70, 0xec, 0x8e, 0x2d, 0x76, 0x11, 0x82, 0x0d, 0xea, 0x37,
-	0xa8, 0xe5, 0xb9, 0x2d, 0xee, 0xa8, 0x47, 0xe3, 0x45, 0x8e, 0xd5, 0x0c, 0x1a, 0xcc, 0x6c, 0x49,
-	0x9e, 0x83, 0xb1, 0x7d, 0xea, 0xf3, 0xfa, 0x3b, 0x48, 0x5e, 0x51, 0xf6, 0xba, 0x00, 0x63, 0x84,
-	0x27, 0x6f, 0x41, 0x21, 0x30, 0x03, 0x47, 0x4e, 0xc2, 0x4e, 0x70, 0xd0, 0xad, 0xde, 0x58, 0x95,
-	0xe6, 0xc1, 0x2f, 0x11, 0x62, 0xff, 0x91, 0xb3, 0x3c, 0x8b, 0x93, 0xb1, 0xbf, 0x2b, 0xc2, 0x74,
-	0xea, 0x94, 0xcf, 0xc3, 0x5c, 0x86, 0xf2, 0x00, 0x23, 0x47, 0x78, 0x80, 0xe7, 0xa1, 0x6c, 0x39,
-	0x36, 0x75, 0xc3, 0x95, 0x96, 0xf4, 0x14, 0x71, 0xb5, 0x97, 0x80, 0x2f, 0xa2, 0xa2, 0x78, 0xdc,
-	0xfe, 0x42, 0x1f, 0xd8, 0xc5, 0xe3, 0xd6, 0x83, 0x97, 0x86, 0x79, 0x8b, 0x6d, 0x3e, 0x55, 0x67,
-	0xa9, 0x8e, 0x3d, 0x51, 0xd8, 0x3e, 0x33, 0x37, 0x53, 0xfc, 0xc3, 0x08, 0x94, 0xd7, 0xeb, 0x5b,
-	0x0d, 0x7e, 0x93, 0xdc, 0xdb, 0xc9, 0xbb, 0xf2, 0x4e, 0x73, 0xc9, 0x6a, 0xff, 0xa5, 0x78, 0x37,
-	0xd8, 0x00, 0x18, 0xf8, 0x3e, 0xbc, 0x8a, 0x18, 0x23, 0x2c, 0x83, 0x13, 0xcd, 0xc9, 0x02, 0x14,
-	0xdc, 0xdd, 0x41, 0xaf, 0x6c, 0xe4, 0x3e, 0x67, 0xfd, 0x26, 0x3d, 0x40, 0xde, 0x98, 0xdc, 0x06,
-	0xb0, 0x7c, 0xda, 0xa2, 0x6e, 0x68, 0xcb, 0x1b, 0xb3, 0x07, 0x5b, 0xb9, 0x5f, 0x50, 0x8d, 0x51,
-	0x63, 0x54, 0xfb, 0x46, 0x09, 0xce, 0xa5, 0xcf, 0xdc, 0x3d, 0xcc, 0x31, 0x3c, 0x07, 0x63, 0x41,
-	0x8f, 0x57, 0x88, 0x4b, 0xd7, 0xa0, 0x9c, 0x70, 0x43, 0x80, 0x31, 0xc2, 0x67, 0x0f, 0xf8, 0xd1,
-	0xc7, 0x32, 0xe0, 0x0b, 0xc7, 0x1d, 0xf0, 0x79, 0x4f, 0x27, 0x12, 0x13, 0x84, 0x52, 0x2e, 0x13,
-	0x84, 0x74, 0x8f, 0x0d, 0x30, 0xe2, 0xa9, 0xbc, 0x6c, 0x6f, 0x2c, 0x97, 0xda, 0xea, 0x68, 0x20,
-	0xf6, 0xdd, 0xb3, 0x77, 0x06, 0x1d, 0xcb, 0xcf, 0x8b, 0x30, 0x95, 0x3c, 0x44, 0xc3, 0x92, 0xd2,
-	0x1d, 0x2f, 0x08, 0x65, 0xaa, 0x9e, 0xbe, 0x36, 0xff, 0xd5, 0x18, 0x85, 0x3a, 0xdd, 0xf1, 0x22,
-	0xe7, 0x73, 0x30, 0x26, 0x2f, 0x73, 0x91, 0x81, 0x53, 0x8d, 0x22, 0x79, 0xe1, 0x0b, 0x46, 0xf8,
-	0xff, 0x0b, 0x9b, 0x4e, 0x40, 0xbe, 0xd9, 0x1f, 0x36, 0xdf, 0xce, 0xf5, 0xc4, 0xd4, 0x87, 0x3b,
-	0x6a, 0xbe, 0x05, 0x33, 0x7d, 0xdb, 0x22, 0xf1, 0x45, 0x97, 0xc6, 0x11, 0x17, 0x5d, 0x5e, 0x86,
-	0xa2, 0x6b, 0x76, 0xa8, 0xb8, 0x4f, 0xa2, 0x22, 0xc2, 0x1b, 0xcb, 0x7b, 0x03, 0x14, 0xf0, 0xda,
-	0x8f, 0x4a, 0x30, 0xd3, 0x77, 0x32, 0x98, 0x27, 0x9c, 0x6a, 0x69, 0x3d, 0x95, 0x46, 0x67, 0x2e,
-	0xa8, 0xbf, 0x02, 0x53, 0x7c, 0x60, 0x6c, 0xa4, 0x16, 0xe4, 0xd5, 0xf6, 0xf0, 0x56, 0x02, 0x8b,
-	0x29, 0xea, 0xe3, 0x25, 0xac, 0xaf, 0xc0, 0x54, 0xd0, 0x6b, 0x06, 0x96, 0x6f, 0x77, 0xe5, 0x1e,
-	0x74, 0x21, 0x29, 0xa4, 0x91, 0xc0, 0x62, 0x8a, 0x9a, 0xb4, 0xf9, 0x15, 0x53, 0x32, 0x78, 0xca,
-	0xc5, 0xb0, 0x81, 0x6e, 0x4a, 0xba, 0x20, 0x6f, 0xa1, 0x4a, 0xb0, 0xc0, 0x3e, 0xa6, 0xa4, 0x09,
-	0xb3, 0x62, 0x61, 0x5c, 0x57, 0x48, 0x2d, 0xab, 0x8b, 0xac, 0xb4, 0x26, 0x95, 0x9e, 0x5d, 0x3c,
-	0x94, 0x12, 0x8f, 0xe0, 0x32, 0xe0, 0xf5, 0x48, 0xdf, 0xea, 0xff, 0xfa, 0xc2, 0x3b, 0x79, 0x9f,
-	0x27, 0x3f, 0xd1, 0x18, 0x3c, 0x33, 0x77, 0xa1, 0xfe, 0x7d, 0x99, 0x0d, 0x94, 0xd4, 0xd1, 0x48,
-	0x52, 0x83, 0x12, 0xb7, 0x4d, 0x16, 0x5e, 0xd4, 0x46, 0x12, 0x37, 0xda, 0x00, 0x25, 0xe6, 0x18,
-	0x4b, 0xd4, 0x72, 0xca, 0x36, 0x7a, 0xc8, 0x94, 0xad, 0x0b, 0xe7, 0x43, 0x27, 0xd8, 0xf2, 0x7b,
-	0x41, 0xb8, 0x40, 0xfd, 0x30, 0x90, 0xa6, 0x5b, 0x18, 0xf8, 0xca, 0xf2, 0xad, 0xd5, 0x46, 0x9a,
-	0x0b, 0x66, 0xb1, 0x66, 0x06, 0x1c, 0x3a, 0x41, 0xdd, 0x71, 0xbc, 0x3b, 0xd1, 0x9e, 0x7d, 0x1c,
-	0x6c, 0x64, 0x18, 0x51, 0x06, 0xbc, 0xb5, 0xda, 0x38, 0x84, 0x12, 0x8f, 0xe0, 0x42, 0xd6, 0xf8,
-	0x53, 0xbd, 0x6e, 0x3a, 0x76, 0xcb, 0x0c, 0x29, 0x0b, 0xc7, 0x7c, 0xed, 0x58, 0x8c, 0x0e, 0xb5,
-	0x91, 0xb7, 0xb5, 0xda, 0x48, 0x93, 0x60, 0x56, 0xbb, 0x61, 0x7d, 0xb6, 0x24, 0x33, 0x7a, 0x97,
-	0x1f, 0x4b, 0xf4, 0xae, 0x0c, 0x36, 0xca, 0x21, 0xa7, 0x51, 0x9e, 0x32, 0xf9, 0x01, 0x46, 0x79,
-	0x0b, 0xa6, 0xd5, 0x6d, 0xe2, 0xd2, 0x66, 0xc7, 0x07, 0xde, 0x7b, 0xa8, 0x27, 0x39, 0x60, 0x9a,
-	0xe5, 0x59, 0x5c, 0xcf, 0xf9, 0xf3, 0xa2, 0x3c, 0x81, 0x9b, 0xc3, 0x74, 0x35, 0xef, 0xdb, 0xd3,
-	0x59, 0xec, 0xe7, 0x53, 0x83, 0xae, 0x69, 0x45, 0xb7, 0x19, 0xaa, 0xd8, 0xbf, 0x1e, 0x21, 0x30,
-	0xa6, 0x21, 0xb3, 0x30, 0xd2, 0x6a, 0x72, 0x6f, 0x54, 0x8c, 0x0f, 0x71, 0x2d, 0xce, 0xe3, 0x48,
-	0xab, 0x49, 0xae, 0x41, 0x59, 0xce, 0x83, 0xa3, 0x33, 0x4e, 0x5c, 0xac, 0x9c, 0x24, 0x07, 0xa8,
-	0xb0, 0xc3, 0x9a, 0x79, 0x0e, 0x61, 0x81, 0x37, 0xdd, 0x73, 0x1f, 0xee, 0xb9, 0xe7, 0x7b, 0x25,
-	0xb8, 0x98, 0x7d, 0x76, 0xfb, 0x7f, 0x8d, 0xc5, 0x0a, 0x03, 0x1c, 0xcd, 0x34, 0xc0, 0x8f, 0xc1,
-	0x58, 0xc0, 0x15, 0x8f, 0xb6, 0x6f, 0xc5, 0xb5, 0x5e, 0x02, 0x84, 0x11, 0x8e, 0xbc, 0x06, 0xa4,
-	0x63, 0xde, 0x5d, 0x0b, 0xda, 0x0b, 0x5e, 0x8f, 0xdf, 0x54, 0x88, 0xd4, 0x14, 0xd7, 0x68, 0x16,
-	0xe3, 0x03, 0x10, 0x6b, 0x7d, 0x14, 0x98, 0xd1, 0x8a, 0x6f, 0x38, 0x27, 0x16, 0xf1, 0x53, 0x27,
-	0x31, 0x8e, 0x5c, 0x75, 0x1f, 0x52, 0x18, 0xfb, 0xa0, 0x7f, 0xfe, 0x67, 0x0d, 0xe5, 0x40, 0xff,
-	0x87, 0x7b, 0x12, 0xf8, 0x8b, 0x02, 0x9c, 0xcf, 0x28, 0xcf, 0x4e, 0xfa, 0x4c, 0xe3, 0x18, 0x3e,
-	0x73, 0x4f, 0x3d, 0x7b, 0x3e, 0x67, 0x5b, 0x23, 0xa5, 0x0e, 0x7f, 0x70, 0x36, 0x39, 0xb8, 0xc0,
-	0xf7, 0x3d, 0xa3, 0xcd, 0x96, 0xe8, 0xf2, 0xb4, 0x51, 0x69, 0x6b, 0xc7, 0xba, 0xc5, 0x70, 0x39,
-	0x83, 0x43, 0xbc, 0x19, 0x94, 0x85, 0xc5, 0x4c, 0xa9, 0x64, 0x01, 0x40, 0xd5, 0x4f, 0x44, 0x63,
-	0xf3, 0x59, 0x7e, 0x17, 0xa3, 0x82, 0xfe, 0x17, 0xdf, 0x53, 0xd5, 0xde, 0x36, 0x9f, 0x32, 0x6b,
-	0xcd, 0x86, 0x71, 0x63, 0x75, 0x46, 0xf7, 0x1e, 0xdf, 0xa6, 0x4f, 0x67, 0x5d, 0x7f, 0x31, 0x0a,
-	0x53, 0xc9, 0x8e, 0x24, 0x57, 0xa1, 0xd4, 0xf5, 0xe9, 0xb6, 0x7d, 0x37, 0x7d, 0x71, 0xf1, 0x06,
-	0x87, 0xa2, 0xc4, 0x12, 0x0f, 0x4a, 0x8e, 0xd9, 0x64, 0x51, 0x56, 0x5c, 0x1c, 0xb9, 0x7c, 0xea,
-	0x4b, 0x10, 0xa3, 0xe5, 0xe7, 0x48, 0xe0, 0x2a, 0x67, 0x8f, 0x52, 0x0c, 0x13, 0xb8, 0x6d, 0x53,
-	0xa7, 0x25, 0x4e, 0xd0, 0x0d, 0x43, 0xe0, 0x0d, 0xce, 0x1e, 0xa5, 0x18, 0xf2, 0x36, 0x54, 0xc4,
-	0x6d, 0xcf, 0xad, 0xf9, 0x03, 0x99, 0xfa, 0xfc, 0xbf, 0xe3, 0x99, 0xec, 0x96, 0xdd, 0xa1, 0xf1,
-	0x70, 0x5c, 0x88, 0x98, 0x60, 0xcc, 0x8f, 0x7f, 0x12, 0x6b, 0x3b, 0xa4, 0x7e, 0x23, 0x34, 0xfd,
-	0xe8, 0x8b, 0x55, 0xf1, 0x27, 0xb1, 0x14, 0x06, 0x35, 0xaa, 0xda, 0x5f, 0x95, 0x60, 0x2a, 0x59,
-	0x66, 0xfe, 0x98, 0xce, 0x41, 0x3e, 0x0f, 0x65, 0x9e, 0x69, 0xd6, 0x7d, 0x37, 0x7d, 0x9d, 0xfc,
-	0x96, 0x84, 0xa3, 0xa2, 0x20, 0x08, 0x15, 0xf3, 0x64, 0xdf, 0xa1, 0x12, 0x07, 0x9f, 0xd4, 0x17,
-	0xa8, 0x62, 0x36, 0x8c, 0x67, 0x10, 0x91, 0x0f, 0x96, 0x96, 0x72, 0x9e, 0x0a, 0x8c, 0x31, 0x1b,
-	0x66, 0xf9, 0x3e, 0x6d, 0x47, 0xe9, 0xa6, 0x66, 0xf9, 0xc8, 0xa1, 0x28, 0xb1, 0xe4, 0x39, 0x18,
-	0xf3, 0x3d, 0x87, 0xd6, 0x71, 0x5d, 0xc6, 0x59, 0xb5, 0x12, 0x8b, 0x02, 0x8c, 0x11, 0x7e, 0x18,
-	0xab, 0x90, 0x49, 0x03, 0x18, 0x20, 0xf8, 0x2d, 0xc3, 0xcc, 0xbe, 0x4c, 0x61, 0x1b, 0x76, 0xdb,
-	0x35, 0xc3, 0xf8, 0xb8, 0xbc, 0x3a, 0x4f, 0xf2, 0x7a, 0x9a, 0x00, 0xfb, 0xdb, 0x9c, 0xc5, 0x28,
-	0xfa, 0x6f, 0x6c, 0xe4, 0x24, 0x2e, 0x46, 0x48, 0x5a, 0xa5, 0x31, 0x04, 0xab, 0x1c, 0xc9, 0xdb,
-	0x2a, 0x47, 0x8f, 0xb4, 0xca, 0x67, 0xa1, 0xc8, 0x3f, 0x62, 0x29, 0x57, 0x28, 0xd5, 0x7a, 0x26,
-	0xff, 0xb6, 0x1f, 0x0a, 0x1c, 0xa9, 0xc3, 0xf4, 0x1d, 0xd3, 0x0e, 0x99, 0x7f, 0x12, 0x27, 0x24,
-	0xc4, 0xf6, 0xd5, 0xa8, 0x7e, 0xfc, 0x31, 0x81, 0xc6, 0x34, 0xfd, 0x20, 0xd6, 0x3f, 0xd8, 0x82,
-	0xe1, 0x2b, 0x30, 0xc5, 0x95, 0xac, 0x5b, 0x16, 0x9b, 0xdb, 0xae, 0xb4, 0xd2, 0x5f, 0x3b, 0xda,
-	0xd4, 0xb1, 0x8b, 0x98, 0xa2, 0x4e, 0x8e, 0xb5, 0x4a, 0x3e, 0x63, 0x6d, 0xf3, 0x84, 0x63, 0xed,
-	0x19, 0x18, 0x6d, 0x39, 0x7b, 0xfc, 0xcc, 0x49, 0x39, 0x5e, 0x5e, 0x5b, 0x5c, 0xdd, 0x44, 0x06,
-	0x7f, 0x3c, 0x5f, 0x46, 0x61, 0xdd, 0x41, 0xdd, 0x56, 0xd7, 0xb3, 0xdd, 0x50, 0x56, 0x95, 0xa8,
-	0x47, 0x58, 0x92, 0x70, 0x54, 0x14, 0xa7, 0x1b, 0x6f, 0x5f, 0x85, 0x72, 0x64, 0xda, 0xec, 0x5d,
-	0xa8, 0x76, 0xf1, 0xbb, 0x60, 0x56, 0xce, 0x99, 0x5c, 0x87, 0x8a, 0xd7, 0xa5, 0x89, 0x8f, 0x3e,
-	0xa8, 0xc8, 0x79, 0x2b, 0x42, 0x60, 0x4c, 0xc3, 0x0c, 0x5d, 0x48, 0x4d, 0x2d, 0xdc, 0xbf, 0xce,
-	0x80, 0x52, 0x89, 0xda, 0xd7, 0x0c, 0x88, 0x6e, 0x52, 0x26, 0x8b, 0x50, 0xec, 0x7a, 0x7e, 0x28,
-	0x16, 0x4c, 0xc7, 0x5f, 0xbc, 0x9c, 0x3d, 0x22, 0xc5, 0x89, 0x49, 0xcf, 0x0f, 0x63, 0x8e, 0xec,
-	0x5f, 0x80, 0xa2, 0x31, 0xd3, 0xd3, 0x72, 0x7a, 0x41, 0x48, 0xfd, 0x95, 0x8d, 0xb4, 0x9e, 0x0b,
-	0x11, 0x02, 0x63, 0x9a, 0xda, 0xbf, 0x17, 0xe0, 0x5c, 0xfa, 0x3a, 0x0b, 0xf2, 0x0e, 0x4c, 0x06,
-	0x76, 0xdb, 0xb5, 0xdd, 0xb6, 0x5c, 0x9e, 0x32, 0x06, 0x2e, 0x85, 0x6a, 0xe8, 0xed, 0x31, 0xc9,
-	0x2e, 0xb7, 0x33, 0x08, 0x8f, 0xe7, 0xcb, 0x6e, 0xef, 0xf7, 0x57, 0x05, 0x7f, 0x31, 0xe7, 0x0b,
-	0x45, 0x3e, 0xdc, 0x65, 0xc1, 0xff, 0x51, 0x84, 0x8b, 0xd9, 0x17, 0x96, 0x3c, 0xa6, 0x99, 0x62,
-	0x5c, 0xf6, 0x32, 0x72, 0x68, 0xd9, 0x4b, 0xfc, 0x9e, 0x47, 0x73, 0xba, 0x80, 0x44, 0xbd, 0x80,
-	0xa3, 0xbd, 0xa1, 0x9a, 0xc3, 0x16, 0x1e, 0x3a, 0x87, 0xbd, 0x0a, 0x25, 0x79, 0x9b, 0x60, 0x6a,
-	0x6e, 0x38, 0x2f, 0xee, 0xfa, 0x93, 0x58, 0x2d, 0x5a, 0x97, 0x8e, 0x8c, 0xd6, 0x6c, 0xf6, 0xa1,
-	0xbe, 0x9f, 0x39, 0x36, 0xf8, 0xec, 0x43, 0x7d, 0x3e, 0x33, 0x66, 0xc3, 0x0b, 0x1b, 0xbb, 0x76,
-	0xfc, 0x6d, 0xb2, 0xb8, 0xb0, 0x71, 0x63, 0xe5, 0x36, 0xae, 0xa2, 0xc4, 0x26, 0x57, 0x66, 0x2a,
-	0xb9, 0xac, 0xcc, 0x64, 0xdb, 0xdc, 0xa3, 0xca, 0x62, 0x2d, 0x98, 0xe9, 0xeb, 0xf3, 0x63, 0xe7,
-	0xb1, 0x57, 0xa1, 0x14, 0xf4, 0xb6, 0x19, 0x5d, 0xaa, 0x26, 0xbe, 0xc1, 0xa1, 0x28, 0xb1, 0xb5,
-	0xef, 0x15, 0x98, 0x94, 0xd4, 0xd5, 0x36, 0x8f, 0x69, 0x54, 0xbd, 0x0c, 0x93, 0x22, 0x93, 0x7c,
-	0x43, 0x2b, 0x57, 0x2e, 0x6b, 0xeb, 0x7d, 0x3a, 0x12, 0x93, 0xb4, 0x64, 0x85, 0x9b, 0xc9, 0xc0,
-	0xb9, 0x18, 0x48, 0x4b, 0x62, 0x81, 0x5b, 0x32, 0x20, 0x2f, 0xc0, 0x38, 0x7f, 0x08, 0xf1, 0xca,
-	0xe5, 0x92, 0x0a, 0x2f, 0x4c, 0x5a, 0x8a, 0xc1, 0xa8, 0xd3, 0x24, 0x37, 0x8c, 0x8a, 0xb9, 0x6c,
-	0x18, 0xf5, 0xf5, 0xca, 0xa3, 0xb2, 0xbb, 0xef, 0x94, 0x41, 0x7d, 0x1f, 0x82, 0x58, 0x7d, 0x5f,
-	0xe9, 0xf8, 0xd4, 0xc0, 0x6b, 0xa9, 0x91, 0x2a, 0x62, 0xdd, 0x39, 0x23, 0x24, 0xbd, 0x06, 0x44,
-	0x7e, 0x16, 0x42, 0xce, 0x7b, 0xd5, 0x97, 0xa4, 0x2b, 0xf1, 0xa2, 0x71, 0xa3, 0x8f, 0x02, 0x33,
-	0x5a, 0x91, 0xd7, 0xf8, 0x37, 0x69, 0x42, 0xd3, 0x76, 0x95, 0xe7, 0x7d, 0xe6, 0x90, 0x9a, 0x16,
-	0x41, 0xa4, 0xbe, 0x2e, 0x23, 0xfe, 0x62, 0xdc, 0x9c, 0x2c, 0xc1, 0xd8, 0xbe, 0xe7, 0xf4, 0x3a,
-	0xea, 0x9b, 0x94, 0xb3, 0x59, 0x9c, 0x5e, 0xe7, 0x24, 0xda, 0x19, 0x6c, 0xd1, 0x04, 0xa3, 0xb6,
-	0x84, 0xc2, 0x34, 0xdf, 0xb4, 0xb5, 0xc3, 0x03, 0x39, 0x00, 0x64, 0xe8, 0xbd, 0x9a, 0xc5, 0x6e,
-	0xc3, 0x6b, 0x35, 0x92, 0xd4, 0xf2, 0xc3, 0xd5, 0x49, 0x20, 0xa6, 0x79, 0x92, 0x1b, 0x50, 0x36,
-	0xb7, 0xb7, 0x6d, 0xd7, 0x0e, 0x0f, 0xe4, 0xee, 0xcf, 0x47, 0xb3, 0xf8, 0xd7, 0x25, 0x8d, 0xac,
-	0x6b, 0x97, 0xff, 0x50, 0xb5, 0x25, 0xb7, 0x61, 0x3c, 0xf4, 0x1c, 0x39, 0x2f, 0x0d, 0x64, 0x7e,
-	0x7f, 0x29, 0x8b, 0xd5, 0x96, 0x22, 0x8b, 0x77, 0x2b, 0x62, 0x58, 0x80, 0x3a, 0x1f, 0xf2, 0xfb,
-	0x06, 0x4c, 0xb8, 0x5e, 0x8b, 0x46, 0x43, 0x4f, 0xae, 0x9e, 0xbf, 0x95, 0xd3, 0x77, 0x4d, 0xe6,
-	0xd6, 0x35, 0xde, 0x62, 0x84, 0xa8, 0x7a, 0x67, 0x1d, 0x85, 0x09, 0x25, 0x88, 0x0b, 0xe7, 0xec,
-	0x8e, 0xd9, 0xa6, 0x1b, 0x3d, 0x47, 0x1e, 0x3a, 0x09, 0x64, 0xf0, 0xc8, 0xac, 0x84, 0x5a, 0xf5,
-	0x2c, 0xd3, 0x11, 0xdf, 0x05, 0x42, 0xba, 0x4d, 0x7d, 0xfe, 0x79, 0x22, 0xf5, 0x5d, 0xb5, 0x95,
-	0x14, 0x27, 0xec, 0xe3, 0x4d, 0x96, 0x61, 0xa6, 0xeb, 0xdb, 0x1e, 0xef, 0x37, 0xc7, 0x0c, 0xc4,
-	0x77, 0x61, 0x20, 0x59, 0xfe, 0xb2, 0x91, 0x26, 0xc0, 0xfe, 0x36, 0xa2, 0x1c, 0x53, 0x00, 0x79,
-	0xba, 0x55, 0x8c, 0xca, 0x31, 0x05, 0x0c, 0x15, 0x76, 0xf6, 0x73, 0x30, 0xd3, 0xf7, 0x6e, 0x06,
-	0x72, 0x08, 0x7f, 0x64, 0x40, 0xba, 0x7e, 0x90, 0xe5, 0x0d, 0x2d, 0xdb, 0xe7, 0x0c, 0x0f, 0xd2,
-	0x0b, 0xf5, 0x8b, 0x11, 0x02, 0x63, 0x1a, 0x72, 0x05, 0x0a, 0x5d, 0x33, 0xdc, 0x49, 0x1f, 0xde,
-	0x60, 0x2c, 0x91, 0x63, 0xf8, 0x77, 0x28, 0xd9, 0x3f, 0xda, 0xa6, 0x77, 0xbb, 0x32, 0x0d, 0x8a,
-	0xbf, 0x43, 0xa9, 0x30, 0xa8, 0x51, 0xd5, 0x7e, 0x50, 0x84, 0xa9, 0x64, 0x6c, 0x49, 0xe4, 0x83,
-	0xc6, 0xc3, 0xf2, 0x41, 0x16, 0x27, 0x3b, 0x34, 0xdc, 0xf1, 0x5a, 0xe9, 0x38, 0xb9, 0xc6, 0xa1,
-	0x28, 0xb1, 0x5c, 0x7d, 0xcf, 0x0f, 0xa5, 0x5a, 0xb1, 0xfa, 0x9e, 0x1f, 0x22, 0xc7, 0x44, 0x67,
-	0x4f, 0x0a, 0x87, 0x9c, 0x3d, 0x69, 0xc3, 0x39, 0x71, 0xad, 0xd6, 0x02, 0xf5, 0xc3, 0x13, 0x9f,
-	0x99, 0x6a, 0xa4, 0x58, 0x60, 0x1f, 0x53, 0xfe, 0x95, 0x7c, 0x0e, 0xe3, 0x8d, 0x4f, 0x58, 0x0e,
-	0xd9, 0x48, 0x72, 0xc0, 0x34, 0xcb, 0x61, 0x2c, 0x01, 0x26, 0xfb, 0xf1, 0xc4, 0x77, 0xdd, 0x94,
-	0x73, 0xba, 0xeb, 0xe6, 0x54, 0x41, 0x74, 0x7e, 0xee, 0x27, 0xbf, 0xba, 0xf4, 0xc4, 0x4f, 0x7f,
-	0x75, 0xe9, 0x89, 0x9f, 0xfd, 0xea, 0xd2, 0x13, 0x5f, 0x7b, 0x70, 0xc9, 0xf8, 0xc9, 0x83, 0x4b,
-	0xc6, 0x4f, 0x1f, 0x5c, 0x32, 0x7e, 0xf6, 0xe0, 0x92, 0xf1, 0xcb, 0x07, 0x97, 0x8c, 0xef, 0xfd,
-	0xf3, 0xa5, 0x27, 0x3e, 0x5f, 0x8e, 0x1e, 0xfe, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x33, 0x83,
-	0x01, 0xe7, 0x72, 0x8c, 0x00, 0x00,
+	// 6672 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3d, 0x5d, 0x8f, 0x1c, 0xc7,
+	0x71, 0x9a, 0xdb, 0x8f, 0xdb, 0xed, 0xbd, 0xcf, 0x21, 0x45, 0x8d, 0x68, 0x89, 0x24, 0xd6, 0xb0,
+	0x20, 0x27, 0xf6, 0xd1, 0x52, 0x3e, 0x2c, 0xcb, 0xb6, 0x8c, 0xbd, 0x0f, 0x92, 0x27, 0xde, 0x1d,
+	0x8f, 0xb5, 0x47, 0x4a, 0xb2, 0x6c, 0xc9, 0xbd, 0xb3, 0x7d, 0x7b, 0xe3, 0x9b, 0x9d, 0xd9, 0x9b,
+	0x99, 0x25, 0xef, 0x88, 0xc4, 0x36, 0x02, 0x24, 0xb1, 0x25, 0x7f, 0x29, 0xb1, 0x93, 0x00, 0x81,
+	0x5f, 0x92, 0xc0, 0x40, 0x10, 0x20, 0x40, 0x5e, 0x92, 0x3f, 0x10, 0x24, 0x0e, 0x92, 0x07, 0x27,
+	0x4f, 0x86, 0x0d, 0x10, 0x36, 0x83, 0xe4, 0x29, 0x79, 0x08, 0xf2, 0x14, 0x23, 0x0f, 0x41, 0x7f,
+	0x4c, 0x4f, 0xf7, 0xec, 0xec, 0xf1, 0xf6, 0x6e, 0x96, 0xf4, 0x09, 0x79, 0xdb, 0xed, 0xaa, 0xae,
+	0xaa, 0xe9, 0xae, 0xae, 0xee, 0xaa, 0xee, 0xea, 0x46, 0xeb, 0x1d, 0x27, 0xda, 0xe9, 0xb7, 0x16,
+	0x6c, 0xbf, 0x7b, 0x19, 0x07, 0x1d, 0xbf, 0x17, 0xf8, 0x5f, 0x64, 0x3f, 0x3e, 0x4a, 0xee, 0x10,
+	0x2f, 0x0a, 0x2f, 0xf7, 0x76, 0x3b, 0x97, 0x71, 0xcf, 0x09, 0x2f, 0xf3, 0xff, 0x7e, 0x3f, 0xb0,
+	0xc9, 0xe5, 0x3b, 0x2f, 0x60, 0xb7, 0xb7, 0x83, 0x5f, 0xb8, 0xdc, 0x21, 0x1e, 0x09, 0x70, 0x44,
+	0xda, 0x0b, 0xbd, 0xc0, 0x8f, 0x7c, 0xf3, 0xd3, 0x09, 0xb9, 0x85, 0x98, 0x1c, 0xfb, 0xf1, 0x36,
+	0xaf, 0xbe, 0xd0, 0xdb, 0xed, 0x2c, 0x50, 0x72, 0x0b, 0x0a, 0xb9, 0x85, 0x98, 0xdc, 0xf9, 0xcf,
+	0x1c, 0x59, 0x1a, 0xdb, 0xef, 0x76, 0x7d, 0x2f, 0xcd, 0xff, 0xfc, 0x47, 0x15, 0x02, 0x1d, 0xbf,
+	0xe3, 0x5f, 0x66, 0xc5, 0xad, 0xfe, 0x36, 0xfb, 0xc7, 0xfe, 0xb0, 0x5f, 0x02, 0xbd, 0xbe, 0xfb,
+	0x52, 0xb8, 0xe0, 0xf8, 0x94, 0xe4, 0x65, 0xdb, 0x0f, 0xe8, 0x87, 0x0d, 0x90, 0xfc, 0xd5, 0x04,
+	0xa7, 0x8b, 0xed, 0x1d, 0xc7, 0x23, 0xc1, 0x41, 0x22, 0x47, 0x97, 0x44, 0x38, 0xab, 0xd6, 0xe5,
+	0x61, 0xb5, 0x82, 0xbe, 0x17, 0x39, 0x5d, 0x32, 0x50, 0xe1, 0xd7, 0x1f, 0x56, 0x21, 0xb4, 0x77,
+	0x48, 0x17, 0xa7, 0xeb, 0xd5, 0xff, 0xc7, 0x40, 0xf3, 0x8d, 0xf5, 0x9b, 0x9b, 0x4b, 0xbe, 0x17,
+	0xf6, 0xbb, 0x64, 0xc9, 0xf7, 0xb6, 0x9d, 0x8e, 0xf9, 0x6b, 0xa8, 0x66, 0xf3, 0x82, 0x60, 0x0b,
+	0x77, 0x2c, 0xe3, 0x92, 0xf1, 0x7c, 0x75, 0xf1, 0xcc, 0x0f, 0xee, 0x5f, 0x7c, 0xe2, 0xc1, 0xfd,
+	0x8b, 0xb5, 0xa5, 0x04, 0x04, 0x2a, 0x9e, 0xf9, 0x61, 0x34, 0x89, 0xfb, 0x91, 0xdf, 0xb0, 0x77,
+	0xad, 0x89, 0x4b, 0xc6, 0xf3, 0x95, 0xc5, 0x59, 0x51, 0x65, 0xb2, 0xc1, 0x8b, 0x21, 0x86, 0x9b,
+	0x97, 0x51, 0x95, 0xec, 0xdb, 0x6e, 0x3f, 0x74, 0xee, 0x10, 0xab, 0xc0, 0x90, 0xe7, 0x05, 0x72,
+	0x75, 0x25, 0x06, 0x40, 0x82, 0x43, 0x69, 0x7b, 0xfe, 0x9a, 0x6f, 0x63, 0xd7, 0x2a, 0xea, 0xb4,
+	0x37, 0x78, 0x31, 0xc4, 0x70, 0xf3, 0x39, 0x54, 0xf6, 0xfc, 0xd7, 0xb0, 0x13, 0x59, 0x25, 0x86,
+	0x39, 0x23, 0x30, 0xcb, 0x1b, 0xac, 0x14, 0x04, 0xb4, 0xfe, 0x1f, 0x35, 0x34, 0x4b, 0xbf, 0x7d,
+	0x85, 0x2a, 0x47, 0x93, 0xe9, 0x92, 0xf9, 0x2c, 0x2a, 0xf4, 0x03, 0x57, 0x7c, 0x71, 0x4d, 0x54,
+	0x2c, 0xdc, 0x82, 0x35, 0xa0, 0xe5, 0xe6, 0x4b, 0x68, 0x8a, 0xec, 0xdb, 0x3b, 0xd8, 0xeb, 0x90,
+	0x0d, 0xdc, 0x25, 0xec, 0x33, 0xab, 0x8b, 0x67, 0x05, 0xde, 0xd4, 0x8a, 0x02, 0x03, 0x0d, 0x53,
+	0xad, 0xb9, 0x75, 0xd0, 0xe3, 0xdf, 0x9c, 0x51, 0x93, 0xc2, 0x40, 0xc3, 0x34, 0x5f, 0x44, 0x28,
+	0xf0, 0xfb, 0x91, 0xe3, 0x75, 0xae, 0x93, 0x03, 0xf6, 0xf1, 0xd5, 0x45, 0x53, 0xd4, 0x43, 0x20,
+	0x21, 0xa0, 0x60, 0x99, 0xbf, 0x89, 0xe6, 0x6d, 0xdf, 0xf3, 0x88, 0x1d, 0x39, 0xbe, 0xb7, 0x88,
+	0xed, 0x5d, 0x7f, 0x7b, 0x9b, 0xb5, 0x46, 0xed, 0xc5, 0x97, 0x16, 0x8e, 0x3c, 0xc8, 0xf8, 0x28,
+	0x59, 0x10, 0xf5, 0x17, 0x9f, 0x7c, 0x70, 0xff, 0xe2, 0xfc, 0x52, 0x9a, 0x2c, 0x0c, 0x72, 0x32,
+	0x3f, 0x82, 0x2a, 0x5f, 0x0c, 0x7d, 0x6f, 0xd1, 0x6f, 0x1f, 0x58, 0x65, 0xd6, 0x07, 0x73, 0x42,
+	0xe0, 0xca, 0xab, 0xcd, 0x1b, 0x1b, 0xb4, 0x1c, 0x24, 0x86, 0x79, 0x0b, 0x15, 0x22, 0x37, 0xb4,
+	0x26, 0x99, 0x78, 0x2f, 0x8f, 0x2c, 0xde, 0xd6, 0x5a, 0x93, 0xab, 0xed, 0xe2, 0x24, 0xed, 0xab,
+	0xad, 0xb5, 0x26, 0x50, 0x7a, 0xe6, 0x3b, 0x06, 0xaa, 0xd0, 0xf1, 0xd5, 0xc6, 0x11, 0xb6, 0x2a,
+	0x97, 0x0a, 0xcf, 0xd7, 0x5e, 0xfc, 0xdc, 0xc2, 0x89, 0x0c, 0xcc, 0x42, 0x4a, 0x5b, 0x16, 0xd6,
+	0x05, 0xf9, 0x15, 0x2f, 0x0a, 0x0e, 0x92, 0x6f, 0x8c, 0x8b, 0x41, 0xf2, 0x37, 0xff, 0xd0, 0x40,
+	0xb3, 0x71, 0xaf, 0x2e, 0x13, 0xdb, 0xc5, 0x01, 0xb1, 0xaa, 0xec, 0x83, 0x5f, 0xcf, 0x43, 0x26,
+	0x9d, 0xb2, 0x68, 0x8e, 0x33, 0x0f, 0xee, 0x5f, 0x9c, 0x4d, 0x81, 0x20, 0x2d, 0x85, 0xf9, 0xae,
+	0x81, 0xa6, 0xf6, 0xfa, 0xa4, 0x2f, 0xc5, 0x42, 0x4c, 0xac, 0x5b, 0x39, 0x88, 0x75, 0x53, 0x21,
+	0x2b, 0x64, 0x9a, 0xa3, 0xca, 0xae, 0x96, 0x83, 0xc6, 0xdc, 0xfc, 0x32, 0xaa, 0xb2, 0xff, 0x8b,
+	0x8e, 0xd7, 0xb6, 0x6a, 0x4c, 0x12, 0xc8, 0x4b, 0x12, 0x4a, 0x53, 0x88, 0x31, 0x4d, 0xed, 0x8c,
+	0x2c, 0x84, 0x84, 0xa7, 0x79, 0x17, 0x4d, 0x0a, 0x93, 0x66, 0x4d, 0x31, 0xf6, 0x9b, 0x39, 0xb0,
+	0xd7, 0xac, 0xeb, 0x62, 0x8d, 0x5a, 0x2d, 0x51, 0x04, 0x31, 0x37, 0xf3, 0x75, 0x54, 0xc4, 0xfd,
+	0x68, 0xc7, 0x9a, 0x3e, 0xe6, 0x30, 0x58, 0xc4, 0xa1, 0x63, 0x37, 0xfa, 0xd1, 0xce, 0x62, 0xe5,
+	0xc1, 0xfd, 0x8b, 0x45, 0xfa, 0x0b, 0x18, 0x45, 0x13, 0x50, 0xb5, 0x1f, 0xb8, 0x4d, 0x62, 0x07,
+	0x24, 0xb2, 0x66, 0x18, 0xf9, 0x0f, 0x2d, 0xf0, 0xf9, 0x82, 0x52, 0x58, 0xa0, 0x53, 0xd7, 0xc2,
+	0x9d, 0x17, 0x16, 0x38, 0xc6, 0x75, 0x72, 0xd0, 0x24, 0x2e, 0xb1, 0x23, 0x3f, 0xe0, 0xcd, 0x74,
+	0x0b, 0xd6, 0x38, 0x04, 0x12, 0x32, 0x66, 0x84, 0xca, 0xdb, 0x8e, 0x1b, 0x91, 0xc0, 0x9a, 0xcd,
+	0xa5, 0x95, 0x94, 0x51, 0x75, 0x85, 0xd1, 0x5d, 0x44, 0xd4, 0x62, 0xf3, 0xdf, 0x20, 0x78, 0x9d,
+	0xff, 0x24, 0x9a, 0xd6, 0x86, 0x9c, 0x39, 0x87, 0x0a, 0xbb, 0xe4, 0x80, 0x9b, 0x6b, 0xa0, 0x3f,
+	0xcd, 0xb3, 0xa8, 0x74, 0x07, 0xbb, 0x7d, 0x61, 0x9a, 0x81, 0xff, 0x79, 0x79, 0xe2, 0x25, 0xa3,
+	0xfe, 0x43, 0x03, 0x3d, 0x3d, 0x74, 0xb0, 0xd0, 0xf9, 0xa5, 0xdd, 0x0f, 0x70, 0xcb, 0x25, 0x96,
+	0xa1, 0xcf, 0x2f, 0xcb, 0xbc, 0x18, 0x62, 0x38, 0x35, 0xc8, 0x74, 0x1a, 0x5b, 0x26, 0x2e, 0x89,
+	0x88, 0x98, 0xe9, 0xa4, 0x41, 0x6e, 0x48, 0x08, 0x28, 0x58, 0xd4, 0x22, 0x3a, 0x5e, 0x44, 0x02,
+	0x0f, 0xbb, 0x62, 0xba, 0x93, 0xd6, 0x62, 0x55, 0x94, 0x83, 0xc4, 0x50, 0x66, 0xb0, 0xe2, 0xa1,
+	0x33, 0xd8, 0xa7, 0xd1, 0x99, 0x0c, 0xed, 0x56, 0xaa, 0x1b, 0x87, 0x56, 0xff, 0xd3, 0x09, 0x74,
+	0x2e, 0x7b, 0x9c, 0x9a, 0x97, 0x50, 0xd1, 0xa3, 0x13, 0x1c, 0x9f, 0x08, 0xa7, 0x04, 0x81, 0x22,
+	0x9b, 0xd8, 0x18, 0x44, 0x6d, 0xb0, 0x89, 0x91, 0x1a, 0xac, 0x70, 0xa4, 0x06, 0xd3, 0x16, 0x08,
+	0xc5, 0x23, 0x2c, 0x10, 0x8e, 0x38, 0xeb, 0x53, 0xc2, 0x38, 0xe8, 0xf4, 0xbb, 0x54, 0x09, 0xd9,
+	0xe4, 0x54, 0x4d, 0x08, 0x37, 0x62, 0x00, 0x24, 0x38, 0xf5, 0x77, 0x4a, 0xe8, 0xe9, 0xc6, 0xbd,
+	0x7e, 0x40, 0x98, 0x8e, 0x86, 0xd7, 0xfa, 0x2d, 0x75, 0xc1, 0x70, 0x09, 0x15, 0xb7, 0xf7, 0xda,
+	0x5e, 0xba, 0xa1, 0xae, 0xdc, 0x5c, 0xde, 0x00, 0x06, 0x31, 0x7b, 0xe8, 0x4c, 0xb8, 0x83, 0x03,
+	0xd2, 0x6e, 0xd8, 0x36, 0x09, 0xc3, 0xeb, 0xe4, 0x40, 0x2e, 0x1d, 0x8e, 0x3c, 0x10, 0x9f, 0x7a,
+	0x70, 0xff, 0xe2, 0x99, 0xe6, 0x20, 0x15, 0xc8, 0x22, 0x6d, 0xb6, 0xd1, 0x6c, 0xaa, 0xd8, 0x2a,
+	0x8c, 0xc2, 0x8d, 0x4d, 0x1c, 0x29, 0x6e, 0x90, 0x26, 0x49, 0x15, 0x60, 0xa7, 0xdf, 0x62, 0xdf,
+	0xc2, 0x17, 0x25, 0x52, 0x01, 0xae, 0xf1, 0x62, 0x88, 0xe1, 0xe6, 0x77, 0xd4, 0xa9, 0xb8, 0xc4,
+	0xa6, 0xe2, 0xed, 0x93, 0x9a, 0xd5, 0x61, 0x3d, 0x32, 0xc2, 0xa4, 0x9c, 0x18, 0xb1, 0xf2, 0x69,
+	0x31, 0x62, 0x3f, 0x36, 0xd0, 0xf4, 0xa2, 0x13, 0xb5, 0xfa, 0xf6, 0x2e, 0x89, 0xa8, 0x8d, 0x37,
+	0x03, 0x54, 0x6a, 0x51, 0xd3, 0xcf, 0xea, 0xd7, 0x5e, 0xbc, 0x79, 0xc2, 0x6f, 0x90, 0xc4, 0x93,
+	0xf9, 0xa4, 0xfa, 0xe0, 0xfe, 0xc5, 0x12, 0xfb, 0x0b, 0x9c, 0x95, 0x79, 0x0b, 0x21, 0x9f, 0x4e,
+	0x2d, 0x5b, 0xfe, 0x2e, 0xf1, 0x46, 0xd3, 0xe4, 0x19, 0x3a, 0xe6, 0x6f, 0x34, 0xe2, 0xca, 0xa0,
+	0x10, 0xaa, 0xff, 0xb5, 0x81, 0xcc, 0x41, 0xfe, 0xe6, 0x0d, 0x54, 0xe9, 0x87, 0x24, 0x90, 0xf6,
+	0xe8, 0xc8, 0xbc, 0xa6, 0x68, 0xbf, 0xdf, 0x12, 0x55, 0x41, 0x12, 0xa1, 0x04, 0x7b, 0x38, 0x0c,
+	0xef, 0xfa, 0x41, 0xdb, 0x9a, 0x18, 0x99, 0xe0, 0xa6, 0xa8, 0x0a, 0x92, 0x48, 0xfd, 0xef, 0xca,
+	0xe8, 0xac, 0x14, 0x5c, 0xb5, 0x0e, 0xaf, 0x22, 0xb3, 0xcd, 0xec, 0xd9, 0x35, 0xdf, 0xdf, 0xbd,
+	0xe1, 0x5d, 0x71, 0x3c, 0x27, 0xdc, 0x11, 0x56, 0xf9, 0xbc, 0xd0, 0x4c, 0x73, 0x79, 0x00, 0x03,
+	0x32, 0x6a, 0x99, 0xdf, 0x52, 0x07, 0xd1, 0x04, 0x1b, 0x44, 0x38, 0xaf, 0xce, 0x3e, 0xee, 0xf8,
+	0x99, 0xbc, 0x4b, 0x5a, 0x3b, 0xbe, 0xbf, 0x2b, 0xec, 0xcb, 0xfa, 0x09, 0xe5, 0x79, 0x8d, 0x53,
+	0x5b, 0xf2, 0xbd, 0x88, 0xec, 0x47, 0x7c, 0xa1, 0x24, 0xca, 0x20, 0x66, 0x65, 0x7e, 0x51, 0x2c,
+	0x94, 0x8a, 0x8c, 0xe5, 0x5a, 0x5e, 0x4d, 0x90, 0xb9, 0x74, 0xaa, 0xa3, 0x32, 0xaf, 0xc5, 0xac,
+	0x56, 0x95, 0x8f, 0x67, 0x6e, 0x75, 0x40, 0x40, 0xcc, 0x0f, 0xa2, 0x92, 0x7f, 0xd7, 0x13, 0x46,
+	0xa4, 0xba, 0x38, 0x2d, 0x1a, 0xac, 0x74, 0x83, 0x16, 0x02, 0x87, 0xd1, 0x29, 0x90, 0x0a, 0x46,
+	0x6c, 0xaa, 0x4f, 0xcc, 0xd5, 0x51, 0x9c, 0xb8, 0x4d, 0x09, 0x01, 0x05, 0xcb, 0x7c, 0x05, 0xcd,
+	0x04, 0xa4, 0xe7, 0x87, 0x4e, 0xe4, 0x07, 0x07, 0x4d, 0xb7, 0xdf, 0xb1, 0x2a, 0xac, 0xde, 0x39,
+	0x51, 0x6f, 0x06, 0x34, 0x28, 0xa4, 0xb0, 0x15, 0xf3, 0x56, 0x3d, 0x2d, 0xe6, 0xed, 0x7f, 0x2b,
+	0xe8, 0xbc, 0xec, 0x91, 0x26, 0x09, 0xee, 0x90, 0x40, 0x1d, 0x4e, 0x8a, 0xc2, 0x19, 0x8f, 0x4e,
+	0xe1, 0x3e, 0xa5, 0xf5, 0x1d, 0x77, 0xf9, 0x9f, 0x11, 0x7d, 0x70, 0x76, 0x99, 0xf4, 0x02, 0x62,
+	0xd3, 0x88, 0xca, 0x90, 0x5e, 0xbc, 0x36, 0xd0, 0x8b, 0xdc, 0xf5, 0xbf, 0x24, 0x28, 0x58, 0x09,
+	0x85, 0x87, 0xf4, 0xe7, 0xef, 0x19, 0x68, 0x4a, 0x16, 0x39, 0x24, 0xb4, 0x8a, 0x97, 0x0a, 0x39,
+	0x38, 0x90, 0xa9, 0xf6, 0x4e, 0x84, 0x48, 0xa2, 0x13, 0xa0, 0x70, 0x05, 0x4d, 0x86, 0x23, 0x8d,
+	0x90, 0xd7, 0x51, 0x0d, 0xb3, 0x65, 0x03, 0x9f, 0x2f, 0xca, 0xa3, 0x98, 0xdc, 0x59, 0x1a, 0x71,
+	0x6a, 0x24, 0xb5, 0x41, 0x25, 0x65, 0xbe, 0x85, 0xa6, 0x45, 0x2f, 0xf1, 0x9a, 0xd6, 0xe4, 0x28,
+	0xb4, 0xe7, 0x1f, 0xdc, 0xbf, 0x38, 0xfd, 0x9a, 0x5a, 0x1f, 0x74, 0x72, 0xe6, 0x6d, 0x74, 0xae,
+	0x15, 0x37, 0x4f, 0xc8, 0x9a, 0x67, 0x11, 0x87, 0xe4, 0x16, 0xac, 0x89, 0xa1, 0x78, 0x41, 0xb4,
+	0xd0, 0xb9, 0x54, 0x23, 0x0a, 0x2c, 0x18, 0x52, 0x7b, 0xc8, 0xbc, 0x50, 0x3d, 0xd6, 0xbc, 0xf0,
+	0x5d, 0x75, 0x5e, 0x40, 0x4c, 0x25, 0x3a, 0xf9, 0xaa, 0xc4, 0x49, 0x57, 0x57, 0xb5, 0xd3, 0x62,
+	0x7e, 0xbe, 0x65, 0xa0, 0xa7, 0x87, 0x0e, 0x87, 0x94, 0x0d, 0x37, 0x8e, 0x69, 0xc3, 0x27, 0x46,
+	0xb1, 0xe1, 0xf5, 0x3f, 0x2b, 0xa1, 0x33, 0x4b, 0xd8, 0x25, 0x5e, 0x1b, 0x6b, 0x96, 0xf0, 0x23,
+	0xa8, 0x42, 0x23, 0xba, 0xed, 0xbe, 0x1b, 0xfb, 0x68, 0xb2, 0x2b, 0x9a, 0xa2, 0x1c, 0x24, 0x86,
+	0xf4, 0x3e, 0xef, 0x60, 0xd7, 0x9a, 0xd0, 0xb1, 0x57, 0x45, 0x39, 0x48, 0x0c, 0xf3, 0x65, 0x34,
+	0x23, 0xdc, 0x2a, 0xdf, 0x5b, 0xc6, 0x11, 0x09, 0xad, 0x02, 0x1b, 0xda, 0x26, 0x95, 0x77, 0x45,
+	0x83, 0x40, 0x0a, 0x93, 0x72, 0xa2, 0xe1, 0xe6, 0x7b, 0xbe, 0x17, 0x7b, 0x05, 0x92, 0xd3, 0x96,
+	0x28, 0x07, 0x89, 0x61, 0x7e, 0x73, 0xd0, 0x2f, 0xf8, 0xc2, 0x09, 0xb5, 0x24, 0xa3, 0xb1, 0x46,
+	0xd0, 0xd9, 0xdf, 0x32, 0x50, 0xad, 0x47, 0x82, 0xd0, 0x09, 0x23, 0xe2, 0xd9, 0x44, 0x98, 0xaa,
+	0x1b, 0x79, 0x68, 0xee, 0x66, 0x42, 0x96, 0x1b, 0x35, 0xa5, 0x00, 0x54, 0xa6, 0xca, 0xc0, 0xa9,
+	0x9c, 0x96, 0x81, 0xb3, 0x8f, 0xce, 0x2e, 0xe1, 0xc8, 0xde, 0xe9, 0xf7, 0x78, 0xfc, 0xa0, 0x1f,
+	0xe0, 0xc8, 0xf1, 0x3d, 0xea, 0x23, 0x12, 0x8f, 0xc6, 0x00, 0xda, 0xe9, 0xa8, 0xca, 0x0a, 0x2f,
+	0x86, 0x18, 0x4e, 0xf7, 0x1c, 0xba, 0x78, 0x7f, 0x59, 0xd4, 0xb4, 0x26, 0xf4, 0x3d, 0x87, 0xf5,
+	0x04, 0x04, 0x2a, 0x5e, 0xfd, 0x4b, 0xe8, 0x2c, 0x67, 0xb9, 0x8e, 0x7b, 0x4a, 0x8b, 0x1e, 0x21,
+	0x80, 0xb1, 0x8c, 0xe6, 0xec, 0x80, 0xe0, 0x88, 0xac, 0x6e, 0x6f, 0xf8, 0xd1, 0xca, 0xbe, 0x13,
+	0x46, 0x22, 0x92, 0x61, 0x09, 0xec, 0xb9, 0xa5, 0x14, 0x1c, 0x06, 0x6a, 0xd4, 0xbf, 0x3d, 0x89,
+	0xcc, 0x95, 0xae, 0x13, 0x45, 0xfa, 0x4a, 0xe5, 0x39, 0x54, 0x6e, 0x05, 0xfe, 0x2e, 0x09, 0x84,
+	0x00, 0x32, 0x1a, 0xb1, 0xc8, 0x4a, 0x41, 0x40, 0xa9, 0x4d, 0xa1, 0xd1, 0x28, 0x8f, 0xb8, 0xc9,
+	0xda, 0x42, 0xda, 0x94, 0x25, 0x09, 0x01, 0x05, 0x8b, 0xed, 0xce, 0xf0, 0x7f, 0xcc, 0xf9, 0x2e,
+	0xa4, 0x76, 0x67, 0x12, 0x10, 0xa8, 0x78, 0x9a, 0x1b, 0x55, 0xcc, 0xdb, 0x8d, 0x2a, 0xe5, 0xe0,
+	0x46, 0x65, 0xef, 0x5a, 0x94, 0x1f, 0xcb, 0xae, 0xc5, 0xe4, 0x51, 0x77, 0x2d, 0x2a, 0x39, 0xef,
+	0x5a, 0x7c, 0x43, 0x35, 0x89, 0x55, 0x66, 0x12, 0xdf, 0x3e, 0xe9, 0xf8, 0x1f, 0x50, 0xcf, 0x63,
+	0xcd, 0xe2, 0xe8, 0xb4, 0x18, 0xa3, 0xf7, 0x26, 0xd0, 0x5c, 0xda, 0xe4, 0x9a, 0xf7, 0xd0, 0xa4,
+	0xcd, 0x2d, 0x94, 0x70, 0x1d, 0x9a, 0x27, 0x9e, 0x68, 0x06, 0xed, 0x9d, 0x08, 0xed, 0x73, 0x08,
+	0xc4, 0x0c, 0xcd, 0xaf, 0x18, 0xa8, 0x6a, 0xc7, 0x46, 0xca, 0x9a, 0xc8, 0x87, 0x7d, 0x86, 0xd1,
+	0xe3, 0xf1, 0x7a, 0x09, 0x81, 0x84, 0x69, 0xfd, 0x27, 0x13, 0xa8, 0xa6, 0xda, 0xa7, 0x2f, 0x28,
+	0x5a, 0xc6, 0xdb, 0xe3, 0x63, 0xca, 0xd8, 0x95, 0x5b, 0xc8, 0x89, 0x10, 0x14, 0x9b, 0x8e, 0xe6,
+	0x1b, 0x2d, 0xba, 0xb4, 0xa1, 0x9d, 0x93, 0xd8, 0xa9, 0xa4, 0x4c, 0x51, 0x9c, 0x1e, 0x2a, 0x86,
+	0x3d, 0x62, 0x8b, 0xcf, 0xdd, 0xc8, 0x4f, 0x6d, 0x9a, 0x3d, 0x62, 0x27, 0x06, 0x9d, 0xfe, 0x03,
+	0xc6, 0xc9, 0xdc, 0x47, 0xe5, 0x30, 0xc2, 0x51, 0x3f, 0xb4, 0x0a, 0x79, 0xab, 0x6a, 0x93, 0xd1,
+	0x4d, 0xac, 0x38, 0xff, 0x0f, 0x82, 0x5f, 0xfd, 0x2a, 0x9a, 0x1f, 0xd0, 0x6b, 0x6a, 0xda, 0xc9,
+	0x7e, 0x2f, 0x20, 0x21, 0x5d, 0x1d, 0xa5, 0x97, 0x8b, 0x2b, 0x12, 0x02, 0x0a, 0x56, 0xfd, 0xa7,
+	0x06, 0x9a, 0x55, 0x28, 0xad, 0x39, 0x61, 0x64, 0x7e, 0x6e, 0xa0, 0xab, 0x16, 0x8e, 0xd6, 0x55,
+	0xb4, 0x36, 0xeb, 0x28, 0x39, 0xbe, 0xe3, 0x12, 0xa5, 0x9b, 0x7c, 0x54, 0x72, 0x22, 0xd2, 0x0d,
+	0x45, 0x44, 0xe9, 0xd5, 0xfc, 0xda, 0x2c, 0x89, 0x84, 0xac, 0x52, 0x06, 0xc0, 0xf9, 0xd4, 0xbf,
+	0xfa, 0x19, 0xed, 0x13, 0x69, 0xff, 0xb1, 0xcd, 0x71, 0x5a, 0xb4, 0xd8, 0x0f, 0x37, 0x92, 0x49,
+	0x3b, 0xd9, 0x1c, 0x57, 0x60, 0xa0, 0x61, 0x9a, 0x7b, 0xa8, 0x12, 0x91, 0x6e, 0xcf, 0xc5, 0x51,
+	0x1c, 0x51, 0xbf, 0x7a, 0xc2, 0x2f, 0xd8, 0x12, 0xe4, 0xf8, 0x2c, 0x15, 0xff, 0x03, 0xc9, 0xc6,
+	0xec, 0xa2, 0x49, 0xea, 0xcc, 0x39, 0x36, 0x11, 0x7a, 0x76, 0xe5, 0x84, 0x1c, 0x9b, 0x9c, 0x1a,
+	0x37, 0x1e, 0xe2, 0x0f, 0xc4, 0x3c, 0xcc, 0x2f, 0xa1, 0x52, 0xd7, 0xf1, 0x1c, 0x5f, 0x78, 0xfb,
+	0x6f, 0xe4, 0x3b, 0x90, 0x16, 0xd6, 0x29, 0x6d, 0x3e, 0x0d, 0xc8, 0xfe, 0x62, 0x65, 0xc0, 0xd9,
+	0xb2, 0x6d, 0x74, 0x5b, 0x2c, 0xaa, 0xad, 0x52, 0x2e, 0xdb, 0xe8, 0x69, 0x19, 0xe4, 0x9a, 0x5d,
+	0x9f, 0x8d, 0xe2, 0x62, 0x90, 0xfc, 0xcd, 0x7b, 0xa8, 0xb8, 0xed, 0xb8, 0x74, 0x5d, 0x9e, 0x47,
+	0xe4, 0x23, 0x2d, 0xc7, 0x15, 0xc7, 0x25, 0x5c, 0x86, 0x64, 0x1f, 0xc7, 0x71, 0x09, 0x30, 0x9e,
+	0xac, 0x21, 0x02, 0xc2, 0x69, 0x58, 0x93, 0x63, 0x69, 0x08, 0x10, 0xe4, 0x53, 0x0d, 0x11, 0x17,
+	0x83, 0xe4, 0x6f, 0xfe, 0x8e, 0x91, 0x84, 0xc2, 0xf8, 0xd9, 0x86, 0x37, 0x73, 0x96, 0x45, 0xc4,
+	0x45, 0xb8, 0x28, 0x72, 0xd9, 0x3e, 0x10, 0x1c, 0xbb, 0x87, 0x8a, 0xb8, 0xbb, 0xd7, 0xb3, 0xaa,
+	0x63, 0xe9, 0x91, 0x46, 0x77, 0xaf, 0x97, 0xea, 0x11, 0xba, 0x61, 0x09, 0x8c, 0x27, 0x1d, 0x1a,
+	0xbb, 0x78, 0x7b, 0x37, 0x8e, 0x7a, 0xe4, 0x3d, 0x34, 0xae, 0x53, 0xda, 0xa9, 0xa1, 0xc1, 0xca,
+	0x80, 0xb3, 0xa5, 0xdf, 0xde, 0xdd, 0x8b, 0x22, 0xab, 0x36, 0x96, 0x6f, 0x5f, 0xdf, 0x8b, 0xa2,
+	0xd4, 0xb7, 0xaf, 0xdf, 0xdc, 0xda, 0x02, 0xc6, 0x93, 0xf2, 0xf6, 0x70, 0x14, 0x5a, 0x53, 0x63,
+	0xe1, 0xbd, 0x81, 0xa3, 0x30, 0xc5, 0x7b, 0xa3, 0xb1, 0xd5, 0x04, 0xc6, 0xd3, 0xbc, 0x83, 0x0a,
+	0xa1, 0x17, 0x5a, 0xd3, 0x8c, 0xf5, 0x6b, 0x39, 0xb3, 0x6e, 0x7a, 0x82, 0xb3, 0x3c, 0x7d, 0xd5,
+	0xdc, 0x68, 0x02, 0x65, 0xc8, 0xf8, 0xee, 0x85, 0xd6, 0xcc, 0x78, 0xf8, 0xee, 0x0d, 0xf0, 0xbd,
+	0x49, 0xf9, 0xee, 0x85, 0x34, 0x2a, 0x50, 0xee, 0xf5, 0x5b, 0xcd, 0x7e, 0xcb, 0x9a, 0x65, 0xbc,
+	0x3f, 0x9b, 0x33, 0xef, 0x4d, 0x46, 0x9c, 0xb3, 0x97, 0x6b, 0x0c, 0x5e, 0x08, 0x82, 0x33, 0x13,
+	0x82, 0x73, 0xb5, 0xe6, 0xc6, 0x22, 0xc4, 0x55, 0x46, 0x2d, 0x25, 0x04, 0x2f, 0x04, 0xc1, 0x39,
+	0x16, 0xc2, 0xc5, 0x2d, 0x6b, 0x7e, 0x5c, 0x42, 0xb8, 0x38, 0x43, 0x08, 0x17, 0x73, 0x21, 0x5c,
+	0xdc, 0xa2, 0xaa, 0xbf, 0xd3, 0xde, 0x0e, 0x2d, 0x73, 0x2c, 0xaa, 0x7f, 0xad, 0xbd, 0x9d, 0x56,
+	0xfd, 0x6b, 0xcb, 0x57, 0x9a, 0xc0, 0x78, 0x52, 0x93, 0x13, 0xba, 0xd8, 0xde, 0xb5, 0xce, 0x8c,
+	0xc5, 0xe4, 0x34, 0x29, 0xed, 0x94, 0xc9, 0x61, 0x65, 0xc0, 0xd9, 0x9a, 0x7f, 0x60, 0xa0, 0x5a,
+	0x18, 0xf9, 0x01, 0xee, 0x90, 0xab, 0x81, 0xd3, 0xb6, 0xce, 0xe6, 0xe3, 0x21, 0xa6, 0xc5, 0x48,
+	0x38, 0x70, 0x61, 0x64, 0x74, 0x41, 0x81, 0x80, 0x2a, 0x88, 0xf9, 0x27, 0x06, 0x9a, 0xc1, 0xda,
+	0x9e, 0xbc, 0xf5, 0x24, 0x93, 0xad, 0x95, 0xf7, 0x94, 0xa0, 0x6f, 0xfc, 0x33, 0xf1, 0x64, 0x34,
+	0x55, 0x07, 0x42, 0x4a, 0x22, 0xa6, 0xbe, 0x61, 0x14, 0x38, 0x3d, 0x62, 0x9d, 0x1b, 0x8b, 0xfa,
+	0x36, 0x19, 0xf1, 0x94, 0xfa, 0xf2, 0x42, 0x10, 0x9c, 0xd9, 0xd4, 0x4d, 0xb8, 0x4b, 0x6e, 0x3d,
+	0x35, 0x96, 0xa9, 0x3b, 0x76, 0xf8, 0xf5, 0xa9, 0x5b, 0x94, 0x42, 0xcc, 0x9c, 0xea, 0x72, 0x40,
+	0xda, 0x4e, 0x68, 0x59, 0x63, 0xd1, 0x65, 0xa0, 0xb4, 0x53, 0xba, 0xcc, 0xca, 0x80, 0xb3, 0xa5,
+	0xe6, 0xdc, 0x0b, 0xf7, 0xac, 0xa7, 0xc7, 0x62, 0xce, 0x37, 0xc2, 0xbd, 0x94, 0x39, 0xdf, 0x68,
+	0xde, 0x04, 0xca, 0x50, 0x98, 0x73, 0x37, 0xc4, 0x81, 0x75, 0x7e, 0x4c, 0xe6, 0x9c, 0x12, 0x1f,
+	0x30, 0xe7, 0xb4, 0x10, 0x04, 0x67, 0xa6, 0x05, 0xec, 0x30, 0xb6, 0x63, 0x5b, 0x1f, 0x18, 0x8b,
+	0x16, 0x5c, 0xe5, 0xd4, 0x53, 0x5a, 0x20, 0x4a, 0x21, 0x66, 0x6e, 0x3e, 0x4f, 0x57, 0xb5, 0x3d,
+	0xd7, 0xb1, 0x71, 0x68, 0x3d, 0x73, 0xc9, 0x78, 0xbe, 0xc4, 0x1d, 0x1f, 0x10, 0x65, 0x20, 0xa1,
+	0xe6, 0xf7, 0x0d, 0x34, 0x9b, 0xda, 0xcf, 0xb2, 0x9e, 0x65, 0xa2, 0xdb, 0x39, 0x8b, 0xbe, 0xa8,
+	0x73, 0xe1, 0x9f, 0xf0, 0x94, 0xf8, 0x84, 0xd9, 0xf4, 0x0e, 0x4d, 0x5a, 0x28, 0xba, 0xad, 0x50,
+	0x95, 0x65, 0xd6, 0x05, 0x26, 0xe2, 0xe7, 0xc7, 0x25, 0x22, 0x17, 0x4e, 0x1e, 0x21, 0x93, 0xe5,
+	0x90, 0x88, 0xc0, 0xac, 0x36, 0xd3, 0xf9, 0x66, 0x14, 0x10, 0xdc, 0xb5, 0x2e, 0x8e, 0xc5, 0x6a,
+	0x43, 0xc2, 0x21, 0x65, 0xb5, 0x15, 0x08, 0xa8, 0x82, 0xd0, 0x21, 0x18, 0xd9, 0x3d, 0xeb, 0xd2,
+	0x58, 0x86, 0xe0, 0x96, 0xdd, 0x4b, 0x0d, 0xc1, 0xad, 0xa5, 0x4d, 0xa0, 0x0c, 0xcf, 0xf7, 0x11,
+	0x4a, 0x1c, 0xcf, 0x8c, 0xe0, 0xde, 0x4d, 0x35, 0xb8, 0x57, 0x7b, 0xf1, 0x93, 0x23, 0x87, 0x57,
+	0x9b, 0xbf, 0xd2, 0x08, 0x22, 0x67, 0x1b, 0xdb, 0x91, 0x12, 0x19, 0x3c, 0xff, 0x2d, 0x03, 0x4d,
+	0x6b, 0xce, 0x66, 0x06, 0xeb, 0x1d, 0x9d, 0x35, 0xe4, 0xbf, 0x1f, 0xa5, 0x4a, 0xf4, 0xbb, 0x06,
+	0xaa, 0x4a, 0xb7, 0x33, 0x43, 0x9a, 0xb6, 0x2e, 0xcd, 0x49, 0xc3, 0x68, 0x8c, 0x55, 0xb6, 0x24,
+	0xb4, 0x6d, 0x34, 0xff, 0x73, 0xfc, 0x6d, 0x23, 0xd9, 0x65, 0x4b, 0xf4, 0x35, 0x03, 0x4d, 0xa9,
+	0x5e, 0x68, 0x86, 0x40, 0xb6, 0x2e, 0x50, 0xbe, 0xc7, 0x41, 0xd2, 0xfd, 0x24, 0x9d, 0xd1, 0xf1,
+	0xf7, 0x53, 0x2a, 0xd1, 0x20, 0xd5, 0x2a, 0x28, 0xf1, 0x4c, 0x33, 0x44, 0x21, 0xba, 0x28, 0x27,
+	0xdd, 0xbc, 0xe4, 0xbc, 0x86, 0x6b, 0xaf, 0x74, 0x53, 0xc7, 0xdf, 0x2a, 0xd4, 0xfd, 0x1d, 0x22,
+	0xc9, 0x57, 0x0d, 0x54, 0x95, 0x4e, 0xeb, 0xf8, 0x1b, 0x85, 0x3a, 0xc3, 0x7c, 0x59, 0x39, 0x28,
+	0xca, 0x6f, 0x1b, 0xa8, 0xd2, 0xf4, 0x86, 0x4a, 0x92, 0xb3, 0xca, 0x36, 0x37, 0x9a, 0x43, 0x9a,
+	0x84, 0xc9, 0xb1, 0xf7, 0xc8, 0xe4, 0xb8, 0x39, 0x4c, 0x8e, 0x77, 0x0d, 0x54, 0x53, 0x1c, 0xdc,
+	0x0c, 0x51, 0xb6, 0x75, 0x51, 0x4e, 0x1a, 0xb7, 0x17, 0xcc, 0x86, 0x4b, 0xa3, 0x78, 0xba, 0xe3,
+	0x97, 0x46, 0x30, 0x3b, 0x54, 0x1a, 0x17, 0x3f, 0x42, 0x69, 0x28, 0xb3, 0xe1, 0xc3, 0x59, 0xba,
+	0xbf, 0xe3, 0x1f, 0xce, 0xd4, 0xad, 0x3e, 0xc4, 0xc8, 0x25, 0xbe, 0xf0, 0xf8, 0xc7, 0x33, 0xe7,
+	0x95, 0x2d, 0xcb, 0x77, 0x0d, 0x34, 0x97, 0x76, 0x88, 0x33, 0x24, 0xda, 0xd5, 0x25, 0x3a, 0x69,
+	0xfe, 0x94, 0xca, 0x31, 0x5b, 0xae, 0x3f, 0x36, 0xd0, 0x99, 0x0c, 0x67, 0x38, 0x43, 0x34, 0x4f,
+	0x17, 0xed, 0xf5, 0x71, 0x1d, 0xbd, 0x4f, 0x6b, 0xb6, 0xe2, 0x0d, 0x8f, 0x5f, 0xb3, 0x05, 0xb3,
+	0x6c, 0x69, 0xbe, 0x61, 0xa0, 0x29, 0xd5, 0x2b, 0xce, 0x10, 0xa7, 0xa3, 0x8b, 0x73, 0x33, 0xf7,
+	0x4d, 0xf7, 0xb4, 0x7e, 0x27, 0xfe, 0xf1, 0xf8, 0xf5, 0x9b, 0xf3, 0x1a, 0x3e, 0x4f, 0xc4, 0xde,
+	0xf2, 0xf8, 0xe7, 0x89, 0x8d, 0xe6, 0xcd, 0x43, 0xe7, 0x09, 0xe9, 0x39, 0x3f, 0x8a, 0x79, 0x82,
+	0x31, 0x1b, 0xae, 0x31, 0xaa, 0x07, 0x3d, 0x7e, 0x8d, 0x89, 0xb9, 0x65, 0xcb, 0xf3, 0x3d, 0x43,
+	0x49, 0x31, 0x50, 0xdc, 0xe2, 0x0c, 0xb9, 0x7c, 0x5d, 0xae, 0x37, 0xc6, 0x76, 0x18, 0x54, 0x95,
+	0xef, 0x3d, 0x03, 0xcd, 0xe8, 0x3e, 0x71, 0x86, 0x64, 0x8e, 0x2e, 0x59, 0x73, 0x0c, 0xe9, 0x0b,
+	0x69, 0xcb, 0x9d, 0x76, 0x8a, 0xc7, 0x6f, 0xb9, 0x55, 0x8e, 0xc3, 0x47, 0x5c, 0xec, 0x1c, 0x8f,
+	0x7f, 0xc4, 0x6d, 0x2d, 0x0d, 0x71, 0x25, 0xea, 0x91, 0x76, 0x6c, 0x81, 0x9f, 0x69, 0x30, 0xdf,
+	0x96, 0xa7, 0x28, 0xf8, 0x61, 0x83, 0x8f, 0x8f, 0xee, 0x7b, 0x1f, 0x7e, 0x58, 0xe2, 0x2f, 0x4b,
+	0x68, 0x36, 0xe5, 0x87, 0xb2, 0x6c, 0x3f, 0xfa, 0x97, 0xa5, 0xc6, 0x1b, 0x7a, 0x52, 0xde, 0x4a,
+	0x0c, 0x80, 0x04, 0xc7, 0x7c, 0xcf, 0x40, 0xb3, 0x77, 0x71, 0x64, 0xef, 0x6c, 0xe2, 0x68, 0x87,
+	0x9f, 0x78, 0xc9, 0x69, 0x55, 0xf2, 0x9a, 0x4e, 0x35, 0x09, 0x3b, 0xa5, 0x00, 0x90, 0xe6, 0x4f,
+	0x0f, 0x3b, 0xf6, 0x7c, 0xd7, 0x75, 0xbc, 0x8e, 0xc8, 0x71, 0x94, 0x41, 0xb7, 0x4d, 0x5e, 0x0c,
+	0x31, 0x5c, 0xcf, 0x4d, 0x2f, 0xe6, 0xb2, 0x97, 0x9c, 0x6a, 0xd2, 0x63, 0x1d, 0xf1, 0x2a, 0x3d,
+	0xba, 0x23, 0x5e, 0xe6, 0x2e, 0x2a, 0x46, 0xd8, 0x71, 0xad, 0x72, 0x2e, 0x4a, 0x4e, 0xbf, 0x7e,
+	0x0b, 0x3b, 0xae, 0xe8, 0x34, 0x96, 0xc7, 0x43, 0xff, 0x03, 0x63, 0x72, 0xb2, 0xf3, 0x64, 0xff,
+	0x62, 0xa0, 0x19, 0x9d, 0xbe, 0xf9, 0x31, 0x54, 0xec, 0xfa, 0xed, 0x58, 0x55, 0x9f, 0x91, 0xfb,
+	0xb3, 0x7e, 0x9b, 0xfc, 0xfc, 0xfe, 0xc5, 0xa9, 0x18, 0x9b, 0xfe, 0x07, 0x86, 0x49, 0xcf, 0xa3,
+	0x76, 0x5c, 0xbf, 0xc5, 0xa9, 0x27, 0x5b, 0x4b, 0x57, 0x5d, 0xbf, 0x05, 0x0c, 0x42, 0xcf, 0x0b,
+	0xf9, 0xdb, 0xdb, 0x21, 0x89, 0x68, 0x6d, 0xab, 0xa0, 0x9f, 0x17, 0xba, 0x21, 0x21, 0xa0, 0x60,
+	0xd1, 0x83, 0x33, 0x5d, 0xbc, 0xcf, 0x1b, 0xdc, 0xb9, 0xc7, 0xcf, 0x75, 0x16, 0x92, 0x83, 0x33,
+	0xeb, 0x0a, 0x0c, 0x34, 0xcc, 0xfa, 0x3f, 0x17, 0x91, 0x39, 0x38, 0xe3, 0x3c, 0xec, 0xfe, 0x8b,
+	0xe7, 0x50, 0xd9, 0x4e, 0x06, 0x9b, 0x72, 0xac, 0x55, 0x8c, 0x09, 0x01, 0xe5, 0x07, 0xce, 0x43,
+	0x62, 0xf7, 0x03, 0x32, 0x98, 0xee, 0xcc, 0xcb, 0x41, 0x62, 0x68, 0x07, 0x2f, 0x8b, 0x0f, 0x3d,
+	0x78, 0xf9, 0x8d, 0xc1, 0x43, 0xe3, 0x6f, 0xe7, 0x3e, 0xf5, 0x8e, 0x30, 0x7c, 0x6e, 0xb1, 0xec,
+	0xe6, 0x1d, 0x91, 0x80, 0x52, 0x1e, 0x39, 0x19, 0xb2, 0x21, 0x2b, 0x83, 0x42, 0x48, 0x19, 0x95,
+	0x93, 0xa7, 0xe5, 0xe0, 0xe5, 0x3f, 0x19, 0x68, 0x86, 0xbb, 0xbb, 0x8d, 0x5e, 0x6f, 0x29, 0x20,
+	0xed, 0x90, 0x36, 0x4e, 0x2f, 0x70, 0xee, 0xe0, 0x88, 0xc4, 0x39, 0x13, 0xa3, 0x35, 0xce, 0xa6,
+	0xac, 0x0c, 0x0a, 0x21, 0x9a, 0x73, 0x87, 0x7b, 0xbd, 0xd5, 0x65, 0x26, 0x43, 0x21, 0xd9, 0x5f,
+	0x6a, 0xd0, 0x42, 0xe0, 0x30, 0x9a, 0x7b, 0xe1, 0x78, 0x61, 0x84, 0x5d, 0x97, 0x1d, 0xce, 0x5c,
+	0x5d, 0x66, 0xaa, 0x58, 0x48, 0x76, 0x0b, 0x57, 0x35, 0x28, 0xa4, 0xb0, 0xeb, 0x7f, 0x5b, 0x43,
+	0xf3, 0x03, 0xde, 0xbb, 0x79, 0x1e, 0x4d, 0x38, 0xfc, 0x34, 0x7b, 0x61, 0x11, 0x09, 0x4a, 0x13,
+	0xab, 0xcb, 0x30, 0xe1, 0xb4, 0xd5, 0xfc, 0xb4, 0x89, 0x47, 0x97, 0x9f, 0xf6, 0xd1, 0x38, 0x01,
+	0x91, 0xdb, 0x0c, 0x39, 0x61, 0x25, 0x89, 0x65, 0x5a, 0x2a, 0xe2, 0xa7, 0x10, 0x4a, 0x92, 0x4c,
+	0xac, 0xa2, 0x66, 0xc1, 0xce, 0x66, 0x25, 0xa3, 0x81, 0x82, 0x7f, 0xa4, 0x7c, 0xaf, 0x1b, 0xa8,
+	0x82, 0x7b, 0xce, 0x31, 0x92, 0xbd, 0xd8, 0xce, 0x53, 0x63, 0x73, 0x95, 0x55, 0x05, 0x49, 0x64,
+	0xec, 0x69, 0x5e, 0xaa, 0xb9, 0xaa, 0x3c, 0xd4, 0x5c, 0x3d, 0x87, 0xca, 0xd8, 0x8e, 0xe8, 0xbd,
+	0x04, 0x55, 0xfd, 0xa6, 0x81, 0x06, 0x2b, 0x05, 0x01, 0x15, 0xb7, 0x28, 0x45, 0xf1, 0xb2, 0x06,
+	0x0d, 0xdc, 0xa2, 0x14, 0x83, 0x40, 0xc5, 0x33, 0x3f, 0x89, 0xa6, 0xb9, 0xd2, 0xc4, 0xa9, 0x66,
+	0x35, 0x56, 0xf1, 0x49, 0x51, 0x71, 0xfa, 0xaa, 0x0a, 0x04, 0x1d, 0xd7, 0x6c, 0xa0, 0x59, 0x5e,
+	0x70, 0xab, 0xe7, 0xfa, 0xb8, 0x4d, 0xab, 0x4f, 0xe9, 0x5a, 0x71, 0x55, 0x07, 0x43, 0x1a, 0x7f,
+	0x48, 0x6e, 0xda, 0xf4, 0xb1, 0x72, 0xd3, 0xbe, 0xae, 0xda, 0x6a, 0x7e, 0x6e, 0xe7, 0xad, 0xbc,
+	0xe3, 0x69, 0x23, 0x98, 0xea, 0x77, 0xd2, 0x19, 0x94, 0xfc, 0x38, 0xcf, 0x49, 0x4d, 0x2b, 0x1d,
+	0x5e, 0x6d, 0x35, 0x47, 0xf2, 0x48, 0x99, 0x93, 0x1f, 0x47, 0xd3, 0x7e, 0xd0, 0xc1, 0x9e, 0x73,
+	0x8f, 0x19, 0x9c, 0x90, 0x1d, 0xeb, 0xa9, 0x72, 0x6d, 0xbd, 0xa1, 0x02, 0x40, 0xc7, 0x33, 0xef,
+	0xa1, 0x6a, 0x27, 0xb6, 0xb2, 0xd6, 0x7c, 0x2e, 0x76, 0x46, 0xb7, 0xda, 0xfc, 0x1c, 0xb9, 0x2c,
+	0x83, 0x84, 0x9d, 0x32, 0x2b, 0x99, 0xa7, 0x65, 0x56, 0xfa, 0xf7, 0x49, 0x34, 0x3f, 0x10, 0xf6,
+	0x7c, 0x4c, 0xa9, 0xc4, 0x9f, 0x40, 0x55, 0x91, 0x1c, 0x28, 0xe6, 0xae, 0xea, 0xe2, 0x07, 0x84,
+	0xaa, 0x9c, 0x19, 0xc8, 0x24, 0x5e, 0x5d, 0x86, 0x04, 0x5b, 0x31, 0xbc, 0x85, 0xa3, 0x26, 0xda,
+	0x16, 0xf3, 0x4b, 0xb4, 0x6d, 0xa2, 0x27, 0x79, 0xa2, 0x56, 0xb3, 0xb9, 0x76, 0x9b, 0x04, 0xce,
+	0xb6, 0x63, 0xf3, 0x3c, 0x2d, 0x7e, 0xd9, 0xca, 0xb3, 0xe2, 0x23, 0x9e, 0x5c, 0xc9, 0x42, 0x82,
+	0xec, 0xba, 0xc2, 0xd2, 0xb9, 0x58, 0x5a, 0xba, 0xf2, 0x80, 0xa5, 0x73, 0xb1, 0x66, 0xe9, 0x92,
+	0xbf, 0x43, 0xcc, 0x54, 0xe5, 0xe4, 0x66, 0xaa, 0x9a, 0x97, 0x99, 0x72, 0xf1, 0x31, 0xcd, 0xd4,
+	0xf3, 0xa8, 0x22, 0xfa, 0x3d, 0x64, 0x47, 0x5b, 0xab, 0x22, 0x63, 0x4a, 0x94, 0x81, 0x84, 0xd2,
+	0x0e, 0x0f, 0x59, 0x4f, 0xf2, 0x0e, 0xaf, 0x8d, 0xdc, 0xe1, 0xcd, 0xa4, 0x36, 0xa8, 0xa4, 0x94,
+	0x81, 0x3e, 0x75, 0x5a, 0x06, 0xfa, 0xf7, 0xaa, 0x68, 0x36, 0xb5, 0xa7, 0x90, 0x19, 0x27, 0x30,
+	0x1e, 0x73, 0x9c, 0xe0, 0x12, 0x2a, 0x46, 0x07, 0x3d, 0xf1, 0x01, 0x89, 0x2b, 0xc8, 0x56, 0x02,
+	0x0c, 0x42, 0x07, 0x86, 0xbd, 0x43, 0xec, 0xdd, 0x38, 0x39, 0xd7, 0x2a, 0xe8, 0x03, 0x63, 0x49,
+	0x05, 0x82, 0x8e, 0x6b, 0xfe, 0x32, 0xaa, 0xe2, 0x76, 0x3b, 0x20, 0x61, 0x28, 0xae, 0x08, 0xa8,
+	0x72, 0x7b, 0xde, 0x88, 0x0b, 0x21, 0x81, 0xd3, 0x95, 0x0f, 0x3d, 0xd7, 0x48, 0xb3, 0xfb, 0xac,
+	0x92, 0x9e, 0xaf, 0x4b, 0x9b, 0x92, 0x96, 0x83, 0xc4, 0xa0, 0x17, 0x0b, 0xed, 0x06, 0xad, 0xa5,
+	0x25, 0x6c, 0xef, 0x90, 0xe3, 0xf8, 0x3b, 0xec, 0x62, 0xa1, 0xeb, 0x3a, 0x05, 0x48, 0x93, 0x14,
+	0x5c, 0xae, 0x93, 0x83, 0x08, 0xb7, 0x8e, 0xb3, 0xde, 0x8b, 0xb9, 0xa8, 0x14, 0x20, 0x4d, 0x92,
+	0xae, 0xce, 0x76, 0x83, 0x56, 0x9c, 0xd6, 0x68, 0x55, 0xf4, 0xd5, 0xd9, 0xf5, 0x04, 0x04, 0x2a,
+	0x1e, 0x6d, 0xb0, 0xdd, 0xa0, 0x05, 0x04, 0xbb, 0x5d, 0xab, 0xaa, 0x37, 0xd8, 0x75, 0x51, 0x0e,
+	0x12, 0xc3, 0xec, 0x21, 0x93, 0x7e, 0x1d, 0xeb, 0x77, 0x99, 0x97, 0x25, 0x32, 0xe9, 0x9e, 0xcf,
+	0xfa, 0x1a, 0x89, 0xa4, 0x7e, 0xd0, 0x39, 0x6a, 0xca, 0xae, 0x0f, 0xd0, 0x81, 0x0c, 0xda, 0xe6,
+	0x1b, 0xe8, 0xa9, 0xdd, 0xa0, 0x25, 0xb2, 0x48, 0x36, 0x03, 0xc7, 0xb3, 0x9d, 0x1e, 0xe6, 0x89,
+	0xa2, 0x7c, 0x1d, 0x79, 0x51, 0x88, 0xfb, 0xd4, 0xf5, 0x6c, 0x34, 0x18, 0x56, 0x5f, 0x0f, 0x5a,
+	0x4d, 0xe5, 0x12, 0xb4, 0x4a, 0x0d, 0xd7, 0x63, 0x05, 0xad, 0xa6, 0x4f, 0x8b, 0x7d, 0xa2, 0xd7,
+	0x1b, 0xb1, 0xd3, 0x14, 0xf1, 0x05, 0xaa, 0x57, 0x03, 0xbf, 0xdf, 0xa3, 0xb1, 0xcf, 0x0e, 0xfd,
+	0xa1, 0x64, 0x3e, 0xc9, 0xd8, 0xe7, 0xd5, 0x18, 0x00, 0x09, 0x0e, 0xf5, 0x3f, 0x7c, 0xb7, 0x4d,
+	0x64, 0xba, 0xb2, 0xf4, 0x3f, 0x6e, 0xb0, 0x52, 0x10, 0x50, 0xf3, 0x2a, 0x9a, 0x0f, 0x48, 0x0b,
+	0xbb, 0xd8, 0xa3, 0xc1, 0xdd, 0x00, 0x47, 0xa4, 0x73, 0x20, 0x2c, 0xc9, 0xd3, 0xa2, 0xca, 0x3c,
+	0xa4, 0x11, 0x60, 0xb0, 0x4e, 0xfd, 0xaf, 0x2a, 0x68, 0x2e, 0x7d, 0x0c, 0xe4, 0x61, 0x91, 0xa2,
+	0xcb, 0xa8, 0xda, 0xc3, 0x41, 0xe4, 0x28, 0xc9, 0xdc, 0xf2, 0xab, 0x36, 0x63, 0x00, 0x24, 0x38,
+	0xd4, 0xa5, 0x8f, 0xfc, 0x9e, 0x63, 0x0b, 0x09, 0xa5, 0x4b, 0xbf, 0x45, 0x0b, 0x81, 0xc3, 0xb2,
+	0x33, 0x84, 0x8b, 0x8f, 0x2c, 0x43, 0x58, 0xe4, 0xfc, 0x96, 0x72, 0xce, 0xf9, 0x1d, 0xed, 0xba,
+	0xd4, 0x77, 0xd5, 0x61, 0x38, 0x99, 0xcb, 0xe1, 0xc6, 0x74, 0xe7, 0x8e, 0xe6, 0x52, 0x4d, 0xdb,
+	0xaa, 0x3e, 0x5b, 0x95, 0x5c, 0x76, 0xc3, 0x06, 0x07, 0x0a, 0xf7, 0x8c, 0xb4, 0x22, 0xd0, 0x59,
+	0x9b, 0x9b, 0xe8, 0xac, 0xeb, 0x74, 0x1d, 0xbe, 0x1f, 0x14, 0x6e, 0x92, 0xa0, 0x49, 0x6c, 0xdf,
+	0x6b, 0x33, 0x43, 0x5d, 0x48, 0x82, 0x1c, 0x6b, 0x19, 0x38, 0x90, 0x59, 0x93, 0xc6, 0xf4, 0xef,
+	0x90, 0x80, 0x65, 0x70, 0x22, 0xfd, 0x92, 0xbb, 0xdb, 0xbc, 0x18, 0x62, 0xb8, 0xf9, 0x06, 0x2a,
+	0x86, 0x38, 0x74, 0xad, 0xda, 0x71, 0x8f, 0x2c, 0x36, 0x9a, 0x6b, 0x6a, 0xf8, 0x9a, 0xfe, 0x07,
+	0x46, 0xf2, 0x34, 0x2e, 0xc6, 0xfe, 0xbe, 0x84, 0x66, 0x53, 0xe7, 0xb5, 0x1e, 0x66, 0x32, 0xa4,
+	0x05, 0x98, 0x38, 0xc4, 0x02, 0x7c, 0x04, 0x55, 0x6c, 0xd7, 0x21, 0x5e, 0xb4, 0xda, 0x16, 0x96,
+	0x22, 0xc9, 0x17, 0xe4, 0xe5, 0xcb, 0x20, 0x31, 0x1e, 0xb7, 0xbd, 0x50, 0x07, 0x76, 0xe9, 0xa8,
+	0x37, 0x0a, 0x94, 0xc7, 0x79, 0x0f, 0x72, 0x3e, 0x79, 0x8b, 0xa9, 0x8e, 0x3d, 0xd6, 0xb4, 0x7d,
+	0x6a, 0xee, 0x36, 0xf9, 0xc7, 0x09, 0x54, 0xa1, 0xe7, 0xfd, 0xd8, 0x5d, 0x84, 0x6f, 0xea, 0xb7,
+	0x2d, 0x9e, 0xe4, 0x9a, 0xde, 0xc1, 0x6b, 0x15, 0xaf, 0xd0, 0x01, 0x30, 0xf2, 0x8d, 0x8a, 0x55,
+	0x3e, 0x46, 0xa8, 0x07, 0xc7, 0xab, 0x9b, 0x4b, 0xa8, 0xe8, 0xed, 0x8e, 0x7a, 0xe9, 0x27, 0xb3,
+	0x39, 0x1b, 0x34, 0xd0, 0xce, 0x2a, 0xd3, 0xc8, 0xbd, 0x1d, 0x90, 0x36, 0xf1, 0x22, 0x47, 0xdc,
+	0xb9, 0x3e, 0x5a, 0xe4, 0x7e, 0x49, 0x56, 0x06, 0x85, 0x50, 0xfd, 0xab, 0x65, 0x34, 0x97, 0x3e,
+	0x3d, 0xf9, 0x30, 0xc3, 0xf0, 0x61, 0x34, 0x19, 0xf6, 0xd9, 0x1d, 0x03, 0xd6, 0x84, 0x6e, 0x84,
+	0x9b, 0xbc, 0x18, 0x62, 0x78, 0xf6, 0x80, 0x2f, 0x3c, 0x96, 0x01, 0x5f, 0x3c, 0xea, 0x80, 0xcf,
+	0x7b, 0x39, 0xa1, 0x2d, 0x10, 0xca, 0xb9, 0x2c, 0x10, 0xd2, 0x3d, 0x36, 0xc2, 0x88, 0x27, 0xe2,
+	0xba, 0xc6, 0xc9, 0x5c, 0xb2, 0xf3, 0xe3, 0x81, 0x38, 0x70, 0x53, 0xe3, 0x29, 0x34, 0x2c, 0x3f,
+	0x2e, 0xa1, 0x19, 0xfd, 0x38, 0x14, 0x75, 0x4a, 0x77, 0xfc, 0x30, 0x12, 0xae, 0x7a, 0xfa, 0xe1,
+	0x85, 0x6b, 0x09, 0x08, 0x54, 0xbc, 0xa3, 0xcd, 0x9c, 0x1f, 0x46, 0x93, 0xe2, 0x3a, 0x20, 0xab,
+	0xa0, 0x8f, 0x22, 0x71, 0x65, 0x10, 0xc4, 0xf0, 0xff, 0x9f, 0x36, 0xdd, 0xd0, 0xfc, 0xda, 0xe0,
+	0xb4, 0xf9, 0x66, 0xae, 0x67, 0xdf, 0xde, 0xdf, 0xb3, 0xe6, 0x1b, 0x68, 0x7e, 0x60, 0x5b, 0x24,
+	0xb9, 0x2a, 0xd5, 0x38, 0xe4, 0xaa, 0xd4, 0x8b, 0xa8, 0x44, 0x23, 0x2d, 0xfc, 0x46, 0x92, 0x2a,
+	0x9f, 0xde, 0xa8, 0xdf, 0x1b, 0x02, 0x2f, 0xaf, 0x7f, 0xbf, 0x8c, 0xe6, 0x07, 0xce, 0x78, 0x33,
+	0x87, 0x53, 0x86, 0xd6, 0x53, 0x6e, 0x74, 0x66, 0x40, 0xfd, 0x15, 0x34, 0xc3, 0x06, 0xc6, 0x66,
+	0x2a, 0x20, 0x2f, 0xb7, 0x87, 0xb7, 0x34, 0x28, 0xa4, 0xb0, 0x8f, 0xe6, 0xb0, 0xbe, 0x82, 0x66,
+	0xc2, 0x7e, 0x2b, 0xb4, 0x03, 0xa7, 0x27, 0xf6, 0xa0, 0x8b, 0x3a, 0x93, 0xa6, 0x06, 0x85, 0x14,
+	0xb6, 0xd9, 0x41, 0x73, 0xc9, 0xe4, 0x29, 0x82, 0x61, 0x23, 0xdd, 0xb5, 0x75, 0x56, 0xdc, 0x63,
+	0xa6, 0x91, 0x80, 0x01, 0xa2, 0x66, 0x0b, 0x9d, 0xe7, 0x81, 0x71, 0x55, 0x20, 0x19, 0x56, 0xe7,
+	0x5e, 0x69, 0x5d, 0x08, 0x7d, 0x7e, 0x79, 0x28, 0x26, 0x1c, 0x42, 0x65, 0xc4, 0x0b, 0xb6, 0xbe,
+	0x3e, 0xf8, 0x7e, 0xc7, 0x5b, 0x79, 0x67, 0x06, 0x1c, 0x6b, 0x0c, 0x9e, 0x9a, 0xdb, 0x74, 0xff,
+	0xa1, 0x82, 0xe6, 0x07, 0x0e, 0xb9, 0xd2, 0x8d, 0x24, 0xa6, 0x9b, 0x74, 0x7a, 0x91, 0x1b, 0x49,
+	0x4c, 0x69, 0x43, 0x10, 0x90, 0x23, 0x84, 0xa8, 0xc5, 0x92, 0xad, 0x30, 0x64, 0xc9, 0xd6, 0x43,
+	0x67, 0x22, 0x37, 0xdc, 0x0a, 0xfa, 0x61, 0xb4, 0x44, 0x82, 0x28, 0x14, 0xaa, 0x5b, 0x1c, 0xf9,
+	0xd2, 0xfb, 0xad, 0xb5, 0x66, 0x9a, 0x0a, 0x64, 0x91, 0xa6, 0x0a, 0x1c, 0xb9, 0x61, 0xc3, 0x75,
+	0xfd, 0xbb, 0xf1, 0x9e, 0x7d, 0x32, 0xd9, 0x58, 0x25, 0x5d, 0x81, 0xb7, 0xd6, 0x9a, 0x43, 0x30,
+	0xe1, 0x10, 0x2a, 0xe6, 0x3a, 0xfb, 0xaa, 0xdb, 0xd8, 0x75, 0xda, 0x98, 0x6e, 0x21, 0x85, 0x11,
+	0x8b, 0x1d, 0xf3, 0xd1, 0x21, 0x37, 0xf2, 0xb6, 0xd6, 0x9a, 0x69, 0x14, 0xc8, 0xaa, 0x37, 0xae,
+	0x87, 0x6f, 0x32, 0x67, 0xef, 0xca, 0x63, 0x99, 0xbd, 0xab, 0xa3, 0x8d, 0x72, 0x94, 0xd3, 0x28,
+	0x4f, 0xa9, 0xfc, 0x08, 0xa3, 0xbc, 0x8d, 0x66, 0xe5, 0x7d, 0xf4, 0x42, 0x67, 0x6b, 0x23, 0xef,
+	0x3d, 0x34, 0x74, 0x0a, 0x90, 0x26, 0x79, 0x1a, 0xe3, 0x39, 0x7f, 0x5e, 0x12, 0x67, 0xa9, 0x73,
+	0x58, 0xae, 0xe6, 0x7d, 0xff, 0x3e, 0x9d, 0xfb, 0xd9, 0xd2, 0xa0, 0x87, 0xed, 0xf8, 0xe4, 0xa4,
+	0x9c, 0xfb, 0x37, 0x62, 0x00, 0x24, 0x38, 0xf4, 0x10, 0x57, 0xbb, 0xc5, 0xac, 0x51, 0x29, 0x39,
+	0xc4, 0xb5, 0xbc, 0x08, 0x13, 0xed, 0x16, 0xdd, 0x7d, 0x15, 0xeb, 0xe0, 0xf8, 0x8c, 0x13, 0x63,
+	0x2b, 0x16, 0xc9, 0x21, 0x48, 0xe8, 0xb8, 0x56, 0x9e, 0x63, 0x08, 0xf0, 0xa6, 0x7b, 0xee, 0xfd,
+	0xbd, 0xf6, 0x7c, 0xa7, 0x8c, 0xce, 0x65, 0x9f, 0xc2, 0xff, 0x85, 0xd1, 0x58, 0xae, 0x80, 0x85,
+	0x4c, 0x05, 0xfc, 0x10, 0x9a, 0x0c, 0x99, 0xe0, 0xf1, 0xf6, 0x2d, 0xbf, 0x18, 0x8e, 0x17, 0x41,
+	0x0c, 0xa3, 0x07, 0x20, 0xba, 0x78, 0x7f, 0x3d, 0xec, 0x2c, 0xf9, 0x7d, 0x76, 0xd7, 0x25, 0x10,
+	0xcc, 0x2f, 0x62, 0x2d, 0x25, 0x07, 0x20, 0xd6, 0x07, 0x30, 0x20, 0xa3, 0x16, 0xdb, 0x70, 0xd6,
+	0x82, 0xf8, 0xa9, 0x93, 0x18, 0x87, 0x46, 0xdd, 0xc7, 0x34, 0x8d, 0xbd, 0x37, 0xb8, 0xfe, 0xb3,
+	0xc7, 0x92, 0x9a, 0xf1, 0xfe, 0x5e, 0x04, 0xfe, 0xa4, 0x88, 0xce, 0x64, 0x24, 0xda, 0xeb, 0x36,
+	0xd3, 0x38, 0x82, 0xcd, 0xdc, 0x93, 0xdf, 0x9e, 0xcf, 0xd9, 0xd6, 0x58, 0xa8, 0xe1, 0x1f, 0x4e,
+	0x17, 0x07, 0x67, 0xd9, 0xbe, 0x67, 0xbc, 0xd9, 0x22, 0xaa, 0x88, 0x88, 0xde, 0xcb, 0x47, 0xbb,
+	0x07, 0xf3, 0x6a, 0x06, 0x85, 0x64, 0x33, 0x28, 0x0b, 0x0a, 0x99, 0x5c, 0xcd, 0x25, 0x84, 0x64,
+	0x06, 0x4a, 0x3c, 0x36, 0x3f, 0xc8, 0x6e, 0xf3, 0x94, 0xa5, 0x3f, 0x67, 0x7b, 0xaa, 0x4a, 0x6b,
+	0xd3, 0x52, 0x50, 0xaa, 0x8d, 0xe3, 0xce, 0xf3, 0x8c, 0xee, 0x3d, 0xba, 0x4e, 0x9f, 0x4c, 0xbb,
+	0xfe, 0xa2, 0x80, 0x66, 0xf4, 0x8e, 0xa4, 0xdb, 0xd3, 0xbd, 0x80, 0x6c, 0x3b, 0xfb, 0xe9, 0xab,
+	0xaf, 0x37, 0x59, 0x29, 0x08, 0xa8, 0xe9, 0xa3, 0xb2, 0x8b, 0x5b, 0xc4, 0xe5, 0x8e, 0xfe, 0xc9,
+	0x43, 0x83, 0x49, 0xf8, 0x39, 0x66, 0xb8, 0xc6, 0xc8, 0x83, 0x60, 0x43, 0x19, 0x6e, 0x3b, 0xc4,
+	0x6d, 0xf3, 0x13, 0x74, 0xe3, 0x60, 0x78, 0x85, 0x91, 0x07, 0xc1, 0xc6, 0x7c, 0x13, 0x55, 0xf9,
+	0x7d, 0xe1, 0xed, 0xc5, 0x03, 0xe1, 0xfa, 0xfc, 0xd2, 0xd1, 0x54, 0x96, 0xde, 0x95, 0x9f, 0x0c,
+	0xc7, 0xa5, 0x98, 0x08, 0x24, 0xf4, 0xd8, 0xa3, 0x6a, 0xdb, 0x11, 0x09, 0x9a, 0x11, 0x0e, 0xe2,
+	0x37, 0xcf, 0x92, 0x47, 0xd5, 0x24, 0x04, 0x14, 0xac, 0xfa, 0xdf, 0x94, 0xd1, 0x8c, 0x7e, 0x61,
+	0xc0, 0x63, 0x3a, 0x07, 0x49, 0x9f, 0x09, 0xa0, 0x9e, 0x66, 0x23, 0xf0, 0xd2, 0x0f, 0x12, 0x6c,
+	0x89, 0x72, 0x90, 0x18, 0xf4, 0x01, 0x43, 0x7c, 0xbc, 0x97, 0xcc, 0xf8, 0xc1, 0xa7, 0xb8, 0x2e,
+	0x24, 0x64, 0x28, 0xcd, 0x30, 0x46, 0xb7, 0x8a, 0x23, 0xd3, 0x94, 0xc5, 0x90, 0x90, 0xa1, 0x9a,
+	0x1f, 0x90, 0x4e, 0xec, 0x6e, 0x2a, 0x9a, 0x0f, 0xac, 0x14, 0x04, 0x94, 0x46, 0x62, 0x03, 0xdf,
+	0x25, 0x0d, 0xd8, 0xb0, 0xca, 0x7a, 0x24, 0x16, 0x78, 0x31, 0xc4, 0xf0, 0x71, 0x44, 0x21, 0x75,
+	0x05, 0x18, 0x61, 0xf2, 0xbb, 0x8a, 0xe6, 0xef, 0x08, 0x17, 0xb6, 0xe9, 0x74, 0x3c, 0x1c, 0x25,
+	0xc7, 0xe5, 0xe5, 0x79, 0x92, 0xdb, 0x69, 0x04, 0x18, 0xac, 0x73, 0x1a, 0x67, 0xd1, 0xff, 0xa4,
+	0x23, 0x47, 0xbb, 0xe2, 0x42, 0xd7, 0x4a, 0x63, 0x0c, 0x5a, 0x39, 0x91, 0xb7, 0x56, 0x16, 0x0e,
+	0xd5, 0xca, 0x0f, 0xa2, 0x12, 0x7b, 0x06, 0xd5, 0x2a, 0xea, 0xf1, 0x4c, 0xf6, 0x3a, 0x24, 0x70,
+	0x18, 0xcd, 0x2f, 0xb8, 0x8b, 0x9d, 0x88, 0xda, 0x27, 0x7e, 0x42, 0x82, 0x6f, 0x5f, 0x15, 0xd4,
+	0xe3, 0x8f, 0x1a, 0x18, 0xd2, 0xf8, 0xa3, 0x68, 0xff, 0x68, 0x01, 0xc3, 0x57, 0xd0, 0x0c, 0x13,
+	0xb2, 0x61, 0xdb, 0x74, 0x6d, 0xbb, 0xda, 0x4e, 0xbf, 0x97, 0x75, 0x53, 0x85, 0x2e, 0x43, 0x0a,
+	0xdb, 0xfc, 0xda, 0xe0, 0x29, 0xe0, 0x37, 0x73, 0xbd, 0x15, 0x65, 0x84, 0xb1, 0xf6, 0x2c, 0x2a,
+	0xb4, 0xdd, 0x3d, 0x76, 0xe6, 0xa4, 0x92, 0x84, 0xd7, 0x96, 0xd7, 0x6e, 0x02, 0x2d, 0x7f, 0x3c,
+	0x6f, 0xeb, 0xd0, 0xee, 0x20, 0x5e, 0xbb, 0xe7, 0x3b, 0x5e, 0x24, 0xb2, 0x4a, 0xe4, 0x27, 0xac,
+	0x88, 0x72, 0x90, 0x18, 0x27, 0x1b, 0x6f, 0x5f, 0x46, 0x95, 0x58, 0xb5, 0xcd, 0x67, 0x95, 0x7a,
+	0x49, 0x5b, 0x50, 0x2d, 0x67, 0x44, 0x2e, 0xa3, 0xaa, 0xdf, 0x23, 0xda, 0xb3, 0x21, 0x72, 0xe6,
+	0xbc, 0x11, 0x03, 0x20, 0xc1, 0xa1, 0x8a, 0xce, 0xb9, 0xa6, 0x02, 0xf7, 0xb7, 0x69, 0xa1, 0x10,
+	0xa2, 0xfe, 0x15, 0x03, 0xc5, 0x77, 0x71, 0x9b, 0xcb, 0xa8, 0xd4, 0xf3, 0x83, 0x88, 0x07, 0x4c,
+	0x6b, 0x2f, 0x5e, 0xcc, 0x1e, 0x91, 0x0c, 0x77, 0xd3, 0x0f, 0xa2, 0x84, 0x22, 0xfd, 0x17, 0x02,
+	0xaf, 0x4c, 0xe5, 0xa4, 0x4f, 0xe5, 0x44, 0x24, 0x58, 0xdd, 0x4c, 0xcb, 0xb9, 0x14, 0x03, 0x20,
+	0xc1, 0xa9, 0xff, 0x57, 0x11, 0xcd, 0xa5, 0x2f, 0x26, 0xa1, 0xa9, 0x50, 0xa1, 0xd3, 0xf1, 0x1c,
+	0xaf, 0x23, 0xc2, 0x53, 0xc6, 0xc8, 0xa9, 0x50, 0x4d, 0xb5, 0x3e, 0xe8, 0xe4, 0x72, 0x3b, 0x83,
+	0xf0, 0x78, 0xde, 0x06, 0x7c, 0x77, 0x30, 0xaf, 0xfa, 0xf3, 0x39, 0x5f, 0x0d, 0xf3, 0x8b, 0x9e,
+	0x58, 0x7d, 0xb2, 0x71, 0xf7, 0xdf, 0x25, 0x74, 0x2e, 0xfb, 0xea, 0x99, 0xc7, 0xb4, 0x52, 0x4c,
+	0xd2, 0x5e, 0x26, 0x86, 0xa6, 0xbd, 0x24, 0xed, 0x5c, 0xc8, 0xe9, 0x2a, 0x19, 0xd9, 0x00, 0x87,
+	0x5b, 0x43, 0xb9, 0x86, 0x2d, 0x3e, 0x74, 0x0d, 0x4b, 0x1f, 0x04, 0xe2, 0xf7, 0x51, 0xa6, 0xd6,
+	0x86, 0x8b, 0xac, 0x14, 0x04, 0x54, 0x99, 0xad, 0xcb, 0x87, 0xce, 0xd6, 0x74, 0xf5, 0x21, 0x5f,
+	0x60, 0x9d, 0x1c, 0x7d, 0xf5, 0x11, 0xd7, 0x85, 0x84, 0x0c, 0xe5, 0x8d, 0x7b, 0x4e, 0xf2, 0xba,
+	0x5d, 0x92, 0xd8, 0xb8, 0xb9, 0x4a, 0x77, 0x76, 0x04, 0xd4, 0x7c, 0x6f, 0x70, 0xa2, 0xb4, 0xc7,
+	0x72, 0xdd, 0xd1, 0xa3, 0xf2, 0x62, 0x6d, 0x34, 0x3f, 0xd0, 0xe7, 0x47, 0xf6, 0x63, 0x9f, 0x43,
+	0xe5, 0xb0, 0xbf, 0x4d, 0xf1, 0x52, 0x39, 0xf1, 0x4d, 0x56, 0x0a, 0x02, 0x5a, 0xff, 0x76, 0x11,
+	0xcd, 0x0f, 0x5c, 0x52, 0xf4, 0x98, 0x46, 0x15, 0x8d, 0xf7, 0x31, 0x4f, 0xf2, 0x35, 0x25, 0x5d,
+	0xb9, 0xa2, 0xc4, 0xfb, 0x54, 0x20, 0xe8, 0xb8, 0xe6, 0x2a, 0x53, 0x93, 0x91, 0x7d, 0x31, 0x24,
+	0x34, 0x89, 0x4e, 0xdc, 0x82, 0x80, 0xf9, 0x02, 0xaa, 0xb1, 0x8f, 0xe0, 0x4d, 0x2e, 0x42, 0x2a,
+	0x2c, 0x31, 0x69, 0x25, 0x29, 0x06, 0x15, 0xc7, 0xfc, 0xfa, 0x60, 0xfc, 0xe4, 0xad, 0xbc, 0xaf,
+	0x8e, 0x7a, 0x54, 0x7a, 0xf7, 0x6f, 0x05, 0x34, 0xa3, 0x5f, 0xcf, 0x42, 0x77, 0x5e, 0xe9, 0x72,
+	0x21, 0xfd, 0x6e, 0x19, 0x5d, 0x49, 0x00, 0x83, 0xd0, 0xbe, 0xeb, 0xe2, 0xfd, 0x35, 0xc7, 0x23,
+	0x6b, 0xc4, 0xeb, 0x44, 0x3b, 0x8c, 0x6c, 0x29, 0xe9, 0xbb, 0x75, 0x15, 0x08, 0x3a, 0x2e, 0x8d,
+	0x7f, 0x07, 0x04, 0xb7, 0xe9, 0x7a, 0xdc, 0xef, 0x47, 0xe9, 0xb7, 0xc3, 0x20, 0x01, 0x81, 0x8a,
+	0xa7, 0x2f, 0x8d, 0x8b, 0xb9, 0x2c, 0x8d, 0xf5, 0xef, 0x7e, 0x7f, 0xcf, 0xaa, 0xdf, 0xac, 0x20,
+	0xf9, 0x92, 0x8c, 0x69, 0x0f, 0xbc, 0xe7, 0xf3, 0x89, 0x91, 0x63, 0xe6, 0xb1, 0x28, 0x7c, 0x7f,
+	0x21, 0xa3, 0x91, 0x5e, 0x45, 0xa6, 0x78, 0x40, 0x46, 0xf8, 0x37, 0xf2, 0xcd, 0xf9, 0x6a, 0xb2,
+	0x39, 0xd0, 0x1c, 0xc0, 0x80, 0x8c, 0x5a, 0xe6, 0xab, 0xec, 0xf5, 0xaa, 0x08, 0x3b, 0x9e, 0x9c,
+	0x61, 0x9f, 0x1d, 0x92, 0xbb, 0xc4, 0x91, 0xe4, 0x3b, 0x54, 0xfc, 0x2f, 0x24, 0xd5, 0xcd, 0x15,
+	0x34, 0x79, 0xc7, 0x77, 0xfb, 0x5d, 0xf9, 0x7a, 0xed, 0xf9, 0x2c, 0x4a, 0xb7, 0x19, 0x8a, 0x72,
+	0xd6, 0x9e, 0x57, 0x81, 0xb8, 0xae, 0x49, 0xd0, 0x2c, 0xdb, 0x9c, 0x77, 0xa2, 0x03, 0x61, 0xe8,
+	0x84, 0x32, 0x3c, 0x97, 0x45, 0x6e, 0xd3, 0x6f, 0x37, 0x75, 0x6c, 0xf1, 0xc4, 0xbd, 0x5e, 0x08,
+	0x69, 0x9a, 0xe6, 0x15, 0x54, 0xc1, 0xdb, 0xdb, 0x8e, 0xe7, 0x44, 0x07, 0x62, 0x97, 0xef, 0x99,
+	0x2c, 0xfa, 0x0d, 0x81, 0x23, 0xee, 0x2f, 0x10, 0xff, 0x40, 0xd6, 0x35, 0x6f, 0xa1, 0x5a, 0xe4,
+	0xbb, 0xc2, 0xff, 0x08, 0x45, 0x1c, 0xe7, 0x42, 0x16, 0xa9, 0x2d, 0x89, 0x96, 0x8c, 0xca, 0xa4,
+	0x2c, 0x04, 0x95, 0x8e, 0xf9, 0xfb, 0x06, 0x9a, 0xf2, 0xfc, 0x36, 0x89, 0x4d, 0xac, 0xd8, 0x25,
+	0x79, 0x23, 0xa7, 0x17, 0x90, 0x16, 0x36, 0x14, 0xda, 0x7c, 0x5c, 0xca, 0xbc, 0x76, 0x15, 0x04,
+	0x9a, 0x10, 0xa6, 0x87, 0xe6, 0x9c, 0x2e, 0xee, 0x90, 0xcd, 0xbe, 0x2b, 0x0e, 0x17, 0x85, 0x62,
+	0x91, 0x90, 0x99, 0xf1, 0xb6, 0xe6, 0xdb, 0xd8, 0xe5, 0x2f, 0x88, 0x01, 0xd9, 0x26, 0x01, 0x7b,
+	0xc8, 0x4c, 0xbe, 0xc0, 0xb8, 0x9a, 0xa2, 0x04, 0x03, 0xb4, 0x69, 0x58, 0xaa, 0x17, 0x38, 0x3e,
+	0xeb, 0x37, 0x17, 0x87, 0xfc, 0x05, 0x29, 0xa4, 0xa7, 0x39, 0x6d, 0xa6, 0x11, 0x60, 0xb0, 0x0e,
+	0x4f, 0xbb, 0xe5, 0x85, 0x56, 0x2d, 0xb9, 0x09, 0x3d, 0xae, 0x0b, 0x12, 0x7a, 0xfe, 0x33, 0x68,
+	0x7e, 0xa0, 0x6d, 0x46, 0x32, 0x08, 0x7f, 0x64, 0xa0, 0x74, 0x9e, 0x28, 0xf5, 0x0f, 0xdb, 0x4e,
+	0xc0, 0x08, 0x1e, 0xa4, 0x37, 0x64, 0x96, 0x63, 0x00, 0x24, 0x38, 0x6c, 0xaa, 0xc0, 0xc2, 0xfe,
+	0xab, 0x53, 0x05, 0xa6, 0x87, 0x62, 0x29, 0x84, 0xbd, 0x58, 0x4b, 0xff, 0x91, 0x0e, 0xd9, 0xef,
+	0xa5, 0xaf, 0x14, 0xda, 0x94, 0x10, 0x50, 0xb0, 0xea, 0xdf, 0x29, 0xa3, 0x19, 0x7d, 0x0d, 0xa1,
+	0xf9, 0xfd, 0xc6, 0xc3, 0xfc, 0x7e, 0xba, 0x1e, 0xea, 0x92, 0x68, 0xc7, 0x6f, 0xa7, 0xd7, 0x43,
+	0xeb, 0xac, 0x14, 0x04, 0x54, 0xce, 0x74, 0x85, 0xa1, 0x33, 0x9d, 0x38, 0x63, 0x54, 0x1c, 0x72,
+	0xc6, 0xa8, 0x83, 0xe6, 0xf8, 0x45, 0x78, 0xf4, 0x18, 0xd0, 0xb1, 0xcf, 0xc6, 0x35, 0x53, 0x24,
+	0x60, 0x80, 0x28, 0x3d, 0x14, 0xc2, 0xcb, 0x58, 0xe5, 0x63, 0xa6, 0xbd, 0x36, 0x75, 0x0a, 0x90,
+	0x26, 0x39, 0x8e, 0x50, 0xaf, 0xde, 0x8f, 0xc7, 0xbe, 0xd3, 0xa8, 0x92, 0xd7, 0x9d, 0x46, 0xbf,
+	0x81, 0xaa, 0xa1, 0x8c, 0x1c, 0x57, 0x73, 0xb9, 0xd9, 0x52, 0x7c, 0xa2, 0x0c, 0x2e, 0x8b, 0x40,
+	0x68, 0xfc, 0x17, 0x12, 0x86, 0x27, 0xbc, 0x04, 0x6c, 0x02, 0xcd, 0xa5, 0x79, 0xd1, 0x95, 0x70,
+	0x78, 0x8c, 0x28, 0x0c, 0x5b, 0x5f, 0x88, 0xe6, 0x11, 0x04, 0xe8, 0xe8, 0xc7, 0x6e, 0x87, 0x9a,
+	0x97, 0x9d, 0x6e, 0x3a, 0x3a, 0xd4, 0x88, 0x01, 0x90, 0xe0, 0xd0, 0x61, 0xb6, 0x43, 0x70, 0x5b,
+	0x5e, 0xfb, 0x23, 0x87, 0xd9, 0x35, 0x56, 0x0a, 0x02, 0xaa, 0xb8, 0x31, 0xc5, 0x43, 0xdd, 0x98,
+	0x06, 0x9a, 0x8d, 0x9c, 0x2e, 0x09, 0x23, 0xdc, 0xed, 0x71, 0x12, 0xc2, 0x53, 0x95, 0x91, 0xdd,
+	0x2d, 0x1d, 0x0c, 0x69, 0x7c, 0xfa, 0x0d, 0x7c, 0x7a, 0x8a, 0x9f, 0x4e, 0x56, 0xbe, 0x61, 0x2b,
+	0x06, 0x40, 0x82, 0xb3, 0xb8, 0xf0, 0x83, 0x9f, 0x5d, 0x78, 0xe2, 0x87, 0x3f, 0xbb, 0xf0, 0xc4,
+	0x8f, 0x7e, 0x76, 0xe1, 0x89, 0xaf, 0x3c, 0xb8, 0x60, 0xfc, 0xe0, 0xc1, 0x05, 0xe3, 0x87, 0x0f,
+	0x2e, 0x18, 0x3f, 0x7a, 0x70, 0xc1, 0xf8, 0xe9, 0x83, 0x0b, 0xc6, 0xb7, 0xff, 0xf5, 0xc2, 0x13,
+	0x9f, 0xad, 0xc4, 0x9d, 0xfd, 0x7f, 0x03, 0x00, 0x6b, 0x56, 0xb7, 0x12, 0x6f, 0x92, 0x00, 0x00,
 }
 
 func (m *AMQPConsumeConfig) Marshal() (dAtA []byte, err error) {
@@ -3352,6 +3458,37 @@ func (m *EventSourceSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TCP) > 0 {
+		keysForTCP := make([]string, 0, len(m.TCP))
+		for k := range m.TCP {
+			keysForTCP = append(keysForTCP, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForTCP)
+		for iNdEx := len(keysForTCP) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.TCP[string(keysForTCP[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForTCP[iNdEx])
+			copy(dAtA[i:], keysForTCP[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForTCP[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x82
+		}
+	}
 	if len(m.RedisStream) > 0 {
 		keysForRedisStream := make([]string, 0, len(m.RedisStream))
 		for k := range m.RedisStream {
@@ -4257,6 +4394,18 @@ func (m *FileEventSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Tail != nil {
+		{
+			size, err := m.Tail.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.Filter != nil {
 		{
 			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
@@ -4319,6 +4468,47 @@ func (m *FileEventSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FileTailConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FileTailConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FileTailConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MaxEventSize))
+	i--
+	dAtA[i] = 0x20
+	i -= len(m.OffsetFile)
+	copy(dAtA[i:], m.OffsetFile)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.OffsetFile)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Glob)
+	copy(dAtA[i:], m.Glob)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Glob)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Mode)
+	copy(dAtA[i:], m.Mode)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Mode)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *GenericEventSource) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6829,6 +7019,78 @@ func (m *StripeEventSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TCPEventSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TCPEventSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TCPEventSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Filter != nil {
+		{
+			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Metadata) > 0 {
+		keysForMetadata := make([]string, 0, len(m.Metadata))
+		for k := range m.Metadata {
+			keysForMetadata = append(keysForMetadata, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForMetadata)
+		for iNdEx := len(keysForMetadata) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Metadata[string(keysForMetadata[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForMetadata[iNdEx])
+			copy(dAtA[i:], keysForMetadata[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForMetadata[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	i -= len(m.ReadTimeout)
+	copy(dAtA[i:], m.ReadTimeout)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ReadTimeout)))
+	i--
+	dAtA[i] = 0x1a
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MaxLineLength))
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.Port)
+	copy(dAtA[i:], m.Port)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Port)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Template) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -7039,6 +7301,18 @@ func (m *WebhookContext) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Signature != nil {
+		{
+			size, err := m.Signature.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
 	if m.AuthSecret != nil {
 		{
 			size, err := m.AuthSecret.MarshalToSizedBuffer(dAtA[:i])
@@ -7122,20 +7396,80 @@ func (m *WebhookContext) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenerated(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenerated(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *WebhookSignature) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *AMQPConsumeConfig) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *WebhookSignature) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WebhookSignature) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Tolerance)
+	copy(dAtA[i:], m.Tolerance)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Tolerance)))
+	i--
+	dAtA[i] = 0x32
+	i -= len(m.TimestampHeader)
+	copy(dAtA[i:], m.TimestampHeader)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TimestampHeader)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.Prefix)
+	copy(dAtA[i:], m.Prefix)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Prefix)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Header)
+	copy(dAtA[i:], m.Header)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Header)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Algorithm)
+	copy(dAtA[i:], m.Algorithm)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Algorithm)))
+	i--
+	dAtA[i] = 0x12
+	if m.Secret != nil {
+		{
+			size, err := m.Secret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenerated(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenerated(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AMQPConsumeConfig) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -7851,6 +8185,15 @@ func (m *EventSourceSpec) Size() (n int) {
 			n += mapEntrySize + 2 + sovGenerated(uint64(mapEntrySize))
 		}
 	}
+	if len(m.TCP) > 0 {
+		for k, v := range m.TCP {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 2 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -7888,6 +8231,26 @@ func (m *FileEventSource) Size() (n int) {
 		l = m.Filter.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Tail != nil {
+		l = m.Tail.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *FileTailConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Mode)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Glob)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.OffsetFile)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.MaxEventSize))
 	return n
 }
 
@@ -8781,6 +9144,32 @@ func (m *StripeEventSource) Size() (n int) {
 	return n
 }
 
+func (m *TCPEventSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Port)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.MaxLineLength))
+	l = len(m.ReadTimeout)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Metadata) > 0 {
+		for k, v := range m.Metadata {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if m.Filter != nil {
+		l = m.Filter.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *Template) Size() (n int) {
 	if m == nil {
 		return 0
@@ -8888,6 +9277,33 @@ func (m *WebhookContext) Size() (n int) {
 		l = m.AuthSecret.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Signature != nil {
+		l = m.Signature.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *WebhookSignature) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Secret != nil {
+		l = m.Secret.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Algorithm)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Header)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Prefix)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.TimestampHeader)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Tolerance)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -9504,6 +9920,16 @@ func (this *EventSourceSpec) String() string {
 		mapStringForRedisStream += fmt.Sprintf("%v: %v,", k, this.RedisStream[k])
 	}
 	mapStringForRedisStream += "}"
+	keysForTCP := make([]string, 0, len(this.TCP))
+	for k := range this.TCP {
+		keysForTCP = append(keysForTCP, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForTCP)
+	mapStringForTCP := "map[string]TCPEventSource{"
+	for _, k := range keysForTCP {
+		mapStringForTCP += fmt.Sprintf("%v: %v,", k, this.TCP[k])
+	}
+	mapStringForTCP += "}"
 	s := strings.Join([]string{`&EventSourceSpec{`,
 		`EventBusName:` + fmt.Sprintf("%v", this.EventBusName) + `,`,
 		`Template:` + strings.Replace(this.Template.String(), "Template", "Template", 1) + `,`,
@@ -9536,6 +9962,7 @@ func (this *EventSourceSpec) String() string {
 		`BitbucketServer:` + mapStringForBitbucketServer + `,`,
 		`Bitbucket:` + mapStringForBitbucket + `,`,
 		`RedisStream:` + mapStringForRedisStream + `,`,
+		`TCP:` + mapStringForTCP + `,`,
 		`}`,
 	}, "")
 	return s
@@ -9570,6 +9997,20 @@ func (this *FileEventSource) String() string {
 		`Polling:` + fmt.Sprintf("%v", this.Polling) + `,`,
 		`Metadata:` + mapStringForMetadata + `,`,
 		`Filter:` + strings.Replace(this.Filter.String(), "EventSourceFilter", "EventSourceFilter", 1) + `,`,
+		`Tail:` + strings.Replace(this.Tail.String(), "FileTailConfig", "FileTailConfig", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FileTailConfig) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FileTailConfig{`,
+		`Mode:` + fmt.Sprintf("%v", this.Mode) + `,`,
+		`Glob:` + fmt.Sprintf("%v", this.Glob) + `,`,
+		`OffsetFile:` + fmt.Sprintf("%v", this.OffsetFile) + `,`,
+		`MaxEventSize:` + fmt.Sprintf("%v", this.MaxEventSize) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -10200,6 +10641,30 @@ func (this *StripeEventSource) String() string {
 	}, "")
 	return s
 }
+func (this *TCPEventSource) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForMetadata := make([]string, 0, len(this.Metadata))
+	for k := range this.Metadata {
+		keysForMetadata = append(keysForMetadata, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForMetadata)
+	mapStringForMetadata := "map[string]string{"
+	for _, k := range keysForMetadata {
+		mapStringForMetadata += fmt.Sprintf("%v: %v,", k, this.Metadata[k])
+	}
+	mapStringForMetadata += "}"
+	s := strings.Join([]string{`&TCPEventSource{`,
+		`Port:` + fmt.Sprintf("%v", this.Port) + `,`,
+		`MaxLineLength:` + fmt.Sprintf("%v", this.MaxLineLength) + `,`,
+		`ReadTimeout:` + fmt.Sprintf("%v", this.ReadTimeout) + `,`,
+		`Metadata:` + mapStringForMetadata + `,`,
+		`Filter:` + strings.Replace(this.Filter.String(), "EventSourceFilter", "EventSourceFilter", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Template) String() string {
 	if this == nil {
 		return "nil"
@@ -10280,6 +10745,22 @@ func (this *WebhookContext) String() string {
 		`ServerKeySecret:` + strings.Replace(fmt.Sprintf("%v", this.ServerKeySecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
 		`Metadata:` + mapStringForMetadata + `,`,
 		`AuthSecret:` + strings.Replace(fmt.Sprintf("%v", this.AuthSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`Signature:` + strings.Replace(this.Signature.String(), "WebhookSignature", "WebhookSignature", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WebhookSignature) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WebhookSignature{`,
+		`Secret:` + strings.Replace(fmt.Sprintf("%v", this.Secret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`Algorithm:` + fmt.Sprintf("%v", this.Algorithm) + `,`,
+		`Header:` + fmt.Sprintf("%v", this.Header) + `,`,
+		`Prefix:` + fmt.Sprintf("%v", this.Prefix) + `,`,
+		`TimestampHeader:` + fmt.Sprintf("%v", this.TimestampHeader) + `,`,
+		`Tolerance:` + fmt.Sprintf("%v", this.Tolerance) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -18300,59 +18781,9 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 			}
 			m.RedisStream[mapkey] = *mapvalue
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventSourceStatus) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventSourceStatus: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSourceStatus: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 32:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TCP", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18379,179 +18810,11 @@ func (m *EventSourceStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *FileEventSource) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: FileEventSource: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FileEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventType", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.EventType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WatchPathConfig", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.WatchPathConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Polling", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Polling = bool(v != 0)
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Metadata == nil {
-				m.Metadata = make(map[string]string)
+			if m.TCP == nil {
+				m.TCP = make(map[string]TCPEventSource)
 			}
 			var mapkey string
-			var mapvalue string
+			mapvalue := &TCPEventSource{}
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -18600,7 +18863,7 @@ func (m *FileEventSource) Unmarshal(dAtA []byte) error {
 					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
+					var mapmsglen int
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowGenerated
@@ -18610,24 +18873,26 @@ func (m *FileEventSource) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
+						mapmsglen |= int(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
+					if mapmsglen < 0 {
 						return ErrInvalidLengthGenerated
 					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
 						return ErrInvalidLengthGenerated
 					}
-					if postStringIndexmapvalue > l {
+					if postmsgIndex > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
+					mapvalue = &TCPEventSource{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -18643,11 +18908,61 @@ func (m *FileEventSource) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Metadata[mapkey] = mapvalue
+			m.TCP[mapkey] = *mapvalue
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSourceStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSourceStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSourceStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18674,10 +18989,7 @@ func (m *FileEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Filter == nil {
-				m.Filter = &EventSourceFilter{}
-			}
-			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -18702,7 +19014,7 @@ func (m *FileEventSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GenericEventSource) Unmarshal(dAtA []byte) error {
+func (m *FileEventSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18725,15 +19037,15 @@ func (m *GenericEventSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenericEventSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: FileEventSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenericEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FileEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EventType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18761,13 +19073,13 @@ func (m *GenericEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			m.EventType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WatchPathConfig", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18777,27 +19089,28 @@ func (m *GenericEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Config = string(dAtA[iNdEx:postIndex])
+			if err := m.WatchPathConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Polling", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -18814,28 +19127,8 @@ func (m *GenericEventSource) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Insecure = bool(v != 0)
+			m.Polling = bool(v != 0)
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JSONBody", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.JSONBody = bool(v != 0)
-		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
@@ -18962,9 +19255,9 @@ func (m *GenericEventSource) Unmarshal(dAtA []byte) error {
 			}
 			m.Metadata[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18991,16 +19284,16 @@ func (m *GenericEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AuthSecret == nil {
-				m.AuthSecret = &v1.SecretKeySelector{}
+			if m.Filter == nil {
+				m.Filter = &EventSourceFilter{}
 			}
-			if err := m.AuthSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tail", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19027,10 +19320,10 @@ func (m *GenericEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Filter == nil {
-				m.Filter = &EventSourceFilter{}
+			if m.Tail == nil {
+				m.Tail = &FileTailConfig{}
 			}
-			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Tail.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -19055,7 +19348,7 @@ func (m *GenericEventSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GithubAppCreds) Unmarshal(dAtA []byte) error {
+func (m *FileTailConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19078,17 +19371,17 @@ func (m *GithubAppCreds) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GithubAppCreds: wiretype end group for non-group")
+			return fmt.Errorf("proto: FileTailConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GithubAppCreds: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FileTailConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrivateKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19098,33 +19391,29 @@ func (m *GithubAppCreds) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PrivateKey == nil {
-				m.PrivateKey = &v1.SecretKeySelector{}
-			}
-			if err := m.PrivateKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Mode = FileTailMode(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Glob", wireType)
 			}
-			m.AppID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19134,16 +19423,61 @@ func (m *GithubAppCreds) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AppID |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Glob = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OffsetFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OffsetFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InstallationID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEventSize", wireType)
 			}
-			m.InstallationID = 0
+			m.MaxEventSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19153,7 +19487,7 @@ func (m *GithubAppCreds) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.InstallationID |= int64(b&0x7F) << shift
+				m.MaxEventSize |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -19179,7 +19513,7 @@ func (m *GithubAppCreds) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GithubEventSource) Unmarshal(dAtA []byte) error {
+func (m *GenericEventSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19202,70 +19536,15 @@ func (m *GithubEventSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GithubEventSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: GenericEventSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GithubEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GenericEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
-			}
-			m.ID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ID |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Webhook", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Webhook == nil {
-				m.Webhook = &WebhookContext{}
-			}
-			if err := m.Webhook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedOwner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19293,11 +19572,11 @@ func (m *GithubEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DeprecatedOwner = string(dAtA[iNdEx:postIndex])
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedRepository", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19325,13 +19604,13 @@ func (m *GithubEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DeprecatedRepository = string(dAtA[iNdEx:postIndex])
+			m.Config = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19341,29 +19620,17 @@ func (m *GithubEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Events = append(m.Events, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field APIToken", wireType)
+			m.Insecure = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JSONBody", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19373,31 +19640,15 @@ func (m *GithubEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.APIToken == nil {
-				m.APIToken = &v1.SecretKeySelector{}
-			}
-			if err := m.APIToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
+			m.JSONBody = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WebhookSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19424,219 +19675,27 @@ func (m *GithubEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WebhookSecret == nil {
-				m.WebhookSecret = &v1.SecretKeySelector{}
-			}
-			if err := m.WebhookSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Insecure = bool(v != 0)
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Active = bool(v != 0)
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ContentType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GithubBaseURL", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GithubBaseURL = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GithubUploadURL", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GithubUploadURL = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeleteHookOnFinish", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.DeleteHookOnFinish = bool(v != 0)
-		case 14:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Metadata == nil {
-				m.Metadata = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
 				fieldNum := int32(wire >> 3)
 				if fieldNum == 1 {
@@ -19714,75 +19773,9 @@ func (m *GithubEventSource) Unmarshal(dAtA []byte) error {
 			}
 			m.Metadata[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 15:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repositories", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Repositories = append(m.Repositories, OwnedRepositories{})
-			if err := m.Repositories[len(m.Repositories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 16:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Organizations", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Organizations = append(m.Organizations, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 17:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GithubApp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthSecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19809,14 +19802,14 @@ func (m *GithubEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GithubApp == nil {
-				m.GithubApp = &GithubAppCreds{}
+			if m.AuthSecret == nil {
+				m.AuthSecret = &v1.SecretKeySelector{}
 			}
-			if err := m.GithubApp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AuthSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 18:
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 			}
@@ -19873,7 +19866,7 @@ func (m *GithubEventSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GitlabEventSource) Unmarshal(dAtA []byte) error {
+func (m *GithubAppCreds) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19896,13 +19889,156 @@ func (m *GitlabEventSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GitlabEventSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: GithubAppCreds: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GitlabEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GithubAppCreds: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrivateKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PrivateKey == nil {
+				m.PrivateKey = &v1.SecretKeySelector{}
+			}
+			if err := m.PrivateKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppID", wireType)
+			}
+			m.AppID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppID |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstallationID", wireType)
+			}
+			m.InstallationID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InstallationID |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GithubEventSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GithubEventSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GithubEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Webhook", wireType)
 			}
@@ -19938,9 +20074,9 @@ func (m *GitlabEventSource) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedProjectID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedOwner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19968,11 +20104,11 @@ func (m *GitlabEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DeprecatedProjectID = string(dAtA[iNdEx:postIndex])
+			m.DeprecatedOwner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedRepository", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20000,13 +20136,13 @@ func (m *GitlabEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Events = append(m.Events, string(dAtA[iNdEx:postIndex]))
+			m.DeprecatedRepository = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20016,33 +20152,29 @@ func (m *GitlabEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AccessToken == nil {
-				m.AccessToken = &v1.SecretKeySelector{}
-			}
-			if err := m.AccessToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Events = append(m.Events, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableSSLVerification", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field APIToken", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20052,17 +20184,33 @@ func (m *GitlabEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.EnableSSLVerification = bool(v != 0)
-		case 6:
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.APIToken == nil {
+				m.APIToken = &v1.SecretKeySelector{}
+			}
+			if err := m.APIToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GitlabBaseURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WebhookSecret", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20072,27 +20220,31 @@ func (m *GitlabEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GitlabBaseURL = string(dAtA[iNdEx:postIndex])
+			if m.WebhookSecret == nil {
+				m.WebhookSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.WebhookSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeleteHookOnFinish", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -20109,12 +20261,12 @@ func (m *GitlabEventSource) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.DeleteHookOnFinish = bool(v != 0)
+			m.Insecure = bool(v != 0)
 		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20124,25 +20276,161 @@ func (m *GitlabEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Metadata == nil {
-				m.Metadata = make(map[string]string)
+			m.Active = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
 			}
-			var mapkey string
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GithubBaseURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GithubBaseURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GithubUploadURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GithubUploadURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteHookOnFinish", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DeleteHookOnFinish = bool(v != 0)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
+			}
+			var mapkey string
 			var mapvalue string
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
@@ -20237,9 +20525,43 @@ func (m *GitlabEventSource) Unmarshal(dAtA []byte) error {
 			}
 			m.Metadata[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 10:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Projects", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repositories", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Repositories = append(m.Repositories, OwnedRepositories{})
+			if err := m.Repositories[len(m.Repositories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Organizations", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20267,11 +20589,11 @@ func (m *GitlabEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Projects = append(m.Projects, string(dAtA[iNdEx:postIndex]))
+			m.Organizations = append(m.Organizations, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 11:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecretToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GithubApp", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20298,14 +20620,14 @@ func (m *GitlabEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SecretToken == nil {
-				m.SecretToken = &v1.SecretKeySelector{}
+			if m.GithubApp == nil {
+				m.GithubApp = &GithubAppCreds{}
 			}
-			if err := m.SecretToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GithubApp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 18:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 			}
@@ -20362,7 +20684,7 @@ func (m *GitlabEventSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HDFSEventSource) Unmarshal(dAtA []byte) error {
+func (m *GitlabEventSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20385,15 +20707,15 @@ func (m *HDFSEventSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HDFSEventSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: GitlabEventSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HDFSEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GitlabEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WatchPathConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Webhook", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20420,13 +20742,16 @@ func (m *HDFSEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.WatchPathConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Webhook == nil {
+				m.Webhook = &WebhookContext{}
+			}
+			if err := m.Webhook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedProjectID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20454,11 +20779,11 @@ func (m *HDFSEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.DeprecatedProjectID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CheckInterval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20486,13 +20811,13 @@ func (m *HDFSEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CheckInterval = string(dAtA[iNdEx:postIndex])
+			m.Events = append(m.Events, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessToken", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20502,29 +20827,33 @@ func (m *HDFSEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			if m.AccessToken == nil {
+				m.AccessToken = &v1.SecretKeySelector{}
+			}
+			if err := m.AccessToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HDFSUser", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableSSLVerification", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20534,131 +20863,15 @@ func (m *HDFSEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.HDFSUser = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KrbCCacheSecret", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.KrbCCacheSecret == nil {
-				m.KrbCCacheSecret = &v1.SecretKeySelector{}
-			}
-			if err := m.KrbCCacheSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KrbKeytabSecret", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.KrbKeytabSecret == nil {
-				m.KrbKeytabSecret = &v1.SecretKeySelector{}
-			}
-			if err := m.KrbKeytabSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KrbUsername", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.KrbUsername = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KrbRealm", wireType)
+			m.EnableSSLVerification = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GitlabBaseURL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20686,49 +20899,13 @@ func (m *HDFSEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KrbRealm = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KrbConfigConfigMap", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.KrbConfigConfigMap == nil {
-				m.KrbConfigConfigMap = &v1.ConfigMapKeySelector{}
-			}
-			if err := m.KrbConfigConfigMap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.GitlabBaseURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KrbServicePrincipalName", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteHookOnFinish", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20738,25 +20915,13 @@ func (m *HDFSEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.KrbServicePrincipalName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 12:
+			m.DeleteHookOnFinish = bool(v != 0)
+		case 9:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
@@ -20883,7 +21048,75 @@ func (m *HDFSEventSource) Unmarshal(dAtA []byte) error {
 			}
 			m.Metadata[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 13:
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Projects", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Projects = append(m.Projects, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretToken", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SecretToken == nil {
+				m.SecretToken = &v1.SecretKeySelector{}
+			}
+			if err := m.SecretToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 			}
@@ -20940,7 +21173,7 @@ func (m *HDFSEventSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KafkaConsumerGroup) Unmarshal(dAtA []byte) error {
+func (m *HDFSEventSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20963,17 +21196,17 @@ func (m *KafkaConsumerGroup) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KafkaConsumerGroup: wiretype end group for non-group")
+			return fmt.Errorf("proto: HDFSEventSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KafkaConsumerGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HDFSEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WatchPathConfig", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20983,29 +21216,30 @@ func (m *KafkaConsumerGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupName = string(dAtA[iNdEx:postIndex])
+			if err := m.WatchPathConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Oldest", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21015,15 +21249,27 @@ func (m *KafkaConsumerGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Oldest = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RebalanceStrategy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckInterval", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21051,61 +21297,11 @@ func (m *KafkaConsumerGroup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RebalanceStrategy = string(dAtA[iNdEx:postIndex])
+			m.CheckInterval = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *KafkaEventSource) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: KafkaEventSource: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KafkaEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21133,11 +21329,11 @@ func (m *KafkaEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HDFSUser", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21165,13 +21361,13 @@ func (m *KafkaEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Partition = string(dAtA[iNdEx:postIndex])
+			m.HDFSUser = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KrbCCacheSecret", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21181,27 +21377,31 @@ func (m *KafkaEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Topic = string(dAtA[iNdEx:postIndex])
+			if m.KrbCCacheSecret == nil {
+				m.KrbCCacheSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.KrbCCacheSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionBackoff", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KrbKeytabSecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21228,16 +21428,80 @@ func (m *KafkaEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConnectionBackoff == nil {
-				m.ConnectionBackoff = &common.Backoff{}
+			if m.KrbKeytabSecret == nil {
+				m.KrbKeytabSecret = &v1.SecretKeySelector{}
 			}
-			if err := m.ConnectionBackoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.KrbKeytabSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KrbUsername", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KrbUsername = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KrbRealm", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KrbRealm = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KrbConfigConfigMap", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21264,18 +21528,18 @@ func (m *KafkaEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TLS == nil {
-				m.TLS = &common.TLSConfig{}
+			if m.KrbConfigConfigMap == nil {
+				m.KrbConfigConfigMap = &v1.ConfigMapKeySelector{}
 			}
-			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.KrbConfigConfigMap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JSONBody", wireType)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KrbServicePrincipalName", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21285,13 +21549,25 @@ func (m *KafkaEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.JSONBody = bool(v != 0)
-		case 7:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KrbServicePrincipalName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
@@ -21418,9 +21694,9 @@ func (m *KafkaEventSource) Unmarshal(dAtA []byte) error {
 			}
 			m.Metadata[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 8:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerGroup", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21447,35 +21723,66 @@ func (m *KafkaEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConsumerGroup == nil {
-				m.ConsumerGroup = &KafkaConsumerGroup{}
+			if m.Filter == nil {
+				m.Filter = &EventSourceFilter{}
 			}
-			if err := m.ConsumerGroup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LimitEventsPerSecond", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.LimitEventsPerSecond = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LimitEventsPerSecond |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
 			}
-		case 10:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KafkaConsumerGroup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KafkaConsumerGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KafkaConsumerGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21503,13 +21810,13 @@ func (m *KafkaEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.GroupName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SASL", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Oldest", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21519,33 +21826,17 @@ func (m *KafkaEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.SASL == nil {
-				m.SASL = &common.SASLConfig{}
-			}
-			if err := m.SASL.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 12:
+			m.Oldest = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RebalanceStrategy", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21555,27 +21846,23 @@ func (m *KafkaEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Filter == nil {
-				m.Filter = &EventSourceFilter{}
-			}
-			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RebalanceStrategy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -21598,7 +21885,7 @@ func (m *KafkaEventSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MQTTEventSource) Unmarshal(dAtA []byte) error {
+func (m *KafkaEventSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21621,10 +21908,10 @@ func (m *MQTTEventSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MQTTEventSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: KafkaEventSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MQTTEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KafkaEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -21661,7 +21948,7 @@ func (m *MQTTEventSource) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21689,11 +21976,11 @@ func (m *MQTTEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Topic = string(dAtA[iNdEx:postIndex])
+			m.Partition = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21721,7 +22008,7 @@ func (m *MQTTEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientID = string(dAtA[iNdEx:postIndex])
+			m.Topic = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
@@ -21760,26 +22047,6 @@ func (m *MQTTEventSource) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JSONBody", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.JSONBody = bool(v != 0)
-		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
 			}
@@ -21815,6 +22082,26 @@ func (m *MQTTEventSource) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JSONBody", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.JSONBody = bool(v != 0)
 		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
@@ -21944,7 +22231,7 @@ func (m *MQTTEventSource) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerGroup", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21971,68 +22258,37 @@ func (m *MQTTEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Filter == nil {
-				m.Filter = &EventSourceFilter{}
+			if m.ConsumerGroup == nil {
+				m.ConsumerGroup = &KafkaConsumerGroup{}
 			}
-			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ConsumerGroup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *NATSAuth) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitEventsPerSecond", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.LimitEventsPerSecond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LimitEventsPerSecond |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: NATSAuth: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NATSAuth: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Basic", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22042,31 +22298,27 @@ func (m *NATSAuth) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Basic == nil {
-				m.Basic = &common.BasicAuth{}
-			}
-			if err := m.Basic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SASL", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22093,52 +22345,16 @@ func (m *NATSAuth) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Token == nil {
-				m.Token = &v1.SecretKeySelector{}
-			}
-			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NKey", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.NKey == nil {
-				m.NKey = &v1.SecretKeySelector{}
+			if m.SASL == nil {
+				m.SASL = &common.SASLConfig{}
 			}
-			if err := m.NKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SASL.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Credential", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22165,10 +22381,10 @@ func (m *NATSAuth) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Credential == nil {
-				m.Credential = &v1.SecretKeySelector{}
+			if m.Filter == nil {
+				m.Filter = &EventSourceFilter{}
 			}
-			if err := m.Credential.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -22193,7 +22409,7 @@ func (m *NATSAuth) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NATSEventsSource) Unmarshal(dAtA []byte) error {
+func (m *MQTTEventSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22216,10 +22432,10 @@ func (m *NATSEventsSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NATSEventsSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: MQTTEventSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NATSEventsSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MQTTEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -22256,7 +22472,7 @@ func (m *NATSEventsSource) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22284,9 +22500,41 @@ func (m *NATSEventsSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Subject = string(dAtA[iNdEx:postIndex])
+			m.Topic = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionBackoff", wireType)
 			}
@@ -22322,7 +22570,7 @@ func (m *NATSEventsSource) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field JSONBody", wireType)
 			}
@@ -22342,7 +22590,7 @@ func (m *NATSEventsSource) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.JSONBody = bool(v != 0)
-		case 5:
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
 			}
@@ -22378,7 +22626,7 @@ func (m *NATSEventsSource) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
@@ -22505,42 +22753,6 @@ func (m *NATSEventsSource) Unmarshal(dAtA []byte) error {
 			}
 			m.Metadata[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Auth == nil {
-				m.Auth = &NATSAuth{}
-			}
-			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 8:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
@@ -22598,7 +22810,7 @@ func (m *NATSEventsSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NSQEventSource) Unmarshal(dAtA []byte) error {
+func (m *NATSAuth) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22621,17 +22833,17 @@ func (m *NSQEventSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NSQEventSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: NATSAuth: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NSQEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NATSAuth: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Basic", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22641,29 +22853,33 @@ func (m *NSQEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostAddress = string(dAtA[iNdEx:postIndex])
+			if m.Basic == nil {
+				m.Basic = &common.BasicAuth{}
+			}
+			if err := m.Basic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22673,59 +22889,31 @@ func (m *NSQEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Topic = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if m.Token == nil {
+				m.Token = &v1.SecretKeySelector{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Channel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionBackoff", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NKey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22752,36 +22940,16 @@ func (m *NSQEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConnectionBackoff == nil {
-				m.ConnectionBackoff = &common.Backoff{}
+			if m.NKey == nil {
+				m.NKey = &v1.SecretKeySelector{}
 			}
-			if err := m.ConnectionBackoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JSONBody", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.JSONBody = bool(v != 0)
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Credential", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22808,22 +22976,228 @@ func (m *NSQEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TLS == nil {
-				m.TLS = &common.TLSConfig{}
+			if m.Credential == nil {
+				m.Credential = &v1.SecretKeySelector{}
 			}
-			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Credential.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NATSEventsSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NATSEventsSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NATSEventsSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subject = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionBackoff", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConnectionBackoff == nil {
+				m.ConnectionBackoff = &common.Backoff{}
+			}
+			if err := m.ConnectionBackoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JSONBody", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.JSONBody = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TLS == nil {
+				m.TLS = &common.TLSConfig{}
+			}
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
 				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
@@ -22942,6 +23316,42 @@ func (m *NSQEventSource) Unmarshal(dAtA []byte) error {
 			}
 			m.Metadata[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Auth == nil {
+				m.Auth = &NATSAuth{}
+			}
+			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 8:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
@@ -22999,7 +23409,7 @@ func (m *NSQEventSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OwnedRepositories) Unmarshal(dAtA []byte) error {
+func (m *NSQEventSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23022,15 +23432,15 @@ func (m *OwnedRepositories) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OwnedRepositories: wiretype end group for non-group")
+			return fmt.Errorf("proto: NSQEventSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OwnedRepositories: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NSQEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HostAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23058,11 +23468,11 @@ func (m *OwnedRepositories) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.HostAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Names", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23090,61 +23500,11 @@ func (m *OwnedRepositories) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Names = append(m.Names, string(dAtA[iNdEx:postIndex]))
+			m.Topic = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PubSubEventSource) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PubSubEventSource: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PubSubEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProjectID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23172,13 +23532,13 @@ func (m *PubSubEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProjectID = string(dAtA[iNdEx:postIndex])
+			m.Channel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicProjectID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionBackoff", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23188,61 +23548,33 @@ func (m *PubSubEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TopicProjectID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if m.ConnectionBackoff == nil {
+				m.ConnectionBackoff = &common.Backoff{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.ConnectionBackoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Topic = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionID", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JSONBody", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23252,27 +23584,15 @@ func (m *PubSubEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SubscriptionID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			m.JSONBody = bool(v != 0)
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CredentialSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23299,54 +23619,14 @@ func (m *PubSubEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CredentialSecret == nil {
-				m.CredentialSecret = &v1.SecretKeySelector{}
+			if m.TLS == nil {
+				m.TLS = &common.TLSConfig{}
 			}
-			if err := m.CredentialSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeleteSubscriptionOnFinish", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.DeleteSubscriptionOnFinish = bool(v != 0)
 		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JSONBody", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.JSONBody = bool(v != 0)
-		case 8:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
@@ -23473,7 +23753,7 @@ func (m *PubSubEventSource) Unmarshal(dAtA []byte) error {
 			}
 			m.Metadata[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 9:
+		case 8:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 			}
@@ -23530,7 +23810,7 @@ func (m *PubSubEventSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PulsarEventSource) Unmarshal(dAtA []byte) error {
+func (m *OwnedRepositories) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23553,15 +23833,15 @@ func (m *PulsarEventSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PulsarEventSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: OwnedRepositories: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PulsarEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OwnedRepositories: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23589,11 +23869,11 @@ func (m *PulsarEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Topics = append(m.Topics, string(dAtA[iNdEx:postIndex]))
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Names", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23621,11 +23901,61 @@ func (m *PulsarEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.Names = append(m.Names, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PubSubEventSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PubSubEventSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PubSubEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23653,13 +23983,13 @@ func (m *PulsarEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			m.ProjectID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLSTrustCertsSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicProjectID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23669,33 +23999,29 @@ func (m *PulsarEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TLSTrustCertsSecret == nil {
-				m.TLSTrustCertsSecret = &v1.SecretKeySelector{}
-			}
-			if err := m.TLSTrustCertsSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TopicProjectID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLSAllowInsecureConnection", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23705,17 +24031,29 @@ func (m *PulsarEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.TLSAllowInsecureConnection = bool(v != 0)
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLSValidateHostname", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Topic = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionID", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23725,15 +24063,27 @@ func (m *PulsarEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.TLSValidateHostname = bool(v != 0)
-		case 7:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubscriptionID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialSecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23760,18 +24110,18 @@ func (m *PulsarEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TLS == nil {
-				m.TLS = &common.TLSConfig{}
+			if m.CredentialSecret == nil {
+				m.CredentialSecret = &v1.SecretKeySelector{}
 			}
-			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CredentialSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionBackoff", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteSubscriptionOnFinish", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23781,29 +24131,13 @@ func (m *PulsarEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ConnectionBackoff == nil {
-				m.ConnectionBackoff = &common.Backoff{}
-			}
-			if err := m.ConnectionBackoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 9:
+			m.DeleteSubscriptionOnFinish = bool(v != 0)
+		case 7:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field JSONBody", wireType)
 			}
@@ -23823,7 +24157,7 @@ func (m *PulsarEventSource) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.JSONBody = bool(v != 0)
-		case 10:
+		case 8:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
@@ -23950,43 +24284,7 @@ func (m *PulsarEventSource) Unmarshal(dAtA []byte) error {
 			}
 			m.Metadata[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthTokenSecret", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.AuthTokenSecret == nil {
-				m.AuthTokenSecret = &v1.SecretKeySelector{}
-			}
-			if err := m.AuthTokenSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 12:
+		case 9:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 			}
@@ -24043,7 +24341,7 @@ func (m *PulsarEventSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RedisEventSource) Unmarshal(dAtA []byte) error {
+func (m *PulsarEventSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24066,15 +24364,15 @@ func (m *RedisEventSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RedisEventSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: PulsarEventSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RedisEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PulsarEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24102,13 +24400,13 @@ func (m *RedisEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostAddress = string(dAtA[iNdEx:postIndex])
+			m.Topics = append(m.Topics, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24118,31 +24416,27 @@ func (m *RedisEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Password == nil {
-				m.Password = &v1.SecretKeySelector{}
-			}
-			if err := m.Password.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24170,13 +24464,13 @@ func (m *RedisEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DB", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSTrustCertsSecret", wireType)
 			}
-			m.DB = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24186,16 +24480,33 @@ func (m *RedisEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DB |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TLSTrustCertsSecret == nil {
+				m.TLSTrustCertsSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.TLSTrustCertsSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Channels", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSAllowInsecureConnection", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24205,25 +24516,33 @@ func (m *RedisEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			m.TLSAllowInsecureConnection = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSValidateHostname", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Channels = append(m.Channels, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 6:
+			m.TLSValidateHostname = bool(v != 0)
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
 			}
@@ -24259,9 +24578,9 @@ func (m *RedisEventSource) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionBackoff", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24288,15 +24607,71 @@ func (m *RedisEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metadata == nil {
-				m.Metadata = make(map[string]string)
+			if m.ConnectionBackoff == nil {
+				m.ConnectionBackoff = &common.Backoff{}
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
+			if err := m.ConnectionBackoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JSONBody", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.JSONBody = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return ErrIntOverflowGenerated
 					}
@@ -24386,7 +24761,43 @@ func (m *RedisEventSource) Unmarshal(dAtA []byte) error {
 			}
 			m.Metadata[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 8:
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthTokenSecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AuthTokenSecret == nil {
+				m.AuthTokenSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.AuthTokenSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 			}
@@ -24443,7 +24854,7 @@ func (m *RedisEventSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RedisStreamEventSource) Unmarshal(dAtA []byte) error {
+func (m *RedisEventSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24466,10 +24877,10 @@ func (m *RedisStreamEventSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RedisStreamEventSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: RedisEventSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RedisStreamEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RedisEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -24541,27 +24952,8 @@ func (m *RedisStreamEventSource) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DB", wireType)
-			}
-			m.DB = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DB |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Streams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24589,13 +24981,13 @@ func (m *RedisStreamEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Streams = append(m.Streams, string(dAtA[iNdEx:postIndex]))
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxMsgCountPerRead", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DB", wireType)
 			}
-			m.MaxMsgCountPerRead = 0
+			m.DB = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24605,14 +24997,14 @@ func (m *RedisStreamEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxMsgCountPerRead |= int32(b&0x7F) << shift
+				m.DB |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerGroup", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Channels", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24640,9 +25032,9 @@ func (m *RedisStreamEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsumerGroup = string(dAtA[iNdEx:postIndex])
+			m.Channels = append(m.Channels, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 7:
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
 			}
@@ -24678,7 +25070,7 @@ func (m *RedisStreamEventSource) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
@@ -24805,7 +25197,7 @@ func (m *RedisStreamEventSource) Unmarshal(dAtA []byte) error {
 			}
 			m.Metadata[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 9:
+		case 8:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 			}
@@ -24862,7 +25254,7 @@ func (m *RedisStreamEventSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceEventSource) Unmarshal(dAtA []byte) error {
+func (m *RedisStreamEventSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24885,15 +25277,15 @@ func (m *ResourceEventSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceEventSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: RedisStreamEventSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RedisStreamEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HostAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24921,11 +25313,11 @@ func (m *ResourceEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.HostAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24952,18 +25344,37 @@ func (m *ResourceEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Filter == nil {
-				m.Filter = &ResourceFilter{}
+			if m.Password == nil {
+				m.Password = &v1.SecretKeySelector{}
 			}
-			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Password.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DB", wireType)
+			}
+			m.DB = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DB |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupVersionResource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Streams", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24973,28 +25384,46 @@ func (m *ResourceEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GroupVersionResource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Streams = append(m.Streams, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMsgCountPerRead", wireType)
+			}
+			m.MaxMsgCountPerRead = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMsgCountPerRead |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventTypes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerGroup", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25022,11 +25451,11 @@ func (m *ResourceEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EventTypes = append(m.EventTypes, ResourceEventType(dAtA[iNdEx:postIndex]))
+			m.ConsumerGroup = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25053,31 +25482,67 @@ func (m *ResourceEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metadata == nil {
-				m.Metadata = make(map[string]string)
+			if m.TLS == nil {
+				m.TLS = &common.TLSConfig{}
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowGenerated
@@ -25151,6 +25616,42 @@ func (m *ResourceEventSource) Unmarshal(dAtA []byte) error {
 			}
 			m.Metadata[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Filter == nil {
+				m.Filter = &EventSourceFilter{}
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -25172,7 +25673,7 @@ func (m *ResourceEventSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceFilter) Unmarshal(dAtA []byte) error {
+func (m *ResourceEventSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25195,15 +25696,15 @@ func (m *ResourceFilter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceFilter: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceEventSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25231,11 +25732,11 @@ func (m *ResourceFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Prefix = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25262,14 +25763,16 @@ func (m *ResourceFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Labels = append(m.Labels, Selector{})
-			if err := m.Labels[len(m.Labels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Filter == nil {
+				m.Filter = &ResourceFilter{}
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupVersionResource", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25296,16 +25799,15 @@ func (m *ResourceFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fields = append(m.Fields, Selector{})
-			if err := m.Fields[len(m.Fields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GroupVersionResource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EventTypes", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25315,30 +25817,29 @@ func (m *ResourceFilter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CreatedBy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.EventTypes = append(m.EventTypes, ResourceEventType(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AfterStart", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25348,12 +25849,119 @@ func (m *ResourceFilter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AfterStart = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Metadata[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -25375,7 +25983,7 @@ func (m *ResourceFilter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SNSEventSource) Unmarshal(dAtA []byte) error {
+func (m *ResourceFilter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25398,15 +26006,218 @@ func (m *SNSEventSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SNSEventSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceFilter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SNSEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceFilter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Webhook", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Labels = append(m.Labels, Selector{})
+			if err := m.Labels[len(m.Labels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fields = append(m.Fields, Selector{})
+			if err := m.Fields[len(m.Fields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedBy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CreatedBy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AfterStart", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AfterStart = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SNSEventSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SNSEventSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SNSEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Webhook", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25754,12 +26565,782 @@ func (m *SNSEventSource) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.ValidateSignature = bool(v != 0)
-		case 9:
+			m.ValidateSignature = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Filter == nil {
+				m.Filter = &EventSourceFilter{}
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SQSEventSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SQSEventSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SQSEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AccessKey == nil {
+				m.AccessKey = &v1.SecretKeySelector{}
+			}
+			if err := m.AccessKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SecretKey == nil {
+				m.SecretKey = &v1.SecretKeySelector{}
+			}
+			if err := m.SecretKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Region = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WaitTimeSeconds", wireType)
+			}
+			m.WaitTimeSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WaitTimeSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleARN", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoleARN = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JSONBody", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.JSONBody = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueAccountID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueueAccountID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Metadata[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DLQ", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DLQ = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Filter == nil {
+				m.Filter = &EventSourceFilter{}
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Endpoint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Selector) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Selector: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Selector: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Service) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Service: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Service: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ports", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ports = append(m.Ports, v1.ServicePort{})
+			if err := m.Ports[len(m.Ports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterIP", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25769,27 +27350,23 @@ func (m *SNSEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Filter == nil {
-				m.Filter = &EventSourceFilter{}
-			}
-			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ClusterIP = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -25812,7 +27389,7 @@ func (m *SNSEventSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SQSEventSource) Unmarshal(dAtA []byte) error {
+func (m *SlackEventSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25835,15 +27412,15 @@ func (m *SQSEventSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SQSEventSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: SlackEventSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SQSEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SlackEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningSecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25870,16 +27447,16 @@ func (m *SQSEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AccessKey == nil {
-				m.AccessKey = &v1.SecretKeySelector{}
+			if m.SigningSecret == nil {
+				m.SigningSecret = &v1.SecretKeySelector{}
 			}
-			if err := m.AccessKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SigningSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecretKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25906,101 +27483,18 @@ func (m *SQSEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SecretKey == nil {
-				m.SecretKey = &v1.SecretKeySelector{}
+			if m.Token == nil {
+				m.Token = &v1.SecretKeySelector{}
 			}
-			if err := m.SecretKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Region = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Queue = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WaitTimeSeconds", wireType)
-			}
-			m.WaitTimeSeconds = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.WaitTimeSeconds |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RoleARN", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Webhook", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26010,77 +27504,29 @@ func (m *SQSEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RoleARN = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JSONBody", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.JSONBody = bool(v != 0)
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueueAccountID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if m.Webhook == nil {
+				m.Webhook = &WebhookContext{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Webhook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.QueueAccountID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
@@ -26201,73 +27647,17 @@ func (m *SQSEventSource) Unmarshal(dAtA []byte) error {
 					}
 					if (iNdEx + skippy) > postIndex {
 						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Metadata[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DLQ", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.DLQ = bool(v != 0)
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Filter == nil {
-				m.Filter = &EventSourceFilter{}
-			}
-			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Metadata[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 12:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26277,23 +27667,27 @@ func (m *SQSEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Endpoint = string(dAtA[iNdEx:postIndex])
+			if m.Filter == nil {
+				m.Filter = &EventSourceFilter{}
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -26316,7 +27710,7 @@ func (m *SQSEventSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Selector) Unmarshal(dAtA []byte) error {
+func (m *StorageGridEventSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26339,17 +27733,17 @@ func (m *Selector) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Selector: wiretype end group for non-group")
+			return fmt.Errorf("proto: StorageGridEventSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Selector: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StorageGridEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Webhook", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26359,27 +27753,31 @@ func (m *Selector) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			if m.Webhook == nil {
+				m.Webhook = &WebhookContext{}
+			}
+			if err := m.Webhook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26407,13 +27805,13 @@ func (m *Selector) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operation = string(dAtA[iNdEx:postIndex])
+			m.Events = append(m.Events, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26423,79 +27821,33 @@ func (m *Selector) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Service) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Filter == nil {
+				m.Filter = &StorageGridFilter{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Service: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Service: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ports", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicArn", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26505,29 +27857,27 @@ func (m *Service) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ports = append(m.Ports, v1.ServicePort{})
-			if err := m.Ports[len(m.Ports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TopicArn = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterIP", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26555,63 +27905,13 @@ func (m *Service) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClusterIP = string(dAtA[iNdEx:postIndex])
+			m.Bucket = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SlackEventSource) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SlackEventSource: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SlackEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SigningSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26621,31 +27921,27 @@ func (m *SlackEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SigningSecret == nil {
-				m.SigningSecret = &v1.SecretKeySelector{}
-			}
-			if err := m.SigningSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Region = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthToken", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26672,18 +27968,18 @@ func (m *SlackEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Token == nil {
-				m.Token = &v1.SecretKeySelector{}
+			if m.AuthToken == nil {
+				m.AuthToken = &v1.SecretKeySelector{}
 			}
-			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AuthToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Webhook", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field APIURL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26693,29 +27989,25 @@ func (m *SlackEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Webhook == nil {
-				m.Webhook = &WebhookContext{}
-			}
-			if err := m.Webhook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.APIURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 9:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
@@ -26840,13 +28132,63 @@ func (m *SlackEventSource) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Metadata[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 5:
+			m.Metadata[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StorageGridFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StorageGridFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StorageGridFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26856,27 +28198,55 @@ func (m *SlackEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Filter == nil {
-				m.Filter = &EventSourceFilter{}
+			m.Prefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Suffix", wireType)
 			}
-			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Suffix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -26899,7 +28269,7 @@ func (m *SlackEventSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StorageGridEventSource) Unmarshal(dAtA []byte) error {
+func (m *StripeEventSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26922,10 +28292,10 @@ func (m *StorageGridEventSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StorageGridEventSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: StripeEventSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StorageGridEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StripeEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -26965,10 +28335,10 @@ func (m *StorageGridEventSource) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateWebhook", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26978,27 +28348,15 @@ func (m *StorageGridEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Events = append(m.Events, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
+			m.CreateWebhook = bool(v != 0)
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field APIKey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27025,16 +28383,16 @@ func (m *StorageGridEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Filter == nil {
-				m.Filter = &StorageGridFilter{}
+			if m.APIKey == nil {
+				m.APIKey = &v1.SecretKeySelector{}
 			}
-			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.APIKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicArn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EventFilter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27062,13 +28420,13 @@ func (m *StorageGridEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TopicArn = string(dAtA[iNdEx:postIndex])
+			m.EventFilter = append(m.EventFilter, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27078,27 +28436,172 @@ func (m *StorageGridEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Metadata[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TCPEventSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bucket = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TCPEventSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TCPEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27126,13 +28629,13 @@ func (m *StorageGridEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Region = string(dAtA[iNdEx:postIndex])
+			m.Port = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthToken", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLineLength", wireType)
 			}
-			var msglen int
+			m.MaxLineLength = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27142,31 +28645,14 @@ func (m *StorageGridEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxLineLength |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.AuthToken == nil {
-				m.AuthToken = &v1.SecretKeySelector{}
-			}
-			if err := m.AuthToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field APIURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadTimeout", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27194,9 +28680,9 @@ func (m *StorageGridEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.APIURL = string(dAtA[iNdEx:postIndex])
+			m.ReadTimeout = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
@@ -27323,6 +28809,42 @@ func (m *StorageGridEventSource) Unmarshal(dAtA []byte) error {
 			}
 			m.Metadata[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Filter == nil {
+				m.Filter = &EventSourceFilter{}
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -27344,7 +28866,7 @@ func (m *StorageGridEventSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StorageGridFilter) Unmarshal(dAtA []byte) error {
+func (m *Template) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27367,17 +28889,17 @@ func (m *StorageGridFilter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StorageGridFilter: wiretype end group for non-group")
+			return fmt.Errorf("proto: Template: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StorageGridFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Template: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27387,27 +28909,31 @@ func (m *StorageGridFilter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Prefix = string(dAtA[iNdEx:postIndex])
+			if m.Metadata == nil {
+				m.Metadata = &common.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Suffix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27435,61 +28961,47 @@ func (m *StorageGridFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Suffix = string(dAtA[iNdEx:postIndex])
+			m.ServiceAccountName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *StripeEventSource) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Container == nil {
+				m.Container = &v1.Container{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: StripeEventSource: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StripeEventSource: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Webhook", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Volumes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27516,18 +29028,16 @@ func (m *StripeEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Webhook == nil {
-				m.Webhook = &WebhookContext{}
-			}
-			if err := m.Webhook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Volumes = append(m.Volumes, v1.Volume{})
+			if err := m.Volumes[len(m.Volumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateWebhook", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContext", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27537,15 +29047,31 @@ func (m *StripeEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.CreateWebhook = bool(v != 0)
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SecurityContext == nil {
+				m.SecurityContext = &v1.PodSecurityContext{}
+			}
+			if err := m.SecurityContext.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field APIKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Affinity", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27572,18 +29098,18 @@ func (m *StripeEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.APIKey == nil {
-				m.APIKey = &v1.SecretKeySelector{}
+			if m.Affinity == nil {
+				m.Affinity = &v1.Affinity{}
 			}
-			if err := m.APIKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Affinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventFilter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tolerations", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27593,27 +29119,29 @@ func (m *StripeEventSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EventFilter = append(m.EventFilter, string(dAtA[iNdEx:postIndex]))
+			m.Tolerations = append(m.Tolerations, v1.Toleration{})
+			if err := m.Tolerations[len(m.Tolerations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeSelector", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27640,8 +29168,8 @@ func (m *StripeEventSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metadata == nil {
-				m.Metadata = make(map[string]string)
+			if m.NodeSelector == nil {
+				m.NodeSelector = make(map[string]string)
 			}
 			var mapkey string
 			var mapvalue string
@@ -27736,8 +29264,94 @@ func (m *StripeEventSource) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Metadata[mapkey] = mapvalue
+			m.NodeSelector[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImagePullSecrets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ImagePullSecrets = append(m.ImagePullSecrets, v1.LocalObjectReference{})
+			if err := m.ImagePullSecrets[len(m.ImagePullSecrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityClassName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriorityClassName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Priority = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -27759,7 +29373,7 @@ func (m *StripeEventSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Template) Unmarshal(dAtA []byte) error {
+func (m *WatchPathConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27782,17 +29396,17 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Template: wiretype end group for non-group")
+			return fmt.Errorf("proto: WatchPathConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Template: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WatchPathConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Directory", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27802,31 +29416,59 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metadata == nil {
-				m.Metadata = &common.Metadata{}
+			m.Directory = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PathRegexp", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27854,13 +29496,95 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServiceAccountName = string(dAtA[iNdEx:postIndex])
+			m.PathRegexp = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WebhookContext) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WebhookContext: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WebhookContext: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Endpoint = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27870,33 +29594,29 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Container == nil {
-				m.Container = &v1.Container{}
-			}
-			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Method = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Volumes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27906,31 +29626,29 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Volumes = append(m.Volumes, v1.Volume{})
-			if err := m.Volumes[len(m.Volumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Port = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContext", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27940,31 +29658,27 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SecurityContext == nil {
-				m.SecurityContext = &v1.PodSecurityContext{}
-			}
-			if err := m.SecurityContext.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Affinity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerCertSecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27991,16 +29705,16 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Affinity == nil {
-				m.Affinity = &v1.Affinity{}
+			if m.ServerCertSecret == nil {
+				m.ServerCertSecret = &v1.SecretKeySelector{}
 			}
-			if err := m.Affinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ServerCertSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tolerations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerKeySecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28027,14 +29741,16 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tolerations = append(m.Tolerations, v1.Toleration{})
-			if err := m.Tolerations[len(m.Tolerations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ServerKeySecret == nil {
+				m.ServerKeySecret = &v1.SecretKeySelector{}
+			}
+			if err := m.ServerKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeSelector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28061,8 +29777,8 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NodeSelector == nil {
-				m.NodeSelector = make(map[string]string)
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
 			}
 			var mapkey string
 			var mapvalue string
@@ -28157,181 +29873,13 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.NodeSelector[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ImagePullSecrets", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ImagePullSecrets = append(m.ImagePullSecrets, v1.LocalObjectReference{})
-			if err := m.ImagePullSecrets[len(m.ImagePullSecrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriorityClassName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PriorityClassName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Priority = &v
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *WatchPathConfig) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: WatchPathConfig: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WatchPathConfig: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Directory", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Directory = string(dAtA[iNdEx:postIndex])
+			m.Metadata[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthSecret", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28341,29 +29889,33 @@ func (m *WatchPathConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			if m.AuthSecret == nil {
+				m.AuthSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.AuthSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PathRegexp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28373,23 +29925,27 @@ func (m *WatchPathConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PathRegexp = string(dAtA[iNdEx:postIndex])
+			if m.Signature == nil {
+				m.Signature = &WebhookSignature{}
+			}
+			if err := m.Signature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -28412,7 +29968,7 @@ func (m *WatchPathConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WebhookContext) Unmarshal(dAtA []byte) error {
+func (m *WebhookSignature) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28435,17 +29991,17 @@ func (m *WebhookContext) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WebhookContext: wiretype end group for non-group")
+			return fmt.Errorf("proto: WebhookSignature: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WebhookContext: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WebhookSignature: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28455,27 +30011,31 @@ func (m *WebhookContext) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Endpoint = string(dAtA[iNdEx:postIndex])
+			if m.Secret == nil {
+				m.Secret = &v1.SecretKeySelector{}
+			}
+			if err := m.Secret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Algorithm", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28503,11 +30063,11 @@ func (m *WebhookContext) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Method = string(dAtA[iNdEx:postIndex])
+			m.Algorithm = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28535,11 +30095,11 @@ func (m *WebhookContext) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Port = string(dAtA[iNdEx:postIndex])
+			m.Header = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28567,13 +30127,13 @@ func (m *WebhookContext) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			m.Prefix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServerCertSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimestampHeader", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28583,196 +30143,29 @@ func (m *WebhookContext) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ServerCertSecret == nil {
-				m.ServerCertSecret = &v1.SecretKeySelector{}
-			}
-			if err := m.ServerCertSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TimestampHeader = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServerKeySecret", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ServerKeySecret == nil {
-				m.ServerKeySecret = &v1.SecretKeySelector{}
-			}
-			if err := m.ServerKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Metadata == nil {
-				m.Metadata = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Metadata[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tolerance", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28782,27 +30175,23 @@ func (m *WebhookContext) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AuthSecret == nil {
-				m.AuthSecret = &v1.SecretKeySelector{}
-			}
-			if err := m.AuthSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Tolerance = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -116,6 +116,8 @@ type EventSourceSpec struct {
 	Bitbucket map[string]BitbucketEventSource `json:"bitbucket,omitempty" protobuf:"bytes,30,rep,name=bitbucket"`
 	// Redis stream source
 	RedisStream map[string]RedisStreamEventSource `json:"redisStream,omitempty" protobuf:"bytes,31,rep,name=redisStream"`
+	// TCP event sources
+	TCP map[string]TCPEventSource `json:"tcp,omitempty" protobuf:"bytes,32,rep,name=tcp"`
 }
 
 func (e EventSourceSpec) GetReplicas() int32 {
@@ -274,6 +276,61 @@ type FileEventSource struct {
 	// Filter
 	// +optional
 	Filter *EventSourceFilter `json:"filter,omitempty" protobuf:"bytes,5,opt,name=filter"`
+	// Tail, if specified, makes the event source emit the content of the watched files instead of
+	// their file operations, the event type is not used then.
+	// +optional
+	Tail *FileTailConfig `json:"tail,omitempty" protobuf:"bytes,6,opt,name=tail"`
+}
+
+// FileTailMode is how the content of the watched files is emitted
+type FileTailMode string
+
+// possible values of FileTailMode
+const (
+	// FileTailModeLine emits an event per line appended to the files
+	FileTailModeLine FileTailMode = "line"
+	// FileTailModeFile emits an event per new file, with its whole content
+	FileTailModeFile FileTailMode = "file"
+)
+
+// FileTailConfig describes how the content of the watched files is emitted. The file at the path of
+// the watch path config is watched if it is specified, the files of the directory matching the glob
+// and the path regexp otherwise.
+type FileTailConfig struct {
+	// Mode is "line" to emit an event per line appended to the files, or "file" to emit an event per
+	// new file. The files should be moved into the directory once written in the file mode.
+	// Defaults to line.
+	// +optional
+	Mode FileTailMode `json:"mode,omitempty" protobuf:"bytes,1,opt,name=mode,casttype=FileTailMode"`
+	// Glob filters the files of the directory by their name, e.g. "*.json".
+	// Defaults to all the files.
+	// +optional
+	Glob string `json:"glob,omitempty" protobuf:"bytes,2,opt,name=glob"`
+	// OffsetFile is the path of the file the positions read up to are saved in, for the content
+	// to not be emitted again when the event source restarts, e.g. on the watched volume.
+	// The content is emitted again from the start of the files without it.
+	// +optional
+	OffsetFile string `json:"offsetFile,omitempty" protobuf:"bytes,3,opt,name=offsetFile"`
+	// MaxEventSize is the maximum size in bytes of a line or a file, the bigger ones are skipped.
+	// Defaults to 1048576 (1MiB).
+	// +optional
+	MaxEventSize int64 `json:"maxEventSize,omitempty" protobuf:"varint,4,opt,name=maxEventSize"`
+}
+
+// GetMode returns the mode of the tail, line if not specified
+func (t FileTailConfig) GetMode() FileTailMode {
+	if t.Mode == "" {
+		return FileTailModeLine
+	}
+	return t.Mode
+}
+
+// GetMaxEventSize returns the maximum size of the events, 1MiB if not specified
+func (t FileTailConfig) GetMaxEventSize() int64 {
+	if t.MaxEventSize <= 0 {
+		return 1024 * 1024
+	}
+	return t.MaxEventSize
 }
 
 // ResourceEventType is the type of event for the K8s resource mutation
@@ -1189,6 +1246,27 @@ type RedisStreamEventSource struct {
 	Filter *EventSourceFilter `json:"filter,omitempty" protobuf:"bytes,9,opt,name=filter"`
 }
 
+// TCPEventSource describes an event source that listens on a TCP port
+// and publishes each newline delimited line received as an event.
+type TCPEventSource struct {
+	// Port to listen on.
+	Port string `json:"port" protobuf:"bytes,1,opt,name=port"`
+	// MaxLineLength is the maximum length of a line in bytes. A connection sending
+	// a longer line is closed. Defaults to 1MB.
+	// +optional
+	MaxLineLength int32 `json:"maxLineLength,omitempty" protobuf:"varint,2,opt,name=maxLineLength"`
+	// ReadTimeout is the duration after which a connection without any incoming line is closed,
+	// e.g. "30s" or "5m". Defaults to no timeout.
+	// +optional
+	ReadTimeout string `json:"readTimeout,omitempty" protobuf:"bytes,3,opt,name=readTimeout"`
+	// Metadata holds the user defined metadata which will passed along the event payload.
+	// +optional
+	Metadata map[string]string `json:"metadata,omitempty" protobuf:"bytes,4,rep,name=metadata"`
+	// Filter
+	// +optional
+	Filter *EventSourceFilter `json:"filter,omitempty" protobuf:"bytes,5,opt,name=filter"`
+}
+
 // NSQEventSource describes the event source for NSQ PubSub
 // More info at https://godoc.org/github.com/nsqio/go-nsq
 type NSQEventSource struct {
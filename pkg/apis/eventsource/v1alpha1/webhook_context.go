@@ -25,4 +25,31 @@ type WebhookContext struct {
 	// AuthSecret holds a secret selector that contains a bearer token for authentication
 	// +optional
 	AuthSecret *corev1.SecretKeySelector `json:"authSecret,omitempty" protobuf:"bytes,8,opt,name=authSecret"`
+	// Signature verifies the HMAC signature of the requests, the requests not signed with the secret are rejected
+	// +optional
+	Signature *WebhookSignature `json:"signature,omitempty" protobuf:"bytes,9,opt,name=signature"`
+}
+
+// WebhookSignature describes how the requests are signed with an HMAC of their body
+type WebhookSignature struct {
+	// Secret holds the key the signature is computed with
+	Secret *corev1.SecretKeySelector `json:"secret" protobuf:"bytes,1,opt,name=secret"`
+	// Algorithm is the hash function of the HMAC, sha1 or sha256. Defaults to sha256.
+	// +optional
+	Algorithm string `json:"algorithm,omitempty" protobuf:"bytes,2,opt,name=algorithm"`
+	// Header holding the hex encoded signature. Defaults to X-Signature.
+	// +optional
+	Header string `json:"header,omitempty" protobuf:"bytes,3,opt,name=header"`
+	// Prefix of the signature in the header, e.g. "sha256=".
+	// +optional
+	Prefix string `json:"prefix,omitempty" protobuf:"bytes,4,opt,name=prefix"`
+	// TimestampHeader holding the Unix time in seconds the request was signed at. If set, the signature
+	// is computed over "{timestamp}.{body}", and the requests signed out of the tolerance are rejected
+	// to prevent their replay.
+	// +optional
+	TimestampHeader string `json:"timestampHeader,omitempty" protobuf:"bytes,5,opt,name=timestampHeader"`
+	// Tolerance is how far the timestamp can be from the time the request is received, e.g. "5m".
+	// Defaults to 5m.
+	// +optional
+	Tolerance string `json:"tolerance,omitempty" protobuf:"bytes,6,opt,name=tolerance"`
 }
@@ -789,6 +789,13 @@ func (in *EventSourceSpec) DeepCopyInto(out *EventSourceSpec) {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.TCP != nil {
+		in, out := &in.TCP, &out.TCP
+		*out = make(map[string]TCPEventSource, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 	return
 }
 
@@ -835,6 +842,11 @@ func (in *FileEventSource) DeepCopyInto(out *FileEventSource) {
 		*out = new(EventSourceFilter)
 		**out = **in
 	}
+	if in.Tail != nil {
+		in, out := &in.Tail, &out.Tail
+		*out = new(FileTailConfig)
+		**out = **in
+	}
 	return
 }
 
@@ -848,6 +860,22 @@ func (in *FileEventSource) DeepCopy() *FileEventSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileTailConfig) DeepCopyInto(out *FileTailConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileTailConfig.
+func (in *FileTailConfig) DeepCopy() *FileTailConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(FileTailConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GenericEventSource) DeepCopyInto(out *GenericEventSource) {
 	*out = *in
@@ -1802,6 +1830,34 @@ func (in *StripeEventSource) DeepCopy() *StripeEventSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TCPEventSource) DeepCopyInto(out *TCPEventSource) {
+	*out = *in
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(EventSourceFilter)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TCPEventSource.
+func (in *TCPEventSource) DeepCopy() *TCPEventSource {
+	if in == nil {
+		return nil
+	}
+	out := new(TCPEventSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Template) DeepCopyInto(out *Template) {
 	*out = *in
@@ -1910,6 +1966,11 @@ func (in *WebhookContext) DeepCopyInto(out *WebhookContext) {
 		*out = new(v1.SecretKeySelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Signature != nil {
+		in, out := &in.Signature, &out.Signature
+		*out = new(WebhookSignature)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1922,3 +1983,24 @@ func (in *WebhookContext) DeepCopy() *WebhookContext {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookSignature) DeepCopyInto(out *WebhookSignature) {
+	*out = *in
+	if in.Secret != nil {
+		in, out := &in.Secret, &out.Secret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookSignature.
+func (in *WebhookSignature) DeepCopy() *WebhookSignature {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookSignature)
+	in.DeepCopyInto(out)
+	return out
+}
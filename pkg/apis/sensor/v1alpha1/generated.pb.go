@@ -76,10 +76,38 @@ func (m *AWSLambdaTrigger) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AWSLambdaTrigger proto.InternalMessageInfo
 
+func (m *AWSSQSTrigger) Reset()      { *m = AWSSQSTrigger{} }
+func (*AWSSQSTrigger) ProtoMessage() {}
+func (*AWSSQSTrigger) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c4bded897df1f16, []int{1}
+}
+func (m *AWSSQSTrigger) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AWSSQSTrigger) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AWSSQSTrigger) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AWSSQSTrigger.Merge(m, src)
+}
+func (m *AWSSQSTrigger) XXX_Size() int {
+	return m.Size()
+}
+func (m *AWSSQSTrigger) XXX_DiscardUnknown() {
+	xxx_messageInfo_AWSSQSTrigger.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AWSSQSTrigger proto.InternalMessageInfo
+
 func (m *ArgoWorkflowTrigger) Reset()      { *m = ArgoWorkflowTrigger{} }
 func (*ArgoWorkflowTrigger) ProtoMessage() {}
 func (*ArgoWorkflowTrigger) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{1}
+	return fileDescriptor_6c4bded897df1f16, []int{2}
 }
 func (m *ArgoWorkflowTrigger) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -107,7 +135,7 @@ var xxx_messageInfo_ArgoWorkflowTrigger proto.InternalMessageInfo
 func (m *ArtifactLocation) Reset()      { *m = ArtifactLocation{} }
 func (*ArtifactLocation) ProtoMessage() {}
 func (*ArtifactLocation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{2}
+	return fileDescriptor_6c4bded897df1f16, []int{3}
 }
 func (m *ArtifactLocation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -135,7 +163,7 @@ var xxx_messageInfo_ArtifactLocation proto.InternalMessageInfo
 func (m *AzureEventHubsTrigger) Reset()      { *m = AzureEventHubsTrigger{} }
 func (*AzureEventHubsTrigger) ProtoMessage() {}
 func (*AzureEventHubsTrigger) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{3}
+	return fileDescriptor_6c4bded897df1f16, []int{4}
 }
 func (m *AzureEventHubsTrigger) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -160,10 +188,66 @@ func (m *AzureEventHubsTrigger) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AzureEventHubsTrigger proto.InternalMessageInfo
 
+func (m *BusTrigger) Reset()      { *m = BusTrigger{} }
+func (*BusTrigger) ProtoMessage() {}
+func (*BusTrigger) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c4bded897df1f16, []int{5}
+}
+func (m *BusTrigger) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BusTrigger) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *BusTrigger) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BusTrigger.Merge(m, src)
+}
+func (m *BusTrigger) XXX_Size() int {
+	return m.Size()
+}
+func (m *BusTrigger) XXX_DiscardUnknown() {
+	xxx_messageInfo_BusTrigger.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BusTrigger proto.InternalMessageInfo
+
+func (m *CloudEventEnvelope) Reset()      { *m = CloudEventEnvelope{} }
+func (*CloudEventEnvelope) ProtoMessage() {}
+func (*CloudEventEnvelope) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c4bded897df1f16, []int{6}
+}
+func (m *CloudEventEnvelope) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CloudEventEnvelope) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *CloudEventEnvelope) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CloudEventEnvelope.Merge(m, src)
+}
+func (m *CloudEventEnvelope) XXX_Size() int {
+	return m.Size()
+}
+func (m *CloudEventEnvelope) XXX_DiscardUnknown() {
+	xxx_messageInfo_CloudEventEnvelope.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CloudEventEnvelope proto.InternalMessageInfo
+
 func (m *ConditionsResetByTime) Reset()      { *m = ConditionsResetByTime{} }
 func (*ConditionsResetByTime) ProtoMessage() {}
 func (*ConditionsResetByTime) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{4}
+	return fileDescriptor_6c4bded897df1f16, []int{7}
 }
 func (m *ConditionsResetByTime) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -191,7 +275,7 @@ var xxx_messageInfo_ConditionsResetByTime proto.InternalMessageInfo
 func (m *ConditionsResetCriteria) Reset()      { *m = ConditionsResetCriteria{} }
 func (*ConditionsResetCriteria) ProtoMessage() {}
 func (*ConditionsResetCriteria) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{5}
+	return fileDescriptor_6c4bded897df1f16, []int{8}
 }
 func (m *ConditionsResetCriteria) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -219,7 +303,7 @@ var xxx_messageInfo_ConditionsResetCriteria proto.InternalMessageInfo
 func (m *CustomTrigger) Reset()      { *m = CustomTrigger{} }
 func (*CustomTrigger) ProtoMessage() {}
 func (*CustomTrigger) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{6}
+	return fileDescriptor_6c4bded897df1f16, []int{9}
 }
 func (m *CustomTrigger) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -247,7 +331,7 @@ var xxx_messageInfo_CustomTrigger proto.InternalMessageInfo
 func (m *DataFilter) Reset()      { *m = DataFilter{} }
 func (*DataFilter) ProtoMessage() {}
 func (*DataFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{7}
+	return fileDescriptor_6c4bded897df1f16, []int{10}
 }
 func (m *DataFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -272,10 +356,38 @@ func (m *DataFilter) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DataFilter proto.InternalMessageInfo
 
+func (m *DeadLetter) Reset()      { *m = DeadLetter{} }
+func (*DeadLetter) ProtoMessage() {}
+func (*DeadLetter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c4bded897df1f16, []int{11}
+}
+func (m *DeadLetter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeadLetter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *DeadLetter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeadLetter.Merge(m, src)
+}
+func (m *DeadLetter) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeadLetter) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeadLetter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeadLetter proto.InternalMessageInfo
+
 func (m *Event) Reset()      { *m = Event{} }
 func (*Event) ProtoMessage() {}
 func (*Event) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{8}
+	return fileDescriptor_6c4bded897df1f16, []int{12}
 }
 func (m *Event) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -300,10 +412,38 @@ func (m *Event) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Event proto.InternalMessageInfo
 
+func (m *EventBusDeadLetterSink) Reset()      { *m = EventBusDeadLetterSink{} }
+func (*EventBusDeadLetterSink) ProtoMessage() {}
+func (*EventBusDeadLetterSink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c4bded897df1f16, []int{13}
+}
+func (m *EventBusDeadLetterSink) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBusDeadLetterSink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *EventBusDeadLetterSink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBusDeadLetterSink.Merge(m, src)
+}
+func (m *EventBusDeadLetterSink) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBusDeadLetterSink) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBusDeadLetterSink.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBusDeadLetterSink proto.InternalMessageInfo
+
 func (m *EventContext) Reset()      { *m = EventContext{} }
 func (*EventContext) ProtoMessage() {}
 func (*EventContext) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{9}
+	return fileDescriptor_6c4bded897df1f16, []int{14}
 }
 func (m *EventContext) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -331,7 +471,7 @@ var xxx_messageInfo_EventContext proto.InternalMessageInfo
 func (m *EventDependency) Reset()      { *m = EventDependency{} }
 func (*EventDependency) ProtoMessage() {}
 func (*EventDependency) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{10}
+	return fileDescriptor_6c4bded897df1f16, []int{15}
 }
 func (m *EventDependency) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -359,7 +499,7 @@ var xxx_messageInfo_EventDependency proto.InternalMessageInfo
 func (m *EventDependencyFilter) Reset()      { *m = EventDependencyFilter{} }
 func (*EventDependencyFilter) ProtoMessage() {}
 func (*EventDependencyFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{11}
+	return fileDescriptor_6c4bded897df1f16, []int{16}
 }
 func (m *EventDependencyFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -387,7 +527,7 @@ var xxx_messageInfo_EventDependencyFilter proto.InternalMessageInfo
 func (m *EventDependencyTransformer) Reset()      { *m = EventDependencyTransformer{} }
 func (*EventDependencyTransformer) ProtoMessage() {}
 func (*EventDependencyTransformer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{12}
+	return fileDescriptor_6c4bded897df1f16, []int{17}
 }
 func (m *EventDependencyTransformer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -415,7 +555,7 @@ var xxx_messageInfo_EventDependencyTransformer proto.InternalMessageInfo
 func (m *ExprFilter) Reset()      { *m = ExprFilter{} }
 func (*ExprFilter) ProtoMessage() {}
 func (*ExprFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{13}
+	return fileDescriptor_6c4bded897df1f16, []int{18}
 }
 func (m *ExprFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -443,7 +583,7 @@ var xxx_messageInfo_ExprFilter proto.InternalMessageInfo
 func (m *FileArtifact) Reset()      { *m = FileArtifact{} }
 func (*FileArtifact) ProtoMessage() {}
 func (*FileArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{14}
+	return fileDescriptor_6c4bded897df1f16, []int{19}
 }
 func (m *FileArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -468,10 +608,94 @@ func (m *FileArtifact) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_FileArtifact proto.InternalMessageInfo
 
+func (m *GCPCloudFunctionBatch) Reset()      { *m = GCPCloudFunctionBatch{} }
+func (*GCPCloudFunctionBatch) ProtoMessage() {}
+func (*GCPCloudFunctionBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c4bded897df1f16, []int{20}
+}
+func (m *GCPCloudFunctionBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GCPCloudFunctionBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *GCPCloudFunctionBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GCPCloudFunctionBatch.Merge(m, src)
+}
+func (m *GCPCloudFunctionBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *GCPCloudFunctionBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_GCPCloudFunctionBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GCPCloudFunctionBatch proto.InternalMessageInfo
+
+func (m *GCPCloudFunctionResponseLogging) Reset()      { *m = GCPCloudFunctionResponseLogging{} }
+func (*GCPCloudFunctionResponseLogging) ProtoMessage() {}
+func (*GCPCloudFunctionResponseLogging) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c4bded897df1f16, []int{21}
+}
+func (m *GCPCloudFunctionResponseLogging) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GCPCloudFunctionResponseLogging) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *GCPCloudFunctionResponseLogging) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GCPCloudFunctionResponseLogging.Merge(m, src)
+}
+func (m *GCPCloudFunctionResponseLogging) XXX_Size() int {
+	return m.Size()
+}
+func (m *GCPCloudFunctionResponseLogging) XXX_DiscardUnknown() {
+	xxx_messageInfo_GCPCloudFunctionResponseLogging.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GCPCloudFunctionResponseLogging proto.InternalMessageInfo
+
+func (m *GCPCloudFunctionTrigger) Reset()      { *m = GCPCloudFunctionTrigger{} }
+func (*GCPCloudFunctionTrigger) ProtoMessage() {}
+func (*GCPCloudFunctionTrigger) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c4bded897df1f16, []int{22}
+}
+func (m *GCPCloudFunctionTrigger) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GCPCloudFunctionTrigger) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *GCPCloudFunctionTrigger) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GCPCloudFunctionTrigger.Merge(m, src)
+}
+func (m *GCPCloudFunctionTrigger) XXX_Size() int {
+	return m.Size()
+}
+func (m *GCPCloudFunctionTrigger) XXX_DiscardUnknown() {
+	xxx_messageInfo_GCPCloudFunctionTrigger.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GCPCloudFunctionTrigger proto.InternalMessageInfo
+
 func (m *GitArtifact) Reset()      { *m = GitArtifact{} }
 func (*GitArtifact) ProtoMessage() {}
 func (*GitArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{15}
+	return fileDescriptor_6c4bded897df1f16, []int{23}
 }
 func (m *GitArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -499,7 +723,7 @@ var xxx_messageInfo_GitArtifact proto.InternalMessageInfo
 func (m *GitCreds) Reset()      { *m = GitCreds{} }
 func (*GitCreds) ProtoMessage() {}
 func (*GitCreds) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{16}
+	return fileDescriptor_6c4bded897df1f16, []int{24}
 }
 func (m *GitCreds) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -527,7 +751,7 @@ var xxx_messageInfo_GitCreds proto.InternalMessageInfo
 func (m *GitRemoteConfig) Reset()      { *m = GitRemoteConfig{} }
 func (*GitRemoteConfig) ProtoMessage() {}
 func (*GitRemoteConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{17}
+	return fileDescriptor_6c4bded897df1f16, []int{25}
 }
 func (m *GitRemoteConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -552,10 +776,38 @@ func (m *GitRemoteConfig) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GitRemoteConfig proto.InternalMessageInfo
 
+func (m *HTTPDeadLetterSink) Reset()      { *m = HTTPDeadLetterSink{} }
+func (*HTTPDeadLetterSink) ProtoMessage() {}
+func (*HTTPDeadLetterSink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c4bded897df1f16, []int{26}
+}
+func (m *HTTPDeadLetterSink) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HTTPDeadLetterSink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HTTPDeadLetterSink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HTTPDeadLetterSink.Merge(m, src)
+}
+func (m *HTTPDeadLetterSink) XXX_Size() int {
+	return m.Size()
+}
+func (m *HTTPDeadLetterSink) XXX_DiscardUnknown() {
+	xxx_messageInfo_HTTPDeadLetterSink.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HTTPDeadLetterSink proto.InternalMessageInfo
+
 func (m *HTTPTrigger) Reset()      { *m = HTTPTrigger{} }
 func (*HTTPTrigger) ProtoMessage() {}
 func (*HTTPTrigger) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{18}
+	return fileDescriptor_6c4bded897df1f16, []int{27}
 }
 func (m *HTTPTrigger) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -580,10 +832,94 @@ func (m *HTTPTrigger) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_HTTPTrigger proto.InternalMessageInfo
 
+func (m *Idempotency) Reset()      { *m = Idempotency{} }
+func (*Idempotency) ProtoMessage() {}
+func (*Idempotency) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c4bded897df1f16, []int{28}
+}
+func (m *Idempotency) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Idempotency) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Idempotency) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Idempotency.Merge(m, src)
+}
+func (m *Idempotency) XXX_Size() int {
+	return m.Size()
+}
+func (m *Idempotency) XXX_DiscardUnknown() {
+	xxx_messageInfo_Idempotency.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Idempotency proto.InternalMessageInfo
+
+func (m *JetStreamDeadLetterSink) Reset()      { *m = JetStreamDeadLetterSink{} }
+func (*JetStreamDeadLetterSink) ProtoMessage() {}
+func (*JetStreamDeadLetterSink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c4bded897df1f16, []int{29}
+}
+func (m *JetStreamDeadLetterSink) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JetStreamDeadLetterSink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *JetStreamDeadLetterSink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JetStreamDeadLetterSink.Merge(m, src)
+}
+func (m *JetStreamDeadLetterSink) XXX_Size() int {
+	return m.Size()
+}
+func (m *JetStreamDeadLetterSink) XXX_DiscardUnknown() {
+	xxx_messageInfo_JetStreamDeadLetterSink.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JetStreamDeadLetterSink proto.InternalMessageInfo
+
+func (m *JetStreamIdempotencyStore) Reset()      { *m = JetStreamIdempotencyStore{} }
+func (*JetStreamIdempotencyStore) ProtoMessage() {}
+func (*JetStreamIdempotencyStore) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c4bded897df1f16, []int{30}
+}
+func (m *JetStreamIdempotencyStore) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JetStreamIdempotencyStore) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *JetStreamIdempotencyStore) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JetStreamIdempotencyStore.Merge(m, src)
+}
+func (m *JetStreamIdempotencyStore) XXX_Size() int {
+	return m.Size()
+}
+func (m *JetStreamIdempotencyStore) XXX_DiscardUnknown() {
+	xxx_messageInfo_JetStreamIdempotencyStore.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JetStreamIdempotencyStore proto.InternalMessageInfo
+
 func (m *K8SResourcePolicy) Reset()      { *m = K8SResourcePolicy{} }
 func (*K8SResourcePolicy) ProtoMessage() {}
 func (*K8SResourcePolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{19}
+	return fileDescriptor_6c4bded897df1f16, []int{31}
 }
 func (m *K8SResourcePolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -611,7 +947,7 @@ var xxx_messageInfo_K8SResourcePolicy proto.InternalMessageInfo
 func (m *KafkaTrigger) Reset()      { *m = KafkaTrigger{} }
 func (*KafkaTrigger) ProtoMessage() {}
 func (*KafkaTrigger) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{20}
+	return fileDescriptor_6c4bded897df1f16, []int{32}
 }
 func (m *KafkaTrigger) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -639,7 +975,7 @@ var xxx_messageInfo_KafkaTrigger proto.InternalMessageInfo
 func (m *LogTrigger) Reset()      { *m = LogTrigger{} }
 func (*LogTrigger) ProtoMessage() {}
 func (*LogTrigger) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{21}
+	return fileDescriptor_6c4bded897df1f16, []int{33}
 }
 func (m *LogTrigger) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -667,7 +1003,7 @@ var xxx_messageInfo_LogTrigger proto.InternalMessageInfo
 func (m *NATSTrigger) Reset()      { *m = NATSTrigger{} }
 func (*NATSTrigger) ProtoMessage() {}
 func (*NATSTrigger) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{22}
+	return fileDescriptor_6c4bded897df1f16, []int{34}
 }
 func (m *NATSTrigger) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -695,7 +1031,7 @@ var xxx_messageInfo_NATSTrigger proto.InternalMessageInfo
 func (m *OpenWhiskTrigger) Reset()      { *m = OpenWhiskTrigger{} }
 func (*OpenWhiskTrigger) ProtoMessage() {}
 func (*OpenWhiskTrigger) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{23}
+	return fileDescriptor_6c4bded897df1f16, []int{35}
 }
 func (m *OpenWhiskTrigger) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -723,7 +1059,7 @@ var xxx_messageInfo_OpenWhiskTrigger proto.InternalMessageInfo
 func (m *PayloadField) Reset()      { *m = PayloadField{} }
 func (*PayloadField) ProtoMessage() {}
 func (*PayloadField) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{24}
+	return fileDescriptor_6c4bded897df1f16, []int{36}
 }
 func (m *PayloadField) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -751,7 +1087,7 @@ var xxx_messageInfo_PayloadField proto.InternalMessageInfo
 func (m *PulsarTrigger) Reset()      { *m = PulsarTrigger{} }
 func (*PulsarTrigger) ProtoMessage() {}
 func (*PulsarTrigger) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{25}
+	return fileDescriptor_6c4bded897df1f16, []int{37}
 }
 func (m *PulsarTrigger) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -776,10 +1112,66 @@ func (m *PulsarTrigger) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PulsarTrigger proto.InternalMessageInfo
 
-func (m *RateLimit) Reset()      { *m = RateLimit{} }
-func (*RateLimit) ProtoMessage() {}
+func (m *PushgatewayMetric) Reset()      { *m = PushgatewayMetric{} }
+func (*PushgatewayMetric) ProtoMessage() {}
+func (*PushgatewayMetric) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c4bded897df1f16, []int{38}
+}
+func (m *PushgatewayMetric) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PushgatewayMetric) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *PushgatewayMetric) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PushgatewayMetric.Merge(m, src)
+}
+func (m *PushgatewayMetric) XXX_Size() int {
+	return m.Size()
+}
+func (m *PushgatewayMetric) XXX_DiscardUnknown() {
+	xxx_messageInfo_PushgatewayMetric.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PushgatewayMetric proto.InternalMessageInfo
+
+func (m *PushgatewayTrigger) Reset()      { *m = PushgatewayTrigger{} }
+func (*PushgatewayTrigger) ProtoMessage() {}
+func (*PushgatewayTrigger) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c4bded897df1f16, []int{39}
+}
+func (m *PushgatewayTrigger) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PushgatewayTrigger) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *PushgatewayTrigger) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PushgatewayTrigger.Merge(m, src)
+}
+func (m *PushgatewayTrigger) XXX_Size() int {
+	return m.Size()
+}
+func (m *PushgatewayTrigger) XXX_DiscardUnknown() {
+	xxx_messageInfo_PushgatewayTrigger.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PushgatewayTrigger proto.InternalMessageInfo
+
+func (m *RateLimit) Reset()      { *m = RateLimit{} }
+func (*RateLimit) ProtoMessage() {}
 func (*RateLimit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{26}
+	return fileDescriptor_6c4bded897df1f16, []int{40}
 }
 func (m *RateLimit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -804,10 +1196,38 @@ func (m *RateLimit) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RateLimit proto.InternalMessageInfo
 
+func (m *RedisStreamTrigger) Reset()      { *m = RedisStreamTrigger{} }
+func (*RedisStreamTrigger) ProtoMessage() {}
+func (*RedisStreamTrigger) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c4bded897df1f16, []int{41}
+}
+func (m *RedisStreamTrigger) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RedisStreamTrigger) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RedisStreamTrigger) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedisStreamTrigger.Merge(m, src)
+}
+func (m *RedisStreamTrigger) XXX_Size() int {
+	return m.Size()
+}
+func (m *RedisStreamTrigger) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedisStreamTrigger.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedisStreamTrigger proto.InternalMessageInfo
+
 func (m *Sensor) Reset()      { *m = Sensor{} }
 func (*Sensor) ProtoMessage() {}
 func (*Sensor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{27}
+	return fileDescriptor_6c4bded897df1f16, []int{42}
 }
 func (m *Sensor) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -835,7 +1255,7 @@ var xxx_messageInfo_Sensor proto.InternalMessageInfo
 func (m *SensorList) Reset()      { *m = SensorList{} }
 func (*SensorList) ProtoMessage() {}
 func (*SensorList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{28}
+	return fileDescriptor_6c4bded897df1f16, []int{43}
 }
 func (m *SensorList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -863,7 +1283,7 @@ var xxx_messageInfo_SensorList proto.InternalMessageInfo
 func (m *SensorSpec) Reset()      { *m = SensorSpec{} }
 func (*SensorSpec) ProtoMessage() {}
 func (*SensorSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{29}
+	return fileDescriptor_6c4bded897df1f16, []int{44}
 }
 func (m *SensorSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -891,7 +1311,7 @@ var xxx_messageInfo_SensorSpec proto.InternalMessageInfo
 func (m *SensorStatus) Reset()      { *m = SensorStatus{} }
 func (*SensorStatus) ProtoMessage() {}
 func (*SensorStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{30}
+	return fileDescriptor_6c4bded897df1f16, []int{45}
 }
 func (m *SensorStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -919,7 +1339,7 @@ var xxx_messageInfo_SensorStatus proto.InternalMessageInfo
 func (m *SlackTrigger) Reset()      { *m = SlackTrigger{} }
 func (*SlackTrigger) ProtoMessage() {}
 func (*SlackTrigger) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{31}
+	return fileDescriptor_6c4bded897df1f16, []int{46}
 }
 func (m *SlackTrigger) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -947,7 +1367,7 @@ var xxx_messageInfo_SlackTrigger proto.InternalMessageInfo
 func (m *StandardK8STrigger) Reset()      { *m = StandardK8STrigger{} }
 func (*StandardK8STrigger) ProtoMessage() {}
 func (*StandardK8STrigger) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{32}
+	return fileDescriptor_6c4bded897df1f16, []int{47}
 }
 func (m *StandardK8STrigger) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -975,7 +1395,7 @@ var xxx_messageInfo_StandardK8STrigger proto.InternalMessageInfo
 func (m *StatusPolicy) Reset()      { *m = StatusPolicy{} }
 func (*StatusPolicy) ProtoMessage() {}
 func (*StatusPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{33}
+	return fileDescriptor_6c4bded897df1f16, []int{48}
 }
 func (m *StatusPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1003,7 +1423,7 @@ var xxx_messageInfo_StatusPolicy proto.InternalMessageInfo
 func (m *Template) Reset()      { *m = Template{} }
 func (*Template) ProtoMessage() {}
 func (*Template) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{34}
+	return fileDescriptor_6c4bded897df1f16, []int{49}
 }
 func (m *Template) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1031,7 +1451,7 @@ var xxx_messageInfo_Template proto.InternalMessageInfo
 func (m *TimeFilter) Reset()      { *m = TimeFilter{} }
 func (*TimeFilter) ProtoMessage() {}
 func (*TimeFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{35}
+	return fileDescriptor_6c4bded897df1f16, []int{50}
 }
 func (m *TimeFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1059,7 +1479,7 @@ var xxx_messageInfo_TimeFilter proto.InternalMessageInfo
 func (m *Trigger) Reset()      { *m = Trigger{} }
 func (*Trigger) ProtoMessage() {}
 func (*Trigger) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{36}
+	return fileDescriptor_6c4bded897df1f16, []int{51}
 }
 func (m *Trigger) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1084,10 +1504,66 @@ func (m *Trigger) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Trigger proto.InternalMessageInfo
 
+func (m *TriggerCircuitBreaker) Reset()      { *m = TriggerCircuitBreaker{} }
+func (*TriggerCircuitBreaker) ProtoMessage() {}
+func (*TriggerCircuitBreaker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c4bded897df1f16, []int{52}
+}
+func (m *TriggerCircuitBreaker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TriggerCircuitBreaker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *TriggerCircuitBreaker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TriggerCircuitBreaker.Merge(m, src)
+}
+func (m *TriggerCircuitBreaker) XXX_Size() int {
+	return m.Size()
+}
+func (m *TriggerCircuitBreaker) XXX_DiscardUnknown() {
+	xxx_messageInfo_TriggerCircuitBreaker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TriggerCircuitBreaker proto.InternalMessageInfo
+
+func (m *TriggerDedupe) Reset()      { *m = TriggerDedupe{} }
+func (*TriggerDedupe) ProtoMessage() {}
+func (*TriggerDedupe) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c4bded897df1f16, []int{53}
+}
+func (m *TriggerDedupe) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TriggerDedupe) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *TriggerDedupe) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TriggerDedupe.Merge(m, src)
+}
+func (m *TriggerDedupe) XXX_Size() int {
+	return m.Size()
+}
+func (m *TriggerDedupe) XXX_DiscardUnknown() {
+	xxx_messageInfo_TriggerDedupe.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TriggerDedupe proto.InternalMessageInfo
+
 func (m *TriggerParameter) Reset()      { *m = TriggerParameter{} }
 func (*TriggerParameter) ProtoMessage() {}
 func (*TriggerParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{37}
+	return fileDescriptor_6c4bded897df1f16, []int{54}
 }
 func (m *TriggerParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1115,7 +1591,7 @@ var xxx_messageInfo_TriggerParameter proto.InternalMessageInfo
 func (m *TriggerParameterSource) Reset()      { *m = TriggerParameterSource{} }
 func (*TriggerParameterSource) ProtoMessage() {}
 func (*TriggerParameterSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{38}
+	return fileDescriptor_6c4bded897df1f16, []int{55}
 }
 func (m *TriggerParameterSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1143,7 +1619,7 @@ var xxx_messageInfo_TriggerParameterSource proto.InternalMessageInfo
 func (m *TriggerPolicy) Reset()      { *m = TriggerPolicy{} }
 func (*TriggerPolicy) ProtoMessage() {}
 func (*TriggerPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{39}
+	return fileDescriptor_6c4bded897df1f16, []int{56}
 }
 func (m *TriggerPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1168,10 +1644,38 @@ func (m *TriggerPolicy) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TriggerPolicy proto.InternalMessageInfo
 
+func (m *TriggerRedelivery) Reset()      { *m = TriggerRedelivery{} }
+func (*TriggerRedelivery) ProtoMessage() {}
+func (*TriggerRedelivery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c4bded897df1f16, []int{57}
+}
+func (m *TriggerRedelivery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TriggerRedelivery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *TriggerRedelivery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TriggerRedelivery.Merge(m, src)
+}
+func (m *TriggerRedelivery) XXX_Size() int {
+	return m.Size()
+}
+func (m *TriggerRedelivery) XXX_DiscardUnknown() {
+	xxx_messageInfo_TriggerRedelivery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TriggerRedelivery proto.InternalMessageInfo
+
 func (m *TriggerTemplate) Reset()      { *m = TriggerTemplate{} }
 func (*TriggerTemplate) ProtoMessage() {}
 func (*TriggerTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{40}
+	return fileDescriptor_6c4bded897df1f16, []int{58}
 }
 func (m *TriggerTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1199,7 +1703,7 @@ var xxx_messageInfo_TriggerTemplate proto.InternalMessageInfo
 func (m *URLArtifact) Reset()      { *m = URLArtifact{} }
 func (*URLArtifact) ProtoMessage() {}
 func (*URLArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{41}
+	return fileDescriptor_6c4bded897df1f16, []int{59}
 }
 func (m *URLArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1226,26 +1730,42 @@ var xxx_messageInfo_URLArtifact proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*AWSLambdaTrigger)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.AWSLambdaTrigger")
+	proto.RegisterType((*AWSSQSTrigger)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.AWSSQSTrigger")
 	proto.RegisterType((*ArgoWorkflowTrigger)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.ArgoWorkflowTrigger")
 	proto.RegisterType((*ArtifactLocation)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.ArtifactLocation")
 	proto.RegisterType((*AzureEventHubsTrigger)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.AzureEventHubsTrigger")
+	proto.RegisterType((*BusTrigger)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.BusTrigger")
+	proto.RegisterType((*CloudEventEnvelope)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.CloudEventEnvelope")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.CloudEventEnvelope.ExtensionsEntry")
 	proto.RegisterType((*ConditionsResetByTime)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.ConditionsResetByTime")
 	proto.RegisterType((*ConditionsResetCriteria)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.ConditionsResetCriteria")
 	proto.RegisterType((*CustomTrigger)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.CustomTrigger")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.CustomTrigger.SpecEntry")
 	proto.RegisterType((*DataFilter)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.DataFilter")
+	proto.RegisterType((*DeadLetter)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.DeadLetter")
 	proto.RegisterType((*Event)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.Event")
+	proto.RegisterType((*EventBusDeadLetterSink)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.EventBusDeadLetterSink")
 	proto.RegisterType((*EventContext)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.EventContext")
 	proto.RegisterType((*EventDependency)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.EventDependency")
 	proto.RegisterType((*EventDependencyFilter)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.EventDependencyFilter")
 	proto.RegisterType((*EventDependencyTransformer)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.EventDependencyTransformer")
 	proto.RegisterType((*ExprFilter)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.ExprFilter")
 	proto.RegisterType((*FileArtifact)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.FileArtifact")
+	proto.RegisterType((*GCPCloudFunctionBatch)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.GCPCloudFunctionBatch")
+	proto.RegisterType((*GCPCloudFunctionResponseLogging)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.GCPCloudFunctionResponseLogging")
+	proto.RegisterType((*GCPCloudFunctionTrigger)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.GCPCloudFunctionTrigger")
+	proto.RegisterMapType((map[string]v1.SecretKeySelector)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.GCPCloudFunctionTrigger.CredentialsEntry")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.GCPCloudFunctionTrigger.HeadersEntry")
 	proto.RegisterType((*GitArtifact)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.GitArtifact")
 	proto.RegisterType((*GitCreds)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.GitCreds")
 	proto.RegisterType((*GitRemoteConfig)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.GitRemoteConfig")
+	proto.RegisterType((*HTTPDeadLetterSink)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.HTTPDeadLetterSink")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.HTTPDeadLetterSink.HeadersEntry")
 	proto.RegisterType((*HTTPTrigger)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.HTTPTrigger")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.HTTPTrigger.HeadersEntry")
+	proto.RegisterType((*Idempotency)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.Idempotency")
+	proto.RegisterType((*JetStreamDeadLetterSink)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.JetStreamDeadLetterSink")
+	proto.RegisterType((*JetStreamIdempotencyStore)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.JetStreamIdempotencyStore")
 	proto.RegisterType((*K8SResourcePolicy)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.K8SResourcePolicy")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.K8SResourcePolicy.LabelsEntry")
 	proto.RegisterType((*KafkaTrigger)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.KafkaTrigger")
@@ -1254,7 +1774,13 @@ func init() {
 	proto.RegisterType((*OpenWhiskTrigger)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.OpenWhiskTrigger")
 	proto.RegisterType((*PayloadField)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.PayloadField")
 	proto.RegisterType((*PulsarTrigger)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.PulsarTrigger")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.PulsarTrigger.PropertiesEntry")
+	proto.RegisterType((*PushgatewayMetric)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.PushgatewayMetric")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.PushgatewayMetric.LabelsEntry")
+	proto.RegisterType((*PushgatewayTrigger)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.PushgatewayTrigger")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.PushgatewayTrigger.GroupingEntry")
 	proto.RegisterType((*RateLimit)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.RateLimit")
+	proto.RegisterType((*RedisStreamTrigger)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.RedisStreamTrigger")
 	proto.RegisterType((*Sensor)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.Sensor")
 	proto.RegisterType((*SensorList)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.SensorList")
 	proto.RegisterType((*SensorSpec)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.SensorSpec")
@@ -1266,9 +1792,12 @@ func init() {
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.Template.NodeSelectorEntry")
 	proto.RegisterType((*TimeFilter)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.TimeFilter")
 	proto.RegisterType((*Trigger)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.Trigger")
+	proto.RegisterType((*TriggerCircuitBreaker)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.TriggerCircuitBreaker")
+	proto.RegisterType((*TriggerDedupe)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.TriggerDedupe")
 	proto.RegisterType((*TriggerParameter)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.TriggerParameter")
 	proto.RegisterType((*TriggerParameterSource)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.TriggerParameterSource")
 	proto.RegisterType((*TriggerPolicy)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.TriggerPolicy")
+	proto.RegisterType((*TriggerRedelivery)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.TriggerRedelivery")
 	proto.RegisterType((*TriggerTemplate)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.TriggerTemplate")
 	proto.RegisterType((*URLArtifact)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.URLArtifact")
 }
@@ -1278,276 +1807,414 @@ func init() {
 }
 
 var fileDescriptor_6c4bded897df1f16 = []byte{
-	// 4293 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x3b, 0x4b, 0x6c, 0x63, 0xc9,
-	0x71, 0x43, 0x8a, 0x94, 0xc8, 0x12, 0x25, 0xcd, 0xf4, 0x78, 0x76, 0x69, 0x79, 0x57, 0x1c, 0xd0,
-	0x88, 0x33, 0x36, 0x6c, 0x6a, 0x77, 0x36, 0x89, 0xe5, 0x0d, 0x62, 0x2f, 0x45, 0x49, 0x3b, 0x1f,
-	0xce, 0x48, 0x5b, 0x8f, 0x9a, 0x45, 0x3e, 0xc0, 0xee, 0xd3, 0x63, 0x93, 0x7c, 0xa3, 0xc7, 0xf7,
-	0x38, 0xdd, 0x4d, 0xcd, 0xca, 0x80, 0x13, 0x3b, 0x46, 0x0e, 0x41, 0x80, 0x4d, 0x0e, 0x39, 0xe4,
-	0x14, 0x24, 0x87, 0x9c, 0x92, 0x43, 0x82, 0x1c, 0x73, 0xf3, 0x69, 0x81, 0x5c, 0x36, 0x87, 0x00,
-	0x3e, 0x04, 0x42, 0x56, 0x3e, 0xe5, 0x60, 0x24, 0xbe, 0xce, 0x29, 0xe8, 0xcf, 0xfb, 0x92, 0xe3,
-	0x91, 0x86, 0x8a, 0x26, 0x80, 0x6f, 0x64, 0x55, 0x75, 0x55, 0x77, 0x75, 0x55, 0x75, 0x55, 0x75,
-	0x3f, 0xb8, 0xd3, 0x77, 0xc5, 0x60, 0x7c, 0xd0, 0x70, 0x82, 0xe1, 0xba, 0xcd, 0xfa, 0xc1, 0x88,
-	0x05, 0x8f, 0xd5, 0x8f, 0x6f, 0xd1, 0x23, 0xea, 0x0b, 0xbe, 0x3e, 0x3a, 0xec, 0xaf, 0xdb, 0x23,
-	0x97, 0xaf, 0x73, 0xea, 0xf3, 0x80, 0xad, 0x1f, 0xbd, 0x6d, 0x7b, 0xa3, 0x81, 0xfd, 0xf6, 0x7a,
-	0x9f, 0xfa, 0x94, 0xd9, 0x82, 0x76, 0x1b, 0x23, 0x16, 0x88, 0x80, 0x6c, 0xc4, 0x9c, 0x1a, 0x21,
-	0x27, 0xf5, 0xe3, 0x23, 0xcd, 0xa9, 0x31, 0x3a, 0xec, 0x37, 0x24, 0xa7, 0x86, 0xe6, 0xd4, 0x08,
-	0x39, 0xad, 0x7e, 0xef, 0xcc, 0x73, 0x70, 0x82, 0xe1, 0x30, 0xf0, 0xb3, 0xa2, 0x57, 0xbf, 0x95,
-	0x60, 0xd0, 0x0f, 0xfa, 0xc1, 0xba, 0x02, 0x1f, 0x8c, 0x7b, 0xea, 0x9f, 0xfa, 0xa3, 0x7e, 0x19,
-	0xf2, 0xfa, 0xe1, 0x06, 0x6f, 0xb8, 0x81, 0x64, 0xb9, 0xee, 0x04, 0x8c, 0xae, 0x1f, 0x4d, 0xac,
-	0x66, 0xf5, 0x37, 0x62, 0x9a, 0xa1, 0xed, 0x0c, 0x5c, 0x9f, 0xb2, 0xe3, 0x78, 0x1e, 0x43, 0x2a,
-	0xec, 0x69, 0xa3, 0xd6, 0x9f, 0x37, 0x8a, 0x8d, 0x7d, 0xe1, 0x0e, 0xe9, 0xc4, 0x80, 0xdf, 0x7a,
-	0xd1, 0x00, 0xee, 0x0c, 0xe8, 0xd0, 0xce, 0x8e, 0xab, 0x3f, 0x2b, 0xc0, 0xd5, 0xe6, 0x87, 0x56,
-	0xdb, 0x1e, 0x1e, 0x74, 0xed, 0x0e, 0x73, 0xfb, 0x7d, 0xca, 0xc8, 0x06, 0x54, 0x7a, 0x63, 0xdf,
-	0x11, 0x6e, 0xe0, 0x3f, 0xb4, 0x87, 0xb4, 0x9a, 0xbb, 0x99, 0xbb, 0x55, 0xde, 0xfc, 0xd2, 0x67,
-	0x27, 0xb5, 0x2b, 0xa7, 0x27, 0xb5, 0xca, 0x4e, 0x02, 0x87, 0x29, 0x4a, 0x82, 0x50, 0xb6, 0x1d,
-	0x87, 0x72, 0x7e, 0x9f, 0x1e, 0x57, 0xf3, 0x37, 0x73, 0xb7, 0x16, 0x6f, 0xff, 0x5a, 0x43, 0x4f,
-	0x4d, 0x6e, 0x59, 0x43, 0x6a, 0xa9, 0x71, 0xf4, 0x76, 0xc3, 0xa2, 0x0e, 0xa3, 0xe2, 0x3e, 0x3d,
-	0xb6, 0xa8, 0x47, 0x1d, 0x11, 0xb0, 0xcd, 0xa5, 0xd3, 0x93, 0x5a, 0xb9, 0x19, 0x8e, 0xc5, 0x98,
-	0x8d, 0xe4, 0xc9, 0x43, 0xf2, 0xea, 0xdc, 0xb9, 0x79, 0x46, 0x60, 0x8c, 0xd9, 0x90, 0xaf, 0xc1,
-	0x3c, 0xa3, 0x7d, 0x37, 0xf0, 0xab, 0x05, 0xb5, 0xb6, 0x65, 0xb3, 0xb6, 0x79, 0x54, 0x50, 0x34,
-	0x58, 0x32, 0x86, 0x85, 0x91, 0x7d, 0xec, 0x05, 0x76, 0xb7, 0x5a, 0xbc, 0x39, 0x77, 0x6b, 0xf1,
-	0xf6, 0xbd, 0xc6, 0xcb, 0x5a, 0x67, 0xc3, 0x68, 0x77, 0xcf, 0x66, 0xf6, 0x90, 0x0a, 0xca, 0x36,
-	0x57, 0x8c, 0xd0, 0x85, 0x3d, 0x2d, 0x02, 0x43, 0x59, 0xe4, 0x0f, 0x01, 0x46, 0x21, 0x19, 0xaf,
-	0xce, 0x5f, 0xb8, 0x64, 0x62, 0x24, 0x43, 0x04, 0xe2, 0x98, 0x90, 0x48, 0xde, 0x85, 0x65, 0xd7,
-	0x3f, 0x0a, 0x1c, 0x5b, 0x6e, 0x6c, 0xe7, 0x78, 0x44, 0xab, 0x0b, 0x4a, 0x4d, 0xe4, 0xf4, 0xa4,
-	0xb6, 0x7c, 0x37, 0x85, 0xc1, 0x0c, 0x25, 0xf9, 0x3a, 0x2c, 0xb0, 0xc0, 0xa3, 0x4d, 0x7c, 0x58,
-	0x2d, 0xa9, 0x41, 0xd1, 0x32, 0x51, 0x83, 0x31, 0xc4, 0xd7, 0x7f, 0x9e, 0x87, 0xeb, 0x4d, 0xd6,
-	0x0f, 0x3e, 0x0c, 0xd8, 0x61, 0xcf, 0x0b, 0x9e, 0x86, 0xf6, 0xe7, 0xc3, 0x3c, 0x0f, 0xc6, 0xcc,
-	0xd1, 0x96, 0x37, 0xd3, 0xd2, 0x9b, 0x4c, 0xb8, 0x3d, 0xdb, 0x11, 0x6d, 0x33, 0xc5, 0x4d, 0x90,
-	0xbb, 0x6c, 0x29, 0xee, 0x68, 0xa4, 0x90, 0x3b, 0x50, 0x0e, 0x46, 0xd2, 0x2d, 0xa4, 0x41, 0xe4,
-	0xd5, 0xa4, 0xbf, 0x61, 0x26, 0x5d, 0xde, 0x0d, 0x11, 0xcf, 0x4e, 0x6a, 0x37, 0x92, 0x93, 0x8d,
-	0x10, 0x18, 0x0f, 0xce, 0x6c, 0xdc, 0xdc, 0xa5, 0x6f, 0xdc, 0x1b, 0x50, 0xb0, 0x59, 0x9f, 0x57,
-	0x0b, 0x37, 0xe7, 0x6e, 0x95, 0x37, 0x4b, 0xa7, 0x27, 0xb5, 0x42, 0x93, 0xf5, 0x39, 0x2a, 0x68,
-	0xfd, 0x17, 0xd2, 0xd9, 0x33, 0x0a, 0x21, 0x16, 0xe4, 0xf9, 0x3b, 0x46, 0xd1, 0xbf, 0x7d, 0xf6,
-	0xa9, 0xea, 0x08, 0xda, 0xb0, 0xde, 0x09, 0x19, 0x6e, 0xce, 0x9f, 0x9e, 0xd4, 0xf2, 0xd6, 0x3b,
-	0x98, 0xe7, 0xef, 0x90, 0x3a, 0xcc, 0xbb, 0xbe, 0xe7, 0xfa, 0xd4, 0xa8, 0x53, 0x69, 0xfd, 0xae,
-	0x82, 0xa0, 0xc1, 0x90, 0x2e, 0x14, 0x7a, 0xae, 0x47, 0x8d, 0x4b, 0xef, 0xbc, 0xbc, 0x96, 0x76,
-	0x5c, 0x8f, 0x46, 0xb3, 0x50, 0x6b, 0x96, 0x10, 0x54, 0xdc, 0xc9, 0xc7, 0x30, 0x37, 0x66, 0x9e,
-	0x72, 0xf3, 0xc5, 0xdb, 0xdb, 0x2f, 0x2f, 0x64, 0x1f, 0xdb, 0x91, 0x8c, 0x85, 0xd3, 0x93, 0xda,
-	0xdc, 0x3e, 0xb6, 0x51, 0xb2, 0x26, 0xfb, 0x50, 0x76, 0x02, 0xbf, 0xe7, 0xf6, 0x87, 0xf6, 0xa8,
-	0x5a, 0x54, 0x72, 0x6e, 0x4d, 0x8b, 0x4f, 0x2d, 0x45, 0xf4, 0xc0, 0x1e, 0x4d, 0x84, 0xa8, 0x56,
-	0x38, 0x1c, 0x63, 0x4e, 0x72, 0xe2, 0x7d, 0x57, 0x54, 0xe7, 0x67, 0x9d, 0xf8, 0xfb, 0xae, 0x48,
-	0x4f, 0xfc, 0x7d, 0x57, 0xa0, 0x64, 0x4d, 0x1c, 0x28, 0x31, 0x6a, 0x1c, 0x6d, 0x41, 0x89, 0xf9,
-	0xce, 0xb9, 0xf7, 0x1f, 0x0d, 0x83, 0xcd, 0xca, 0xe9, 0x49, 0xad, 0x14, 0xfe, 0xc3, 0x88, 0x71,
-	0xfd, 0x9f, 0x0b, 0x70, 0xa3, 0xf9, 0xfd, 0x31, 0xa3, 0xdb, 0x92, 0xc1, 0x9d, 0xf1, 0x01, 0x0f,
-	0xbd, 0xfc, 0x26, 0x14, 0x7a, 0x4f, 0xba, 0xbe, 0x39, 0x5d, 0x2a, 0xc6, 0xb2, 0x0b, 0x3b, 0x1f,
-	0x6c, 0x3d, 0x44, 0x85, 0x91, 0xa1, 0x64, 0x30, 0x3e, 0x50, 0x47, 0x50, 0x3e, 0x1d, 0x4a, 0xee,
-	0x68, 0x30, 0x86, 0x78, 0x32, 0x82, 0xeb, 0x7c, 0x60, 0x33, 0xda, 0x8d, 0x8e, 0x10, 0x35, 0xec,
-	0x5c, 0xc7, 0xc5, 0xeb, 0xa7, 0x27, 0xb5, 0xeb, 0xd6, 0x24, 0x17, 0x9c, 0xc6, 0x9a, 0x74, 0x61,
-	0x25, 0x03, 0x36, 0x46, 0x76, 0x46, 0x69, 0xd7, 0x4f, 0x4f, 0x6a, 0x2b, 0x19, 0x69, 0x98, 0x65,
-	0xf9, 0x2b, 0x7a, 0x00, 0xd5, 0xfb, 0x70, 0xa3, 0x15, 0xf8, 0x5d, 0x57, 0x46, 0x28, 0x8e, 0x94,
-	0x53, 0xb1, 0x79, 0xdc, 0x71, 0x87, 0x54, 0x1a, 0x8d, 0xc3, 0x82, 0x09, 0xa3, 0x69, 0xb1, 0xc0,
-	0x47, 0x85, 0x21, 0xdf, 0x84, 0x92, 0x4c, 0x78, 0xbe, 0x1f, 0x44, 0xc1, 0xe7, 0xaa, 0xa1, 0x2a,
-	0x75, 0x0c, 0x1c, 0x23, 0x8a, 0xfa, 0xa7, 0x39, 0x78, 0x3d, 0x23, 0xa9, 0xc5, 0x5c, 0x41, 0x99,
-	0x6b, 0x13, 0x0e, 0xf3, 0x07, 0x4a, 0xaa, 0x89, 0x8e, 0xbb, 0x2f, 0xaf, 0x80, 0xa9, 0x8b, 0xd1,
-	0x51, 0x51, 0xff, 0x46, 0x23, 0xaa, 0xfe, 0x8f, 0x45, 0x58, 0x6a, 0x8d, 0xb9, 0x08, 0x86, 0xa1,
-	0x9f, 0xac, 0xcb, 0xfc, 0x87, 0x1d, 0x51, 0xb6, 0x8f, 0x6d, 0xb3, 0xee, 0x6b, 0xe1, 0xe9, 0x64,
-	0x85, 0x08, 0x8c, 0x69, 0x64, 0x72, 0xc3, 0xa9, 0x33, 0x66, 0x7a, 0xfd, 0xa5, 0x38, 0xb9, 0xb1,
-	0x14, 0x14, 0x0d, 0x96, 0xec, 0x03, 0x38, 0x94, 0x09, 0x6d, 0x9a, 0xe7, 0x73, 0x95, 0x65, 0xb9,
-	0x77, 0xad, 0x68, 0x30, 0x26, 0x18, 0x91, 0x7b, 0x40, 0xf4, 0x5c, 0xa4, 0x9b, 0xec, 0x1e, 0x51,
-	0xc6, 0xdc, 0x2e, 0x35, 0x79, 0xd6, 0xaa, 0x99, 0x0a, 0xb1, 0x26, 0x28, 0x70, 0xca, 0x28, 0xc2,
-	0xa1, 0xc0, 0x47, 0xd4, 0x31, 0xb6, 0xff, 0xc1, 0x0c, 0x1b, 0x90, 0x54, 0x69, 0xc3, 0x1a, 0x51,
-	0x67, 0xdb, 0x17, 0xec, 0x38, 0xb6, 0x20, 0x09, 0x42, 0x25, 0xec, 0x95, 0x67, 0x5f, 0x09, 0x9f,
-	0x5f, 0xb8, 0x3c, 0x9f, 0x5f, 0xfd, 0x36, 0x94, 0x23, 0xbd, 0x90, 0xab, 0x30, 0x77, 0x48, 0x8f,
-	0xb5, 0xb9, 0xa1, 0xfc, 0x49, 0xbe, 0x04, 0xc5, 0x23, 0xdb, 0x1b, 0x1b, 0xa7, 0x42, 0xfd, 0xe7,
-	0xdd, 0xfc, 0x46, 0xae, 0xfe, 0xf3, 0x1c, 0xc0, 0x96, 0x2d, 0xec, 0x1d, 0xd7, 0x13, 0x3a, 0xae,
-	0x8f, 0x6c, 0x31, 0xc8, 0xba, 0xe8, 0x9e, 0x2d, 0x06, 0xa8, 0x30, 0xe4, 0x9b, 0x50, 0x10, 0x32,
-	0xa9, 0xd4, 0xee, 0x59, 0x0d, 0x29, 0x64, 0xfa, 0xf8, 0xec, 0xa4, 0x56, 0xba, 0x67, 0xed, 0x3e,
-	0x54, 0xa9, 0xa5, 0xa2, 0x22, 0xb5, 0x50, 0xf0, 0x9c, 0x4a, 0x6a, 0xca, 0xa7, 0x27, 0xb5, 0xe2,
-	0x23, 0x09, 0x30, 0x73, 0x20, 0xef, 0x01, 0x38, 0xc1, 0x50, 0x2a, 0x50, 0x04, 0xcc, 0x18, 0xda,
-	0xcd, 0x50, 0xc7, 0xad, 0x08, 0xf3, 0x2c, 0xf5, 0x0f, 0x13, 0x63, 0x54, 0xcc, 0xa0, 0xc3, 0x91,
-	0x67, 0x0b, 0xaa, 0x4e, 0xf0, 0x64, 0xcc, 0x30, 0x70, 0x8c, 0x28, 0xea, 0x7f, 0x9d, 0x83, 0xa2,
-	0x3a, 0xcd, 0xc8, 0x10, 0x16, 0x9c, 0xc0, 0x17, 0xf4, 0x13, 0x61, 0x42, 0xc4, 0x0c, 0x59, 0x8c,
-	0xe2, 0xd8, 0xd2, 0xdc, 0x36, 0x17, 0xe5, 0x0e, 0x99, 0x3f, 0x18, 0xca, 0x90, 0xd9, 0x5d, 0xd7,
-	0x16, 0xb6, 0xd2, 0x5b, 0x45, 0x67, 0x3a, 0x52, 0xef, 0xa8, 0xa0, 0xef, 0x96, 0xfe, 0xea, 0x6f,
-	0x6a, 0x57, 0x7e, 0xf8, 0x1f, 0x37, 0xaf, 0xd4, 0x7f, 0x91, 0x87, 0x4a, 0x92, 0x1d, 0x59, 0x85,
-	0xbc, 0xdb, 0x35, 0x1b, 0x02, 0x66, 0x65, 0xf9, 0xbb, 0x5b, 0x98, 0x77, 0xbb, 0x2a, 0x5a, 0xe8,
-	0x1c, 0x20, 0x9f, 0x2e, 0x85, 0x32, 0x49, 0xf2, 0x6f, 0xc2, 0xa2, 0xf4, 0x8e, 0x23, 0xca, 0xb8,
-	0x4c, 0x93, 0xe7, 0x14, 0xf1, 0x75, 0x43, 0xbc, 0x28, 0x2d, 0xe7, 0x91, 0x46, 0x61, 0x92, 0x4e,
-	0x5a, 0x83, 0xda, 0xeb, 0x42, 0xda, 0x1a, 0x12, 0xfb, 0xdb, 0x84, 0x15, 0x39, 0x7f, 0xb5, 0x48,
-	0x5f, 0x28, 0x62, 0xbd, 0x07, 0xaf, 0x1b, 0xe2, 0x15, 0xb9, 0xc8, 0x96, 0x46, 0xab, 0x71, 0x59,
-	0x7a, 0x99, 0x28, 0xf0, 0xf1, 0xc1, 0x63, 0xea, 0xe8, 0x7c, 0x29, 0x91, 0x28, 0x58, 0x1a, 0x8c,
-	0x21, 0x9e, 0xb4, 0xa1, 0x20, 0x83, 0xbf, 0x49, 0x78, 0xbe, 0x91, 0x08, 0x77, 0x51, 0xdd, 0x1c,
-	0xef, 0x91, 0x2c, 0xcf, 0x65, 0x00, 0x54, 0xd1, 0x3a, 0x9e, 0xbb, 0x8c, 0xd7, 0x8a, 0x4b, 0x42,
-	0xe7, 0x9f, 0x16, 0x60, 0x45, 0xe9, 0x7c, 0x8b, 0x8e, 0xa8, 0xdf, 0xa5, 0xbe, 0x73, 0x2c, 0xd7,
-	0xee, 0xc7, 0xf5, 0x73, 0x34, 0x5e, 0xe5, 0x14, 0x0a, 0x23, 0xd7, 0xae, 0xec, 0x42, 0xeb, 0x3a,
-	0x91, 0xe9, 0x44, 0x6b, 0xdf, 0x4e, 0xa3, 0x31, 0x4b, 0x2f, 0x8f, 0x07, 0x05, 0x8a, 0xf2, 0x9d,
-	0xc4, 0xf1, 0xb0, 0x1d, 0x22, 0x30, 0xa6, 0x21, 0x47, 0xb0, 0xd0, 0x53, 0x9e, 0xca, 0x4d, 0xc2,
-	0xb2, 0x3b, 0xa3, 0xd1, 0xc6, 0x2b, 0xd6, 0x11, 0x40, 0x5b, 0xaf, 0xfe, 0xcd, 0x31, 0x14, 0x46,
-	0x7e, 0x94, 0x83, 0xb2, 0x60, 0xb6, 0xcf, 0x7b, 0x01, 0x1b, 0x9a, 0x44, 0xb9, 0x73, 0x61, 0xa2,
-	0x3b, 0x21, 0x67, 0x6a, 0x92, 0xea, 0x08, 0x80, 0xb1, 0x54, 0xe2, 0xc2, 0x6b, 0x66, 0x3a, 0xed,
-	0xa0, 0xef, 0x3a, 0xb6, 0xa7, 0xab, 0xb8, 0x80, 0x19, 0xbb, 0x79, 0xdb, 0x68, 0xee, 0xb5, 0x9d,
-	0xa9, 0x54, 0xcf, 0x4e, 0x6a, 0x2b, 0x19, 0x10, 0x3e, 0x87, 0x61, 0xfd, 0x47, 0x45, 0xb8, 0x31,
-	0x55, 0x3d, 0xe4, 0xc0, 0x98, 0xa0, 0x0e, 0x19, 0x5b, 0x33, 0x04, 0x77, 0x77, 0x48, 0x8d, 0xca,
-	0x4b, 0x69, 0xc3, 0x4c, 0x46, 0xa6, 0xfc, 0x25, 0x44, 0xa6, 0x9e, 0x89, 0x4c, 0xba, 0xe2, 0x9d,
-	0x61, 0x49, 0xf1, 0x39, 0x12, 0xfb, 0x4b, 0x1c, 0xe3, 0x88, 0x0b, 0x45, 0xfa, 0xc9, 0x88, 0xe9,
-	0x02, 0x77, 0x26, 0x41, 0xdb, 0x9f, 0x8c, 0x98, 0x11, 0xb4, 0x64, 0x04, 0x15, 0x25, 0x8c, 0xa3,
-	0x96, 0x40, 0x3e, 0x86, 0xeb, 0x52, 0x64, 0xd6, 0x4e, 0x74, 0x68, 0x6a, 0x98, 0x21, 0xd7, 0xb7,
-	0x26, 0x49, 0xa6, 0x19, 0xc9, 0x34, 0x56, 0x52, 0x82, 0x14, 0x35, 0xdd, 0x12, 0x23, 0x09, 0xdb,
-	0x93, 0x24, 0x53, 0x25, 0x4c, 0x61, 0x55, 0xff, 0x18, 0x56, 0x9f, 0xef, 0x26, 0xf2, 0x54, 0x78,
-	0xfc, 0x24, 0x7b, 0x2a, 0xdc, 0xfb, 0x00, 0xf3, 0x8f, 0x9f, 0xa8, 0x53, 0xc1, 0x61, 0xee, 0x48,
-	0x4c, 0x9c, 0x0a, 0x0a, 0x8a, 0x06, 0x2b, 0xcf, 0x42, 0x88, 0x55, 0x29, 0x23, 0x9e, 0x9c, 0x47,
-	0x36, 0xe2, 0x49, 0x0a, 0x54, 0x18, 0xe2, 0xc3, 0x7c, 0xcf, 0xa5, 0x5e, 0x97, 0x57, 0xf3, 0x6a,
-	0x0b, 0x67, 0xb0, 0x4b, 0x93, 0xc1, 0xec, 0x48, 0x76, 0xf1, 0x04, 0xd5, 0x5f, 0x8e, 0x46, 0x4a,
-	0xfd, 0x2d, 0xa8, 0x24, 0xfb, 0x03, 0x2f, 0xce, 0x4e, 0xea, 0xff, 0x54, 0x80, 0xc5, 0x44, 0xd1,
-	0x4c, 0xde, 0xd4, 0x1d, 0x04, 0x3d, 0x60, 0xd1, 0x0c, 0x88, 0xcb, 0xff, 0xef, 0xc2, 0xb2, 0xe3,
-	0x05, 0x3e, 0xdd, 0x72, 0x99, 0xca, 0x8d, 0x8f, 0x8d, 0xc6, 0x5e, 0x33, 0x94, 0xcb, 0xad, 0x14,
-	0x16, 0x33, 0xd4, 0xc4, 0x81, 0xa2, 0xc3, 0x68, 0x97, 0x9b, 0x04, 0x7c, 0x73, 0xa6, 0x4a, 0xbf,
-	0x25, 0x39, 0xe9, 0x14, 0x49, 0xfd, 0x44, 0xcd, 0x9b, 0xfc, 0x3e, 0x54, 0x38, 0x1f, 0xa8, 0x0c,
-	0x5e, 0x25, 0xfb, 0xe7, 0xaa, 0x54, 0xaf, 0x9e, 0x9e, 0xd4, 0x2a, 0x96, 0x75, 0x27, 0x1a, 0x8e,
-	0x29, 0x66, 0x32, 0x7b, 0xea, 0xb9, 0x1e, 0x95, 0x2a, 0xcc, 0x66, 0x4f, 0x3b, 0x06, 0x8e, 0x11,
-	0x85, 0xb4, 0xac, 0x03, 0x66, 0xfb, 0xce, 0xc0, 0x18, 0x7a, 0xb4, 0x71, 0x9b, 0x0a, 0x8a, 0x06,
-	0x2b, 0xd5, 0x2e, 0xec, 0xbe, 0x69, 0x3c, 0x46, 0x6a, 0xef, 0xd8, 0x7d, 0x94, 0x70, 0x89, 0x66,
-	0xb4, 0x67, 0x5a, 0x8c, 0x11, 0x1a, 0x69, 0x0f, 0x25, 0x9c, 0x0c, 0x61, 0x9e, 0xd1, 0x61, 0x20,
-	0x68, 0xb5, 0xac, 0x96, 0x7a, 0x77, 0x26, 0xb5, 0xa2, 0x62, 0xa5, 0xdb, 0x34, 0xba, 0x6a, 0xd3,
-	0x10, 0x34, 0x42, 0xea, 0xff, 0x90, 0x83, 0x52, 0xa8, 0x7e, 0xb2, 0x0b, 0xa5, 0x31, 0xa7, 0x2c,
-	0x3a, 0xfa, 0xcf, 0xac, 0x68, 0xd5, 0x43, 0xd9, 0x37, 0x43, 0x31, 0x62, 0x22, 0x19, 0x8e, 0x6c,
-	0xce, 0x9f, 0x06, 0xac, 0x7b, 0xbe, 0xa6, 0xba, 0x62, 0xb8, 0x67, 0x86, 0x62, 0xc4, 0xa4, 0xfe,
-	0x01, 0xac, 0x64, 0x56, 0x75, 0x86, 0x5c, 0xe5, 0x0d, 0x28, 0x8c, 0x99, 0xa7, 0xfd, 0xd6, 0xf4,
-	0x16, 0xf7, 0xb1, 0x6d, 0xa1, 0x82, 0xd6, 0xff, 0x6b, 0x1e, 0x16, 0xef, 0x74, 0x3a, 0x7b, 0x61,
-	0xd5, 0xfa, 0x02, 0xaf, 0x49, 0xd4, 0x38, 0xf9, 0x4b, 0xec, 0x6b, 0xec, 0xc3, 0x9c, 0xf0, 0x42,
-	0x57, 0x7b, 0xf7, 0xdc, 0xdd, 0xae, 0x4e, 0xdb, 0x32, 0x46, 0xa0, 0x3a, 0x69, 0x9d, 0xb6, 0x85,
-	0x92, 0x9f, 0xb4, 0xe9, 0x21, 0x15, 0x83, 0xa0, 0x9b, 0xbd, 0x4e, 0x78, 0xa0, 0xa0, 0x68, 0xb0,
-	0x99, 0xca, 0xb2, 0x78, 0xe9, 0x95, 0xe5, 0xd7, 0x61, 0x41, 0x66, 0x07, 0xc1, 0x58, 0xe7, 0xc9,
-	0x73, 0xb1, 0xa6, 0x3a, 0x1a, 0x8c, 0x21, 0x9e, 0xf4, 0xa1, 0x7c, 0x60, 0x73, 0xd7, 0x69, 0x8e,
-	0xc5, 0xc0, 0x24, 0xcb, 0xe7, 0xd7, 0xd7, 0x66, 0xc8, 0x41, 0xa7, 0x64, 0xd1, 0x5f, 0x8c, 0x79,
-	0x93, 0x1f, 0xc0, 0xc2, 0x80, 0xda, 0x5d, 0xa9, 0x90, 0x92, 0x52, 0x08, 0xbe, 0xbc, 0x42, 0x12,
-	0x06, 0xd8, 0xb8, 0xa3, 0x99, 0xea, 0x32, 0x3f, 0x6e, 0x1c, 0x6a, 0x28, 0x86, 0x32, 0xc9, 0x11,
-	0x2c, 0xe9, 0x76, 0x88, 0xc1, 0x54, 0xcb, 0x6a, 0x12, 0xbf, 0x73, 0xfe, 0x4e, 0x78, 0x82, 0xcb,
-	0xe6, 0xb5, 0xd3, 0x93, 0xda, 0x52, 0x12, 0xc2, 0x31, 0x2d, 0x66, 0xf5, 0x5d, 0xa8, 0x24, 0x67,
-	0x78, 0xae, 0x82, 0xfb, 0x4f, 0xe6, 0xe0, 0xda, 0xfd, 0x0d, 0x2b, 0xec, 0xb6, 0xee, 0x05, 0x9e,
-	0xeb, 0x1c, 0x93, 0x3f, 0x82, 0x79, 0xcf, 0x3e, 0xa0, 0x1e, 0xaf, 0xe6, 0xd4, 0x12, 0x3e, 0x7c,
-	0x79, 0x3d, 0x4e, 0x30, 0x6f, 0xb4, 0x15, 0x67, 0xad, 0xcc, 0xc8, 0xba, 0x35, 0x10, 0x8d, 0x58,
-	0xf2, 0x11, 0x2c, 0x1c, 0xd8, 0xce, 0x61, 0xd0, 0xeb, 0x99, 0x28, 0xb5, 0xf1, 0x12, 0x06, 0xa3,
-	0xc6, 0xeb, 0x2c, 0xd3, 0xfc, 0xc1, 0x90, 0x2b, 0xb1, 0xe0, 0x06, 0x65, 0x2c, 0x60, 0xbb, 0xbe,
-	0x41, 0x19, 0xab, 0x55, 0xfe, 0x5c, 0xda, 0x7c, 0xd3, 0xcc, 0xeb, 0xc6, 0xf6, 0x34, 0x22, 0x9c,
-	0x3e, 0x76, 0xf5, 0x3b, 0xb0, 0x98, 0x58, 0xdc, 0xb9, 0xf6, 0xe1, 0x27, 0xf3, 0x50, 0xb9, 0x6f,
-	0xf7, 0x0e, 0xed, 0x33, 0x06, 0xbd, 0xaf, 0x42, 0x51, 0x04, 0x23, 0xd7, 0x31, 0x19, 0x42, 0x94,
-	0x77, 0x76, 0x24, 0x10, 0x35, 0x4e, 0xd6, 0x73, 0x23, 0x9b, 0x09, 0xd5, 0x2d, 0x54, 0x0b, 0x2b,
-	0xc6, 0xf5, 0xdc, 0x5e, 0x88, 0xc0, 0x98, 0x26, 0x13, 0x54, 0x0a, 0x97, 0x1e, 0x54, 0x36, 0xa0,
-	0xc2, 0xe8, 0x93, 0xb1, 0xab, 0xfa, 0xd6, 0x87, 0x5c, 0xa5, 0x00, 0xc5, 0xf8, 0xb6, 0x18, 0x13,
-	0x38, 0x4c, 0x51, 0xca, 0xc4, 0xc1, 0x09, 0x86, 0x23, 0x46, 0x39, 0x57, 0xf1, 0xa8, 0x14, 0x27,
-	0x0e, 0x2d, 0x03, 0xc7, 0x88, 0x42, 0x26, 0x5a, 0x3d, 0x6f, 0xcc, 0x07, 0x3b, 0x92, 0x87, 0xcc,
-	0x65, 0x55, 0x58, 0x2a, 0xc6, 0x89, 0xd6, 0x4e, 0x0a, 0x8b, 0x19, 0xea, 0x30, 0xf6, 0x97, 0x2e,
-	0x38, 0xf6, 0x27, 0x4e, 0xb2, 0xf2, 0x25, 0x9e, 0x64, 0x4d, 0x58, 0x89, 0x4c, 0xc0, 0xf5, 0xfb,
-	0xf7, 0xe9, 0x71, 0x15, 0xd2, 0x9d, 0x83, 0xbd, 0x34, 0x1a, 0xb3, 0xf4, 0xf2, 0x34, 0x08, 0xbb,
-	0x39, 0x8b, 0xe9, 0xae, 0x49, 0xd8, 0xc9, 0x09, 0xf1, 0xe4, 0x77, 0xa1, 0xc0, 0x6d, 0xee, 0x55,
-	0x2b, 0x2f, 0x7b, 0x4d, 0xd8, 0xb4, 0xda, 0x46, 0x7b, 0x2a, 0x71, 0x90, 0xff, 0x51, 0xb1, 0xac,
-	0xef, 0x02, 0xb4, 0x83, 0x7e, 0xe8, 0x41, 0x4d, 0x58, 0x71, 0x7d, 0x41, 0xd9, 0x91, 0xed, 0x59,
-	0xd4, 0x09, 0xfc, 0x2e, 0x57, 0xde, 0x54, 0x88, 0x97, 0x75, 0x37, 0x8d, 0xc6, 0x2c, 0x7d, 0xfd,
-	0xef, 0xe6, 0x60, 0xf1, 0x61, 0xb3, 0x63, 0x9d, 0xd1, 0x29, 0x13, 0xbd, 0xa3, 0xfc, 0x0b, 0x7a,
-	0x47, 0x89, 0xad, 0x9e, 0x7b, 0x65, 0x97, 0x31, 0x97, 0xef, 0xe0, 0xc6, 0x71, 0x8a, 0x17, 0xeb,
-	0x38, 0xf5, 0x3f, 0x2f, 0xc0, 0xd5, 0xdd, 0x11, 0xf5, 0x3f, 0x1c, 0xb8, 0xfc, 0x30, 0x71, 0x29,
-	0x38, 0x08, 0xb8, 0xc8, 0xa6, 0xa1, 0x77, 0x02, 0x2e, 0x50, 0x61, 0x92, 0x56, 0x9b, 0x7f, 0x81,
-	0xd5, 0xae, 0x43, 0x59, 0x66, 0xae, 0x7c, 0x64, 0x3b, 0x13, 0xad, 0xb1, 0x87, 0x21, 0x02, 0x63,
-	0x1a, 0xf5, 0x7c, 0x65, 0x2c, 0x06, 0x9d, 0xe0, 0x90, 0xfa, 0xe7, 0xab, 0x91, 0xf4, 0xf3, 0x95,
-	0x70, 0x2c, 0xc6, 0x6c, 0xc8, 0x6d, 0x00, 0x3b, 0x7e, 0x4a, 0xa3, 0xeb, 0xa3, 0x48, 0xe3, 0xcd,
-	0xf8, 0x21, 0x4d, 0x82, 0x2a, 0x69, 0x68, 0xf3, 0xaf, 0xcc, 0xd0, 0x16, 0x2e, 0xfd, 0xd6, 0x0f,
-	0xa1, 0x92, 0xac, 0xe9, 0xcf, 0x70, 0x93, 0x10, 0x56, 0x2d, 0xf9, 0xe7, 0x55, 0x2d, 0xf5, 0xbf,
-	0x5f, 0x80, 0xa5, 0xbd, 0xb1, 0xc7, 0x6d, 0x76, 0x91, 0x87, 0xf4, 0xab, 0x7e, 0xe7, 0x91, 0x30,
-	0x90, 0xc2, 0x25, 0x1a, 0xc8, 0x08, 0xae, 0x0b, 0x8f, 0x77, 0xd8, 0x98, 0x8b, 0x16, 0x65, 0x82,
-	0x9b, 0x6e, 0x42, 0xf1, 0xdc, 0xb7, 0xec, 0x9d, 0xb6, 0x95, 0xe5, 0x82, 0xd3, 0x58, 0x93, 0x03,
-	0x58, 0x15, 0x1e, 0x6f, 0x7a, 0x5e, 0xf0, 0xf4, 0xae, 0xaf, 0x33, 0xe8, 0x56, 0xe0, 0xfb, 0x54,
-	0xf9, 0x8a, 0x49, 0x1a, 0xea, 0x66, 0xbe, 0xab, 0x9d, 0xb6, 0xf5, 0x1c, 0x4a, 0xfc, 0x25, 0x5c,
-	0xc8, 0x03, 0xb5, 0xaa, 0x47, 0xb6, 0xe7, 0x76, 0x6d, 0x41, 0x65, 0xa8, 0x51, 0x36, 0xb5, 0xa0,
-	0x98, 0x7f, 0x25, 0xec, 0xc3, 0x75, 0xda, 0x56, 0x96, 0x04, 0xa7, 0x8d, 0xfb, 0xbf, 0xca, 0x33,
-	0xba, 0xb0, 0x12, 0x05, 0x15, 0xa3, 0xf7, 0xf2, 0xb9, 0xdf, 0x1b, 0x34, 0xd3, 0x1c, 0x30, 0xcb,
-	0x92, 0xfc, 0x00, 0xae, 0x39, 0x91, 0x66, 0x4c, 0xa6, 0xac, 0x12, 0x8b, 0x59, 0xb2, 0xf9, 0x1b,
-	0xa7, 0x27, 0xb5, 0x6b, 0xad, 0x2c, 0x5b, 0x9c, 0x94, 0x54, 0xff, 0xe3, 0x1c, 0x94, 0xd1, 0x16,
-	0xb4, 0xed, 0x0e, 0x5d, 0x41, 0x6e, 0x43, 0x61, 0xec, 0xbb, 0xe1, 0x61, 0xb0, 0x16, 0x7a, 0xf7,
-	0xbe, 0xef, 0x8a, 0x67, 0x27, 0xb5, 0xe5, 0x88, 0x90, 0x4a, 0x08, 0x2a, 0x5a, 0x99, 0x40, 0xa8,
-	0x8c, 0x8f, 0x0b, 0xbe, 0x47, 0x99, 0x44, 0x28, 0x47, 0x2e, 0xc6, 0x09, 0x04, 0xa6, 0xd1, 0x98,
-	0xa5, 0xaf, 0xff, 0x24, 0x0f, 0xf3, 0x96, 0x72, 0x12, 0xf2, 0x31, 0x94, 0x86, 0x54, 0xd8, 0xaa,
-	0xb7, 0xad, 0x5b, 0x39, 0x6f, 0x9d, 0xed, 0xc6, 0x68, 0x57, 0x65, 0x0c, 0x0f, 0xa8, 0xb0, 0x63,
-	0x5f, 0x8e, 0x61, 0x18, 0x71, 0x25, 0x3d, 0x73, 0xc3, 0x9d, 0x9f, 0xf5, 0x32, 0x40, 0xcf, 0xd8,
-	0x1a, 0x51, 0x67, 0xea, 0xa5, 0xb6, 0x0f, 0xf3, 0x5c, 0xd8, 0x62, 0xcc, 0x67, 0x7f, 0x6f, 0x65,
-	0x24, 0x29, 0x6e, 0x89, 0xc6, 0xb0, 0xfa, 0x8f, 0x46, 0x4a, 0xfd, 0xdf, 0x72, 0x00, 0x9a, 0xb0,
-	0xed, 0x72, 0x41, 0xfe, 0x60, 0x42, 0x91, 0x8d, 0xb3, 0x29, 0x52, 0x8e, 0x56, 0x6a, 0x8c, 0x4a,
-	0x83, 0x10, 0x92, 0x50, 0x22, 0x85, 0xa2, 0x2b, 0xe8, 0x30, 0xec, 0x29, 0xbf, 0x37, 0xeb, 0xda,
-	0xe2, 0xa8, 0x7f, 0x57, 0xb2, 0x45, 0xcd, 0xbd, 0xfe, 0xb7, 0x85, 0x70, 0x4d, 0x52, 0xb1, 0xe4,
-	0xc7, 0x39, 0xa8, 0x74, 0xc3, 0xce, 0xba, 0x4b, 0xc3, 0xba, 0xfb, 0xee, 0x85, 0xdd, 0x69, 0xc5,
-	0x45, 0xd4, 0x56, 0x42, 0x0c, 0xa6, 0x84, 0x92, 0x00, 0x4a, 0x42, 0x47, 0xf0, 0x70, 0xf9, 0xcd,
-	0x99, 0xcf, 0x82, 0xc4, 0xf5, 0xb7, 0x61, 0x8d, 0x91, 0x10, 0xe2, 0x25, 0x2e, 0xcb, 0x67, 0xee,
-	0x59, 0x87, 0xd7, 0xeb, 0xba, 0x55, 0x39, 0x79, 0xd9, 0x4e, 0xee, 0x01, 0x31, 0x75, 0xfb, 0x8e,
-	0xed, 0x7a, 0xb4, 0x8b, 0xc1, 0xd8, 0xd7, 0x6d, 0xb6, 0x52, 0xfc, 0x9a, 0x64, 0x7b, 0x82, 0x02,
-	0xa7, 0x8c, 0x92, 0x95, 0xaa, 0x9a, 0xcf, 0xe6, 0x98, 0x27, 0x92, 0xb1, 0x48, 0xc9, 0xdb, 0x09,
-	0x1c, 0xa6, 0x28, 0xc9, 0x2d, 0x28, 0x31, 0x3a, 0xf2, 0x5c, 0xc7, 0xd6, 0x95, 0x6a, 0x31, 0x7c,
-	0xef, 0xa6, 0x61, 0x18, 0x61, 0xeb, 0x01, 0x54, 0x92, 0xfe, 0x41, 0x3e, 0x8a, 0xfc, 0x4e, 0x9b,
-	0xfd, 0xb7, 0xcf, 0x5f, 0x3b, 0xfd, 0x72, 0x47, 0xfb, 0x97, 0x3c, 0x54, 0x2c, 0xcf, 0x76, 0xa2,
-	0x14, 0x3a, 0x9d, 0x9b, 0xe4, 0x5e, 0x41, 0xb9, 0x00, 0x5c, 0xcd, 0x47, 0x65, 0xd1, 0xf9, 0x73,
-	0x3f, 0x2b, 0xb2, 0xa2, 0xc1, 0x98, 0x60, 0x24, 0xf3, 0x7e, 0x67, 0x60, 0xfb, 0x3e, 0xf5, 0x4c,
-	0x2a, 0x1f, 0xa5, 0x29, 0x2d, 0x0d, 0xc6, 0x10, 0x2f, 0x49, 0x87, 0x94, 0x73, 0xbb, 0x1f, 0x3e,
-	0x3b, 0x88, 0x48, 0x1f, 0x68, 0x30, 0x86, 0xf8, 0xfa, 0xff, 0xcc, 0x01, 0xb1, 0x84, 0xed, 0x77,
-	0x6d, 0xd6, 0xbd, 0xbf, 0x61, 0xbd, 0xaa, 0x17, 0xc8, 0x0f, 0x27, 0x5f, 0x20, 0xbf, 0x35, 0xed,
-	0x05, 0xf2, 0x57, 0xee, 0x8f, 0x0f, 0x28, 0xf3, 0xa9, 0xa0, 0x3c, 0x6c, 0xd0, 0xfd, 0xbf, 0x7c,
-	0x87, 0xdc, 0x83, 0xa5, 0x91, 0x2d, 0x9c, 0x81, 0x25, 0x98, 0x2d, 0x68, 0xff, 0xd8, 0xec, 0xc3,
-	0x7b, 0x66, 0xd8, 0xd2, 0x5e, 0x12, 0xf9, 0xec, 0xa4, 0xf6, 0xeb, 0xcf, 0xfb, 0x7c, 0x41, 0x1c,
-	0x8f, 0x28, 0x6f, 0x28, 0x72, 0xf5, 0xf4, 0x23, 0xcd, 0x56, 0x16, 0x57, 0x9e, 0x7b, 0x44, 0xf5,
-	0xc9, 0xaa, 0xfc, 0xb9, 0x14, 0xcf, 0xad, 0x1d, 0x61, 0x30, 0x41, 0x55, 0x5f, 0x87, 0x8a, 0x76,
-	0x21, 0xd3, 0x37, 0xad, 0x41, 0xd1, 0x96, 0x99, 0xa1, 0x72, 0x95, 0xa2, 0xbe, 0x3c, 0x53, 0xa9,
-	0x22, 0x6a, 0x78, 0xfd, 0x4f, 0x4b, 0x10, 0x45, 0x26, 0xe2, 0x4c, 0x1c, 0x64, 0xe7, 0x7f, 0x34,
-	0xfb, 0xc0, 0x30, 0xd0, 0x41, 0x24, 0xfc, 0x97, 0x38, 0xcf, 0xcc, 0x13, 0x3a, 0xd7, 0xa1, 0x4d,
-	0xc7, 0x09, 0xc6, 0xe6, 0x71, 0x47, 0x7e, 0xf2, 0x09, 0x5d, 0x9a, 0x02, 0xa7, 0x8c, 0x22, 0xf7,
-	0xd4, 0xf3, 0x64, 0x61, 0x4b, 0x9d, 0x9a, 0x78, 0xfd, 0xe6, 0x73, 0x9e, 0x27, 0x6b, 0xa2, 0xe8,
-	0x4d, 0xb2, 0xfe, 0x8b, 0xf1, 0x70, 0xb2, 0x0d, 0x0b, 0x47, 0x81, 0x37, 0x1e, 0xd2, 0xb0, 0x0d,
-	0xb1, 0x3a, 0x8d, 0xd3, 0x23, 0x45, 0x92, 0xa8, 0xcb, 0xf5, 0x10, 0x0c, 0xc7, 0x12, 0x0a, 0x2b,
-	0x2a, 0x09, 0x77, 0xc5, 0xb1, 0x79, 0x49, 0x60, 0x4a, 0x88, 0xaf, 0x4d, 0x63, 0xb7, 0x17, 0x74,
-	0xad, 0x34, 0xb5, 0x79, 0x3b, 0x9b, 0x06, 0x62, 0x96, 0x27, 0xf9, 0x34, 0x07, 0x15, 0x3f, 0xe8,
-	0xd2, 0x30, 0xbc, 0x98, 0x5a, 0xba, 0x33, 0xfb, 0x69, 0xd5, 0x78, 0x98, 0x60, 0xab, 0x9b, 0xe2,
-	0xd1, 0x29, 0x92, 0x44, 0x61, 0x4a, 0x3e, 0xd9, 0x87, 0x45, 0x11, 0x78, 0xc6, 0x47, 0xc3, 0x02,
-	0x7b, 0x6d, 0xda, 0x9a, 0x3b, 0x11, 0x59, 0xfc, 0xc4, 0x2a, 0x86, 0x71, 0x4c, 0xf2, 0x21, 0x3e,
-	0x5c, 0x75, 0x87, 0x76, 0x9f, 0xee, 0x8d, 0x3d, 0x4f, 0xc7, 0xd4, 0xf0, 0x2a, 0x65, 0xea, 0x3b,
-	0x74, 0x19, 0x88, 0x3c, 0xe3, 0x17, 0xb4, 0x47, 0x19, 0xf5, 0x1d, 0x1a, 0x3d, 0xc2, 0xbb, 0x7a,
-	0x37, 0xc3, 0x09, 0x27, 0x78, 0x93, 0xf7, 0xe1, 0xda, 0x88, 0xb9, 0x81, 0x52, 0xb5, 0x67, 0x73,
-	0x7d, 0x96, 0x96, 0x95, 0x71, 0x7e, 0xd9, 0xb0, 0xb9, 0xb6, 0x97, 0x25, 0xc0, 0xc9, 0x31, 0xf2,
-	0x54, 0x0d, 0x81, 0xaa, 0xc6, 0x30, 0xa7, 0x6a, 0x38, 0x16, 0x23, 0x2c, 0xd9, 0x81, 0x92, 0xdd,
-	0xeb, 0xb9, 0xbe, 0xa4, 0x5c, 0x54, 0xa6, 0xf2, 0xc6, 0xb4, 0xa5, 0x35, 0x0d, 0x8d, 0xe6, 0x13,
-	0xfe, 0xc3, 0x68, 0xec, 0xea, 0xf7, 0xe0, 0xda, 0xc4, 0xd6, 0x9d, 0xab, 0xe5, 0x6f, 0x01, 0xc4,
-	0xaf, 0x6e, 0xc8, 0x57, 0xa1, 0xc8, 0x85, 0xcd, 0xc2, 0x0a, 0x25, 0xca, 0x1a, 0x2d, 0x09, 0x44,
-	0x8d, 0x23, 0x37, 0xa1, 0xc0, 0x45, 0x30, 0xca, 0xf6, 0x28, 0x2c, 0x11, 0x8c, 0x50, 0x61, 0xea,
-	0x9f, 0x17, 0x60, 0x21, 0x3c, 0x79, 0x78, 0x22, 0xbb, 0xca, 0xcd, 0x7a, 0x75, 0x6d, 0x98, 0xbe,
-	0x30, 0xc9, 0x4a, 0x1f, 0x17, 0xf9, 0x4b, 0x3f, 0x2e, 0x0e, 0x61, 0x7e, 0xa4, 0x82, 0xb1, 0x09,
-	0x50, 0xef, 0xcf, 0x2e, 0x5b, 0xb1, 0xd3, 0x67, 0xad, 0xfe, 0x8d, 0x46, 0x04, 0x79, 0x02, 0x4b,
-	0x8c, 0x0a, 0x76, 0x9c, 0x3a, 0x9b, 0x66, 0x29, 0x6f, 0xd5, 0x65, 0x1f, 0x26, 0x59, 0x62, 0x5a,
-	0x02, 0x19, 0x41, 0x99, 0x85, 0xc5, 0xaa, 0x09, 0x75, 0xad, 0x97, 0x5f, 0x62, 0x54, 0xf7, 0xea,
-	0x48, 0x1d, 0xfd, 0xc5, 0x58, 0x48, 0xfd, 0xbf, 0x73, 0x70, 0x35, 0xbb, 0x0d, 0xe4, 0x10, 0xe6,
-	0x38, 0x73, 0x8c, 0x59, 0xed, 0x5d, 0xdc, 0xfe, 0xea, 0x64, 0x46, 0xf7, 0x2b, 0x2c, 0xe6, 0xa0,
-	0x94, 0x22, 0xcd, 0xbe, 0x4b, 0xb9, 0xc8, 0x9a, 0xfd, 0x16, 0xe5, 0x02, 0x15, 0x86, 0xb4, 0x93,
-	0x49, 0xcf, 0x5c, 0xea, 0xd5, 0x53, 0x2a, 0xe9, 0xf9, 0x72, 0x56, 0xde, 0xb4, 0x94, 0xa7, 0xfe,
-	0xef, 0x79, 0x78, 0x6d, 0xfa, 0xc4, 0xc8, 0x77, 0x61, 0x39, 0x2a, 0x99, 0x8e, 0x13, 0x5f, 0x34,
-	0x46, 0x37, 0x47, 0x5b, 0x29, 0x2c, 0x66, 0xa8, 0x65, 0x96, 0x61, 0x1e, 0xba, 0x85, 0x9f, 0x35,
-	0x26, 0x5a, 0xb8, 0xad, 0x08, 0x83, 0x09, 0x2a, 0xd2, 0x84, 0x15, 0xf3, 0xaf, 0x93, 0x2c, 0x96,
-	0x12, 0xf7, 0x33, 0xad, 0x34, 0x1a, 0xb3, 0xf4, 0x32, 0x8d, 0x95, 0xd9, 0x40, 0xf8, 0x65, 0x49,
-	0x22, 0x8d, 0xdd, 0xd2, 0x60, 0x0c, 0xf1, 0xb2, 0xb2, 0x91, 0x3f, 0x3b, 0xe9, 0x47, 0xcc, 0x71,
-	0xf9, 0x98, 0xc0, 0x61, 0x8a, 0x32, 0x7e, 0x5d, 0xad, 0x5f, 0xe3, 0x4c, 0xbc, 0xae, 0xae, 0xff,
-	0x2c, 0x07, 0x4b, 0x29, 0xa7, 0x22, 0x3d, 0x98, 0x3b, 0xdc, 0x08, 0xeb, 0x99, 0xfb, 0x17, 0x78,
-	0xcb, 0xac, 0x2d, 0xe8, 0xfe, 0x06, 0x47, 0x29, 0x80, 0x3c, 0x8e, 0x4a, 0xa7, 0x99, 0x9f, 0x30,
-	0x26, 0x13, 0x3e, 0x93, 0x80, 0xa7, 0xab, 0xa8, 0x7f, 0xad, 0xc0, 0x4a, 0x26, 0x5a, 0x9e, 0xe1,
-	0x49, 0x8c, 0x36, 0x0c, 0xf3, 0x65, 0xc7, 0x14, 0xc3, 0x08, 0xbf, 0xf9, 0x48, 0x50, 0x91, 0xbe,
-	0xd6, 0x9e, 0x0e, 0x74, 0xed, 0x99, 0x96, 0x94, 0xa9, 0x5a, 0x32, 0xea, 0xfb, 0x71, 0x0e, 0x2a,
-	0x76, 0xe2, 0x83, 0x45, 0x13, 0xe7, 0x1e, 0xcc, 0x52, 0xca, 0x4c, 0x7c, 0xab, 0xa9, 0x1f, 0x87,
-	0x25, 0x11, 0x98, 0x12, 0x4a, 0x1c, 0x28, 0x0c, 0x84, 0x08, 0x3f, 0x8c, 0xdb, 0xbe, 0x90, 0xb7,
-	0x1d, 0xfa, 0x0e, 0x51, 0x02, 0x50, 0x31, 0x27, 0x4f, 0xa1, 0x6c, 0x3f, 0xe5, 0xfa, 0x23, 0x66,
-	0xf3, 0xc5, 0xdc, 0x2c, 0x15, 0x5b, 0xe6, 0x7b, 0x68, 0x73, 0xb9, 0x13, 0x42, 0x31, 0x96, 0x45,
-	0x18, 0xcc, 0x3b, 0xea, 0xcb, 0x12, 0xf3, 0x44, 0xe6, 0xfd, 0x0b, 0xfa, 0x42, 0x45, 0x9f, 0x29,
-	0x29, 0x10, 0x1a, 0x49, 0xa4, 0x0f, 0xc5, 0x43, 0xbb, 0x77, 0x68, 0x9b, 0x0e, 0xf3, 0x0c, 0x5e,
-	0x91, 0x7c, 0xbb, 0xa0, 0x3d, 0x5f, 0x41, 0x50, 0xf3, 0x97, 0x5b, 0xe7, 0xdb, 0x82, 0x9b, 0x36,
-	0xf3, 0x0c, 0x5b, 0x97, 0xb8, 0x8d, 0xd5, 0x5b, 0x27, 0x01, 0xa8, 0x98, 0xcb, 0xd5, 0xa8, 0x22,
-	0xdf, 0x34, 0x99, 0x67, 0xf1, 0xf1, 0x44, 0x13, 0x44, 0xaf, 0x46, 0x41, 0x50, 0xf3, 0x97, 0x36,
-	0x12, 0x84, 0xb7, 0x8d, 0x26, 0x87, 0x9c, 0xc1, 0x46, 0xb2, 0x17, 0x97, 0xda, 0x46, 0x22, 0x28,
-	0xc6, 0xb2, 0xc8, 0x47, 0x30, 0xe7, 0x05, 0x7d, 0x73, 0x75, 0x3e, 0x43, 0x83, 0x37, 0xbe, 0x25,
-	0xd7, 0x8e, 0xde, 0x0e, 0xfa, 0x28, 0x39, 0x93, 0x3f, 0xcb, 0xc1, 0xb2, 0x9d, 0xfa, 0xc4, 0xb2,
-	0xba, 0x34, 0xeb, 0xc3, 0xfe, 0xa9, 0x9f, 0x6c, 0xea, 0xef, 0xbf, 0xd3, 0x28, 0xcc, 0x88, 0x56,
-	0xb9, 0x9c, 0xba, 0x6f, 0xab, 0x2e, 0xcf, 0xea, 0x12, 0xa9, 0x7b, 0x3b, 0x93, 0xcb, 0x29, 0x10,
-	0x1a, 0x11, 0xe4, 0x2f, 0x73, 0xea, 0x98, 0x4d, 0x7e, 0x5b, 0x57, 0x5d, 0x99, 0xf9, 0x5b, 0xb1,
-	0xe9, 0xdf, 0x03, 0xa6, 0x4e, 0xee, 0x24, 0x01, 0x66, 0xa7, 0x50, 0x77, 0x60, 0x31, 0xf1, 0xbd,
-	0xf0, 0x19, 0xee, 0x31, 0x6f, 0x03, 0x1c, 0x51, 0xe6, 0xf6, 0x8e, 0x5b, 0x94, 0x09, 0xf3, 0xd9,
-	0x5e, 0x74, 0x90, 0x3c, 0x8a, 0x30, 0x98, 0xa0, 0xda, 0x6c, 0x7c, 0xf6, 0xc5, 0xda, 0x95, 0xcf,
-	0xbf, 0x58, 0xbb, 0xf2, 0xd3, 0x2f, 0xd6, 0xae, 0xfc, 0xf0, 0x74, 0x2d, 0xf7, 0xd9, 0xe9, 0x5a,
-	0xee, 0xf3, 0xd3, 0xb5, 0xdc, 0x4f, 0x4f, 0xd7, 0x72, 0xff, 0x79, 0xba, 0x96, 0xfb, 0x8b, 0x9f,
-	0xad, 0x5d, 0xf9, 0xbd, 0x52, 0xb8, 0xac, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0xec, 0x25, 0xde,
-	0x83, 0xa2, 0x43, 0x00, 0x00,
+	// 6508 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x7d, 0x5d, 0x6c, 0x1c, 0xc9,
+	0x71, 0xf0, 0xed, 0x1f, 0xb9, 0x5b, 0x24, 0x45, 0xb1, 0x75, 0x92, 0xe6, 0xe8, 0x3b, 0x51, 0xdf,
+	0x1a, 0xf6, 0x77, 0x76, 0xce, 0xe4, 0xfd, 0xc4, 0xb1, 0x7c, 0x86, 0x63, 0x2f, 0xff, 0x24, 0x4a,
+	0xa4, 0x44, 0xd5, 0xae, 0x4e, 0x38, 0xc7, 0xf0, 0xdd, 0x70, 0xb6, 0xb9, 0x1c, 0x71, 0x76, 0x66,
+	0x35, 0x33, 0x4b, 0x89, 0x97, 0xf8, 0x2f, 0x3f, 0x40, 0x8c, 0x00, 0x8e, 0x83, 0xe4, 0xc1, 0x79,
+	0x48, 0x10, 0x04, 0x48, 0x9e, 0x02, 0x24, 0x81, 0x5f, 0x12, 0x20, 0x40, 0x80, 0x3c, 0x24, 0x46,
+	0x90, 0x07, 0xfb, 0x25, 0x30, 0x90, 0x80, 0x88, 0xe9, 0xb7, 0x00, 0x06, 0x62, 0xc0, 0x41, 0x0c,
+	0x3d, 0x05, 0xfd, 0x3b, 0x3d, 0xb3, 0x4b, 0x89, 0xab, 0xa1, 0xc4, 0x00, 0x7e, 0xe3, 0x56, 0x55,
+	0x57, 0xf5, 0x54, 0x77, 0x57, 0x57, 0x55, 0x77, 0x17, 0xe1, 0x5a, 0xc7, 0x8d, 0x77, 0xfa, 0x5b,
+	0xf3, 0x4e, 0xd0, 0x5d, 0xb0, 0xc3, 0x4e, 0xd0, 0x0b, 0x83, 0x7b, 0xfc, 0x8f, 0x4f, 0xd0, 0x3d,
+	0xea, 0xc7, 0xd1, 0x42, 0x6f, 0xb7, 0xb3, 0x60, 0xf7, 0xdc, 0x68, 0x21, 0xa2, 0x7e, 0x14, 0x84,
+	0x0b, 0x7b, 0x6f, 0xd8, 0x5e, 0x6f, 0xc7, 0x7e, 0x63, 0xa1, 0x43, 0x7d, 0x1a, 0xda, 0x31, 0x6d,
+	0xcf, 0xf7, 0xc2, 0x20, 0x0e, 0xc8, 0x95, 0x84, 0xd3, 0xbc, 0xe2, 0xc4, 0xff, 0x78, 0x4f, 0x70,
+	0x9a, 0xef, 0xed, 0x76, 0xe6, 0x19, 0xa7, 0x79, 0xc1, 0x69, 0x5e, 0x71, 0x9a, 0xfd, 0xdc, 0xb1,
+	0xfb, 0xe0, 0x04, 0xdd, 0x6e, 0xe0, 0x67, 0x45, 0xcf, 0x7e, 0xc2, 0x60, 0xd0, 0x09, 0x3a, 0xc1,
+	0x02, 0x07, 0x6f, 0xf5, 0xb7, 0xf9, 0x2f, 0xfe, 0x83, 0xff, 0x25, 0xc9, 0xeb, 0xbb, 0x57, 0xa2,
+	0x79, 0x37, 0x60, 0x2c, 0x17, 0x9c, 0x20, 0xa4, 0x0b, 0x7b, 0x03, 0x5f, 0x33, 0xfb, 0x8b, 0x09,
+	0x4d, 0xd7, 0x76, 0x76, 0x5c, 0x9f, 0x86, 0xfb, 0x49, 0x3f, 0xba, 0x34, 0xb6, 0x87, 0xb5, 0x5a,
+	0x38, 0xaa, 0x55, 0xd8, 0xf7, 0x63, 0xb7, 0x4b, 0x07, 0x1a, 0xfc, 0xd2, 0x93, 0x1a, 0x44, 0xce,
+	0x0e, 0xed, 0xda, 0xd9, 0x76, 0xf5, 0x47, 0x65, 0x38, 0xdb, 0xb8, 0xdb, 0x5c, 0xb7, 0xbb, 0x5b,
+	0x6d, 0xbb, 0x15, 0xba, 0x9d, 0x0e, 0x0d, 0xc9, 0x15, 0x98, 0xdc, 0xee, 0xfb, 0x4e, 0xec, 0x06,
+	0xfe, 0x4d, 0xbb, 0x4b, 0xad, 0xc2, 0xe5, 0xc2, 0xab, 0xb5, 0xc5, 0x17, 0xbf, 0x7b, 0x30, 0xf7,
+	0xc2, 0xe1, 0xc1, 0xdc, 0xe4, 0xaa, 0x81, 0xc3, 0x14, 0x25, 0x41, 0xa8, 0xd9, 0x8e, 0x43, 0xa3,
+	0xe8, 0x06, 0xdd, 0xb7, 0x8a, 0x97, 0x0b, 0xaf, 0x4e, 0xbc, 0xf9, 0x91, 0x79, 0xd1, 0x35, 0x36,
+	0x64, 0xf3, 0x4c, 0x4b, 0xf3, 0x7b, 0x6f, 0xcc, 0x37, 0xa9, 0x13, 0xd2, 0xf8, 0x06, 0xdd, 0x6f,
+	0x52, 0x8f, 0x3a, 0x71, 0x10, 0x2e, 0x4e, 0x1d, 0x1e, 0xcc, 0xd5, 0x1a, 0xaa, 0x2d, 0x26, 0x6c,
+	0x18, 0xcf, 0x48, 0x91, 0x5b, 0xa5, 0x91, 0x79, 0x6a, 0x30, 0x26, 0x6c, 0xc8, 0x47, 0x61, 0x2c,
+	0xa4, 0x1d, 0x37, 0xf0, 0xad, 0x32, 0xff, 0xb6, 0x33, 0xf2, 0xdb, 0xc6, 0x90, 0x43, 0x51, 0x62,
+	0x49, 0x1f, 0xc6, 0x7b, 0xf6, 0xbe, 0x17, 0xd8, 0x6d, 0xab, 0x72, 0xb9, 0xf4, 0xea, 0xc4, 0x9b,
+	0xd7, 0xe7, 0x9f, 0x76, 0x76, 0xce, 0x4b, 0xed, 0x6e, 0xda, 0xa1, 0xdd, 0xa5, 0x31, 0x0d, 0x17,
+	0xa7, 0xa5, 0xd0, 0xf1, 0x4d, 0x21, 0x02, 0x95, 0x2c, 0xf2, 0x15, 0x80, 0x9e, 0x22, 0x8b, 0xac,
+	0xb1, 0x13, 0x97, 0x4c, 0xa4, 0x64, 0xd0, 0xa0, 0x08, 0x0d, 0x89, 0xe4, 0x6d, 0x38, 0xe3, 0xfa,
+	0x7b, 0x81, 0x63, 0xb3, 0x81, 0x6d, 0xed, 0xf7, 0xa8, 0x35, 0xce, 0xd5, 0x44, 0x0e, 0x0f, 0xe6,
+	0xce, 0xac, 0xa5, 0x30, 0x98, 0xa1, 0x24, 0x1f, 0x83, 0xf1, 0x30, 0xf0, 0x68, 0x03, 0x6f, 0x5a,
+	0x55, 0xde, 0x48, 0x7f, 0x26, 0x0a, 0x30, 0x2a, 0x7c, 0xfd, 0x9f, 0x2a, 0x30, 0xd5, 0xb8, 0xdb,
+	0x6c, 0xde, 0x6e, 0xaa, 0x99, 0xf7, 0x1a, 0x54, 0xef, 0xf7, 0x69, 0x9f, 0xde, 0xc1, 0x75, 0x39,
+	0xeb, 0xce, 0xca, 0xd6, 0xd5, 0xdb, 0x12, 0x8e, 0x9a, 0xc2, 0x18, 0xc5, 0xe2, 0x63, 0x47, 0x31,
+	0x35, 0x2b, 0x4b, 0xcf, 0x60, 0x56, 0x96, 0x4f, 0x66, 0x56, 0x1a, 0xaa, 0xab, 0x3c, 0x5e, 0x75,
+	0xe4, 0x97, 0xe1, 0x4c, 0x97, 0x46, 0x91, 0xdd, 0xa1, 0x57, 0xc3, 0xa0, 0xdf, 0x5b, 0x5b, 0xb6,
+	0xc6, 0x78, 0x8b, 0x0b, 0xb2, 0xc5, 0x99, 0x8d, 0x14, 0x16, 0x33, 0xd4, 0xe4, 0x1d, 0xb8, 0x20,
+	0x21, 0xcb, 0xb4, 0xdd, 0xef, 0x79, 0xae, 0x18, 0xc1, 0xb5, 0x65, 0x39, 0xd2, 0x97, 0x24, 0x9f,
+	0x0b, 0x1b, 0x43, 0xa9, 0xf0, 0x88, 0xd6, 0xe6, 0x82, 0xa9, 0x9e, 0xda, 0x82, 0xa9, 0x3d, 0xef,
+	0x05, 0x53, 0xff, 0x71, 0x11, 0xce, 0x35, 0xc2, 0x4e, 0x70, 0x37, 0x08, 0x77, 0xb7, 0xbd, 0xe0,
+	0x81, 0x9a, 0xcf, 0x3e, 0x8c, 0x45, 0x41, 0x3f, 0x74, 0x84, 0x0d, 0xcd, 0xd5, 0xa7, 0x46, 0x18,
+	0xbb, 0xdb, 0xb6, 0x13, 0xaf, 0xcb, 0xc5, 0xb6, 0x08, 0x6c, 0xa6, 0x37, 0x39, 0x77, 0x94, 0x52,
+	0xc8, 0x35, 0xa8, 0x05, 0x3d, 0x66, 0xe0, 0x93, 0x45, 0xf1, 0x71, 0xd9, 0xf5, 0xda, 0x2d, 0x85,
+	0x78, 0x74, 0x30, 0x77, 0xde, 0xec, 0xac, 0x46, 0x60, 0xd2, 0x38, 0xa3, 0xd1, 0xd2, 0x73, 0x37,
+	0x41, 0x2f, 0x43, 0xd9, 0x0e, 0x3b, 0x91, 0x55, 0xbe, 0x5c, 0x7a, 0xb5, 0xb6, 0x58, 0x3d, 0x3c,
+	0x98, 0x2b, 0x37, 0xc2, 0x4e, 0x84, 0x1c, 0x5a, 0xff, 0x09, 0xdb, 0xb6, 0x32, 0x0a, 0x21, 0x4d,
+	0x28, 0x46, 0x6f, 0x49, 0x45, 0x7f, 0xe6, 0xf8, 0x5d, 0x15, 0xbe, 0xc0, 0x7c, 0xf3, 0x2d, 0xc5,
+	0x70, 0x71, 0xec, 0xf0, 0x60, 0xae, 0xd8, 0x7c, 0x0b, 0x8b, 0xd1, 0x5b, 0xa4, 0x0e, 0x63, 0xae,
+	0xef, 0xb9, 0x3e, 0x95, 0xea, 0xe4, 0x5a, 0x5f, 0xe3, 0x10, 0x94, 0x18, 0xd2, 0x86, 0xf2, 0xb6,
+	0xeb, 0x51, 0x69, 0x5a, 0x56, 0x9f, 0x5e, 0x4b, 0xab, 0xae, 0x47, 0x75, 0x2f, 0xf8, 0x37, 0x33,
+	0x08, 0x72, 0xee, 0xe4, 0x7d, 0x28, 0xf5, 0x43, 0x4f, 0xda, 0x9a, 0x95, 0xa7, 0x17, 0x72, 0x07,
+	0xd7, 0xb5, 0x8c, 0xf1, 0xc3, 0x83, 0xb9, 0x12, 0x33, 0xaa, 0x8c, 0x35, 0xb9, 0x03, 0x35, 0x27,
+	0xf0, 0xb7, 0xdd, 0x4e, 0xd7, 0xee, 0x71, 0x0b, 0x34, 0xf1, 0xe6, 0xab, 0xc3, 0x6c, 0xda, 0x12,
+	0x27, 0xda, 0xb0, 0x7b, 0x03, 0x66, 0x6d, 0x49, 0x35, 0xc7, 0x84, 0x13, 0xeb, 0x78, 0xc7, 0x8d,
+	0xad, 0xb1, 0xbc, 0x1d, 0xbf, 0xea, 0xc6, 0xe9, 0x8e, 0x5f, 0x75, 0x63, 0x64, 0xac, 0x89, 0x03,
+	0xd5, 0x90, 0xca, 0x85, 0x36, 0xce, 0xc5, 0x7c, 0x7a, 0xe4, 0xf1, 0x47, 0xc9, 0x60, 0x71, 0x92,
+	0xed, 0x36, 0xea, 0x17, 0x6a, 0xc6, 0xf5, 0xef, 0x94, 0xe1, 0x7c, 0xe3, 0x83, 0x7e, 0x48, 0x57,
+	0x18, 0x83, 0x6b, 0xfd, 0xad, 0x48, 0xad, 0xf2, 0xcb, 0x50, 0xde, 0xbe, 0xdf, 0xf6, 0xe5, 0x8e,
+	0x35, 0x29, 0x67, 0x76, 0x79, 0xf5, 0xf6, 0xf2, 0x4d, 0xe4, 0x18, 0x66, 0xd9, 0x77, 0xfa, 0x5b,
+	0xdc, 0x99, 0x2a, 0xa6, 0x2d, 0xfb, 0x35, 0x01, 0x46, 0x85, 0x27, 0x3d, 0x38, 0x17, 0xed, 0xd8,
+	0x21, 0x6d, 0xeb, 0x6d, 0x87, 0x37, 0x1b, 0x69, 0xdb, 0xba, 0x78, 0x78, 0x30, 0x77, 0xae, 0x39,
+	0xc8, 0x05, 0x87, 0xb1, 0x26, 0x6d, 0x98, 0xce, 0x80, 0x47, 0xdb, 0xd0, 0xce, 0x1d, 0x1e, 0xcc,
+	0x4d, 0x67, 0xa4, 0x61, 0x96, 0xe5, 0xcf, 0xa9, 0x2b, 0x55, 0xff, 0xd3, 0x12, 0xc0, 0x62, 0x5f,
+	0x4f, 0x95, 0x8f, 0xc1, 0x78, 0xd4, 0xdf, 0xba, 0x47, 0x9d, 0x58, 0xce, 0x16, 0xdd, 0xf3, 0xa6,
+	0x00, 0xa3, 0xc2, 0x93, 0x06, 0x4c, 0xf3, 0xde, 0x08, 0x13, 0x6f, 0xcc, 0x9d, 0x8b, 0xb2, 0xc9,
+	0xf4, 0x4a, 0x1a, 0x8d, 0x59, 0x7a, 0xb2, 0x00, 0x35, 0x0e, 0xd2, 0x33, 0xa8, 0xb6, 0x38, 0xa3,
+	0xb6, 0x83, 0x15, 0x85, 0xc0, 0x84, 0xc6, 0x1c, 0xa4, 0xf2, 0xa9, 0x0d, 0x52, 0xe5, 0xb9, 0x0f,
+	0xd2, 0xbf, 0x15, 0x81, 0x2c, 0x79, 0x41, 0xbf, 0xcd, 0x95, 0xb2, 0xe2, 0xef, 0x51, 0x2f, 0xe8,
+	0x51, 0xb6, 0xae, 0x63, 0xe6, 0xfc, 0x66, 0xd6, 0x35, 0x77, 0x7b, 0x39, 0x86, 0x79, 0xa0, 0xd2,
+	0xec, 0x64, 0x3c, 0xd0, 0xcc, 0xbe, 0x6c, 0x0c, 0x7b, 0xe9, 0x09, 0xc3, 0xfe, 0xad, 0x02, 0x00,
+	0x7d, 0x18, 0x53, 0x3f, 0x72, 0x03, 0x3f, 0x92, 0xc3, 0xf0, 0xc5, 0xa7, 0x57, 0xc6, 0xe0, 0x77,
+	0xcd, 0xaf, 0x68, 0xf6, 0x2b, 0x7e, 0x1c, 0xee, 0x27, 0xea, 0x49, 0x10, 0x68, 0xf4, 0x61, 0xf6,
+	0xb3, 0x30, 0x9d, 0x69, 0x42, 0xce, 0x42, 0x69, 0x97, 0xee, 0x0b, 0xcd, 0x20, 0xfb, 0x93, 0xbc,
+	0x08, 0x95, 0x3d, 0xdb, 0xeb, 0x4b, 0x4d, 0xa0, 0xf8, 0xf1, 0x76, 0xf1, 0x4a, 0xa1, 0xde, 0x81,
+	0xf3, 0x4b, 0x81, 0xdf, 0x76, 0x63, 0xce, 0x98, 0x46, 0x34, 0x5e, 0xdc, 0x6f, 0xb9, 0x5d, 0xae,
+	0x5f, 0x27, 0x0c, 0x06, 0xec, 0xe6, 0x52, 0x18, 0xf8, 0xc8, 0x31, 0x2c, 0x1e, 0x60, 0xd1, 0xeb,
+	0x07, 0x81, 0xde, 0x7f, 0x75, 0x3c, 0xd0, 0x92, 0x70, 0xd4, 0x14, 0xf5, 0x6f, 0x16, 0xe0, 0x62,
+	0x46, 0xd2, 0x52, 0xe8, 0xc6, 0x34, 0x74, 0x6d, 0x12, 0xc1, 0xd8, 0x16, 0x97, 0x2a, 0x1d, 0x84,
+	0x5b, 0x39, 0x34, 0x3a, 0xec, 0x63, 0x84, 0x63, 0x20, 0xfe, 0x46, 0x29, 0xaa, 0xfe, 0x57, 0x15,
+	0x98, 0x5a, 0xea, 0x47, 0x71, 0xd0, 0x55, 0xeb, 0x7f, 0x81, 0x85, 0x0d, 0xe1, 0x1e, 0x0d, 0x93,
+	0x08, 0x47, 0xaf, 0xc8, 0xa6, 0x42, 0x60, 0x42, 0xc3, 0x67, 0x18, 0x75, 0xfa, 0xa1, 0xf8, 0xfe,
+	0xaa, 0x31, 0xc3, 0x38, 0x14, 0x25, 0x96, 0xdc, 0x01, 0x70, 0x68, 0x18, 0x0b, 0xeb, 0x3c, 0xda,
+	0x6e, 0x71, 0x86, 0x0d, 0xfd, 0x92, 0x6e, 0x8c, 0x06, 0x23, 0x72, 0x1d, 0x88, 0xe8, 0x0b, 0x33,
+	0x0f, 0xb7, 0xf6, 0x68, 0x18, 0xba, 0x6d, 0x2a, 0x83, 0xe6, 0x59, 0xd9, 0x15, 0xd2, 0x1c, 0xa0,
+	0xc0, 0x21, 0xad, 0x48, 0x04, 0xe5, 0xa8, 0x47, 0x1d, 0xb9, 0xbe, 0x6f, 0xe7, 0x18, 0x00, 0x53,
+	0xa5, 0xf3, 0xcd, 0x1e, 0x75, 0xc4, 0x3c, 0xd6, 0x33, 0x88, 0x81, 0x90, 0x0b, 0x3b, 0xf5, 0x50,
+	0xda, 0xb0, 0xa8, 0xe3, 0xcf, 0xcf, 0xa2, 0xce, 0x7e, 0x0a, 0x6a, 0x5a, 0x2f, 0x23, 0x2d, 0xd6,
+	0x1f, 0x17, 0x00, 0x96, 0xed, 0xd8, 0x5e, 0x75, 0xbd, 0x58, 0xb8, 0x36, 0x3d, 0x3b, 0xde, 0xc9,
+	0x2e, 0xd1, 0x4d, 0x3b, 0xde, 0x41, 0x8e, 0x21, 0xaf, 0x49, 0x23, 0x29, 0x96, 0xa7, 0x65, 0x1a,
+	0xc9, 0x47, 0x07, 0x73, 0xd5, 0xeb, 0xcd, 0x5b, 0x37, 0x0d, 0x83, 0x39, 0xa7, 0x04, 0x97, 0xb8,
+	0x5f, 0x5f, 0x3b, 0x3c, 0x98, 0xab, 0xbc, 0xc3, 0x00, 0xb2, 0x0f, 0xe4, 0xf3, 0x00, 0x4e, 0xd0,
+	0x65, 0x0a, 0x8c, 0x83, 0x50, 0x4e, 0xb4, 0xcb, 0x4a, 0xc7, 0x4b, 0x1a, 0xf3, 0x28, 0xf5, 0x0b,
+	0x8d, 0x36, 0xdc, 0x66, 0xd0, 0x6e, 0xcf, 0xb3, 0x63, 0x6a, 0x55, 0x32, 0x36, 0x43, 0xc2, 0x51,
+	0x53, 0xd4, 0x7f, 0x5a, 0x04, 0x58, 0xa6, 0x76, 0x7b, 0x9d, 0xc6, 0xec, 0x7b, 0x3f, 0x80, 0x2a,
+	0x1f, 0x85, 0xc5, 0x7e, 0x24, 0x0d, 0xc5, 0xe6, 0xd3, 0x8f, 0xd7, 0x8a, 0xe4, 0x94, 0xf0, 0x6f,
+	0xba, 0xfe, 0xae, 0x70, 0x30, 0x15, 0x0e, 0xb5, 0x3c, 0x72, 0x0f, 0xca, 0x3b, 0x71, 0xdc, 0x93,
+	0x79, 0xb3, 0xf5, 0xa7, 0x97, 0x7b, 0xad, 0xd5, 0xda, 0xcc, 0xc8, 0xe4, 0xc1, 0x04, 0x83, 0x23,
+	0x97, 0x41, 0xbe, 0x02, 0xb5, 0x7b, 0x34, 0x6e, 0xc6, 0x21, 0xb5, 0xbb, 0xd2, 0x5a, 0xe4, 0x58,
+	0x90, 0xd7, 0x15, 0xab, 0x8c, 0x54, 0x1e, 0x13, 0x68, 0x24, 0x26, 0x22, 0xeb, 0x7f, 0x5c, 0x80,
+	0x0a, 0x57, 0x01, 0xe9, 0xc2, 0xb8, 0x13, 0xf8, 0x31, 0x7d, 0x18, 0x5b, 0x85, 0xbc, 0xf1, 0x13,
+	0xe7, 0xb8, 0x24, 0xb8, 0x2d, 0x4e, 0xb0, 0x85, 0x21, 0x7f, 0xa0, 0x92, 0xc1, 0xe2, 0xca, 0xb6,
+	0x1d, 0xdb, 0x5c, 0xc9, 0x93, 0x42, 0x2d, 0x6c, 0xba, 0x23, 0x87, 0xbe, 0x5d, 0xfd, 0xf6, 0x9f,
+	0xcc, 0xbd, 0xf0, 0xb5, 0x7f, 0xbf, 0xfc, 0x42, 0x7d, 0x09, 0x2e, 0x0c, 0x1f, 0xbe, 0x11, 0x5c,
+	0xb8, 0xfa, 0x4f, 0x8a, 0x30, 0x69, 0xf6, 0x89, 0xcc, 0x42, 0xd1, 0x6d, 0xcb, 0x66, 0x20, 0x9b,
+	0x15, 0xd7, 0x96, 0xb1, 0xe8, 0xb6, 0x8f, 0xed, 0x4b, 0x7c, 0x12, 0x26, 0x98, 0x65, 0xdb, 0xa3,
+	0x21, 0xdb, 0x8f, 0xa5, 0x3f, 0x71, 0x4e, 0x12, 0x4f, 0xb0, 0x55, 0xff, 0x8e, 0x40, 0xa1, 0x49,
+	0xa7, 0x9d, 0x99, 0xf2, 0x91, 0xce, 0x4c, 0x03, 0xa6, 0x99, 0x12, 0xb8, 0xa6, 0xfc, 0x98, 0x13,
+	0x57, 0xd2, 0x0e, 0x27, 0xd3, 0xd4, 0x92, 0x40, 0xf3, 0x76, 0x59, 0x7a, 0x53, 0x37, 0x63, 0x4f,
+	0xf0, 0x73, 0xd6, 0xa1, 0xcc, 0x36, 0x6e, 0x19, 0xaf, 0x7d, 0xdc, 0xd8, 0xaa, 0x74, 0x02, 0x3b,
+	0x19, 0x68, 0x96, 0x27, 0x67, 0x9b, 0x17, 0xdf, 0x69, 0x93, 0xbe, 0xb3, 0xbd, 0x96, 0x73, 0x31,
+	0x06, 0xee, 0xbf, 0xcb, 0x20, 0x1c, 0xe3, 0x65, 0xda, 0xa3, 0x7e, 0x9b, 0xfa, 0xce, 0x3e, 0xfb,
+	0x76, 0x3f, 0x49, 0x64, 0xeb, 0xf6, 0xdc, 0xfb, 0xe5, 0x98, 0x53, 0x71, 0xb6, 0xf7, 0x60, 0x7c,
+	0x9b, 0x5b, 0xd9, 0xc8, 0x2a, 0xe7, 0xf5, 0x49, 0x32, 0x5f, 0x2c, 0xac, 0xb7, 0x58, 0x02, 0xe2,
+	0xef, 0x08, 0x95, 0x30, 0xf2, 0xf5, 0x02, 0xd4, 0xe2, 0xd0, 0xf6, 0xa3, 0xed, 0x20, 0xec, 0xca,
+	0x38, 0xbf, 0x75, 0x62, 0xa2, 0x5b, 0x8a, 0x33, 0x95, 0x39, 0x01, 0x0d, 0xc0, 0x44, 0x2a, 0x71,
+	0xe1, 0x82, 0xec, 0xce, 0x7a, 0xd0, 0x71, 0x1d, 0xdb, 0x13, 0x49, 0xa8, 0x20, 0x94, 0xf3, 0xe6,
+	0x0d, 0x95, 0x7f, 0x5c, 0x1d, 0x4a, 0xf5, 0xe8, 0x60, 0x6e, 0x3a, 0x03, 0xc2, 0x23, 0x18, 0xf2,
+	0x75, 0xc5, 0x0f, 0x3f, 0xac, 0xf1, 0xcc, 0xba, 0xe2, 0x50, 0x94, 0x58, 0xf2, 0x59, 0x98, 0x66,
+	0x9f, 0xe6, 0xc6, 0xee, 0x1e, 0x5d, 0x75, 0xa9, 0xd7, 0x8e, 0x78, 0x0a, 0xb3, 0x26, 0xe3, 0xdb,
+	0x34, 0x0a, 0xb3, 0xb4, 0xf5, 0xaf, 0x57, 0xe0, 0xfc, 0xd0, 0x51, 0x20, 0x5b, 0x72, 0xa6, 0x0b,
+	0xf3, 0xb6, 0x9c, 0x63, 0xff, 0x77, 0xbb, 0x54, 0x8e, 0x6c, 0x35, 0x3d, 0xff, 0x4d, 0x2b, 0x5a,
+	0x7c, 0x0e, 0x56, 0x74, 0x5b, 0x5a, 0x51, 0x91, 0x17, 0xcc, 0xf1, 0x49, 0x89, 0xab, 0x91, 0x2c,
+	0xcb, 0xc4, 0x1e, 0x13, 0x17, 0x2a, 0xf4, 0x61, 0x2f, 0x54, 0x61, 0x50, 0x0e, 0x41, 0x2b, 0x0f,
+	0x7b, 0xa1, 0x14, 0x34, 0x25, 0x05, 0x55, 0x18, 0x2c, 0x42, 0x21, 0x81, 0xbc, 0x0f, 0xe7, 0x98,
+	0xc8, 0xec, 0x74, 0x14, 0x16, 0x70, 0x5e, 0x36, 0x39, 0xb7, 0x3c, 0x48, 0x32, 0x6c, 0x2e, 0x0e,
+	0x63, 0xc5, 0x24, 0x30, 0x51, 0xc3, 0x27, 0xbc, 0x96, 0xb0, 0x32, 0x48, 0x32, 0x54, 0xc2, 0x10,
+	0x56, 0xf5, 0xf7, 0x61, 0xf6, 0xe8, 0xd5, 0xc8, 0x36, 0x9f, 0x7b, 0xf7, 0xb3, 0x9b, 0xcf, 0xf5,
+	0xdb, 0x58, 0xbc, 0x77, 0x5f, 0x2c, 0x92, 0xd0, 0xed, 0xc5, 0x03, 0x9b, 0x0f, 0x87, 0xa2, 0xc4,
+	0xb2, 0x7d, 0x1b, 0x12, 0x55, 0x32, 0xc3, 0xca, 0xfa, 0x91, 0x35, 0xac, 0x8c, 0x02, 0x39, 0x86,
+	0x65, 0xc0, 0xb7, 0xc5, 0x62, 0x2a, 0x5e, 0x2e, 0xe5, 0x9b, 0x97, 0xd2, 0xc9, 0xe5, 0xeb, 0x2d,
+	0xe9, 0xa0, 0x5c, 0x8f, 0x52, 0x4a, 0xfd, 0x75, 0x98, 0x34, 0xb3, 0xa8, 0x4f, 0x76, 0x60, 0xeb,
+	0x5d, 0x38, 0x7f, 0x75, 0x69, 0x93, 0x87, 0xc9, 0xea, 0x64, 0x73, 0xd1, 0x8e, 0x9d, 0x1d, 0xb6,
+	0x99, 0x75, 0xed, 0x87, 0x4d, 0xf7, 0x03, 0xb1, 0x74, 0x2b, 0xc9, 0x66, 0xb6, 0x21, 0xc0, 0xa8,
+	0xf0, 0x92, 0xf4, 0xae, 0xed, 0xc6, 0xd9, 0xfc, 0xde, 0x86, 0x00, 0xa3, 0xc2, 0xd7, 0xf7, 0x60,
+	0x2e, 0x2b, 0x0e, 0x69, 0xd4, 0x0b, 0xfc, 0x88, 0xae, 0x07, 0x9d, 0x8e, 0xeb, 0x77, 0xc8, 0x02,
+	0x54, 0x3c, 0xba, 0x47, 0x3d, 0xd9, 0xe9, 0x97, 0xd4, 0x7c, 0x5d, 0x67, 0x40, 0xe6, 0x54, 0xaf,
+	0x07, 0x1d, 0xfe, 0x37, 0x0a, 0x3a, 0x96, 0xa4, 0x0e, 0x69, 0xdb, 0x76, 0x62, 0xae, 0x64, 0x99,
+	0xa4, 0x46, 0x0e, 0x41, 0x89, 0xa9, 0xff, 0xdd, 0x39, 0xb8, 0x98, 0x15, 0x9c, 0xff, 0xc0, 0xb7,
+	0x01, 0xd3, 0x4e, 0x48, 0xdb, 0xd4, 0x8f, 0x5d, 0xdb, 0x8b, 0x98, 0x56, 0xb3, 0xfb, 0xe6, 0x52,
+	0x1a, 0x8d, 0x59, 0x7a, 0x33, 0x42, 0x2a, 0x9d, 0x5a, 0xce, 0xa9, 0xfc, 0xdc, 0x03, 0xc3, 0xfb,
+	0x30, 0x15, 0xd2, 0x38, 0xdc, 0x6f, 0xc6, 0xa1, 0x1d, 0xd3, 0xce, 0xbe, 0xdc, 0x88, 0xaf, 0x8c,
+	0x9c, 0xb8, 0x5e, 0xb4, 0x9d, 0xdd, 0x60, 0x7b, 0x7b, 0x71, 0xe6, 0xf0, 0x60, 0x6e, 0x0a, 0x4d,
+	0x96, 0x98, 0x96, 0x40, 0xee, 0xc1, 0x8c, 0xa1, 0x7c, 0x99, 0x2a, 0x18, 0x1b, 0x25, 0x55, 0x70,
+	0xfe, 0xf0, 0x60, 0x6e, 0x66, 0x29, 0xcb, 0x03, 0x07, 0xd9, 0x92, 0x6b, 0x50, 0xa5, 0xbe, 0x13,
+	0xb4, 0x5d, 0xbf, 0x23, 0xf7, 0xdd, 0xd7, 0x54, 0x14, 0xb6, 0x22, 0xe1, 0x8f, 0x0e, 0xe6, 0xac,
+	0xec, 0x8c, 0x54, 0x38, 0xd4, 0xad, 0xc9, 0x97, 0x60, 0xca, 0xb1, 0x59, 0x7a, 0xc2, 0xdd, 0x66,
+	0xe7, 0x8c, 0xd4, 0xaa, 0x8e, 0xd2, 0x63, 0xae, 0x95, 0xa5, 0x86, 0xd1, 0x1e, 0xd3, 0xec, 0x58,
+	0xbc, 0xd8, 0x0b, 0x83, 0x87, 0xfb, 0x2c, 0x23, 0x53, 0x4b, 0xc7, 0x8b, 0x9b, 0x12, 0x8e, 0x9a,
+	0x82, 0xf4, 0xa0, 0xb2, 0xc5, 0xac, 0x83, 0x05, 0x79, 0x5d, 0xb6, 0xa1, 0x46, 0x47, 0x44, 0xc4,
+	0xfc, 0x4f, 0x14, 0x82, 0xc8, 0x9b, 0x00, 0xf2, 0xd6, 0x06, 0x73, 0xf7, 0x27, 0xb8, 0x25, 0xd2,
+	0x93, 0xeb, 0xaa, 0xc6, 0xa0, 0x41, 0x45, 0x5e, 0x11, 0x67, 0x45, 0x93, 0xfc, 0x73, 0x26, 0x24,
+	0x71, 0x72, 0xd0, 0xf3, 0x1a, 0x54, 0x3d, 0x79, 0x6a, 0x66, 0x4d, 0xa5, 0x3f, 0x59, 0x9d, 0xa6,
+	0xa1, 0xa6, 0x60, 0xd4, 0x54, 0xa6, 0x0e, 0xad, 0x33, 0x3c, 0x09, 0x75, 0x36, 0x19, 0x4a, 0x01,
+	0x47, 0x4d, 0x41, 0x36, 0x01, 0x92, 0x1b, 0x01, 0xd6, 0x34, 0xe7, 0xfe, 0xba, 0xea, 0x6e, 0x72,
+	0x77, 0xe0, 0xd1, 0xc1, 0xdc, 0x6c, 0x56, 0x03, 0x09, 0x16, 0x0d, 0x1e, 0xe4, 0xc3, 0x50, 0x89,
+	0x83, 0x9e, 0xeb, 0x58, 0x67, 0x39, 0x33, 0xbd, 0x7d, 0xb7, 0x18, 0x10, 0x05, 0x8e, 0x11, 0xd9,
+	0xd1, 0xbe, 0xef, 0x58, 0x33, 0xbc, 0x87, 0x9a, 0xa8, 0xc1, 0x80, 0x28, 0x70, 0xe4, 0x1b, 0x05,
+	0x18, 0xdf, 0xa1, 0x76, 0x9b, 0xad, 0x78, 0xc2, 0x57, 0xfc, 0x97, 0x4e, 0x6e, 0xfc, 0x54, 0x3e,
+	0xea, 0x9a, 0x10, 0x20, 0x52, 0x52, 0xc9, 0x39, 0x8f, 0x80, 0xa2, 0x92, 0x4f, 0xf6, 0x60, 0x4a,
+	0xa4, 0xee, 0x24, 0xc6, 0x3a, 0xc7, 0x3b, 0xf4, 0xd9, 0xd1, 0x0f, 0x2e, 0x0d, 0x2e, 0x62, 0xba,
+	0x9b, 0x90, 0x08, 0xd3, 0x62, 0xc8, 0xb7, 0x0b, 0x30, 0x1d, 0xa6, 0x37, 0x1c, 0xeb, 0x45, 0x3e,
+	0x97, 0xdf, 0x3d, 0x39, 0x5d, 0x64, 0x76, 0x34, 0xe1, 0x42, 0x67, 0x80, 0x98, 0xed, 0x06, 0x8b,
+	0xa0, 0x92, 0xb8, 0xe4, 0x7c, 0x3a, 0x82, 0x1a, 0x1a, 0x45, 0xbc, 0x07, 0x2f, 0xb9, 0xdd, 0x1e,
+	0x0d, 0xa3, 0xc0, 0xb7, 0x63, 0xca, 0xd2, 0x90, 0xae, 0x43, 0x1b, 0x8e, 0x13, 0xf4, 0xfd, 0xd8,
+	0xba, 0xc0, 0x19, 0xfc, 0x3f, 0xc9, 0xe0, 0xa5, 0xb5, 0xa3, 0x08, 0xf1, 0x68, 0x1e, 0x04, 0xe1,
+	0x42, 0x82, 0x74, 0x03, 0x7f, 0x99, 0x7a, 0xb4, 0x63, 0xc7, 0x34, 0xb2, 0x2e, 0xf2, 0x8d, 0x76,
+	0x96, 0x85, 0x28, 0x6b, 0x43, 0x29, 0xf0, 0x88, 0x96, 0xe4, 0x0f, 0x0b, 0x30, 0x61, 0xd8, 0x4b,
+	0xcb, 0xe2, 0xe3, 0xbe, 0x75, 0xf2, 0x13, 0xd1, 0xb0, 0xd3, 0x62, 0x32, 0xea, 0x24, 0x81, 0x81,
+	0x41, 0xb3, 0x2f, 0xec, 0x5a, 0x89, 0xf1, 0x93, 0x9d, 0x04, 0xbe, 0x94, 0xbe, 0x56, 0xb2, 0x94,
+	0xc2, 0x62, 0x86, 0x9a, 0xb9, 0x03, 0x5d, 0xfb, 0xa1, 0x1a, 0x68, 0xee, 0x3a, 0xcd, 0x5e, 0x2e,
+	0xbc, 0x5a, 0x4a, 0xdc, 0x81, 0x8d, 0x34, 0x1a, 0xb3, 0xf4, 0x6c, 0x12, 0xf4, 0x23, 0x1a, 0x36,
+	0x3a, 0xd4, 0x8f, 0xad, 0x0f, 0xa5, 0x27, 0xc1, 0x1d, 0x85, 0xc0, 0x84, 0x86, 0xc9, 0xe4, 0xdb,
+	0xdc, 0x2d, 0x3d, 0xeb, 0xac, 0x97, 0xd3, 0x2e, 0x08, 0xa6, 0xd1, 0x98, 0xa5, 0x9f, 0x7d, 0x1b,
+	0x26, 0xcd, 0x55, 0x3b, 0x4a, 0xc2, 0x74, 0x96, 0xc2, 0xd9, 0xac, 0xa2, 0x87, 0xb4, 0xff, 0x8c,
+	0xd9, 0xfe, 0xb8, 0x9b, 0x97, 0x99, 0x97, 0xfd, 0xeb, 0x32, 0x4c, 0x18, 0x07, 0xe0, 0xca, 0xc2,
+	0x17, 0x8e, 0xb0, 0xf0, 0x6c, 0x20, 0xbd, 0xc0, 0xa7, 0xcb, 0x6e, 0xc8, 0x59, 0xed, 0x5b, 0xc5,
+	0xcc, 0x40, 0xa6, 0xb0, 0x98, 0xa1, 0x26, 0x0e, 0x54, 0xd8, 0xd0, 0x46, 0x32, 0x37, 0xb8, 0x98,
+	0xeb, 0xd4, 0x9e, 0xe9, 0x27, 0x12, 0x3b, 0x1b, 0xff, 0x13, 0x05, 0x6f, 0xf2, 0x2b, 0x30, 0x19,
+	0x45, 0x3b, 0xfc, 0x83, 0xb9, 0x2b, 0x32, 0xd2, 0xa9, 0xf3, 0x59, 0xe6, 0x99, 0x36, 0x9b, 0xd7,
+	0x74, 0x73, 0x4c, 0x31, 0x63, 0xbb, 0x16, 0xbb, 0x36, 0xc1, 0x5d, 0xd2, 0x4c, 0x1a, 0x78, 0x55,
+	0xc2, 0x51, 0x53, 0xb0, 0xf8, 0x67, 0x2b, 0xb4, 0x7d, 0x67, 0x47, 0x86, 0x63, 0x3a, 0xbc, 0x58,
+	0xe4, 0x50, 0x94, 0x58, 0xa6, 0xf6, 0xd8, 0x56, 0x1e, 0x8d, 0x56, 0x7b, 0xcb, 0xee, 0x20, 0x83,
+	0x33, 0x74, 0x48, 0xb7, 0xad, 0x6a, 0x1a, 0x8d, 0x74, 0x1b, 0x19, 0x9c, 0x74, 0x99, 0x9f, 0xde,
+	0x0d, 0x62, 0xca, 0x1d, 0x8d, 0x89, 0x37, 0xd7, 0x72, 0xa9, 0x15, 0x39, 0x2b, 0x71, 0xe5, 0x42,
+	0xb9, 0xfc, 0x0c, 0x82, 0x52, 0x48, 0xfd, 0x2f, 0x0a, 0x50, 0x55, 0xea, 0x27, 0xb7, 0xa0, 0xca,
+	0xd6, 0x8c, 0xce, 0x83, 0x1d, 0x5b, 0xd1, 0x3c, 0x5d, 0x7d, 0x47, 0x36, 0x45, 0xcd, 0x84, 0x31,
+	0xec, 0xd9, 0x51, 0xf4, 0x20, 0x08, 0xdb, 0x56, 0x71, 0x64, 0x86, 0x9b, 0xb2, 0x29, 0x6a, 0x26,
+	0xf5, 0xdb, 0x30, 0x9d, 0xf9, 0xaa, 0x63, 0x24, 0xee, 0x5e, 0x86, 0x72, 0x3f, 0xf4, 0x22, 0x19,
+	0xf8, 0xf0, 0xb4, 0xc8, 0x1d, 0x5c, 0x6f, 0x22, 0x87, 0xd6, 0x7f, 0x56, 0x04, 0x32, 0x98, 0x0d,
+	0x7f, 0xd2, 0xe2, 0xf9, 0x4d, 0xc3, 0x4d, 0x10, 0x51, 0xeb, 0xbb, 0x27, 0x99, 0x8c, 0x3f, 0xae,
+	0x87, 0x70, 0x07, 0x4a, 0xb1, 0xa7, 0x56, 0xe0, 0xdb, 0x23, 0xfb, 0x05, 0xad, 0xf5, 0xa6, 0x9c,
+	0x1b, 0xfc, 0xb2, 0x4c, 0x6b, 0xbd, 0x89, 0x8c, 0x1f, 0x8b, 0x55, 0x59, 0xca, 0x28, 0xe8, 0xc7,
+	0x32, 0x17, 0xac, 0x7b, 0xd0, 0x12, 0x60, 0x54, 0xf8, 0x3c, 0x76, 0xb1, 0xfe, 0x8f, 0x55, 0x98,
+	0x60, 0xdf, 0xae, 0x62, 0xcc, 0x27, 0xe8, 0xdc, 0x88, 0x02, 0x8b, 0xcf, 0x31, 0x0a, 0x7c, 0x46,
+	0x3a, 0xfe, 0x28, 0x8c, 0x75, 0x69, 0xbc, 0x13, 0xb4, 0xb3, 0xf7, 0x8b, 0x37, 0x38, 0x14, 0x25,
+	0xf6, 0xb4, 0x2f, 0x3e, 0x98, 0x73, 0x61, 0x8c, 0xef, 0xd3, 0x47, 0xce, 0x05, 0xd2, 0x81, 0xda,
+	0x96, 0x1d, 0xb9, 0x4e, 0xa3, 0x1f, 0xef, 0x58, 0xe3, 0x4f, 0xa9, 0xaf, 0x45, 0xc5, 0x41, 0xa4,
+	0x86, 0xf5, 0x4f, 0x4c, 0x78, 0x93, 0x2f, 0x27, 0x8b, 0x4f, 0x5c, 0x21, 0xc5, 0x7c, 0x8b, 0x2f,
+	0xaf, 0x5f, 0x5e, 0x7b, 0x3e, 0x7e, 0xf9, 0x10, 0xd7, 0x09, 0x46, 0x74, 0x9d, 0x06, 0x52, 0x0a,
+	0x13, 0xcf, 0x3c, 0xa5, 0xf0, 0x11, 0x18, 0x97, 0xce, 0x94, 0x35, 0xc9, 0x2d, 0x30, 0xcf, 0x17,
+	0x2b, 0x87, 0x4b, 0xe1, 0x72, 0x19, 0x92, 0xbf, 0x2c, 0xc0, 0xc4, 0x5a, 0x9b, 0x76, 0x7b, 0x41,
+	0xcc, 0x0f, 0x73, 0xd8, 0x16, 0x1c, 0x0f, 0x18, 0x92, 0x56, 0x6b, 0x1d, 0x19, 0x9c, 0x7c, 0xad,
+	0x60, 0x1e, 0x6d, 0x8a, 0x8d, 0xa9, 0x79, 0x02, 0x47, 0x9b, 0x46, 0x17, 0x9a, 0x71, 0x10, 0xd2,
+	0xc7, 0x1c, 0x6e, 0x1e, 0x16, 0xe0, 0xe2, 0x11, 0x47, 0xa2, 0x4f, 0x32, 0x83, 0xc6, 0x01, 0x5a,
+	0xf1, 0x09, 0x07, 0x68, 0x2c, 0x65, 0x9b, 0x9c, 0xdf, 0x9a, 0x29, 0x5b, 0xd1, 0x21, 0x89, 0x55,
+	0x26, 0xae, 0x7c, 0xb2, 0x26, 0xae, 0xfe, 0xb7, 0x05, 0x78, 0xe9, 0x48, 0xe5, 0x3c, 0xe9, 0x33,
+	0x99, 0xbb, 0xd5, 0x77, 0x76, 0xe9, 0x40, 0xba, 0x79, 0x91, 0x43, 0x51, 0x62, 0x9f, 0x91, 0x79,
+	0xae, 0xff, 0x56, 0x09, 0x66, 0x6e, 0x5c, 0x69, 0xaa, 0x4b, 0x9e, 0x9b, 0x81, 0xe7, 0x3a, 0xfb,
+	0xe4, 0xab, 0x30, 0xe6, 0xd9, 0x5b, 0xd4, 0x63, 0x27, 0xff, 0x6c, 0xc9, 0xdf, 0x7d, 0xfa, 0x59,
+	0x33, 0xc0, 0x7c, 0x7e, 0x9d, 0x73, 0x16, 0xc6, 0x47, 0x7f, 0xad, 0x00, 0xa2, 0x14, 0x4b, 0xde,
+	0x83, 0xf1, 0x2d, 0xb1, 0xf2, 0xac, 0x62, 0xce, 0x95, 0xcb, 0x97, 0xa1, 0xfc, 0x81, 0x8a, 0x2b,
+	0x69, 0xc2, 0x79, 0x1a, 0x86, 0x41, 0x78, 0xcb, 0x97, 0x28, 0x69, 0xe5, 0xb9, 0x82, 0xab, 0x8b,
+	0xaf, 0xc8, 0x7e, 0x9d, 0x5f, 0x19, 0x46, 0x84, 0xc3, 0xdb, 0xce, 0x7e, 0x1a, 0x26, 0x8c, 0x8f,
+	0x1b, 0x69, 0x69, 0x7f, 0x7f, 0x1c, 0x26, 0x6f, 0xd8, 0xdb, 0xbb, 0xf6, 0x31, 0x9d, 0x04, 0x9d,
+	0x09, 0x2a, 0x3e, 0x26, 0x13, 0xb4, 0x00, 0xb5, 0x9e, 0x1d, 0xc6, 0xfc, 0x86, 0x16, 0xff, 0xb0,
+	0x4a, 0x12, 0x40, 0x6e, 0x2a, 0x04, 0x26, 0x34, 0xa7, 0x9e, 0x09, 0xbe, 0x02, 0x93, 0x21, 0xbd,
+	0xdf, 0x77, 0xf9, 0x75, 0xd9, 0xdd, 0x88, 0x47, 0x2b, 0x95, 0x24, 0xfb, 0x8e, 0x06, 0x0e, 0x53,
+	0x94, 0x2c, 0xc6, 0x61, 0x17, 0x5f, 0x42, 0x1a, 0x45, 0xd6, 0x58, 0x3a, 0x33, 0xb7, 0x24, 0xe1,
+	0xa8, 0x29, 0x58, 0x4c, 0xb8, 0xed, 0xf5, 0xa3, 0x9d, 0x55, 0xc6, 0x83, 0x2d, 0x55, 0xbe, 0x8d,
+	0x57, 0x92, 0x98, 0x70, 0x35, 0x85, 0xc5, 0x0c, 0xb5, 0x5a, 0x8c, 0xd5, 0x13, 0xf6, 0x95, 0x0c,
+	0xcf, 0xaf, 0xf6, 0x1c, 0x3d, 0xbf, 0x06, 0x4c, 0xeb, 0x29, 0xe0, 0xfa, 0x1d, 0x96, 0xeb, 0x80,
+	0x74, 0xda, 0x60, 0x33, 0x8d, 0xc6, 0x2c, 0x3d, 0x33, 0xd6, 0xea, 0x16, 0xc6, 0x44, 0xda, 0x58,
+	0xab, 0x1b, 0x18, 0x0a, 0x4f, 0xde, 0x85, 0x72, 0x64, 0x47, 0x22, 0x23, 0xfb, 0x54, 0xaf, 0x13,
+	0x1a, 0xcd, 0x75, 0xa9, 0x3d, 0x1e, 0xe3, 0xb0, 0xdf, 0xc8, 0x59, 0xb2, 0xfc, 0xb0, 0xab, 0xcc,
+	0x6f, 0xcc, 0xd3, 0xb9, 0xd5, 0x64, 0xca, 0x69, 0xc3, 0x1c, 0xa3, 0x41, 0x45, 0xde, 0x85, 0x8b,
+	0x99, 0x8f, 0x51, 0x57, 0xa3, 0x78, 0x86, 0xb7, 0xb6, 0x38, 0x27, 0x19, 0x5c, 0xdc, 0x1c, 0x4e,
+	0x86, 0x47, 0xb5, 0xaf, 0xff, 0x4f, 0x11, 0x60, 0x3d, 0xe8, 0xa8, 0x15, 0xdd, 0x80, 0x69, 0xd7,
+	0x8f, 0x69, 0xb8, 0x67, 0x7b, 0x4d, 0xea, 0x04, 0x7e, 0x5b, 0xdc, 0xab, 0x2a, 0x27, 0x6a, 0x5e,
+	0x4b, 0xa3, 0x31, 0x4b, 0x9f, 0x1c, 0x87, 0x15, 0x8f, 0x79, 0x1c, 0xf6, 0xf3, 0x79, 0xa2, 0x54,
+	0xff, 0xb3, 0x12, 0x4c, 0xdc, 0x6c, 0xb4, 0x9a, 0xc7, 0x34, 0xa6, 0x23, 0xb8, 0x1a, 0x3f, 0xa7,
+	0x47, 0x74, 0xd2, 0xe0, 0x55, 0x4e, 0xd8, 0xfb, 0xf8, 0xdd, 0x32, 0x9c, 0xbd, 0xd5, 0xa3, 0xfe,
+	0xdd, 0x1d, 0x37, 0xda, 0x35, 0xde, 0x90, 0xec, 0x04, 0x51, 0x9c, 0xcd, 0x74, 0x5c, 0x0b, 0xa2,
+	0x18, 0x39, 0xc6, 0xb4, 0x36, 0xc5, 0x27, 0x58, 0x9b, 0x05, 0xa8, 0xb1, 0xe4, 0x48, 0xd4, 0xb3,
+	0x9d, 0x81, 0xab, 0x48, 0x37, 0x15, 0x02, 0x13, 0x1a, 0xfe, 0x42, 0xb2, 0x1f, 0xef, 0xb4, 0x82,
+	0x5d, 0xea, 0x3f, 0xc5, 0x6b, 0xc6, 0x86, 0x6a, 0x8b, 0x09, 0x1b, 0x66, 0x97, 0xec, 0xe4, 0x48,
+	0x59, 0xa4, 0xe0, 0xb4, 0xc6, 0x1b, 0x1a, 0x83, 0x06, 0x95, 0x39, 0xd1, 0xc6, 0x4e, 0x6d, 0xa2,
+	0x8d, 0x3f, 0xf7, 0x95, 0x8b, 0x30, 0x69, 0x5e, 0x6e, 0x38, 0xc6, 0xad, 0x5b, 0x95, 0x18, 0x2b,
+	0x1e, 0x95, 0x18, 0xab, 0xff, 0xac, 0x0a, 0x53, 0x9b, 0x7d, 0x2f, 0xb2, 0xc3, 0x93, 0x74, 0xae,
+	0x4e, 0xfb, 0x59, 0xe0, 0x29, 0x3d, 0x50, 0xe9, 0xc1, 0xb9, 0xd8, 0x8b, 0x5a, 0x61, 0x3f, 0x8a,
+	0xd9, 0xd1, 0xb1, 0x3a, 0x3b, 0xaf, 0x8c, 0xfc, 0x28, 0xab, 0xb5, 0xde, 0xcc, 0x72, 0xc1, 0x61,
+	0xac, 0xc9, 0x16, 0xcc, 0xc6, 0x5e, 0xd4, 0xf0, 0xbc, 0xe0, 0xc1, 0x9a, 0x2f, 0x32, 0x05, 0x4b,
+	0x81, 0xef, 0x53, 0xbe, 0x56, 0xa4, 0xb3, 0x57, 0x97, 0xfd, 0x9d, 0x6d, 0xad, 0x37, 0x8f, 0xa0,
+	0xc4, 0xc7, 0x70, 0x21, 0x1b, 0xfc, 0xab, 0xde, 0xb1, 0x3d, 0xb7, 0x6d, 0xc7, 0x94, 0x99, 0x1a,
+	0x3e, 0xa7, 0xc6, 0x39, 0xf3, 0x0f, 0xa9, 0x0b, 0x49, 0xad, 0xf5, 0x66, 0x96, 0x04, 0x87, 0xb5,
+	0x7b, 0x56, 0xfe, 0x61, 0x1b, 0xa6, 0xb5, 0x51, 0x91, 0x7a, 0xaf, 0x8d, 0xfc, 0x3c, 0xad, 0x91,
+	0xe6, 0x80, 0x59, 0x96, 0xe4, 0xcb, 0x30, 0xe3, 0x68, 0xcd, 0xc8, 0x08, 0xc7, 0x82, 0x9c, 0x51,
+	0x98, 0xb8, 0x2e, 0x91, 0x65, 0x8b, 0x83, 0x92, 0xc8, 0xef, 0x14, 0x00, 0x7a, 0x61, 0xd0, 0xa3,
+	0x61, 0xec, 0xd2, 0xc8, 0x9a, 0xc8, 0x1b, 0x80, 0xa6, 0x56, 0xfe, 0xfc, 0xa6, 0xe6, 0x9c, 0x79,
+	0xf0, 0x93, 0x20, 0xd0, 0x10, 0xcf, 0x1e, 0xfc, 0x64, 0x9a, 0x8c, 0x14, 0xd6, 0xfd, 0x4d, 0x11,
+	0x66, 0x36, 0xfb, 0xd1, 0x4e, 0xc7, 0x8e, 0xe9, 0x03, 0x7b, 0x7f, 0x83, 0xc6, 0xa1, 0xeb, 0x1c,
+	0x23, 0x97, 0xcf, 0xf6, 0x40, 0xea, 0xf5, 0xb2, 0x46, 0xed, 0x1a, 0xf5, 0x7a, 0xc8, 0x31, 0x46,
+	0x88, 0x5e, 0xca, 0xaf, 0xa1, 0x4c, 0x07, 0x8f, 0x15, 0xa2, 0x7f, 0x58, 0x7d, 0x74, 0x39, 0x6d,
+	0x24, 0xcd, 0x37, 0x0c, 0x79, 0x22, 0xe2, 0x3f, 0xaf, 0x00, 0x31, 0x7a, 0x76, 0x4c, 0xd3, 0xfd,
+	0x0a, 0x94, 0xee, 0x05, 0x5b, 0x56, 0x31, 0x8d, 0xbe, 0x1e, 0x6c, 0x21, 0x83, 0x93, 0xdf, 0x2e,
+	0x40, 0xb5, 0x13, 0x06, 0xfd, 0x1e, 0x3b, 0xeb, 0x17, 0x8a, 0xfb, 0xc2, 0x89, 0x28, 0x4e, 0xcd,
+	0xaf, 0xab, 0x92, 0xb9, 0xd0, 0x9d, 0x8e, 0x41, 0x15, 0x18, 0xb5, 0x74, 0x76, 0xe7, 0xb9, 0xcb,
+	0xb5, 0xad, 0xfc, 0xb9, 0x1b, 0x27, 0x38, 0x82, 0xc6, 0xad, 0x3b, 0x21, 0x03, 0x95, 0x30, 0x5e,
+	0x5a, 0x81, 0xf6, 0x3c, 0xe6, 0x0f, 0x55, 0xb8, 0x79, 0xd3, 0xa4, 0x28, 0xc0, 0xa8, 0xf0, 0xe9,
+	0x44, 0xf7, 0xd8, 0x33, 0x4c, 0x74, 0x9f, 0xb2, 0xd7, 0x31, 0xfb, 0x19, 0x98, 0x4a, 0x0d, 0xdc,
+	0x48, 0x13, 0xf5, 0x3f, 0x0b, 0x50, 0x43, 0x3b, 0xa6, 0xeb, 0x6e, 0xd7, 0x8d, 0xc9, 0x9b, 0x50,
+	0xee, 0xfb, 0xae, 0xf2, 0x5e, 0x55, 0xf1, 0x88, 0xf2, 0x1d, 0xdf, 0x8d, 0x1f, 0x1d, 0xcc, 0x9d,
+	0xd1, 0x84, 0x94, 0x41, 0x90, 0xd3, 0x8a, 0x73, 0xfb, 0xfb, 0x7d, 0x1a, 0xc5, 0xd1, 0x26, 0x0d,
+	0x19, 0x82, 0x4b, 0xa9, 0x98, 0xe7, 0xf6, 0x29, 0x34, 0x66, 0xe9, 0xd9, 0x6a, 0xdc, 0xea, 0x87,
+	0x51, 0x2c, 0xd3, 0x3c, 0x7a, 0x35, 0x2e, 0x32, 0x20, 0x0a, 0x1c, 0x69, 0x40, 0x35, 0xd8, 0xa3,
+	0x21, 0xab, 0x74, 0x20, 0x57, 0xed, 0x47, 0xd4, 0x04, 0xbd, 0x25, 0xe1, 0x8f, 0x0e, 0xe6, 0x66,
+	0x74, 0x1f, 0x15, 0x10, 0x75, 0xb3, 0xfa, 0xbf, 0x96, 0x81, 0x20, 0x6d, 0xbb, 0x91, 0xc8, 0x76,
+	0xaa, 0x55, 0xf9, 0x49, 0x98, 0x60, 0x9e, 0x79, 0xa3, 0xdd, 0xe6, 0x19, 0x98, 0x42, 0xfa, 0x25,
+	0xc6, 0xb5, 0x04, 0x85, 0x26, 0xdd, 0x89, 0x1f, 0x9c, 0xb2, 0x8b, 0xbd, 0xed, 0x2d, 0xa9, 0x03,
+	0x7d, 0xb1, 0x77, 0x79, 0x11, 0x8b, 0xed, 0xad, 0x67, 0x94, 0xfd, 0x35, 0x92, 0xcf, 0x95, 0xc7,
+	0x26, 0x9f, 0xd9, 0x41, 0x98, 0xfd, 0x70, 0x9d, 0xfa, 0xf2, 0x7c, 0x29, 0x39, 0x08, 0xe3, 0x50,
+	0x94, 0xd8, 0x53, 0x7a, 0x26, 0x97, 0x59, 0x82, 0xd5, 0xe7, 0xee, 0xf8, 0xff, 0x43, 0x11, 0xc6,
+	0x9a, 0x9c, 0x09, 0x79, 0x1f, 0xaa, 0x5d, 0x1a, 0xdb, 0xfc, 0x5a, 0xbd, 0x38, 0x9f, 0x7f, 0xfd,
+	0x78, 0x6f, 0x62, 0x6e, 0xf1, 0x18, 0x7d, 0x83, 0xc6, 0x76, 0x22, 0x2e, 0x81, 0xa1, 0xe6, 0xca,
+	0x2e, 0xed, 0xf3, 0xf7, 0x97, 0xc5, 0xbc, 0xef, 0x10, 0x44, 0x8f, 0xd9, 0x4b, 0xa3, 0xa1, 0x4f,
+	0x2e, 0x59, 0xd1, 0x93, 0xd8, 0x8e, 0xfb, 0x51, 0xfe, 0x82, 0x18, 0x52, 0x12, 0xe7, 0x66, 0xce,
+	0x31, 0xf6, 0x1b, 0xa5, 0x94, 0xfa, 0xf7, 0x0b, 0x00, 0x82, 0x70, 0xdd, 0x8d, 0x62, 0xf2, 0xc5,
+	0x01, 0x45, 0xce, 0x1f, 0x4f, 0x91, 0xac, 0x35, 0x57, 0x63, 0x72, 0x19, 0xd2, 0x8d, 0xb2, 0x4a,
+	0xa4, 0x50, 0x71, 0x63, 0xda, 0x55, 0x17, 0x03, 0x3e, 0x9f, 0xf7, 0xdb, 0x12, 0xa3, 0xb5, 0xc6,
+	0xd8, 0xa2, 0xe0, 0x5e, 0xff, 0x97, 0x9a, 0xfa, 0x26, 0xa6, 0x58, 0xf2, 0x1b, 0x05, 0x98, 0x6c,
+	0xab, 0x4b, 0xfd, 0x2e, 0x55, 0x27, 0x14, 0x6b, 0x27, 0xf6, 0x6a, 0x27, 0x49, 0x37, 0x2f, 0x1b,
+	0x62, 0x30, 0x25, 0x94, 0x04, 0x50, 0x8d, 0xc5, 0x0c, 0x57, 0x9f, 0xdf, 0xc8, 0xbd, 0x56, 0x8c,
+	0xc7, 0x99, 0x92, 0x35, 0x6a, 0x21, 0xc4, 0x33, 0x9e, 0x72, 0xe6, 0xbe, 0x88, 0xa4, 0x32, 0x94,
+	0xc2, 0x8c, 0x0e, 0x3e, 0x05, 0x65, 0x6f, 0x9d, 0xe5, 0x09, 0xc7, 0xaa, 0xed, 0x7a, 0xb4, 0x8d,
+	0x41, 0xdf, 0x17, 0x07, 0xf8, 0xd5, 0xe4, 0xad, 0xf3, 0xca, 0x00, 0x05, 0x0e, 0x69, 0xc5, 0x72,
+	0xfa, 0xea, 0x5d, 0xa7, 0x91, 0xfe, 0xd0, 0x4a, 0x5e, 0x31, 0x70, 0x98, 0xa2, 0x24, 0xaf, 0xb2,
+	0x5a, 0x26, 0xbc, 0xa4, 0x92, 0xc8, 0xe9, 0x57, 0x54, 0x41, 0x12, 0x01, 0x43, 0x8d, 0x25, 0x0f,
+	0x61, 0xc2, 0x4d, 0xce, 0xdd, 0xac, 0xf1, 0xbc, 0xf5, 0x55, 0x8c, 0x43, 0xbc, 0xc5, 0x69, 0xb6,
+	0x83, 0x19, 0x00, 0x34, 0x45, 0x31, 0x4d, 0xc9, 0x31, 0x5a, 0x0a, 0x7c, 0xa7, 0x1f, 0x86, 0xbc,
+	0x03, 0x55, 0xde, 0x5b, 0xad, 0xa9, 0xd6, 0x00, 0x05, 0x0e, 0x69, 0x45, 0xbe, 0x08, 0x33, 0x6d,
+	0xea, 0xb9, 0x7b, 0x34, 0xdc, 0x6f, 0xd2, 0xae, 0xed, 0xc7, 0xcc, 0x37, 0xac, 0xa5, 0xde, 0xc4,
+	0xcc, 0x2c, 0x67, 0x09, 0x1e, 0x0d, 0x03, 0xe2, 0x20, 0x23, 0x12, 0x03, 0xb4, 0xf5, 0x01, 0xac,
+	0x05, 0x79, 0x2d, 0x5f, 0x72, 0x98, 0x2b, 0x5e, 0xcd, 0x27, 0xbf, 0xd1, 0x90, 0x43, 0xae, 0xc2,
+	0x4c, 0xd7, 0x7e, 0xb8, 0xe6, 0xaf, 0x7a, 0x6e, 0x67, 0x27, 0xe6, 0x83, 0x1d, 0xc9, 0x9b, 0xdb,
+	0x2a, 0x7b, 0x3d, 0xb3, 0x91, 0x25, 0xc0, 0xc1, 0x36, 0x6c, 0x1a, 0xe9, 0x3c, 0x3b, 0x3b, 0xa1,
+	0x98, 0x4c, 0x4f, 0xa3, 0x4d, 0x03, 0x87, 0x29, 0x4a, 0x16, 0xdb, 0x77, 0xed, 0x87, 0x2c, 0xcd,
+	0xb6, 0x47, 0x35, 0x59, 0xc4, 0x8f, 0x07, 0x2a, 0x49, 0x6c, 0xbf, 0x31, 0x48, 0x82, 0xc3, 0xda,
+	0x0d, 0x7b, 0x1c, 0x77, 0x66, 0x84, 0xc7, 0x71, 0x01, 0x4c, 0x9a, 0xa6, 0x9c, 0xbc, 0xa7, 0xb7,
+	0x08, 0x61, 0xa1, 0x3f, 0x35, 0xfa, 0x81, 0xc8, 0xe3, 0xf7, 0x84, 0xdf, 0x2b, 0xc1, 0x64, 0xd3,
+	0xb3, 0x1d, 0x9d, 0x5f, 0x4d, 0xef, 0xf4, 0x85, 0x53, 0xc8, 0x25, 0x43, 0xc4, 0xfb, 0xc3, 0x53,
+	0xac, 0xc5, 0x91, 0xeb, 0x33, 0x34, 0x75, 0x63, 0x34, 0x18, 0xb1, 0xb8, 0xc6, 0xd9, 0xb1, 0x7d,
+	0x9f, 0x7a, 0xd9, 0xc2, 0x22, 0x4b, 0x02, 0x8c, 0x0a, 0xcf, 0x48, 0x65, 0xd1, 0xb6, 0xec, 0xbd,
+	0x2f, 0x59, 0xe3, 0x0d, 0x15, 0x9e, 0x1f, 0xcf, 0x7b, 0x81, 0x3a, 0x8b, 0x34, 0x8f, 0xe7, 0x39,
+	0x14, 0x25, 0x96, 0x3f, 0xb5, 0xdf, 0x09, 0xa9, 0xdd, 0x6e, 0x45, 0xf2, 0xde, 0x64, 0x62, 0xcd,
+	0x05, 0xbc, 0x89, 0x9a, 0xa2, 0xfe, 0x5f, 0x25, 0x20, 0xcd, 0xd8, 0xf6, 0xdb, 0x76, 0xd8, 0xbe,
+	0x71, 0xa5, 0x79, 0x5a, 0x35, 0xd2, 0x6e, 0x0e, 0xd6, 0x48, 0x7b, 0x7d, 0x58, 0x8d, 0xb4, 0x0f,
+	0xdd, 0xe8, 0x6f, 0xd1, 0xd0, 0xa7, 0xec, 0x2e, 0xb7, 0x3c, 0xcb, 0xff, 0x3f, 0x59, 0x29, 0x6d,
+	0x1b, 0xa6, 0x7a, 0xec, 0xa1, 0x88, 0xbe, 0xf5, 0x23, 0x46, 0xf7, 0xf3, 0xb2, 0xd9, 0xd4, 0xa6,
+	0x89, 0x7c, 0x74, 0x30, 0xf7, 0xff, 0x8f, 0x2a, 0x15, 0xca, 0x5e, 0x70, 0x47, 0xf3, 0x9c, 0x9c,
+	0xbf, 0xee, 0x4e, 0xb3, 0x65, 0xf9, 0x7c, 0x66, 0x5d, 0x85, 0x6b, 0x29, 0xa3, 0x68, 0xdd, 0xb7,
+	0x75, 0x8d, 0x41, 0x83, 0xaa, 0xbe, 0x05, 0x93, 0x62, 0x61, 0xca, 0x2b, 0x16, 0x73, 0x50, 0xb1,
+	0x59, 0x32, 0x92, 0x2f, 0xc0, 0x8a, 0xb8, 0x12, 0xcc, 0xb3, 0x93, 0x28, 0xe0, 0xe4, 0x0d, 0x98,
+	0xe0, 0x7f, 0xa0, 0xed, 0x77, 0xa8, 0xba, 0xd5, 0xc9, 0x37, 0xa3, 0x46, 0x02, 0x46, 0x93, 0xa6,
+	0xfe, 0x8d, 0x2a, 0xe8, 0xdd, 0x9c, 0x55, 0x02, 0xcb, 0x38, 0x7f, 0xa3, 0x57, 0x02, 0xdb, 0x90,
+	0x0c, 0xc4, 0xc6, 0xab, 0x7e, 0x19, 0x3e, 0xa0, 0x2c, 0x8a, 0x92, 0xbc, 0x13, 0x30, 0xde, 0x8b,
+	0xa7, 0x8a, 0xa2, 0xa4, 0x29, 0x70, 0x48, 0x2b, 0x72, 0x9d, 0xd7, 0x5c, 0x8b, 0x6d, 0x36, 0x0c,
+	0xd2, 0xc7, 0x79, 0xe5, 0x88, 0x9a, 0x6b, 0x82, 0x48, 0x17, 0x5a, 0x13, 0x3f, 0x31, 0x69, 0x4e,
+	0x56, 0x60, 0x7c, 0x2f, 0xf0, 0xfa, 0x5d, 0xaa, 0x92, 0x2b, 0xb3, 0xc3, 0x38, 0xbd, 0xc3, 0x49,
+	0x8c, 0xd3, 0x23, 0xd1, 0x04, 0x55, 0x5b, 0x42, 0x99, 0xad, 0x77, 0xfa, 0xa1, 0x1b, 0xef, 0xcb,
+	0x87, 0xbf, 0x32, 0xd1, 0xfd, 0xd1, 0x61, 0xec, 0x36, 0x83, 0x76, 0x33, 0x4d, 0xad, 0xf6, 0x84,
+	0x14, 0x10, 0xb3, 0x3c, 0xc9, 0x37, 0x0b, 0x30, 0xe9, 0x07, 0x6d, 0xaa, 0xec, 0x9c, 0x3c, 0xf1,
+	0x69, 0xe5, 0xf7, 0xf0, 0xe6, 0x6f, 0x1a, 0x6c, 0x45, 0x4e, 0x4a, 0x6f, 0x99, 0x26, 0x0a, 0x53,
+	0xf2, 0xc9, 0x1d, 0x98, 0x88, 0x03, 0x4f, 0x2e, 0x6b, 0x95, 0x90, 0xb9, 0x34, 0xec, 0x9b, 0x5b,
+	0x9a, 0x2c, 0x09, 0xf7, 0x13, 0x58, 0x84, 0x26, 0x1f, 0xe2, 0xc3, 0x59, 0xb7, 0x6b, 0x77, 0xe8,
+	0x66, 0xdf, 0xf3, 0x84, 0x71, 0x57, 0x91, 0xe6, 0xd0, 0xe2, 0x7a, 0xcc, 0x76, 0x79, 0x72, 0x29,
+	0xd1, 0x6d, 0xca, 0x9c, 0x24, 0xaa, 0xcb, 0xaa, 0x9c, 0x5d, 0xcb, 0x70, 0xc2, 0x01, 0xde, 0xcc,
+	0xf9, 0xe8, 0x85, 0x6e, 0xc0, 0x55, 0xed, 0xd9, 0x91, 0xf0, 0x3f, 0x6b, 0xa9, 0xa3, 0xf3, 0x99,
+	0xcd, 0x2c, 0x01, 0x0e, 0xb6, 0x61, 0x9e, 0xa8, 0x02, 0x5a, 0x90, 0x78, 0xa2, 0xaa, 0x2d, 0x6a,
+	0x2c, 0x59, 0x85, 0xaa, 0xbd, 0xbd, 0xed, 0xfa, 0x8c, 0x52, 0xdc, 0x39, 0x7c, 0x79, 0xd8, 0xa7,
+	0x35, 0x24, 0x8d, 0xe0, 0xa3, 0x7e, 0xa1, 0x6e, 0x3b, 0xfb, 0x39, 0x98, 0x19, 0x18, 0xba, 0x91,
+	0xb2, 0x52, 0x4d, 0x80, 0xe4, 0x91, 0x3c, 0x4b, 0x0f, 0x45, 0xb1, 0x1d, 0xaa, 0xb4, 0x94, 0x8e,
+	0xb4, 0x9a, 0x0c, 0x88, 0x02, 0xc7, 0x92, 0xce, 0x51, 0x1c, 0x0c, 0x24, 0x9d, 0x9b, 0x71, 0xd0,
+	0x43, 0x8e, 0xa9, 0xff, 0x7a, 0x0d, 0xc6, 0xd5, 0x66, 0x15, 0x19, 0x11, 0x49, 0x21, 0xef, 0x1d,
+	0x7e, 0xc9, 0xf4, 0x89, 0x81, 0x49, 0x7a, 0x87, 0x29, 0x3e, 0xf7, 0x1d, 0x66, 0x17, 0xc6, 0x7a,
+	0xdc, 0x7e, 0x4b, 0x03, 0x75, 0x35, 0xbf, 0x6c, 0xce, 0x4e, 0x6c, 0xcf, 0xe2, 0x6f, 0x94, 0x22,
+	0x06, 0x2f, 0xb1, 0x96, 0x9f, 0xf9, 0x25, 0xd6, 0x1e, 0xd4, 0x42, 0x95, 0xfd, 0x93, 0xa6, 0x6e,
+	0xe9, 0xe9, 0x3f, 0x51, 0x27, 0x12, 0x85, 0xa5, 0xd6, 0x3f, 0x31, 0x11, 0xc2, 0x34, 0xda, 0x66,
+	0x95, 0x73, 0xa9, 0x35, 0x76, 0x42, 0x1a, 0xe5, 0x85, 0x78, 0x65, 0x15, 0x32, 0xf1, 0x37, 0x4a,
+	0x11, 0xec, 0x6c, 0xe9, 0x8c, 0xe3, 0x86, 0x4e, 0xdf, 0x8d, 0x17, 0x43, 0x6a, 0xef, 0xd2, 0xd0,
+	0x1a, 0xcf, 0xfb, 0x78, 0x55, 0x05, 0x77, 0x29, 0xb6, 0xa2, 0x3e, 0x74, 0x1a, 0x86, 0x19, 0xd1,
+	0x2c, 0x69, 0xea, 0xd8, 0xbe, 0x1d, 0xee, 0xf3, 0xdc, 0xb3, 0x7c, 0x2a, 0x93, 0xbc, 0x4c, 0x4b,
+	0x50, 0x68, 0xd2, 0x31, 0x97, 0xf4, 0x01, 0x65, 0x91, 0x11, 0x37, 0x65, 0x95, 0xc4, 0x25, 0xbd,
+	0xcb, 0xa1, 0x28, 0xb1, 0xfc, 0x4a, 0x5c, 0xe8, 0xc6, 0xae, 0x63, 0x7b, 0x16, 0x64, 0xae, 0xc4,
+	0x49, 0x38, 0x6a, 0x0a, 0xf2, 0xab, 0x00, 0x21, 0x55, 0x51, 0xa3, 0x34, 0x5d, 0x37, 0x72, 0x6b,
+	0x05, 0x35, 0x4b, 0xe1, 0xbb, 0x27, 0xbf, 0xd1, 0x10, 0x47, 0x7e, 0x01, 0x6a, 0x22, 0xbd, 0x12,
+	0xe9, 0xdb, 0xd3, 0x7c, 0xc6, 0x2c, 0x2b, 0x20, 0x26, 0xf8, 0xfa, 0x77, 0x0a, 0x70, 0x7e, 0xa8,
+	0xd2, 0xc9, 0x32, 0x9c, 0xdd, 0xb6, 0x5d, 0xaf, 0x1f, 0x52, 0xe6, 0x73, 0x47, 0x3b, 0x81, 0xd7,
+	0x96, 0xf5, 0x0a, 0xf4, 0xae, 0xb1, 0x9a, 0xc1, 0xe3, 0x40, 0x0b, 0xae, 0x5f, 0xd7, 0x6f, 0x07,
+	0x0f, 0xb2, 0x37, 0x72, 0xef, 0x72, 0x28, 0x4a, 0x2c, 0xd7, 0x6f, 0x10, 0x78, 0xed, 0xe0, 0x81,
+	0x2a, 0x3d, 0x94, 0xe8, 0x57, 0xc2, 0x51, 0x53, 0xd4, 0xff, 0xb9, 0x00, 0x53, 0xa9, 0x09, 0x4a,
+	0x82, 0xc4, 0x9a, 0xe7, 0xaa, 0xad, 0x95, 0x35, 0x62, 0xc2, 0xc9, 0x4f, 0x0e, 0xbf, 0x58, 0x44,
+	0xcc, 0x37, 0x0b, 0x79, 0x5d, 0xbc, 0x78, 0xc4, 0x75, 0x71, 0x51, 0xb9, 0xe1, 0x06, 0xdd, 0x8f,
+	0x64, 0x02, 0xdd, 0xac, 0xdc, 0xc0, 0xc0, 0xa8, 0xf0, 0xf5, 0x3f, 0x2a, 0xc2, 0xd9, 0xac, 0x58,
+	0xb2, 0x0b, 0xa5, 0x28, 0x74, 0x9e, 0xd9, 0xf7, 0xf0, 0xac, 0x7b, 0x33, 0x74, 0x90, 0x49, 0x61,
+	0x7b, 0x55, 0x9b, 0x46, 0x71, 0x76, 0xaf, 0x5a, 0xa6, 0xec, 0x92, 0x10, 0xc3, 0x90, 0x75, 0x33,
+	0xb8, 0x29, 0xa5, 0xb2, 0x28, 0xa9, 0xe0, 0xe6, 0xa5, 0xac, 0xbc, 0xa1, 0xa1, 0x8d, 0x59, 0x4a,
+	0xad, 0xfc, 0xc4, 0x52, 0x6a, 0x7f, 0x5f, 0x82, 0x0b, 0xc3, 0x3f, 0x83, 0x5d, 0x3d, 0xd5, 0x99,
+	0xc4, 0x7d, 0xa3, 0xc4, 0x84, 0xbe, 0x7a, 0xba, 0x9c, 0xc2, 0x62, 0x86, 0x9a, 0xc5, 0x1e, 0xb2,
+	0xf4, 0x8c, 0xfa, 0xc7, 0x02, 0xc6, 0x5d, 0xa2, 0x25, 0x8d, 0x41, 0x83, 0x8a, 0x97, 0xa6, 0x10,
+	0xbf, 0x5a, 0x66, 0x0e, 0xd1, 0x2c, 0x4d, 0x91, 0x46, 0x63, 0x96, 0x9e, 0x4d, 0x0e, 0xe6, 0xf0,
+	0xab, 0x8a, 0xb8, 0x46, 0xc8, 0xbc, 0x2c, 0xc0, 0xa8, 0xf0, 0x2c, 0x53, 0xc3, 0xfe, 0x6c, 0xa5,
+	0x2b, 0xcf, 0x25, 0x59, 0x55, 0x03, 0x87, 0x29, 0xca, 0xa4, 0x24, 0x9e, 0x88, 0xa0, 0x07, 0x4b,
+	0xe2, 0xbd, 0x02, 0x25, 0xea, 0xef, 0x65, 0xdf, 0x1c, 0xae, 0xf8, 0x7b, 0xc8, 0xe0, 0x64, 0x8d,
+	0x57, 0x88, 0x0c, 0x69, 0x3c, 0x5a, 0x61, 0x04, 0x90, 0x45, 0x24, 0x43, 0x76, 0xdd, 0x5e, 0x30,
+	0xa8, 0xff, 0x28, 0x59, 0xae, 0x32, 0x60, 0xdb, 0x86, 0xd2, 0xee, 0x15, 0x95, 0xa5, 0xb9, 0x71,
+	0x82, 0x17, 0xe2, 0xc5, 0xcc, 0xbe, 0x71, 0x25, 0x42, 0x26, 0x80, 0xdc, 0xd3, 0x09, 0xa1, 0xdc,
+	0xe5, 0x8b, 0xcc, 0x80, 0x53, 0x7e, 0x65, 0x3a, 0x37, 0xf4, 0xd3, 0x02, 0xcc, 0x0c, 0x58, 0x6a,
+	0x36, 0xd6, 0xcc, 0x09, 0x75, 0x6d, 0x2f, 0x5b, 0xd6, 0x6d, 0x4d, 0x80, 0x51, 0xe1, 0xd9, 0x80,
+	0x74, 0xed, 0x87, 0x59, 0x93, 0xc2, 0x9e, 0xe7, 0x30, 0x38, 0xe9, 0x00, 0x74, 0xfb, 0x5e, 0xec,
+	0xf6, 0x3c, 0x57, 0xc7, 0x74, 0xa3, 0x27, 0xb8, 0x1a, 0x5d, 0x16, 0x23, 0x8a, 0x0d, 0x64, 0x43,
+	0xb3, 0x43, 0x83, 0x35, 0x5b, 0x9e, 0x76, 0xcc, 0x96, 0x5f, 0x2c, 0x0e, 0xf8, 0x2a, 0xc9, 0xf2,
+	0x6c, 0x48, 0x38, 0x6a, 0x8a, 0xfa, 0x77, 0xce, 0xc1, 0x74, 0xc6, 0xe3, 0x3c, 0xc6, 0x9d, 0x0c,
+	0xb1, 0xf2, 0x64, 0xbd, 0xd3, 0x21, 0x2b, 0x4f, 0x62, 0xd0, 0xa0, 0x22, 0x1d, 0x31, 0x69, 0x4a,
+	0x79, 0xeb, 0x18, 0x0e, 0x26, 0x8b, 0x32, 0xb3, 0x86, 0x1d, 0x8b, 0xd8, 0x46, 0x25, 0x7b, 0xe9,
+	0x2b, 0x6e, 0xe4, 0xc9, 0x20, 0x0d, 0x14, 0xf1, 0x17, 0x2f, 0x8d, 0x4d, 0x04, 0xa6, 0x84, 0x12,
+	0x47, 0xd6, 0x6d, 0xac, 0xe4, 0x4d, 0xc0, 0x1b, 0xaf, 0xd5, 0x06, 0x0a, 0x36, 0x3e, 0x80, 0x9a,
+	0xfd, 0x20, 0x12, 0xff, 0xa7, 0x45, 0x3a, 0x8d, 0x79, 0x12, 0x65, 0x99, 0x7f, 0xf9, 0x22, 0xaf,
+	0x71, 0x2a, 0x28, 0x26, 0xb2, 0x48, 0x08, 0x63, 0x0e, 0xaf, 0xb7, 0x6a, 0x8d, 0xe7, 0x75, 0x55,
+	0x53, 0x75, 0x5b, 0x65, 0x65, 0x16, 0x13, 0x84, 0x52, 0x12, 0xe9, 0x40, 0x65, 0x97, 0x3d, 0x0b,
+	0xb1, 0xaa, 0x79, 0x8d, 0x81, 0xf9, 0xba, 0x44, 0x98, 0x56, 0x0e, 0x41, 0xc1, 0x9f, 0x0d, 0x9d,
+	0x6f, 0xc7, 0x91, 0x55, 0xcb, 0x3b, 0x74, 0xc6, 0xbd, 0x6b, 0x31, 0x74, 0x0c, 0x80, 0x9c, 0x39,
+	0xfb, 0x1a, 0x9e, 0xb1, 0xb5, 0x20, 0xef, 0xd7, 0x98, 0x19, 0x6d, 0xf1, 0x35, 0x1c, 0x82, 0x82,
+	0x3f, 0x9b, 0x23, 0x81, 0xba, 0x57, 0x6c, 0x4d, 0xe4, 0x9d, 0x23, 0xd9, 0x2b, 0xca, 0x62, 0x8e,
+	0x68, 0x28, 0x26, 0xb2, 0xc8, 0x7b, 0x50, 0xf2, 0x82, 0x8e, 0x35, 0x99, 0xf7, 0x78, 0x25, 0x79,
+	0x37, 0x20, 0x16, 0xfa, 0x7a, 0xd0, 0x41, 0xc6, 0x99, 0x87, 0x30, 0x76, 0xaa, 0xf6, 0xbe, 0x35,
+	0x95, 0x37, 0x84, 0x19, 0x5a, 0xcb, 0x5f, 0x84, 0x30, 0x69, 0x14, 0x66, 0x44, 0xf3, 0x78, 0x98,
+	0xdf, 0xaf, 0xb3, 0xce, 0xe4, 0x5d, 0x12, 0xa9, 0x7b, 0x7a, 0x32, 0x1e, 0xe6, 0x20, 0x94, 0x22,
+	0xc8, 0x1f, 0x14, 0x60, 0x3a, 0xb1, 0xad, 0xbc, 0xe2, 0xb4, 0x35, 0x9d, 0xbb, 0x82, 0xf2, 0xf0,
+	0x2a, 0xd9, 0x29, 0xd7, 0xc8, 0x24, 0xc0, 0x6c, 0x17, 0xc8, 0xef, 0x17, 0xe0, 0x6c, 0xc7, 0xe9,
+	0xa5, 0x0a, 0x8f, 0xf0, 0x02, 0x3d, 0xb9, 0xfa, 0x75, 0x44, 0x29, 0x93, 0xc5, 0x17, 0x59, 0x14,
+	0x93, 0x45, 0xe2, 0x40, 0x07, 0xc8, 0x57, 0x61, 0x22, 0x4c, 0xee, 0xe9, 0x58, 0x33, 0x79, 0x77,
+	0xa0, 0xc1, 0x4b, 0x3f, 0x22, 0x19, 0x6d, 0xc0, 0xd1, 0x94, 0xc8, 0xc2, 0xa8, 0x76, 0xb8, 0x8f,
+	0x7d, 0xdf, 0x22, 0xe9, 0x72, 0xdd, 0xcb, 0x1c, 0x8a, 0x12, 0xcb, 0x6e, 0xe8, 0x6b, 0x8d, 0x5a,
+	0xe7, 0xd2, 0x37, 0xf4, 0xb5, 0xee, 0x31, 0xa1, 0x61, 0x73, 0xce, 0x7e, 0x10, 0x35, 0x6f, 0x37,
+	0xad, 0x17, 0xf3, 0xce, 0xb9, 0xd4, 0xbf, 0x5c, 0x12, 0x73, 0x4e, 0x80, 0x50, 0x8a, 0x30, 0x9f,
+	0x85, 0x9f, 0x7f, 0x7c, 0x89, 0x00, 0xf2, 0x6b, 0x00, 0x8e, 0xae, 0x30, 0x6f, 0x5d, 0xc8, 0xab,
+	0xf0, 0xc1, 0x6a, 0xf5, 0xb2, 0x3c, 0xb9, 0x86, 0xa3, 0x21, 0x8f, 0x8d, 0x77, 0x2f, 0xb9, 0x05,
+	0x68, 0x5d, 0xcc, 0x2b, 0x7e, 0xf0, 0x6e, 0xa3, 0x18, 0x6f, 0x03, 0x8e, 0xa6, 0x44, 0x66, 0xf9,
+	0xb6, 0xfa, 0xac, 0x86, 0x4f, 0x4e, 0xcb, 0x97, 0xfc, 0x8b, 0x08, 0x61, 0xf9, 0x58, 0x65, 0x68,
+	0xc6, 0xb9, 0xee, 0xc0, 0x84, 0xf1, 0x0f, 0x5b, 0x8e, 0xf1, 0x32, 0xe0, 0x4d, 0x80, 0x3d, 0x1a,
+	0xba, 0xdb, 0xfb, 0xec, 0x36, 0xb9, 0x2c, 0x1a, 0xaf, 0x1d, 0xb6, 0x77, 0x34, 0x06, 0x0d, 0xaa,
+	0xc5, 0xf9, 0xef, 0xfe, 0xf0, 0xd2, 0x0b, 0xdf, 0xfb, 0xe1, 0xa5, 0x17, 0x7e, 0xf0, 0xc3, 0x4b,
+	0x2f, 0x7c, 0xed, 0xf0, 0x52, 0xe1, 0xbb, 0x87, 0x97, 0x0a, 0xdf, 0x3b, 0xbc, 0x54, 0xf8, 0xc1,
+	0xe1, 0xa5, 0xc2, 0x7f, 0x1c, 0x5e, 0x2a, 0x7c, 0xeb, 0x47, 0x97, 0x5e, 0xf8, 0x42, 0x55, 0xf5,
+	0xf6, 0x7f, 0x07, 0x00, 0x5e, 0xa7, 0xdd, 0xfa, 0xed, 0x6f, 0x00, 0x00,
 }
 
 func (m *AWSLambdaTrigger) Marshal() (dAtA []byte, err error) {
@@ -1647,6 +2314,106 @@ func (m *AWSLambdaTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AWSSQSTrigger) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AWSSQSTrigger) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AWSSQSTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Parameters) > 0 {
+		for iNdEx := len(m.Parameters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Parameters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Payload) > 0 {
+		for iNdEx := len(m.Payload) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Payload[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	i -= len(m.MessageDeduplicationID)
+	copy(dAtA[i:], m.MessageDeduplicationID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.MessageDeduplicationID)))
+	i--
+	dAtA[i] = 0x3a
+	i -= len(m.MessageGroupID)
+	copy(dAtA[i:], m.MessageGroupID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.MessageGroupID)))
+	i--
+	dAtA[i] = 0x32
+	i -= len(m.RoleARN)
+	copy(dAtA[i:], m.RoleARN)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RoleARN)))
+	i--
+	dAtA[i] = 0x2a
+	if m.SecretKey != nil {
+		{
+			size, err := m.SecretKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.AccessKey != nil {
+		{
+			size, err := m.AccessKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	i -= len(m.Region)
+	copy(dAtA[i:], m.Region)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Region)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.QueueURL)
+	copy(dAtA[i:], m.QueueURL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.QueueURL)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ArgoWorkflowTrigger) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1897,7 +2664,7 @@ func (m *AzureEventHubsTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ConditionsResetByTime) Marshal() (dAtA []byte, err error) {
+func (m *BusTrigger) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1907,30 +2674,63 @@ func (m *ConditionsResetByTime) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ConditionsResetByTime) MarshalTo(dAtA []byte) (int, error) {
+func (m *BusTrigger) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ConditionsResetByTime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BusTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.Timezone)
-	copy(dAtA[i:], m.Timezone)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Timezone)))
+	if len(m.Parameters) > 0 {
+		for iNdEx := len(m.Parameters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Parameters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Payload) > 0 {
+		for iNdEx := len(m.Payload) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Payload[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	i -= len(m.EventName)
+	copy(dAtA[i:], m.EventName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.EventName)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.EventSourceName)
+	copy(dAtA[i:], m.EventSourceName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.EventSourceName)))
 	i--
 	dAtA[i] = 0x12
-	i -= len(m.Cron)
-	copy(dAtA[i:], m.Cron)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Cron)))
+	i -= len(m.Subject)
+	copy(dAtA[i:], m.Subject)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Subject)))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *ConditionsResetCriteria) Marshal() (dAtA []byte, err error) {
+func (m *CloudEventEnvelope) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1940,7 +2740,102 @@ func (m *ConditionsResetCriteria) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ConditionsResetCriteria) MarshalTo(dAtA []byte) (int, error) {
+func (m *CloudEventEnvelope) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CloudEventEnvelope) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Extensions) > 0 {
+		keysForExtensions := make([]string, 0, len(m.Extensions))
+		for k := range m.Extensions {
+			keysForExtensions = append(keysForExtensions, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForExtensions)
+		for iNdEx := len(keysForExtensions) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Extensions[string(keysForExtensions[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForExtensions[iNdEx])
+			copy(dAtA[i:], keysForExtensions[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForExtensions[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	i -= len(m.Subject)
+	copy(dAtA[i:], m.Subject)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Subject)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Source)
+	copy(dAtA[i:], m.Source)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Source)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Type)
+	copy(dAtA[i:], m.Type)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ConditionsResetByTime) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConditionsResetByTime) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConditionsResetByTime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Timezone)
+	copy(dAtA[i:], m.Timezone)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Timezone)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Cron)
+	copy(dAtA[i:], m.Cron)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Cron)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ConditionsResetCriteria) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConditionsResetCriteria) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
@@ -2122,6 +3017,65 @@ func (m *DataFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DeadLetter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeadLetter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeadLetter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.JetStream != nil {
+		{
+			size, err := m.JetStream.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.HTTP != nil {
+		{
+			size, err := m.HTTP.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EventBus != nil {
+		{
+			size, err := m.EventBus.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Event) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2164,6 +3118,34 @@ func (m *Event) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventBusDeadLetterSink) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBusDeadLetterSink) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBusDeadLetterSink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Subject)
+	copy(dAtA[i:], m.Subject)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Subject)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *EventContext) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2247,6 +3229,20 @@ func (m *EventDependency) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SensitiveFields) > 0 {
+		for iNdEx := len(m.SensitiveFields) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SensitiveFields[iNdEx])
+			copy(dAtA[i:], m.SensitiveFields[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.SensitiveFields[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	i -= len(m.Schema)
+	copy(dAtA[i:], m.Schema)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Schema)))
+	i--
+	dAtA[i] = 0x3a
 	i -= len(m.FiltersLogicalOperator)
 	copy(dAtA[i:], m.FiltersLogicalOperator)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.FiltersLogicalOperator)))
@@ -2482,7 +3478,7 @@ func (m *FileArtifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *GitArtifact) Marshal() (dAtA []byte, err error) {
+func (m *GCPCloudFunctionBatch) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2492,86 +3488,65 @@ func (m *GitArtifact) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GitArtifact) MarshalTo(dAtA []byte) (int, error) {
+func (m *GCPCloudFunctionBatch) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GitArtifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GCPCloudFunctionBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Remote != nil {
-		{
-			size, err := m.Remote.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x4a
-	}
-	i -= len(m.Ref)
-	copy(dAtA[i:], m.Ref)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Ref)))
-	i--
-	dAtA[i] = 0x42
-	i -= len(m.Tag)
-	copy(dAtA[i:], m.Tag)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Tag)))
-	i--
-	dAtA[i] = 0x3a
-	i -= len(m.Branch)
-	copy(dAtA[i:], m.Branch)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Branch)))
+	i -= len(m.MaxWait)
+	copy(dAtA[i:], m.MaxWait)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.MaxWait)))
 	i--
-	dAtA[i] = 0x32
-	i -= len(m.FilePath)
-	copy(dAtA[i:], m.FilePath)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.FilePath)))
+	dAtA[i] = 0x12
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MaxSize))
 	i--
-	dAtA[i] = 0x2a
-	if m.SSHKeySecret != nil {
-		{
-			size, err := m.SSHKeySecret.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *GCPCloudFunctionResponseLogging) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.Creds != nil {
-		{
-			size, err := m.Creds.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
+	return dAtA[:n], nil
+}
+
+func (m *GCPCloudFunctionResponseLogging) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GCPCloudFunctionResponseLogging) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Redact) > 0 {
+		for iNdEx := len(m.Redact) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Redact[iNdEx])
+			copy(dAtA[i:], m.Redact[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Redact[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
-		i--
-		dAtA[i] = 0x1a
 	}
-	i -= len(m.CloneDirectory)
-	copy(dAtA[i:], m.CloneDirectory)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CloneDirectory)))
-	i--
-	dAtA[i] = 0x12
-	i -= len(m.URL)
-	copy(dAtA[i:], m.URL)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i -= len(m.Level)
+	copy(dAtA[i:], m.Level)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Level)))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *GitCreds) Marshal() (dAtA []byte, err error) {
+func (m *GCPCloudFunctionTrigger) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2581,31 +3556,101 @@ func (m *GitCreds) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GitCreds) MarshalTo(dAtA []byte) (int, error) {
+func (m *GCPCloudFunctionTrigger) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GitCreds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GCPCloudFunctionTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Password != nil {
-		{
-			size, err := m.Password.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	i -= len(m.RetryOnResponse)
+	copy(dAtA[i:], m.RetryOnResponse)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RetryOnResponse)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xe2
+	i -= len(m.UserAgent)
+	copy(dAtA[i:], m.UserAgent)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.UserAgent)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xda
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MaxResponseSize))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xd0
+	i -= len(m.CredentialsKey)
+	copy(dAtA[i:], m.CredentialsKey)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CredentialsKey)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xca
+	if len(m.Credentials) > 0 {
+		keysForCredentials := make([]string, 0, len(m.Credentials))
+		for k := range m.Credentials {
+			keysForCredentials = append(keysForCredentials, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForCredentials)
+		for iNdEx := len(keysForCredentials) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Credentials[string(keysForCredentials[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForCredentials[iNdEx])
+			copy(dAtA[i:], keysForCredentials[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForCredentials[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
 		}
-		i--
-		dAtA[i] = 0x12
 	}
-	if m.Username != nil {
+	if len(m.ImpersonationDelegates) > 0 {
+		for iNdEx := len(m.ImpersonationDelegates) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ImpersonationDelegates[iNdEx])
+			copy(dAtA[i:], m.ImpersonationDelegates[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.ImpersonationDelegates[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	i -= len(m.ImpersonateServiceAccount)
+	copy(dAtA[i:], m.ImpersonateServiceAccount)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ImpersonateServiceAccount)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xb2
+	i -= len(m.Transform)
+	copy(dAtA[i:], m.Transform)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Transform)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
+	if m.ResponseLogging != nil {
 		{
-			size, err := m.Username.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ResponseLogging.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2613,68 +3658,10 @@ func (m *GitCreds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *GitRemoteConfig) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *GitRemoteConfig) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *GitRemoteConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.URLS) > 0 {
-		for iNdEx := len(m.URLS) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.URLS[iNdEx])
-			copy(dAtA[i:], m.URLS[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(m.URLS[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	i -= len(m.Name)
-	copy(dAtA[i:], m.Name)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *HTTPTrigger) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
 	}
-	return dAtA[:n], nil
-}
-
-func (m *HTTPTrigger) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *HTTPTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
 	if len(m.SecureHeaders) > 0 {
 		for iNdEx := len(m.SecureHeaders) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -2686,7 +3673,9 @@ func (m *HTTPTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x4a
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
 		}
 	}
 	if len(m.Headers) > 0 {
@@ -2710,12 +3699,57 @@ func (m *HTTPTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0xa
 			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
 			i--
-			dAtA[i] = 0x42
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
 		}
 	}
-	if m.BasicAuth != nil {
+	i--
+	if m.Async {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x88
+	i -= len(m.Topic)
+	copy(dAtA[i:], m.Topic)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Topic)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	i -= len(m.Invocation)
+	copy(dAtA[i:], m.Invocation)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Invocation)))
+	i--
+	dAtA[i] = 0x7a
+	i--
+	if m.Envelope {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x70
+	i -= len(m.Location)
+	copy(dAtA[i:], m.Location)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Location)))
+	i--
+	dAtA[i] = 0x6a
+	i -= len(m.URL)
+	copy(dAtA[i:], m.URL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i--
+	dAtA[i] = 0x62
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Generation))
+	i--
+	dAtA[i] = 0x58
+	if m.Batch != nil {
 		{
-			size, err := m.BasicAuth.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Batch.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2723,33 +3757,33 @@ func (m *HTTPTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x3a
+		dAtA[i] = 0x52
 	}
-	i = encodeVarintGenerated(dAtA, i, uint64(m.Timeout))
+	i -= len(m.ProxyURL)
+	copy(dAtA[i:], m.ProxyURL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ProxyURL)))
 	i--
-	dAtA[i] = 0x30
-	if len(m.Parameters) > 0 {
-		for iNdEx := len(m.Parameters) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Parameters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
+	dAtA[i] = 0x4a
+	if m.CACertificate != nil {
+		{
+			size, err := m.CACertificate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0x2a
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x42
 	}
-	i -= len(m.Method)
-	copy(dAtA[i:], m.Method)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Method)))
+	i -= len(m.Encoding)
+	copy(dAtA[i:], m.Encoding)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Encoding)))
 	i--
-	dAtA[i] = 0x22
-	if m.TLS != nil {
+	dAtA[i] = 0x3a
+	if m.CredentialsSecret != nil {
 		{
-			size, err := m.TLS.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.CredentialsSecret.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2757,7 +3791,33 @@ func (m *HTTPTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x32
+	}
+	if m.RetryStrategy != nil {
+		{
+			size, err := m.RetryStrategy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Parameters) > 0 {
+		for iNdEx := len(m.Parameters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Parameters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
 	if len(m.Payload) > 0 {
 		for iNdEx := len(m.Payload) - 1; iNdEx >= 0; iNdEx-- {
@@ -2770,18 +3830,23 @@ func (m *HTTPTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x1a
 		}
 	}
-	i -= len(m.URL)
-	copy(dAtA[i:], m.URL)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i -= len(m.CredentialsPath)
+	copy(dAtA[i:], m.CredentialsPath)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CredentialsPath)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.FunctionName)
+	copy(dAtA[i:], m.FunctionName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.FunctionName)))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *K8SResourcePolicy) Marshal() (dAtA []byte, err error) {
+func (m *GitArtifact) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2791,27 +3856,51 @@ func (m *K8SResourcePolicy) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *K8SResourcePolicy) MarshalTo(dAtA []byte) (int, error) {
+func (m *GitArtifact) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *K8SResourcePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GitArtifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i--
-	if m.ErrorOnBackoffTimeout {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
+	if m.Remote != nil {
+		{
+			size, err := m.Remote.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
 	}
+	i -= len(m.Ref)
+	copy(dAtA[i:], m.Ref)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Ref)))
 	i--
-	dAtA[i] = 0x18
-	if m.Backoff != nil {
+	dAtA[i] = 0x42
+	i -= len(m.Tag)
+	copy(dAtA[i:], m.Tag)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Tag)))
+	i--
+	dAtA[i] = 0x3a
+	i -= len(m.Branch)
+	copy(dAtA[i:], m.Branch)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Branch)))
+	i--
+	dAtA[i] = 0x32
+	i -= len(m.FilePath)
+	copy(dAtA[i:], m.FilePath)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.FilePath)))
+	i--
+	dAtA[i] = 0x2a
+	if m.SSHKeySecret != nil {
 		{
-			size, err := m.Backoff.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.SSHKeySecret.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2819,36 +3908,34 @@ func (m *K8SResourcePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x22
 	}
-	if len(m.Labels) > 0 {
-		keysForLabels := make([]string, 0, len(m.Labels))
-		for k := range m.Labels {
-			keysForLabels = append(keysForLabels, string(k))
-		}
-		github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
-		for iNdEx := len(keysForLabels) - 1; iNdEx >= 0; iNdEx-- {
-			v := m.Labels[string(keysForLabels[iNdEx])]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(keysForLabels[iNdEx])
-			copy(dAtA[i:], keysForLabels[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForLabels[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
+	if m.Creds != nil {
+		{
+			size, err := m.Creds.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
+	i -= len(m.CloneDirectory)
+	copy(dAtA[i:], m.CloneDirectory)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CloneDirectory)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.URL)
+	copy(dAtA[i:], m.URL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *KafkaTrigger) Marshal() (dAtA []byte, err error) {
+func (m *GitCreds) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2858,19 +3945,19 @@ func (m *KafkaTrigger) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *KafkaTrigger) MarshalTo(dAtA []byte) (int, error) {
+func (m *GitCreds) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *KafkaTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GitCreds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.SASL != nil {
+	if m.Password != nil {
 		{
-			size, err := m.SASL.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Password.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2878,35 +3965,11 @@ func (m *KafkaTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x62
-	}
-	i -= len(m.Version)
-	copy(dAtA[i:], m.Version)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Version)))
-	i--
-	dAtA[i] = 0x5a
-	i -= len(m.PartitioningKey)
-	copy(dAtA[i:], m.PartitioningKey)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PartitioningKey)))
-	i--
-	dAtA[i] = 0x52
-	if len(m.Payload) > 0 {
-		for iNdEx := len(m.Payload) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Payload[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x4a
-		}
+		dAtA[i] = 0x12
 	}
-	if m.TLS != nil {
+	if m.Username != nil {
 		{
-			size, err := m.TLS.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Username.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2914,53 +3977,12 @@ func (m *KafkaTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x42
-	}
-	i = encodeVarintGenerated(dAtA, i, uint64(m.FlushFrequency))
-	i--
-	dAtA[i] = 0x38
-	i--
-	if m.Compress {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
-	}
-	i--
-	dAtA[i] = 0x30
-	i = encodeVarintGenerated(dAtA, i, uint64(m.RequiredAcks))
-	i--
-	dAtA[i] = 0x28
-	if len(m.Parameters) > 0 {
-		for iNdEx := len(m.Parameters) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Parameters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
+		dAtA[i] = 0xa
 	}
-	i = encodeVarintGenerated(dAtA, i, uint64(m.Partition))
-	i--
-	dAtA[i] = 0x18
-	i -= len(m.Topic)
-	copy(dAtA[i:], m.Topic)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Topic)))
-	i--
-	dAtA[i] = 0x12
-	i -= len(m.URL)
-	copy(dAtA[i:], m.URL)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *LogTrigger) Marshal() (dAtA []byte, err error) {
+func (m *GitRemoteConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2970,23 +3992,34 @@ func (m *LogTrigger) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *LogTrigger) MarshalTo(dAtA []byte) (int, error) {
+func (m *GitRemoteConfig) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *LogTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GitRemoteConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i = encodeVarintGenerated(dAtA, i, uint64(m.IntervalSeconds))
+	if len(m.URLS) > 0 {
+		for iNdEx := len(m.URLS) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.URLS[iNdEx])
+			copy(dAtA[i:], m.URLS[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.URLS[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
 	i--
-	dAtA[i] = 0x8
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *NATSTrigger) Marshal() (dAtA []byte, err error) {
+func (m *HTTPDeadLetterSink) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2996,16 +4029,21 @@ func (m *NATSTrigger) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *NATSTrigger) MarshalTo(dAtA []byte) (int, error) {
+func (m *HTTPDeadLetterSink) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *NATSTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *HTTPDeadLetterSink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.Timeout)
+	copy(dAtA[i:], m.Timeout)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Timeout)))
+	i--
+	dAtA[i] = 0x22
 	if m.TLS != nil {
 		{
 			size, err := m.TLS.MarshalToSizedBuffer(dAtA[:i])
@@ -3016,41 +4054,32 @@ func (m *NATSTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x1a
 	}
-	if len(m.Parameters) > 0 {
-		for iNdEx := len(m.Parameters) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Parameters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
+	if len(m.Headers) > 0 {
+		keysForHeaders := make([]string, 0, len(m.Headers))
+		for k := range m.Headers {
+			keysForHeaders = append(keysForHeaders, string(k))
 		}
-	}
-	if len(m.Payload) > 0 {
-		for iNdEx := len(m.Payload) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Payload[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForHeaders)
+		for iNdEx := len(keysForHeaders) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Headers[string(keysForHeaders[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x12
+			i -= len(keysForHeaders[iNdEx])
+			copy(dAtA[i:], keysForHeaders[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForHeaders[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	i -= len(m.Subject)
-	copy(dAtA[i:], m.Subject)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Subject)))
-	i--
-	dAtA[i] = 0x12
 	i -= len(m.URL)
 	copy(dAtA[i:], m.URL)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
@@ -3059,7 +4088,7 @@ func (m *NATSTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *OpenWhiskTrigger) Marshal() (dAtA []byte, err error) {
+func (m *HTTPTrigger) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3069,34 +4098,44 @@ func (m *OpenWhiskTrigger) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OpenWhiskTrigger) MarshalTo(dAtA []byte) (int, error) {
+func (m *HTTPTrigger) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OpenWhiskTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *HTTPTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Parameters) > 0 {
-		for iNdEx := len(m.Parameters) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Parameters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
+	if len(m.RetryOn) > 0 {
+		for iNdEx := len(m.RetryOn) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RetryOn[iNdEx])
+			copy(dAtA[i:], m.RetryOn[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.RetryOn[iNdEx])))
 			i--
-			dAtA[i] = 0x3a
+			dAtA[i] = 0x62
 		}
 	}
-	if len(m.Payload) > 0 {
-		for iNdEx := len(m.Payload) - 1; iNdEx >= 0; iNdEx-- {
+	if m.RetryStrategy != nil {
+		{
+			size, err := m.RetryStrategy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MaxResponseSize))
+	i--
+	dAtA[i] = 0x50
+	if len(m.SecureHeaders) > 0 {
+		for iNdEx := len(m.SecureHeaders) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Payload[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.SecureHeaders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3104,112 +4143,36 @@ func (m *OpenWhiskTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x32
+			dAtA[i] = 0x4a
 		}
 	}
-	i -= len(m.ActionName)
-	copy(dAtA[i:], m.ActionName)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ActionName)))
-	i--
-	dAtA[i] = 0x2a
-	if m.AuthToken != nil {
-		{
-			size, err := m.AuthToken.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
+	if len(m.Headers) > 0 {
+		keysForHeaders := make([]string, 0, len(m.Headers))
+		for k := range m.Headers {
+			keysForHeaders = append(keysForHeaders, string(k))
 		}
-		i--
-		dAtA[i] = 0x22
-	}
-	i -= len(m.Namespace)
-	copy(dAtA[i:], m.Namespace)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
-	i--
-	dAtA[i] = 0x1a
-	i -= len(m.Version)
-	copy(dAtA[i:], m.Version)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Version)))
-	i--
-	dAtA[i] = 0x12
-	i -= len(m.Host)
-	copy(dAtA[i:], m.Host)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Host)))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *PayloadField) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *PayloadField) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *PayloadField) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	i -= len(m.Name)
-	copy(dAtA[i:], m.Name)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
-	i--
-	dAtA[i] = 0x12
-	i -= len(m.Path)
-	copy(dAtA[i:], m.Path)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Path)))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *PulsarTrigger) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *PulsarTrigger) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *PulsarTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ConnectionBackoff != nil {
-		{
-			size, err := m.ConnectionBackoff.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		github_com_gogo_protobuf_sortkeys.Strings(keysForHeaders)
+		for iNdEx := len(keysForHeaders) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Headers[string(keysForHeaders[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForHeaders[iNdEx])
+			copy(dAtA[i:], keysForHeaders[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForHeaders[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x42
 		}
-		i--
-		dAtA[i] = 0x52
 	}
-	if m.AuthTokenSecret != nil {
+	if m.BasicAuth != nil {
 		{
-			size, err := m.AuthTokenSecret.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.BasicAuth.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3217,39 +4180,33 @@ func (m *PulsarTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x4a
+		dAtA[i] = 0x3a
 	}
-	if m.TLS != nil {
-		{
-			size, err := m.TLS.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Timeout))
+	i--
+	dAtA[i] = 0x30
+	if len(m.Parameters) > 0 {
+		for iNdEx := len(m.Parameters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Parameters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x2a
 		}
-		i--
-		dAtA[i] = 0x42
-	}
-	i--
-	if m.TLSValidateHostname {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
-	}
-	i--
-	dAtA[i] = 0x38
-	i--
-	if m.TLSAllowInsecureConnection {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
 	}
+	i -= len(m.Method)
+	copy(dAtA[i:], m.Method)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Method)))
 	i--
-	dAtA[i] = 0x30
-	if m.TLSTrustCertsSecret != nil {
+	dAtA[i] = 0x22
+	if m.TLS != nil {
 		{
-			size, err := m.TLSTrustCertsSecret.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.TLS.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3257,7 +4214,7 @@ func (m *PulsarTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x1a
 	}
 	if len(m.Payload) > 0 {
 		for iNdEx := len(m.Payload) - 1; iNdEx >= 0; iNdEx-- {
@@ -3270,28 +4227,9 @@ func (m *PulsarTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.Parameters) > 0 {
-		for iNdEx := len(m.Parameters) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Parameters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x12
 		}
 	}
-	i -= len(m.Topic)
-	copy(dAtA[i:], m.Topic)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Topic)))
-	i--
-	dAtA[i] = 0x12
 	i -= len(m.URL)
 	copy(dAtA[i:], m.URL)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
@@ -3300,7 +4238,7 @@ func (m *PulsarTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *RateLimit) Marshal() (dAtA []byte, err error) {
+func (m *Idempotency) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3310,28 +4248,37 @@ func (m *RateLimit) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RateLimit) MarshalTo(dAtA []byte) (int, error) {
+func (m *Idempotency) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Idempotency) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i = encodeVarintGenerated(dAtA, i, uint64(m.RequestsPerUnit))
-	i--
-	dAtA[i] = 0x10
-	i -= len(m.Unit)
-	copy(dAtA[i:], m.Unit)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Unit)))
+	if m.JetStream != nil {
+		{
+			size, err := m.JetStream.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i -= len(m.TTL)
+	copy(dAtA[i:], m.TTL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TTL)))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *Sensor) Marshal() (dAtA []byte, err error) {
+func (m *JetStreamDeadLetterSink) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3341,50 +4288,47 @@ func (m *Sensor) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Sensor) MarshalTo(dAtA []byte) (int, error) {
+func (m *JetStreamDeadLetterSink) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Sensor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JetStreamDeadLetterSink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.TLS != nil {
+		{
+			size, err := m.TLS.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x22
 	}
+	i -= len(m.Stream)
+	copy(dAtA[i:], m.Stream)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Stream)))
 	i--
 	dAtA[i] = 0x1a
-	{
-		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
+	i -= len(m.Subject)
+	copy(dAtA[i:], m.Subject)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Subject)))
 	i--
 	dAtA[i] = 0x12
-	{
-		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
+	i -= len(m.URL)
+	copy(dAtA[i:], m.URL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *SensorList) Marshal() (dAtA []byte, err error) {
+func (m *JetStreamIdempotencyStore) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3394,44 +4338,42 @@ func (m *SensorList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SensorList) MarshalTo(dAtA []byte) (int, error) {
+func (m *JetStreamIdempotencyStore) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SensorList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JetStreamIdempotencyStore) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Items) > 0 {
-		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
+	if m.TLS != nil {
+		{
+			size, err := m.TLS.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0x12
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	{
-		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
+	i -= len(m.Bucket)
+	copy(dAtA[i:], m.Bucket)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Bucket)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.URL)
+	copy(dAtA[i:], m.URL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *SensorSpec) Marshal() (dAtA []byte, err error) {
+func (m *K8SResourcePolicy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3441,37 +4383,27 @@ func (m *SensorSpec) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SensorSpec) MarshalTo(dAtA []byte) (int, error) {
+func (m *K8SResourcePolicy) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SensorSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *K8SResourcePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Replicas != nil {
-		i = encodeVarintGenerated(dAtA, i, uint64(*m.Replicas))
-		i--
-		dAtA[i] = 0x30
-	}
-	i -= len(m.EventBusName)
-	copy(dAtA[i:], m.EventBusName)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.EventBusName)))
-	i--
-	dAtA[i] = 0x2a
 	i--
-	if m.ErrorOnFailedRound {
+	if m.ErrorOnBackoffTimeout {
 		dAtA[i] = 1
 	} else {
 		dAtA[i] = 0
 	}
 	i--
-	dAtA[i] = 0x20
-	if m.Template != nil {
+	dAtA[i] = 0x18
+	if m.Backoff != nil {
 		{
-			size, err := m.Template.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Backoff.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3479,32 +4411,28 @@ func (m *SensorSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x12
 	}
-	if len(m.Triggers) > 0 {
-		for iNdEx := len(m.Triggers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Triggers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
+	if len(m.Labels) > 0 {
+		keysForLabels := make([]string, 0, len(m.Labels))
+		for k := range m.Labels {
+			keysForLabels = append(keysForLabels, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+		for iNdEx := len(keysForLabels) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Labels[string(keysForLabels[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
 			i--
 			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Dependencies) > 0 {
-		for iNdEx := len(m.Dependencies) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Dependencies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
+			i -= len(keysForLabels[iNdEx])
+			copy(dAtA[i:], keysForLabels[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForLabels[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -3512,7 +4440,7 @@ func (m *SensorSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *SensorStatus) Marshal() (dAtA []byte, err error) {
+func (m *KafkaTrigger) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3522,62 +4450,68 @@ func (m *SensorStatus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SensorStatus) MarshalTo(dAtA []byte) (int, error) {
+func (m *KafkaTrigger) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SensorStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *KafkaTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	i -= len(m.PartitioningKeyTemplate)
+	copy(dAtA[i:], m.PartitioningKeyTemplate)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PartitioningKeyTemplate)))
+	i--
+	dAtA[i] = 0x72
+	i--
+	if m.Idempotent {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
 	}
 	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *SlackTrigger) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	dAtA[i] = 0x68
+	if m.SASL != nil {
+		{
+			size, err := m.SASL.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
 	}
-	return dAtA[:n], nil
-}
-
-func (m *SlackTrigger) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *SlackTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	i -= len(m.Message)
-	copy(dAtA[i:], m.Message)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i -= len(m.Version)
+	copy(dAtA[i:], m.Version)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Version)))
 	i--
-	dAtA[i] = 0x22
-	i -= len(m.Channel)
-	copy(dAtA[i:], m.Channel)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Channel)))
+	dAtA[i] = 0x5a
+	i -= len(m.PartitioningKey)
+	copy(dAtA[i:], m.PartitioningKey)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PartitioningKey)))
 	i--
-	dAtA[i] = 0x1a
-	if m.SlackToken != nil {
+	dAtA[i] = 0x52
+	if len(m.Payload) > 0 {
+		for iNdEx := len(m.Payload) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Payload[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.TLS != nil {
 		{
-			size, err := m.SlackToken.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.TLS.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3585,8 +4519,22 @@ func (m *SlackTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x42
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.FlushFrequency))
+	i--
+	dAtA[i] = 0x38
+	i--
+	if m.Compress {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
 	}
+	i--
+	dAtA[i] = 0x30
+	i = encodeVarintGenerated(dAtA, i, uint64(m.RequiredAcks))
+	i--
+	dAtA[i] = 0x28
 	if len(m.Parameters) > 0 {
 		for iNdEx := len(m.Parameters) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -3598,13 +4546,26 @@ func (m *SlackTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x22
 		}
 	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Partition))
+	i--
+	dAtA[i] = 0x18
+	i -= len(m.Topic)
+	copy(dAtA[i:], m.Topic)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Topic)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.URL)
+	copy(dAtA[i:], m.URL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *StandardK8STrigger) Marshal() (dAtA []byte, err error) {
+func (m *LogTrigger) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3614,29 +4575,16 @@ func (m *StandardK8STrigger) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *StandardK8STrigger) MarshalTo(dAtA []byte) (int, error) {
+func (m *LogTrigger) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *StandardK8STrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *LogTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i--
-	if m.LiveObject {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
-	}
-	i--
-	dAtA[i] = 0x28
-	i -= len(m.PatchStrategy)
-	copy(dAtA[i:], m.PatchStrategy)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PatchStrategy)))
-	i--
-	dAtA[i] = 0x22
 	if len(m.Parameters) > 0 {
 		for iNdEx := len(m.Parameters) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -3648,30 +4596,35 @@ func (m *StandardK8STrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x22
 		}
 	}
-	i -= len(m.Operation)
-	copy(dAtA[i:], m.Operation)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Operation)))
-	i--
-	dAtA[i] = 0x12
-	if m.Source != nil {
-		{
-			size, err := m.Source.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Payload) > 0 {
+		for iNdEx := len(m.Payload) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Payload[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x1a
 		}
-		i--
-		dAtA[i] = 0xa
 	}
+	i -= len(m.Level)
+	copy(dAtA[i:], m.Level)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Level)))
+	i--
+	dAtA[i] = 0x12
+	i = encodeVarintGenerated(dAtA, i, uint64(m.IntervalSeconds))
+	i--
+	dAtA[i] = 0x8
 	return len(dAtA) - i, nil
 }
 
-func (m *StatusPolicy) Marshal() (dAtA []byte, err error) {
+func (m *NATSTrigger) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3681,49 +4634,19 @@ func (m *StatusPolicy) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *StatusPolicy) MarshalTo(dAtA []byte) (int, error) {
+func (m *NATSTrigger) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *StatusPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *NATSTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Allow) > 0 {
-		for iNdEx := len(m.Allow) - 1; iNdEx >= 0; iNdEx-- {
-			i = encodeVarintGenerated(dAtA, i, uint64(m.Allow[iNdEx]))
-			i--
-			dAtA[i] = 0x8
-		}
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Template) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *Template) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Template) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Affinity != nil {
+	if m.TLS != nil {
 		{
-			size, err := m.Affinity.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.TLS.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3731,22 +4654,12 @@ func (m *Template) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x5a
-	}
-	if m.Priority != nil {
-		i = encodeVarintGenerated(dAtA, i, uint64(*m.Priority))
-		i--
-		dAtA[i] = 0x50
+		dAtA[i] = 0x2a
 	}
-	i -= len(m.PriorityClassName)
-	copy(dAtA[i:], m.PriorityClassName)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PriorityClassName)))
-	i--
-	dAtA[i] = 0x4a
-	if len(m.ImagePullSecrets) > 0 {
-		for iNdEx := len(m.ImagePullSecrets) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Parameters) > 0 {
+		for iNdEx := len(m.Parameters) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.ImagePullSecrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Parameters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3754,13 +4667,13 @@ func (m *Template) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x42
+			dAtA[i] = 0x22
 		}
 	}
-	if len(m.Tolerations) > 0 {
-		for iNdEx := len(m.Tolerations) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Payload) > 0 {
+		for iNdEx := len(m.Payload) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Tolerations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Payload[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3768,49 +4681,60 @@ func (m *Template) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x3a
+			dAtA[i] = 0x1a
 		}
 	}
-	if len(m.NodeSelector) > 0 {
-		keysForNodeSelector := make([]string, 0, len(m.NodeSelector))
-		for k := range m.NodeSelector {
-			keysForNodeSelector = append(keysForNodeSelector, string(k))
-		}
-		github_com_gogo_protobuf_sortkeys.Strings(keysForNodeSelector)
-		for iNdEx := len(keysForNodeSelector) - 1; iNdEx >= 0; iNdEx-- {
-			v := m.NodeSelector[string(keysForNodeSelector[iNdEx])]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(keysForNodeSelector[iNdEx])
-			copy(dAtA[i:], keysForNodeSelector[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForNodeSelector[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x32
-		}
+	i -= len(m.Subject)
+	copy(dAtA[i:], m.Subject)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Subject)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.URL)
+	copy(dAtA[i:], m.URL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *OpenWhiskTrigger) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.SecurityContext != nil {
-		{
-			size, err := m.SecurityContext.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	return dAtA[:n], nil
+}
+
+func (m *OpenWhiskTrigger) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OpenWhiskTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Parameters) > 0 {
+		for iNdEx := len(m.Parameters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Parameters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x3a
 		}
-		i--
-		dAtA[i] = 0x2a
 	}
-	if len(m.Volumes) > 0 {
-		for iNdEx := len(m.Volumes) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Payload) > 0 {
+		for iNdEx := len(m.Payload) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Volumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Payload[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3818,12 +4742,17 @@ func (m *Template) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x32
 		}
 	}
-	if m.Container != nil {
+	i -= len(m.ActionName)
+	copy(dAtA[i:], m.ActionName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ActionName)))
+	i--
+	dAtA[i] = 0x2a
+	if m.AuthToken != nil {
 		{
-			size, err := m.Container.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.AuthToken.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3831,29 +4760,27 @@ func (m *Template) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x22
 	}
-	i -= len(m.ServiceAccountName)
-	copy(dAtA[i:], m.ServiceAccountName)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ServiceAccountName)))
+	i -= len(m.Namespace)
+	copy(dAtA[i:], m.Namespace)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Version)
+	copy(dAtA[i:], m.Version)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Version)))
 	i--
 	dAtA[i] = 0x12
-	if m.Metadata != nil {
-		{
-			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
+	i -= len(m.Host)
+	copy(dAtA[i:], m.Host)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Host)))
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *TimeFilter) Marshal() (dAtA []byte, err error) {
+func (m *PayloadField) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3863,30 +4790,30 @@ func (m *TimeFilter) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TimeFilter) MarshalTo(dAtA []byte) (int, error) {
+func (m *PayloadField) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TimeFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PayloadField) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.Stop)
-	copy(dAtA[i:], m.Stop)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Stop)))
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
 	i--
 	dAtA[i] = 0x12
-	i -= len(m.Start)
-	copy(dAtA[i:], m.Start)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Start)))
+	i -= len(m.Path)
+	copy(dAtA[i:], m.Path)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Path)))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *Trigger) Marshal() (dAtA []byte, err error) {
+func (m *PulsarTrigger) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3896,31 +4823,43 @@ func (m *Trigger) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Trigger) MarshalTo(dAtA []byte) (int, error) {
+func (m *PulsarTrigger) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Trigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PulsarTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.RateLimit != nil {
-		{
-			size, err := m.RateLimit.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
+	if len(m.Properties) > 0 {
+		keysForProperties := make([]string, 0, len(m.Properties))
+		for k := range m.Properties {
+			keysForProperties = append(keysForProperties, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForProperties)
+		for iNdEx := len(keysForProperties) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Properties[string(keysForProperties[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForProperties[iNdEx])
+			copy(dAtA[i:], keysForProperties[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForProperties[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x5a
 		}
-		i--
-		dAtA[i] = 0x2a
 	}
-	if m.RetryStrategy != nil {
+	if m.ConnectionBackoff != nil {
 		{
-			size, err := m.RetryStrategy.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ConnectionBackoff.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3928,11 +4867,11 @@ func (m *Trigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x52
 	}
-	if m.Policy != nil {
+	if m.AuthTokenSecret != nil {
 		{
-			size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.AuthTokenSecret.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3940,25 +4879,11 @@ func (m *Trigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Parameters) > 0 {
-		for iNdEx := len(m.Parameters) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Parameters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+		dAtA[i] = 0x4a
 	}
-	if m.Template != nil {
+	if m.TLS != nil {
 		{
-			size, err := m.Template.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.TLS.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3966,44 +4891,27 @@ func (m *Trigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x42
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *TriggerParameter) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	i--
+	if m.TLSValidateHostname {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TriggerParameter) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *TriggerParameter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	i -= len(m.Operation)
-	copy(dAtA[i:], m.Operation)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Operation)))
 	i--
-	dAtA[i] = 0x1a
-	i -= len(m.Dest)
-	copy(dAtA[i:], m.Dest)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Dest)))
+	dAtA[i] = 0x38
 	i--
-	dAtA[i] = 0x12
-	if m.Src != nil {
+	if m.TLSAllowInsecureConnection {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x30
+	if m.TLSTrustCertsSecret != nil {
 		{
-			size, err := m.Src.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.TLSTrustCertsSecret.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4011,12 +4919,50 @@ func (m *TriggerParameter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x2a
+	}
+	if len(m.Payload) > 0 {
+		for iNdEx := len(m.Payload) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Payload[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Parameters) > 0 {
+		for iNdEx := len(m.Parameters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Parameters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
+	i -= len(m.Topic)
+	copy(dAtA[i:], m.Topic)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Topic)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.URL)
+	copy(dAtA[i:], m.URL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *TriggerParameterSource) Marshal() (dAtA []byte, err error) {
+func (m *PushgatewayMetric) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4026,52 +4972,59 @@ func (m *TriggerParameterSource) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TriggerParameterSource) MarshalTo(dAtA []byte) (int, error) {
+func (m *PushgatewayMetric) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TriggerParameterSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PushgatewayMetric) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Value != nil {
-		i -= len(*m.Value)
-		copy(dAtA[i:], *m.Value)
-		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.Value)))
-		i--
-		dAtA[i] = 0x32
-	}
-	i -= len(m.DataTemplate)
-	copy(dAtA[i:], m.DataTemplate)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DataTemplate)))
-	i--
-	dAtA[i] = 0x2a
-	i -= len(m.DataKey)
-	copy(dAtA[i:], m.DataKey)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DataKey)))
+	i -= len(m.Value)
+	copy(dAtA[i:], m.Value)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
 	i--
 	dAtA[i] = 0x22
-	i -= len(m.ContextTemplate)
-	copy(dAtA[i:], m.ContextTemplate)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ContextTemplate)))
-	i--
-	dAtA[i] = 0x1a
-	i -= len(m.ContextKey)
-	copy(dAtA[i:], m.ContextKey)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ContextKey)))
+	if len(m.Labels) > 0 {
+		keysForLabels := make([]string, 0, len(m.Labels))
+		for k := range m.Labels {
+			keysForLabels = append(keysForLabels, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+		for iNdEx := len(keysForLabels) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Labels[string(keysForLabels[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForLabels[iNdEx])
+			copy(dAtA[i:], keysForLabels[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForLabels[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	i -= len(m.Help)
+	copy(dAtA[i:], m.Help)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Help)))
 	i--
 	dAtA[i] = 0x12
-	i -= len(m.DependencyName)
-	copy(dAtA[i:], m.DependencyName)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DependencyName)))
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *TriggerPolicy) Marshal() (dAtA []byte, err error) {
+func (m *PushgatewayTrigger) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4081,31 +5034,33 @@ func (m *TriggerPolicy) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TriggerPolicy) MarshalTo(dAtA []byte) (int, error) {
+func (m *PushgatewayTrigger) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TriggerPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PushgatewayTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Status != nil {
-		{
-			size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Parameters) > 0 {
+		for iNdEx := len(m.Parameters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Parameters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x3a
 		}
-		i--
-		dAtA[i] = 0x12
 	}
-	if m.K8s != nil {
+	if m.BasicAuth != nil {
 		{
-			size, err := m.K8s.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.BasicAuth.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4113,12 +5068,68 @@ func (m *TriggerPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x32
+	}
+	i--
+	if m.Replace {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x28
+	if len(m.Metrics) > 0 {
+		for iNdEx := len(m.Metrics) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Metrics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Grouping) > 0 {
+		keysForGrouping := make([]string, 0, len(m.Grouping))
+		for k := range m.Grouping {
+			keysForGrouping = append(keysForGrouping, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForGrouping)
+		for iNdEx := len(keysForGrouping) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Grouping[string(keysForGrouping[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForGrouping[iNdEx])
+			copy(dAtA[i:], keysForGrouping[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForGrouping[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
+	i -= len(m.Job)
+	copy(dAtA[i:], m.Job)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Job)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.URL)
+	copy(dAtA[i:], m.URL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *TriggerTemplate) Marshal() (dAtA []byte, err error) {
+func (m *RateLimit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4128,20 +5139,59 @@ func (m *TriggerTemplate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TriggerTemplate) MarshalTo(dAtA []byte) (int, error) {
+func (m *RateLimit) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TriggerTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ConditionsReset) > 0 {
-		for iNdEx := len(m.ConditionsReset) - 1; iNdEx >= 0; iNdEx-- {
+	i -= len(m.Overflow)
+	copy(dAtA[i:], m.Overflow)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Overflow)))
+	i--
+	dAtA[i] = 0x22
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Burst))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintGenerated(dAtA, i, uint64(m.RequestsPerUnit))
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.Unit)
+	copy(dAtA[i:], m.Unit)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Unit)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RedisStreamTrigger) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RedisStreamTrigger) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedisStreamTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Parameters) > 0 {
+		for iNdEx := len(m.Parameters) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.ConditionsReset[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Parameters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -4149,24 +5199,34 @@ func (m *TriggerTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x7a
+			dAtA[i] = 0x42
 		}
 	}
-	if m.Pulsar != nil {
-		{
-			size, err := m.Pulsar.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Payload) > 0 {
+		for iNdEx := len(m.Payload) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Payload[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x3a
 		}
-		i--
-		dAtA[i] = 0x72
 	}
-	if m.AzureEventHubs != nil {
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MaxLen))
+	i--
+	dAtA[i] = 0x30
+	i -= len(m.Stream)
+	copy(dAtA[i:], m.Stream)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Stream)))
+	i--
+	dAtA[i] = 0x2a
+	if m.TLS != nil {
 		{
-			size, err := m.AzureEventHubs.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.TLS.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4174,11 +5234,14 @@ func (m *TriggerTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x6a
+		dAtA[i] = 0x22
 	}
-	if m.Log != nil {
+	i = encodeVarintGenerated(dAtA, i, uint64(m.DB))
+	i--
+	dAtA[i] = 0x18
+	if m.Password != nil {
 		{
-			size, err := m.Log.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Password.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4186,83 +5249,159 @@ func (m *TriggerTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x62
+		dAtA[i] = 0x12
 	}
-	if m.OpenWhisk != nil {
-		{
-			size, err := m.OpenWhisk.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
+	i -= len(m.HostAddress)
+	copy(dAtA[i:], m.HostAddress)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.HostAddress)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Sensor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Sensor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Sensor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x5a
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	if m.Slack != nil {
-		{
-			size, err := m.Slack.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x52
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	if m.NATS != nil {
-		{
-			size, err := m.NATS.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x4a
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	if m.Kafka != nil {
-		{
-			size, err := m.Kafka.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SensorList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SensorList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SensorList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x12
 		}
-		i--
-		dAtA[i] = 0x42
 	}
-	if m.CustomTrigger != nil {
-		{
-			size, err := m.CustomTrigger.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x3a
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	if m.AWSLambda != nil {
-		{
-			size, err := m.AWSLambda.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SensorSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SensorSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SensorSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SensitiveFields) > 0 {
+		for iNdEx := len(m.SensitiveFields) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SensitiveFields[iNdEx])
+			copy(dAtA[i:], m.SensitiveFields[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.SensitiveFields[iNdEx])))
+			i--
+			dAtA[i] = 0x72
 		}
-		i--
-		dAtA[i] = 0x32
 	}
-	if m.HTTP != nil {
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MaxActivePartitions))
+	i--
+	dAtA[i] = 0x68
+	i -= len(m.PartitionKey)
+	copy(dAtA[i:], m.PartitionKey)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PartitionKey)))
+	i--
+	dAtA[i] = 0x62
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MaxInFlightEvents))
+	i--
+	dAtA[i] = 0x58
+	if m.DeadLetter != nil {
 		{
-			size, err := m.HTTP.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.DeadLetter.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4270,11 +5409,19 @@ func (m *TriggerTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x52
 	}
-	if m.ArgoWorkflow != nil {
+	i -= len(m.DeliverySemantics)
+	copy(dAtA[i:], m.DeliverySemantics)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DeliverySemantics)))
+	i--
+	dAtA[i] = 0x4a
+	i = encodeVarintGenerated(dAtA, i, uint64(m.TriggerConcurrency))
+	i--
+	dAtA[i] = 0x40
+	if m.Idempotency != nil {
 		{
-			size, err := m.ArgoWorkflow.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Idempotency.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4282,11 +5429,29 @@ func (m *TriggerTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x3a
 	}
-	if m.K8s != nil {
+	if m.Replicas != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.Replicas))
+		i--
+		dAtA[i] = 0x30
+	}
+	i -= len(m.EventBusName)
+	copy(dAtA[i:], m.EventBusName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.EventBusName)))
+	i--
+	dAtA[i] = 0x2a
+	i--
+	if m.ErrorOnFailedRound {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x20
+	if m.Template != nil {
 		{
-			size, err := m.K8s.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Template.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4296,20 +5461,38 @@ func (m *TriggerTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1a
 	}
-	i -= len(m.Conditions)
-	copy(dAtA[i:], m.Conditions)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Conditions)))
-	i--
-	dAtA[i] = 0x12
-	i -= len(m.Name)
-	copy(dAtA[i:], m.Name)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
-	i--
-	dAtA[i] = 0xa
+	if len(m.Triggers) > 0 {
+		for iNdEx := len(m.Triggers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Triggers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Dependencies) > 0 {
+		for iNdEx := len(m.Dependencies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Dependencies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *URLArtifact) Marshal() (dAtA []byte, err error) {
+func (m *SensorStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4319,1886 +5502,8932 @@ func (m *URLArtifact) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *URLArtifact) MarshalTo(dAtA []byte) (int, error) {
+func (m *SensorStatus) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *URLArtifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SensorStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i--
-	if m.VerifyCert {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x10
-	i -= len(m.Path)
-	copy(dAtA[i:], m.Path)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Path)))
-	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenerated(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenerated(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *SlackTrigger) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *AWSLambdaTrigger) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *SlackTrigger) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlackTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.FunctionName)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.AccessKey != nil {
-		l = m.AccessKey.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.SecretKey != nil {
-		l = m.SecretKey.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	l = len(m.Region)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Payload) > 0 {
-		for _, e := range m.Payload {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	i -= len(m.ThreadTS)
+	copy(dAtA[i:], m.ThreadTS)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ThreadTS)))
+	i--
+	dAtA[i] = 0x32
+	i -= len(m.Blocks)
+	copy(dAtA[i:], m.Blocks)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Blocks)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Channel)
+	copy(dAtA[i:], m.Channel)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Channel)))
+	i--
+	dAtA[i] = 0x1a
+	if m.SlackToken != nil {
+		{
+			size, err := m.SlackToken.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
 	if len(m.Parameters) > 0 {
-		for _, e := range m.Parameters {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+		for iNdEx := len(m.Parameters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Parameters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if m.InvocationType != nil {
-		l = len(*m.InvocationType)
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	l = len(m.RoleARN)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ArgoWorkflowTrigger) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *StandardK8STrigger) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *StandardK8STrigger) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StandardK8STrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Source != nil {
-		l = m.Source.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	i--
+	if m.LiveObject {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
 	}
-	l = len(m.Operation)
-	n += 1 + l + sovGenerated(uint64(l))
+	i--
+	dAtA[i] = 0x28
+	i -= len(m.PatchStrategy)
+	copy(dAtA[i:], m.PatchStrategy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PatchStrategy)))
+	i--
+	dAtA[i] = 0x22
 	if len(m.Parameters) > 0 {
-		for _, e := range m.Parameters {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+		for iNdEx := len(m.Parameters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Parameters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	if len(m.Args) > 0 {
-		for _, s := range m.Args {
-			l = len(s)
-			n += 1 + l + sovGenerated(uint64(l))
+	i -= len(m.Operation)
+	copy(dAtA[i:], m.Operation)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Operation)))
+	i--
+	dAtA[i] = 0x12
+	if m.Source != nil {
+		{
+			size, err := m.Source.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ArtifactLocation) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *StatusPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *StatusPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StatusPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.S3 != nil {
-		l = m.S3.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.Inline != nil {
-		l = len(*m.Inline)
-		n += 1 + l + sovGenerated(uint64(l))
+	if len(m.AllowRanges) > 0 {
+		for iNdEx := len(m.AllowRanges) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowRanges[iNdEx])
+			copy(dAtA[i:], m.AllowRanges[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.AllowRanges[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.File != nil {
-		l = m.File.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Allow) > 0 {
+		for iNdEx := len(m.Allow) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarintGenerated(dAtA, i, uint64(m.Allow[iNdEx]))
+			i--
+			dAtA[i] = 0x8
+		}
 	}
-	if m.URL != nil {
-		l = m.URL.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.Configmap != nil {
-		l = m.Configmap.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.Git != nil {
-		l = m.Git.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.Resource != nil {
-		l = m.Resource.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AzureEventHubsTrigger) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.FQDN)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.HubName)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.SharedAccessKeyName != nil {
-		l = m.SharedAccessKeyName.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.SharedAccessKey != nil {
-		l = m.SharedAccessKey.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if len(m.Payload) > 0 {
-		for _, e := range m.Payload {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	if len(m.Parameters) > 0 {
-		for _, e := range m.Parameters {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
+func (m *Template) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ConditionsResetByTime) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Cron)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Timezone)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+func (m *Template) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ConditionsResetCriteria) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *Template) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ByTime != nil {
-		l = m.ByTime.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if m.Affinity != nil {
+		{
+			size, err := m.Affinity.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
 	}
-	return n
-}
-
-func (m *CustomTrigger) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Priority != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.Priority))
+		i--
+		dAtA[i] = 0x50
 	}
-	var l int
-	_ = l
-	l = len(m.ServerURL)
-	n += 1 + l + sovGenerated(uint64(l))
-	n += 2
-	if m.CertSecret != nil {
-		l = m.CertSecret.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	i -= len(m.PriorityClassName)
+	copy(dAtA[i:], m.PriorityClassName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PriorityClassName)))
+	i--
+	dAtA[i] = 0x4a
+	if len(m.ImagePullSecrets) > 0 {
+		for iNdEx := len(m.ImagePullSecrets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ImagePullSecrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
 	}
-	l = len(m.ServerNameOverride)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Spec) > 0 {
-		for k, v := range m.Spec {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+	if len(m.Tolerations) > 0 {
+		for iNdEx := len(m.Tolerations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tolerations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
 		}
 	}
-	if len(m.Parameters) > 0 {
-		for _, e := range m.Parameters {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	if len(m.NodeSelector) > 0 {
+		keysForNodeSelector := make([]string, 0, len(m.NodeSelector))
+		for k := range m.NodeSelector {
+			keysForNodeSelector = append(keysForNodeSelector, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForNodeSelector)
+		for iNdEx := len(keysForNodeSelector) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.NodeSelector[string(keysForNodeSelector[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForNodeSelector[iNdEx])
+			copy(dAtA[i:], keysForNodeSelector[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForNodeSelector[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
 		}
 	}
-	if len(m.Payload) > 0 {
-		for _, e := range m.Payload {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	if m.SecurityContext != nil {
+		{
+			size, err := m.SecurityContext.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	return n
-}
-
-func (m *DataFilter) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Volumes) > 0 {
+		for iNdEx := len(m.Volumes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Volumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.Path)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Type)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Value) > 0 {
-		for _, s := range m.Value {
-			l = len(s)
-			n += 1 + l + sovGenerated(uint64(l))
+	if m.Container != nil {
+		{
+			size, err := m.Container.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Comparator)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Template)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	i -= len(m.ServiceAccountName)
+	copy(dAtA[i:], m.ServiceAccountName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ServiceAccountName)))
+	i--
+	dAtA[i] = 0x12
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Event) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TimeFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Context != nil {
-		l = m.Context.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.Data != nil {
-		l = len(m.Data)
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventContext) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *TimeFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TimeFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ID)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Source)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.SpecVersion)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Type)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.DataContentType)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Subject)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Time.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	i -= len(m.Stop)
+	copy(dAtA[i:], m.Stop)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Stop)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Start)
+	copy(dAtA[i:], m.Start)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Start)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *EventDependency) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Trigger) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Trigger) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Trigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.EventSourceName)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.EventName)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.Filters != nil {
-		l = m.Filters.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if len(m.DependsOn) > 0 {
+		for iNdEx := len(m.DependsOn) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DependsOn[iNdEx])
+			copy(dAtA[i:], m.DependsOn[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.DependsOn[iNdEx])))
+			i--
+			dAtA[i] = 0x62
+		}
 	}
-	if m.Transform != nil {
-		l = m.Transform.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if m.Redelivery != nil {
+		{
+			size, err := m.Redelivery.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
 	}
-	l = len(m.FiltersLogicalOperator)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
-}
-
-func (m *EventDependencyFilter) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	if m.Critical {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
 	}
-	var l int
-	_ = l
-	if m.Time != nil {
-		l = m.Time.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	i--
+	dAtA[i] = 0x50
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Weight))
+	i--
+	dAtA[i] = 0x48
+	i -= len(m.CanaryGroup)
+	copy(dAtA[i:], m.CanaryGroup)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CanaryGroup)))
+	i--
+	dAtA[i] = 0x42
+	if m.CircuitBreaker != nil {
+		{
+			size, err := m.CircuitBreaker.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
 	}
-	if m.Context != nil {
-		l = m.Context.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if m.Dedupe != nil {
+		{
+			size, err := m.Dedupe.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
 	}
-	if len(m.Data) > 0 {
-		for _, e := range m.Data {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	if m.RateLimit != nil {
+		{
+			size, err := m.RateLimit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	if len(m.Exprs) > 0 {
-		for _, e := range m.Exprs {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	if m.RetryStrategy != nil {
+		{
+			size, err := m.RetryStrategy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.DataLogicalOperator)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.ExprLogicalOperator)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
-}
-
-func (m *EventDependencyTransformer) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Policy != nil {
+		{
+			size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	var l int
-	_ = l
-	l = len(m.JQ)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Script)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
-}
-
-func (m *ExprFilter) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Parameters) > 0 {
+		for iNdEx := len(m.Parameters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Parameters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.Expr)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Fields) > 0 {
-		for _, e := range m.Fields {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	if m.Template != nil {
+		{
+			size, err := m.Template.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *FileArtifact) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TriggerCircuitBreaker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Path)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GitArtifact) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *TriggerCircuitBreaker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TriggerCircuitBreaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.URL)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.CloneDirectory)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.Creds != nil {
-		l = m.Creds.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.SSHKeySecret != nil {
-		l = m.SSHKeySecret.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	l = len(m.FilePath)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Branch)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Tag)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Ref)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.Remote != nil {
-		l = m.Remote.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	return n
+	i -= len(m.Cooldown)
+	copy(dAtA[i:], m.Cooldown)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Cooldown)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Window)
+	copy(dAtA[i:], m.Window)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Window)))
+	i--
+	dAtA[i] = 0x12
+	i = encodeVarintGenerated(dAtA, i, uint64(m.FailureThreshold))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
-func (m *GitCreds) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Username != nil {
-		l = m.Username.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.Password != nil {
-		l = m.Password.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+func (m *TriggerDedupe) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GitRemoteConfig) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *TriggerDedupe) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TriggerDedupe) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.URLS) > 0 {
-		for _, s := range m.URLS {
-			l = len(s)
-			n += 1 + l + sovGenerated(uint64(l))
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MaxKeys))
+	i--
+	dAtA[i] = 0x18
+	i -= len(m.TTL)
+	copy(dAtA[i:], m.TTL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TTL)))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Key.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *HTTPTrigger) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TriggerParameter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TriggerParameter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TriggerParameter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.URL)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Payload) > 0 {
-		for _, e := range m.Payload {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	if m.TLS != nil {
-		l = m.TLS.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	l = len(m.Method)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Parameters) > 0 {
-		for _, e := range m.Parameters {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	n += 1 + sovGenerated(uint64(m.Timeout))
-	if m.BasicAuth != nil {
-		l = m.BasicAuth.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if len(m.Headers) > 0 {
-		for k, v := range m.Headers {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
-		}
-	}
-	if len(m.SecureHeaders) > 0 {
-		for _, e := range m.SecureHeaders {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	i -= len(m.Template)
+	copy(dAtA[i:], m.Template)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Template)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Operation)
+	copy(dAtA[i:], m.Operation)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Operation)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Dest)
+	copy(dAtA[i:], m.Dest)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Dest)))
+	i--
+	dAtA[i] = 0x12
+	if m.Src != nil {
+		{
+			size, err := m.Src.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *K8SResourcePolicy) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TriggerParameterSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TriggerParameterSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TriggerParameterSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Labels) > 0 {
-		for k, v := range m.Labels {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+	if m.Secret != nil {
+		{
+			size, err := m.Secret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x42
 	}
-	if m.Backoff != nil {
-		l = m.Backoff.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	i -= len(m.Env)
+	copy(dAtA[i:], m.Env)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Env)))
+	i--
+	dAtA[i] = 0x3a
+	if m.Value != nil {
+		i -= len(*m.Value)
+		copy(dAtA[i:], *m.Value)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.Value)))
+		i--
+		dAtA[i] = 0x32
 	}
-	n += 2
-	return n
+	i -= len(m.DataTemplate)
+	copy(dAtA[i:], m.DataTemplate)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DataTemplate)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.DataKey)
+	copy(dAtA[i:], m.DataKey)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DataKey)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.ContextTemplate)
+	copy(dAtA[i:], m.ContextTemplate)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ContextTemplate)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.ContextKey)
+	copy(dAtA[i:], m.ContextKey)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ContextKey)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.DependencyName)
+	copy(dAtA[i:], m.DependencyName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DependencyName)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *KafkaTrigger) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TriggerPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TriggerPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TriggerPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.URL)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Topic)
-	n += 1 + l + sovGenerated(uint64(l))
-	n += 1 + sovGenerated(uint64(m.Partition))
-	if len(m.Parameters) > 0 {
-		for _, e := range m.Parameters {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	if m.Status != nil {
+		{
+			size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	n += 1 + sovGenerated(uint64(m.RequiredAcks))
-	n += 2
-	n += 1 + sovGenerated(uint64(m.FlushFrequency))
-	if m.TLS != nil {
-		l = m.TLS.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if len(m.Payload) > 0 {
-		for _, e := range m.Payload {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	if m.K8s != nil {
+		{
+			size, err := m.K8s.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.PartitioningKey)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Version)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.SASL != nil {
-		l = m.SASL.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *LogTrigger) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TriggerRedelivery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	n += 1 + sovGenerated(uint64(m.IntervalSeconds))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *NATSTrigger) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.URL)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Subject)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Payload) > 0 {
-		for _, e := range m.Payload {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	if len(m.Parameters) > 0 {
-		for _, e := range m.Parameters {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	if m.TLS != nil {
-		l = m.TLS.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	return n
+func (m *TriggerRedelivery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OpenWhiskTrigger) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *TriggerRedelivery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Host)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Version)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Namespace)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.AuthToken != nil {
-		l = m.AuthToken.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	l = len(m.ActionName)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Payload) > 0 {
-		for _, e := range m.Payload {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	if len(m.Parameters) > 0 {
-		for _, e := range m.Parameters {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Attempts))
+	i--
+	dAtA[i] = 0x20
+	if m.Multiplier != nil {
+		{
+			size, err := m.Multiplier.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	i -= len(m.Max)
+	copy(dAtA[i:], m.Max)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Max)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Initial)
+	copy(dAtA[i:], m.Initial)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Initial)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *PayloadField) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TriggerTemplate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Path)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *PulsarTrigger) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *TriggerTemplate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TriggerTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.URL)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Topic)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Parameters) > 0 {
-		for _, e := range m.Parameters {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	if m.Bus != nil {
+		{
+			size, err := m.Bus.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
 	}
-	if len(m.Payload) > 0 {
-		for _, e := range m.Payload {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	if m.Pushgateway != nil {
+		{
+			size, err := m.Pushgateway.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
 	}
-	if m.TLSTrustCertsSecret != nil {
-		l = m.TLSTrustCertsSecret.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if m.CloudEvent != nil {
+		{
+			size, err := m.CloudEvent.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
 	}
-	n += 2
-	n += 2
-	if m.TLS != nil {
-		l = m.TLS.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	i -= len(m.Timeout)
+	copy(dAtA[i:], m.Timeout)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Timeout)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
+	if m.AWSSQS != nil {
+		{
+			size, err := m.AWSSQS.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
 	}
-	if m.AuthTokenSecret != nil {
-		l = m.AuthTokenSecret.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	i -= len(m.Condition)
+	copy(dAtA[i:], m.Condition)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Condition)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	i--
+	if m.DryRun {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
 	}
-	if m.ConnectionBackoff != nil {
-		l = m.ConnectionBackoff.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x90
+	if m.RedisStream != nil {
+		{
+			size, err := m.RedisStream.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
 	}
-	return n
-}
-
-func (m *RateLimit) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.GCPCloudFunction != nil {
+		{
+			size, err := m.GCPCloudFunction.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
 	}
+	if len(m.ConditionsReset) > 0 {
+		for iNdEx := len(m.ConditionsReset) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConditionsReset[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if m.Pulsar != nil {
+		{
+			size, err := m.Pulsar.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.AzureEventHubs != nil {
+		{
+			size, err := m.AzureEventHubs.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.Log != nil {
+		{
+			size, err := m.Log.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.OpenWhisk != nil {
+		{
+			size, err := m.OpenWhisk.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.Slack != nil {
+		{
+			size, err := m.Slack.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.NATS != nil {
+		{
+			size, err := m.NATS.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Kafka != nil {
+		{
+			size, err := m.Kafka.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.CustomTrigger != nil {
+		{
+			size, err := m.CustomTrigger.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.AWSLambda != nil {
+		{
+			size, err := m.AWSLambda.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.HTTP != nil {
+		{
+			size, err := m.HTTP.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ArgoWorkflow != nil {
+		{
+			size, err := m.ArgoWorkflow.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.K8s != nil {
+		{
+			size, err := m.K8s.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	i -= len(m.Conditions)
+	copy(dAtA[i:], m.Conditions)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Conditions)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *URLArtifact) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *URLArtifact) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *URLArtifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Unit)
-	n += 1 + l + sovGenerated(uint64(l))
-	n += 1 + sovGenerated(uint64(m.RequestsPerUnit))
-	return n
+	i--
+	if m.VerifyCert {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.Path)
+	copy(dAtA[i:], m.Path)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Path)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *Sensor) Size() (n int) {
+func encodeVarintGenerated(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenerated(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AWSLambdaTrigger) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.ObjectMeta.Size()
+	l = len(m.FunctionName)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Spec.Size()
+	if m.AccessKey != nil {
+		l = m.AccessKey.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.SecretKey != nil {
+		l = m.SecretKey.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Region)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Status.Size()
+	if len(m.Payload) > 0 {
+		for _, e := range m.Payload {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Parameters) > 0 {
+		for _, e := range m.Parameters {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.InvocationType != nil {
+		l = len(*m.InvocationType)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.RoleARN)
 	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *SensorList) Size() (n int) {
+func (m *AWSSQSTrigger) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.ListMeta.Size()
+	l = len(m.QueueURL)
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Region)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.AccessKey != nil {
+		l = m.AccessKey.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.SecretKey != nil {
+		l = m.SecretKey.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.RoleARN)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.MessageGroupID)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.MessageDeduplicationID)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Payload) > 0 {
+		for _, e := range m.Payload {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Parameters) > 0 {
+		for _, e := range m.Parameters {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
 	return n
 }
 
-func (m *SensorSpec) Size() (n int) {
+func (m *ArgoWorkflowTrigger) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Dependencies) > 0 {
-		for _, e := range m.Dependencies {
+	if m.Source != nil {
+		l = m.Source.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Operation)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Parameters) > 0 {
+		for _, e := range m.Parameters {
 			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	if len(m.Triggers) > 0 {
-		for _, e := range m.Triggers {
-			l = e.Size()
+	if len(m.Args) > 0 {
+		for _, s := range m.Args {
+			l = len(s)
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	if m.Template != nil {
-		l = m.Template.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	n += 2
-	l = len(m.EventBusName)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.Replicas != nil {
-		n += 1 + sovGenerated(uint64(*m.Replicas))
-	}
 	return n
 }
 
-func (m *SensorStatus) Size() (n int) {
+func (m *ArtifactLocation) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Status.Size()
-	n += 1 + l + sovGenerated(uint64(l))
+	if m.S3 != nil {
+		l = m.S3.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Inline != nil {
+		l = len(*m.Inline)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.File != nil {
+		l = m.File.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.URL != nil {
+		l = m.URL.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Configmap != nil {
+		l = m.Configmap.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Git != nil {
+		l = m.Git.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Resource != nil {
+		l = m.Resource.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
-func (m *SlackTrigger) Size() (n int) {
+func (m *AzureEventHubsTrigger) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.FQDN)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.HubName)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.SharedAccessKeyName != nil {
+		l = m.SharedAccessKeyName.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.SharedAccessKey != nil {
+		l = m.SharedAccessKey.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Payload) > 0 {
+		for _, e := range m.Payload {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	if len(m.Parameters) > 0 {
 		for _, e := range m.Parameters {
 			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	if m.SlackToken != nil {
-		l = m.SlackToken.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	l = len(m.Channel)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Message)
-	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *StandardK8STrigger) Size() (n int) {
+func (m *BusTrigger) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Source != nil {
-		l = m.Source.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	l = len(m.Operation)
+	l = len(m.Subject)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.EventSourceName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.EventName)
 	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Payload) > 0 {
+		for _, e := range m.Payload {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	if len(m.Parameters) > 0 {
 		for _, e := range m.Parameters {
 			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	l = len(m.PatchStrategy)
-	n += 1 + l + sovGenerated(uint64(l))
-	n += 2
 	return n
 }
 
-func (m *StatusPolicy) Size() (n int) {
+func (m *CloudEventEnvelope) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Allow) > 0 {
-		for _, e := range m.Allow {
-			n += 1 + sovGenerated(uint64(e))
+	l = len(m.Type)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Source)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Subject)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Extensions) > 0 {
+		for k, v := range m.Extensions {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
 		}
 	}
 	return n
 }
 
-func (m *Template) Size() (n int) {
+func (m *ConditionsResetByTime) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	l = len(m.ServiceAccountName)
+	l = len(m.Cron)
 	n += 1 + l + sovGenerated(uint64(l))
-	if m.Container != nil {
-		l = m.Container.Size()
+	l = len(m.Timezone)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ConditionsResetCriteria) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ByTime != nil {
+		l = m.ByTime.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if len(m.Volumes) > 0 {
-		for _, e := range m.Volumes {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
+	return n
+}
+
+func (m *CustomTrigger) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	if m.SecurityContext != nil {
-		l = m.SecurityContext.Size()
+	var l int
+	_ = l
+	l = len(m.ServerURL)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	if m.CertSecret != nil {
+		l = m.CertSecret.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if len(m.NodeSelector) > 0 {
-		for k, v := range m.NodeSelector {
+	l = len(m.ServerNameOverride)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Spec) > 0 {
+		for k, v := range m.Spec {
 			_ = k
 			_ = v
 			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
 			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
 		}
 	}
-	if len(m.Tolerations) > 0 {
-		for _, e := range m.Tolerations {
+	if len(m.Parameters) > 0 {
+		for _, e := range m.Parameters {
 			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	if len(m.ImagePullSecrets) > 0 {
-		for _, e := range m.ImagePullSecrets {
+	if len(m.Payload) > 0 {
+		for _, e := range m.Payload {
 			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	l = len(m.PriorityClassName)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.Priority != nil {
-		n += 1 + sovGenerated(uint64(*m.Priority))
-	}
-	if m.Affinity != nil {
-		l = m.Affinity.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
 	return n
 }
 
-func (m *TimeFilter) Size() (n int) {
+func (m *DataFilter) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Start)
+	l = len(m.Path)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Stop)
+	l = len(m.Type)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Value) > 0 {
+		for _, s := range m.Value {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.Comparator)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Template)
 	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *Trigger) Size() (n int) {
+func (m *DeadLetter) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Template != nil {
-		l = m.Template.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if len(m.Parameters) > 0 {
-		for _, e := range m.Parameters {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	if m.Policy != nil {
-		l = m.Policy.Size()
+	if m.EventBus != nil {
+		l = m.EventBus.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if m.RetryStrategy != nil {
-		l = m.RetryStrategy.Size()
+	if m.HTTP != nil {
+		l = m.HTTP.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if m.RateLimit != nil {
-		l = m.RateLimit.Size()
+	if m.JetStream != nil {
+		l = m.JetStream.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
 	return n
 }
 
-func (m *TriggerParameter) Size() (n int) {
+func (m *Event) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Src != nil {
-		l = m.Src.Size()
+	if m.Context != nil {
+		l = m.Context.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	l = len(m.Dest)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Operation)
+	if m.Data != nil {
+		l = len(m.Data)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *EventBusDeadLetterSink) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Subject)
 	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *TriggerParameterSource) Size() (n int) {
+func (m *EventContext) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.DependencyName)
+	l = len(m.ID)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.ContextKey)
+	l = len(m.Source)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.ContextTemplate)
+	l = len(m.SpecVersion)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.DataKey)
+	l = len(m.Type)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.DataTemplate)
+	l = len(m.DataContentType)
 	n += 1 + l + sovGenerated(uint64(l))
-	if m.Value != nil {
-		l = len(*m.Value)
+	l = len(m.Subject)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Time.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *EventDependency) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.EventSourceName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.EventName)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Filters != nil {
+		l = m.Filters.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Transform != nil {
+		l = m.Transform.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.FiltersLogicalOperator)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Schema)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.SensitiveFields) > 0 {
+		for _, s := range m.SensitiveFields {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *TriggerPolicy) Size() (n int) {
+func (m *EventDependencyFilter) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.K8s != nil {
-		l = m.K8s.Size()
+	if m.Time != nil {
+		l = m.Time.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if m.Status != nil {
-		l = m.Status.Size()
+	if m.Context != nil {
+		l = m.Context.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if len(m.Data) > 0 {
+		for _, e := range m.Data {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Exprs) > 0 {
+		for _, e := range m.Exprs {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.DataLogicalOperator)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ExprLogicalOperator)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *TriggerTemplate) Size() (n int) {
+func (m *EventDependencyTransformer) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Name)
+	l = len(m.JQ)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Conditions)
+	l = len(m.Script)
 	n += 1 + l + sovGenerated(uint64(l))
-	if m.K8s != nil {
-		l = m.K8s.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ExprFilter) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	if m.ArgoWorkflow != nil {
-		l = m.ArgoWorkflow.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	var l int
+	_ = l
+	l = len(m.Expr)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Fields) > 0 {
+		for _, e := range m.Fields {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	if m.HTTP != nil {
-		l = m.HTTP.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *FileArtifact) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	if m.AWSLambda != nil {
-		l = m.AWSLambda.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	var l int
+	_ = l
+	l = len(m.Path)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *GCPCloudFunctionBatch) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	if m.CustomTrigger != nil {
-		l = m.CustomTrigger.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.MaxSize))
+	l = len(m.MaxWait)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *GCPCloudFunctionResponseLogging) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	if m.Kafka != nil {
-		l = m.Kafka.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	var l int
+	_ = l
+	l = len(m.Level)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Redact) > 0 {
+		for _, s := range m.Redact {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	if m.NATS != nil {
-		l = m.NATS.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *GCPCloudFunctionTrigger) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	if m.Slack != nil {
-		l = m.Slack.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	var l int
+	_ = l
+	l = len(m.FunctionName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.CredentialsPath)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Payload) > 0 {
+		for _, e := range m.Payload {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	if m.OpenWhisk != nil {
-		l = m.OpenWhisk.Size()
+	if len(m.Parameters) > 0 {
+		for _, e := range m.Parameters {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.RetryStrategy != nil {
+		l = m.RetryStrategy.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if m.Log != nil {
-		l = m.Log.Size()
+	if m.CredentialsSecret != nil {
+		l = m.CredentialsSecret.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if m.AzureEventHubs != nil {
-		l = m.AzureEventHubs.Size()
+	l = len(m.Encoding)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.CACertificate != nil {
+		l = m.CACertificate.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if m.Pulsar != nil {
-		l = m.Pulsar.Size()
+	l = len(m.ProxyURL)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Batch != nil {
+		l = m.Batch.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if len(m.ConditionsReset) > 0 {
-		for _, e := range m.ConditionsReset {
+	n += 1 + sovGenerated(uint64(m.Generation))
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Location)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	l = len(m.Invocation)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Topic)
+	n += 2 + l + sovGenerated(uint64(l))
+	n += 3
+	if len(m.Headers) > 0 {
+		for k, v := range m.Headers {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 2 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.SecureHeaders) > 0 {
+		for _, e := range m.SecureHeaders {
 			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.ResponseLogging != nil {
+		l = m.ResponseLogging.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Transform)
+	n += 2 + l + sovGenerated(uint64(l))
+	l = len(m.ImpersonateServiceAccount)
+	n += 2 + l + sovGenerated(uint64(l))
+	if len(m.ImpersonationDelegates) > 0 {
+		for _, s := range m.ImpersonationDelegates {
+			l = len(s)
+			n += 2 + l + sovGenerated(uint64(l))
 		}
 	}
+	if len(m.Credentials) > 0 {
+		for k, v := range m.Credentials {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 2 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.CredentialsKey)
+	n += 2 + l + sovGenerated(uint64(l))
+	n += 2 + sovGenerated(uint64(m.MaxResponseSize))
+	l = len(m.UserAgent)
+	n += 2 + l + sovGenerated(uint64(l))
+	l = len(m.RetryOnResponse)
+	n += 2 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *URLArtifact) Size() (n int) {
+func (m *GitArtifact) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Path)
+	l = len(m.URL)
 	n += 1 + l + sovGenerated(uint64(l))
-	n += 2
+	l = len(m.CloneDirectory)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Creds != nil {
+		l = m.Creds.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.SSHKeySecret != nil {
+		l = m.SSHKeySecret.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.FilePath)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Branch)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Tag)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Ref)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Remote != nil {
+		l = m.Remote.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
-func sovGenerated(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenerated(x uint64) (n int) {
-	return sovGenerated(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (this *AWSLambdaTrigger) String() string {
-	if this == nil {
-		return "nil"
+func (m *GitCreds) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForPayload := "[]TriggerParameter{"
-	for _, f := range this.Payload {
-		repeatedStringForPayload += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	if m.Username != nil {
+		l = m.Username.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForPayload += "}"
-	repeatedStringForParameters := "[]TriggerParameter{"
-	for _, f := range this.Parameters {
-		repeatedStringForParameters += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
+	if m.Password != nil {
+		l = m.Password.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForParameters += "}"
-	s := strings.Join([]string{`&AWSLambdaTrigger{`,
-		`FunctionName:` + fmt.Sprintf("%v", this.FunctionName) + `,`,
-		`AccessKey:` + strings.Replace(fmt.Sprintf("%v", this.AccessKey), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
-		`SecretKey:` + strings.Replace(fmt.Sprintf("%v", this.SecretKey), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
-		`Region:` + fmt.Sprintf("%v", this.Region) + `,`,
-		`Payload:` + repeatedStringForPayload + `,`,
-		`Parameters:` + repeatedStringForParameters + `,`,
-		`InvocationType:` + valueToStringGenerated(this.InvocationType) + `,`,
-		`RoleARN:` + fmt.Sprintf("%v", this.RoleARN) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *ArgoWorkflowTrigger) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForParameters := "[]TriggerParameter{"
-	for _, f := range this.Parameters {
-		repeatedStringForParameters += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
+
+func (m *GitRemoteConfig) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForParameters += "}"
-	s := strings.Join([]string{`&ArgoWorkflowTrigger{`,
-		`Source:` + strings.Replace(this.Source.String(), "ArtifactLocation", "ArtifactLocation", 1) + `,`,
-		`Operation:` + fmt.Sprintf("%v", this.Operation) + `,`,
-		`Parameters:` + repeatedStringForParameters + `,`,
-		`Args:` + fmt.Sprintf("%v", this.Args) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ArtifactLocation) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.URLS) > 0 {
+		for _, s := range m.URLS {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&ArtifactLocation{`,
-		`S3:` + strings.Replace(fmt.Sprintf("%v", this.S3), "S3Artifact", "common.S3Artifact", 1) + `,`,
-		`Inline:` + valueToStringGenerated(this.Inline) + `,`,
-		`File:` + strings.Replace(this.File.String(), "FileArtifact", "FileArtifact", 1) + `,`,
-		`URL:` + strings.Replace(this.URL.String(), "URLArtifact", "URLArtifact", 1) + `,`,
-		`Configmap:` + strings.Replace(fmt.Sprintf("%v", this.Configmap), "ConfigMapKeySelector", "v1.ConfigMapKeySelector", 1) + `,`,
-		`Git:` + strings.Replace(this.Git.String(), "GitArtifact", "GitArtifact", 1) + `,`,
-		`Resource:` + strings.Replace(fmt.Sprintf("%v", this.Resource), "Resource", "common.Resource", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *AzureEventHubsTrigger) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *HTTPDeadLetterSink) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForPayload := "[]TriggerParameter{"
-	for _, f := range this.Payload {
-		repeatedStringForPayload += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Headers) > 0 {
+		for k, v := range m.Headers {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
 	}
-	repeatedStringForPayload += "}"
-	repeatedStringForParameters := "[]TriggerParameter{"
-	for _, f := range this.Parameters {
-		repeatedStringForParameters += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
+	if m.TLS != nil {
+		l = m.TLS.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForParameters += "}"
-	s := strings.Join([]string{`&AzureEventHubsTrigger{`,
-		`FQDN:` + fmt.Sprintf("%v", this.FQDN) + `,`,
-		`HubName:` + fmt.Sprintf("%v", this.HubName) + `,`,
-		`SharedAccessKeyName:` + strings.Replace(fmt.Sprintf("%v", this.SharedAccessKeyName), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
-		`SharedAccessKey:` + strings.Replace(fmt.Sprintf("%v", this.SharedAccessKey), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
-		`Payload:` + repeatedStringForPayload + `,`,
-		`Parameters:` + repeatedStringForParameters + `,`,
-		`}`,
-	}, "")
-	return s
+	l = len(m.Timeout)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *ConditionsResetByTime) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *HTTPTrigger) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&ConditionsResetByTime{`,
-		`Cron:` + fmt.Sprintf("%v", this.Cron) + `,`,
-		`Timezone:` + fmt.Sprintf("%v", this.Timezone) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ConditionsResetCriteria) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Payload) > 0 {
+		for _, e := range m.Payload {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&ConditionsResetCriteria{`,
-		`ByTime:` + strings.Replace(this.ByTime.String(), "ConditionsResetByTime", "ConditionsResetByTime", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *CustomTrigger) String() string {
-	if this == nil {
-		return "nil"
+	if m.TLS != nil {
+		l = m.TLS.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForParameters := "[]TriggerParameter{"
-	for _, f := range this.Parameters {
-		repeatedStringForParameters += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
+	l = len(m.Method)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Parameters) > 0 {
+		for _, e := range m.Parameters {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForParameters += "}"
-	repeatedStringForPayload := "[]TriggerParameter{"
-	for _, f := range this.Payload {
-		repeatedStringForPayload += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
+	n += 1 + sovGenerated(uint64(m.Timeout))
+	if m.BasicAuth != nil {
+		l = m.BasicAuth.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForPayload += "}"
-	keysForSpec := make([]string, 0, len(this.Spec))
-	for k := range this.Spec {
-		keysForSpec = append(keysForSpec, k)
+	if len(m.Headers) > 0 {
+		for k, v := range m.Headers {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForSpec)
-	mapStringForSpec := "map[string]string{"
-	for _, k := range keysForSpec {
-		mapStringForSpec += fmt.Sprintf("%v: %v,", k, this.Spec[k])
+	if len(m.SecureHeaders) > 0 {
+		for _, e := range m.SecureHeaders {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	mapStringForSpec += "}"
-	s := strings.Join([]string{`&CustomTrigger{`,
-		`ServerURL:` + fmt.Sprintf("%v", this.ServerURL) + `,`,
-		`Secure:` + fmt.Sprintf("%v", this.Secure) + `,`,
-		`CertSecret:` + strings.Replace(fmt.Sprintf("%v", this.CertSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
-		`ServerNameOverride:` + fmt.Sprintf("%v", this.ServerNameOverride) + `,`,
-		`Spec:` + mapStringForSpec + `,`,
-		`Parameters:` + repeatedStringForParameters + `,`,
-		`Payload:` + repeatedStringForPayload + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *DataFilter) String() string {
-	if this == nil {
-		return "nil"
+	n += 1 + sovGenerated(uint64(m.MaxResponseSize))
+	if m.RetryStrategy != nil {
+		l = m.RetryStrategy.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&DataFilter{`,
-		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
-		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
-		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
-		`Comparator:` + fmt.Sprintf("%v", this.Comparator) + `,`,
-		`Template:` + fmt.Sprintf("%v", this.Template) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *EventDependency) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.RetryOn) > 0 {
+		for _, s := range m.RetryOn {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&EventDependency{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`EventSourceName:` + fmt.Sprintf("%v", this.EventSourceName) + `,`,
-		`EventName:` + fmt.Sprintf("%v", this.EventName) + `,`,
-		`Filters:` + strings.Replace(this.Filters.String(), "EventDependencyFilter", "EventDependencyFilter", 1) + `,`,
-		`Transform:` + strings.Replace(this.Transform.String(), "EventDependencyTransformer", "EventDependencyTransformer", 1) + `,`,
-		`FiltersLogicalOperator:` + fmt.Sprintf("%v", this.FiltersLogicalOperator) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *EventDependencyFilter) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForData := "[]DataFilter{"
-	for _, f := range this.Data {
-		repeatedStringForData += strings.Replace(strings.Replace(f.String(), "DataFilter", "DataFilter", 1), `&`, ``, 1) + ","
+
+func (m *Idempotency) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForData += "}"
-	repeatedStringForExprs := "[]ExprFilter{"
-	for _, f := range this.Exprs {
-		repeatedStringForExprs += strings.Replace(strings.Replace(f.String(), "ExprFilter", "ExprFilter", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	l = len(m.TTL)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.JetStream != nil {
+		l = m.JetStream.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForExprs += "}"
-	s := strings.Join([]string{`&EventDependencyFilter{`,
-		`Time:` + strings.Replace(this.Time.String(), "TimeFilter", "TimeFilter", 1) + `,`,
-		`Context:` + strings.Replace(fmt.Sprintf("%v", this.Context), "EventContext", "EventContext", 1) + `,`,
-		`Data:` + repeatedStringForData + `,`,
-		`Exprs:` + repeatedStringForExprs + `,`,
-		`DataLogicalOperator:` + fmt.Sprintf("%v", this.DataLogicalOperator) + `,`,
-		`ExprLogicalOperator:` + fmt.Sprintf("%v", this.ExprLogicalOperator) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *EventDependencyTransformer) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&EventDependencyTransformer{`,
-		`JQ:` + fmt.Sprintf("%v", this.JQ) + `,`,
-		`Script:` + fmt.Sprintf("%v", this.Script) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *ExprFilter) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *JetStreamDeadLetterSink) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForFields := "[]PayloadField{"
-	for _, f := range this.Fields {
-		repeatedStringForFields += strings.Replace(strings.Replace(f.String(), "PayloadField", "PayloadField", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Subject)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Stream)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.TLS != nil {
+		l = m.TLS.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForFields += "}"
-	s := strings.Join([]string{`&ExprFilter{`,
-		`Expr:` + fmt.Sprintf("%v", this.Expr) + `,`,
-		`Fields:` + repeatedStringForFields + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *FileArtifact) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *JetStreamIdempotencyStore) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&FileArtifact{`,
-		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *GitArtifact) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Bucket)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.TLS != nil {
+		l = m.TLS.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&GitArtifact{`,
-		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
-		`CloneDirectory:` + fmt.Sprintf("%v", this.CloneDirectory) + `,`,
-		`Creds:` + strings.Replace(this.Creds.String(), "GitCreds", "GitCreds", 1) + `,`,
-		`SSHKeySecret:` + strings.Replace(fmt.Sprintf("%v", this.SSHKeySecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
-		`FilePath:` + fmt.Sprintf("%v", this.FilePath) + `,`,
-		`Branch:` + fmt.Sprintf("%v", this.Branch) + `,`,
-		`Tag:` + fmt.Sprintf("%v", this.Tag) + `,`,
-		`Ref:` + fmt.Sprintf("%v", this.Ref) + `,`,
-		`Remote:` + strings.Replace(this.Remote.String(), "GitRemoteConfig", "GitRemoteConfig", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *GitCreds) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *K8SResourcePolicy) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&GitCreds{`,
-		`Username:` + strings.Replace(fmt.Sprintf("%v", this.Username), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
-		`Password:` + strings.Replace(fmt.Sprintf("%v", this.Password), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *GitRemoteConfig) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
 	}
-	s := strings.Join([]string{`&GitRemoteConfig{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`URLS:` + fmt.Sprintf("%v", this.URLS) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *HTTPTrigger) String() string {
-	if this == nil {
-		return "nil"
+	if m.Backoff != nil {
+		l = m.Backoff.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForPayload := "[]TriggerParameter{"
-	for _, f := range this.Payload {
-		repeatedStringForPayload += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
+	n += 2
+	return n
+}
+
+func (m *KafkaTrigger) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForPayload += "}"
-	repeatedStringForParameters := "[]TriggerParameter{"
-	for _, f := range this.Parameters {
-		repeatedStringForParameters += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Topic)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Partition))
+	if len(m.Parameters) > 0 {
+		for _, e := range m.Parameters {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForParameters += "}"
-	repeatedStringForSecureHeaders := "[]*SecureHeader{"
-	for _, f := range this.SecureHeaders {
-		repeatedStringForSecureHeaders += strings.Replace(fmt.Sprintf("%v", f), "SecureHeader", "common.SecureHeader", 1) + ","
+	n += 1 + sovGenerated(uint64(m.RequiredAcks))
+	n += 2
+	n += 1 + sovGenerated(uint64(m.FlushFrequency))
+	if m.TLS != nil {
+		l = m.TLS.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForSecureHeaders += "}"
-	keysForHeaders := make([]string, 0, len(this.Headers))
-	for k := range this.Headers {
-		keysForHeaders = append(keysForHeaders, k)
+	if len(m.Payload) > 0 {
+		for _, e := range m.Payload {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForHeaders)
-	mapStringForHeaders := "map[string]string{"
-	for _, k := range keysForHeaders {
-		mapStringForHeaders += fmt.Sprintf("%v: %v,", k, this.Headers[k])
+	l = len(m.PartitioningKey)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Version)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.SASL != nil {
+		l = m.SASL.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	mapStringForHeaders += "}"
-	s := strings.Join([]string{`&HTTPTrigger{`,
-		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
-		`Payload:` + repeatedStringForPayload + `,`,
-		`TLS:` + strings.Replace(fmt.Sprintf("%v", this.TLS), "TLSConfig", "common.TLSConfig", 1) + `,`,
-		`Method:` + fmt.Sprintf("%v", this.Method) + `,`,
-		`Parameters:` + repeatedStringForParameters + `,`,
-		`Timeout:` + fmt.Sprintf("%v", this.Timeout) + `,`,
-		`BasicAuth:` + strings.Replace(fmt.Sprintf("%v", this.BasicAuth), "BasicAuth", "common.BasicAuth", 1) + `,`,
-		`Headers:` + mapStringForHeaders + `,`,
-		`SecureHeaders:` + repeatedStringForSecureHeaders + `,`,
-		`}`,
-	}, "")
-	return s
+	n += 2
+	l = len(m.PartitioningKeyTemplate)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *K8SResourcePolicy) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *LogTrigger) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	keysForLabels := make([]string, 0, len(this.Labels))
-	for k := range this.Labels {
-		keysForLabels = append(keysForLabels, k)
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.IntervalSeconds))
+	l = len(m.Level)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Payload) > 0 {
+		for _, e := range m.Payload {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
-	mapStringForLabels := "map[string]string{"
-	for _, k := range keysForLabels {
-		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	if len(m.Parameters) > 0 {
+		for _, e := range m.Parameters {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	mapStringForLabels += "}"
-	s := strings.Join([]string{`&K8SResourcePolicy{`,
-		`Labels:` + mapStringForLabels + `,`,
-		`Backoff:` + strings.Replace(fmt.Sprintf("%v", this.Backoff), "Backoff", "common.Backoff", 1) + `,`,
-		`ErrorOnBackoffTimeout:` + fmt.Sprintf("%v", this.ErrorOnBackoffTimeout) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *KafkaTrigger) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *NATSTrigger) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForParameters := "[]TriggerParameter{"
-	for _, f := range this.Parameters {
-		repeatedStringForParameters += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Subject)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Payload) > 0 {
+		for _, e := range m.Payload {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForParameters += "}"
-	repeatedStringForPayload := "[]TriggerParameter{"
-	for _, f := range this.Payload {
-		repeatedStringForPayload += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
+	if len(m.Parameters) > 0 {
+		for _, e := range m.Parameters {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForPayload += "}"
-	s := strings.Join([]string{`&KafkaTrigger{`,
-		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
-		`Topic:` + fmt.Sprintf("%v", this.Topic) + `,`,
-		`Partition:` + fmt.Sprintf("%v", this.Partition) + `,`,
-		`Parameters:` + repeatedStringForParameters + `,`,
-		`RequiredAcks:` + fmt.Sprintf("%v", this.RequiredAcks) + `,`,
-		`Compress:` + fmt.Sprintf("%v", this.Compress) + `,`,
-		`FlushFrequency:` + fmt.Sprintf("%v", this.FlushFrequency) + `,`,
-		`TLS:` + strings.Replace(fmt.Sprintf("%v", this.TLS), "TLSConfig", "common.TLSConfig", 1) + `,`,
-		`Payload:` + repeatedStringForPayload + `,`,
-		`PartitioningKey:` + fmt.Sprintf("%v", this.PartitioningKey) + `,`,
-		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
-		`SASL:` + strings.Replace(fmt.Sprintf("%v", this.SASL), "SASLConfig", "common.SASLConfig", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *LogTrigger) String() string {
-	if this == nil {
-		return "nil"
+	if m.TLS != nil {
+		l = m.TLS.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&LogTrigger{`,
-		`IntervalSeconds:` + fmt.Sprintf("%v", this.IntervalSeconds) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *NATSTrigger) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *OpenWhiskTrigger) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForPayload := "[]TriggerParameter{"
-	for _, f := range this.Payload {
-		repeatedStringForPayload += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	l = len(m.Host)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Version)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.AuthToken != nil {
+		l = m.AuthToken.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForPayload += "}"
-	repeatedStringForParameters := "[]TriggerParameter{"
-	for _, f := range this.Parameters {
-		repeatedStringForParameters += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
+	l = len(m.ActionName)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Payload) > 0 {
+		for _, e := range m.Payload {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForParameters += "}"
-	s := strings.Join([]string{`&NATSTrigger{`,
-		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
-		`Subject:` + fmt.Sprintf("%v", this.Subject) + `,`,
-		`Payload:` + repeatedStringForPayload + `,`,
-		`Parameters:` + repeatedStringForParameters + `,`,
-		`TLS:` + strings.Replace(fmt.Sprintf("%v", this.TLS), "TLSConfig", "common.TLSConfig", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	if len(m.Parameters) > 0 {
+		for _, e := range m.Parameters {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
 }
-func (this *OpenWhiskTrigger) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *PayloadField) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForPayload := "[]TriggerParameter{"
-	for _, f := range this.Payload {
-		repeatedStringForPayload += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	l = len(m.Path)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *PulsarTrigger) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForPayload += "}"
-	repeatedStringForParameters := "[]TriggerParameter{"
-	for _, f := range this.Parameters {
-		repeatedStringForParameters += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Topic)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Parameters) > 0 {
+		for _, e := range m.Parameters {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForParameters += "}"
-	s := strings.Join([]string{`&OpenWhiskTrigger{`,
-		`Host:` + fmt.Sprintf("%v", this.Host) + `,`,
-		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
-		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
-		`AuthToken:` + strings.Replace(fmt.Sprintf("%v", this.AuthToken), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
-		`ActionName:` + fmt.Sprintf("%v", this.ActionName) + `,`,
-		`Payload:` + repeatedStringForPayload + `,`,
-		`Parameters:` + repeatedStringForParameters + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *PayloadField) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.Payload) > 0 {
+		for _, e := range m.Payload {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&PayloadField{`,
-		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *PulsarTrigger) String() string {
-	if this == nil {
-		return "nil"
+	if m.TLSTrustCertsSecret != nil {
+		l = m.TLSTrustCertsSecret.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForParameters := "[]TriggerParameter{"
-	for _, f := range this.Parameters {
-		repeatedStringForParameters += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
+	n += 2
+	n += 2
+	if m.TLS != nil {
+		l = m.TLS.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForParameters += "}"
-	repeatedStringForPayload := "[]TriggerParameter{"
-	for _, f := range this.Payload {
-		repeatedStringForPayload += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
+	if m.AuthTokenSecret != nil {
+		l = m.AuthTokenSecret.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForPayload += "}"
-	s := strings.Join([]string{`&PulsarTrigger{`,
-		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
-		`Topic:` + fmt.Sprintf("%v", this.Topic) + `,`,
-		`Parameters:` + repeatedStringForParameters + `,`,
-		`Payload:` + repeatedStringForPayload + `,`,
-		`TLSTrustCertsSecret:` + strings.Replace(fmt.Sprintf("%v", this.TLSTrustCertsSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
-		`TLSAllowInsecureConnection:` + fmt.Sprintf("%v", this.TLSAllowInsecureConnection) + `,`,
-		`TLSValidateHostname:` + fmt.Sprintf("%v", this.TLSValidateHostname) + `,`,
-		`TLS:` + strings.Replace(fmt.Sprintf("%v", this.TLS), "TLSConfig", "common.TLSConfig", 1) + `,`,
-		`AuthTokenSecret:` + strings.Replace(fmt.Sprintf("%v", this.AuthTokenSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
-		`ConnectionBackoff:` + strings.Replace(fmt.Sprintf("%v", this.ConnectionBackoff), "Backoff", "common.Backoff", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *RateLimit) String() string {
-	if this == nil {
-		return "nil"
+	if m.ConnectionBackoff != nil {
+		l = m.ConnectionBackoff.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&RateLimit{`,
-		`Unit:` + fmt.Sprintf("%v", this.Unit) + `,`,
-		`RequestsPerUnit:` + fmt.Sprintf("%v", this.RequestsPerUnit) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Sensor) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.Properties) > 0 {
+		for k, v := range m.Properties {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
 	}
-	s := strings.Join([]string{`&Sensor{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "SensorSpec", "SensorSpec", 1), `&`, ``, 1) + `,`,
-		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "SensorStatus", "SensorStatus", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *SensorList) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *PushgatewayMetric) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForItems := "[]Sensor{"
-	for _, f := range this.Items {
-		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "Sensor", "Sensor", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Help)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
 	}
-	repeatedStringForItems += "}"
-	s := strings.Join([]string{`&SensorList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
-		`Items:` + repeatedStringForItems + `,`,
-		`}`,
-	}, "")
-	return s
+	l = len(m.Value)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *SensorSpec) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *PushgatewayTrigger) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForDependencies := "[]EventDependency{"
-	for _, f := range this.Dependencies {
-		repeatedStringForDependencies += strings.Replace(strings.Replace(f.String(), "EventDependency", "EventDependency", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Job)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Grouping) > 0 {
+		for k, v := range m.Grouping {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
 	}
-	repeatedStringForDependencies += "}"
-	repeatedStringForTriggers := "[]Trigger{"
-	for _, f := range this.Triggers {
-		repeatedStringForTriggers += strings.Replace(strings.Replace(f.String(), "Trigger", "Trigger", 1), `&`, ``, 1) + ","
+	if len(m.Metrics) > 0 {
+		for _, e := range m.Metrics {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForTriggers += "}"
-	s := strings.Join([]string{`&SensorSpec{`,
-		`Dependencies:` + repeatedStringForDependencies + `,`,
-		`Triggers:` + repeatedStringForTriggers + `,`,
-		`Template:` + strings.Replace(this.Template.String(), "Template", "Template", 1) + `,`,
-		`ErrorOnFailedRound:` + fmt.Sprintf("%v", this.ErrorOnFailedRound) + `,`,
-		`EventBusName:` + fmt.Sprintf("%v", this.EventBusName) + `,`,
-		`Replicas:` + valueToStringGenerated(this.Replicas) + `,`,
-		`}`,
-	}, "")
-	return s
+	n += 2
+	if m.BasicAuth != nil {
+		l = m.BasicAuth.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Parameters) > 0 {
+		for _, e := range m.Parameters {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
 }
-func (this *SensorStatus) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *RateLimit) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&SensorStatus{`,
-		`Status:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Status), "Status", "common.Status", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = len(m.Unit)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.RequestsPerUnit))
+	n += 1 + sovGenerated(uint64(m.Burst))
+	l = len(m.Overflow)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *SlackTrigger) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *RedisStreamTrigger) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForParameters := "[]TriggerParameter{"
-	for _, f := range this.Parameters {
-		repeatedStringForParameters += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	l = len(m.HostAddress)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Password != nil {
+		l = m.Password.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForParameters += "}"
-	s := strings.Join([]string{`&SlackTrigger{`,
-		`Parameters:` + repeatedStringForParameters + `,`,
-		`SlackToken:` + strings.Replace(fmt.Sprintf("%v", this.SlackToken), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
-		`Channel:` + fmt.Sprintf("%v", this.Channel) + `,`,
-		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *StandardK8STrigger) String() string {
-	if this == nil {
-		return "nil"
+	n += 1 + sovGenerated(uint64(m.DB))
+	if m.TLS != nil {
+		l = m.TLS.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForParameters := "[]TriggerParameter{"
-	for _, f := range this.Parameters {
-		repeatedStringForParameters += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
+	l = len(m.Stream)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.MaxLen))
+	if len(m.Payload) > 0 {
+		for _, e := range m.Payload {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForParameters += "}"
-	s := strings.Join([]string{`&StandardK8STrigger{`,
-		`Source:` + strings.Replace(this.Source.String(), "ArtifactLocation", "ArtifactLocation", 1) + `,`,
-		`Operation:` + fmt.Sprintf("%v", this.Operation) + `,`,
-		`Parameters:` + repeatedStringForParameters + `,`,
-		`PatchStrategy:` + fmt.Sprintf("%v", this.PatchStrategy) + `,`,
-		`LiveObject:` + fmt.Sprintf("%v", this.LiveObject) + `,`,
-		`}`,
-	}, "")
-	return s
+	if len(m.Parameters) > 0 {
+		for _, e := range m.Parameters {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
 }
-func (this *StatusPolicy) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *Sensor) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&StatusPolicy{`,
-		`Allow:` + fmt.Sprintf("%v", this.Allow) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Status.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *Template) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *SensorList) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForVolumes := "[]Volume{"
-	for _, f := range this.Volumes {
-		repeatedStringForVolumes += fmt.Sprintf("%v", f) + ","
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForVolumes += "}"
-	repeatedStringForTolerations := "[]Toleration{"
-	for _, f := range this.Tolerations {
-		repeatedStringForTolerations += fmt.Sprintf("%v", f) + ","
+	return n
+}
+
+func (m *SensorSpec) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForTolerations += "}"
-	repeatedStringForImagePullSecrets := "[]LocalObjectReference{"
-	for _, f := range this.ImagePullSecrets {
-		repeatedStringForImagePullSecrets += fmt.Sprintf("%v", f) + ","
+	var l int
+	_ = l
+	if len(m.Dependencies) > 0 {
+		for _, e := range m.Dependencies {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForImagePullSecrets += "}"
-	keysForNodeSelector := make([]string, 0, len(this.NodeSelector))
-	for k := range this.NodeSelector {
-		keysForNodeSelector = append(keysForNodeSelector, k)
+	if len(m.Triggers) > 0 {
+		for _, e := range m.Triggers {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForNodeSelector)
-	mapStringForNodeSelector := "map[string]string{"
-	for _, k := range keysForNodeSelector {
-		mapStringForNodeSelector += fmt.Sprintf("%v: %v,", k, this.NodeSelector[k])
+	if m.Template != nil {
+		l = m.Template.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	mapStringForNodeSelector += "}"
-	s := strings.Join([]string{`&Template{`,
-		`Metadata:` + strings.Replace(fmt.Sprintf("%v", this.Metadata), "Metadata", "common.Metadata", 1) + `,`,
-		`ServiceAccountName:` + fmt.Sprintf("%v", this.ServiceAccountName) + `,`,
-		`Container:` + strings.Replace(fmt.Sprintf("%v", this.Container), "Container", "v1.Container", 1) + `,`,
-		`Volumes:` + repeatedStringForVolumes + `,`,
-		`SecurityContext:` + strings.Replace(fmt.Sprintf("%v", this.SecurityContext), "PodSecurityContext", "v1.PodSecurityContext", 1) + `,`,
-		`NodeSelector:` + mapStringForNodeSelector + `,`,
-		`Tolerations:` + repeatedStringForTolerations + `,`,
-		`ImagePullSecrets:` + repeatedStringForImagePullSecrets + `,`,
-		`PriorityClassName:` + fmt.Sprintf("%v", this.PriorityClassName) + `,`,
-		`Priority:` + valueToStringGenerated(this.Priority) + `,`,
-		`Affinity:` + strings.Replace(fmt.Sprintf("%v", this.Affinity), "Affinity", "v1.Affinity", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *TimeFilter) String() string {
-	if this == nil {
-		return "nil"
+	n += 2
+	l = len(m.EventBusName)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Replicas != nil {
+		n += 1 + sovGenerated(uint64(*m.Replicas))
 	}
-	s := strings.Join([]string{`&TimeFilter{`,
-		`Start:` + fmt.Sprintf("%v", this.Start) + `,`,
-		`Stop:` + fmt.Sprintf("%v", this.Stop) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Trigger) String() string {
-	if this == nil {
-		return "nil"
+	if m.Idempotency != nil {
+		l = m.Idempotency.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForParameters := "[]TriggerParameter{"
-	for _, f := range this.Parameters {
-		repeatedStringForParameters += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
+	n += 1 + sovGenerated(uint64(m.TriggerConcurrency))
+	l = len(m.DeliverySemantics)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.DeadLetter != nil {
+		l = m.DeadLetter.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForParameters += "}"
-	s := strings.Join([]string{`&Trigger{`,
-		`Template:` + strings.Replace(this.Template.String(), "TriggerTemplate", "TriggerTemplate", 1) + `,`,
-		`Parameters:` + repeatedStringForParameters + `,`,
-		`Policy:` + strings.Replace(this.Policy.String(), "TriggerPolicy", "TriggerPolicy", 1) + `,`,
-		`RetryStrategy:` + strings.Replace(fmt.Sprintf("%v", this.RetryStrategy), "Backoff", "common.Backoff", 1) + `,`,
-		`RateLimit:` + strings.Replace(this.RateLimit.String(), "RateLimit", "RateLimit", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *TriggerParameter) String() string {
-	if this == nil {
-		return "nil"
+	n += 1 + sovGenerated(uint64(m.MaxInFlightEvents))
+	l = len(m.PartitionKey)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.MaxActivePartitions))
+	if len(m.SensitiveFields) > 0 {
+		for _, s := range m.SensitiveFields {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&TriggerParameter{`,
-		`Src:` + strings.Replace(this.Src.String(), "TriggerParameterSource", "TriggerParameterSource", 1) + `,`,
-		`Dest:` + fmt.Sprintf("%v", this.Dest) + `,`,
-		`Operation:` + fmt.Sprintf("%v", this.Operation) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *TriggerParameterSource) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *SensorStatus) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&TriggerParameterSource{`,
-		`DependencyName:` + fmt.Sprintf("%v", this.DependencyName) + `,`,
-		`ContextKey:` + fmt.Sprintf("%v", this.ContextKey) + `,`,
-		`ContextTemplate:` + fmt.Sprintf("%v", this.ContextTemplate) + `,`,
-		`DataKey:` + fmt.Sprintf("%v", this.DataKey) + `,`,
-		`DataTemplate:` + fmt.Sprintf("%v", this.DataTemplate) + `,`,
-		`Value:` + valueToStringGenerated(this.Value) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = m.Status.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *TriggerPolicy) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *SlackTrigger) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&TriggerPolicy{`,
-		`K8s:` + strings.Replace(this.K8s.String(), "K8SResourcePolicy", "K8SResourcePolicy", 1) + `,`,
-		`Status:` + strings.Replace(this.Status.String(), "StatusPolicy", "StatusPolicy", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	if len(m.Parameters) > 0 {
+		for _, e := range m.Parameters {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.SlackToken != nil {
+		l = m.SlackToken.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Channel)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Blocks)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ThreadTS)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *TriggerTemplate) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *StandardK8STrigger) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForConditionsReset := "[]ConditionsResetCriteria{"
-	for _, f := range this.ConditionsReset {
-		repeatedStringForConditionsReset += strings.Replace(strings.Replace(f.String(), "ConditionsResetCriteria", "ConditionsResetCriteria", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	if m.Source != nil {
+		l = m.Source.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForConditionsReset += "}"
-	s := strings.Join([]string{`&TriggerTemplate{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Conditions:` + fmt.Sprintf("%v", this.Conditions) + `,`,
-		`K8s:` + strings.Replace(this.K8s.String(), "StandardK8STrigger", "StandardK8STrigger", 1) + `,`,
-		`ArgoWorkflow:` + strings.Replace(this.ArgoWorkflow.String(), "ArgoWorkflowTrigger", "ArgoWorkflowTrigger", 1) + `,`,
-		`HTTP:` + strings.Replace(this.HTTP.String(), "HTTPTrigger", "HTTPTrigger", 1) + `,`,
-		`AWSLambda:` + strings.Replace(this.AWSLambda.String(), "AWSLambdaTrigger", "AWSLambdaTrigger", 1) + `,`,
-		`CustomTrigger:` + strings.Replace(this.CustomTrigger.String(), "CustomTrigger", "CustomTrigger", 1) + `,`,
-		`Kafka:` + strings.Replace(this.Kafka.String(), "KafkaTrigger", "KafkaTrigger", 1) + `,`,
-		`NATS:` + strings.Replace(this.NATS.String(), "NATSTrigger", "NATSTrigger", 1) + `,`,
-		`Slack:` + strings.Replace(this.Slack.String(), "SlackTrigger", "SlackTrigger", 1) + `,`,
-		`OpenWhisk:` + strings.Replace(this.OpenWhisk.String(), "OpenWhiskTrigger", "OpenWhiskTrigger", 1) + `,`,
-		`Log:` + strings.Replace(this.Log.String(), "LogTrigger", "LogTrigger", 1) + `,`,
-		`AzureEventHubs:` + strings.Replace(this.AzureEventHubs.String(), "AzureEventHubsTrigger", "AzureEventHubsTrigger", 1) + `,`,
-		`Pulsar:` + strings.Replace(this.Pulsar.String(), "PulsarTrigger", "PulsarTrigger", 1) + `,`,
-		`ConditionsReset:` + repeatedStringForConditionsReset + `,`,
-		`}`,
-	}, "")
-	return s
+	l = len(m.Operation)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Parameters) > 0 {
+		for _, e := range m.Parameters {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.PatchStrategy)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	return n
 }
-func (this *URLArtifact) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *StatusPolicy) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&URLArtifact{`,
-		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
-		`VerifyCert:` + fmt.Sprintf("%v", this.VerifyCert) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	if len(m.Allow) > 0 {
+		for _, e := range m.Allow {
+			n += 1 + sovGenerated(uint64(e))
+		}
+	}
+	if len(m.AllowRanges) > 0 {
+		for _, s := range m.AllowRanges {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
 }
-func valueToStringGenerated(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+
+func (m *Template) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.ServiceAccountName)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Container != nil {
+		l = m.Container.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Volumes) > 0 {
+		for _, e := range m.Volumes {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.SecurityContext != nil {
+		l = m.SecurityContext.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.NodeSelector) > 0 {
+		for k, v := range m.NodeSelector {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Tolerations) > 0 {
+		for _, e := range m.Tolerations {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.ImagePullSecrets) > 0 {
+		for _, e := range m.ImagePullSecrets {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.PriorityClassName)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Priority != nil {
+		n += 1 + sovGenerated(uint64(*m.Priority))
+	}
+	if m.Affinity != nil {
+		l = m.Affinity.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
 }
-func (m *AWSLambdaTrigger) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+
+func (m *TimeFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Start)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Stop)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *Trigger) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Template != nil {
+		l = m.Template.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Parameters) > 0 {
+		for _, e := range m.Parameters {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.Policy != nil {
+		l = m.Policy.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.RetryStrategy != nil {
+		l = m.RetryStrategy.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.RateLimit != nil {
+		l = m.RateLimit.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Dedupe != nil {
+		l = m.Dedupe.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.CircuitBreaker != nil {
+		l = m.CircuitBreaker.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.CanaryGroup)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Weight))
+	n += 2
+	if m.Redelivery != nil {
+		l = m.Redelivery.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.DependsOn) > 0 {
+		for _, s := range m.DependsOn {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TriggerCircuitBreaker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.FailureThreshold))
+	l = len(m.Window)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Cooldown)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *TriggerDedupe) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Key.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.TTL)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.MaxKeys))
+	return n
+}
+
+func (m *TriggerParameter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Src != nil {
+		l = m.Src.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Dest)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Operation)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Template)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *TriggerParameterSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DependencyName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ContextKey)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ContextTemplate)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.DataKey)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.DataTemplate)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Value != nil {
+		l = len(*m.Value)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Env)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Secret != nil {
+		l = m.Secret.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *TriggerPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.K8s != nil {
+		l = m.K8s.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Status != nil {
+		l = m.Status.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *TriggerRedelivery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Initial)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Max)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Multiplier != nil {
+		l = m.Multiplier.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 1 + sovGenerated(uint64(m.Attempts))
+	return n
+}
+
+func (m *TriggerTemplate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Conditions)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.K8s != nil {
+		l = m.K8s.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.ArgoWorkflow != nil {
+		l = m.ArgoWorkflow.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.HTTP != nil {
+		l = m.HTTP.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.AWSLambda != nil {
+		l = m.AWSLambda.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.CustomTrigger != nil {
+		l = m.CustomTrigger.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Kafka != nil {
+		l = m.Kafka.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.NATS != nil {
+		l = m.NATS.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Slack != nil {
+		l = m.Slack.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.OpenWhisk != nil {
+		l = m.OpenWhisk.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Log != nil {
+		l = m.Log.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.AzureEventHubs != nil {
+		l = m.AzureEventHubs.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Pulsar != nil {
+		l = m.Pulsar.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.ConditionsReset) > 0 {
+		for _, e := range m.ConditionsReset {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.GCPCloudFunction != nil {
+		l = m.GCPCloudFunction.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.RedisStream != nil {
+		l = m.RedisStream.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	n += 3
+	l = len(m.Condition)
+	n += 2 + l + sovGenerated(uint64(l))
+	if m.AWSSQS != nil {
+		l = m.AWSSQS.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Timeout)
+	n += 2 + l + sovGenerated(uint64(l))
+	if m.CloudEvent != nil {
+		l = m.CloudEvent.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.Pushgateway != nil {
+		l = m.Pushgateway.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.Bus != nil {
+		l = m.Bus.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *URLArtifact) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	return n
+}
+
+func sovGenerated(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenerated(x uint64) (n int) {
+	return sovGenerated(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *AWSLambdaTrigger) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForPayload := "[]TriggerParameter{"
+	for _, f := range this.Payload {
+		repeatedStringForPayload += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForPayload += "}"
+	repeatedStringForParameters := "[]TriggerParameter{"
+	for _, f := range this.Parameters {
+		repeatedStringForParameters += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForParameters += "}"
+	s := strings.Join([]string{`&AWSLambdaTrigger{`,
+		`FunctionName:` + fmt.Sprintf("%v", this.FunctionName) + `,`,
+		`AccessKey:` + strings.Replace(fmt.Sprintf("%v", this.AccessKey), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`SecretKey:` + strings.Replace(fmt.Sprintf("%v", this.SecretKey), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`Region:` + fmt.Sprintf("%v", this.Region) + `,`,
+		`Payload:` + repeatedStringForPayload + `,`,
+		`Parameters:` + repeatedStringForParameters + `,`,
+		`InvocationType:` + valueToStringGenerated(this.InvocationType) + `,`,
+		`RoleARN:` + fmt.Sprintf("%v", this.RoleARN) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AWSSQSTrigger) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForPayload := "[]TriggerParameter{"
+	for _, f := range this.Payload {
+		repeatedStringForPayload += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForPayload += "}"
+	repeatedStringForParameters := "[]TriggerParameter{"
+	for _, f := range this.Parameters {
+		repeatedStringForParameters += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForParameters += "}"
+	s := strings.Join([]string{`&AWSSQSTrigger{`,
+		`QueueURL:` + fmt.Sprintf("%v", this.QueueURL) + `,`,
+		`Region:` + fmt.Sprintf("%v", this.Region) + `,`,
+		`AccessKey:` + strings.Replace(fmt.Sprintf("%v", this.AccessKey), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`SecretKey:` + strings.Replace(fmt.Sprintf("%v", this.SecretKey), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`RoleARN:` + fmt.Sprintf("%v", this.RoleARN) + `,`,
+		`MessageGroupID:` + fmt.Sprintf("%v", this.MessageGroupID) + `,`,
+		`MessageDeduplicationID:` + fmt.Sprintf("%v", this.MessageDeduplicationID) + `,`,
+		`Payload:` + repeatedStringForPayload + `,`,
+		`Parameters:` + repeatedStringForParameters + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ArgoWorkflowTrigger) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForParameters := "[]TriggerParameter{"
+	for _, f := range this.Parameters {
+		repeatedStringForParameters += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForParameters += "}"
+	s := strings.Join([]string{`&ArgoWorkflowTrigger{`,
+		`Source:` + strings.Replace(this.Source.String(), "ArtifactLocation", "ArtifactLocation", 1) + `,`,
+		`Operation:` + fmt.Sprintf("%v", this.Operation) + `,`,
+		`Parameters:` + repeatedStringForParameters + `,`,
+		`Args:` + fmt.Sprintf("%v", this.Args) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ArtifactLocation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ArtifactLocation{`,
+		`S3:` + strings.Replace(fmt.Sprintf("%v", this.S3), "S3Artifact", "common.S3Artifact", 1) + `,`,
+		`Inline:` + valueToStringGenerated(this.Inline) + `,`,
+		`File:` + strings.Replace(this.File.String(), "FileArtifact", "FileArtifact", 1) + `,`,
+		`URL:` + strings.Replace(this.URL.String(), "URLArtifact", "URLArtifact", 1) + `,`,
+		`Configmap:` + strings.Replace(fmt.Sprintf("%v", this.Configmap), "ConfigMapKeySelector", "v1.ConfigMapKeySelector", 1) + `,`,
+		`Git:` + strings.Replace(this.Git.String(), "GitArtifact", "GitArtifact", 1) + `,`,
+		`Resource:` + strings.Replace(fmt.Sprintf("%v", this.Resource), "Resource", "common.Resource", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AzureEventHubsTrigger) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForPayload := "[]TriggerParameter{"
+	for _, f := range this.Payload {
+		repeatedStringForPayload += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForPayload += "}"
+	repeatedStringForParameters := "[]TriggerParameter{"
+	for _, f := range this.Parameters {
+		repeatedStringForParameters += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForParameters += "}"
+	s := strings.Join([]string{`&AzureEventHubsTrigger{`,
+		`FQDN:` + fmt.Sprintf("%v", this.FQDN) + `,`,
+		`HubName:` + fmt.Sprintf("%v", this.HubName) + `,`,
+		`SharedAccessKeyName:` + strings.Replace(fmt.Sprintf("%v", this.SharedAccessKeyName), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`SharedAccessKey:` + strings.Replace(fmt.Sprintf("%v", this.SharedAccessKey), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`Payload:` + repeatedStringForPayload + `,`,
+		`Parameters:` + repeatedStringForParameters + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BusTrigger) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForPayload := "[]TriggerParameter{"
+	for _, f := range this.Payload {
+		repeatedStringForPayload += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForPayload += "}"
+	repeatedStringForParameters := "[]TriggerParameter{"
+	for _, f := range this.Parameters {
+		repeatedStringForParameters += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForParameters += "}"
+	s := strings.Join([]string{`&BusTrigger{`,
+		`Subject:` + fmt.Sprintf("%v", this.Subject) + `,`,
+		`EventSourceName:` + fmt.Sprintf("%v", this.EventSourceName) + `,`,
+		`EventName:` + fmt.Sprintf("%v", this.EventName) + `,`,
+		`Payload:` + repeatedStringForPayload + `,`,
+		`Parameters:` + repeatedStringForParameters + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CloudEventEnvelope) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForExtensions := make([]string, 0, len(this.Extensions))
+	for k := range this.Extensions {
+		keysForExtensions = append(keysForExtensions, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForExtensions)
+	mapStringForExtensions := "map[string]string{"
+	for _, k := range keysForExtensions {
+		mapStringForExtensions += fmt.Sprintf("%v: %v,", k, this.Extensions[k])
+	}
+	mapStringForExtensions += "}"
+	s := strings.Join([]string{`&CloudEventEnvelope{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Source:` + fmt.Sprintf("%v", this.Source) + `,`,
+		`Subject:` + fmt.Sprintf("%v", this.Subject) + `,`,
+		`Extensions:` + mapStringForExtensions + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ConditionsResetByTime) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ConditionsResetByTime{`,
+		`Cron:` + fmt.Sprintf("%v", this.Cron) + `,`,
+		`Timezone:` + fmt.Sprintf("%v", this.Timezone) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ConditionsResetCriteria) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ConditionsResetCriteria{`,
+		`ByTime:` + strings.Replace(this.ByTime.String(), "ConditionsResetByTime", "ConditionsResetByTime", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CustomTrigger) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForParameters := "[]TriggerParameter{"
+	for _, f := range this.Parameters {
+		repeatedStringForParameters += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForParameters += "}"
+	repeatedStringForPayload := "[]TriggerParameter{"
+	for _, f := range this.Payload {
+		repeatedStringForPayload += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForPayload += "}"
+	keysForSpec := make([]string, 0, len(this.Spec))
+	for k := range this.Spec {
+		keysForSpec = append(keysForSpec, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForSpec)
+	mapStringForSpec := "map[string]string{"
+	for _, k := range keysForSpec {
+		mapStringForSpec += fmt.Sprintf("%v: %v,", k, this.Spec[k])
+	}
+	mapStringForSpec += "}"
+	s := strings.Join([]string{`&CustomTrigger{`,
+		`ServerURL:` + fmt.Sprintf("%v", this.ServerURL) + `,`,
+		`Secure:` + fmt.Sprintf("%v", this.Secure) + `,`,
+		`CertSecret:` + strings.Replace(fmt.Sprintf("%v", this.CertSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`ServerNameOverride:` + fmt.Sprintf("%v", this.ServerNameOverride) + `,`,
+		`Spec:` + mapStringForSpec + `,`,
+		`Parameters:` + repeatedStringForParameters + `,`,
+		`Payload:` + repeatedStringForPayload + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DataFilter) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DataFilter{`,
+		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`Comparator:` + fmt.Sprintf("%v", this.Comparator) + `,`,
+		`Template:` + fmt.Sprintf("%v", this.Template) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeadLetter) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeadLetter{`,
+		`EventBus:` + strings.Replace(this.EventBus.String(), "EventBusDeadLetterSink", "EventBusDeadLetterSink", 1) + `,`,
+		`HTTP:` + strings.Replace(this.HTTP.String(), "HTTPDeadLetterSink", "HTTPDeadLetterSink", 1) + `,`,
+		`JetStream:` + strings.Replace(this.JetStream.String(), "JetStreamDeadLetterSink", "JetStreamDeadLetterSink", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventBusDeadLetterSink) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventBusDeadLetterSink{`,
+		`Subject:` + fmt.Sprintf("%v", this.Subject) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventDependency) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventDependency{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`EventSourceName:` + fmt.Sprintf("%v", this.EventSourceName) + `,`,
+		`EventName:` + fmt.Sprintf("%v", this.EventName) + `,`,
+		`Filters:` + strings.Replace(this.Filters.String(), "EventDependencyFilter", "EventDependencyFilter", 1) + `,`,
+		`Transform:` + strings.Replace(this.Transform.String(), "EventDependencyTransformer", "EventDependencyTransformer", 1) + `,`,
+		`FiltersLogicalOperator:` + fmt.Sprintf("%v", this.FiltersLogicalOperator) + `,`,
+		`Schema:` + fmt.Sprintf("%v", this.Schema) + `,`,
+		`SensitiveFields:` + fmt.Sprintf("%v", this.SensitiveFields) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventDependencyFilter) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForData := "[]DataFilter{"
+	for _, f := range this.Data {
+		repeatedStringForData += strings.Replace(strings.Replace(f.String(), "DataFilter", "DataFilter", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForData += "}"
+	repeatedStringForExprs := "[]ExprFilter{"
+	for _, f := range this.Exprs {
+		repeatedStringForExprs += strings.Replace(strings.Replace(f.String(), "ExprFilter", "ExprFilter", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForExprs += "}"
+	s := strings.Join([]string{`&EventDependencyFilter{`,
+		`Time:` + strings.Replace(this.Time.String(), "TimeFilter", "TimeFilter", 1) + `,`,
+		`Context:` + strings.Replace(fmt.Sprintf("%v", this.Context), "EventContext", "EventContext", 1) + `,`,
+		`Data:` + repeatedStringForData + `,`,
+		`Exprs:` + repeatedStringForExprs + `,`,
+		`DataLogicalOperator:` + fmt.Sprintf("%v", this.DataLogicalOperator) + `,`,
+		`ExprLogicalOperator:` + fmt.Sprintf("%v", this.ExprLogicalOperator) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventDependencyTransformer) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventDependencyTransformer{`,
+		`JQ:` + fmt.Sprintf("%v", this.JQ) + `,`,
+		`Script:` + fmt.Sprintf("%v", this.Script) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ExprFilter) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForFields := "[]PayloadField{"
+	for _, f := range this.Fields {
+		repeatedStringForFields += strings.Replace(strings.Replace(f.String(), "PayloadField", "PayloadField", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForFields += "}"
+	s := strings.Join([]string{`&ExprFilter{`,
+		`Expr:` + fmt.Sprintf("%v", this.Expr) + `,`,
+		`Fields:` + repeatedStringForFields + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FileArtifact) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FileArtifact{`,
+		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GCPCloudFunctionBatch) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GCPCloudFunctionBatch{`,
+		`MaxSize:` + fmt.Sprintf("%v", this.MaxSize) + `,`,
+		`MaxWait:` + fmt.Sprintf("%v", this.MaxWait) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GCPCloudFunctionResponseLogging) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GCPCloudFunctionResponseLogging{`,
+		`Level:` + fmt.Sprintf("%v", this.Level) + `,`,
+		`Redact:` + fmt.Sprintf("%v", this.Redact) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GCPCloudFunctionTrigger) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForPayload := "[]TriggerParameter{"
+	for _, f := range this.Payload {
+		repeatedStringForPayload += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForPayload += "}"
+	repeatedStringForParameters := "[]TriggerParameter{"
+	for _, f := range this.Parameters {
+		repeatedStringForParameters += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForParameters += "}"
+	repeatedStringForSecureHeaders := "[]*SecureHeader{"
+	for _, f := range this.SecureHeaders {
+		repeatedStringForSecureHeaders += strings.Replace(fmt.Sprintf("%v", f), "SecureHeader", "common.SecureHeader", 1) + ","
+	}
+	repeatedStringForSecureHeaders += "}"
+	keysForHeaders := make([]string, 0, len(this.Headers))
+	for k := range this.Headers {
+		keysForHeaders = append(keysForHeaders, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForHeaders)
+	mapStringForHeaders := "map[string]string{"
+	for _, k := range keysForHeaders {
+		mapStringForHeaders += fmt.Sprintf("%v: %v,", k, this.Headers[k])
+	}
+	mapStringForHeaders += "}"
+	keysForCredentials := make([]string, 0, len(this.Credentials))
+	for k := range this.Credentials {
+		keysForCredentials = append(keysForCredentials, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForCredentials)
+	mapStringForCredentials := "map[string]v1.SecretKeySelector{"
+	for _, k := range keysForCredentials {
+		mapStringForCredentials += fmt.Sprintf("%v: %v,", k, this.Credentials[k])
+	}
+	mapStringForCredentials += "}"
+	s := strings.Join([]string{`&GCPCloudFunctionTrigger{`,
+		`FunctionName:` + fmt.Sprintf("%v", this.FunctionName) + `,`,
+		`CredentialsPath:` + fmt.Sprintf("%v", this.CredentialsPath) + `,`,
+		`Payload:` + repeatedStringForPayload + `,`,
+		`Parameters:` + repeatedStringForParameters + `,`,
+		`RetryStrategy:` + strings.Replace(fmt.Sprintf("%v", this.RetryStrategy), "Backoff", "common.Backoff", 1) + `,`,
+		`CredentialsSecret:` + strings.Replace(fmt.Sprintf("%v", this.CredentialsSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`Encoding:` + fmt.Sprintf("%v", this.Encoding) + `,`,
+		`CACertificate:` + strings.Replace(fmt.Sprintf("%v", this.CACertificate), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`ProxyURL:` + fmt.Sprintf("%v", this.ProxyURL) + `,`,
+		`Batch:` + strings.Replace(this.Batch.String(), "GCPCloudFunctionBatch", "GCPCloudFunctionBatch", 1) + `,`,
+		`Generation:` + fmt.Sprintf("%v", this.Generation) + `,`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`Location:` + fmt.Sprintf("%v", this.Location) + `,`,
+		`Envelope:` + fmt.Sprintf("%v", this.Envelope) + `,`,
+		`Invocation:` + fmt.Sprintf("%v", this.Invocation) + `,`,
+		`Topic:` + fmt.Sprintf("%v", this.Topic) + `,`,
+		`Async:` + fmt.Sprintf("%v", this.Async) + `,`,
+		`Headers:` + mapStringForHeaders + `,`,
+		`SecureHeaders:` + repeatedStringForSecureHeaders + `,`,
+		`ResponseLogging:` + strings.Replace(this.ResponseLogging.String(), "GCPCloudFunctionResponseLogging", "GCPCloudFunctionResponseLogging", 1) + `,`,
+		`Transform:` + fmt.Sprintf("%v", this.Transform) + `,`,
+		`ImpersonateServiceAccount:` + fmt.Sprintf("%v", this.ImpersonateServiceAccount) + `,`,
+		`ImpersonationDelegates:` + fmt.Sprintf("%v", this.ImpersonationDelegates) + `,`,
+		`Credentials:` + mapStringForCredentials + `,`,
+		`CredentialsKey:` + fmt.Sprintf("%v", this.CredentialsKey) + `,`,
+		`MaxResponseSize:` + fmt.Sprintf("%v", this.MaxResponseSize) + `,`,
+		`UserAgent:` + fmt.Sprintf("%v", this.UserAgent) + `,`,
+		`RetryOnResponse:` + fmt.Sprintf("%v", this.RetryOnResponse) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GitArtifact) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GitArtifact{`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`CloneDirectory:` + fmt.Sprintf("%v", this.CloneDirectory) + `,`,
+		`Creds:` + strings.Replace(this.Creds.String(), "GitCreds", "GitCreds", 1) + `,`,
+		`SSHKeySecret:` + strings.Replace(fmt.Sprintf("%v", this.SSHKeySecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`FilePath:` + fmt.Sprintf("%v", this.FilePath) + `,`,
+		`Branch:` + fmt.Sprintf("%v", this.Branch) + `,`,
+		`Tag:` + fmt.Sprintf("%v", this.Tag) + `,`,
+		`Ref:` + fmt.Sprintf("%v", this.Ref) + `,`,
+		`Remote:` + strings.Replace(this.Remote.String(), "GitRemoteConfig", "GitRemoteConfig", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GitCreds) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GitCreds{`,
+		`Username:` + strings.Replace(fmt.Sprintf("%v", this.Username), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`Password:` + strings.Replace(fmt.Sprintf("%v", this.Password), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GitRemoteConfig) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GitRemoteConfig{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`URLS:` + fmt.Sprintf("%v", this.URLS) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HTTPDeadLetterSink) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForHeaders := make([]string, 0, len(this.Headers))
+	for k := range this.Headers {
+		keysForHeaders = append(keysForHeaders, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForHeaders)
+	mapStringForHeaders := "map[string]string{"
+	for _, k := range keysForHeaders {
+		mapStringForHeaders += fmt.Sprintf("%v: %v,", k, this.Headers[k])
+	}
+	mapStringForHeaders += "}"
+	s := strings.Join([]string{`&HTTPDeadLetterSink{`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`Headers:` + mapStringForHeaders + `,`,
+		`TLS:` + strings.Replace(fmt.Sprintf("%v", this.TLS), "TLSConfig", "common.TLSConfig", 1) + `,`,
+		`Timeout:` + fmt.Sprintf("%v", this.Timeout) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HTTPTrigger) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForPayload := "[]TriggerParameter{"
+	for _, f := range this.Payload {
+		repeatedStringForPayload += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForPayload += "}"
+	repeatedStringForParameters := "[]TriggerParameter{"
+	for _, f := range this.Parameters {
+		repeatedStringForParameters += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForParameters += "}"
+	repeatedStringForSecureHeaders := "[]*SecureHeader{"
+	for _, f := range this.SecureHeaders {
+		repeatedStringForSecureHeaders += strings.Replace(fmt.Sprintf("%v", f), "SecureHeader", "common.SecureHeader", 1) + ","
+	}
+	repeatedStringForSecureHeaders += "}"
+	keysForHeaders := make([]string, 0, len(this.Headers))
+	for k := range this.Headers {
+		keysForHeaders = append(keysForHeaders, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForHeaders)
+	mapStringForHeaders := "map[string]string{"
+	for _, k := range keysForHeaders {
+		mapStringForHeaders += fmt.Sprintf("%v: %v,", k, this.Headers[k])
+	}
+	mapStringForHeaders += "}"
+	s := strings.Join([]string{`&HTTPTrigger{`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`Payload:` + repeatedStringForPayload + `,`,
+		`TLS:` + strings.Replace(fmt.Sprintf("%v", this.TLS), "TLSConfig", "common.TLSConfig", 1) + `,`,
+		`Method:` + fmt.Sprintf("%v", this.Method) + `,`,
+		`Parameters:` + repeatedStringForParameters + `,`,
+		`Timeout:` + fmt.Sprintf("%v", this.Timeout) + `,`,
+		`BasicAuth:` + strings.Replace(fmt.Sprintf("%v", this.BasicAuth), "BasicAuth", "common.BasicAuth", 1) + `,`,
+		`Headers:` + mapStringForHeaders + `,`,
+		`SecureHeaders:` + repeatedStringForSecureHeaders + `,`,
+		`MaxResponseSize:` + fmt.Sprintf("%v", this.MaxResponseSize) + `,`,
+		`RetryStrategy:` + strings.Replace(fmt.Sprintf("%v", this.RetryStrategy), "Backoff", "common.Backoff", 1) + `,`,
+		`RetryOn:` + fmt.Sprintf("%v", this.RetryOn) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Idempotency) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Idempotency{`,
+		`TTL:` + fmt.Sprintf("%v", this.TTL) + `,`,
+		`JetStream:` + strings.Replace(this.JetStream.String(), "JetStreamIdempotencyStore", "JetStreamIdempotencyStore", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JetStreamDeadLetterSink) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JetStreamDeadLetterSink{`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`Subject:` + fmt.Sprintf("%v", this.Subject) + `,`,
+		`Stream:` + fmt.Sprintf("%v", this.Stream) + `,`,
+		`TLS:` + strings.Replace(fmt.Sprintf("%v", this.TLS), "TLSConfig", "common.TLSConfig", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JetStreamIdempotencyStore) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JetStreamIdempotencyStore{`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`Bucket:` + fmt.Sprintf("%v", this.Bucket) + `,`,
+		`TLS:` + strings.Replace(fmt.Sprintf("%v", this.TLS), "TLSConfig", "common.TLSConfig", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *K8SResourcePolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForLabels := make([]string, 0, len(this.Labels))
+	for k := range this.Labels {
+		keysForLabels = append(keysForLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+	mapStringForLabels := "map[string]string{"
+	for _, k := range keysForLabels {
+		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	}
+	mapStringForLabels += "}"
+	s := strings.Join([]string{`&K8SResourcePolicy{`,
+		`Labels:` + mapStringForLabels + `,`,
+		`Backoff:` + strings.Replace(fmt.Sprintf("%v", this.Backoff), "Backoff", "common.Backoff", 1) + `,`,
+		`ErrorOnBackoffTimeout:` + fmt.Sprintf("%v", this.ErrorOnBackoffTimeout) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *KafkaTrigger) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForParameters := "[]TriggerParameter{"
+	for _, f := range this.Parameters {
+		repeatedStringForParameters += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForParameters += "}"
+	repeatedStringForPayload := "[]TriggerParameter{"
+	for _, f := range this.Payload {
+		repeatedStringForPayload += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForPayload += "}"
+	s := strings.Join([]string{`&KafkaTrigger{`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`Topic:` + fmt.Sprintf("%v", this.Topic) + `,`,
+		`Partition:` + fmt.Sprintf("%v", this.Partition) + `,`,
+		`Parameters:` + repeatedStringForParameters + `,`,
+		`RequiredAcks:` + fmt.Sprintf("%v", this.RequiredAcks) + `,`,
+		`Compress:` + fmt.Sprintf("%v", this.Compress) + `,`,
+		`FlushFrequency:` + fmt.Sprintf("%v", this.FlushFrequency) + `,`,
+		`TLS:` + strings.Replace(fmt.Sprintf("%v", this.TLS), "TLSConfig", "common.TLSConfig", 1) + `,`,
+		`Payload:` + repeatedStringForPayload + `,`,
+		`PartitioningKey:` + fmt.Sprintf("%v", this.PartitioningKey) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`SASL:` + strings.Replace(fmt.Sprintf("%v", this.SASL), "SASLConfig", "common.SASLConfig", 1) + `,`,
+		`Idempotent:` + fmt.Sprintf("%v", this.Idempotent) + `,`,
+		`PartitioningKeyTemplate:` + fmt.Sprintf("%v", this.PartitioningKeyTemplate) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *LogTrigger) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForPayload := "[]TriggerParameter{"
+	for _, f := range this.Payload {
+		repeatedStringForPayload += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForPayload += "}"
+	repeatedStringForParameters := "[]TriggerParameter{"
+	for _, f := range this.Parameters {
+		repeatedStringForParameters += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForParameters += "}"
+	s := strings.Join([]string{`&LogTrigger{`,
+		`IntervalSeconds:` + fmt.Sprintf("%v", this.IntervalSeconds) + `,`,
+		`Level:` + fmt.Sprintf("%v", this.Level) + `,`,
+		`Payload:` + repeatedStringForPayload + `,`,
+		`Parameters:` + repeatedStringForParameters + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NATSTrigger) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForPayload := "[]TriggerParameter{"
+	for _, f := range this.Payload {
+		repeatedStringForPayload += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForPayload += "}"
+	repeatedStringForParameters := "[]TriggerParameter{"
+	for _, f := range this.Parameters {
+		repeatedStringForParameters += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForParameters += "}"
+	s := strings.Join([]string{`&NATSTrigger{`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`Subject:` + fmt.Sprintf("%v", this.Subject) + `,`,
+		`Payload:` + repeatedStringForPayload + `,`,
+		`Parameters:` + repeatedStringForParameters + `,`,
+		`TLS:` + strings.Replace(fmt.Sprintf("%v", this.TLS), "TLSConfig", "common.TLSConfig", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *OpenWhiskTrigger) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForPayload := "[]TriggerParameter{"
+	for _, f := range this.Payload {
+		repeatedStringForPayload += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForPayload += "}"
+	repeatedStringForParameters := "[]TriggerParameter{"
+	for _, f := range this.Parameters {
+		repeatedStringForParameters += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForParameters += "}"
+	s := strings.Join([]string{`&OpenWhiskTrigger{`,
+		`Host:` + fmt.Sprintf("%v", this.Host) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`AuthToken:` + strings.Replace(fmt.Sprintf("%v", this.AuthToken), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`ActionName:` + fmt.Sprintf("%v", this.ActionName) + `,`,
+		`Payload:` + repeatedStringForPayload + `,`,
+		`Parameters:` + repeatedStringForParameters + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PayloadField) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PayloadField{`,
+		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PulsarTrigger) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForParameters := "[]TriggerParameter{"
+	for _, f := range this.Parameters {
+		repeatedStringForParameters += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForParameters += "}"
+	repeatedStringForPayload := "[]TriggerParameter{"
+	for _, f := range this.Payload {
+		repeatedStringForPayload += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForPayload += "}"
+	keysForProperties := make([]string, 0, len(this.Properties))
+	for k := range this.Properties {
+		keysForProperties = append(keysForProperties, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForProperties)
+	mapStringForProperties := "map[string]string{"
+	for _, k := range keysForProperties {
+		mapStringForProperties += fmt.Sprintf("%v: %v,", k, this.Properties[k])
+	}
+	mapStringForProperties += "}"
+	s := strings.Join([]string{`&PulsarTrigger{`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`Topic:` + fmt.Sprintf("%v", this.Topic) + `,`,
+		`Parameters:` + repeatedStringForParameters + `,`,
+		`Payload:` + repeatedStringForPayload + `,`,
+		`TLSTrustCertsSecret:` + strings.Replace(fmt.Sprintf("%v", this.TLSTrustCertsSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`TLSAllowInsecureConnection:` + fmt.Sprintf("%v", this.TLSAllowInsecureConnection) + `,`,
+		`TLSValidateHostname:` + fmt.Sprintf("%v", this.TLSValidateHostname) + `,`,
+		`TLS:` + strings.Replace(fmt.Sprintf("%v", this.TLS), "TLSConfig", "common.TLSConfig", 1) + `,`,
+		`AuthTokenSecret:` + strings.Replace(fmt.Sprintf("%v", this.AuthTokenSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`ConnectionBackoff:` + strings.Replace(fmt.Sprintf("%v", this.ConnectionBackoff), "Backoff", "common.Backoff", 1) + `,`,
+		`Properties:` + mapStringForProperties + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PushgatewayMetric) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForLabels := make([]string, 0, len(this.Labels))
+	for k := range this.Labels {
+		keysForLabels = append(keysForLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+	mapStringForLabels := "map[string]string{"
+	for _, k := range keysForLabels {
+		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	}
+	mapStringForLabels += "}"
+	s := strings.Join([]string{`&PushgatewayMetric{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Help:` + fmt.Sprintf("%v", this.Help) + `,`,
+		`Labels:` + mapStringForLabels + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PushgatewayTrigger) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForMetrics := "[]PushgatewayMetric{"
+	for _, f := range this.Metrics {
+		repeatedStringForMetrics += strings.Replace(strings.Replace(f.String(), "PushgatewayMetric", "PushgatewayMetric", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForMetrics += "}"
+	repeatedStringForParameters := "[]TriggerParameter{"
+	for _, f := range this.Parameters {
+		repeatedStringForParameters += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForParameters += "}"
+	keysForGrouping := make([]string, 0, len(this.Grouping))
+	for k := range this.Grouping {
+		keysForGrouping = append(keysForGrouping, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForGrouping)
+	mapStringForGrouping := "map[string]string{"
+	for _, k := range keysForGrouping {
+		mapStringForGrouping += fmt.Sprintf("%v: %v,", k, this.Grouping[k])
+	}
+	mapStringForGrouping += "}"
+	s := strings.Join([]string{`&PushgatewayTrigger{`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`Job:` + fmt.Sprintf("%v", this.Job) + `,`,
+		`Grouping:` + mapStringForGrouping + `,`,
+		`Metrics:` + repeatedStringForMetrics + `,`,
+		`Replace:` + fmt.Sprintf("%v", this.Replace) + `,`,
+		`BasicAuth:` + strings.Replace(fmt.Sprintf("%v", this.BasicAuth), "BasicAuth", "common.BasicAuth", 1) + `,`,
+		`Parameters:` + repeatedStringForParameters + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RateLimit) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RateLimit{`,
+		`Unit:` + fmt.Sprintf("%v", this.Unit) + `,`,
+		`RequestsPerUnit:` + fmt.Sprintf("%v", this.RequestsPerUnit) + `,`,
+		`Burst:` + fmt.Sprintf("%v", this.Burst) + `,`,
+		`Overflow:` + fmt.Sprintf("%v", this.Overflow) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RedisStreamTrigger) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForPayload := "[]TriggerParameter{"
+	for _, f := range this.Payload {
+		repeatedStringForPayload += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForPayload += "}"
+	repeatedStringForParameters := "[]TriggerParameter{"
+	for _, f := range this.Parameters {
+		repeatedStringForParameters += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForParameters += "}"
+	s := strings.Join([]string{`&RedisStreamTrigger{`,
+		`HostAddress:` + fmt.Sprintf("%v", this.HostAddress) + `,`,
+		`Password:` + strings.Replace(fmt.Sprintf("%v", this.Password), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`DB:` + fmt.Sprintf("%v", this.DB) + `,`,
+		`TLS:` + strings.Replace(fmt.Sprintf("%v", this.TLS), "TLSConfig", "common.TLSConfig", 1) + `,`,
+		`Stream:` + fmt.Sprintf("%v", this.Stream) + `,`,
+		`MaxLen:` + fmt.Sprintf("%v", this.MaxLen) + `,`,
+		`Payload:` + repeatedStringForPayload + `,`,
+		`Parameters:` + repeatedStringForParameters + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Sensor) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Sensor{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "SensorSpec", "SensorSpec", 1), `&`, ``, 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "SensorStatus", "SensorStatus", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SensorList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]Sensor{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "Sensor", "Sensor", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&SensorList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SensorSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForDependencies := "[]EventDependency{"
+	for _, f := range this.Dependencies {
+		repeatedStringForDependencies += strings.Replace(strings.Replace(f.String(), "EventDependency", "EventDependency", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForDependencies += "}"
+	repeatedStringForTriggers := "[]Trigger{"
+	for _, f := range this.Triggers {
+		repeatedStringForTriggers += strings.Replace(strings.Replace(f.String(), "Trigger", "Trigger", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForTriggers += "}"
+	s := strings.Join([]string{`&SensorSpec{`,
+		`Dependencies:` + repeatedStringForDependencies + `,`,
+		`Triggers:` + repeatedStringForTriggers + `,`,
+		`Template:` + strings.Replace(this.Template.String(), "Template", "Template", 1) + `,`,
+		`ErrorOnFailedRound:` + fmt.Sprintf("%v", this.ErrorOnFailedRound) + `,`,
+		`EventBusName:` + fmt.Sprintf("%v", this.EventBusName) + `,`,
+		`Replicas:` + valueToStringGenerated(this.Replicas) + `,`,
+		`Idempotency:` + strings.Replace(this.Idempotency.String(), "Idempotency", "Idempotency", 1) + `,`,
+		`TriggerConcurrency:` + fmt.Sprintf("%v", this.TriggerConcurrency) + `,`,
+		`DeliverySemantics:` + fmt.Sprintf("%v", this.DeliverySemantics) + `,`,
+		`DeadLetter:` + strings.Replace(this.DeadLetter.String(), "DeadLetter", "DeadLetter", 1) + `,`,
+		`MaxInFlightEvents:` + fmt.Sprintf("%v", this.MaxInFlightEvents) + `,`,
+		`PartitionKey:` + fmt.Sprintf("%v", this.PartitionKey) + `,`,
+		`MaxActivePartitions:` + fmt.Sprintf("%v", this.MaxActivePartitions) + `,`,
+		`SensitiveFields:` + fmt.Sprintf("%v", this.SensitiveFields) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SensorStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SensorStatus{`,
+		`Status:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Status), "Status", "common.Status", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SlackTrigger) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForParameters := "[]TriggerParameter{"
+	for _, f := range this.Parameters {
+		repeatedStringForParameters += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForParameters += "}"
+	s := strings.Join([]string{`&SlackTrigger{`,
+		`Parameters:` + repeatedStringForParameters + `,`,
+		`SlackToken:` + strings.Replace(fmt.Sprintf("%v", this.SlackToken), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`Channel:` + fmt.Sprintf("%v", this.Channel) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`Blocks:` + fmt.Sprintf("%v", this.Blocks) + `,`,
+		`ThreadTS:` + fmt.Sprintf("%v", this.ThreadTS) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StandardK8STrigger) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForParameters := "[]TriggerParameter{"
+	for _, f := range this.Parameters {
+		repeatedStringForParameters += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForParameters += "}"
+	s := strings.Join([]string{`&StandardK8STrigger{`,
+		`Source:` + strings.Replace(this.Source.String(), "ArtifactLocation", "ArtifactLocation", 1) + `,`,
+		`Operation:` + fmt.Sprintf("%v", this.Operation) + `,`,
+		`Parameters:` + repeatedStringForParameters + `,`,
+		`PatchStrategy:` + fmt.Sprintf("%v", this.PatchStrategy) + `,`,
+		`LiveObject:` + fmt.Sprintf("%v", this.LiveObject) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StatusPolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StatusPolicy{`,
+		`Allow:` + fmt.Sprintf("%v", this.Allow) + `,`,
+		`AllowRanges:` + fmt.Sprintf("%v", this.AllowRanges) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Template) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForVolumes := "[]Volume{"
+	for _, f := range this.Volumes {
+		repeatedStringForVolumes += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForVolumes += "}"
+	repeatedStringForTolerations := "[]Toleration{"
+	for _, f := range this.Tolerations {
+		repeatedStringForTolerations += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForTolerations += "}"
+	repeatedStringForImagePullSecrets := "[]LocalObjectReference{"
+	for _, f := range this.ImagePullSecrets {
+		repeatedStringForImagePullSecrets += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForImagePullSecrets += "}"
+	keysForNodeSelector := make([]string, 0, len(this.NodeSelector))
+	for k := range this.NodeSelector {
+		keysForNodeSelector = append(keysForNodeSelector, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForNodeSelector)
+	mapStringForNodeSelector := "map[string]string{"
+	for _, k := range keysForNodeSelector {
+		mapStringForNodeSelector += fmt.Sprintf("%v: %v,", k, this.NodeSelector[k])
+	}
+	mapStringForNodeSelector += "}"
+	s := strings.Join([]string{`&Template{`,
+		`Metadata:` + strings.Replace(fmt.Sprintf("%v", this.Metadata), "Metadata", "common.Metadata", 1) + `,`,
+		`ServiceAccountName:` + fmt.Sprintf("%v", this.ServiceAccountName) + `,`,
+		`Container:` + strings.Replace(fmt.Sprintf("%v", this.Container), "Container", "v1.Container", 1) + `,`,
+		`Volumes:` + repeatedStringForVolumes + `,`,
+		`SecurityContext:` + strings.Replace(fmt.Sprintf("%v", this.SecurityContext), "PodSecurityContext", "v1.PodSecurityContext", 1) + `,`,
+		`NodeSelector:` + mapStringForNodeSelector + `,`,
+		`Tolerations:` + repeatedStringForTolerations + `,`,
+		`ImagePullSecrets:` + repeatedStringForImagePullSecrets + `,`,
+		`PriorityClassName:` + fmt.Sprintf("%v", this.PriorityClassName) + `,`,
+		`Priority:` + valueToStringGenerated(this.Priority) + `,`,
+		`Affinity:` + strings.Replace(fmt.Sprintf("%v", this.Affinity), "Affinity", "v1.Affinity", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TimeFilter) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TimeFilter{`,
+		`Start:` + fmt.Sprintf("%v", this.Start) + `,`,
+		`Stop:` + fmt.Sprintf("%v", this.Stop) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Trigger) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForParameters := "[]TriggerParameter{"
+	for _, f := range this.Parameters {
+		repeatedStringForParameters += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForParameters += "}"
+	s := strings.Join([]string{`&Trigger{`,
+		`Template:` + strings.Replace(this.Template.String(), "TriggerTemplate", "TriggerTemplate", 1) + `,`,
+		`Parameters:` + repeatedStringForParameters + `,`,
+		`Policy:` + strings.Replace(this.Policy.String(), "TriggerPolicy", "TriggerPolicy", 1) + `,`,
+		`RetryStrategy:` + strings.Replace(fmt.Sprintf("%v", this.RetryStrategy), "Backoff", "common.Backoff", 1) + `,`,
+		`RateLimit:` + strings.Replace(this.RateLimit.String(), "RateLimit", "RateLimit", 1) + `,`,
+		`Dedupe:` + strings.Replace(this.Dedupe.String(), "TriggerDedupe", "TriggerDedupe", 1) + `,`,
+		`CircuitBreaker:` + strings.Replace(this.CircuitBreaker.String(), "TriggerCircuitBreaker", "TriggerCircuitBreaker", 1) + `,`,
+		`CanaryGroup:` + fmt.Sprintf("%v", this.CanaryGroup) + `,`,
+		`Weight:` + fmt.Sprintf("%v", this.Weight) + `,`,
+		`Critical:` + fmt.Sprintf("%v", this.Critical) + `,`,
+		`Redelivery:` + strings.Replace(this.Redelivery.String(), "TriggerRedelivery", "TriggerRedelivery", 1) + `,`,
+		`DependsOn:` + fmt.Sprintf("%v", this.DependsOn) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TriggerCircuitBreaker) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TriggerCircuitBreaker{`,
+		`FailureThreshold:` + fmt.Sprintf("%v", this.FailureThreshold) + `,`,
+		`Window:` + fmt.Sprintf("%v", this.Window) + `,`,
+		`Cooldown:` + fmt.Sprintf("%v", this.Cooldown) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TriggerDedupe) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TriggerDedupe{`,
+		`Key:` + strings.Replace(strings.Replace(this.Key.String(), "TriggerParameterSource", "TriggerParameterSource", 1), `&`, ``, 1) + `,`,
+		`TTL:` + fmt.Sprintf("%v", this.TTL) + `,`,
+		`MaxKeys:` + fmt.Sprintf("%v", this.MaxKeys) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TriggerParameter) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TriggerParameter{`,
+		`Src:` + strings.Replace(this.Src.String(), "TriggerParameterSource", "TriggerParameterSource", 1) + `,`,
+		`Dest:` + fmt.Sprintf("%v", this.Dest) + `,`,
+		`Operation:` + fmt.Sprintf("%v", this.Operation) + `,`,
+		`Template:` + fmt.Sprintf("%v", this.Template) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TriggerParameterSource) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TriggerParameterSource{`,
+		`DependencyName:` + fmt.Sprintf("%v", this.DependencyName) + `,`,
+		`ContextKey:` + fmt.Sprintf("%v", this.ContextKey) + `,`,
+		`ContextTemplate:` + fmt.Sprintf("%v", this.ContextTemplate) + `,`,
+		`DataKey:` + fmt.Sprintf("%v", this.DataKey) + `,`,
+		`DataTemplate:` + fmt.Sprintf("%v", this.DataTemplate) + `,`,
+		`Value:` + valueToStringGenerated(this.Value) + `,`,
+		`Env:` + fmt.Sprintf("%v", this.Env) + `,`,
+		`Secret:` + strings.Replace(fmt.Sprintf("%v", this.Secret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TriggerPolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TriggerPolicy{`,
+		`K8s:` + strings.Replace(this.K8s.String(), "K8SResourcePolicy", "K8SResourcePolicy", 1) + `,`,
+		`Status:` + strings.Replace(this.Status.String(), "StatusPolicy", "StatusPolicy", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TriggerRedelivery) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TriggerRedelivery{`,
+		`Initial:` + fmt.Sprintf("%v", this.Initial) + `,`,
+		`Max:` + fmt.Sprintf("%v", this.Max) + `,`,
+		`Multiplier:` + strings.Replace(fmt.Sprintf("%v", this.Multiplier), "Amount", "common.Amount", 1) + `,`,
+		`Attempts:` + fmt.Sprintf("%v", this.Attempts) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TriggerTemplate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForConditionsReset := "[]ConditionsResetCriteria{"
+	for _, f := range this.ConditionsReset {
+		repeatedStringForConditionsReset += strings.Replace(strings.Replace(f.String(), "ConditionsResetCriteria", "ConditionsResetCriteria", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForConditionsReset += "}"
+	s := strings.Join([]string{`&TriggerTemplate{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Conditions:` + fmt.Sprintf("%v", this.Conditions) + `,`,
+		`K8s:` + strings.Replace(this.K8s.String(), "StandardK8STrigger", "StandardK8STrigger", 1) + `,`,
+		`ArgoWorkflow:` + strings.Replace(this.ArgoWorkflow.String(), "ArgoWorkflowTrigger", "ArgoWorkflowTrigger", 1) + `,`,
+		`HTTP:` + strings.Replace(this.HTTP.String(), "HTTPTrigger", "HTTPTrigger", 1) + `,`,
+		`AWSLambda:` + strings.Replace(this.AWSLambda.String(), "AWSLambdaTrigger", "AWSLambdaTrigger", 1) + `,`,
+		`CustomTrigger:` + strings.Replace(this.CustomTrigger.String(), "CustomTrigger", "CustomTrigger", 1) + `,`,
+		`Kafka:` + strings.Replace(this.Kafka.String(), "KafkaTrigger", "KafkaTrigger", 1) + `,`,
+		`NATS:` + strings.Replace(this.NATS.String(), "NATSTrigger", "NATSTrigger", 1) + `,`,
+		`Slack:` + strings.Replace(this.Slack.String(), "SlackTrigger", "SlackTrigger", 1) + `,`,
+		`OpenWhisk:` + strings.Replace(this.OpenWhisk.String(), "OpenWhiskTrigger", "OpenWhiskTrigger", 1) + `,`,
+		`Log:` + strings.Replace(this.Log.String(), "LogTrigger", "LogTrigger", 1) + `,`,
+		`AzureEventHubs:` + strings.Replace(this.AzureEventHubs.String(), "AzureEventHubsTrigger", "AzureEventHubsTrigger", 1) + `,`,
+		`Pulsar:` + strings.Replace(this.Pulsar.String(), "PulsarTrigger", "PulsarTrigger", 1) + `,`,
+		`ConditionsReset:` + repeatedStringForConditionsReset + `,`,
+		`GCPCloudFunction:` + strings.Replace(this.GCPCloudFunction.String(), "GCPCloudFunctionTrigger", "GCPCloudFunctionTrigger", 1) + `,`,
+		`RedisStream:` + strings.Replace(this.RedisStream.String(), "RedisStreamTrigger", "RedisStreamTrigger", 1) + `,`,
+		`DryRun:` + fmt.Sprintf("%v", this.DryRun) + `,`,
+		`Condition:` + fmt.Sprintf("%v", this.Condition) + `,`,
+		`AWSSQS:` + strings.Replace(this.AWSSQS.String(), "AWSSQSTrigger", "AWSSQSTrigger", 1) + `,`,
+		`Timeout:` + fmt.Sprintf("%v", this.Timeout) + `,`,
+		`CloudEvent:` + strings.Replace(this.CloudEvent.String(), "CloudEventEnvelope", "CloudEventEnvelope", 1) + `,`,
+		`Pushgateway:` + strings.Replace(this.Pushgateway.String(), "PushgatewayTrigger", "PushgatewayTrigger", 1) + `,`,
+		`Bus:` + strings.Replace(this.Bus.String(), "BusTrigger", "BusTrigger", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *URLArtifact) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&URLArtifact{`,
+		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`VerifyCert:` + fmt.Sprintf("%v", this.VerifyCert) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringGenerated(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *AWSLambdaTrigger) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AWSLambdaTrigger: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AWSLambdaTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunctionName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunctionName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AccessKey == nil {
+				m.AccessKey = &v1.SecretKeySelector{}
+			}
+			if err := m.AccessKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SecretKey == nil {
+				m.SecretKey = &v1.SecretKeySelector{}
+			}
+			if err := m.SecretKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Region = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload, TriggerParameter{})
+			if err := m.Payload[len(m.Payload)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parameters = append(m.Parameters, TriggerParameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvocationType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.InvocationType = &s
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleARN", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoleARN = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AWSSQSTrigger) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AWSSQSTrigger: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AWSSQSTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueueURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Region = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AccessKey == nil {
+				m.AccessKey = &v1.SecretKeySelector{}
+			}
+			if err := m.AccessKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SecretKey == nil {
+				m.SecretKey = &v1.SecretKeySelector{}
+			}
+			if err := m.SecretKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleARN", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoleARN = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageGroupID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageGroupID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageDeduplicationID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageDeduplicationID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload, TriggerParameter{})
+			if err := m.Payload[len(m.Payload)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parameters = append(m.Parameters, TriggerParameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArgoWorkflowTrigger) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArgoWorkflowTrigger: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArgoWorkflowTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Source == nil {
+				m.Source = &ArtifactLocation{}
+			}
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operation = ArgoWorkflowOperation(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parameters = append(m.Parameters, TriggerParameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArtifactLocation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArtifactLocation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field S3", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.S3 == nil {
+				m.S3 = &common.S3Artifact{}
+			}
+			if err := m.S3.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inline", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Inline = &s
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.File == nil {
+				m.File = &FileArtifact{}
+			}
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.URL == nil {
+				m.URL = &URLArtifact{}
+			}
+			if err := m.URL.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Configmap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Configmap == nil {
+				m.Configmap = &v1.ConfigMapKeySelector{}
+			}
+			if err := m.Configmap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Git", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Git == nil {
+				m.Git = &GitArtifact{}
+			}
+			if err := m.Git.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Resource == nil {
+				m.Resource = &common.Resource{}
+			}
+			if err := m.Resource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AzureEventHubsTrigger) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AzureEventHubsTrigger: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AzureEventHubsTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FQDN", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FQDN = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HubName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HubName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharedAccessKeyName", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SharedAccessKeyName == nil {
+				m.SharedAccessKeyName = &v1.SecretKeySelector{}
+			}
+			if err := m.SharedAccessKeyName.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharedAccessKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SharedAccessKey == nil {
+				m.SharedAccessKey = &v1.SecretKeySelector{}
+			}
+			if err := m.SharedAccessKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload, TriggerParameter{})
+			if err := m.Payload[len(m.Payload)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parameters = append(m.Parameters, TriggerParameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BusTrigger) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BusTrigger: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BusTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subject = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventSourceName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventSourceName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload, TriggerParameter{})
+			if err := m.Payload[len(m.Payload)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parameters = append(m.Parameters, TriggerParameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CloudEventEnvelope) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CloudEventEnvelope: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CloudEventEnvelope: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subject = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Extensions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Extensions == nil {
+				m.Extensions = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Extensions[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConditionsResetByTime) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConditionsResetByTime: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConditionsResetByTime: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cron", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cron = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timezone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Timezone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConditionsResetCriteria) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConditionsResetCriteria: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConditionsResetCriteria: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ByTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ByTime == nil {
+				m.ByTime = &ConditionsResetByTime{}
+			}
+			if err := m.ByTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CustomTrigger) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CustomTrigger: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CustomTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServerURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Secure", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Secure = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CertSecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CertSecret == nil {
+				m.CertSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.CertSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerNameOverride", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServerNameOverride = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Spec == nil {
+				m.Spec = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Spec[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parameters = append(m.Parameters, TriggerParameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload, TriggerParameter{})
+			if err := m.Payload[len(m.Payload)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DataFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DataFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DataFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = JSONType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Comparator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Comparator = Comparator(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Template = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeadLetter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeadLetter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeadLetter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventBus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EventBus == nil {
+				m.EventBus = &EventBusDeadLetterSink{}
+			}
+			if err := m.EventBus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HTTP", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HTTP == nil {
+				m.HTTP = &HTTPDeadLetterSink{}
+			}
+			if err := m.HTTP.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JetStream", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.JetStream == nil {
+				m.JetStream = &JetStreamDeadLetterSink{}
+			}
+			if err := m.JetStream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Event) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Event: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Event: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Context == nil {
+				m.Context = &EventContext{}
+			}
+			if err := m.Context.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBusDeadLetterSink) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBusDeadLetterSink: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBusDeadLetterSink: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subject = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventContext) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventContext: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventContext: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpecVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataContentType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataContentType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subject = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Time.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDependency) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDependency: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDependency: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventSourceName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventSourceName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Filters == nil {
+				m.Filters = &EventDependencyFilter{}
+			}
+			if err := m.Filters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Transform == nil {
+				m.Transform = &EventDependencyTransformer{}
+			}
+			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FiltersLogicalOperator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FiltersLogicalOperator = LogicalOperator(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schema = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SensitiveFields", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SensitiveFields = append(m.SensitiveFields, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDependencyFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDependencyFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDependencyFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Time == nil {
+				m.Time = &TimeFilter{}
+			}
+			if err := m.Time.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Context == nil {
+				m.Context = &EventContext{}
+			}
+			if err := m.Context.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data, DataFilter{})
+			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exprs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Exprs = append(m.Exprs, ExprFilter{})
+			if err := m.Exprs[len(m.Exprs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataLogicalOperator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataLogicalOperator = LogicalOperator(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExprLogicalOperator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExprLogicalOperator = LogicalOperator(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDependencyTransformer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDependencyTransformer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDependencyTransformer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JQ", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JQ = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Script", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Script = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExprFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExprFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExprFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Expr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fields = append(m.Fields, PayloadField{})
+			if err := m.Fields[len(m.Fields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FileArtifact) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FileArtifact: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FileArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GCPCloudFunctionBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GCPCloudFunctionBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GCPCloudFunctionBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSize", wireType)
+			}
+			m.MaxSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxWait", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxWait = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GCPCloudFunctionResponseLogging) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GCPCloudFunctionResponseLogging: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GCPCloudFunctionResponseLogging: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Level = LogLevel(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redact", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Redact = append(m.Redact, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GCPCloudFunctionTrigger) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GCPCloudFunctionTrigger: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GCPCloudFunctionTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunctionName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunctionName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialsPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CredentialsPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload, TriggerParameter{})
+			if err := m.Payload[len(m.Payload)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parameters = append(m.Parameters, TriggerParameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryStrategy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetryStrategy == nil {
+				m.RetryStrategy = &common.Backoff{}
+			}
+			if err := m.RetryStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialsSecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CredentialsSecret == nil {
+				m.CredentialsSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.CredentialsSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Encoding", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Encoding = GCPCloudFunctionEncoding(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CACertificate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CACertificate == nil {
+				m.CACertificate = &v1.SecretKeySelector{}
+			}
+			if err := m.CACertificate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProxyURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProxyURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Batch == nil {
+				m.Batch = &GCPCloudFunctionBatch{}
+			}
+			if err := m.Batch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Generation", wireType)
+			}
+			m.Generation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Generation |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Location", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AWSLambdaTrigger: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AWSLambdaTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Location = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Envelope", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Envelope = bool(v != 0)
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FunctionName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Invocation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Invocation = GCPCloudFunctionInvocation(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6226,11 +14455,158 @@ func (m *AWSLambdaTrigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FunctionName = string(dAtA[iNdEx:postIndex])
+			m.Topic = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Async", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Async = bool(v != 0)
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Headers[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 2:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecureHeaders", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6257,16 +14633,14 @@ func (m *AWSLambdaTrigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AccessKey == nil {
-				m.AccessKey = &v1.SecretKeySelector{}
-			}
-			if err := m.AccessKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SecureHeaders = append(m.SecureHeaders, &common.SecureHeader{})
+			if err := m.SecureHeaders[len(m.SecureHeaders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecretKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseLogging", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6293,16 +14667,16 @@ func (m *AWSLambdaTrigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SecretKey == nil {
-				m.SecretKey = &v1.SecretKeySelector{}
+			if m.ResponseLogging == nil {
+				m.ResponseLogging = &GCPCloudFunctionResponseLogging{}
 			}
-			if err := m.SecretKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ResponseLogging.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6330,79 +14704,11 @@ func (m *AWSLambdaTrigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Region = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Payload = append(m.Payload, TriggerParameter{})
-			if err := m.Payload[len(m.Payload)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Parameters = append(m.Parameters, TriggerParameter{})
-			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Transform = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InvocationType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ImpersonateServiceAccount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6430,12 +14736,11 @@ func (m *AWSLambdaTrigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.InvocationType = &s
+			m.ImpersonateServiceAccount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RoleARN", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ImpersonationDelegates", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6463,61 +14768,11 @@ func (m *AWSLambdaTrigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RoleARN = string(dAtA[iNdEx:postIndex])
+			m.ImpersonationDelegates = append(m.ImpersonationDelegates, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ArgoWorkflowTrigger) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ArgoWorkflowTrigger: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArgoWorkflowTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 24:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Credentials", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6544,16 +14799,109 @@ func (m *ArgoWorkflowTrigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Source == nil {
-				m.Source = &ArtifactLocation{}
+			if m.Credentials == nil {
+				m.Credentials = make(map[string]v1.SecretKeySelector)
 			}
-			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var mapkey string
+			mapvalue := &v1.SecretKeySelector{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &v1.SecretKeySelector{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Credentials[mapkey] = *mapvalue
 			iNdEx = postIndex
-		case 2:
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialsKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6581,13 +14929,32 @@ func (m *ArgoWorkflowTrigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operation = ArgoWorkflowOperation(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			m.CredentialsKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxResponseSize", wireType)
+			}
+			m.MaxResponseSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxResponseSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 27:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserAgent", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6597,29 +14964,27 @@ func (m *ArgoWorkflowTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Parameters = append(m.Parameters, TriggerParameter{})
-			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.UserAgent = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 28:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryOnResponse", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6647,7 +15012,7 @@ func (m *ArgoWorkflowTrigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			m.RetryOnResponse = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6670,7 +15035,7 @@ func (m *ArgoWorkflowTrigger) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
+func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6693,17 +15058,17 @@ func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ArtifactLocation: wiretype end group for non-group")
+			return fmt.Errorf("proto: GitArtifact: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArtifactLocation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GitArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field S3", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6713,31 +15078,27 @@ func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.S3 == nil {
-				m.S3 = &common.S3Artifact{}
-			}
-			if err := m.S3.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CloneDirectory", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6765,12 +15126,11 @@ func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Inline = &s
+			m.CloneDirectory = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creds", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6797,16 +15157,16 @@ func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.File == nil {
-				m.File = &FileArtifact{}
+			if m.Creds == nil {
+				m.Creds = &GitCreds{}
 			}
-			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Creds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SSHKeySecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6833,18 +15193,18 @@ func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.URL == nil {
-				m.URL = &URLArtifact{}
+			if m.SSHKeySecret == nil {
+				m.SSHKeySecret = &v1.SecretKeySelector{}
 			}
-			if err := m.URL.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SSHKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Configmap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FilePath", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6854,33 +15214,61 @@ func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Configmap == nil {
-				m.Configmap = &v1.ConfigMapKeySelector{}
+			m.FilePath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
-			if err := m.Configmap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Branch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Git", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6890,31 +15278,59 @@ func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Git == nil {
-				m.Git = &GitArtifact{}
+			m.Tag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ref", wireType)
 			}
-			if err := m.Git.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ref = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Remote", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6941,10 +15357,10 @@ func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Resource == nil {
-				m.Resource = &common.Resource{}
+			if m.Remote == nil {
+				m.Remote = &GitRemoteConfig{}
 			}
-			if err := m.Resource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Remote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6969,7 +15385,7 @@ func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AzureEventHubsTrigger) Unmarshal(dAtA []byte) error {
+func (m *GitCreds) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6992,17 +15408,17 @@ func (m *AzureEventHubsTrigger) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AzureEventHubsTrigger: wiretype end group for non-group")
+			return fmt.Errorf("proto: GitCreds: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AzureEventHubsTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GitCreds: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FQDN", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7012,59 +15428,31 @@ func (m *AzureEventHubsTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FQDN = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HubName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if m.Username == nil {
+				m.Username = &v1.SecretKeySelector{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Username.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.HubName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SharedAccessKeyName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7091,54 +15479,68 @@ func (m *AzureEventHubsTrigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SharedAccessKeyName == nil {
-				m.SharedAccessKeyName = &v1.SecretKeySelector{}
+			if m.Password == nil {
+				m.Password = &v1.SecretKeySelector{}
 			}
-			if err := m.SharedAccessKeyName.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Password.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SharedAccessKey", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SharedAccessKey == nil {
-				m.SharedAccessKey = &v1.SecretKeySelector{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GitRemoteConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if err := m.SharedAccessKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GitRemoteConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GitRemoteConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7148,31 +15550,29 @@ func (m *AzureEventHubsTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Payload = append(m.Payload, TriggerParameter{})
-			if err := m.Payload[len(m.Payload)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URLS", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7182,25 +15582,23 @@ func (m *AzureEventHubsTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Parameters = append(m.Parameters, TriggerParameter{})
-			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.URLS = append(m.URLS, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7223,7 +15621,7 @@ func (m *AzureEventHubsTrigger) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConditionsResetByTime) Unmarshal(dAtA []byte) error {
+func (m *HTTPDeadLetterSink) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7246,15 +15644,15 @@ func (m *ConditionsResetByTime) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConditionsResetByTime: wiretype end group for non-group")
+			return fmt.Errorf("proto: HTTPDeadLetterSink: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConditionsResetByTime: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HTTPDeadLetterSink: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cron", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7282,11 +15680,174 @@ func (m *ConditionsResetByTime) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cron = string(dAtA[iNdEx:postIndex])
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Headers[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TLS == nil {
+				m.TLS = &common.TLSConfig{}
+			}
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timezone", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7314,7 +15875,7 @@ func (m *ConditionsResetByTime) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Timezone = string(dAtA[iNdEx:postIndex])
+			m.Timeout = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7337,7 +15898,7 @@ func (m *ConditionsResetByTime) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConditionsResetCriteria) Unmarshal(dAtA []byte) error {
+func (m *HTTPTrigger) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7360,17 +15921,17 @@ func (m *ConditionsResetCriteria) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConditionsResetCriteria: wiretype end group for non-group")
+			return fmt.Errorf("proto: HTTPTrigger: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConditionsResetCriteria: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HTTPTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ByTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7380,83 +15941,63 @@ func (m *ConditionsResetCriteria) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ByTime == nil {
-				m.ByTime = &ConditionsResetByTime{}
-			}
-			if err := m.ByTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CustomTrigger) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Payload = append(m.Payload, TriggerParameter{})
+			if err := m.Payload[len(m.Payload)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CustomTrigger: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CustomTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServerURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7466,29 +16007,33 @@ func (m *CustomTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServerURL = string(dAtA[iNdEx:postIndex])
+			if m.TLS == nil {
+				m.TLS = &common.TLSConfig{}
+			}
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Secure", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7498,15 +16043,27 @@ func (m *CustomTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Secure = bool(v != 0)
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CertSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7533,18 +16090,35 @@ func (m *CustomTrigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CertSecret == nil {
-				m.CertSecret = &v1.SecretKeySelector{}
-			}
-			if err := m.CertSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Parameters = append(m.Parameters, TriggerParameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServerNameOverride", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BasicAuth", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7554,27 +16128,31 @@ func (m *CustomTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServerNameOverride = string(dAtA[iNdEx:postIndex])
+			if m.BasicAuth == nil {
+				m.BasicAuth = &common.BasicAuth{}
+			}
+			if err := m.BasicAuth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7601,8 +16179,8 @@ func (m *CustomTrigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Spec == nil {
-				m.Spec = make(map[string]string)
+			if m.Headers == nil {
+				m.Headers = make(map[string]string)
 			}
 			var mapkey string
 			var mapvalue string
@@ -7697,11 +16275,64 @@ func (m *CustomTrigger) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Spec[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 6:
+			m.Headers[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecureHeaders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecureHeaders = append(m.SecureHeaders, &common.SecureHeader{})
+			if err := m.SecureHeaders[len(m.SecureHeaders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxResponseSize", wireType)
+			}
+			m.MaxResponseSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxResponseSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryStrategy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7728,14 +16359,130 @@ func (m *CustomTrigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Parameters = append(m.Parameters, TriggerParameter{})
-			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.RetryStrategy == nil {
+				m.RetryStrategy = &common.Backoff{}
+			}
+			if err := m.RetryStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryOn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetryOn = append(m.RetryOn, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Idempotency) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Idempotency: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Idempotency: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TTL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TTL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JetStream", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7762,8 +16509,10 @@ func (m *CustomTrigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Payload = append(m.Payload, TriggerParameter{})
-			if err := m.Payload[len(m.Payload)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.JetStream == nil {
+				m.JetStream = &JetStreamIdempotencyStore{}
+			}
+			if err := m.JetStream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7788,7 +16537,7 @@ func (m *CustomTrigger) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DataFilter) Unmarshal(dAtA []byte) error {
+func (m *JetStreamDeadLetterSink) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7811,15 +16560,15 @@ func (m *DataFilter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DataFilter: wiretype end group for non-group")
+			return fmt.Errorf("proto: JetStreamDeadLetterSink: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DataFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JetStreamDeadLetterSink: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7847,11 +16596,11 @@ func (m *DataFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7879,11 +16628,11 @@ func (m *DataFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = JSONType(dAtA[iNdEx:postIndex])
+			m.Subject = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7911,11 +16660,97 @@ func (m *DataFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value, string(dAtA[iNdEx:postIndex]))
+			m.Stream = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Comparator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TLS == nil {
+				m.TLS = &common.TLSConfig{}
+			}
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JetStreamIdempotencyStore) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JetStreamIdempotencyStore: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JetStreamIdempotencyStore: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7943,11 +16778,11 @@ func (m *DataFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Comparator = Comparator(dAtA[iNdEx:postIndex])
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7968,14 +16803,50 @@ func (m *DataFilter) Unmarshal(dAtA []byte) error {
 			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bucket = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Template = string(dAtA[iNdEx:postIndex])
+			if m.TLS == nil {
+				m.TLS = &common.TLSConfig{}
+			}
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7998,7 +16869,7 @@ func (m *DataFilter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Event) Unmarshal(dAtA []byte) error {
+func (m *K8SResourcePolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8021,15 +16892,15 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Event: wiretype end group for non-group")
+			return fmt.Errorf("proto: K8SResourcePolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Event: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: K8SResourcePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8056,18 +16927,109 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Context == nil {
-				m.Context = &EventContext{}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
 			}
-			if err := m.Context.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Backoff", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8077,26 +17039,48 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			if m.Backoff == nil {
+				m.Backoff = &common.Backoff{}
+			}
+			if err := m.Backoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorOnBackoffTimeout", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ErrorOnBackoffTimeout = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -8118,7 +17102,7 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventContext) Unmarshal(dAtA []byte) error {
+func (m *KafkaTrigger) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8141,15 +17125,15 @@ func (m *EventContext) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventContext: wiretype end group for non-group")
+			return fmt.Errorf("proto: KafkaTrigger: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventContext: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KafkaTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8177,11 +17161,11 @@ func (m *EventContext) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8209,13 +17193,13 @@ func (m *EventContext) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Source = string(dAtA[iNdEx:postIndex])
+			m.Topic = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpecVersion", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
 			}
-			var stringLen uint64
+			m.Partition = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8225,29 +17209,16 @@ func (m *EventContext) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Partition |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SpecVersion = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8257,29 +17228,31 @@ func (m *EventContext) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.Parameters = append(m.Parameters, TriggerParameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataContentType", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredAcks", wireType)
 			}
-			var stringLen uint64
+			m.RequiredAcks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8289,29 +17262,16 @@ func (m *EventContext) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.RequiredAcks |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DataContentType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compress", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8321,27 +17281,34 @@ func (m *EventContext) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			m.Compress = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlushFrequency", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.FlushFrequency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FlushFrequency |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Subject = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8368,65 +17335,18 @@ func (m *EventContext) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Time.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.TLS == nil {
+				m.TLS = &common.TLSConfig{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventDependency) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventDependency: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDependency: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8436,27 +17356,29 @@ func (m *EventDependency) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Payload = append(m.Payload, TriggerParameter{})
+			if err := m.Payload[len(m.Payload)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventSourceName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitioningKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8484,11 +17406,11 @@ func (m *EventDependency) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EventSourceName = string(dAtA[iNdEx:postIndex])
+			m.PartitioningKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8516,11 +17438,11 @@ func (m *EventDependency) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EventName = string(dAtA[iNdEx:postIndex])
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SASL", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8547,18 +17469,18 @@ func (m *EventDependency) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Filters == nil {
-				m.Filters = &EventDependencyFilter{}
+			if m.SASL == nil {
+				m.SASL = &common.SASLConfig{}
 			}
-			if err := m.Filters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SASL.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Idempotent", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8568,31 +17490,15 @@ func (m *EventDependency) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Transform == nil {
-				m.Transform = &EventDependencyTransformer{}
-			}
-			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
+			m.Idempotent = bool(v != 0)
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FiltersLogicalOperator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitioningKeyTemplate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8620,7 +17526,7 @@ func (m *EventDependency) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FiltersLogicalOperator = LogicalOperator(dAtA[iNdEx:postIndex])
+			m.PartitioningKeyTemplate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8643,7 +17549,7 @@ func (m *EventDependency) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventDependencyFilter) Unmarshal(dAtA []byte) error {
+func (m *LogTrigger) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8666,17 +17572,17 @@ func (m *EventDependencyFilter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventDependencyFilter: wiretype end group for non-group")
+			return fmt.Errorf("proto: LogTrigger: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDependencyFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LogTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntervalSeconds", wireType)
 			}
-			var msglen int
+			m.IntervalSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8686,33 +17592,16 @@ func (m *EventDependencyFilter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.IntervalSeconds |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Time == nil {
-				m.Time = &TimeFilter{}
-			}
-			if err := m.Time.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8722,65 +17611,27 @@ func (m *EventDependencyFilter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Context == nil {
-				m.Context = &EventContext{}
-			}
-			if err := m.Context.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Level = LogLevel(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Data = append(m.Data, DataFilter{})
-			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exprs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8807,48 +17658,16 @@ func (m *EventDependencyFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Exprs = append(m.Exprs, ExprFilter{})
-			if err := m.Exprs[len(m.Exprs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataLogicalOperator", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DataLogicalOperator = LogicalOperator(dAtA[iNdEx:postIndex])
+			m.Payload = append(m.Payload, TriggerParameter{})
+			if err := m.Payload[len(m.Payload)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExprLogicalOperator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8858,23 +17677,25 @@ func (m *EventDependencyFilter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExprLogicalOperator = LogicalOperator(dAtA[iNdEx:postIndex])
+			m.Parameters = append(m.Parameters, TriggerParameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8897,7 +17718,7 @@ func (m *EventDependencyFilter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventDependencyTransformer) Unmarshal(dAtA []byte) error {
+func (m *NATSTrigger) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8920,15 +17741,15 @@ func (m *EventDependencyTransformer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventDependencyTransformer: wiretype end group for non-group")
+			return fmt.Errorf("proto: NATSTrigger: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDependencyTransformer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NATSTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JQ", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8956,11 +17777,11 @@ func (m *EventDependencyTransformer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JQ = string(dAtA[iNdEx:postIndex])
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Script", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8988,63 +17809,13 @@ func (m *EventDependencyTransformer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Script = string(dAtA[iNdEx:postIndex])
+			m.Subject = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ExprFilter) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ExprFilter: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExprFilter: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9054,27 +17825,29 @@ func (m *ExprFilter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Expr = string(dAtA[iNdEx:postIndex])
+			m.Payload = append(m.Payload, TriggerParameter{})
+			if err := m.Payload[len(m.Payload)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9101,66 +17874,16 @@ func (m *ExprFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fields = append(m.Fields, PayloadField{})
-			if err := m.Fields[len(m.Fields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Parameters = append(m.Parameters, TriggerParameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *FileArtifact) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: FileArtifact: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FileArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9170,23 +17893,27 @@ func (m *FileArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			if m.TLS == nil {
+				m.TLS = &common.TLSConfig{}
+			}
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9209,7 +17936,7 @@ func (m *FileArtifact) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GitArtifact) Unmarshal(dAtA []byte) error {
+func (m *OpenWhiskTrigger) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9232,81 +17959,17 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GitArtifact: wiretype end group for non-group")
+			return fmt.Errorf("proto: OpenWhiskTrigger: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GitArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.URL = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CloneDirectory", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CloneDirectory = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			return fmt.Errorf("proto: OpenWhiskTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9316,33 +17979,29 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Creds == nil {
-				m.Creds = &GitCreds{}
-			}
-			if err := m.Creds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Host = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SSHKeySecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9352,31 +18011,27 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SSHKeySecret == nil {
-				m.SSHKeySecret = &v1.SecretKeySelector{}
-			}
-			if err := m.SSHKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FilePath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9404,13 +18059,13 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FilePath = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthToken", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9420,27 +18075,31 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Branch = string(dAtA[iNdEx:postIndex])
+			if m.AuthToken == nil {
+				m.AuthToken = &v1.SecretKeySelector{}
+			}
+			if err := m.AuthToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9468,13 +18127,13 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tag = string(dAtA[iNdEx:postIndex])
+			m.ActionName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ref", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9484,27 +18143,29 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ref = string(dAtA[iNdEx:postIndex])
+			m.Payload = append(m.Payload, TriggerParameter{})
+			if err := m.Payload[len(m.Payload)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Remote", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9531,10 +18192,8 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Remote == nil {
-				m.Remote = &GitRemoteConfig{}
-			}
-			if err := m.Remote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Parameters = append(m.Parameters, TriggerParameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9559,7 +18218,7 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GitCreds) Unmarshal(dAtA []byte) error {
+func (m *PayloadField) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9582,17 +18241,17 @@ func (m *GitCreds) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GitCreds: wiretype end group for non-group")
+			return fmt.Errorf("proto: PayloadField: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GitCreds: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PayloadField: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9602,33 +18261,29 @@ func (m *GitCreds) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Username == nil {
-				m.Username = &v1.SecretKeySelector{}
-			}
-			if err := m.Username.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9638,27 +18293,23 @@ func (m *GitCreds) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Password == nil {
-				m.Password = &v1.SecretKeySelector{}
-			}
-			if err := m.Password.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9681,7 +18332,7 @@ func (m *GitCreds) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GitRemoteConfig) Unmarshal(dAtA []byte) error {
+func (m *PulsarTrigger) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9704,15 +18355,15 @@ func (m *GitRemoteConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GitRemoteConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: PulsarTrigger: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GitRemoteConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PulsarTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9740,11 +18391,11 @@ func (m *GitRemoteConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URLS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9772,63 +18423,81 @@ func (m *GitRemoteConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URLS = append(m.URLS, string(dAtA[iNdEx:postIndex]))
+			m.Topic = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *HTTPTrigger) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			m.Parameters = append(m.Parameters, TriggerParameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: HTTPTrigger: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HTTPTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload, TriggerParameter{})
+			if err := m.Payload[len(m.Payload)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSTrustCertsSecret", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9838,27 +18507,71 @@ func (m *HTTPTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			if m.TLSTrustCertsSecret == nil {
+				m.TLSTrustCertsSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.TLSTrustCertsSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSAllowInsecureConnection", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TLSAllowInsecureConnection = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSValidateHostname", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TLSValidateHostname = bool(v != 0)
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9885,14 +18598,16 @@ func (m *HTTPTrigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Payload = append(m.Payload, TriggerParameter{})
-			if err := m.Payload[len(m.Payload)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.TLS == nil {
+				m.TLS = &common.TLSConfig{}
+			}
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthTokenSecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9919,18 +18634,18 @@ func (m *HTTPTrigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TLS == nil {
-				m.TLS = &common.TLSConfig{}
+			if m.AuthTokenSecret == nil {
+				m.AuthTokenSecret = &v1.SecretKeySelector{}
 			}
-			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AuthTokenSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionBackoff", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9940,27 +18655,31 @@ func (m *HTTPTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Method = string(dAtA[iNdEx:postIndex])
+			if m.ConnectionBackoff == nil {
+				m.ConnectionBackoff = &common.Backoff{}
+			}
+			if err := m.ConnectionBackoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Properties", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9987,16 +18706,159 @@ func (m *HTTPTrigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Parameters = append(m.Parameters, TriggerParameter{})
-			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Properties == nil {
+				m.Properties = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Properties[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Timeout = 0
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PushgatewayMetric) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PushgatewayMetric: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PushgatewayMetric: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10006,16 +18868,29 @@ func (m *HTTPTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timeout |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BasicAuth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Help", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10025,31 +18900,27 @@ func (m *HTTPTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BasicAuth == nil {
-				m.BasicAuth = &common.BasicAuth{}
-			}
-			if err := m.BasicAuth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Help = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10076,8 +18947,8 @@ func (m *HTTPTrigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Headers == nil {
-				m.Headers = make(map[string]string)
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
 			}
 			var mapkey string
 			var mapvalue string
@@ -10172,13 +19043,13 @@ func (m *HTTPTrigger) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Headers[mapkey] = mapvalue
+			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 9:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecureHeaders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10188,25 +19059,23 @@ func (m *HTTPTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SecureHeaders = append(m.SecureHeaders, &common.SecureHeader{})
-			if err := m.SecureHeaders[len(m.SecureHeaders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10229,7 +19098,7 @@ func (m *HTTPTrigger) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *K8SResourcePolicy) Unmarshal(dAtA []byte) error {
+func (m *PushgatewayTrigger) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10252,15 +19121,79 @@ func (m *K8SResourcePolicy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: K8SResourcePolicy: wiretype end group for non-group")
+			return fmt.Errorf("proto: PushgatewayTrigger: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: K8SResourcePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PushgatewayTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Job = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grouping", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10287,8 +19220,8 @@ func (m *K8SResourcePolicy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
+			if m.Grouping == nil {
+				m.Grouping = make(map[string]string)
 			}
 			var mapkey string
 			var mapvalue string
@@ -10383,13 +19316,187 @@ func (m *K8SResourcePolicy) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Labels[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 2:
+			m.Grouping[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metrics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metrics = append(m.Metrics, PushgatewayMetric{})
+			if err := m.Metrics[len(m.Metrics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replace", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Replace = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasicAuth", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BasicAuth == nil {
+				m.BasicAuth = &common.BasicAuth{}
+			}
+			if err := m.BasicAuth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parameters = append(m.Parameters, TriggerParameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Backoff", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Unit", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10399,33 +19506,48 @@ func (m *K8SResourcePolicy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Backoff == nil {
-				m.Backoff = &common.Backoff{}
+			m.Unit = RateLimiteUnit(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestsPerUnit", wireType)
 			}
-			if err := m.Backoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.RequestsPerUnit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestsPerUnit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ErrorOnBackoffTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Burst", wireType)
 			}
-			var v int
+			m.Burst = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10435,12 +19557,43 @@ func (m *K8SResourcePolicy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Burst |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ErrorOnBackoffTimeout = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Overflow = RateLimitOverflow(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -10462,7 +19615,7 @@ func (m *K8SResourcePolicy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KafkaTrigger) Unmarshal(dAtA []byte) error {
+func (m *RedisStreamTrigger) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10485,15 +19638,15 @@ func (m *KafkaTrigger) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KafkaTrigger: wiretype end group for non-group")
+			return fmt.Errorf("proto: RedisStreamTrigger: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KafkaTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RedisStreamTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HostAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10521,13 +19674,13 @@ func (m *KafkaTrigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			m.HostAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10537,29 +19690,33 @@ func (m *KafkaTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Topic = string(dAtA[iNdEx:postIndex])
+			if m.Password == nil {
+				m.Password = &v1.SecretKeySelector{}
+			}
+			if err := m.Password.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DB", wireType)
 			}
-			m.Partition = 0
+			m.DB = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10569,14 +19726,14 @@ func (m *KafkaTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Partition |= int32(b&0x7F) << shift
+				m.DB |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10603,16 +19760,18 @@ func (m *KafkaTrigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Parameters = append(m.Parameters, TriggerParameter{})
-			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.TLS == nil {
+				m.TLS = &common.TLSConfig{}
+			}
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequiredAcks", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
 			}
-			m.RequiredAcks = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10622,36 +19781,29 @@ func (m *KafkaTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RequiredAcks |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Compress", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.Compress = bool(v != 0)
-		case 7:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stream = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FlushFrequency", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLen", wireType)
 			}
-			m.FlushFrequency = 0
+			m.MaxLen = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10661,14 +19813,14 @@ func (m *KafkaTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FlushFrequency |= int32(b&0x7F) << shift
+				m.MaxLen |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10695,16 +19847,14 @@ func (m *KafkaTrigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TLS == nil {
-				m.TLS = &common.TLSConfig{}
-			}
-			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Payload = append(m.Payload, TriggerParameter{})
+			if err := m.Payload[len(m.Payload)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10731,16 +19881,66 @@ func (m *KafkaTrigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Payload = append(m.Payload, TriggerParameter{})
-			if err := m.Payload[len(m.Payload)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Parameters = append(m.Parameters, TriggerParameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Sensor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Sensor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Sensor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PartitioningKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10750,29 +19950,30 @@ func (m *KafkaTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PartitioningKey = string(dAtA[iNdEx:postIndex])
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 11:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10782,27 +19983,28 @@ func (m *KafkaTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 12:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SASL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10829,10 +20031,7 @@ func (m *KafkaTrigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SASL == nil {
-				m.SASL = &common.SASLConfig{}
-			}
-			if err := m.SASL.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10857,7 +20056,7 @@ func (m *KafkaTrigger) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LogTrigger) Unmarshal(dAtA []byte) error {
+func (m *SensorList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10880,17 +20079,17 @@ func (m *LogTrigger) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LogTrigger: wiretype end group for non-group")
+			return fmt.Errorf("proto: SensorList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LogTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SensorList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IntervalSeconds", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
-			m.IntervalSeconds = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10900,11 +20099,59 @@ func (m *LogTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.IntervalSeconds |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, Sensor{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -10926,7 +20173,7 @@ func (m *LogTrigger) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NATSTrigger) Unmarshal(dAtA []byte) error {
+func (m *SensorSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10949,17 +20196,17 @@ func (m *NATSTrigger) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NATSTrigger: wiretype end group for non-group")
+			return fmt.Errorf("proto: SensorSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NATSTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SensorSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dependencies", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10969,29 +20216,31 @@ func (m *NATSTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			m.Dependencies = append(m.Dependencies, EventDependency{})
+			if err := m.Dependencies[len(m.Dependencies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Triggers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11001,27 +20250,29 @@ func (m *NATSTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Subject = string(dAtA[iNdEx:postIndex])
+			m.Triggers = append(m.Triggers, Trigger{})
+			if err := m.Triggers[len(m.Triggers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11048,16 +20299,18 @@ func (m *NATSTrigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Payload = append(m.Payload, TriggerParameter{})
-			if err := m.Payload[len(m.Payload)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Template == nil {
+				m.Template = &Template{}
+			}
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorOnFailedRound", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11067,31 +20320,17 @@ func (m *NATSTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Parameters = append(m.Parameters, TriggerParameter{})
-			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.ErrorOnFailedRound = bool(v != 0)
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EventBusName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11101,83 +20340,49 @@ func (m *NATSTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TLS == nil {
-				m.TLS = &common.TLSConfig{}
-			}
-			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.EventBusName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *OpenWhiskTrigger) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: OpenWhiskTrigger: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OpenWhiskTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Replicas = &v
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Idempotency", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11187,29 +20392,33 @@ func (m *OpenWhiskTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Host = string(dAtA[iNdEx:postIndex])
+			if m.Idempotency == nil {
+				m.Idempotency = &Idempotency{}
+			}
+			if err := m.Idempotency.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerConcurrency", wireType)
 			}
-			var stringLen uint64
+			m.TriggerConcurrency = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11219,27 +20428,14 @@ func (m *OpenWhiskTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TriggerConcurrency |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Version = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeliverySemantics", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11267,11 +20463,11 @@ func (m *OpenWhiskTrigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.DeliverySemantics = DeliverySemantics(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadLetter", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11298,16 +20494,35 @@ func (m *OpenWhiskTrigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AuthToken == nil {
-				m.AuthToken = &v1.SecretKeySelector{}
+			if m.DeadLetter == nil {
+				m.DeadLetter = &DeadLetter{}
 			}
-			if err := m.AuthToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DeadLetter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxInFlightEvents", wireType)
+			}
+			m.MaxInFlightEvents = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxInFlightEvents |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActionName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11335,13 +20550,13 @@ func (m *OpenWhiskTrigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActionName = string(dAtA[iNdEx:postIndex])
+			m.PartitionKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxActivePartitions", wireType)
 			}
-			var msglen int
+			m.MaxActivePartitions = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11351,31 +20566,16 @@ func (m *OpenWhiskTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxActivePartitions |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Payload = append(m.Payload, TriggerParameter{})
-			if err := m.Payload[len(m.Payload)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SensitiveFields", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11385,25 +20585,23 @@ func (m *OpenWhiskTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Parameters = append(m.Parameters, TriggerParameter{})
-			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SensitiveFields = append(m.SensitiveFields, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11426,7 +20624,7 @@ func (m *OpenWhiskTrigger) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PayloadField) Unmarshal(dAtA []byte) error {
+func (m *SensorStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11449,17 +20647,17 @@ func (m *PayloadField) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PayloadField: wiretype end group for non-group")
+			return fmt.Errorf("proto: SensorStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PayloadField: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SensorStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11469,55 +20667,24 @@ func (m *PayloadField) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11540,7 +20707,7 @@ func (m *PayloadField) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PulsarTrigger) Unmarshal(dAtA []byte) error {
+func (m *SlackTrigger) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11563,15 +20730,85 @@ func (m *PulsarTrigger) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PulsarTrigger: wiretype end group for non-group")
+			return fmt.Errorf("proto: SlackTrigger: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PulsarTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SlackTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parameters = append(m.Parameters, TriggerParameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlackToken", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SlackToken == nil {
+				m.SlackToken = &v1.SecretKeySelector{}
+			}
+			if err := m.SlackToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11599,11 +20836,11 @@ func (m *PulsarTrigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			m.Channel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11631,13 +20868,13 @@ func (m *PulsarTrigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Topic = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11647,31 +20884,29 @@ func (m *PulsarTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Parameters = append(m.Parameters, TriggerParameter{})
-			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Blocks = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ThreadTS", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11681,29 +20916,77 @@ func (m *PulsarTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Payload = append(m.Payload, TriggerParameter{})
-			if err := m.Payload[len(m.Payload)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ThreadTS = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 5:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StandardK8STrigger) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StandardK8STrigger: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StandardK8STrigger: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLSTrustCertsSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11730,18 +21013,18 @@ func (m *PulsarTrigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TLSTrustCertsSecret == nil {
-				m.TLSTrustCertsSecret = &v1.SecretKeySelector{}
+			if m.Source == nil {
+				m.Source = &ArtifactLocation{}
 			}
-			if err := m.TLSTrustCertsSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLSAllowInsecureConnection", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11751,35 +21034,27 @@ func (m *PulsarTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.TLSAllowInsecureConnection = bool(v != 0)
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLSValidateHostname", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.TLSValidateHostname = bool(v != 0)
-		case 8:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operation = KubernetesResourceOperation(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11806,18 +21081,16 @@ func (m *PulsarTrigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TLS == nil {
-				m.TLS = &common.TLSConfig{}
-			}
-			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Parameters = append(m.Parameters, TriggerParameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthTokenSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PatchStrategy", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11827,33 +21100,29 @@ func (m *PulsarTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AuthTokenSecret == nil {
-				m.AuthTokenSecret = &v1.SecretKeySelector{}
-			}
-			if err := m.AuthTokenSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PatchStrategy = k8s_io_apimachinery_pkg_types.PatchType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionBackoff", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiveObject", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11863,28 +21132,12 @@ func (m *PulsarTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ConnectionBackoff == nil {
-				m.ConnectionBackoff = &common.Backoff{}
-			}
-			if err := m.ConnectionBackoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.LiveObject = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -11906,7 +21159,7 @@ func (m *PulsarTrigger) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RateLimit) Unmarshal(dAtA []byte) error {
+func (m *StatusPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11925,19 +21178,95 @@ func (m *RateLimit) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RateLimit: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StatusPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StatusPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Allow = append(m.Allow, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenerated
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenerated
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Allow) == 0 {
+					m.Allow = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Allow = append(m.Allow, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allow", wireType)
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Unit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowRanges", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11965,27 +21294,8 @@ func (m *RateLimit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Unit = RateLimiteUnit(dAtA[iNdEx:postIndex])
+			m.AllowRanges = append(m.AllowRanges, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestsPerUnit", wireType)
-			}
-			m.RequestsPerUnit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RequestsPerUnit |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -12007,7 +21317,7 @@ func (m *RateLimit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Sensor) Unmarshal(dAtA []byte) error {
+func (m *Template) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12030,15 +21340,15 @@ func (m *Sensor) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Sensor: wiretype end group for non-group")
+			return fmt.Errorf("proto: Template: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Sensor: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Template: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12065,15 +21375,18 @@ func (m *Sensor) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Metadata == nil {
+				m.Metadata = &common.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12083,28 +21396,27 @@ func (m *Sensor) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ServiceAccountName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12131,63 +21443,50 @@ func (m *Sensor) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Container == nil {
+				m.Container = &v1.Container{}
+			}
+			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volumes", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SensorList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Volumes = append(m.Volumes, v1.Volume{})
+			if err := m.Volumes[len(m.Volumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SensorList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SensorList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContext", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12214,13 +21513,16 @@ func (m *SensorList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.SecurityContext == nil {
+				m.SecurityContext = &v1.PodSecurityContext{}
+			}
+			if err := m.SecurityContext.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeSelector", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12247,64 +21549,107 @@ func (m *SensorList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, Sensor{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.NodeSelector == nil {
+				m.NodeSelector = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.NodeSelector[mapkey] = mapvalue
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SensorSpec) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SensorSpec: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SensorSpec: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dependencies", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tolerations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12331,14 +21676,14 @@ func (m *SensorSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Dependencies = append(m.Dependencies, EventDependency{})
-			if err := m.Dependencies[len(m.Dependencies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Tolerations = append(m.Tolerations, v1.Toleration{})
+			if err := m.Tolerations[len(m.Tolerations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Triggers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ImagePullSecrets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12365,16 +21710,16 @@ func (m *SensorSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Triggers = append(m.Triggers, Trigger{})
-			if err := m.Triggers[len(m.Triggers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ImagePullSecrets = append(m.ImagePullSecrets, v1.LocalObjectReference{})
+			if err := m.ImagePullSecrets[len(m.ImagePullSecrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityClassName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12384,33 +21729,29 @@ func (m *SensorSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Template == nil {
-				m.Template = &Template{}
-			}
-			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PriorityClassName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ErrorOnFailedRound", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
 			}
-			var v int
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12420,17 +21761,17 @@ func (m *SensorSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ErrorOnFailedRound = bool(v != 0)
-		case 5:
+			m.Priority = &v
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventBusName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Affinity", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12440,44 +21781,28 @@ func (m *SensorSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EventBusName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			if m.Affinity == nil {
+				m.Affinity = &v1.Affinity{}
 			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Affinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Replicas = &v
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -12499,7 +21824,7 @@ func (m *SensorSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SensorStatus) Unmarshal(dAtA []byte) error {
+func (m *TimeFilter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12522,17 +21847,17 @@ func (m *SensorStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SensorStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: TimeFilter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SensorStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TimeFilter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12542,24 +21867,55 @@ func (m *SensorStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Start = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stop", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Stop = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12582,7 +21938,7 @@ func (m *SensorStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SlackTrigger) Unmarshal(dAtA []byte) error {
+func (m *Trigger) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12605,15 +21961,15 @@ func (m *SlackTrigger) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SlackTrigger: wiretype end group for non-group")
+			return fmt.Errorf("proto: Trigger: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SlackTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Trigger: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12640,14 +21996,16 @@ func (m *SlackTrigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Parameters = append(m.Parameters, TriggerParameter{})
-			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Template == nil {
+				m.Template = &TriggerTemplate{}
+			}
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlackToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12674,18 +22032,16 @@ func (m *SlackTrigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SlackToken == nil {
-				m.SlackToken = &v1.SecretKeySelector{}
-			}
-			if err := m.SlackToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Parameters = append(m.Parameters, TriggerParameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12695,29 +22051,33 @@ func (m *SlackTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Channel = string(dAtA[iNdEx:postIndex])
+			if m.Policy == nil {
+				m.Policy = &TriggerPolicy{}
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryStrategy", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12727,77 +22087,31 @@ func (m *SlackTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Message = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *StandardK8STrigger) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.RetryStrategy == nil {
+				m.RetryStrategy = &common.Backoff{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.RetryStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: StandardK8STrigger: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StandardK8STrigger: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12824,18 +22138,18 @@ func (m *StandardK8STrigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Source == nil {
-				m.Source = &ArtifactLocation{}
+			if m.RateLimit == nil {
+				m.RateLimit = &RateLimit{}
 			}
-			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dedupe", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12845,27 +22159,31 @@ func (m *StandardK8STrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operation = KubernetesResourceOperation(dAtA[iNdEx:postIndex])
+			if m.Dedupe == nil {
+				m.Dedupe = &TriggerDedupe{}
+			}
+			if err := m.Dedupe.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreaker", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12892,14 +22210,16 @@ func (m *StandardK8STrigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Parameters = append(m.Parameters, TriggerParameter{})
-			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.CircuitBreaker == nil {
+				m.CircuitBreaker = &TriggerCircuitBreaker{}
+			}
+			if err := m.CircuitBreaker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PatchStrategy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CanaryGroup", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12927,11 +22247,30 @@ func (m *StandardK8STrigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PatchStrategy = k8s_io_apimachinery_pkg_types.PatchType(dAtA[iNdEx:postIndex])
+			m.CanaryGroup = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiveObject", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Critical", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -12948,133 +22287,75 @@ func (m *StandardK8STrigger) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.LiveObject = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			m.Critical = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redelivery", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *StatusPolicy) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			if m.Redelivery == nil {
+				m.Redelivery = &TriggerRedelivery{}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if err := m.Redelivery.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DependsOn", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: StatusPolicy: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StatusPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType == 0 {
-				var v int32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Allow = append(m.Allow, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthGenerated
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthGenerated
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Allow) == 0 {
-					m.Allow = make([]int32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Allow = append(m.Allow, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allow", wireType)
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DependsOn = append(m.DependsOn, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -13096,7 +22377,7 @@ func (m *StatusPolicy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Template) Unmarshal(dAtA []byte) error {
+func (m *TriggerCircuitBreaker) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13119,17 +22400,17 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Template: wiretype end group for non-group")
+			return fmt.Errorf("proto: TriggerCircuitBreaker: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Template: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TriggerCircuitBreaker: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureThreshold", wireType)
 			}
-			var msglen int
+			m.FailureThreshold = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13139,31 +22420,14 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.FailureThreshold |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Metadata == nil {
-				m.Metadata = &common.Metadata{}
-			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13191,13 +22455,13 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServiceAccountName = string(dAtA[iNdEx:postIndex])
+			m.Window = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cooldown", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13207,31 +22471,77 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Container == nil {
-				m.Container = &v1.Container{}
-			}
-			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Cooldown = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 4:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TriggerDedupe) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TriggerDedupe: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TriggerDedupe: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Volumes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13258,16 +22568,15 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Volumes = append(m.Volumes, v1.Volume{})
-			if err := m.Volumes[len(m.Volumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Key.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContext", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TTL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13277,33 +22586,29 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SecurityContext == nil {
-				m.SecurityContext = &v1.PodSecurityContext{}
-			}
-			if err := m.SecurityContext.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TTL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeSelector", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxKeys", wireType)
 			}
-			var msglen int
+			m.MaxKeys = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13313,122 +22618,64 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxKeys |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NodeSelector == nil {
-				m.NodeSelector = make(map[string]string)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TriggerParameter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.NodeSelector[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 7:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TriggerParameter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TriggerParameter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tolerations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Src", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13455,16 +22702,18 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tolerations = append(m.Tolerations, v1.Toleration{})
-			if err := m.Tolerations[len(m.Tolerations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Src == nil {
+				m.Src = &TriggerParameterSource{}
+			}
+			if err := m.Src.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ImagePullSecrets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dest", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13474,29 +22723,27 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ImagePullSecrets = append(m.ImagePullSecrets, v1.LocalObjectReference{})
-			if err := m.ImagePullSecrets[len(m.ImagePullSecrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Dest = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriorityClassName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13524,33 +22771,13 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PriorityClassName = string(dAtA[iNdEx:postIndex])
+			m.Operation = TriggerParameterOperation(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Priority = &v
-		case 11:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Affinity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13560,27 +22787,23 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Affinity == nil {
-				m.Affinity = &v1.Affinity{}
-			}
-			if err := m.Affinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Template = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13603,7 +22826,7 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TimeFilter) Unmarshal(dAtA []byte) error {
+func (m *TriggerParameterSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13626,15 +22849,15 @@ func (m *TimeFilter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TimeFilter: wiretype end group for non-group")
+			return fmt.Errorf("proto: TriggerParameterSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TimeFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TriggerParameterSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DependencyName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13662,11 +22885,11 @@ func (m *TimeFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Start = string(dAtA[iNdEx:postIndex])
+			m.DependencyName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stop", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContextKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13694,63 +22917,77 @@ func (m *TimeFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Stop = string(dAtA[iNdEx:postIndex])
+			m.ContextKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContextTemplate", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Trigger) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			m.ContextTemplate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataKey", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Trigger: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Trigger: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataTemplate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13760,33 +22997,62 @@ func (m *Trigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Template == nil {
-				m.Template = &TriggerTemplate{}
+			m.DataTemplate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Value = &s
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13796,29 +23062,27 @@ func (m *Trigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Parameters = append(m.Parameters, TriggerParameter{})
-			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Env = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13845,16 +23109,66 @@ func (m *Trigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Policy == nil {
-				m.Policy = &TriggerPolicy{}
+			if m.Secret == nil {
+				m.Secret = &v1.SecretKeySelector{}
 			}
-			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Secret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TriggerPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TriggerPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TriggerPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RetryStrategy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field K8s", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13881,16 +23195,16 @@ func (m *Trigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RetryStrategy == nil {
-				m.RetryStrategy = &common.Backoff{}
+			if m.K8s == nil {
+				m.K8s = &K8SResourcePolicy{}
 			}
-			if err := m.RetryStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.K8s.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13917,10 +23231,10 @@ func (m *Trigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RateLimit == nil {
-				m.RateLimit = &RateLimit{}
+			if m.Status == nil {
+				m.Status = &StatusPolicy{}
 			}
-			if err := m.RateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13945,7 +23259,7 @@ func (m *Trigger) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TriggerParameter) Unmarshal(dAtA []byte) error {
+func (m *TriggerRedelivery) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13968,17 +23282,17 @@ func (m *TriggerParameter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TriggerParameter: wiretype end group for non-group")
+			return fmt.Errorf("proto: TriggerRedelivery: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TriggerParameter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TriggerRedelivery: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Src", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Initial", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13988,31 +23302,27 @@ func (m *TriggerParameter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Src == nil {
-				m.Src = &TriggerParameterSource{}
-			}
-			if err := m.Src.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Initial = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14040,13 +23350,13 @@ func (m *TriggerParameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Dest = string(dAtA[iNdEx:postIndex])
+			m.Max = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14056,24 +23366,47 @@ func (m *TriggerParameter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operation = TriggerParameterOperation(dAtA[iNdEx:postIndex])
+			if m.Multiplier == nil {
+				m.Multiplier = &common.Amount{}
+			}
+			if err := m.Multiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+			}
+			m.Attempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempts |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -14095,7 +23428,7 @@ func (m *TriggerParameter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TriggerParameterSource) Unmarshal(dAtA []byte) error {
+func (m *TriggerTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14118,15 +23451,15 @@ func (m *TriggerParameterSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TriggerParameterSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: TriggerTemplate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TriggerParameterSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TriggerTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DependencyName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14154,11 +23487,11 @@ func (m *TriggerParameterSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DependencyName = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContextKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14186,13 +23519,13 @@ func (m *TriggerParameterSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContextKey = string(dAtA[iNdEx:postIndex])
+			m.Conditions = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContextTemplate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field K8s", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14202,29 +23535,33 @@ func (m *TriggerParameterSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContextTemplate = string(dAtA[iNdEx:postIndex])
+			if m.K8s == nil {
+				m.K8s = &StandardK8STrigger{}
+			}
+			if err := m.K8s.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ArgoWorkflow", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14234,29 +23571,33 @@ func (m *TriggerParameterSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DataKey = string(dAtA[iNdEx:postIndex])
+			if m.ArgoWorkflow == nil {
+				m.ArgoWorkflow = &ArgoWorkflowTrigger{}
+			}
+			if err := m.ArgoWorkflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataTemplate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HTTP", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14266,29 +23607,33 @@ func (m *TriggerParameterSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DataTemplate = string(dAtA[iNdEx:postIndex])
+			if m.HTTP == nil {
+				m.HTTP = &HTTPTrigger{}
+			}
+			if err := m.HTTP.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AWSLambda", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14298,78 +23643,31 @@ func (m *TriggerParameterSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Value = &s
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TriggerPolicy) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.AWSLambda == nil {
+				m.AWSLambda = &AWSLambdaTrigger{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TriggerPolicy: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TriggerPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.AWSLambda.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field K8s", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomTrigger", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14396,16 +23694,16 @@ func (m *TriggerPolicy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.K8s == nil {
-				m.K8s = &K8SResourcePolicy{}
+			if m.CustomTrigger == nil {
+				m.CustomTrigger = &CustomTrigger{}
 			}
-			if err := m.K8s.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CustomTrigger.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kafka", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14432,68 +23730,18 @@ func (m *TriggerPolicy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Status == nil {
-				m.Status = &StatusPolicy{}
+			if m.Kafka == nil {
+				m.Kafka = &KafkaTrigger{}
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Kafka.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TriggerTemplate) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TriggerTemplate: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TriggerTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NATS", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14503,29 +23751,33 @@ func (m *TriggerTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.NATS == nil {
+				m.NATS = &NATSTrigger{}
+			}
+			if err := m.NATS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Slack", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14535,27 +23787,31 @@ func (m *TriggerTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Conditions = string(dAtA[iNdEx:postIndex])
+			if m.Slack == nil {
+				m.Slack = &SlackTrigger{}
+			}
+			if err := m.Slack.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field K8s", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenWhisk", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14582,16 +23838,16 @@ func (m *TriggerTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.K8s == nil {
-				m.K8s = &StandardK8STrigger{}
+			if m.OpenWhisk == nil {
+				m.OpenWhisk = &OpenWhiskTrigger{}
 			}
-			if err := m.K8s.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OpenWhisk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ArgoWorkflow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14618,16 +23874,16 @@ func (m *TriggerTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ArgoWorkflow == nil {
-				m.ArgoWorkflow = &ArgoWorkflowTrigger{}
+			if m.Log == nil {
+				m.Log = &LogTrigger{}
 			}
-			if err := m.ArgoWorkflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Log.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HTTP", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AzureEventHubs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14654,16 +23910,16 @@ func (m *TriggerTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HTTP == nil {
-				m.HTTP = &HTTPTrigger{}
+			if m.AzureEventHubs == nil {
+				m.AzureEventHubs = &AzureEventHubsTrigger{}
 			}
-			if err := m.HTTP.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AzureEventHubs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AWSLambda", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pulsar", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14690,16 +23946,16 @@ func (m *TriggerTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AWSLambda == nil {
-				m.AWSLambda = &AWSLambdaTrigger{}
+			if m.Pulsar == nil {
+				m.Pulsar = &PulsarTrigger{}
 			}
-			if err := m.AWSLambda.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pulsar.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CustomTrigger", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConditionsReset", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14726,16 +23982,14 @@ func (m *TriggerTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CustomTrigger == nil {
-				m.CustomTrigger = &CustomTrigger{}
-			}
-			if err := m.CustomTrigger.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ConditionsReset = append(m.ConditionsReset, ConditionsResetCriteria{})
+			if err := m.ConditionsReset[len(m.ConditionsReset)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kafka", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GCPCloudFunction", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14762,16 +24016,16 @@ func (m *TriggerTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Kafka == nil {
-				m.Kafka = &KafkaTrigger{}
+			if m.GCPCloudFunction == nil {
+				m.GCPCloudFunction = &GCPCloudFunctionTrigger{}
 			}
-			if err := m.Kafka.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GCPCloudFunction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NATS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RedisStream", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14798,18 +24052,38 @@ func (m *TriggerTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NATS == nil {
-				m.NATS = &NATSTrigger{}
+			if m.RedisStream == nil {
+				m.RedisStream = &RedisStreamTrigger{}
 			}
-			if err := m.NATS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RedisStream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slack", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Condition", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14819,31 +24093,27 @@ func (m *TriggerTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Slack == nil {
-				m.Slack = &SlackTrigger{}
-			}
-			if err := m.Slack.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Condition = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OpenWhisk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AWSSQS", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14870,18 +24140,18 @@ func (m *TriggerTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OpenWhisk == nil {
-				m.OpenWhisk = &OpenWhiskTrigger{}
+			if m.AWSSQS == nil {
+				m.AWSSQS = &AWSSQSTrigger{}
 			}
-			if err := m.OpenWhisk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AWSSQS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14891,31 +24161,27 @@ func (m *TriggerTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Log == nil {
-				m.Log = &LogTrigger{}
-			}
-			if err := m.Log.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Timeout = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AzureEventHubs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CloudEvent", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14942,16 +24208,16 @@ func (m *TriggerTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AzureEventHubs == nil {
-				m.AzureEventHubs = &AzureEventHubsTrigger{}
+			if m.CloudEvent == nil {
+				m.CloudEvent = &CloudEventEnvelope{}
 			}
-			if err := m.AzureEventHubs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CloudEvent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pulsar", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pushgateway", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14978,16 +24244,16 @@ func (m *TriggerTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pulsar == nil {
-				m.Pulsar = &PulsarTrigger{}
+			if m.Pushgateway == nil {
+				m.Pushgateway = &PushgatewayTrigger{}
 			}
-			if err := m.Pulsar.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pushgateway.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
+		case 24:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConditionsReset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bus", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15014,8 +24280,10 @@ func (m *TriggerTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConditionsReset = append(m.ConditionsReset, ConditionsResetCriteria{})
-			if err := m.ConditionsReset[len(m.ConditionsReset)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Bus == nil {
+				m.Bus = &BusTrigger{}
+			}
+			if err := m.Bus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
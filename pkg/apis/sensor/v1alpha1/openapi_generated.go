@@ -30,48 +30,66 @@ import (
 
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
-		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.AWSLambdaTrigger":           schema_pkg_apis_sensor_v1alpha1_AWSLambdaTrigger(ref),
-		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ArgoWorkflowTrigger":        schema_pkg_apis_sensor_v1alpha1_ArgoWorkflowTrigger(ref),
-		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ArtifactLocation":           schema_pkg_apis_sensor_v1alpha1_ArtifactLocation(ref),
-		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.AzureEventHubsTrigger":      schema_pkg_apis_sensor_v1alpha1_AzureEventHubsTrigger(ref),
-		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ConditionsResetByTime":      schema_pkg_apis_sensor_v1alpha1_ConditionsResetByTime(ref),
-		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ConditionsResetCriteria":    schema_pkg_apis_sensor_v1alpha1_ConditionsResetCriteria(ref),
-		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.CustomTrigger":              schema_pkg_apis_sensor_v1alpha1_CustomTrigger(ref),
-		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.DataFilter":                 schema_pkg_apis_sensor_v1alpha1_DataFilter(ref),
-		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.Event":                      schema_pkg_apis_sensor_v1alpha1_Event(ref),
-		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.EventContext":               schema_pkg_apis_sensor_v1alpha1_EventContext(ref),
-		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.EventDependency":            schema_pkg_apis_sensor_v1alpha1_EventDependency(ref),
-		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.EventDependencyFilter":      schema_pkg_apis_sensor_v1alpha1_EventDependencyFilter(ref),
-		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.EventDependencyTransformer": schema_pkg_apis_sensor_v1alpha1_EventDependencyTransformer(ref),
-		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ExprFilter":                 schema_pkg_apis_sensor_v1alpha1_ExprFilter(ref),
-		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.FileArtifact":               schema_pkg_apis_sensor_v1alpha1_FileArtifact(ref),
-		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.GitArtifact":                schema_pkg_apis_sensor_v1alpha1_GitArtifact(ref),
-		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.GitCreds":                   schema_pkg_apis_sensor_v1alpha1_GitCreds(ref),
-		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.GitRemoteConfig":            schema_pkg_apis_sensor_v1alpha1_GitRemoteConfig(ref),
-		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.HTTPTrigger":                schema_pkg_apis_sensor_v1alpha1_HTTPTrigger(ref),
-		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.K8SResourcePolicy":          schema_pkg_apis_sensor_v1alpha1_K8SResourcePolicy(ref),
-		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.KafkaTrigger":               schema_pkg_apis_sensor_v1alpha1_KafkaTrigger(ref),
-		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.LogTrigger":                 schema_pkg_apis_sensor_v1alpha1_LogTrigger(ref),
-		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.NATSTrigger":                schema_pkg_apis_sensor_v1alpha1_NATSTrigger(ref),
-		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.OpenWhiskTrigger":           schema_pkg_apis_sensor_v1alpha1_OpenWhiskTrigger(ref),
-		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.PayloadField":               schema_pkg_apis_sensor_v1alpha1_PayloadField(ref),
-		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.PulsarTrigger":              schema_pkg_apis_sensor_v1alpha1_PulsarTrigger(ref),
-		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.RateLimit":                  schema_pkg_apis_sensor_v1alpha1_RateLimit(ref),
-		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.Sensor":                     schema_pkg_apis_sensor_v1alpha1_Sensor(ref),
-		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.SensorList":                 schema_pkg_apis_sensor_v1alpha1_SensorList(ref),
-		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.SensorSpec":                 schema_pkg_apis_sensor_v1alpha1_SensorSpec(ref),
-		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.SensorStatus":               schema_pkg_apis_sensor_v1alpha1_SensorStatus(ref),
-		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.SlackTrigger":               schema_pkg_apis_sensor_v1alpha1_SlackTrigger(ref),
-		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.StandardK8STrigger":         schema_pkg_apis_sensor_v1alpha1_StandardK8STrigger(ref),
-		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.StatusPolicy":               schema_pkg_apis_sensor_v1alpha1_StatusPolicy(ref),
-		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.Template":                   schema_pkg_apis_sensor_v1alpha1_Template(ref),
-		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TimeFilter":                 schema_pkg_apis_sensor_v1alpha1_TimeFilter(ref),
-		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.Trigger":                    schema_pkg_apis_sensor_v1alpha1_Trigger(ref),
-		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter":           schema_pkg_apis_sensor_v1alpha1_TriggerParameter(ref),
-		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameterSource":     schema_pkg_apis_sensor_v1alpha1_TriggerParameterSource(ref),
-		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerPolicy":              schema_pkg_apis_sensor_v1alpha1_TriggerPolicy(ref),
-		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerTemplate":            schema_pkg_apis_sensor_v1alpha1_TriggerTemplate(ref),
-		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.URLArtifact":                schema_pkg_apis_sensor_v1alpha1_URLArtifact(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.AWSLambdaTrigger":                schema_pkg_apis_sensor_v1alpha1_AWSLambdaTrigger(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.AWSSQSTrigger":                   schema_pkg_apis_sensor_v1alpha1_AWSSQSTrigger(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ArgoWorkflowTrigger":             schema_pkg_apis_sensor_v1alpha1_ArgoWorkflowTrigger(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ArtifactLocation":                schema_pkg_apis_sensor_v1alpha1_ArtifactLocation(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.AzureEventHubsTrigger":           schema_pkg_apis_sensor_v1alpha1_AzureEventHubsTrigger(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.BusTrigger":                      schema_pkg_apis_sensor_v1alpha1_BusTrigger(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.CloudEventEnvelope":              schema_pkg_apis_sensor_v1alpha1_CloudEventEnvelope(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ConditionsResetByTime":           schema_pkg_apis_sensor_v1alpha1_ConditionsResetByTime(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ConditionsResetCriteria":         schema_pkg_apis_sensor_v1alpha1_ConditionsResetCriteria(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.CustomTrigger":                   schema_pkg_apis_sensor_v1alpha1_CustomTrigger(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.DataFilter":                      schema_pkg_apis_sensor_v1alpha1_DataFilter(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.DeadLetter":                      schema_pkg_apis_sensor_v1alpha1_DeadLetter(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.Event":                           schema_pkg_apis_sensor_v1alpha1_Event(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.EventBusDeadLetterSink":          schema_pkg_apis_sensor_v1alpha1_EventBusDeadLetterSink(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.EventContext":                    schema_pkg_apis_sensor_v1alpha1_EventContext(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.EventDependency":                 schema_pkg_apis_sensor_v1alpha1_EventDependency(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.EventDependencyFilter":           schema_pkg_apis_sensor_v1alpha1_EventDependencyFilter(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.EventDependencyTransformer":      schema_pkg_apis_sensor_v1alpha1_EventDependencyTransformer(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ExprFilter":                      schema_pkg_apis_sensor_v1alpha1_ExprFilter(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.FileArtifact":                    schema_pkg_apis_sensor_v1alpha1_FileArtifact(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.GCPCloudFunctionBatch":           schema_pkg_apis_sensor_v1alpha1_GCPCloudFunctionBatch(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.GCPCloudFunctionResponseLogging": schema_pkg_apis_sensor_v1alpha1_GCPCloudFunctionResponseLogging(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.GCPCloudFunctionTrigger":         schema_pkg_apis_sensor_v1alpha1_GCPCloudFunctionTrigger(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.GitArtifact":                     schema_pkg_apis_sensor_v1alpha1_GitArtifact(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.GitCreds":                        schema_pkg_apis_sensor_v1alpha1_GitCreds(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.GitRemoteConfig":                 schema_pkg_apis_sensor_v1alpha1_GitRemoteConfig(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.HTTPDeadLetterSink":              schema_pkg_apis_sensor_v1alpha1_HTTPDeadLetterSink(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.HTTPTrigger":                     schema_pkg_apis_sensor_v1alpha1_HTTPTrigger(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.Idempotency":                     schema_pkg_apis_sensor_v1alpha1_Idempotency(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.JetStreamDeadLetterSink":         schema_pkg_apis_sensor_v1alpha1_JetStreamDeadLetterSink(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.JetStreamIdempotencyStore":       schema_pkg_apis_sensor_v1alpha1_JetStreamIdempotencyStore(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.K8SResourcePolicy":               schema_pkg_apis_sensor_v1alpha1_K8SResourcePolicy(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.KafkaTrigger":                    schema_pkg_apis_sensor_v1alpha1_KafkaTrigger(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.LogTrigger":                      schema_pkg_apis_sensor_v1alpha1_LogTrigger(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.NATSTrigger":                     schema_pkg_apis_sensor_v1alpha1_NATSTrigger(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.OpenWhiskTrigger":                schema_pkg_apis_sensor_v1alpha1_OpenWhiskTrigger(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.PayloadField":                    schema_pkg_apis_sensor_v1alpha1_PayloadField(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.PulsarTrigger":                   schema_pkg_apis_sensor_v1alpha1_PulsarTrigger(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.PushgatewayMetric":               schema_pkg_apis_sensor_v1alpha1_PushgatewayMetric(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.PushgatewayTrigger":              schema_pkg_apis_sensor_v1alpha1_PushgatewayTrigger(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.RateLimit":                       schema_pkg_apis_sensor_v1alpha1_RateLimit(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.RedisStreamTrigger":              schema_pkg_apis_sensor_v1alpha1_RedisStreamTrigger(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.Sensor":                          schema_pkg_apis_sensor_v1alpha1_Sensor(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.SensorList":                      schema_pkg_apis_sensor_v1alpha1_SensorList(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.SensorSpec":                      schema_pkg_apis_sensor_v1alpha1_SensorSpec(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.SensorStatus":                    schema_pkg_apis_sensor_v1alpha1_SensorStatus(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.SlackTrigger":                    schema_pkg_apis_sensor_v1alpha1_SlackTrigger(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.StandardK8STrigger":              schema_pkg_apis_sensor_v1alpha1_StandardK8STrigger(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.StatusPolicy":                    schema_pkg_apis_sensor_v1alpha1_StatusPolicy(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.Template":                        schema_pkg_apis_sensor_v1alpha1_Template(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TimeFilter":                      schema_pkg_apis_sensor_v1alpha1_TimeFilter(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.Trigger":                         schema_pkg_apis_sensor_v1alpha1_Trigger(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerCircuitBreaker":           schema_pkg_apis_sensor_v1alpha1_TriggerCircuitBreaker(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerDedupe":                   schema_pkg_apis_sensor_v1alpha1_TriggerDedupe(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter":                schema_pkg_apis_sensor_v1alpha1_TriggerParameter(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameterSource":          schema_pkg_apis_sensor_v1alpha1_TriggerParameterSource(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerPolicy":                   schema_pkg_apis_sensor_v1alpha1_TriggerPolicy(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerRedelivery":               schema_pkg_apis_sensor_v1alpha1_TriggerRedelivery(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerTemplate":                 schema_pkg_apis_sensor_v1alpha1_TriggerTemplate(ref),
+		"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.URLArtifact":                     schema_pkg_apis_sensor_v1alpha1_URLArtifact(ref),
 	}
 }
 
@@ -161,6 +179,99 @@ func schema_pkg_apis_sensor_v1alpha1_AWSLambdaTrigger(ref common.ReferenceCallba
 	}
 }
 
+func schema_pkg_apis_sensor_v1alpha1_AWSSQSTrigger(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AWSSQSTrigger refers to specification of the trigger to send messages to an AWS SQS queue",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"queueURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QueueURL refers to the URL of the queue to send the messages to. FIFO queues are identified by the \".fifo\" suffix of their name.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"region": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Region is AWS region",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"accessKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AccessKey refers K8s secret containing aws access key",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"secretKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretKey refers K8s secret containing aws secret key",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"roleARN": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RoleARN is the Amazon Resource Name (ARN) of the role to assume.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"messageGroupID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MessageGroupID is the group of the messages of a FIFO queue, the messages of a group are delivered in order. Required for FIFO queues, it is usually set from the event data with a parameter.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"messageDeduplicationID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MessageDeduplicationID is the ID used to deduplicate the messages sent to a FIFO queue. If not specified, the queue must have content-based deduplication enabled.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"payload": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Payload is the list of key-value extracted from an event payload to construct the message body.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter"),
+									},
+								},
+							},
+						},
+					},
+					"parameters": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Parameters is the list of key-value extracted from event's payload that are applied to the trigger resource.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"queueURL", "region", "payload"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter", "k8s.io/api/core/v1.SecretKeySelector"},
+	}
+}
+
 func schema_pkg_apis_sensor_v1alpha1_ArgoWorkflowTrigger(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -348,6 +459,123 @@ func schema_pkg_apis_sensor_v1alpha1_AzureEventHubsTrigger(ref common.ReferenceC
 	}
 }
 
+func schema_pkg_apis_sensor_v1alpha1_BusTrigger(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BusTrigger refers to the specification of the trigger publishing an event to the EventBus the sensor is connected to, as an event of an event source, for the sensors depending on it to be triggered without publishing the original event again.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"subject": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Subject of the EventBus the event is published to, defaults to the subject the sensors of the namespace subscribe to.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"eventSourceName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EventSourceName is the event source name of the published event, the one the dependencies of the downstream sensors refer to.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"eventName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EventName is the event name of the published event.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"payload": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Payload is the list of key-value extracted from the events to construct the data of the published event.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter"),
+									},
+								},
+							},
+						},
+					},
+					"parameters": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Parameters is the list of key-value extracted from event's payload that are applied to the trigger resource.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"eventSourceName", "eventName", "payload"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter"},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_CloudEventEnvelope(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CloudEventEnvelope describes the attributes of the CloudEvent the payload of a trigger is sent as, the payload being its data. The attributes which are not specified are taken from the event the payload is constructed from, or from the sensor if it is constructed from several events.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type of the CloudEvent, e.g. \"com.example.order.created\". Defaults to the type of the event, or \"io.argoproj.sensor.trigger\" for several events.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"source": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Source of the CloudEvent, a URI reference, e.g. \"/orders\". Defaults to the source of the event, or \"/namespaces/{namespace}/sensors/{sensor}\" for several events.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"subject": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Subject of the CloudEvent. Defaults to the subject of the event, if any.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"extensions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Extensions are the extension attributes of the CloudEvent. The names must be lowercase alphanumeric.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_sensor_v1alpha1_ConditionsResetByTime(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -541,6 +769,39 @@ func schema_pkg_apis_sensor_v1alpha1_DataFilter(ref common.ReferenceCallback) co
 	}
 }
 
+func schema_pkg_apis_sensor_v1alpha1_DeadLetter(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DeadLetter describes the sink of the events of the failed trigger executions. Exactly one sink must be specified. The dead letters are JSON documents holding the events as they were delivered to the trigger, the name of the trigger and the error of its last execution.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"eventBus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EventBus publishes the dead letters to a subject of the NATS Streaming EventBus of the sensor.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.EventBusDeadLetterSink"),
+						},
+					},
+					"http": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HTTP posts the dead letters to an HTTP endpoint.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.HTTPDeadLetterSink"),
+						},
+					},
+					"jetStream": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JetStream publishes the dead letters to a JetStream stream, waiting for the stream to store them.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.JetStreamDeadLetterSink"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.EventBusDeadLetterSink", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.HTTPDeadLetterSink", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.JetStreamDeadLetterSink"},
+	}
+}
+
 func schema_pkg_apis_sensor_v1alpha1_Event(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -568,6 +829,28 @@ func schema_pkg_apis_sensor_v1alpha1_Event(ref common.ReferenceCallback) common.
 	}
 }
 
+func schema_pkg_apis_sensor_v1alpha1_EventBusDeadLetterSink(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EventBusDeadLetterSink refers to the subject of the EventBus the dead letters are published to.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"subject": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Subject is the subject, or channel, of the dead letters. It must differ from the subject of the events of the EventBus.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"subject"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_sensor_v1alpha1_EventContext(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -689,6 +972,28 @@ func schema_pkg_apis_sensor_v1alpha1_EventDependency(ref common.ReferenceCallbac
 							Format:      "",
 						},
 					},
+					"schema": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schema is a JSON schema, written in JSON or YAML, which the event data must match after the transformation. The events which don't match it are rejected before the filters are applied.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"sensitiveFields": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SensitiveFields are the paths of the fields of the event data masked whenever the event is logged, e.g. \"body.user.ssn\". The triggers are executed with the event as is.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"name", "eventSourceName", "eventName"},
 			},
@@ -850,30 +1155,345 @@ func schema_pkg_apis_sensor_v1alpha1_FileArtifact(ref common.ReferenceCallback)
 	}
 }
 
-func schema_pkg_apis_sensor_v1alpha1_GitArtifact(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_sensor_v1alpha1_GCPCloudFunctionBatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "GitArtifact contains information about an artifact stored in git",
+				Description: "GCPCloudFunctionBatch describes how to batch the executions of a GCP Cloud Function trigger. A batch is flushed once it holds MaxSize executions, or MaxWait after its first execution.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"url": {
+					"maxSize": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Git URL",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "MaxSize is the maximum number of executions in a batch. Defaults to 10.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"cloneDirectory": {
+					"maxWait": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Directory to clone the repository. We clone complete directory because GitArtifact is not limited to any specific Git service providers. Hence we don't use any specific git provider client.",
-							Default:     "",
+							Description: "MaxWait is how long a batch waits for more executions after the first one, e.g. \"500ms\" or \"5s\". Defaults to 1s.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"creds": {
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_GCPCloudFunctionResponseLogging(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GCPCloudFunctionResponseLogging describes how the responses of a GCP Cloud Function trigger are logged.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"level": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Level is the level to log the responses at, debug, info, warn or error. Defaults to debug.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"redact": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Redact is the list of the JSON paths of the fields redacted from the logged responses, e.g. \"user.email\". If specified, the responses which are not JSON are logged as their size and SHA-256 hash instead.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_GCPCloudFunctionTrigger(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GCPCloudFunctionTrigger refers to specification of the trigger to call a GCP Cloud Function",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"functionName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FunctionName refers to the full resource name of the function to call, in the format of \"projects/{project}/locations/{location}/functions/{function}\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"credentialsPath": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CredentialsPath refers to the path of a mounted service account JSON key file.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"payload": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Payload is the list of key-value extracted from an event payload to construct the request payload. Required unless a transform is specified.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter"),
+									},
+								},
+							},
+						},
+					},
+					"parameters": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Parameters is the list of key-value extracted from event's payload that are applied to the trigger resource.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter"),
+									},
+								},
+							},
+						},
+					},
+					"retryStrategy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetryStrategy is the backoff used to retry the asynchronous function calls when GCP returns a retryable error, e.g. 429 or 503. Non-retryable errors are returned immediately. Defaults to the retry strategy of the trigger, which retries the other calls.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/common.Backoff"),
+						},
+					},
+					"credentialsSecret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CredentialsSecret refers to a K8s secret containing the service account JSON key. It takes precedence over CredentialsPath if both are specified. If neither is specified, Application Default Credentials are used.",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"encoding": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Encoding applied to the payload before calling the function, none, base64 or gzip. The gzip encoding compresses the payload and base64 encodes the result. Defaults to none.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"caCertificate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CACertificate refers to a K8s secret containing a PEM encoded CA bundle to trust in addition to the system roots, e.g. the CA of a TLS inspecting proxy.",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"proxyURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProxyURL is the URL of the proxy to call GCP through, e.g. \"http://proxy.example.com:3128\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"batch": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Batch accumulates the executions of the trigger and calls the function once per batch, with a JSON array of their payloads. Defaults to a call per execution.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.GCPCloudFunctionBatch"),
+						},
+					},
+					"generation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Generation of the function, 1 or 2. The 1st gen functions are called through the Cloud Functions API, the 2nd gen ones are called over HTTP on their URL, with an identity token. Defaults to 1.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL is the HTTPS endpoint of a 2nd gen function, e.g. \"https://{function}-{hash}-{region}.a.run.app\", it is also the audience of the identity token. Required for the 2nd gen functions, it can't be templated.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"location": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Location replaces the location of FunctionName, e.g. to follow the region of the cluster with a parameter reading it from an environment variable.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"envelope": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Envelope wraps the encoded payload in a JSON envelope carrying its content type, encoding and size, for the function to know how to decode it. Defaults to false, i.e. the payload is sent as is.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"invocation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Invocation is how the function is invoked, \"call\" to call it, or \"pubsub\" to publish the payload to the Pub/Sub topic triggering it. Defaults to call.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"topic": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Topic is the full resource name of the Pub/Sub topic triggering the function, in the format of \"projects/{project}/topics/{topic}\". Required for the pubsub invocation, it can't be templated.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"async": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Async calls the function in the background, the execution succeeding once the call is dispatched rather than once the function responded. The failures of the call are logged and counted, but don't fail the execution. The call is made before the execution returns if the concurrency limit of the sensor is reached.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"headers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Headers for the requests to 2nd gen functions, or the attributes of the messages published for the pubsub invocation. They can be templated with parameters, e.g. with dest \"headers.X-Tenant\".",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"secureHeaders": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecureHeaders stored in Kubernetes Secrets or ConfigMaps, added like Headers. Their values are never logged.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/argoproj/argo-events/pkg/apis/common.SecureHeader"),
+									},
+								},
+							},
+						},
+					},
+					"responseLogging": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResponseLogging logs the responses of the function, they are not logged if not specified.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.GCPCloudFunctionResponseLogging"),
+						},
+					},
+					"transform": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Transform is a CEL expression evaluated against the events, the object it evaluates to is the payload, with the payload parameters applied on top of it. The data of each event is accessible under the name of its dependency. For example: `{\"user\": events[\"dep\"].body.user.name, \"total\": events[\"a\"].body.amount + events[\"b\"].body.amount}` The payload parameters are optional with a transform.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"impersonateServiceAccount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImpersonateServiceAccount is the email of the service account the function is called as, e.g. \"invoker@{project}.iam.gserviceaccount.com\". The credentials of the trigger, or the Application Default Credentials, must be allowed to create tokens for it, i.e. have the Service Account Token Creator role on it.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"impersonationDelegates": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImpersonationDelegates is the chain of service accounts the impersonation is delegated through, each one allowed to create tokens for the next one, the last one for the impersonated service account.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"credentials": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Credentials are named K8s secrets containing service account JSON keys, e.g. one per tenant, the one of each execution being selected by CredentialsKey. They take precedence over CredentialsSecret and CredentialsPath.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.SecretKeySelector"),
+									},
+								},
+							},
+						},
+					},
+					"credentialsKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CredentialsKey is the name of the Credentials to call the function with, usually templated from the events with a parameter of dest \"credentialsKey\". An execution selecting none of the Credentials fails.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxResponseSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxResponseSize is the max size in bytes of the response body of the 2nd gen functions, once decoded if it is compressed with gzip or deflate. A bigger response fails the execution. Defaults to 10MiB.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"userAgent": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UserAgent is appended to the User-Agent of the calls, which identifies Argo Events, the sensor and the trigger, e.g. \"billing-team/1.0\" for the calls to be told apart in Cloud Logging.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"retryOnResponse": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetryOnResponse is a CEL expression evaluated against the responses of the function, in addition to the classification of their status, the calls it evaluates to true for being retried, and the failed calls it evaluates to false for not being retried. The status and the body of the response, decoded if it is JSON, are accessible under response. For example: `has(response.body.retryable) && response.body.retryable` It is evaluated against the results of the 1st gen functions, and the responses of the 2nd gen ones.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"functionName"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/common.Backoff", "github.com/argoproj/argo-events/pkg/apis/common.SecureHeader", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.GCPCloudFunctionBatch", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.GCPCloudFunctionResponseLogging", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter", "k8s.io/api/core/v1.SecretKeySelector"},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_GitArtifact(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GitArtifact contains information about an artifact stored in git",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Git URL",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"cloneDirectory": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Directory to clone the repository. We clone complete directory because GitArtifact is not limited to any specific Git service providers. Hence we don't use any specific git provider client.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"creds": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Creds contain reference to git username and password",
 							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.GitCreds"),
@@ -991,6 +1611,59 @@ func schema_pkg_apis_sensor_v1alpha1_GitRemoteConfig(ref common.ReferenceCallbac
 	}
 }
 
+func schema_pkg_apis_sensor_v1alpha1_HTTPDeadLetterSink(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HTTPDeadLetterSink refers to the HTTP endpoint the dead letters are posted to. A response with a status code other than 2xx fails the forwarding.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL of the endpoint.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"headers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Headers are added to the requests.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"tls": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TLS configuration for the HTTP client.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/common.TLSConfig"),
+						},
+					},
+					"timeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timeout of the requests, e.g. \"10s\". Defaults to 10s.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"url"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/common.TLSConfig"},
+	}
+}
+
 func schema_pkg_apis_sensor_v1alpha1_HTTPTrigger(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1088,12 +1761,150 @@ func schema_pkg_apis_sensor_v1alpha1_HTTPTrigger(ref common.ReferenceCallback) c
 							},
 						},
 					},
+					"maxResponseSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxResponseSize is the max size in bytes of the response body, once decoded if it is compressed with gzip or deflate. A bigger response fails the execution. Defaults to 10MiB.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"retryStrategy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetryStrategy is the backoff of the retries of the request on network errors and on the responses with a status of RetryOn. The response of the last attempt is the one the policy of the trigger is applied to. Defaults to a single attempt.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/common.Backoff"),
+						},
+					},
+					"retryOn": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetryOn are the response statuses the request is retried on, single statuses, e.g. \"429\", classes of statuses, e.g. \"5xx\", or ranges of statuses, e.g. \"500-504\". Defaults to 429, 500, 502, 503 and 504.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"url", "payload"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/common.Backoff", "github.com/argoproj/argo-events/pkg/apis/common.BasicAuth", "github.com/argoproj/argo-events/pkg/apis/common.SecureHeader", "github.com/argoproj/argo-events/pkg/apis/common.TLSConfig", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter"},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_Idempotency(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Idempotency describes how the executions of the triggers are recorded. A record is kept for each successful execution of a trigger, keyed by the trigger name and the IDs of the events.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ttl": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TTL is how long the records are kept, e.g. \"1h\". Defaults to 24h.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"jetStream": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JetStream keeps the records in a JetStream key-value bucket, for them to survive the restarts of the sensor. The records are kept in memory if it is not specified.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.JetStreamIdempotencyStore"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.JetStreamIdempotencyStore"},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_JetStreamDeadLetterSink(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "JetStreamDeadLetterSink refers to the JetStream stream the dead letters are published to.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL of the NATS server with JetStream enabled.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"subject": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Subject of the dead letters, which must be bound to a stream.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"stream": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Stream is the name of the stream the subject is expected to be bound to. The publishing fails if the subject is bound to another stream.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tls": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TLS configuration for the NATS client.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/common.TLSConfig"),
+						},
+					},
+				},
+				Required: []string{"url", "subject"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/common.TLSConfig"},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_JetStreamIdempotencyStore(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "JetStreamIdempotencyStore refers to the JetStream key-value bucket of the records.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL of the NATS server with JetStream enabled.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"bucket": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Bucket is the name of the key-value bucket, created if it does not exist. Defaults to \"sensor-\" followed by the name of the sensor.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tls": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TLS configuration for the NATS client.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/common.TLSConfig"),
+						},
+					},
 				},
-				Required: []string{"url", "payload"},
+				Required: []string{"url"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/common.BasicAuth", "github.com/argoproj/argo-events/pkg/apis/common.SecureHeader", "github.com/argoproj/argo-events/pkg/apis/common.TLSConfig", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter"},
+			"github.com/argoproj/argo-events/pkg/apis/common.TLSConfig"},
 	}
 }
 
@@ -1249,6 +2060,20 @@ func schema_pkg_apis_sensor_v1alpha1_KafkaTrigger(ref common.ReferenceCallback)
 							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/common.SASLConfig"),
 						},
 					},
+					"idempotent": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Idempotent enables the idempotent producer, for the retries of the producer not to duplicate the messages. It requires the acknowledgement of all the in-sync replicas, and Kafka 0.11.0.0 or later.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"partitioningKeyTemplate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PartitioningKeyTemplate is the Go template of the partitioning key of the messages, executed against the data of the events keyed by their dependency names, e.g. {{ .order.customerId }}. It takes precedence over the partitioning key.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"url", "topic", "partition", "payload"},
 			},
@@ -1271,9 +2096,46 @@ func schema_pkg_apis_sensor_v1alpha1_LogTrigger(ref common.ReferenceCallback) co
 							Format:      "int64",
 						},
 					},
+					"level": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Level is the level to log at, debug, info, warn or error. Defaults to info.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"payload": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Payload is the list of key-value extracted from the events to construct the payload, the same as the payload of another trigger. If specified, the resolved events and the payload are logged as a single JSON entry per execution, otherwise each event is logged on its own.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter"),
+									},
+								},
+							},
+						},
+					},
+					"parameters": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Parameters is the list of key-value extracted from event's payload that are applied to the trigger resource.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter"},
 	}
 }
 
@@ -1540,6 +2402,22 @@ func schema_pkg_apis_sensor_v1alpha1_PulsarTrigger(ref common.ReferenceCallback)
 							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/common.Backoff"),
 						},
 					},
+					"properties": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Properties are set on the produced messages, they can be templated from the events by the parameters with \"properties.<name>\" as destination.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"url", "topic", "payload"},
 			},
@@ -1549,6 +2427,148 @@ func schema_pkg_apis_sensor_v1alpha1_PulsarTrigger(ref common.ReferenceCallback)
 	}
 }
 
+func schema_pkg_apis_sensor_v1alpha1_PushgatewayMetric(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PushgatewayMetric is a gauge pushed to a Prometheus Pushgateway",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the gauge.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"help": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Help describes the gauge.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Labels of the gauge.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Value of the gauge, a number or a Go template resolving to a number from the data of the events by dependency name, e.g. \"{{ .dep.body.duration }}\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "value"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_PushgatewayTrigger(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PushgatewayTrigger refers to the specification of the trigger pushing metrics to a Prometheus Pushgateway, e.g. on the completion of a batch job.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL of the Pushgateway, e.g. http://pushgateway.monitoring:9091",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"job": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Job is the job label of the grouping key of the metrics.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"grouping": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Grouping are the other labels of the grouping key of the metrics, e.g. instance.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"metrics": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Metrics are the gauges pushed to the Pushgateway.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.PushgatewayMetric"),
+									},
+								},
+							},
+						},
+					},
+					"replace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Replace replaces all the metrics of the grouping key, rather than only the ones with the same names as the pushed metrics.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"basicAuth": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BasicAuth configuration for the pushes.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/common.BasicAuth"),
+						},
+					},
+					"parameters": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Parameters is the list of key-value extracted from event's payload that are applied to the trigger resource.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"url", "job", "metrics"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/common.BasicAuth", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.PushgatewayMetric", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter"},
+	}
+}
+
 func schema_pkg_apis_sensor_v1alpha1_RateLimit(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1562,15 +2582,115 @@ func schema_pkg_apis_sensor_v1alpha1_RateLimit(ref common.ReferenceCallback) com
 							Format:      "",
 						},
 					},
-					"requestsPerUnit": {
+					"requestsPerUnit": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"burst": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Burst is the maximum number of executions allowed at once, defaults to 1",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"overflow": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Overflow is the policy applied to an execution exceeding the rate limit, Block or Drop, defaults to Block.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_RedisStreamTrigger(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RedisStreamTrigger refers to the specification of the Redis stream trigger.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"hostAddress": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HostAddress refers to the address of the Redis host/server (master instance)",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"password": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Password required for authentication if any.",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"db": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DB to use. If not specified, default DB 0 will be used.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"tls": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TLS configuration for the redis client.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/common.TLSConfig"),
+						},
+					},
+					"stream": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Stream refers to the key of the stream to add entries to.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxLen": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxLen trims the stream to the given number of entries on each XADD. If not specified, the stream is not trimmed.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"payload": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Payload is the list of key-value extracted from an event payload to construct the entry. Each top level key of the constructed payload becomes a field of the entry.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter"),
+									},
+								},
+							},
+						},
+					},
+					"parameters": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int32",
+							Description: "Parameters is the list of key-value extracted from event's payload that are applied to the trigger resource.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter"),
+									},
+								},
+							},
 						},
 					},
 				},
+				Required: []string{"hostAddress", "stream", "payload"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/common.TLSConfig", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter", "k8s.io/api/core/v1.SecretKeySelector"},
 	}
 }
 
@@ -1733,12 +2853,74 @@ func schema_pkg_apis_sensor_v1alpha1_SensorSpec(ref common.ReferenceCallback) co
 							Format:      "int32",
 						},
 					},
+					"idempotency": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Idempotency records the events each trigger has been executed for, and skips the executions for the events delivered again, e.g. after a restart of the sensor.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.Idempotency"),
+						},
+					},
+					"triggerConcurrency": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TriggerConcurrency is the maximum number of trigger executions running at once in the sensor, the other executions waiting for one to finish. Defaults to no limit.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"deliverySemantics": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeliverySemantics is when the events are acknowledged on the eventbus, AtLeastOnce or AtMostOnce. With AtMostOnce, the events are acknowledged before the triggers are executed, so the executions interrupted by a crash of the sensor are not retried. Defaults to AtLeastOnce.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"deadLetter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeadLetter forwards the events to a sink once the execution of a trigger failed for good, i.e. the retries and redeliveries are exhausted, the failure is permanent or the circuit is open. The events are dropped if it is not specified.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.DeadLetter"),
+						},
+					},
+					"maxInFlightEvents": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxInFlightEvents is the maximum number of events of the trigger executions in progress in the sensor, from their delivery to the completion of their execution, including its retries and redeliveries. Once it is reached, the sensor stops taking events from the eventbus until executions complete, the events waiting on the eventbus rather than in memory. Defaults to no limit.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"partitionKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PartitionKey is a CEL expression evaluated against the events of each trigger execution, the executions of a trigger whose events share a key run one at a time, in the order the events were delivered, while the ones of different keys run in parallel. The data of each event is accessible under the name of its dependency, e.g. `events[\"dep\"].body.customerId`. The executions whose key can't be evaluated are not ordered. Defaults to no ordering.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxActivePartitions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxActivePartitions is the maximum number of partition keys with trigger executions queued or running. Once it is reached, the sensor stops taking events from the eventbus until a partition completes. Defaults to 1000.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"sensitiveFields": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SensitiveFields are the paths of the fields of the data of all the events masked whenever an event is logged, in addition to the SensitiveFields of its dependency, e.g. \"body.password\".",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"dependencies", "triggers"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.EventDependency", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.Template", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.Trigger"},
+			"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.DeadLetter", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.EventDependency", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.Idempotency", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.Template", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.Trigger"},
 	}
 }
 
@@ -1818,6 +3000,20 @@ func schema_pkg_apis_sensor_v1alpha1_SlackTrigger(ref common.ReferenceCallback)
 							Format:      "",
 						},
 					},
+					"blocks": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Blocks is a JSON array of Block Kit blocks to send to the Slack channel, the message being the fallback text of the notifications. The parameters with a destination prefixed with \"blocks.\" are applied to the blocks, e.g. \"blocks.0.text.text\". See https://api.slack.com/block-kit.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"threadTs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ThreadTS is the timestamp of the message to reply to in a thread, usually set from the events with a parameter. If empty, the message is sent to the channel.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -1903,6 +3099,21 @@ func schema_pkg_apis_sensor_v1alpha1_StatusPolicy(ref common.ReferenceCallback)
 							},
 						},
 					},
+					"allowRanges": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AllowRanges are the ranges of allowed response statuses, a class of statuses, e.g. \"2xx\", or a range of statuses, e.g. \"200-204\", in addition to the ones of Allow.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"allow"},
 			},
@@ -2115,11 +3326,136 @@ func schema_pkg_apis_sensor_v1alpha1_Trigger(ref common.ReferenceCallback) commo
 							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.RateLimit"),
 						},
 					},
+					"dedupe": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Dedupe suppresses the repeated executions of the trigger for the same key extracted from the events, within a time window.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerDedupe"),
+						},
+					},
+					"circuitBreaker": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CircuitBreaker stops calling the trigger for a while after consecutive failures.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerCircuitBreaker"),
+						},
+					},
+					"canaryGroup": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CanaryGroup is the group of triggers the events are split between, each event executing only one trigger of the group selected by their weights.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"weight": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Weight is the share of the events of the canary group executing the trigger, relative to the weights of the other triggers of the group, e.g. 10 and 90 for 10% and 90%.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"critical": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Critical marks the trigger as required for the sensor to be ready, the readiness probe of the sensor fails while the connectivity check of a critical trigger fails.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"redelivery": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Redelivery executes the trigger again with the same events after an execution failed, on an increasing schedule. Defaults to no redelivery.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerRedelivery"),
+						},
+					},
+					"dependsOn": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DependsOn are the names of the triggers this trigger is executed after, once they all succeeded for the same events. The trigger doesn't subscribe to the events itself, it is executed with the events of the trigger its graph starts from, and can refer to the outputs of the triggers it depends on.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/common.Backoff", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.RateLimit", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerCircuitBreaker", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerDedupe", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerPolicy", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerRedelivery", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerTemplate"},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_TriggerCircuitBreaker(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TriggerCircuitBreaker describes when to stop executing a trigger which keeps failing. The circuit opens after a number of consecutive failures within a window, the executions then fail fast without calling the trigger until the cooldown is over. A single execution is let through after the cooldown, closing the circuit if it succeeds or opening it again if it fails. The state is kept in memory, so it is scoped to a sensor pod.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"failureThreshold": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailureThreshold is the number of consecutive failures opening the circuit. Defaults to 5.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"window": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Window is the time in which the consecutive failures have to happen, counted from the first of them, e.g. \"30s\" or \"10m\". Defaults to 1m.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"cooldown": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Cooldown is how long the circuit stays open before an execution is let through to test the recovery of the trigger, e.g. \"30s\" or \"10m\". Defaults to 30s.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_sensor_v1alpha1_TriggerDedupe(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TriggerDedupe describes how to deduplicate the executions of a trigger. The keys are kept in memory, so the deduplication is scoped to a sensor pod.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"key": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Key is the source of the dedupe key, extracted from the event of a dependency with a context or data key, or a template. If the dependency has no event, the execution is not deduplicated unless a default value is specified.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameterSource"),
+						},
+					},
+					"ttl": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TTL is the window in which the executions for the same key are suppressed, counted from the first execution, e.g. \"30s\" or \"10m\". Defaults to 10m.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxKeys": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxKeys is the maximum number of keys kept in memory, the least recently used keys are evicted first. Defaults to 10000.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 				},
+				Required: []string{"key"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/common.Backoff", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.RateLimit", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameter", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerPolicy", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerTemplate"},
+			"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.TriggerParameterSource"},
 	}
 }
 
@@ -2151,6 +3487,13 @@ func schema_pkg_apis_sensor_v1alpha1_TriggerParameter(ref common.ReferenceCallba
 							Format:      "",
 						},
 					},
+					"template": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Template is a go-template evaluated against the events to produce the value of the parameter, Src is ignored if it is set. The data of each event is accessible under the name of its dependency, e.g. `{{ .input.body.id | upper }}`. The templating follows the standard go-template syntax as well as sprig's extra functions. See https://pkg.go.dev/text/template and https://masterminds.github.io/sprig/",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"dest"},
 			},
@@ -2210,10 +3553,25 @@ func schema_pkg_apis_sensor_v1alpha1_TriggerParameterSource(ref common.Reference
 							Format:      "",
 						},
 					},
+					"env": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Env is the name of an environment variable of the sensor container to use the value of, e.g. one set from the downward API. A value extracted from the event with a key or template takes precedence over it, and it takes precedence over Value. Without a key or template, the dependency name is optional.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"secret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Secret refers to a K8s secret to use the value of, e.g. a token to include in a payload. It takes precedence over the other fields of the source, and the dependency name is optional with it. The value is redacted from the logs of the sensor.",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
 				},
 				Required: []string{"dependencyName"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.SecretKeySelector"},
 	}
 }
 
@@ -2244,6 +3602,48 @@ func schema_pkg_apis_sensor_v1alpha1_TriggerPolicy(ref common.ReferenceCallback)
 	}
 }
 
+func schema_pkg_apis_sensor_v1alpha1_TriggerRedelivery(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TriggerRedelivery describes how the events of a failed trigger execution are redelivered to the trigger. Each redelivery waits longer than the previous one, from the initial delay up to the max delay. The retry strategy applies to each redelivery, the permanent failures are not redelivered. The redeliveries are kept in memory, so they are scoped to a sensor pod.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"initial": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Initial is the delay before the first redelivery, e.g. \"1s\" or \"30s\". Defaults to 1s.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"max": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Max is the maximum delay between the redeliveries, e.g. \"1m\" or \"10m\". Defaults to 5m.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"multiplier": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Multiplier is applied to the delay after each redelivery. Defaults to 2.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/common.Amount"),
+						},
+					},
+					"attempts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Attempts is the maximum number of redeliveries, the events are dropped once they are exhausted. Defaults to 5.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-events/pkg/apis/common.Amount"},
+	}
+}
+
 func schema_pkg_apis_sensor_v1alpha1_TriggerTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2352,12 +3752,69 @@ func schema_pkg_apis_sensor_v1alpha1_TriggerTemplate(ref common.ReferenceCallbac
 							},
 						},
 					},
+					"gcpCloudFunction": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GCPCloudFunction refers to the trigger designed to call a GCP Cloud Function with on-the-fly constructable payload.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.GCPCloudFunctionTrigger"),
+						},
+					},
+					"redisStream": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RedisStream refers to the trigger designed to add entries to a Redis stream.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.RedisStreamTrigger"),
+						},
+					},
+					"dryRun": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DryRun, if true, makes the trigger log the fully parameterized request instead of executing it, and skips the trigger policy.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"condition": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Condition is a CEL expression evaluated against the events before the trigger is executed, the trigger is skipped if it evaluates to false. The data of each event is accessible under the name of its dependency. For example: `events[\"dep\"].body.amount > 1000` See https://github.com/google/cel-spec for the syntax.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"awsSQS": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AWSSQS refers to the trigger designed to send messages to an AWS SQS queue.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.AWSSQSTrigger"),
+						},
+					},
+					"timeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timeout is the deadline of each execution of the trigger, e.g. \"30s\" or \"2m\". Defaults to no timeout.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"cloudEvent": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CloudEvent, if specified, wraps the payload of the trigger in a CloudEvents 1.0 structured JSON envelope. Its attributes can be templated from the events by the parameters of the trigger, e.g. with the dest \"cloudEvent.type\".",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.CloudEventEnvelope"),
+						},
+					},
+					"pushgateway": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Pushgateway refers to the trigger designed to push metrics to a Prometheus Pushgateway.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.PushgatewayTrigger"),
+						},
+					},
+					"bus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Bus refers to the trigger designed to publish the events to the EventBus the sensor is connected to, for other sensors to be triggered by them.",
+							Ref:         ref("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.BusTrigger"),
+						},
+					},
 				},
 				Required: []string{"name"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.AWSLambdaTrigger", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ArgoWorkflowTrigger", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.AzureEventHubsTrigger", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ConditionsResetCriteria", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.CustomTrigger", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.HTTPTrigger", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.KafkaTrigger", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.LogTrigger", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.NATSTrigger", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.OpenWhiskTrigger", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.PulsarTrigger", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.SlackTrigger", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.StandardK8STrigger"},
+			"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.AWSLambdaTrigger", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.AWSSQSTrigger", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ArgoWorkflowTrigger", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.AzureEventHubsTrigger", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.BusTrigger", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.CloudEventEnvelope", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.ConditionsResetCriteria", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.CustomTrigger", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.GCPCloudFunctionTrigger", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.HTTPTrigger", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.KafkaTrigger", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.LogTrigger", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.NATSTrigger", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.OpenWhiskTrigger", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.PulsarTrigger", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.PushgatewayTrigger", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.RedisStreamTrigger", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.SlackTrigger", "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1.StandardK8STrigger"},
 	}
 }
 
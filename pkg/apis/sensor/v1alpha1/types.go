@@ -106,6 +106,59 @@ type SensorSpec struct {
 	EventBusName string `json:"eventBusName,omitempty" protobuf:"bytes,5,opt,name=eventBusName"`
 	// Replicas is the sensor deployment replicas
 	Replicas *int32 `json:"replicas,omitempty" protobuf:"varint,6,opt,name=replicas"`
+	// Idempotency records the events each trigger has been executed for, and skips
+	// the executions for the events delivered again, e.g. after a restart of the sensor.
+	// +optional
+	Idempotency *Idempotency `json:"idempotency,omitempty" protobuf:"bytes,7,opt,name=idempotency"`
+	// TriggerConcurrency is the maximum number of trigger executions running at once in the sensor,
+	// the other executions waiting for one to finish. Defaults to no limit.
+	// +optional
+	TriggerConcurrency int32 `json:"triggerConcurrency,omitempty" protobuf:"varint,8,opt,name=triggerConcurrency"`
+	// DeliverySemantics is when the events are acknowledged on the eventbus, AtLeastOnce or AtMostOnce.
+	// With AtMostOnce, the events are acknowledged before the triggers are executed, so the executions
+	// interrupted by a crash of the sensor are not retried. Defaults to AtLeastOnce.
+	// +optional
+	DeliverySemantics DeliverySemantics `json:"deliverySemantics,omitempty" protobuf:"bytes,9,opt,name=deliverySemantics,casttype=DeliverySemantics"`
+	// DeadLetter forwards the events to a sink once the execution of a trigger failed for good, i.e.
+	// the retries and redeliveries are exhausted, the failure is permanent or the circuit is open.
+	// The events are dropped if it is not specified.
+	// +optional
+	DeadLetter *DeadLetter `json:"deadLetter,omitempty" protobuf:"bytes,10,opt,name=deadLetter"`
+	// MaxInFlightEvents is the maximum number of events of the trigger executions in progress in the sensor,
+	// from their delivery to the completion of their execution, including its retries and redeliveries. Once
+	// it is reached, the sensor stops taking events from the eventbus until executions complete, the events
+	// waiting on the eventbus rather than in memory. Defaults to no limit.
+	// +optional
+	MaxInFlightEvents int32 `json:"maxInFlightEvents,omitempty" protobuf:"varint,11,opt,name=maxInFlightEvents"`
+	// PartitionKey is a CEL expression evaluated against the events of each trigger execution, the executions
+	// of a trigger whose events share a key run one at a time, in the order the events were delivered, while
+	// the ones of different keys run in parallel. The data of each event is accessible under the name of its
+	// dependency, e.g. `events["dep"].body.customerId`. The executions whose key can't be evaluated are not
+	// ordered. Defaults to no ordering.
+	// +optional
+	PartitionKey string `json:"partitionKey,omitempty" protobuf:"bytes,12,opt,name=partitionKey"`
+	// MaxActivePartitions is the maximum number of partition keys with trigger executions queued or running.
+	// Once it is reached, the sensor stops taking events from the eventbus until a partition completes.
+	// Defaults to 1000.
+	// +optional
+	MaxActivePartitions int32 `json:"maxActivePartitions,omitempty" protobuf:"varint,13,opt,name=maxActivePartitions"`
+	// SensitiveFields are the paths of the fields of the data of all the events masked whenever an event is logged,
+	// in addition to the SensitiveFields of its dependency, e.g. "body.password".
+	// +optional
+	SensitiveFields []string `json:"sensitiveFields,omitempty" protobuf:"bytes,14,rep,name=sensitiveFields"`
+}
+
+// DeliverySemantics is the delivery guarantee of the events to the triggers of a sensor
+type DeliverySemantics string
+
+const (
+	DeliverySemanticsAtLeastOnce DeliverySemantics = "AtLeastOnce"
+	DeliverySemanticsAtMostOnce  DeliverySemantics = "AtMostOnce"
+)
+
+// IsAtMostOnce returns true if the events are acknowledged before the triggers are executed
+func (s SensorSpec) IsAtMostOnce() bool {
+	return s.DeliverySemantics == DeliverySemanticsAtMostOnce
 }
 
 func (s SensorSpec) GetReplicas() int32 {
@@ -119,6 +172,112 @@ func (s SensorSpec) GetReplicas() int32 {
 	return replicas
 }
 
+// Idempotency describes how the executions of the triggers are recorded. A record is kept
+// for each successful execution of a trigger, keyed by the trigger name and the IDs of the events.
+type Idempotency struct {
+	// TTL is how long the records are kept, e.g. "1h". Defaults to 24h.
+	// +optional
+	TTL string `json:"ttl,omitempty" protobuf:"bytes,1,opt,name=ttl"`
+	// JetStream keeps the records in a JetStream key-value bucket, for them to survive
+	// the restarts of the sensor. The records are kept in memory if it is not specified.
+	// +optional
+	JetStream *JetStreamIdempotencyStore `json:"jetStream,omitempty" protobuf:"bytes,2,opt,name=jetStream"`
+}
+
+// GetTTL returns how long the records are kept, an invalid TTL falls back to the default
+func (i Idempotency) GetTTL() time.Duration {
+	if i.TTL != "" {
+		if ttl, err := time.ParseDuration(i.TTL); err == nil && ttl > 0 {
+			return ttl
+		}
+	}
+	return 24 * time.Hour
+}
+
+// JetStreamIdempotencyStore refers to the JetStream key-value bucket of the records.
+type JetStreamIdempotencyStore struct {
+	// URL of the NATS server with JetStream enabled.
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+	// Bucket is the name of the key-value bucket, created if it does not exist.
+	// Defaults to "sensor-" followed by the name of the sensor.
+	// +optional
+	Bucket string `json:"bucket,omitempty" protobuf:"bytes,2,opt,name=bucket"`
+	// TLS configuration for the NATS client.
+	// +optional
+	TLS *apicommon.TLSConfig `json:"tls,omitempty" protobuf:"bytes,3,opt,name=tls"`
+}
+
+// GetBucket returns the name of the key-value bucket of the records of the sensor
+func (s JetStreamIdempotencyStore) GetBucket(sensorName string) string {
+	if s.Bucket != "" {
+		return s.Bucket
+	}
+	return "sensor-" + sensorName
+}
+
+// DeadLetter describes the sink of the events of the failed trigger executions. Exactly one sink must be
+// specified. The dead letters are JSON documents holding the events as they were delivered to the trigger,
+// the name of the trigger and the error of its last execution.
+type DeadLetter struct {
+	// EventBus publishes the dead letters to a subject of the NATS Streaming EventBus of the sensor.
+	// +optional
+	EventBus *EventBusDeadLetterSink `json:"eventBus,omitempty" protobuf:"bytes,1,opt,name=eventBus"`
+	// HTTP posts the dead letters to an HTTP endpoint.
+	// +optional
+	HTTP *HTTPDeadLetterSink `json:"http,omitempty" protobuf:"bytes,2,opt,name=http"`
+	// JetStream publishes the dead letters to a JetStream stream, waiting for the stream to store them.
+	// +optional
+	JetStream *JetStreamDeadLetterSink `json:"jetStream,omitempty" protobuf:"bytes,3,opt,name=jetStream"`
+}
+
+// EventBusDeadLetterSink refers to the subject of the EventBus the dead letters are published to.
+type EventBusDeadLetterSink struct {
+	// Subject is the subject, or channel, of the dead letters. It must differ from the subject of
+	// the events of the EventBus.
+	Subject string `json:"subject" protobuf:"bytes,1,opt,name=subject"`
+}
+
+// HTTPDeadLetterSink refers to the HTTP endpoint the dead letters are posted to. A response with a
+// status code other than 2xx fails the forwarding.
+type HTTPDeadLetterSink struct {
+	// URL of the endpoint.
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+	// Headers are added to the requests.
+	// +optional
+	Headers map[string]string `json:"headers,omitempty" protobuf:"bytes,2,rep,name=headers"`
+	// TLS configuration for the HTTP client.
+	// +optional
+	TLS *apicommon.TLSConfig `json:"tls,omitempty" protobuf:"bytes,3,opt,name=tls"`
+	// Timeout of the requests, e.g. "10s". Defaults to 10s.
+	// +optional
+	Timeout string `json:"timeout,omitempty" protobuf:"bytes,4,opt,name=timeout"`
+}
+
+// GetTimeout returns the timeout of the requests, an invalid timeout falls back to the default
+func (s HTTPDeadLetterSink) GetTimeout() time.Duration {
+	if s.Timeout != "" {
+		if timeout, err := time.ParseDuration(s.Timeout); err == nil && timeout > 0 {
+			return timeout
+		}
+	}
+	return 10 * time.Second
+}
+
+// JetStreamDeadLetterSink refers to the JetStream stream the dead letters are published to.
+type JetStreamDeadLetterSink struct {
+	// URL of the NATS server with JetStream enabled.
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+	// Subject of the dead letters, which must be bound to a stream.
+	Subject string `json:"subject" protobuf:"bytes,2,opt,name=subject"`
+	// Stream is the name of the stream the subject is expected to be bound to. The publishing
+	// fails if the subject is bound to another stream.
+	// +optional
+	Stream string `json:"stream,omitempty" protobuf:"bytes,3,opt,name=stream"`
+	// TLS configuration for the NATS client.
+	// +optional
+	TLS *apicommon.TLSConfig `json:"tls,omitempty" protobuf:"bytes,4,opt,name=tls"`
+}
+
 // Template holds the information of a sensor deployment template
 type Template struct {
 	// Metadata sets the pods's metadata, i.e. annotations and labels
@@ -201,6 +360,15 @@ type EventDependency struct {
 	// Available values: and (&&), or (||)
 	// Is optional and if left blank treated as and (&&).
 	FiltersLogicalOperator LogicalOperator `json:"filtersLogicalOperator,omitempty" protobuf:"bytes,6,opt,name=filtersLogicalOperator,casttype=LogicalOperator"`
+	// Schema is a JSON schema, written in JSON or YAML, which the event data must match
+	// after the transformation. The events which don't match it are rejected before the
+	// filters are applied.
+	// +optional
+	Schema string `json:"schema,omitempty" protobuf:"bytes,7,opt,name=schema"`
+	// SensitiveFields are the paths of the fields of the event data masked whenever the event is logged,
+	// e.g. "body.user.ssn". The triggers are executed with the event as is.
+	// +optional
+	SensitiveFields []string `json:"sensitiveFields,omitempty" protobuf:"bytes,8,rep,name=sensitiveFields"`
 }
 
 // EventDependencyTransformer transforms the event
@@ -321,6 +489,187 @@ type Trigger struct {
 	// Rate limit, default unit is Second
 	// +optional
 	RateLimit *RateLimit `json:"rateLimit,omitempty" protobuf:"bytes,5,opt,name=rateLimit"`
+	// Dedupe suppresses the repeated executions of the trigger for the same key
+	// extracted from the events, within a time window.
+	// +optional
+	Dedupe *TriggerDedupe `json:"dedupe,omitempty" protobuf:"bytes,6,opt,name=dedupe"`
+	// CircuitBreaker stops calling the trigger for a while after consecutive failures.
+	// +optional
+	CircuitBreaker *TriggerCircuitBreaker `json:"circuitBreaker,omitempty" protobuf:"bytes,7,opt,name=circuitBreaker"`
+	// CanaryGroup is the group of triggers the events are split between, each event
+	// executing only one trigger of the group selected by their weights.
+	// +optional
+	CanaryGroup string `json:"canaryGroup,omitempty" protobuf:"bytes,8,opt,name=canaryGroup"`
+	// Weight is the share of the events of the canary group executing the trigger, relative
+	// to the weights of the other triggers of the group, e.g. 10 and 90 for 10% and 90%.
+	// +optional
+	Weight int32 `json:"weight,omitempty" protobuf:"varint,9,opt,name=weight"`
+	// Critical marks the trigger as required for the sensor to be ready, the readiness probe
+	// of the sensor fails while the connectivity check of a critical trigger fails.
+	// +optional
+	Critical bool `json:"critical,omitempty" protobuf:"varint,10,opt,name=critical"`
+	// Redelivery executes the trigger again with the same events after an execution failed,
+	// on an increasing schedule. Defaults to no redelivery.
+	// +optional
+	Redelivery *TriggerRedelivery `json:"redelivery,omitempty" protobuf:"bytes,11,opt,name=redelivery"`
+	// DependsOn are the names of the triggers this trigger is executed after, once they all
+	// succeeded for the same events. The trigger doesn't subscribe to the events itself, it is
+	// executed with the events of the trigger its graph starts from, and can refer to the
+	// outputs of the triggers it depends on.
+	// +optional
+	DependsOn []string `json:"dependsOn,omitempty" protobuf:"bytes,12,rep,name=dependsOn"`
+}
+
+// TriggerDedupe describes how to deduplicate the executions of a trigger.
+// The keys are kept in memory, so the deduplication is scoped to a sensor pod.
+type TriggerDedupe struct {
+	// Key is the source of the dedupe key, extracted from the event of a dependency
+	// with a context or data key, or a template. If the dependency has no event,
+	// the execution is not deduplicated unless a default value is specified.
+	Key TriggerParameterSource `json:"key" protobuf:"bytes,1,opt,name=key"`
+	// TTL is the window in which the executions for the same key are suppressed,
+	// counted from the first execution, e.g. "30s" or "10m". Defaults to 10m.
+	// +optional
+	TTL string `json:"ttl,omitempty" protobuf:"bytes,2,opt,name=ttl"`
+	// MaxKeys is the maximum number of keys kept in memory, the least recently
+	// used keys are evicted first. Defaults to 10000.
+	// +optional
+	MaxKeys int32 `json:"maxKeys,omitempty" protobuf:"varint,3,opt,name=maxKeys"`
+}
+
+// GetTTL returns the dedupe window, an invalid TTL falls back to the default
+func (d TriggerDedupe) GetTTL() time.Duration {
+	if d.TTL != "" {
+		if ttl, err := time.ParseDuration(d.TTL); err == nil && ttl > 0 {
+			return ttl
+		}
+	}
+	return 10 * time.Minute
+}
+
+// GetMaxKeys returns the maximum number of keys kept in memory
+func (d TriggerDedupe) GetMaxKeys() int {
+	if d.MaxKeys <= 0 {
+		return 10000
+	}
+	return int(d.MaxKeys)
+}
+
+// TriggerCircuitBreaker describes when to stop executing a trigger which keeps failing.
+// The circuit opens after a number of consecutive failures within a window, the executions
+// then fail fast without calling the trigger until the cooldown is over. A single execution
+// is let through after the cooldown, closing the circuit if it succeeds or opening it again
+// if it fails. The state is kept in memory, so it is scoped to a sensor pod.
+type TriggerCircuitBreaker struct {
+	// FailureThreshold is the number of consecutive failures opening the circuit. Defaults to 5.
+	// +optional
+	FailureThreshold int32 `json:"failureThreshold,omitempty" protobuf:"varint,1,opt,name=failureThreshold"`
+	// Window is the time in which the consecutive failures have to happen, counted from the first
+	// of them, e.g. "30s" or "10m". Defaults to 1m.
+	// +optional
+	Window string `json:"window,omitempty" protobuf:"bytes,2,opt,name=window"`
+	// Cooldown is how long the circuit stays open before an execution is let through to test the
+	// recovery of the trigger, e.g. "30s" or "10m". Defaults to 30s.
+	// +optional
+	Cooldown string `json:"cooldown,omitempty" protobuf:"bytes,3,opt,name=cooldown"`
+}
+
+// GetFailureThreshold returns the number of consecutive failures opening the circuit
+func (c TriggerCircuitBreaker) GetFailureThreshold() int {
+	if c.FailureThreshold <= 0 {
+		return 5
+	}
+	return int(c.FailureThreshold)
+}
+
+// GetWindow returns the window of the consecutive failures, an invalid window falls back to the default
+func (c TriggerCircuitBreaker) GetWindow() time.Duration {
+	if c.Window != "" {
+		if window, err := time.ParseDuration(c.Window); err == nil && window > 0 {
+			return window
+		}
+	}
+	return time.Minute
+}
+
+// GetCooldown returns how long the circuit stays open, an invalid cooldown falls back to the default
+func (c TriggerCircuitBreaker) GetCooldown() time.Duration {
+	if c.Cooldown != "" {
+		if cooldown, err := time.ParseDuration(c.Cooldown); err == nil && cooldown > 0 {
+			return cooldown
+		}
+	}
+	return 30 * time.Second
+}
+
+// TriggerRedelivery describes how the events of a failed trigger execution are redelivered to the trigger.
+// Each redelivery waits longer than the previous one, from the initial delay up to the max delay. The
+// retry strategy applies to each redelivery, the permanent failures are not redelivered. The redeliveries
+// are kept in memory, so they are scoped to a sensor pod.
+type TriggerRedelivery struct {
+	// Initial is the delay before the first redelivery, e.g. "1s" or "30s". Defaults to 1s.
+	// +optional
+	Initial string `json:"initial,omitempty" protobuf:"bytes,1,opt,name=initial"`
+	// Max is the maximum delay between the redeliveries, e.g. "1m" or "10m". Defaults to 5m.
+	// +optional
+	Max string `json:"max,omitempty" protobuf:"bytes,2,opt,name=max"`
+	// Multiplier is applied to the delay after each redelivery. Defaults to 2.
+	// +optional
+	Multiplier *apicommon.Amount `json:"multiplier,omitempty" protobuf:"bytes,3,opt,name=multiplier"`
+	// Attempts is the maximum number of redeliveries, the events are dropped once they are
+	// exhausted. Defaults to 5.
+	// +optional
+	Attempts int32 `json:"attempts,omitempty" protobuf:"varint,4,opt,name=attempts"`
+}
+
+// GetInitial returns the delay before the first redelivery, an invalid delay falls back to the default
+func (r TriggerRedelivery) GetInitial() time.Duration {
+	if r.Initial != "" {
+		if initial, err := time.ParseDuration(r.Initial); err == nil && initial > 0 {
+			return initial
+		}
+	}
+	return time.Second
+}
+
+// GetMax returns the maximum delay between the redeliveries, an invalid delay falls back to the default
+func (r TriggerRedelivery) GetMax() time.Duration {
+	if r.Max != "" {
+		if max, err := time.ParseDuration(r.Max); err == nil && max > 0 {
+			return max
+		}
+	}
+	return 5 * time.Minute
+}
+
+// GetMultiplier returns the multiplier of the delay, an invalid multiplier falls back to the default
+func (r TriggerRedelivery) GetMultiplier() float64 {
+	if r.Multiplier != nil {
+		if multiplier, err := r.Multiplier.Float64(); err == nil && multiplier >= 1 {
+			return multiplier
+		}
+	}
+	return 2
+}
+
+// GetAttempts returns the maximum number of redeliveries
+func (r TriggerRedelivery) GetAttempts() int {
+	if r.Attempts <= 0 {
+		return 5
+	}
+	return int(r.Attempts)
+}
+
+// GetDelay returns the delay before the given redelivery, counted from 1
+func (r TriggerRedelivery) GetDelay(attempt int) time.Duration {
+	delay := float64(r.GetInitial())
+	for i := 1; i < attempt && delay < float64(r.GetMax()); i++ {
+		delay *= r.GetMultiplier()
+	}
+	if delay > float64(r.GetMax()) {
+		return r.GetMax()
+	}
+	return time.Duration(delay)
 }
 
 type RateLimiteUnit string
@@ -331,10 +680,43 @@ const (
 	Hour   RateLimiteUnit = "Hour"
 )
 
+// RateLimitOverflow is the policy applied to a trigger execution exceeding the rate limit
+type RateLimitOverflow string
+
+const (
+	// RateLimitOverflowBlock waits until the execution is allowed by the rate limit
+	RateLimitOverflowBlock RateLimitOverflow = "Block"
+	// RateLimitOverflowDrop drops the execution
+	RateLimitOverflowDrop RateLimitOverflow = "Drop"
+)
+
 type RateLimit struct {
 	// Defaults to Second
 	Unit            RateLimiteUnit `json:"unit,omitempty" protobuf:"bytes,1,opt,name=unit"`
 	RequestsPerUnit int32          `json:"requestsPerUnit,omitempty" protobuf:"bytes,2,opt,name=requestsPerUnit"`
+	// Burst is the maximum number of executions allowed at once, defaults to 1
+	// +optional
+	Burst int32 `json:"burst,omitempty" protobuf:"varint,3,opt,name=burst"`
+	// Overflow is the policy applied to an execution exceeding the rate limit,
+	// Block or Drop, defaults to Block.
+	// +optional
+	Overflow RateLimitOverflow `json:"overflow,omitempty" protobuf:"bytes,4,opt,name=overflow,casttype=RateLimitOverflow"`
+}
+
+// GetBurst returns the burst of the rate limit
+func (r RateLimit) GetBurst() int {
+	if r.Burst <= 0 {
+		return 1
+	}
+	return int(r.Burst)
+}
+
+// GetOverflow returns the overflow policy of the rate limit
+func (r RateLimit) GetOverflow() RateLimitOverflow {
+	if r.Overflow == "" {
+		return RateLimitOverflowBlock
+	}
+	return r.Overflow
 }
 
 // TriggerTemplate is the template that describes trigger specification.
@@ -384,6 +766,72 @@ type TriggerTemplate struct {
 	// Criteria to reset the conditons
 	// +optional
 	ConditionsReset []ConditionsResetCriteria `json:"conditionsReset,omitempty" protobuf:"bytes,15,rep,name=conditionsReset"`
+	// GCPCloudFunction refers to the trigger designed to call a GCP Cloud Function with on-the-fly constructable payload.
+	// +optional
+	GCPCloudFunction *GCPCloudFunctionTrigger `json:"gcpCloudFunction,omitempty" protobuf:"bytes,16,opt,name=gcpCloudFunction"`
+	// RedisStream refers to the trigger designed to add entries to a Redis stream.
+	// +optional
+	RedisStream *RedisStreamTrigger `json:"redisStream,omitempty" protobuf:"bytes,17,opt,name=redisStream"`
+	// DryRun, if true, makes the trigger log the fully parameterized request instead of executing it,
+	// and skips the trigger policy.
+	// +optional
+	DryRun bool `json:"dryRun,omitempty" protobuf:"varint,18,opt,name=dryRun"`
+	// Condition is a CEL expression evaluated against the events before the trigger is executed, the trigger
+	// is skipped if it evaluates to false. The data of each event is accessible under the name of its dependency.
+	// For example: `events["dep"].body.amount > 1000`
+	// See https://github.com/google/cel-spec for the syntax.
+	// +optional
+	Condition string `json:"condition,omitempty" protobuf:"bytes,19,opt,name=condition"`
+	// AWSSQS refers to the trigger designed to send messages to an AWS SQS queue.
+	// +optional
+	AWSSQS *AWSSQSTrigger `json:"awsSQS,omitempty" protobuf:"bytes,20,opt,name=awsSQS"`
+	// Timeout is the deadline of each execution of the trigger, e.g. "30s" or "2m".
+	// Defaults to no timeout.
+	// +optional
+	Timeout string `json:"timeout,omitempty" protobuf:"bytes,21,opt,name=timeout"`
+	// CloudEvent, if specified, wraps the payload of the trigger in a CloudEvents 1.0 structured JSON envelope.
+	// Its attributes can be templated from the events by the parameters of the trigger, e.g. with the dest
+	// "cloudEvent.type".
+	// +optional
+	CloudEvent *CloudEventEnvelope `json:"cloudEvent,omitempty" protobuf:"bytes,22,opt,name=cloudEvent"`
+	// Pushgateway refers to the trigger designed to push metrics to a Prometheus Pushgateway.
+	// +optional
+	Pushgateway *PushgatewayTrigger `json:"pushgateway,omitempty" protobuf:"bytes,23,opt,name=pushgateway"`
+	// Bus refers to the trigger designed to publish the events to the EventBus the sensor is connected to,
+	// for other sensors to be triggered by them.
+	// +optional
+	Bus *BusTrigger `json:"bus,omitempty" protobuf:"bytes,24,opt,name=bus"`
+}
+
+// CloudEventEnvelope describes the attributes of the CloudEvent the payload of a trigger is sent as, the
+// payload being its data. The attributes which are not specified are taken from the event the payload is
+// constructed from, or from the sensor if it is constructed from several events.
+type CloudEventEnvelope struct {
+	// Type of the CloudEvent, e.g. "com.example.order.created".
+	// Defaults to the type of the event, or "io.argoproj.sensor.trigger" for several events.
+	// +optional
+	Type string `json:"type,omitempty" protobuf:"bytes,1,opt,name=type"`
+	// Source of the CloudEvent, a URI reference, e.g. "/orders".
+	// Defaults to the source of the event, or "/namespaces/{namespace}/sensors/{sensor}" for several events.
+	// +optional
+	Source string `json:"source,omitempty" protobuf:"bytes,2,opt,name=source"`
+	// Subject of the CloudEvent.
+	// Defaults to the subject of the event, if any.
+	// +optional
+	Subject string `json:"subject,omitempty" protobuf:"bytes,3,opt,name=subject"`
+	// Extensions are the extension attributes of the CloudEvent. The names must be lowercase alphanumeric.
+	// +optional
+	Extensions map[string]string `json:"extensions,omitempty" protobuf:"bytes,4,rep,name=extensions"`
+}
+
+// GetTimeout returns the deadline of each execution of the trigger, 0 if there is none
+func (t TriggerTemplate) GetTimeout() time.Duration {
+	if t.Timeout != "" {
+		if timeout, err := time.ParseDuration(t.Timeout); err == nil && timeout > 0 {
+			return timeout
+		}
+	}
+	return 0
 }
 
 type ConditionsResetCriteria struct {
@@ -472,6 +920,19 @@ type HTTPTrigger struct {
 	// Secure Headers stored in Kubernetes Secrets for the HTTP requests.
 	// +optional
 	SecureHeaders []*apicommon.SecureHeader `json:"secureHeaders,omitempty" protobuf:"bytes,9,rep,name=secureHeaders"`
+	// MaxResponseSize is the max size in bytes of the response body, once decoded if it is compressed with
+	// gzip or deflate. A bigger response fails the execution. Defaults to 10MiB.
+	// +optional
+	MaxResponseSize int64 `json:"maxResponseSize,omitempty" protobuf:"varint,10,opt,name=maxResponseSize"`
+	// RetryStrategy is the backoff of the retries of the request on network errors and on the responses
+	// with a status of RetryOn. The response of the last attempt is the one the policy of the trigger is
+	// applied to. Defaults to a single attempt.
+	// +optional
+	RetryStrategy *apicommon.Backoff `json:"retryStrategy,omitempty" protobuf:"bytes,11,opt,name=retryStrategy"`
+	// RetryOn are the response statuses the request is retried on, single statuses, e.g. "429", classes of
+	// statuses, e.g. "5xx", or ranges of statuses, e.g. "500-504". Defaults to 429, 500, 502, 503 and 504.
+	// +optional
+	RetryOn []string `json:"retryOn,omitempty" protobuf:"bytes,12,rep,name=retryOn"`
 }
 
 // AWSLambdaTrigger refers to specification of the trigger to invoke an AWS Lambda function
@@ -511,6 +972,310 @@ type AWSLambdaTrigger struct {
 	RoleARN string `json:"roleARN,omitempty" protobuf:"bytes,8,opt,name=roleARN"`
 }
 
+// AWSSQSTrigger refers to specification of the trigger to send messages to an AWS SQS queue
+type AWSSQSTrigger struct {
+	// QueueURL refers to the URL of the queue to send the messages to.
+	// FIFO queues are identified by the ".fifo" suffix of their name.
+	QueueURL string `json:"queueURL" protobuf:"bytes,1,opt,name=queueURL"`
+	// Region is AWS region
+	Region string `json:"region" protobuf:"bytes,2,opt,name=region"`
+	// AccessKey refers K8s secret containing aws access key
+	// +optional
+	AccessKey *corev1.SecretKeySelector `json:"accessKey,omitempty" protobuf:"bytes,3,opt,name=accessKey"`
+	// SecretKey refers K8s secret containing aws secret key
+	// +optional
+	SecretKey *corev1.SecretKeySelector `json:"secretKey,omitempty" protobuf:"bytes,4,opt,name=secretKey"`
+	// RoleARN is the Amazon Resource Name (ARN) of the role to assume.
+	// +optional
+	RoleARN string `json:"roleARN,omitempty" protobuf:"bytes,5,opt,name=roleARN"`
+	// MessageGroupID is the group of the messages of a FIFO queue, the messages of a group are
+	// delivered in order. Required for FIFO queues, it is usually set from the event data with a parameter.
+	// +optional
+	MessageGroupID string `json:"messageGroupID,omitempty" protobuf:"bytes,6,opt,name=messageGroupID"`
+	// MessageDeduplicationID is the ID used to deduplicate the messages sent to a FIFO queue.
+	// If not specified, the queue must have content-based deduplication enabled.
+	// +optional
+	MessageDeduplicationID string `json:"messageDeduplicationID,omitempty" protobuf:"bytes,7,opt,name=messageDeduplicationID"`
+	// Payload is the list of key-value extracted from an event payload to construct the message body.
+	Payload []TriggerParameter `json:"payload" protobuf:"bytes,8,rep,name=payload"`
+	// Parameters is the list of key-value extracted from event's payload that are applied to
+	// the trigger resource.
+	// +optional
+	Parameters []TriggerParameter `json:"parameters,omitempty" protobuf:"bytes,9,rep,name=parameters"`
+}
+
+// GCPCloudFunctionTrigger refers to specification of the trigger to call a GCP Cloud Function
+type GCPCloudFunctionTrigger struct {
+	// FunctionName refers to the full resource name of the function to call,
+	// in the format of "projects/{project}/locations/{location}/functions/{function}".
+	FunctionName string `json:"functionName" protobuf:"bytes,1,opt,name=functionName"`
+	// CredentialsPath refers to the path of a mounted service account JSON key file.
+	// +optional
+	CredentialsPath string `json:"credentialsPath,omitempty" protobuf:"bytes,2,opt,name=credentialsPath"`
+	// Payload is the list of key-value extracted from an event payload to construct the request payload.
+	// Required unless a transform is specified.
+	// +optional
+	Payload []TriggerParameter `json:"payload,omitempty" protobuf:"bytes,3,rep,name=payload"`
+	// Parameters is the list of key-value extracted from event's payload that are applied to
+	// the trigger resource.
+	// +optional
+	Parameters []TriggerParameter `json:"parameters,omitempty" protobuf:"bytes,4,rep,name=parameters"`
+	// RetryStrategy is the backoff used to retry the asynchronous function calls when GCP returns a
+	// retryable error, e.g. 429 or 503. Non-retryable errors are returned immediately.
+	// Defaults to the retry strategy of the trigger, which retries the other calls.
+	// +optional
+	RetryStrategy *apicommon.Backoff `json:"retryStrategy,omitempty" protobuf:"bytes,5,opt,name=retryStrategy"`
+	// CredentialsSecret refers to a K8s secret containing the service account JSON key.
+	// It takes precedence over CredentialsPath if both are specified. If neither is specified,
+	// Application Default Credentials are used.
+	// +optional
+	CredentialsSecret *corev1.SecretKeySelector `json:"credentialsSecret,omitempty" protobuf:"bytes,6,opt,name=credentialsSecret"`
+	// Encoding applied to the payload before calling the function, none, base64 or gzip.
+	// The gzip encoding compresses the payload and base64 encodes the result. Defaults to none.
+	// +optional
+	Encoding GCPCloudFunctionEncoding `json:"encoding,omitempty" protobuf:"bytes,7,opt,name=encoding,casttype=GCPCloudFunctionEncoding"`
+	// CACertificate refers to a K8s secret containing a PEM encoded CA bundle to trust in addition
+	// to the system roots, e.g. the CA of a TLS inspecting proxy.
+	// +optional
+	CACertificate *corev1.SecretKeySelector `json:"caCertificate,omitempty" protobuf:"bytes,8,opt,name=caCertificate"`
+	// ProxyURL is the URL of the proxy to call GCP through, e.g. "http://proxy.example.com:3128".
+	// +optional
+	ProxyURL string `json:"proxyURL,omitempty" protobuf:"bytes,9,opt,name=proxyURL"`
+	// Batch accumulates the executions of the trigger and calls the function once per batch, with
+	// a JSON array of their payloads. Defaults to a call per execution.
+	// +optional
+	Batch *GCPCloudFunctionBatch `json:"batch,omitempty" protobuf:"bytes,10,opt,name=batch"`
+	// Generation of the function, 1 or 2. The 1st gen functions are called through the Cloud Functions
+	// API, the 2nd gen ones are called over HTTP on their URL, with an identity token. Defaults to 1.
+	// +optional
+	Generation int32 `json:"generation,omitempty" protobuf:"varint,11,opt,name=generation"`
+	// URL is the HTTPS endpoint of a 2nd gen function, e.g. "https://{function}-{hash}-{region}.a.run.app",
+	// it is also the audience of the identity token. Required for the 2nd gen functions, it can't be templated.
+	// +optional
+	URL string `json:"url,omitempty" protobuf:"bytes,12,opt,name=url"`
+	// Location replaces the location of FunctionName, e.g. to follow the region of the cluster
+	// with a parameter reading it from an environment variable.
+	// +optional
+	Location string `json:"location,omitempty" protobuf:"bytes,13,opt,name=location"`
+	// Envelope wraps the encoded payload in a JSON envelope carrying its content type, encoding and size,
+	// for the function to know how to decode it. Defaults to false, i.e. the payload is sent as is.
+	// +optional
+	Envelope bool `json:"envelope,omitempty" protobuf:"varint,14,opt,name=envelope"`
+	// Invocation is how the function is invoked, "call" to call it, or "pubsub" to publish the payload
+	// to the Pub/Sub topic triggering it. Defaults to call.
+	// +optional
+	Invocation GCPCloudFunctionInvocation `json:"invocation,omitempty" protobuf:"bytes,15,opt,name=invocation,casttype=GCPCloudFunctionInvocation"`
+	// Topic is the full resource name of the Pub/Sub topic triggering the function, in the format of
+	// "projects/{project}/topics/{topic}". Required for the pubsub invocation, it can't be templated.
+	// +optional
+	Topic string `json:"topic,omitempty" protobuf:"bytes,16,opt,name=topic"`
+	// Async calls the function in the background, the execution succeeding once the call is dispatched
+	// rather than once the function responded. The failures of the call are logged and counted, but
+	// don't fail the execution. The call is made before the execution returns if the concurrency limit
+	// of the sensor is reached.
+	// +optional
+	Async bool `json:"async,omitempty" protobuf:"varint,17,opt,name=async"`
+	// Headers for the requests to 2nd gen functions, or the attributes of the messages published
+	// for the pubsub invocation. They can be templated with parameters, e.g. with dest "headers.X-Tenant".
+	// +optional
+	Headers map[string]string `json:"headers,omitempty" protobuf:"bytes,18,rep,name=headers"`
+	// SecureHeaders stored in Kubernetes Secrets or ConfigMaps, added like Headers.
+	// Their values are never logged.
+	// +optional
+	SecureHeaders []*apicommon.SecureHeader `json:"secureHeaders,omitempty" protobuf:"bytes,19,rep,name=secureHeaders"`
+	// ResponseLogging logs the responses of the function, they are not logged if not specified.
+	// +optional
+	ResponseLogging *GCPCloudFunctionResponseLogging `json:"responseLogging,omitempty" protobuf:"bytes,20,opt,name=responseLogging"`
+	// Transform is a CEL expression evaluated against the events, the object it evaluates to is the payload,
+	// with the payload parameters applied on top of it. The data of each event is accessible under the name
+	// of its dependency. For example: `{"user": events["dep"].body.user.name, "total": events["a"].body.amount + events["b"].body.amount}`
+	// The payload parameters are optional with a transform.
+	// +optional
+	Transform string `json:"transform,omitempty" protobuf:"bytes,21,opt,name=transform"`
+	// ImpersonateServiceAccount is the email of the service account the function is called as, e.g.
+	// "invoker@{project}.iam.gserviceaccount.com". The credentials of the trigger, or the Application Default
+	// Credentials, must be allowed to create tokens for it, i.e. have the Service Account Token Creator role on it.
+	// +optional
+	ImpersonateServiceAccount string `json:"impersonateServiceAccount,omitempty" protobuf:"bytes,22,opt,name=impersonateServiceAccount"`
+	// ImpersonationDelegates is the chain of service accounts the impersonation is delegated through, each one
+	// allowed to create tokens for the next one, the last one for the impersonated service account.
+	// +optional
+	ImpersonationDelegates []string `json:"impersonationDelegates,omitempty" protobuf:"bytes,23,rep,name=impersonationDelegates"`
+	// Credentials are named K8s secrets containing service account JSON keys, e.g. one per tenant, the one of
+	// each execution being selected by CredentialsKey. They take precedence over CredentialsSecret and CredentialsPath.
+	// +optional
+	Credentials map[string]corev1.SecretKeySelector `json:"credentials,omitempty" protobuf:"bytes,24,rep,name=credentials"`
+	// CredentialsKey is the name of the Credentials to call the function with, usually templated from the events
+	// with a parameter of dest "credentialsKey". An execution selecting none of the Credentials fails.
+	// +optional
+	CredentialsKey string `json:"credentialsKey,omitempty" protobuf:"bytes,25,opt,name=credentialsKey"`
+	// MaxResponseSize is the max size in bytes of the response body of the 2nd gen functions, once decoded
+	// if it is compressed with gzip or deflate. A bigger response fails the execution. Defaults to 10MiB.
+	// +optional
+	MaxResponseSize int64 `json:"maxResponseSize,omitempty" protobuf:"varint,26,opt,name=maxResponseSize"`
+	// UserAgent is appended to the User-Agent of the calls, which identifies Argo Events, the sensor and the trigger,
+	// e.g. "billing-team/1.0" for the calls to be told apart in Cloud Logging.
+	// +optional
+	UserAgent string `json:"userAgent,omitempty" protobuf:"bytes,27,opt,name=userAgent"`
+	// RetryOnResponse is a CEL expression evaluated against the responses of the function, in addition to the
+	// classification of their status, the calls it evaluates to true for being retried,
+	// and the failed calls it evaluates to false for not being retried. The status and the body of the response,
+	// decoded if it is JSON, are accessible under response. For example: `has(response.body.retryable) && response.body.retryable`
+	// It is evaluated against the results of the 1st gen functions, and the responses of the 2nd gen ones.
+	// +optional
+	RetryOnResponse string `json:"retryOnResponse,omitempty" protobuf:"bytes,28,opt,name=retryOnResponse"`
+}
+
+// GetGeneration returns the generation of the function, 1 if not specified
+func (t GCPCloudFunctionTrigger) GetGeneration() int32 {
+	if t.Generation == 0 {
+		return 1
+	}
+	return t.Generation
+}
+
+// GetInvocation returns how the function is invoked, call if not specified
+func (t GCPCloudFunctionTrigger) GetInvocation() GCPCloudFunctionInvocation {
+	if t.Invocation == "" {
+		return GCPCloudFunctionInvocationCall
+	}
+	return t.Invocation
+}
+
+// GCPCloudFunctionResponseLogging describes how the responses of a GCP Cloud Function trigger are logged.
+type GCPCloudFunctionResponseLogging struct {
+	// Level is the level to log the responses at, debug, info, warn or error. Defaults to debug.
+	// +optional
+	Level LogLevel `json:"level,omitempty" protobuf:"bytes,1,opt,name=level,casttype=LogLevel"`
+	// Redact is the list of the JSON paths of the fields redacted from the logged responses, e.g. "user.email".
+	// If specified, the responses which are not JSON are logged as their size and SHA-256 hash instead.
+	// +optional
+	Redact []string `json:"redact,omitempty" protobuf:"bytes,2,rep,name=redact"`
+}
+
+// GetLevel returns the level to log the responses at, debug if not specified
+func (l GCPCloudFunctionResponseLogging) GetLevel() LogLevel {
+	if l.Level == "" {
+		return LogLevelDebug
+	}
+	return l.Level
+}
+
+// GCPCloudFunctionBatch describes how to batch the executions of a GCP Cloud Function trigger.
+// A batch is flushed once it holds MaxSize executions, or MaxWait after its first execution.
+type GCPCloudFunctionBatch struct {
+	// MaxSize is the maximum number of executions in a batch. Defaults to 10.
+	// +optional
+	MaxSize int32 `json:"maxSize,omitempty" protobuf:"varint,1,opt,name=maxSize"`
+	// MaxWait is how long a batch waits for more executions after the first one, e.g. "500ms" or "5s".
+	// Defaults to 1s.
+	// +optional
+	MaxWait string `json:"maxWait,omitempty" protobuf:"bytes,2,opt,name=maxWait"`
+}
+
+// GetMaxSize returns the maximum number of executions in a batch
+func (b GCPCloudFunctionBatch) GetMaxSize() int {
+	if b.MaxSize <= 0 {
+		return 10
+	}
+	return int(b.MaxSize)
+}
+
+// GetMaxWait returns how long a batch waits for more executions, an invalid duration falls back to the default
+func (b GCPCloudFunctionBatch) GetMaxWait() time.Duration {
+	if b.MaxWait != "" {
+		if maxWait, err := time.ParseDuration(b.MaxWait); err == nil && maxWait > 0 {
+			return maxWait
+		}
+	}
+	return time.Second
+}
+
+// GCPCloudFunctionEncoding is the encoding applied to the payload of a GCP Cloud Function call
+type GCPCloudFunctionEncoding string
+
+const (
+	GCPCloudFunctionEncodingNone   GCPCloudFunctionEncoding = "none"
+	GCPCloudFunctionEncodingBase64 GCPCloudFunctionEncoding = "base64"
+	GCPCloudFunctionEncodingGzip   GCPCloudFunctionEncoding = "gzip"
+)
+
+// GCPCloudFunctionInvocation is how a GCP Cloud Function is invoked
+type GCPCloudFunctionInvocation string
+
+const (
+	GCPCloudFunctionInvocationCall   GCPCloudFunctionInvocation = "call"
+	GCPCloudFunctionInvocationPubSub GCPCloudFunctionInvocation = "pubsub"
+)
+
+// RedisStreamTrigger refers to the specification of the Redis stream trigger.
+type RedisStreamTrigger struct {
+	// HostAddress refers to the address of the Redis host/server (master instance)
+	HostAddress string `json:"hostAddress" protobuf:"bytes,1,opt,name=hostAddress"`
+	// Password required for authentication if any.
+	// +optional
+	Password *corev1.SecretKeySelector `json:"password,omitempty" protobuf:"bytes,2,opt,name=password"`
+	// DB to use. If not specified, default DB 0 will be used.
+	// +optional
+	DB int32 `json:"db,omitempty" protobuf:"varint,3,opt,name=db"`
+	// TLS configuration for the redis client.
+	// +optional
+	TLS *apicommon.TLSConfig `json:"tls,omitempty" protobuf:"bytes,4,opt,name=tls"`
+	// Stream refers to the key of the stream to add entries to.
+	Stream string `json:"stream" protobuf:"bytes,5,opt,name=stream"`
+	// MaxLen trims the stream to the given number of entries on each XADD.
+	// If not specified, the stream is not trimmed.
+	// +optional
+	MaxLen int64 `json:"maxLen,omitempty" protobuf:"varint,6,opt,name=maxLen"`
+	// Payload is the list of key-value extracted from an event payload to construct the entry.
+	// Each top level key of the constructed payload becomes a field of the entry.
+	Payload []TriggerParameter `json:"payload" protobuf:"bytes,7,rep,name=payload"`
+	// Parameters is the list of key-value extracted from event's payload that are applied to
+	// the trigger resource.
+	// +optional
+	Parameters []TriggerParameter `json:"parameters,omitempty" protobuf:"bytes,8,rep,name=parameters"`
+}
+
+// PushgatewayTrigger refers to the specification of the trigger pushing metrics to a Prometheus Pushgateway,
+// e.g. on the completion of a batch job.
+type PushgatewayTrigger struct {
+	// URL of the Pushgateway, e.g. http://pushgateway.monitoring:9091
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+	// Job is the job label of the grouping key of the metrics.
+	Job string `json:"job" protobuf:"bytes,2,opt,name=job"`
+	// Grouping are the other labels of the grouping key of the metrics, e.g. instance.
+	// +optional
+	Grouping map[string]string `json:"grouping,omitempty" protobuf:"bytes,3,rep,name=grouping"`
+	// Metrics are the gauges pushed to the Pushgateway.
+	Metrics []PushgatewayMetric `json:"metrics" protobuf:"bytes,4,rep,name=metrics"`
+	// Replace replaces all the metrics of the grouping key, rather than only the ones with the same names
+	// as the pushed metrics.
+	// +optional
+	Replace bool `json:"replace,omitempty" protobuf:"varint,5,opt,name=replace"`
+	// BasicAuth configuration for the pushes.
+	// +optional
+	BasicAuth *apicommon.BasicAuth `json:"basicAuth,omitempty" protobuf:"bytes,6,opt,name=basicAuth"`
+	// Parameters is the list of key-value extracted from event's payload that are applied to
+	// the trigger resource.
+	// +optional
+	Parameters []TriggerParameter `json:"parameters,omitempty" protobuf:"bytes,7,rep,name=parameters"`
+}
+
+// PushgatewayMetric is a gauge pushed to a Prometheus Pushgateway
+type PushgatewayMetric struct {
+	// Name of the gauge.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Help describes the gauge.
+	// +optional
+	Help string `json:"help,omitempty" protobuf:"bytes,2,opt,name=help"`
+	// Labels of the gauge.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty" protobuf:"bytes,3,rep,name=labels"`
+	// Value of the gauge, a number or a Go template resolving to a number from the data of the events by
+	// dependency name, e.g. "{{ .dep.body.duration }}".
+	Value string `json:"value" protobuf:"bytes,4,opt,name=value"`
+}
+
 // AzureEventHubsTrigger refers to specification of the Azure Event Hubs Trigger
 type AzureEventHubsTrigger struct {
 	// FQDN refers to the namespace dns of Azure Event Hubs to be used i.e. <namespace>.servicebus.windows.net
@@ -568,6 +1333,15 @@ type KafkaTrigger struct {
 	// SASL configuration for the kafka client
 	// +optional
 	SASL *apicommon.SASLConfig `json:"sasl,omitempty" protobuf:"bytes,12,opt,name=sasl"`
+	// Idempotent enables the idempotent producer, for the retries of the producer not to duplicate the
+	// messages. It requires the acknowledgement of all the in-sync replicas, and Kafka 0.11.0.0 or later.
+	// +optional
+	Idempotent bool `json:"idempotent,omitempty" protobuf:"varint,13,opt,name=idempotent"`
+	// PartitioningKeyTemplate is the Go template of the partitioning key of the messages, executed
+	// against the data of the events keyed by their dependency names, e.g. {{ .order.customerId }}.
+	// It takes precedence over the partitioning key.
+	// +optional
+	PartitioningKeyTemplate string `json:"partitioningKeyTemplate,omitempty" protobuf:"bytes,14,opt,name=partitioningKeyTemplate"`
 }
 
 // PulsarTrigger refers to the specification of the Pulsar trigger.
@@ -600,6 +1374,10 @@ type PulsarTrigger struct {
 	// Backoff holds parameters applied to connection.
 	// +optional
 	ConnectionBackoff *apicommon.Backoff `json:"connectionBackoff,omitempty" protobuf:"bytes,10,opt,name=connectionBackoff"`
+	// Properties are set on the produced messages, they can be templated from the events
+	// by the parameters with "properties.<name>" as destination.
+	// +optional
+	Properties map[string]string `json:"properties,omitempty" protobuf:"bytes,11,rep,name=properties"`
 }
 
 // NATSTrigger refers to the specification of the NATS trigger.
@@ -619,6 +1397,27 @@ type NATSTrigger struct {
 	TLS *apicommon.TLSConfig `json:"tls,omitempty" protobuf:"bytes,5,opt,name=tls"`
 }
 
+// BusTrigger refers to the specification of the trigger publishing an event to the EventBus the sensor is
+// connected to, as an event of an event source, for the sensors depending on it to be triggered without
+// publishing the original event again.
+type BusTrigger struct {
+	// Subject of the EventBus the event is published to, defaults to the subject the sensors of the
+	// namespace subscribe to.
+	// +optional
+	Subject string `json:"subject,omitempty" protobuf:"bytes,1,opt,name=subject"`
+	// EventSourceName is the event source name of the published event, the one the dependencies of the
+	// downstream sensors refer to.
+	EventSourceName string `json:"eventSourceName" protobuf:"bytes,2,opt,name=eventSourceName"`
+	// EventName is the event name of the published event.
+	EventName string `json:"eventName" protobuf:"bytes,3,opt,name=eventName"`
+	// Payload is the list of key-value extracted from the events to construct the data of the published event.
+	Payload []TriggerParameter `json:"payload" protobuf:"bytes,4,rep,name=payload"`
+	// Parameters is the list of key-value extracted from event's payload that are applied to
+	// the trigger resource.
+	// +optional
+	Parameters []TriggerParameter `json:"parameters,omitempty" protobuf:"bytes,5,rep,name=parameters"`
+}
+
 // CustomTrigger refers to the specification of the custom trigger.
 type CustomTrigger struct {
 	// ServerURL is the url of the gRPC server that executes custom trigger
@@ -651,6 +1450,16 @@ type SlackTrigger struct {
 	// Message refers to the message to send to the Slack channel.
 	// +optional
 	Message string `json:"message,omitempty" protobuf:"bytes,4,opt,name=message"`
+	// Blocks is a JSON array of Block Kit blocks to send to the Slack channel, the message being the
+	// fallback text of the notifications. The parameters with a destination prefixed with "blocks."
+	// are applied to the blocks, e.g. "blocks.0.text.text".
+	// See https://api.slack.com/block-kit.
+	// +optional
+	Blocks string `json:"blocks,omitempty" protobuf:"bytes,5,opt,name=blocks"`
+	// ThreadTS is the timestamp of the message to reply to in a thread, usually set from the events
+	// with a parameter. If empty, the message is sent to the channel.
+	// +optional
+	ThreadTS string `json:"threadTs,omitempty" protobuf:"bytes,6,opt,name=threadTs"`
 }
 
 // OpenWhiskTrigger refers to the specification of the OpenWhisk trigger.
@@ -682,12 +1491,34 @@ type LogTrigger struct {
 	// Only print messages every interval. Useful to prevent logging too much data for busy events.
 	// +optional
 	IntervalSeconds uint64 `json:"intervalSeconds,omitempty" protobuf:"varint,1,opt,name=intervalSeconds"`
+	// Level is the level to log at, debug, info, warn or error. Defaults to info.
+	// +optional
+	Level LogLevel `json:"level,omitempty" protobuf:"bytes,2,opt,name=level,casttype=LogLevel"`
+	// Payload is the list of key-value extracted from the events to construct the payload, the same as the
+	// payload of another trigger. If specified, the resolved events and the payload are logged as a single
+	// JSON entry per execution, otherwise each event is logged on its own.
+	// +optional
+	Payload []TriggerParameter `json:"payload,omitempty" protobuf:"bytes,3,rep,name=payload"`
+	// Parameters is the list of key-value extracted from event's payload that are applied to
+	// the trigger resource.
+	// +optional
+	Parameters []TriggerParameter `json:"parameters,omitempty" protobuf:"bytes,4,rep,name=parameters"`
 }
 
 func (in *LogTrigger) GetInterval() time.Duration {
 	return time.Duration(in.IntervalSeconds) * time.Second
 }
 
+// LogLevel is the level the log trigger logs at
+type LogLevel string
+
+const (
+	LogLevelDebug LogLevel = "debug"
+	LogLevelInfo  LogLevel = "info"
+	LogLevelWarn  LogLevel = "warn"
+	LogLevelError LogLevel = "error"
+)
+
 // TriggerParameterOperation represents how to set a trigger destination
 // resource key
 type TriggerParameterOperation string
@@ -715,6 +1546,12 @@ type TriggerParameter struct {
 	// Operation is what to do with the existing value at Dest, whether to
 	// 'prepend', 'overwrite', or 'append' it.
 	Operation TriggerParameterOperation `json:"operation,omitempty" protobuf:"bytes,3,opt,name=operation,casttype=TriggerParameterOperation"`
+	// Template is a go-template evaluated against the events to produce the value of the parameter, Src is ignored if it is set.
+	// The data of each event is accessible under the name of its dependency, e.g. `{{ .input.body.id | upper }}`.
+	// The templating follows the standard go-template syntax as well as sprig's extra functions.
+	// See https://pkg.go.dev/text/template and https://masterminds.github.io/sprig/
+	// +optional
+	Template string `json:"template,omitempty" protobuf:"bytes,4,opt,name=template"`
 }
 
 // TriggerParameterSource defines the source for a parameter from a event event
@@ -746,6 +1583,16 @@ type TriggerParameterSource struct {
 	// This is only used if the DataKey is invalid.
 	// If the DataKey is invalid and this is not defined, this param source will produce an error.
 	Value *string `json:"value,omitempty" protobuf:"bytes,6,opt,name=value"`
+	// Env is the name of an environment variable of the sensor container to use the value of, e.g. one set
+	// from the downward API. A value extracted from the event with a key or template takes precedence over it,
+	// and it takes precedence over Value. Without a key or template, the dependency name is optional.
+	// +optional
+	Env string `json:"env,omitempty" protobuf:"bytes,7,opt,name=env"`
+	// Secret refers to a K8s secret to use the value of, e.g. a token to include in a payload. It takes
+	// precedence over the other fields of the source, and the dependency name is optional with it.
+	// The value is redacted from the logs of the sensor.
+	// +optional
+	Secret *corev1.SecretKeySelector `json:"secret,omitempty" protobuf:"bytes,8,opt,name=secret"`
 }
 
 // TriggerPolicy dictates the policy for the trigger retries
@@ -773,6 +1620,10 @@ type StatusPolicy struct {
 	// the trigger will marked as successful else it will result in trigger failure.
 
 	Allow []int32 `json:"allow" protobuf:"varint,1,rep,name=allow"`
+	// AllowRanges are the ranges of allowed response statuses, a class of statuses, e.g. "2xx", or a range
+	// of statuses, e.g. "200-204", in addition to the ones of Allow.
+	// +optional
+	AllowRanges []string `json:"allowRanges,omitempty" protobuf:"bytes,2,rep,name=allowRanges"`
 }
 
 func (in *StatusPolicy) GetAllow() []int {
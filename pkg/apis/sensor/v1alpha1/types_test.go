@@ -2,8 +2,11 @@ package v1alpha1
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
 )
 
 func TestEvent_DataString(t *testing.T) {
@@ -21,6 +24,30 @@ func TestGetReplicas(t *testing.T) {
 	assert.Equal(t, sp.GetReplicas(), int32(2))
 }
 
+func TestIsAtMostOnce(t *testing.T) {
+	sp := SensorSpec{}
+	assert.False(t, sp.IsAtMostOnce())
+	sp.DeliverySemantics = DeliverySemanticsAtLeastOnce
+	assert.False(t, sp.IsAtMostOnce())
+	sp.DeliverySemantics = DeliverySemanticsAtMostOnce
+	assert.True(t, sp.IsAtMostOnce())
+}
+
+func TestTriggerRedelivery_GetDelay(t *testing.T) {
+	r := TriggerRedelivery{}
+	assert.Equal(t, time.Second, r.GetDelay(1))
+	assert.Equal(t, 2*time.Second, r.GetDelay(2))
+	assert.Equal(t, 8*time.Second, r.GetDelay(4))
+	assert.Equal(t, 5*time.Minute, r.GetDelay(20))
+	assert.Equal(t, 5, r.GetAttempts())
+
+	multiplier := apicommon.NewAmount("1.5")
+	r = TriggerRedelivery{Initial: "10s", Max: "30s", Multiplier: &multiplier}
+	assert.Equal(t, 10*time.Second, r.GetDelay(1))
+	assert.Equal(t, 15*time.Second, r.GetDelay(2))
+	assert.Equal(t, 30*time.Second, r.GetDelay(4))
+}
+
 func convertInt(t *testing.T, num int) *int32 {
 	t.Helper()
 	r := int32(num)
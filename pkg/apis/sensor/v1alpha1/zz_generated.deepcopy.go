@@ -72,6 +72,46 @@ func (in *AWSLambdaTrigger) DeepCopy() *AWSLambdaTrigger {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSSQSTrigger) DeepCopyInto(out *AWSSQSTrigger) {
+	*out = *in
+	if in.AccessKey != nil {
+		in, out := &in.AccessKey, &out.AccessKey
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecretKey != nil {
+		in, out := &in.SecretKey, &out.SecretKey
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Payload != nil {
+		in, out := &in.Payload, &out.Payload
+		*out = make([]TriggerParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]TriggerParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSSQSTrigger.
+func (in *AWSSQSTrigger) DeepCopy() *AWSSQSTrigger {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSSQSTrigger)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArgoWorkflowTrigger) DeepCopyInto(out *ArgoWorkflowTrigger) {
 	*out = *in
@@ -196,6 +236,59 @@ func (in *AzureEventHubsTrigger) DeepCopy() *AzureEventHubsTrigger {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BusTrigger) DeepCopyInto(out *BusTrigger) {
+	*out = *in
+	if in.Payload != nil {
+		in, out := &in.Payload, &out.Payload
+		*out = make([]TriggerParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]TriggerParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BusTrigger.
+func (in *BusTrigger) DeepCopy() *BusTrigger {
+	if in == nil {
+		return nil
+	}
+	out := new(BusTrigger)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudEventEnvelope) DeepCopyInto(out *CloudEventEnvelope) {
+	*out = *in
+	if in.Extensions != nil {
+		in, out := &in.Extensions, &out.Extensions
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudEventEnvelope.
+func (in *CloudEventEnvelope) DeepCopy() *CloudEventEnvelope {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudEventEnvelope)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConditionsResetByTime) DeepCopyInto(out *ConditionsResetByTime) {
 	*out = *in
@@ -296,6 +389,37 @@ func (in *DataFilter) DeepCopy() *DataFilter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeadLetter) DeepCopyInto(out *DeadLetter) {
+	*out = *in
+	if in.EventBus != nil {
+		in, out := &in.EventBus, &out.EventBus
+		*out = new(EventBusDeadLetterSink)
+		**out = **in
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(HTTPDeadLetterSink)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.JetStream != nil {
+		in, out := &in.JetStream, &out.JetStream
+		*out = new(JetStreamDeadLetterSink)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeadLetter.
+func (in *DeadLetter) DeepCopy() *DeadLetter {
+	if in == nil {
+		return nil
+	}
+	out := new(DeadLetter)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Event) DeepCopyInto(out *Event) {
 	*out = *in
@@ -322,6 +446,22 @@ func (in *Event) DeepCopy() *Event {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventBusDeadLetterSink) DeepCopyInto(out *EventBusDeadLetterSink) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventBusDeadLetterSink.
+func (in *EventBusDeadLetterSink) DeepCopy() *EventBusDeadLetterSink {
+	if in == nil {
+		return nil
+	}
+	out := new(EventBusDeadLetterSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EventContext) DeepCopyInto(out *EventContext) {
 	*out = *in
@@ -352,6 +492,11 @@ func (in *EventDependency) DeepCopyInto(out *EventDependency) {
 		*out = new(EventDependencyTransformer)
 		**out = **in
 	}
+	if in.SensitiveFields != nil {
+		in, out := &in.SensitiveFields, &out.SensitiveFields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -458,6 +603,128 @@ func (in *FileArtifact) DeepCopy() *FileArtifact {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPCloudFunctionBatch) DeepCopyInto(out *GCPCloudFunctionBatch) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPCloudFunctionBatch.
+func (in *GCPCloudFunctionBatch) DeepCopy() *GCPCloudFunctionBatch {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPCloudFunctionBatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPCloudFunctionResponseLogging) DeepCopyInto(out *GCPCloudFunctionResponseLogging) {
+	*out = *in
+	if in.Redact != nil {
+		in, out := &in.Redact, &out.Redact
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPCloudFunctionResponseLogging.
+func (in *GCPCloudFunctionResponseLogging) DeepCopy() *GCPCloudFunctionResponseLogging {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPCloudFunctionResponseLogging)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPCloudFunctionTrigger) DeepCopyInto(out *GCPCloudFunctionTrigger) {
+	*out = *in
+	if in.Payload != nil {
+		in, out := &in.Payload, &out.Payload
+		*out = make([]TriggerParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]TriggerParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RetryStrategy != nil {
+		in, out := &in.RetryStrategy, &out.RetryStrategy
+		*out = new(common.Backoff)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CredentialsSecret != nil {
+		in, out := &in.CredentialsSecret, &out.CredentialsSecret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CACertificate != nil {
+		in, out := &in.CACertificate, &out.CACertificate
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Batch != nil {
+		in, out := &in.Batch, &out.Batch
+		*out = new(GCPCloudFunctionBatch)
+		**out = **in
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.SecureHeaders != nil {
+		in, out := &in.SecureHeaders, &out.SecureHeaders
+		*out = make([]*common.SecureHeader, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(common.SecureHeader)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.ResponseLogging != nil {
+		in, out := &in.ResponseLogging, &out.ResponseLogging
+		*out = new(GCPCloudFunctionResponseLogging)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ImpersonationDelegates != nil {
+		in, out := &in.ImpersonationDelegates, &out.ImpersonationDelegates
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Credentials != nil {
+		in, out := &in.Credentials, &out.Credentials
+		*out = make(map[string]v1.SecretKeySelector, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPCloudFunctionTrigger.
+func (in *GCPCloudFunctionTrigger) DeepCopy() *GCPCloudFunctionTrigger {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPCloudFunctionTrigger)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GitArtifact) DeepCopyInto(out *GitArtifact) {
 	*out = *in
@@ -536,6 +803,34 @@ func (in *GitRemoteConfig) DeepCopy() *GitRemoteConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPDeadLetterSink) DeepCopyInto(out *HTTPDeadLetterSink) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(common.TLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPDeadLetterSink.
+func (in *HTTPDeadLetterSink) DeepCopy() *HTTPDeadLetterSink {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPDeadLetterSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPTrigger) DeepCopyInto(out *HTTPTrigger) {
 	*out = *in
@@ -581,6 +876,16 @@ func (in *HTTPTrigger) DeepCopyInto(out *HTTPTrigger) {
 			}
 		}
 	}
+	if in.RetryStrategy != nil {
+		in, out := &in.RetryStrategy, &out.RetryStrategy
+		*out = new(common.Backoff)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RetryOn != nil {
+		in, out := &in.RetryOn, &out.RetryOn
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -594,6 +899,69 @@ func (in *HTTPTrigger) DeepCopy() *HTTPTrigger {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Idempotency) DeepCopyInto(out *Idempotency) {
+	*out = *in
+	if in.JetStream != nil {
+		in, out := &in.JetStream, &out.JetStream
+		*out = new(JetStreamIdempotencyStore)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Idempotency.
+func (in *Idempotency) DeepCopy() *Idempotency {
+	if in == nil {
+		return nil
+	}
+	out := new(Idempotency)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JetStreamDeadLetterSink) DeepCopyInto(out *JetStreamDeadLetterSink) {
+	*out = *in
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(common.TLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JetStreamDeadLetterSink.
+func (in *JetStreamDeadLetterSink) DeepCopy() *JetStreamDeadLetterSink {
+	if in == nil {
+		return nil
+	}
+	out := new(JetStreamDeadLetterSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JetStreamIdempotencyStore) DeepCopyInto(out *JetStreamIdempotencyStore) {
+	*out = *in
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(common.TLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JetStreamIdempotencyStore.
+func (in *JetStreamIdempotencyStore) DeepCopy() *JetStreamIdempotencyStore {
+	if in == nil {
+		return nil
+	}
+	out := new(JetStreamIdempotencyStore)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *K8SResourcePolicy) DeepCopyInto(out *K8SResourcePolicy) {
 	*out = *in
@@ -665,6 +1033,20 @@ func (in *KafkaTrigger) DeepCopy() *KafkaTrigger {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LogTrigger) DeepCopyInto(out *LogTrigger) {
 	*out = *in
+	if in.Payload != nil {
+		in, out := &in.Payload, &out.Payload
+		*out = make([]TriggerParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]TriggerParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -801,6 +1183,13 @@ func (in *PulsarTrigger) DeepCopyInto(out *PulsarTrigger) {
 		*out = new(common.Backoff)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Properties != nil {
+		in, out := &in.Properties, &out.Properties
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
@@ -814,6 +1203,71 @@ func (in *PulsarTrigger) DeepCopy() *PulsarTrigger {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PushgatewayMetric) DeepCopyInto(out *PushgatewayMetric) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PushgatewayMetric.
+func (in *PushgatewayMetric) DeepCopy() *PushgatewayMetric {
+	if in == nil {
+		return nil
+	}
+	out := new(PushgatewayMetric)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PushgatewayTrigger) DeepCopyInto(out *PushgatewayTrigger) {
+	*out = *in
+	if in.Grouping != nil {
+		in, out := &in.Grouping, &out.Grouping
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = make([]PushgatewayMetric, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.BasicAuth != nil {
+		in, out := &in.BasicAuth, &out.BasicAuth
+		*out = new(common.BasicAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]TriggerParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PushgatewayTrigger.
+func (in *PushgatewayTrigger) DeepCopy() *PushgatewayTrigger {
+	if in == nil {
+		return nil
+	}
+	out := new(PushgatewayTrigger)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RateLimit) DeepCopyInto(out *RateLimit) {
 	*out = *in
@@ -830,6 +1284,46 @@ func (in *RateLimit) DeepCopy() *RateLimit {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisStreamTrigger) DeepCopyInto(out *RedisStreamTrigger) {
+	*out = *in
+	if in.Password != nil {
+		in, out := &in.Password, &out.Password
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(common.TLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Payload != nil {
+		in, out := &in.Payload, &out.Payload
+		*out = make([]TriggerParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]TriggerParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisStreamTrigger.
+func (in *RedisStreamTrigger) DeepCopy() *RedisStreamTrigger {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisStreamTrigger)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Sensor) DeepCopyInto(out *Sensor) {
 	*out = *in
@@ -918,6 +1412,21 @@ func (in *SensorSpec) DeepCopyInto(out *SensorSpec) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.Idempotency != nil {
+		in, out := &in.Idempotency, &out.Idempotency
+		*out = new(Idempotency)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeadLetter != nil {
+		in, out := &in.DeadLetter, &out.DeadLetter
+		*out = new(DeadLetter)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SensitiveFields != nil {
+		in, out := &in.SensitiveFields, &out.SensitiveFields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1012,6 +1521,11 @@ func (in *StatusPolicy) DeepCopyInto(out *StatusPolicy) {
 		*out = make([]int32, len(*in))
 		copy(*out, *in)
 	}
+	if in.AllowRanges != nil {
+		in, out := &in.AllowRanges, &out.AllowRanges
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1138,6 +1652,26 @@ func (in *Trigger) DeepCopyInto(out *Trigger) {
 		*out = new(RateLimit)
 		**out = **in
 	}
+	if in.Dedupe != nil {
+		in, out := &in.Dedupe, &out.Dedupe
+		*out = new(TriggerDedupe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CircuitBreaker != nil {
+		in, out := &in.CircuitBreaker, &out.CircuitBreaker
+		*out = new(TriggerCircuitBreaker)
+		**out = **in
+	}
+	if in.Redelivery != nil {
+		in, out := &in.Redelivery, &out.Redelivery
+		*out = new(TriggerRedelivery)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1151,6 +1685,39 @@ func (in *Trigger) DeepCopy() *Trigger {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TriggerCircuitBreaker) DeepCopyInto(out *TriggerCircuitBreaker) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TriggerCircuitBreaker.
+func (in *TriggerCircuitBreaker) DeepCopy() *TriggerCircuitBreaker {
+	if in == nil {
+		return nil
+	}
+	out := new(TriggerCircuitBreaker)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TriggerDedupe) DeepCopyInto(out *TriggerDedupe) {
+	*out = *in
+	in.Key.DeepCopyInto(&out.Key)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TriggerDedupe.
+func (in *TriggerDedupe) DeepCopy() *TriggerDedupe {
+	if in == nil {
+		return nil
+	}
+	out := new(TriggerDedupe)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TriggerParameter) DeepCopyInto(out *TriggerParameter) {
 	*out = *in
@@ -1180,6 +1747,11 @@ func (in *TriggerParameterSource) DeepCopyInto(out *TriggerParameterSource) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Secret != nil {
+		in, out := &in.Secret, &out.Secret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1219,6 +1791,27 @@ func (in *TriggerPolicy) DeepCopy() *TriggerPolicy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TriggerRedelivery) DeepCopyInto(out *TriggerRedelivery) {
+	*out = *in
+	if in.Multiplier != nil {
+		in, out := &in.Multiplier, &out.Multiplier
+		*out = new(common.Amount)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TriggerRedelivery.
+func (in *TriggerRedelivery) DeepCopy() *TriggerRedelivery {
+	if in == nil {
+		return nil
+	}
+	out := new(TriggerRedelivery)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TriggerTemplate) DeepCopyInto(out *TriggerTemplate) {
 	*out = *in
@@ -1270,7 +1863,7 @@ func (in *TriggerTemplate) DeepCopyInto(out *TriggerTemplate) {
 	if in.Log != nil {
 		in, out := &in.Log, &out.Log
 		*out = new(LogTrigger)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.AzureEventHubs != nil {
 		in, out := &in.AzureEventHubs, &out.AzureEventHubs
@@ -1289,6 +1882,36 @@ func (in *TriggerTemplate) DeepCopyInto(out *TriggerTemplate) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.GCPCloudFunction != nil {
+		in, out := &in.GCPCloudFunction, &out.GCPCloudFunction
+		*out = new(GCPCloudFunctionTrigger)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RedisStream != nil {
+		in, out := &in.RedisStream, &out.RedisStream
+		*out = new(RedisStreamTrigger)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AWSSQS != nil {
+		in, out := &in.AWSSQS, &out.AWSSQS
+		*out = new(AWSSQSTrigger)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CloudEvent != nil {
+		in, out := &in.CloudEvent, &out.CloudEvent
+		*out = new(CloudEventEnvelope)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Pushgateway != nil {
+		in, out := &in.Pushgateway, &out.Pushgateway
+		*out = new(PushgatewayTrigger)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Bus != nil {
+		in, out := &in.Bus, &out.Bus
+		*out = new(BusTrigger)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -80,11 +80,11 @@ func getSSHKeyAuth(sshKeyFile string) (transport.AuthMethod, error) {
 
 func (g *GitArtifactReader) getGitAuth() (transport.AuthMethod, error) {
 	if g.artifact.Creds != nil {
-		username, err := common.GetSecretFromVolume(g.artifact.Creds.Username)
+		username, err := common.GetSecret(g.artifact.Creds.Username)
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to retrieve username")
 		}
-		password, err := common.GetSecretFromVolume(g.artifact.Creds.Password)
+		password, err := common.GetSecret(g.artifact.Creds.Password)
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to retrieve password")
 		}
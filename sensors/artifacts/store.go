@@ -55,11 +55,11 @@ type Credentials struct {
 // GetCredentials for this minio
 func GetCredentials(art *v1alpha1.ArtifactLocation) (*Credentials, error) {
 	if art.S3 != nil {
-		accessKey, err := common.GetSecretFromVolume(art.S3.AccessKey)
+		accessKey, err := common.GetSecret(art.S3.AccessKey)
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to retrieve accessKey")
 		}
-		secretKey, err := common.GetSecretFromVolume(art.S3.SecretKey)
+		secretKey, err := common.GetSecret(art.S3.SecretKey)
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to retrieve secretKey")
 		}
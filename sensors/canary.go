@@ -0,0 +1,81 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"hash/fnv"
+	"sort"
+	"strings"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+// canaryGroup splits the events between its triggers by their weights.
+type canaryGroup struct {
+	// triggers are sorted by name, for all of them to select the same one
+	triggers []canaryTrigger
+	total    uint64
+}
+
+type canaryTrigger struct {
+	name   string
+	weight uint64
+}
+
+// newCanaryGroups returns the canary groups of the triggers, by trigger name.
+func newCanaryGroups(triggers []v1alpha1.Trigger) map[string]*canaryGroup {
+	groups := make(map[string]*canaryGroup)
+	for _, t := range triggers {
+		if t.CanaryGroup == "" || t.Weight < 0 {
+			continue
+		}
+		g, ok := groups[t.CanaryGroup]
+		if !ok {
+			g = &canaryGroup{}
+			groups[t.CanaryGroup] = g
+		}
+		g.triggers = append(g.triggers, canaryTrigger{name: t.Template.Name, weight: uint64(t.Weight)})
+		g.total += uint64(t.Weight)
+	}
+	result := make(map[string]*canaryGroup)
+	for _, g := range groups {
+		sort.Slice(g.triggers, func(i, j int) bool { return g.triggers[i].name < g.triggers[j].name })
+		for _, t := range g.triggers {
+			result[t.name] = g
+		}
+	}
+	return result
+}
+
+// selects tells if the trigger is the one of the group selected for the events. The selection
+// only depends on the IDs of the events, so that the redeliveries of the events select the same
+// trigger, and that each trigger of the group makes the same selection on its own.
+func (g *canaryGroup) selects(triggerName string, eventIDs []string) bool {
+	if g.total == 0 {
+		return false
+	}
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(strings.Join(eventIDs, ",")))
+	point := h.Sum64() % g.total
+	for _, t := range g.triggers {
+		if point < t.weight {
+			return t.name == triggerName
+		}
+		point -= t.weight
+	}
+	return false
+}
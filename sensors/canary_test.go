@@ -0,0 +1,73 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+func TestCanaryGroups(t *testing.T) {
+	newTrigger := func(name, group string, weight int32) v1alpha1.Trigger {
+		return v1alpha1.Trigger{
+			Template:    &v1alpha1.TriggerTemplate{Name: name},
+			CanaryGroup: group,
+			Weight:      weight,
+		}
+	}
+	groups := newCanaryGroups([]v1alpha1.Trigger{
+		newTrigger("stable", "fake-group", 90),
+		newTrigger("canary", "fake-group", 10),
+		newTrigger("disabled", "fake-group", 0),
+		newTrigger("other", "", 0),
+	})
+	assert.Len(t, groups, 3)
+	assert.NotContains(t, groups, "other")
+	g := groups["stable"]
+	assert.Same(t, g, groups["canary"])
+	assert.Equal(t, uint64(100), g.total)
+
+	t.Run("one trigger selected per event", func(t *testing.T) {
+		counts := make(map[string]int)
+		for i := 0; i < 10000; i++ {
+			eventIDs := []string{fmt.Sprintf("event-%d", i)}
+			selected := 0
+			for _, name := range []string{"stable", "canary", "disabled"} {
+				if g.selects(name, eventIDs) {
+					selected++
+					counts[name]++
+				}
+			}
+			assert.Equal(t, 1, selected)
+		}
+		assert.Equal(t, 0, counts["disabled"])
+		assert.InDelta(t, 1000, counts["canary"], 200)
+	})
+
+	t.Run("deterministic", func(t *testing.T) {
+		eventIDs := []string{"event-a", "event-b"}
+		selected := g.selects("canary", eventIDs)
+		for i := 0; i < 10; i++ {
+			assert.Equal(t, selected, g.selects("canary", eventIDs))
+			assert.Equal(t, !selected, g.selects("stable", eventIDs))
+		}
+	})
+}
@@ -0,0 +1,192 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	sensortriggers "github.com/argoproj/argo-events/sensors/triggers"
+)
+
+// errCircuitOpen is the error of the executions failed fast by an open circuit
+var errCircuitOpen = errors.New("trigger circuit is open")
+
+// circuitState is the state of the circuit breaker of a trigger, its value is the one exposed by the metrics
+type circuitState int
+
+const (
+	// circuitClosed lets all the executions through
+	circuitClosed circuitState = iota
+	// circuitOpen fails the executions fast until the cooldown is over
+	circuitOpen
+	// circuitHalfOpen lets a single execution through to test the recovery of the trigger
+	circuitHalfOpen
+)
+
+func (s circuitState) String() string {
+	switch s {
+	case circuitOpen:
+		return "open"
+	case circuitHalfOpen:
+		return "half-open"
+	default:
+		return "closed"
+	}
+}
+
+// circuitBreaker opens after a number of consecutive failures within a window, and lets
+// a single execution through once the cooldown is over to decide whether to close again.
+type circuitBreaker struct {
+	lock             sync.Mutex
+	failureThreshold int
+	window           time.Duration
+	cooldown         time.Duration
+	state            circuitState
+	// failures is the number of consecutive failures since firstFailure
+	failures     int
+	firstFailure time.Time
+	openedAt     time.Time
+	now          func() time.Time
+}
+
+func newCircuitBreaker(failureThreshold int, window, cooldown time.Duration) *circuitBreaker {
+	return &circuitBreaker{
+		failureThreshold: failureThreshold,
+		window:           window,
+		cooldown:         cooldown,
+		now:              time.Now,
+	}
+}
+
+// allow tells if an execution can go ahead. Once the cooldown is over, the circuit turns
+// half-open and the first execution is let through, the others keep failing fast until
+// its outcome is recorded.
+func (cb *circuitBreaker) allow() (bool, circuitState) {
+	cb.lock.Lock()
+	defer cb.lock.Unlock()
+	switch cb.state {
+	case circuitOpen:
+		if cb.now().Sub(cb.openedAt) < cb.cooldown {
+			return false, cb.state
+		}
+		cb.state = circuitHalfOpen
+		return true, cb.state
+	case circuitHalfOpen:
+		return false, cb.state
+	default:
+		return true, cb.state
+	}
+}
+
+// record records the outcome of an execution, and returns the states of the circuit before and after.
+func (cb *circuitBreaker) record(err error) (circuitState, circuitState) {
+	cb.lock.Lock()
+	defer cb.lock.Unlock()
+	previous := cb.state
+	now := cb.now()
+	if err == nil {
+		cb.state = circuitClosed
+		cb.failures = 0
+		return previous, cb.state
+	}
+	if sensortriggers.IsPermanentError(err) {
+		// The execution failed on its own, e.g. an invalid payload, which tells nothing about the
+		// recovery of the trigger. A half-open circuit lets the next execution through instead.
+		if cb.state == circuitHalfOpen {
+			cb.state = circuitOpen
+		}
+		return previous, cb.state
+	}
+	switch cb.state {
+	case circuitHalfOpen:
+		cb.state = circuitOpen
+		cb.openedAt = now
+	case circuitClosed:
+		if cb.failures == 0 || now.Sub(cb.firstFailure) > cb.window {
+			cb.failures = 0
+			cb.firstFailure = now
+		}
+		cb.failures++
+		if cb.failures >= cb.failureThreshold {
+			cb.state = circuitOpen
+			cb.openedAt = now
+			cb.failures = 0
+		}
+	}
+	return previous, cb.state
+}
+
+// circuitSnapshot is the state of a circuit breaker kept in the state store, for the circuit to survive the
+// restarts of the sensor.
+type circuitSnapshot struct {
+	State        circuitState `json:"state"`
+	Failures     int          `json:"failures,omitempty"`
+	FirstFailure time.Time    `json:"firstFailure"`
+	OpenedAt     time.Time    `json:"openedAt"`
+}
+
+func (cb *circuitBreaker) snapshot() circuitSnapshot {
+	cb.lock.Lock()
+	defer cb.lock.Unlock()
+	return circuitSnapshot{State: cb.state, Failures: cb.failures, FirstFailure: cb.firstFailure, OpenedAt: cb.openedAt}
+}
+
+// restore sets the state of the circuit from a snapshot. A half-open circuit is restored open, the outcome of
+// the execution it let through being unknown, for the next one to be let through once the cooldown is over.
+func (cb *circuitBreaker) restore(s circuitSnapshot) {
+	cb.lock.Lock()
+	defer cb.lock.Unlock()
+	cb.state = s.State
+	if cb.state == circuitHalfOpen {
+		cb.state = circuitOpen
+	}
+	cb.failures = s.Failures
+	cb.firstFailure = s.FirstFailure
+	cb.openedAt = s.OpenedAt
+}
+
+// loadCircuit returns the snapshot of the circuit of the trigger kept in the state store, and false if there is none.
+func loadCircuit(ctx context.Context, store StateStore, triggerName string) (circuitSnapshot, bool, error) {
+	value, ok, err := store.Get(ctx, stateKey("circuit", triggerName))
+	if err != nil || !ok {
+		return circuitSnapshot{}, false, err
+	}
+	var s circuitSnapshot
+	if err := json.Unmarshal(value, &s); err != nil {
+		return circuitSnapshot{}, false, errors.Wrap(err, "invalid circuit state")
+	}
+	return s, true, nil
+}
+
+// saveCircuit keeps the snapshot of the circuit of the trigger in the state store, a closed circuit
+// without failures being the default, its snapshot is deleted instead.
+func saveCircuit(ctx context.Context, store StateStore, triggerName string, s circuitSnapshot) error {
+	key := stateKey("circuit", triggerName)
+	if s.State == circuitClosed && s.Failures == 0 {
+		return store.Delete(ctx, key)
+	}
+	value, err := json.Marshal(s)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal the circuit state")
+	}
+	return store.Set(ctx, key, value, 0)
+}
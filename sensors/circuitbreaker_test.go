@@ -0,0 +1,162 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+
+	sensortriggers "github.com/argoproj/argo-events/sensors/triggers"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	errFailed := errors.New("failed")
+
+	t.Run("opens after consecutive failures", func(t *testing.T) {
+		now := time.Now()
+		cb := newCircuitBreaker(3, time.Minute, 30*time.Second)
+		cb.now = func() time.Time { return now }
+		for i := 0; i < 2; i++ {
+			allowed, _ := cb.allow()
+			assert.True(t, allowed)
+			_, state := cb.record(errFailed)
+			assert.Equal(t, circuitClosed, state)
+		}
+		// A success resets the consecutive failures.
+		cb.record(nil)
+		for i := 0; i < 2; i++ {
+			_, state := cb.record(errFailed)
+			assert.Equal(t, circuitClosed, state)
+		}
+		previous, state := cb.record(errFailed)
+		assert.Equal(t, circuitClosed, previous)
+		assert.Equal(t, circuitOpen, state)
+		allowed, state := cb.allow()
+		assert.False(t, allowed)
+		assert.Equal(t, circuitOpen, state)
+	})
+
+	t.Run("failures outside the window", func(t *testing.T) {
+		now := time.Now()
+		cb := newCircuitBreaker(2, time.Minute, 30*time.Second)
+		cb.now = func() time.Time { return now }
+		_, state := cb.record(errFailed)
+		assert.Equal(t, circuitClosed, state)
+		now = now.Add(2 * time.Minute)
+		_, state = cb.record(errFailed)
+		assert.Equal(t, circuitClosed, state)
+		now = now.Add(time.Second)
+		_, state = cb.record(errFailed)
+		assert.Equal(t, circuitOpen, state)
+	})
+
+	t.Run("half-open", func(t *testing.T) {
+		now := time.Now()
+		cb := newCircuitBreaker(1, time.Minute, 30*time.Second)
+		cb.now = func() time.Time { return now }
+		_, state := cb.record(errFailed)
+		assert.Equal(t, circuitOpen, state)
+
+		now = now.Add(29 * time.Second)
+		allowed, _ := cb.allow()
+		assert.False(t, allowed)
+		now = now.Add(time.Second)
+		allowed, state = cb.allow()
+		assert.True(t, allowed)
+		assert.Equal(t, circuitHalfOpen, state)
+		// Only a single execution is let through.
+		allowed, _ = cb.allow()
+		assert.False(t, allowed)
+
+		// A failure opens the circuit for another cooldown.
+		_, state = cb.record(errFailed)
+		assert.Equal(t, circuitOpen, state)
+		allowed, _ = cb.allow()
+		assert.False(t, allowed)
+
+		now = now.Add(30 * time.Second)
+		allowed, _ = cb.allow()
+		assert.True(t, allowed)
+		previous, state := cb.record(nil)
+		assert.Equal(t, circuitHalfOpen, previous)
+		assert.Equal(t, circuitClosed, state)
+		allowed, _ = cb.allow()
+		assert.True(t, allowed)
+	})
+
+	t.Run("permanent failures", func(t *testing.T) {
+		errPermanent := sensortriggers.NewPermanentError(errors.New("invalid payload"))
+		now := time.Now()
+		cb := newCircuitBreaker(1, time.Minute, 30*time.Second)
+		cb.now = func() time.Time { return now }
+		// They don't count as failures of the trigger.
+		_, state := cb.record(errPermanent)
+		assert.Equal(t, circuitClosed, state)
+
+		_, state = cb.record(errFailed)
+		assert.Equal(t, circuitOpen, state)
+		now = now.Add(30 * time.Second)
+		allowed, state := cb.allow()
+		assert.True(t, allowed)
+		assert.Equal(t, circuitHalfOpen, state)
+		// Nor as the outcome of the half-open execution, the next execution is let through.
+		_, state = cb.record(errPermanent)
+		assert.Equal(t, circuitOpen, state)
+		allowed, state = cb.allow()
+		assert.True(t, allowed)
+		assert.Equal(t, circuitHalfOpen, state)
+	})
+}
+
+func TestCircuitSnapshot(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	cb := newCircuitBreaker(1, time.Minute, 30*time.Second)
+	cb.now = func() time.Time { return now }
+	cb.record(errors.New("failed"))
+
+	store := newMemoryStateStore(10)
+	assert.NoError(t, saveCircuit(ctx, store, "fake-trigger", cb.snapshot()))
+	s, ok, err := loadCircuit(ctx, store, "fake-trigger")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, circuitOpen, s.State)
+
+	restored := newCircuitBreaker(1, time.Minute, 30*time.Second)
+	restored.now = cb.now
+	restored.restore(s)
+	allowed, state := restored.allow()
+	assert.False(t, allowed)
+	assert.Equal(t, circuitOpen, state)
+
+	t.Run("half-open restored open", func(t *testing.T) {
+		restored := newCircuitBreaker(1, time.Minute, 30*time.Second)
+		restored.restore(circuitSnapshot{State: circuitHalfOpen, OpenedAt: now})
+		assert.Equal(t, circuitOpen, restored.snapshot().State)
+	})
+
+	t.Run("closed without failures deleted", func(t *testing.T) {
+		assert.NoError(t, saveCircuit(ctx, store, "fake-trigger", circuitSnapshot{State: circuitClosed}))
+		_, ok, err := loadCircuit(ctx, store, "fake-trigger")
+		assert.NoError(t, err)
+		assert.False(t, ok)
+	})
+}
@@ -1,10 +1,13 @@
 package cmd
 
 import (
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"os"
+	"time"
 
 	"go.uber.org/zap"
 	"k8s.io/client-go/dynamic"
@@ -14,12 +17,16 @@ import (
 	argoevents "github.com/argoproj/argo-events"
 	"github.com/argoproj/argo-events/common"
 	"github.com/argoproj/argo-events/common/logging"
+	"github.com/argoproj/argo-events/common/tracing"
 	"github.com/argoproj/argo-events/metrics"
 	eventbusv1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
 	v1alpha1 "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
 	"github.com/argoproj/argo-events/sensors"
 )
 
+// defaultDrainTimeout is kept below the default pod termination grace period.
+const defaultDrainTimeout = 20 * time.Second
+
 func Start() {
 	logger := logging.NewArgoEventsLogger().Named("sensor")
 	kubeConfig, _ := os.LookupEnv(common.EnvVarKubeConfig)
@@ -63,15 +70,43 @@ func Start() {
 		logger.Fatal("required environment variable 'POD_NAME' not defined")
 	}
 
+	drainTimeout := defaultDrainTimeout
+	if v, defined := os.LookupEnv(common.EnvVarSensorDrainTimeout); defined {
+		drainTimeout, err = time.ParseDuration(v)
+		if err != nil || drainTimeout < 0 {
+			logger.Fatalf("invalid value %q of environment variable '%s', it must be a non-negative duration", v, common.EnvVarSensorDrainTimeout)
+		}
+	}
+
 	dynamicClient := dynamic.NewForConfigOrDie(restConfig)
 
-	logger = logger.With("sensorName", sensor.Name)
+	logger = logger.With(logging.LabelSensorName, sensor.Name, logging.LabelNamespace, sensor.Namespace)
 	ctx := logging.WithLogger(signals.SetupSignalHandler(), logger)
 	m := metrics.NewMetrics(sensor.Namespace)
 	go m.Run(ctx, fmt.Sprintf(":%d", common.SensorMetricsPort))
 
+	shutdownTracing, err := tracing.Init(ctx, "argo-events-sensor")
+	if err != nil {
+		logger.Fatalw("failed to set up tracing", zap.Error(err))
+	}
+	defer func() {
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := shutdownTracing(shutdownCtx); err != nil {
+			logger.Errorw("failed to flush the traces", zap.Error(err))
+		}
+	}()
+
+	recorder, stopRecording, err := sensors.NewEventRecorder(kubeClient, sensor.Namespace, hostname)
+	if err != nil {
+		logger.Fatalw("failed to create the event recorder", zap.Error(err))
+	}
+	defer stopRecording()
+
 	logger.Infow("starting sensor server", "version", argoevents.GetVersion())
-	sensorExecutionCtx := sensors.NewSensorContext(kubeClient, dynamicClient, sensor, busConfig, ebSubject, hostname, m)
+	sensorExecutionCtx := sensors.NewSensorContext(kubeClient, dynamicClient, sensor, busConfig, ebSubject, hostname, m, recorder, drainTimeout)
+	// The readiness probe is served along with the metrics.
+	http.Handle("/ready", sensorExecutionCtx.ReadinessHandler())
 	if err := sensorExecutionCtx.Start(ctx); err != nil {
 		logger.Fatalw("failed to listen to events", zap.Error(err))
 	}
@@ -0,0 +1,220 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+)
+
+// executionLimiter bounds the number of trigger executions running at once. A nil limiter
+// doesn't bound them.
+type executionLimiter struct {
+	slots     chan struct{}
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+// newExecutionLimiter returns a limiter of the given number of executions, nil if it is not positive.
+func newExecutionLimiter(limit int) *executionLimiter {
+	if limit <= 0 {
+		return nil
+	}
+	return &executionLimiter{
+		slots:  make(chan struct{}, limit),
+		closed: make(chan struct{}),
+	}
+}
+
+// acquire waits for an execution to be allowed, and returns false if the context is done
+// or the limiter is closed first.
+func (l *executionLimiter) acquire(ctx context.Context) bool {
+	if l == nil {
+		return true
+	}
+	select {
+	case <-l.closed:
+		return false
+	default:
+	}
+	select {
+	case l.slots <- struct{}{}:
+		return true
+	case <-ctx.Done():
+		return false
+	case <-l.closed:
+		return false
+	}
+}
+
+// tryAcquire allows an execution if a slot is free, without waiting for one.
+func (l *executionLimiter) tryAcquire() bool {
+	if l == nil {
+		return true
+	}
+	select {
+	case <-l.closed:
+		return false
+	default:
+	}
+	select {
+	case l.slots <- struct{}{}:
+		return true
+	default:
+		return false
+	}
+}
+
+// release lets another execution run once an allowed one finishes.
+func (l *executionLimiter) release() {
+	if l == nil {
+		return
+	}
+	<-l.slots
+}
+
+// close stops the executions waiting to be allowed, e.g. when the sensor shuts down.
+func (l *executionLimiter) close() {
+	if l == nil {
+		return
+	}
+	l.closeOnce.Do(func() { close(l.closed) })
+}
+
+// eventWindow bounds the number of events of the trigger executions in progress, from their delivery to the
+// completion of their execution. The subscriptions wait for the window to have room before passing more events
+// to the triggers, for the events to wait on the eventbus rather than in memory. A nil window doesn't bound them.
+type eventWindow struct {
+	lock     sync.Mutex
+	limit    int64
+	inFlight int64
+	closed   bool
+	// changed is closed and replaced each time events leave the window, or once it is closed.
+	changed chan struct{}
+}
+
+// newEventWindow returns a window of the given number of events, nil if it is not positive.
+func newEventWindow(limit int) *eventWindow {
+	if limit <= 0 {
+		return nil
+	}
+	return &eventWindow{limit: int64(limit), changed: make(chan struct{})}
+}
+
+// weight returns the room the events of an execution take in the window. An execution of more events than
+// the window holds takes the whole window, for it to run alone rather than never.
+func (w *eventWindow) weight(events int) int64 {
+	if int64(events) > w.limit {
+		return w.limit
+	}
+	return int64(events)
+}
+
+// acquire waits for the window to have room for the events of an execution, and returns false if the context
+// is done or the window is closed first.
+func (w *eventWindow) acquire(ctx context.Context, events int) bool {
+	if w == nil {
+		return true
+	}
+	n := w.weight(events)
+	for {
+		w.lock.Lock()
+		if w.closed {
+			w.lock.Unlock()
+			return false
+		}
+		if w.inFlight+n <= w.limit {
+			w.inFlight += n
+			w.lock.Unlock()
+			return true
+		}
+		changed := w.changed
+		w.lock.Unlock()
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return false
+		}
+	}
+}
+
+// tryAcquire takes room in the window for the events if it has some, without waiting for it.
+func (w *eventWindow) tryAcquire(events int) bool {
+	if w == nil {
+		return true
+	}
+	n := w.weight(events)
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	if w.closed || w.inFlight+n > w.limit {
+		return false
+	}
+	w.inFlight += n
+	return true
+}
+
+// release makes room for other events once the execution of the given number of events completes.
+func (w *eventWindow) release(events int) {
+	if w == nil {
+		return
+	}
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	w.inFlight -= w.weight(events)
+	close(w.changed)
+	w.changed = make(chan struct{})
+}
+
+// close stops the executions waiting for room in the window, e.g. when the sensor shuts down.
+func (w *eventWindow) close() {
+	if w == nil {
+		return
+	}
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	if w.closed {
+		return
+	}
+	w.closed = true
+	close(w.changed)
+	w.changed = make(chan struct{})
+}
+
+// Dispatch runs the work of a trigger in the background, taking an execution slot and room for an event in
+// the window if they are free. The work is tracked as an in-flight execution, so that it is drained on shutdown.
+// It is meant to be called by an in-flight execution, for the drain not to have completed already.
+func (sensorCtx *SensorContext) Dispatch(work func(ctx context.Context)) bool {
+	ctx := sensorCtx.triggerCtx
+	if ctx == nil || ctx.Err() != nil || !sensorCtx.executionLimiter.tryAcquire() {
+		return false
+	}
+	if !sensorCtx.eventWindow.tryAcquire(1) {
+		sensorCtx.executionLimiter.release()
+		return false
+	}
+	sensorCtx.inFlightTriggers.Add(1)
+	atomic.AddInt64(&sensorCtx.inFlightCount, 1)
+	go func() {
+		defer sensorCtx.inFlightTriggers.Done()
+		defer atomic.AddInt64(&sensorCtx.inFlightCount, -1)
+		defer sensorCtx.eventWindow.release(1)
+		defer sensorCtx.executionLimiter.release()
+		work(ctx)
+	}()
+	return true
+}
@@ -0,0 +1,203 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExecutionLimiter(t *testing.T) {
+	t.Run("unbounded", func(t *testing.T) {
+		l := newExecutionLimiter(0)
+		assert.Nil(t, l)
+		for i := 0; i < 100; i++ {
+			assert.True(t, l.acquire(context.Background()))
+		}
+		l.release()
+		l.close()
+	})
+
+	t.Run("bounded", func(t *testing.T) {
+		l := newExecutionLimiter(2)
+		var running, maxRunning int32
+		wg := &sync.WaitGroup{}
+		for i := 0; i < 10; i++ {
+			assert.True(t, l.acquire(context.Background()))
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				defer l.release()
+				n := atomic.AddInt32(&running, 1)
+				for {
+					m := atomic.LoadInt32(&maxRunning)
+					if n <= m || atomic.CompareAndSwapInt32(&maxRunning, m, n) {
+						break
+					}
+				}
+				time.Sleep(10 * time.Millisecond)
+				atomic.AddInt32(&running, -1)
+			}()
+		}
+		wg.Wait()
+		assert.Equal(t, int32(2), atomic.LoadInt32(&maxRunning))
+	})
+
+	t.Run("context done", func(t *testing.T) {
+		l := newExecutionLimiter(1)
+		assert.True(t, l.acquire(context.Background()))
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		assert.False(t, l.acquire(ctx))
+		l.release()
+		assert.True(t, l.acquire(context.Background()))
+	})
+
+	t.Run("closed", func(t *testing.T) {
+		l := newExecutionLimiter(1)
+		assert.True(t, l.acquire(context.Background()))
+		acquired := make(chan bool)
+		go func() {
+			acquired <- l.acquire(context.Background())
+		}()
+		l.close()
+		l.close()
+		assert.False(t, <-acquired)
+		l.release()
+		// No execution is started once closed, even with a free slot.
+		assert.False(t, l.acquire(context.Background()))
+	})
+
+	t.Run("try acquire", func(t *testing.T) {
+		l := newExecutionLimiter(1)
+		assert.True(t, l.tryAcquire())
+		assert.False(t, l.tryAcquire())
+		l.release()
+		assert.True(t, l.tryAcquire())
+		l.release()
+		l.close()
+		assert.False(t, l.tryAcquire())
+	})
+}
+
+func TestEventWindow(t *testing.T) {
+	t.Run("unbounded", func(t *testing.T) {
+		w := newEventWindow(0)
+		assert.Nil(t, w)
+		assert.True(t, w.acquire(context.Background(), 100))
+		assert.True(t, w.tryAcquire(100))
+		w.release(100)
+		w.close()
+	})
+
+	t.Run("bounded", func(t *testing.T) {
+		w := newEventWindow(3)
+		assert.True(t, w.acquire(context.Background(), 2))
+		assert.True(t, w.tryAcquire(1))
+		assert.False(t, w.tryAcquire(1))
+		acquired := make(chan bool)
+		go func() {
+			acquired <- w.acquire(context.Background(), 2)
+		}()
+		w.release(1)
+		select {
+		case <-acquired:
+			t.Fatal("expected to wait for room for the 2 events")
+		case <-time.After(20 * time.Millisecond):
+		}
+		w.release(2)
+		assert.True(t, <-acquired)
+	})
+
+	t.Run("execution bigger than the window", func(t *testing.T) {
+		w := newEventWindow(2)
+		assert.True(t, w.acquire(context.Background(), 5))
+		assert.False(t, w.tryAcquire(1))
+		w.release(5)
+		assert.True(t, w.tryAcquire(2))
+	})
+
+	t.Run("context done", func(t *testing.T) {
+		w := newEventWindow(1)
+		assert.True(t, w.acquire(context.Background(), 1))
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		assert.False(t, w.acquire(ctx, 1))
+	})
+
+	t.Run("closed", func(t *testing.T) {
+		w := newEventWindow(1)
+		assert.True(t, w.acquire(context.Background(), 1))
+		acquired := make(chan bool)
+		go func() {
+			acquired <- w.acquire(context.Background(), 1)
+		}()
+		w.close()
+		w.close()
+		assert.False(t, <-acquired)
+		w.release(1)
+		assert.False(t, w.acquire(context.Background(), 1))
+		assert.False(t, w.tryAcquire(1))
+	})
+}
+
+func TestDispatch(t *testing.T) {
+	t.Run("runs the work as an in-flight execution", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		sensorCtx := &SensorContext{triggerCtx: ctx, executionLimiter: newExecutionLimiter(2), drainTimeout: time.Minute}
+		release := make(chan struct{})
+		assert.True(t, sensorCtx.Dispatch(func(ctx context.Context) {
+			<-release
+		}))
+		assert.Equal(t, int64(1), atomic.LoadInt64(&sensorCtx.inFlightCount))
+		close(release)
+		sensorCtx.inFlightTriggers.Wait()
+		assert.Equal(t, int64(0), atomic.LoadInt64(&sensorCtx.inFlightCount))
+	})
+
+	t.Run("no free slot", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		sensorCtx := &SensorContext{triggerCtx: ctx, executionLimiter: newExecutionLimiter(1)}
+		assert.True(t, sensorCtx.executionLimiter.acquire(ctx))
+		assert.False(t, sensorCtx.Dispatch(func(ctx context.Context) {}))
+	})
+
+	t.Run("full window", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		sensorCtx := &SensorContext{triggerCtx: ctx, executionLimiter: newExecutionLimiter(2), eventWindow: newEventWindow(1)}
+		assert.True(t, sensorCtx.eventWindow.acquire(ctx, 1))
+		assert.False(t, sensorCtx.Dispatch(func(ctx context.Context) {}))
+		// The execution slot is given back.
+		assert.True(t, sensorCtx.executionLimiter.tryAcquire())
+		assert.True(t, sensorCtx.executionLimiter.tryAcquire())
+	})
+
+	t.Run("shutting down", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		sensorCtx := &SensorContext{triggerCtx: ctx}
+		assert.False(t, sensorCtx.Dispatch(func(ctx context.Context) {}))
+	})
+}
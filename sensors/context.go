@@ -17,22 +17,20 @@ limitations under the License.
 */
 
 import (
-	"net/http"
+	"context"
+	"sync"
 	"time"
 
-	eventhubs "github.com/Azure/azure-event-hubs-go/v3"
-	"github.com/Shopify/sarama"
-	"github.com/apache/openwhisk-client-go/whisk"
-	"github.com/apache/pulsar-client-go/pulsar"
-	"github.com/aws/aws-sdk-go/service/lambda"
-	natslib "github.com/nats-io/nats.go"
-	"google.golang.org/grpc"
+	"github.com/google/cel-go/cel"
+	"golang.org/x/time/rate"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/record"
 
 	sensormetrics "github.com/argoproj/argo-events/metrics"
 	eventbusv1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
 	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	sensortriggers "github.com/argoproj/argo-events/sensors/triggers"
 )
 
 // SensorContext contains execution context for Sensor
@@ -49,47 +47,66 @@ type SensorContext struct {
 	eventBusSubject string
 	hostname        string
 
-	// httpClients holds the reference to HTTP clients for HTTP triggers.
-	httpClients map[string]*http.Client
-	// customTriggerClients holds the references to the gRPC clients for the custom trigger servers
-	customTriggerClients map[string]*grpc.ClientConn
-	// http client to send slack messages.
-	slackHTTPClient *http.Client
-	// kafkaProducers holds references to the active kafka producers
-	kafkaProducers map[string]sarama.AsyncProducer
-	// pulsarProducers holds references to the active pulsar producers
-	pulsarProducers map[string]pulsar.Producer
-	// natsConnections holds the references to the active nats connections.
-	natsConnections map[string]*natslib.Conn
-	// awsLambdaClients holds the references to active AWS Lambda clients.
-	awsLambdaClients map[string]*lambda.Lambda
-	// openwhiskClients holds the references to active OpenWhisk clients.
-	openwhiskClients map[string]*whisk.Client
-	// azureEventHubsClients holds the references to active Azure Event Hub clients.
-	azureEventHubsClients map[string]*eventhubs.Hub
-	metrics               *sensormetrics.Metrics
+	// triggerClients holds the clients of the triggers, by trigger type.
+	triggerClients *sensortriggers.ClientCache
+	metrics        *sensormetrics.Metrics
+	// recorder records the outcomes of the trigger executions as events of the sensor.
+	recorder record.EventRecorder
+
+	// drainTimeout is how long to wait for in-flight trigger executions to finish on shutdown.
+	drainTimeout time.Duration
+	// inFlightTriggers tracks the trigger executions in progress.
+	inFlightTriggers sync.WaitGroup
+	// inFlightCount is the number of trigger executions in progress.
+	inFlightCount int64
+	// triggerCtx is the context of the trigger executions, cancelled once the drain timeout is exceeded.
+	triggerCtx context.Context
+	// idempotency records the executions of the triggers, nil if the sensor has no idempotency.
+	idempotency *idempotencyStore
+	// state keeps the state of the sensor features, in the EventBus if it supports it.
+	state StateStore
+	// deadLetter keeps the events of the trigger executions which failed for good, nil if the sensor has no dead letter.
+	deadLetter deadLetterSink
+	// busPublisher publishes the events of the bus triggers, nil if the sensor has none.
+	busPublisher *eventBusPublisher
+	// executionLimiter bounds the number of trigger executions running at once, nil if unbounded.
+	executionLimiter *executionLimiter
+	// eventWindow bounds the number of events of the trigger executions in progress, nil if unbounded.
+	eventWindow *eventWindow
+	// partitionKey is the partition key of the trigger executions, nil if they are not ordered.
+	partitionKey cel.Program
+	// partitioner orders the trigger executions of the same partition, nil if they are not ordered.
+	partitioner *partitioner
+	// health holds the outcome of the last connectivity checks of the triggers.
+	health triggerHealth
+	// graph holds the dependencies between the triggers.
+	graph *sensortriggers.Graph
+	// dependents are the triggers depending on other triggers, executed after them rather than for the events, by name.
+	dependents map[string]v1alpha1.Trigger
+	// rateLimiters are the rate limiters of the triggers, by name.
+	rateLimiters map[string]*rate.Limiter
+	// dedupes deduplicate the executions of the triggers having a dedupe, by name.
+	dedupes map[string]*triggerDedupe
+	// circuitBreakers are the circuit breakers of the triggers having one, by name.
+	circuitBreakers map[string]*circuitBreaker
+	// conditions are the compiled conditions of the triggers having one, by name.
+	conditions map[string]cel.Program
+	// canaryGroups are the canary groups of the triggers, by trigger name.
+	canaryGroups map[string]*canaryGroup
 }
 
 // NewSensorContext returns a new sensor execution context.
-func NewSensorContext(kubeClient kubernetes.Interface, dynamicClient dynamic.Interface, sensor *v1alpha1.Sensor, eventBusConfig *eventbusv1alpha1.BusConfig, eventBusSubject, hostname string, metrics *sensormetrics.Metrics) *SensorContext {
+func NewSensorContext(kubeClient kubernetes.Interface, dynamicClient dynamic.Interface, sensor *v1alpha1.Sensor, eventBusConfig *eventbusv1alpha1.BusConfig, eventBusSubject, hostname string, metrics *sensormetrics.Metrics, recorder record.EventRecorder, drainTimeout time.Duration) *SensorContext {
 	return &SensorContext{
-		kubeClient:           kubeClient,
-		dynamicClient:        dynamicClient,
-		sensor:               sensor,
-		eventBusConfig:       eventBusConfig,
-		eventBusSubject:      eventBusSubject,
-		hostname:             hostname,
-		httpClients:          make(map[string]*http.Client),
-		customTriggerClients: make(map[string]*grpc.ClientConn),
-		slackHTTPClient: &http.Client{
-			Timeout: time.Minute * 5,
-		},
-		kafkaProducers:        make(map[string]sarama.AsyncProducer),
-		pulsarProducers:       make(map[string]pulsar.Producer),
-		natsConnections:       make(map[string]*natslib.Conn),
-		awsLambdaClients:      make(map[string]*lambda.Lambda),
-		openwhiskClients:      make(map[string]*whisk.Client),
-		azureEventHubsClients: make(map[string]*eventhubs.Hub),
-		metrics:               metrics,
+		kubeClient:      kubeClient,
+		dynamicClient:   dynamicClient,
+		sensor:          sensor,
+		eventBusConfig:  eventBusConfig,
+		eventBusSubject: eventBusSubject,
+		hostname:        hostname,
+		triggerClients:  sensortriggers.NewClientCache(),
+		metrics:         metrics,
+		recorder:        recorder,
+		drainTimeout:    drainTimeout,
 	}
 }
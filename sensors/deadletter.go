@@ -0,0 +1,290 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	natslib "github.com/nats-io/nats.go"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/common/logging"
+	"github.com/argoproj/argo-events/eventbus"
+	eventbusdriver "github.com/argoproj/argo-events/eventbus/driver"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	eventbusv1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	sensortriggers "github.com/argoproj/argo-events/sensors/triggers"
+)
+
+// The reasons of the dead letters
+const (
+	deadLetterReasonRetriesExhausted      = "RetriesExhausted"
+	deadLetterReasonRedeliveriesExhausted = "RedeliveriesExhausted"
+	deadLetterReasonPermanentFailure      = "PermanentFailure"
+	deadLetterReasonCircuitOpen           = "CircuitOpen"
+)
+
+var (
+	deadLetterDuration = apicommon.FromString("1s")
+	deadLetterFactor   = apicommon.NewAmount("2")
+
+	// deadLetterBackoff is the backoff of the forwarding of a dead letter to the sink
+	deadLetterBackoff = apicommon.Backoff{
+		Steps:    3,
+		Duration: &deadLetterDuration,
+		Factor:   &deadLetterFactor,
+	}
+)
+
+// DeadLetter is the document forwarded to the dead letter sink of the sensor for the events of a failed
+// trigger execution.
+type DeadLetter struct {
+	// ID identifies the trigger execution, the same for the same trigger and events.
+	ID        string `json:"id"`
+	Sensor    string `json:"sensor"`
+	Namespace string `json:"namespace"`
+	Trigger   string `json:"trigger"`
+	// Events are the events the trigger was executed for, keyed by dependency name, as they were delivered.
+	Events map[string]*v1alpha1.Event `json:"events"`
+	// Reason tells why the execution is given up, e.g. RedeliveriesExhausted or PermanentFailure.
+	Reason string `json:"reason"`
+	// Error is the error of the last execution.
+	Error string `json:"error"`
+	// Attempts is the number of executions, counting the redeliveries but not the retries.
+	Attempts int       `json:"attempts"`
+	Time     time.Time `json:"time"`
+}
+
+// newDeadLetter returns the dead letter of the events of a trigger execution which failed with the error
+func newDeadLetter(sensor *v1alpha1.Sensor, trigger v1alpha1.Trigger, eventsMapping map[string]*v1alpha1.Event, eventIDs []string, err error, attempts int) *DeadLetter {
+	return &DeadLetter{
+		ID:        idempotencyKey(trigger.Template.Name, eventIDs),
+		Sensor:    sensor.Name,
+		Namespace: sensor.Namespace,
+		Trigger:   trigger.Template.Name,
+		Events:    eventsMapping,
+		Reason:    deadLetterReason(err, trigger.Redelivery != nil),
+		Error:     err.Error(),
+		Attempts:  attempts,
+		Time:      time.Now().UTC(),
+	}
+}
+
+// deadLetterReason returns why the trigger execution which failed with the error is given up
+func deadLetterReason(err error, redelivered bool) string {
+	switch {
+	case errors.Is(err, errCircuitOpen):
+		return deadLetterReasonCircuitOpen
+	case sensortriggers.IsPermanentError(err):
+		return deadLetterReasonPermanentFailure
+	case redelivered:
+		return deadLetterReasonRedeliveriesExhausted
+	default:
+		return deadLetterReasonRetriesExhausted
+	}
+}
+
+// forwardDeadLetter forwards the events of a trigger execution which failed for good to the dead letter sink
+// of the sensor, if it has one. The events of the executions interrupted by the shutdown of the sensor are
+// not forwarded, the trigger didn't fail for them.
+func (sensorCtx *SensorContext) forwardDeadLetter(ctx context.Context, sensor *v1alpha1.Sensor, trigger v1alpha1.Trigger, eventsMapping map[string]*v1alpha1.Event, eventIDs []string, err error, attempts int) {
+	if sensorCtx.deadLetter == nil || errors.Is(err, context.Canceled) {
+		return
+	}
+	log := logging.FromContext(ctx)
+	letter := newDeadLetter(sensor, trigger, eventsMapping, eventIDs, err, attempts)
+	data, err := json.Marshal(letter)
+	if err != nil {
+		log.Errorw("failed to marshal the dead letter, dropping the events", zap.Error(err))
+		return
+	}
+	if err := common.Connect(&deadLetterBackoff, func() error {
+		return sensorCtx.deadLetter.send(ctx, letter.ID, data)
+	}); err != nil {
+		log.Errorw("failed to forward the events to the dead letter sink, dropping them", zap.Error(err))
+		return
+	}
+	log.Infow("forwarded the events to the dead letter sink", zap.String("reason", letter.Reason))
+	sensorCtx.metrics.ActionDeadLettered(sensor.Name, trigger.Template.Name)
+}
+
+// deadLetterSink keeps the dead letters of the sensor
+type deadLetterSink interface {
+	// send forwards the dead letter with the ID, the sinks which deduplicate the messages use the ID.
+	send(ctx context.Context, id string, data []byte) error
+	close() error
+}
+
+// newDeadLetterSink returns the dead letter sink of the sensor, or nil if it has none
+func newDeadLetterSink(ctx context.Context, sensor *v1alpha1.Sensor, busConfig *eventbusv1alpha1.BusConfig, busSubject, hostname string) (deadLetterSink, error) {
+	deadLetter := sensor.Spec.DeadLetter
+	switch {
+	case deadLetter == nil:
+		return nil, nil
+	case deadLetter.EventBus != nil:
+		if busConfig == nil {
+			return nil, errors.New("the sensor has no eventbus to forward the dead letters to")
+		}
+		if deadLetter.EventBus.Subject == busSubject {
+			return nil, errors.Errorf("the dead letter subject %s can't be the subject of the events", busSubject)
+		}
+		clientID := fmt.Sprintf("dead-letter-%s", common.Hasher(sensor.Name+"-"+hostname))
+		driver, err := eventbus.GetDriver(ctx, *busConfig, deadLetter.EventBus.Subject, clientID)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get the eventbus driver")
+		}
+		return &eventBusDeadLetterSink{driver: driver}, nil
+	case deadLetter.HTTP != nil:
+		return newHTTPDeadLetterSink(deadLetter.HTTP)
+	case deadLetter.JetStream != nil:
+		return newJetStreamDeadLetterSink(deadLetter.JetStream)
+	default:
+		return nil, errors.New("the dead letter has no sink")
+	}
+}
+
+// eventBusDeadLetterSink publishes the dead letters to a subject of the eventbus, connecting again once
+// the connection is lost.
+type eventBusDeadLetterSink struct {
+	lock   sync.Mutex
+	driver eventbusdriver.Driver
+	conn   eventbusdriver.Connection
+}
+
+func (s *eventBusDeadLetterSink) send(_ context.Context, _ string, data []byte) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.conn == nil || s.conn.IsClosed() {
+		conn, err := s.driver.Connect()
+		if err != nil {
+			return errors.Wrap(err, "failed to connect to the eventbus")
+		}
+		s.conn = conn
+	}
+	return s.driver.Publish(s.conn, data)
+}
+
+func (s *eventBusDeadLetterSink) close() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.conn == nil {
+		return nil
+	}
+	return s.conn.Close()
+}
+
+// httpDeadLetterSink posts the dead letters to an HTTP endpoint
+type httpDeadLetterSink struct {
+	url     string
+	headers map[string]string
+	client  *http.Client
+}
+
+func newHTTPDeadLetterSink(sink *v1alpha1.HTTPDeadLetterSink) (*httpDeadLetterSink, error) {
+	client := &http.Client{Timeout: sink.GetTimeout()}
+	if sink.TLS != nil {
+		tlsConfig, err := common.GetTLSConfig(sink.TLS)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get the tls configuration")
+		}
+		client.Transport = &http.Transport{TLSClientConfig: tlsConfig}
+	}
+	return &httpDeadLetterSink{url: sink.URL, headers: sink.Headers, client: client}, nil
+}
+
+func (s *httpDeadLetterSink) send(ctx context.Context, _ string, data []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(data))
+	if err != nil {
+		return errors.Wrapf(err, "failed to construct the request to %s", s.url)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for name, value := range s.headers {
+		req.Header.Set(name, value)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "failed to post the dead letter to %s", s.url)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return errors.Errorf("failed to post the dead letter to %s, status code %d", s.url, resp.StatusCode)
+	}
+	return nil
+}
+
+func (s *httpDeadLetterSink) close() error {
+	s.client.CloseIdleConnections()
+	return nil
+}
+
+// jetStreamDeadLetterSink publishes the dead letters to a JetStream stream, which deduplicates them by ID
+// within its duplicate window.
+type jetStreamDeadLetterSink struct {
+	conn    *natslib.Conn
+	js      natslib.JetStreamContext
+	subject string
+	stream  string
+}
+
+func newJetStreamDeadLetterSink(sink *v1alpha1.JetStreamDeadLetterSink) (*jetStreamDeadLetterSink, error) {
+	opts := []natslib.Option{natslib.Name("argo-events-dead-letter")}
+	if sink.TLS != nil {
+		tlsConfig, err := common.GetTLSConfig(sink.TLS)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get the tls configuration")
+		}
+		opts = append(opts, natslib.Secure(tlsConfig))
+	}
+	conn, err := natslib.Connect(sink.URL, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to connect to %s", sink.URL)
+	}
+	js, err := conn.JetStream()
+	if err != nil {
+		conn.Close()
+		return nil, errors.Wrap(err, "failed to get the jetstream context")
+	}
+	return &jetStreamDeadLetterSink{conn: conn, js: js, subject: sink.Subject, stream: sink.Stream}, nil
+}
+
+func (s *jetStreamDeadLetterSink) send(ctx context.Context, id string, data []byte) error {
+	opts := []natslib.PubOpt{natslib.Context(ctx), natslib.MsgId(id)}
+	if s.stream != "" {
+		opts = append(opts, natslib.ExpectStream(s.stream))
+	}
+	if _, err := s.js.Publish(s.subject, data, opts...); err != nil {
+		return errors.Wrapf(err, "failed to publish the dead letter to %s", s.subject)
+	}
+	return nil
+}
+
+func (s *jetStreamDeadLetterSink) close() error {
+	s.conn.Close()
+	return nil
+}
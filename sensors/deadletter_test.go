@@ -0,0 +1,133 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+
+	sensormetrics "github.com/argoproj/argo-events/metrics"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	sensortriggers "github.com/argoproj/argo-events/sensors/triggers"
+)
+
+type fakeDeadLetterSink struct {
+	ids     []string
+	letters [][]byte
+}
+
+func (s *fakeDeadLetterSink) send(_ context.Context, id string, data []byte) error {
+	s.ids = append(s.ids, id)
+	s.letters = append(s.letters, data)
+	return nil
+}
+
+func (s *fakeDeadLetterSink) close() error {
+	return nil
+}
+
+func TestDeadLetterReason(t *testing.T) {
+	errFailed := errors.New("failed")
+	assert.Equal(t, deadLetterReasonRetriesExhausted, deadLetterReason(errFailed, false))
+	assert.Equal(t, deadLetterReasonRedeliveriesExhausted, deadLetterReason(errFailed, true))
+	assert.Equal(t, deadLetterReasonPermanentFailure, deadLetterReason(sensortriggers.NewPermanentError(errFailed), true))
+	assert.Equal(t, deadLetterReasonCircuitOpen, deadLetterReason(errors.Wrap(errCircuitOpen, "failed"), true))
+}
+
+func TestForwardDeadLetter(t *testing.T) {
+	trigger := *fakeTrigger.DeepCopy()
+	trigger.Redelivery = &v1alpha1.TriggerRedelivery{}
+	events := map[string]*v1alpha1.Event{
+		"dep": {Context: &v1alpha1.EventContext{ID: "event-1"}, Data: []byte(`{"name":"foo"}`)},
+	}
+
+	t.Run("forwards the events", func(t *testing.T) {
+		sink := &fakeDeadLetterSink{}
+		sensorCtx := &SensorContext{deadLetter: sink, metrics: sensormetrics.NewMetrics("fake")}
+		sensorCtx.forwardDeadLetter(context.TODO(), sensorObj, trigger, events, []string{"event-1"}, errors.New("failed"), 6)
+		assert.Len(t, sink.letters, 1)
+		var letter DeadLetter
+		assert.Nil(t, json.Unmarshal(sink.letters[0], &letter))
+		assert.Equal(t, idempotencyKey("fake-trigger", []string{"event-1"}), letter.ID)
+		assert.Equal(t, letter.ID, sink.ids[0])
+		assert.Equal(t, "fake-sensor", letter.Sensor)
+		assert.Equal(t, "fake", letter.Namespace)
+		assert.Equal(t, "fake-trigger", letter.Trigger)
+		assert.Equal(t, deadLetterReasonRedeliveriesExhausted, letter.Reason)
+		assert.Equal(t, "failed", letter.Error)
+		assert.Equal(t, 6, letter.Attempts)
+		assert.Equal(t, "event-1", letter.Events["dep"].Context.ID)
+		assert.Equal(t, `{"name":"foo"}`, string(letter.Events["dep"].Data))
+	})
+
+	t.Run("does not forward the events on shutdown", func(t *testing.T) {
+		sink := &fakeDeadLetterSink{}
+		sensorCtx := &SensorContext{deadLetter: sink, metrics: sensormetrics.NewMetrics("fake")}
+		sensorCtx.forwardDeadLetter(context.TODO(), sensorObj, trigger, events, []string{"event-1"}, errors.Wrap(context.Canceled, "failed"), 1)
+		assert.Empty(t, sink.letters)
+	})
+
+	t.Run("without dead letter sink", func(t *testing.T) {
+		sensorCtx := &SensorContext{}
+		sensorCtx.forwardDeadLetter(context.TODO(), sensorObj, trigger, events, []string{"event-1"}, errors.New("failed"), 1)
+	})
+}
+
+func TestHTTPDeadLetterSink(t *testing.T) {
+	var body []byte
+	var header http.Header
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ = ioutil.ReadAll(r.Body)
+		header = r.Header
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	sink, err := newHTTPDeadLetterSink(&v1alpha1.HTTPDeadLetterSink{URL: server.URL, Headers: map[string]string{"X-Team": "events"}})
+	assert.Nil(t, err)
+	defer sink.close()
+
+	assert.Nil(t, sink.send(context.TODO(), "id", []byte(`{"id":"id"}`)))
+	assert.Equal(t, `{"id":"id"}`, string(body))
+	assert.Equal(t, "application/json", header.Get("Content-Type"))
+	assert.Equal(t, "events", header.Get("X-Team"))
+
+	status = http.StatusServiceUnavailable
+	err = sink.send(context.TODO(), "id", []byte(`{"id":"id"}`))
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "status code 503")
+}
+
+func TestNewDeadLetterSink(t *testing.T) {
+	sink, err := newDeadLetterSink(context.TODO(), sensorObj, nil, "", "")
+	assert.Nil(t, err)
+	assert.Nil(t, sink)
+
+	sensor := sensorObj.DeepCopy()
+	sensor.Spec.DeadLetter = &v1alpha1.DeadLetter{EventBus: &v1alpha1.EventBusDeadLetterSink{Subject: "events"}}
+	_, err = newDeadLetterSink(context.TODO(), sensor, nil, "events", "")
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "no eventbus")
+}
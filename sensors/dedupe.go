@@ -0,0 +1,45 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"context"
+	"time"
+)
+
+// triggerDedupe deduplicates the executions of a trigger by the keys resolved from the events, recording
+// the keys in a state store for the TTL.
+type triggerDedupe struct {
+	store       StateStore
+	triggerName string
+	ttl         time.Duration
+}
+
+func newTriggerDedupe(store StateStore, triggerName string, ttl time.Duration) *triggerDedupe {
+	return &triggerDedupe{store: store, triggerName: triggerName, ttl: ttl}
+}
+
+// add records the key, and returns false if it was already recorded within the TTL.
+// The TTL of a key is counted from the time it is first recorded.
+func (d *triggerDedupe) add(ctx context.Context, key string) (bool, error) {
+	return d.store.Create(ctx, stateKey("dedupe", d.triggerName, key), nil, d.ttl)
+}
+
+// remove forgets the key, e.g. to let a failed execution be retried.
+func (d *triggerDedupe) remove(ctx context.Context, key string) error {
+	return d.store.Delete(ctx, stateKey("dedupe", d.triggerName, key))
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+func TestTriggerDedupe(t *testing.T) {
+	ctx := context.Background()
+	store := newMemoryStateStore(10)
+	dedupe := newTriggerDedupe(store, "fake-trigger", time.Minute)
+	added, err := dedupe.add(ctx, "a")
+	assert.NoError(t, err)
+	assert.True(t, added)
+	added, err = dedupe.add(ctx, "a")
+	assert.NoError(t, err)
+	assert.False(t, added)
+
+	// The keys of the triggers don't collide.
+	added, err = newTriggerDedupe(store, "other-trigger", time.Minute).add(ctx, "a")
+	assert.NoError(t, err)
+	assert.True(t, added)
+
+	assert.NoError(t, dedupe.remove(ctx, "a"))
+	assert.NoError(t, dedupe.remove(ctx, "missing"))
+	added, err = dedupe.add(ctx, "a")
+	assert.NoError(t, err)
+	assert.True(t, added)
+}
+
+func TestResolveDedupeKey(t *testing.T) {
+	events := map[string]*v1alpha1.Event{
+		"dep": {
+			Context: &v1alpha1.EventContext{
+				ID:              "1",
+				DataContentType: "application/json",
+			},
+			Data: []byte(`{"body": {"orderId": "order-1"}}`),
+		},
+	}
+
+	key, err := resolveDedupeKey(v1alpha1.Trigger{}, events)
+	assert.NoError(t, err)
+	assert.Equal(t, "", key)
+
+	trigger := v1alpha1.Trigger{
+		Dedupe: &v1alpha1.TriggerDedupe{
+			Key: v1alpha1.TriggerParameterSource{DependencyName: "dep", DataKey: "body.orderId"},
+		},
+	}
+	key, err = resolveDedupeKey(trigger, events)
+	assert.NoError(t, err)
+	assert.Equal(t, "order-1", key)
+
+	trigger.Dedupe.Key = v1alpha1.TriggerParameterSource{DependencyName: "dep", DataTemplate: "{{ .Input.body.orderId }}-v1"}
+	key, err = resolveDedupeKey(trigger, events)
+	assert.NoError(t, err)
+	assert.Equal(t, "order-1-v1", key)
+
+	trigger.Dedupe.Key = v1alpha1.TriggerParameterSource{DependencyName: "other", DataKey: "body.orderId"}
+	key, err = resolveDedupeKey(trigger, events)
+	assert.NoError(t, err)
+	assert.Equal(t, "", key)
+}
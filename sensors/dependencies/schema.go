@@ -0,0 +1,75 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dependencies
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/xeipuuv/gojsonschema"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+// SchemaValidationError is returned when the data of an event doesn't match the
+// schema of its dependency.
+type SchemaValidationError struct {
+	// Errors holds the description of each violation of the schema
+	Errors []string
+}
+
+func (e *SchemaValidationError) Error() string {
+	return fmt.Sprintf("event data doesn't match the schema [%s]", strings.Join(e.Errors, errMsgListSeparator))
+}
+
+// CompileSchema compiles the JSON schema of a dependency, written either in JSON or in YAML.
+func CompileSchema(schema string) (*gojsonschema.Schema, error) {
+	b, err := yaml.YAMLToJSON([]byte(schema))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the schema, %w", err)
+	}
+	s, err := gojsonschema.NewSchema(gojsonschema.NewBytesLoader(b))
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile the schema, %w", err)
+	}
+	return s, nil
+}
+
+// ValidateSchema validates the data of the event against the compiled schema,
+// the returned error is a *SchemaValidationError if the data doesn't match it.
+func ValidateSchema(event *v1alpha1.Event, schema *gojsonschema.Schema) error {
+	if schema == nil {
+		return nil
+	}
+	data := event.Data
+	if len(data) == 0 {
+		data = []byte("null")
+	}
+	result, err := schema.Validate(gojsonschema.NewBytesLoader(data))
+	if err != nil {
+		return &SchemaValidationError{Errors: []string{fmt.Sprintf("event data is not valid JSON, %s", err)}}
+	}
+	if result.Valid() {
+		return nil
+	}
+	errs := make([]string, 0, len(result.Errors()))
+	for _, e := range result.Errors() {
+		errs = append(errs, e.String())
+	}
+	return &SchemaValidationError{Errors: errs}
+}
@@ -0,0 +1,76 @@
+package dependencies
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+func TestCompileSchema(t *testing.T) {
+	t.Run("json", func(t *testing.T) {
+		_, err := CompileSchema(`{"type": "object", "required": ["orderId"]}`)
+		assert.NoError(t, err)
+	})
+
+	t.Run("yaml", func(t *testing.T) {
+		_, err := CompileSchema("type: object\nrequired:\n  - orderId\n")
+		assert.NoError(t, err)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := CompileSchema(`{"type": "unknown"}`)
+		assert.Error(t, err)
+	})
+}
+
+func TestValidateSchema(t *testing.T) {
+	schema, err := CompileSchema(`
+type: object
+required:
+  - orderId
+properties:
+  orderId:
+    type: string
+  amount:
+    type: number
+`)
+	assert.NoError(t, err)
+
+	t.Run("valid", func(t *testing.T) {
+		err := ValidateSchema(&v1alpha1.Event{Data: []byte(`{"orderId": "abc", "amount": 12.5}`)}, schema)
+		assert.NoError(t, err)
+	})
+
+	t.Run("missing property", func(t *testing.T) {
+		err := ValidateSchema(&v1alpha1.Event{Data: []byte(`{"amount": 12.5}`)}, schema)
+		var schemaErr *SchemaValidationError
+		assert.ErrorAs(t, err, &schemaErr)
+		assert.Len(t, schemaErr.Errors, 1)
+		assert.Contains(t, err.Error(), "orderId")
+	})
+
+	t.Run("wrong type", func(t *testing.T) {
+		err := ValidateSchema(&v1alpha1.Event{Data: []byte(`{"orderId": 1, "amount": "12.5"}`)}, schema)
+		var schemaErr *SchemaValidationError
+		assert.ErrorAs(t, err, &schemaErr)
+		assert.Len(t, schemaErr.Errors, 2)
+	})
+
+	t.Run("not json", func(t *testing.T) {
+		err := ValidateSchema(&v1alpha1.Event{Data: []byte(`orderId=abc`)}, schema)
+		var schemaErr *SchemaValidationError
+		assert.ErrorAs(t, err, &schemaErr)
+	})
+
+	t.Run("no data", func(t *testing.T) {
+		err := ValidateSchema(&v1alpha1.Event{}, schema)
+		var schemaErr *SchemaValidationError
+		assert.ErrorAs(t, err, &schemaErr)
+	})
+
+	t.Run("no schema", func(t *testing.T) {
+		assert.NoError(t, ValidateSchema(&v1alpha1.Event{Data: []byte(`{}`)}, nil))
+	})
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/argoproj/argo-events/common/logging"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+// triggerDependents executes the triggers depending on the root trigger for the events of its execution, one after
+// the other in topological order, a trigger being executed once all the triggers it depends on succeeded. The
+// triggers depending on a trigger which was skipped or failed are skipped, along with the ones depending on them.
+// Each trigger is given the outputs of the triggers it depends on, out of the outputs of the execution, which it adds
+// its own to. The logs of each execution carry the labels of the events, and the name of the trigger.
+func (sensorCtx *SensorContext) triggerDependents(ctx context.Context, sensor *v1alpha1.Sensor, root string, succeeded bool, outputs triggerOutputs, labels []interface{}, execute func(ctx context.Context, trigger v1alpha1.Trigger, dependsOnOutputs map[string]*v1alpha1.Event) bool) {
+	descendants := sensorCtx.graph.Descendants(root)
+	if len(descendants) == 0 {
+		return
+	}
+	succeededTriggers := map[string]bool{root: succeeded}
+	for _, name := range descendants {
+		ctx := logging.WithLogger(ctx, logging.FromContext(ctx).With(append([]interface{}{logging.LabelTriggerName, name}, labels...)...))
+		log := logging.FromContext(ctx)
+		trigger, ok := sensorCtx.dependents[name]
+		if !ok {
+			log.Warn("trigger is not initialized, skipping the execution")
+			sensorCtx.metrics.ActionSkipped(sensor.Name, name)
+			continue
+		}
+		dependsOn := sensorCtx.graph.DependsOn(name)
+		if dep, ok := firstFailed(dependsOn, succeededTriggers); ok {
+			log.Infow("trigger depends on a trigger which did not succeed, skipping the execution", zap.String("dependsOn", dep))
+			sensorCtx.metrics.ActionSkipped(sensor.Name, name)
+			continue
+		}
+		if ctx.Err() != nil {
+			log.Warn("sensor is shutting down, not triggering the dependent actions")
+			return
+		}
+		succeededTriggers[name] = execute(ctx, trigger, outputs.of(dependsOn))
+	}
+}
+
+// firstFailed returns the first of the triggers which did not succeed, if any
+func firstFailed(triggers []string, succeeded map[string]bool) (string, bool) {
+	for _, name := range triggers {
+		if !succeeded[name] {
+			return name, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	sensormetrics "github.com/argoproj/argo-events/metrics"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	sensortriggers "github.com/argoproj/argo-events/sensors/triggers"
+)
+
+func TestTriggerDependents(t *testing.T) {
+	sensor := &v1alpha1.Sensor{}
+	sensor.Name = "fake-sensor"
+	newTrigger := func(name string, dependsOn ...string) v1alpha1.Trigger {
+		return v1alpha1.Trigger{Template: &v1alpha1.TriggerTemplate{Name: name}, DependsOn: dependsOn}
+	}
+	// create-order <- charge <- ship, create-order <- notify
+	triggers := []v1alpha1.Trigger{
+		newTrigger("create-order"),
+		newTrigger("charge", "create-order"),
+		newTrigger("ship", "charge"),
+		newTrigger("notify", "create-order"),
+	}
+	graph, err := sensortriggers.NewGraph(triggers)
+	assert.Nil(t, err)
+	newSensorCtx := func() *SensorContext {
+		sensorCtx := &SensorContext{graph: graph, dependents: make(map[string]v1alpha1.Trigger), metrics: sensormetrics.NewMetrics("fake")}
+		for _, trigger := range triggers[1:] {
+			sensorCtx.dependents[trigger.Template.Name] = trigger
+		}
+		return sensorCtx
+	}
+
+	t.Run("executed in order", func(t *testing.T) {
+		var executed []string
+		newSensorCtx().triggerDependents(context.TODO(), sensor, "create-order", true, triggerOutputs{}, nil, func(ctx context.Context, trigger v1alpha1.Trigger, dependsOnOutputs map[string]*v1alpha1.Event) bool {
+			executed = append(executed, trigger.Template.Name)
+			return true
+		})
+		assert.Equal(t, []string{"charge", "ship", "notify"}, executed)
+	})
+
+	t.Run("failure skips the dependents", func(t *testing.T) {
+		var executed []string
+		newSensorCtx().triggerDependents(context.TODO(), sensor, "create-order", true, triggerOutputs{}, nil, func(ctx context.Context, trigger v1alpha1.Trigger, dependsOnOutputs map[string]*v1alpha1.Event) bool {
+			executed = append(executed, trigger.Template.Name)
+			return trigger.Template.Name != "charge"
+		})
+		assert.Equal(t, []string{"charge", "notify"}, executed)
+	})
+
+	t.Run("root failure skips all the dependents", func(t *testing.T) {
+		newSensorCtx().triggerDependents(context.TODO(), sensor, "create-order", false, triggerOutputs{}, nil, func(ctx context.Context, trigger v1alpha1.Trigger, dependsOnOutputs map[string]*v1alpha1.Event) bool {
+			t.Fatalf("trigger %s should not be executed", trigger.Template.Name)
+			return true
+		})
+	})
+
+	t.Run("uninitialized trigger is skipped", func(t *testing.T) {
+		sensorCtx := newSensorCtx()
+		delete(sensorCtx.dependents, "charge")
+		var executed []string
+		sensorCtx.triggerDependents(context.TODO(), sensor, "create-order", true, triggerOutputs{}, nil, func(ctx context.Context, trigger v1alpha1.Trigger, dependsOnOutputs map[string]*v1alpha1.Event) bool {
+			executed = append(executed, trigger.Template.Name)
+			return true
+		})
+		assert.Equal(t, []string{"notify"}, executed)
+	})
+
+	t.Run("outputs of the triggers depended on", func(t *testing.T) {
+		outputs := triggerOutputs{"create-order": {Data: []byte(`{"trigger":"create-order"}`)}}
+		given := make(map[string][]string)
+		newSensorCtx().triggerDependents(context.TODO(), sensor, "create-order", true, outputs, nil, func(ctx context.Context, trigger v1alpha1.Trigger, dependsOnOutputs map[string]*v1alpha1.Event) bool {
+			for key := range dependsOnOutputs {
+				given[trigger.Template.Name] = append(given[trigger.Template.Name], key)
+			}
+			outputs[trigger.Template.Name] = &v1alpha1.Event{}
+			return true
+		})
+		assert.Equal(t, map[string][]string{
+			"charge": {sensortriggers.OutputKey("create-order")},
+			"ship":   {sensortriggers.OutputKey("charge")},
+			"notify": {sensortriggers.OutputKey("create-order")},
+		}, given)
+	})
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/api/googleapi"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/record"
+
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+const (
+	// reasonTriggerSucceeded is the reason of the events of successful trigger executions
+	reasonTriggerSucceeded = "TriggerSucceeded"
+	// reasonTriggerFailed is the reason of the events of failed trigger executions
+	reasonTriggerFailed = "TriggerFailed"
+	// annotationTriggerName is the annotation of the events holding the name of the trigger
+	annotationTriggerName = "events.argoproj.io/trigger-name"
+	// annotationTriggerType is the annotation of the events holding the type of the trigger
+	annotationTriggerType = "events.argoproj.io/trigger-type"
+	// maxEventMessageLength is the length the error messages of the events are truncated to
+	maxEventMessageLength = 512
+	// eventsBurst is the number of events of a trigger outcome recorded at once
+	eventsBurst = 10
+	// eventsQPS is the rate the events of a trigger outcome are recorded at once the burst is used up
+	eventsQPS = 1. / 30.
+)
+
+// NewEventRecorder returns a recorder of the Kubernetes events of the sensor, and the function to
+// stop recording. The events of each trigger and outcome are rate limited, so that a storm of
+// executions doesn't flood the API server.
+func NewEventRecorder(kubeClient kubernetes.Interface, namespace, hostname string) (record.EventRecorder, func(), error) {
+	scheme := runtime.NewScheme()
+	if err := v1alpha1.AddToScheme(scheme); err != nil {
+		return nil, nil, err
+	}
+	broadcaster := record.NewBroadcasterWithCorrelatorOptions(record.CorrelatorOptions{
+		BurstSize:   eventsBurst,
+		QPS:         eventsQPS,
+		SpamKeyFunc: triggerEventSpamKey,
+	})
+	broadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{Interface: kubeClient.CoreV1().Events(namespace)})
+	recorder := broadcaster.NewRecorder(scheme, corev1.EventSource{Component: "sensor", Host: hostname})
+	return recorder, broadcaster.Shutdown, nil
+}
+
+// triggerEventSpamKey returns the key the events are rate limited by, the involved object, the
+// reason and the trigger.
+func triggerEventSpamKey(event *corev1.Event) string {
+	return strings.Join([]string{
+		event.Source.Component,
+		event.Source.Host,
+		event.InvolvedObject.Kind,
+		event.InvolvedObject.Namespace,
+		event.InvolvedObject.Name,
+		string(event.InvolvedObject.UID),
+		event.Reason,
+		event.Annotations[annotationTriggerName],
+	}, "")
+}
+
+// recordTriggerEvent records the outcome of a trigger execution as an event of the sensor.
+func (sensorCtx *SensorContext) recordTriggerEvent(sensor *v1alpha1.Sensor, triggerName string, triggerType apicommon.TriggerType, eventIDs []string, err error) {
+	if sensorCtx.recorder == nil {
+		return
+	}
+	annotations := map[string]string{
+		annotationTriggerName: triggerName,
+		annotationTriggerType: string(triggerType),
+	}
+	if err != nil {
+		sensorCtx.recorder.AnnotatedEventf(sensor, annotations, corev1.EventTypeWarning, reasonTriggerFailed,
+			"Trigger %s (%s) failed: %s", triggerName, triggerType, errorSummary(err))
+		return
+	}
+	sensorCtx.recorder.AnnotatedEventf(sensor, annotations, corev1.EventTypeNormal, reasonTriggerSucceeded,
+		"Trigger %s (%s) succeeded, triggered by events %s", triggerName, triggerType, strings.Join(eventIDs, ","))
+}
+
+// errorSummary returns a short description of the error of a trigger execution. The errors of the
+// Google APIs are summarized to their code and message, their details are left to the logs.
+func errorSummary(err error) string {
+	message := err.Error()
+	var apiErr *googleapi.Error
+	if errors.As(err, &apiErr) {
+		message = fmt.Sprintf("googleapi error %d: %s", apiErr.Code, apiErr.Message)
+	}
+	if len(message) > maxEventMessageLength {
+		message = message[:maxEventMessageLength] + "..."
+	}
+	return message
+}
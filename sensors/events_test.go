@@ -0,0 +1,73 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/api/googleapi"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+)
+
+func TestRecordTriggerEvent(t *testing.T) {
+	t.Run("no recorder", func(t *testing.T) {
+		sensorCtx := &SensorContext{}
+		sensorCtx.recordTriggerEvent(sensorObj, "fake-trigger", apicommon.K8sTrigger, []string{"1"}, nil)
+	})
+
+	t.Run("succeeded", func(t *testing.T) {
+		recorder := record.NewFakeRecorder(1)
+		sensorCtx := &SensorContext{recorder: recorder}
+		sensorCtx.recordTriggerEvent(sensorObj, "fake-trigger", apicommon.K8sTrigger, []string{"1", "2"}, nil)
+		assert.Equal(t, "Normal TriggerSucceeded Trigger fake-trigger (Kubernetes) succeeded, triggered by events 1,2", <-recorder.Events)
+	})
+
+	t.Run("failed", func(t *testing.T) {
+		recorder := record.NewFakeRecorder(1)
+		sensorCtx := &SensorContext{recorder: recorder}
+		sensorCtx.recordTriggerEvent(sensorObj, "fake-trigger", apicommon.K8sTrigger, []string{"1"}, errors.New("forbidden"))
+		assert.Equal(t, "Warning TriggerFailed Trigger fake-trigger (Kubernetes) failed: forbidden", <-recorder.Events)
+	})
+}
+
+func TestErrorSummary(t *testing.T) {
+	err := errors.Wrap(&googleapi.Error{Code: 403, Message: "permission denied", Body: "{...}"}, "failed to call function")
+	assert.Equal(t, "googleapi error 403: permission denied", errorSummary(err))
+
+	summary := errorSummary(errors.New(strings.Repeat("x", 1000)))
+	assert.Equal(t, maxEventMessageLength+3, len(summary))
+	assert.True(t, strings.HasSuffix(summary, "..."))
+}
+
+func TestTriggerEventSpamKey(t *testing.T) {
+	event := func(trigger string) *corev1.Event {
+		return &corev1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Annotations: map[string]string{annotationTriggerName: trigger}},
+			InvolvedObject: corev1.ObjectReference{Kind: "Sensor", Namespace: "fake", Name: "fake-sensor"},
+			Reason:         reasonTriggerFailed,
+		}
+	}
+	assert.Equal(t, triggerEventSpamKey(event("a")), triggerEventSpamKey(event("a")))
+	assert.NotEqual(t, triggerEventSpamKey(event("a")), triggerEventSpamKey(event("b")))
+}
@@ -0,0 +1,161 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/argoproj/argo-events/common/logging"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	sensortriggers "github.com/argoproj/argo-events/sensors/triggers"
+)
+
+// healthCheckInterval is how often the connectivity of the triggers is checked
+const healthCheckInterval = 30 * time.Second
+
+// healthCheckTimeout bounds the connectivity check of each trigger
+const healthCheckTimeout = 10 * time.Second
+
+// triggerHealth holds the failures of the last connectivity check of each trigger.
+type triggerHealth struct {
+	lock    sync.RWMutex
+	checked bool
+	errs    map[string]error
+}
+
+// record replaces the failures of the triggers, and returns the previous ones.
+func (h *triggerHealth) record(errs map[string]error) map[string]error {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	previous := h.errs
+	h.errs = errs
+	h.checked = true
+	return previous
+}
+
+// report tells if the critical triggers are all reachable, along with a line for each failing trigger.
+// The sensor is not ready until the triggers are checked once, unless none of them is critical.
+func (h *triggerHealth) report(triggers []v1alpha1.Trigger) (bool, []string) {
+	h.lock.RLock()
+	defer h.lock.RUnlock()
+	ready := true
+	var lines []string
+	for _, trigger := range triggers {
+		if !h.checked {
+			if trigger.Critical {
+				ready = false
+			}
+			continue
+		}
+		err, failed := h.errs[trigger.Template.Name]
+		if !failed {
+			continue
+		}
+		if trigger.Critical {
+			ready = false
+			lines = append(lines, fmt.Sprintf("critical trigger %s is unreachable: %v", trigger.Template.Name, err))
+		} else {
+			lines = append(lines, fmt.Sprintf("trigger %s is unreachable: %v", trigger.Template.Name, err))
+		}
+	}
+	if !h.checked && !ready {
+		lines = append(lines, "the connectivity of the triggers is not checked yet")
+	}
+	sort.Strings(lines)
+	return ready, lines
+}
+
+// ReadinessHandler serves the readiness probe of the sensor, failing while a critical trigger is unreachable.
+func (sensorCtx *SensorContext) ReadinessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ready, lines := sensorCtx.health.report(sensorCtx.sensor.Spec.Triggers)
+		if !ready {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		if len(lines) == 0 {
+			lines = []string{"ok"}
+		}
+		_, _ = w.Write([]byte(strings.Join(lines, "\n") + "\n"))
+	})
+}
+
+// runHealthChecks checks the connectivity of the triggers periodically until the context is done.
+func (sensorCtx *SensorContext) runHealthChecks(ctx context.Context) {
+	ticker := time.NewTicker(healthCheckInterval)
+	defer ticker.Stop()
+	for {
+		sensorCtx.checkTriggers(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// checkTriggers checks the connectivity of each trigger, and logs the triggers becoming unreachable
+// or reachable again.
+func (sensorCtx *SensorContext) checkTriggers(ctx context.Context) {
+	logger := logging.FromContext(ctx)
+	errs := make(map[string]error)
+	for i := range sensorCtx.sensor.Spec.Triggers {
+		trigger := &sensorCtx.sensor.Spec.Triggers[i]
+		triggerCtx := logging.WithLogger(ctx, logger.With(logging.LabelTriggerName, trigger.Template.Name))
+		if err := sensorCtx.checkTrigger(triggerCtx, trigger); err != nil {
+			errs[trigger.Template.Name] = err
+		}
+	}
+	if ctx.Err() != nil {
+		// The checks cut off by the shutdown are not relevant.
+		return
+	}
+	previous := sensorCtx.health.record(errs)
+	for name, err := range errs {
+		if _, ok := previous[name]; !ok {
+			logger.Warnw("trigger is unreachable", zap.String(logging.LabelTriggerName, name), zap.Error(err))
+		}
+	}
+	for name := range previous {
+		if _, ok := errs[name]; !ok {
+			logger.Infow("trigger is reachable again", zap.String(logging.LabelTriggerName, name))
+		}
+	}
+}
+
+// checkTrigger checks the connectivity of a trigger, the triggers unable to check it are considered reachable.
+func (sensorCtx *SensorContext) checkTrigger(ctx context.Context, trigger *v1alpha1.Trigger) error {
+	triggerImpl := sensorCtx.GetTrigger(ctx, trigger)
+	if triggerImpl == nil {
+		return errors.New("failed to create the trigger")
+	}
+	checker, ok := triggerImpl.(sensortriggers.HealthChecker)
+	if !ok {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+	return checker.CheckHealth(ctx)
+}
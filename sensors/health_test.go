@@ -0,0 +1,90 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+func TestTriggerHealth(t *testing.T) {
+	triggers := []v1alpha1.Trigger{
+		{Template: &v1alpha1.TriggerTemplate{Name: "critical"}, Critical: true},
+		{Template: &v1alpha1.TriggerTemplate{Name: "optional"}},
+	}
+
+	t.Run("not checked yet", func(t *testing.T) {
+		h := &triggerHealth{}
+		ready, lines := h.report(triggers)
+		assert.False(t, ready)
+		assert.Len(t, lines, 1)
+
+		ready, _ = h.report(triggers[1:])
+		assert.True(t, ready)
+	})
+
+	t.Run("optional trigger unreachable", func(t *testing.T) {
+		h := &triggerHealth{}
+		h.record(map[string]error{"optional": errors.New("fake error")})
+		ready, lines := h.report(triggers)
+		assert.True(t, ready)
+		assert.Equal(t, []string{"trigger optional is unreachable: fake error"}, lines)
+	})
+
+	t.Run("critical trigger unreachable", func(t *testing.T) {
+		h := &triggerHealth{}
+		h.record(map[string]error{"critical": errors.New("fake error")})
+		ready, lines := h.report(triggers)
+		assert.False(t, ready)
+		assert.Equal(t, []string{"critical trigger critical is unreachable: fake error"}, lines)
+
+		previous := h.record(map[string]error{})
+		assert.Len(t, previous, 1)
+		ready, lines = h.report(triggers)
+		assert.True(t, ready)
+		assert.Empty(t, lines)
+	})
+}
+
+func TestReadinessHandler(t *testing.T) {
+	sensor := sensorObj.DeepCopy()
+	sensor.Spec.Triggers[0].Critical = true
+	sensorCtx := &SensorContext{sensor: sensor}
+	handler := sensorCtx.ReadinessHandler()
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/ready", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+
+	sensorCtx.health.record(map[string]error{})
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/ready", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "ok\n", rec.Body.String())
+
+	sensorCtx.health.record(map[string]error{sensor.Spec.Triggers[0].Template.Name: errors.New("fake error")})
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/ready", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	assert.Contains(t, rec.Body.String(), "fake error")
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	natslib "github.com/nats-io/nats.go"
+	"github.com/pkg/errors"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+// idempotencyStore records the executions of the triggers in a state store, for the executions for
+// the events delivered again to be skipped.
+type idempotencyStore struct {
+	state StateStore
+	ttl   time.Duration
+	// owned is true if the state store is the one of the idempotency only, closed with it.
+	owned bool
+}
+
+// maxIdempotencyKeys is the maximum number of records kept in memory
+const maxIdempotencyKeys = 100000
+
+// newIdempotencyStore returns the idempotency store of the sensor, or nil if it has none. The records are kept in
+// the JetStream key-value bucket of the idempotency if it has one, and in the given state store otherwise.
+func newIdempotencyStore(sensor *v1alpha1.Sensor, state StateStore) (*idempotencyStore, error) {
+	idempotency := sensor.Spec.Idempotency
+	if idempotency == nil {
+		return nil, nil
+	}
+	if js := idempotency.JetStream; js != nil {
+		opts := []natslib.Option{natslib.Name("argo-events-idempotency")}
+		if js.TLS != nil {
+			tlsConfig, err := common.GetTLSConfig(js.TLS)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to get the tls configuration")
+			}
+			opts = append(opts, natslib.Secure(tlsConfig))
+		}
+		store, err := newJetStreamStateStore(js.URL, opts, js.GetBucket(sensor.Name), "Executions of the triggers of a sensor", idempotency.GetTTL())
+		if err != nil {
+			return nil, err
+		}
+		return &idempotencyStore{state: store, ttl: idempotency.GetTTL(), owned: true}, nil
+	}
+	return &idempotencyStore{state: state, ttl: idempotency.GetTTL()}, nil
+}
+
+// idempotencyKey returns the key of the execution of the trigger for the events. The key is
+// hashed, for it to be a valid key whatever the characters of the trigger name and event IDs.
+func idempotencyKey(triggerName string, eventIDs []string) string {
+	sum := sha256.Sum256([]byte(triggerName + "/" + strings.Join(eventIDs, ",")))
+	return hex.EncodeToString(sum[:])
+}
+
+// Exists tells if the key is recorded and not expired.
+func (s *idempotencyStore) Exists(ctx context.Context, key string) (bool, error) {
+	_, ok, err := s.state.Get(ctx, "idempotency."+key)
+	return ok, err
+}
+
+// Record records the key, expiring after the TTL of the store.
+func (s *idempotencyStore) Record(ctx context.Context, key string) error {
+	return s.state.Set(ctx, "idempotency."+key, []byte(time.Now().UTC().Format(time.RFC3339)), s.ttl)
+}
+
+// Close releases the state store if it is the one of the idempotency only.
+func (s *idempotencyStore) Close() error {
+	if !s.owned {
+		return nil
+	}
+	return s.state.Close()
+}
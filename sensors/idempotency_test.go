@@ -0,0 +1,73 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+func TestIdempotencyKey(t *testing.T) {
+	key := idempotencyKey("fake-trigger", []string{"event-a", "event-b"})
+	assert.Len(t, key, 64)
+	assert.Equal(t, key, idempotencyKey("fake-trigger", []string{"event-a", "event-b"}))
+	assert.NotEqual(t, key, idempotencyKey("other-trigger", []string{"event-a", "event-b"}))
+	assert.NotEqual(t, key, idempotencyKey("fake-trigger", []string{"event-a"}))
+}
+
+func TestNewIdempotencyStore(t *testing.T) {
+	state := newMemoryStateStore(10)
+	sensor := sensorObj.DeepCopy()
+	store, err := newIdempotencyStore(sensor, state)
+	assert.NoError(t, err)
+	assert.Nil(t, store)
+
+	sensor.Spec.Idempotency = &v1alpha1.Idempotency{TTL: "1h"}
+	store, err = newIdempotencyStore(sensor, state)
+	assert.NoError(t, err)
+	assert.Same(t, state, store.state)
+	assert.Equal(t, time.Hour, store.ttl)
+	assert.False(t, store.owned)
+}
+
+func TestIdempotencyStore(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	state := newMemoryStateStore(10)
+	state.now = func() time.Time { return now }
+	store := &idempotencyStore{state: state, ttl: time.Hour}
+
+	exists, err := store.Exists(ctx, "a")
+	assert.NoError(t, err)
+	assert.False(t, exists)
+
+	assert.NoError(t, store.Record(ctx, "a"))
+	exists, err = store.Exists(ctx, "a")
+	assert.NoError(t, err)
+	assert.True(t, exists)
+
+	now = now.Add(time.Hour)
+	exists, err = store.Exists(ctx, "a")
+	assert.NoError(t, err)
+	assert.False(t, exists)
+	assert.NoError(t, store.Close())
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"math/rand"
+	"sort"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -30,6 +31,7 @@ import (
 	"github.com/argoproj/argo-events/common"
 	"github.com/argoproj/argo-events/common/leaderelection"
 	"github.com/argoproj/argo-events/common/logging"
+	"github.com/argoproj/argo-events/common/tracing"
 	"github.com/argoproj/argo-events/eventbus"
 	eventbusdriver "github.com/argoproj/argo-events/eventbus/driver"
 	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
@@ -37,15 +39,17 @@ import (
 	sensordependencies "github.com/argoproj/argo-events/sensors/dependencies"
 	sensortriggers "github.com/argoproj/argo-events/sensors/triggers"
 	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/google/cel-go/cel"
 	"github.com/pkg/errors"
 	cronlib "github.com/robfig/cron/v3"
-	"go.uber.org/ratelimit"
+	"github.com/xeipuuv/gojsonschema"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
+	"golang.org/x/time/rate"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
-var rateLimiters = make(map[string]ratelimit.Limiter)
-
 func subscribeOnce(subLock *uint32, subscribe func()) {
 	// acquire subLock if not already held
 	if !atomic.CompareAndSwapUint32(subLock, 0, 1) {
@@ -74,8 +78,13 @@ func (sensorCtx *SensorContext) Start(ctx context.Context) error {
 		log.Errorw("failed to get an elector", zap.Error(err))
 		return err
 	}
+	// The connectivity of the triggers is checked by all the replicas, for them to be ready to take over.
+	go sensorCtx.runHealthChecks(ctx)
+	var listening sync.WaitGroup
 	elector.RunOrDie(ctx, leaderelection.LeaderCallbacks{
 		OnStartedLeading: func(ctx context.Context) {
+			listening.Add(1)
+			defer listening.Done()
 			if err := sensorCtx.listenEvents(ctx); err != nil {
 				log.Fatalw("failed to start", zap.Error(err))
 			}
@@ -84,22 +93,84 @@ func (sensorCtx *SensorContext) Start(ctx context.Context) error {
 			log.Fatalf("leader lost: %s", sensorCtx.hostname)
 		},
 	})
+	// Give the listener the chance to drain in-flight trigger executions before exiting.
+	listening.Wait()
 	return nil
 }
 
-func initRateLimiter(trigger v1alpha1.Trigger) {
-	duration := time.Second
-	if trigger.RateLimit != nil {
-		switch trigger.RateLimit.Unit {
-		case v1alpha1.Minute:
-			duration = time.Minute
-		case v1alpha1.Hour:
-			duration = time.Hour
+// initTriggers builds the rate limiters, dedupes, circuit breakers, conditions and canary groups of the triggers,
+// and the triggers depending on others, before any of them is subscribed. They are only read afterwards. The
+// circuits are restored from the state store, and start closed if they can't be.
+func (sensorCtx *SensorContext) initTriggers(ctx context.Context, triggers []v1alpha1.Trigger) error {
+	if sensorCtx.state == nil {
+		sensorCtx.state = newMemoryStateStore(maxStateKeys)
+	}
+	sensorCtx.rateLimiters = make(map[string]*rate.Limiter)
+	sensorCtx.dedupes = make(map[string]*triggerDedupe)
+	sensorCtx.circuitBreakers = make(map[string]*circuitBreaker)
+	sensorCtx.conditions = make(map[string]cel.Program)
+	sensorCtx.canaryGroups = newCanaryGroups(triggers)
+	sensorCtx.dependents = make(map[string]v1alpha1.Trigger)
+	for _, t := range triggers {
+		name := t.Template.Name
+		sensorCtx.rateLimiters[name] = newRateLimiter(t.RateLimit)
+		if t.Dedupe != nil {
+			sensorCtx.dedupes[name] = newTriggerDedupe(sensorCtx.featureStore(t.Dedupe.GetMaxKeys()), name, t.Dedupe.GetTTL())
+		}
+		if cb := t.CircuitBreaker; cb != nil {
+			breaker := newCircuitBreaker(cb.GetFailureThreshold(), cb.GetWindow(), cb.GetCooldown())
+			snapshot, ok, err := loadCircuit(ctx, sensorCtx.state, name)
+			if err != nil {
+				logging.FromContext(ctx).Warnw("failed to restore the trigger circuit, starting closed", zap.String(logging.LabelTriggerName, name), zap.Error(err))
+			} else if ok {
+				breaker.restore(snapshot)
+			}
+			sensorCtx.circuitBreakers[name] = breaker
+		}
+		if t.Template.Condition != "" {
+			program, err := sensortriggers.NewCondition(t.Template.Condition)
+			if err != nil {
+				return errors.Wrapf(err, "failed to compile the condition of trigger %s", name)
+			}
+			sensorCtx.conditions[name] = program
+		}
+		if !sensorCtx.graph.IsRoot(name) {
+			// The triggers depending on others don't subscribe, they are executed after them for the same events.
+			sensorCtx.dependents[name] = t
 		}
-		rateLimiters[trigger.Template.Name] = ratelimit.New(int(trigger.RateLimit.RequestsPerUnit), ratelimit.Per(duration))
-	} else {
-		rateLimiters[trigger.Template.Name] = ratelimit.NewUnlimited()
 	}
+	return nil
+}
+
+// resolveDedupeKey returns the dedupe key of the trigger resolved from the events,
+// or an empty key if the execution is not deduplicated.
+func resolveDedupeKey(trigger v1alpha1.Trigger, events map[string]*v1alpha1.Event) (string, error) {
+	if trigger.Dedupe == nil {
+		return "", nil
+	}
+	key, err := sensortriggers.ResolveParamValue(&trigger.Dedupe.Key, events)
+	if err != nil {
+		return "", err
+	}
+	if key == nil {
+		return "", nil
+	}
+	return *key, nil
+}
+
+// newRateLimiter returns a token bucket limiter refilled at the requests per unit of the rate limit.
+func newRateLimiter(rateLimit *v1alpha1.RateLimit) *rate.Limiter {
+	if rateLimit == nil || rateLimit.RequestsPerUnit <= 0 {
+		return rate.NewLimiter(rate.Inf, 0)
+	}
+	duration := time.Second
+	switch rateLimit.Unit {
+	case v1alpha1.Minute:
+		duration = time.Minute
+	case v1alpha1.Hour:
+		duration = time.Hour
+	}
+	return rate.NewLimiter(rate.Every(duration/time.Duration(rateLimit.RequestsPerUnit)), rateLimit.GetBurst())
 }
 
 // listenEvents watches and handles events received from the gateway.
@@ -108,15 +179,99 @@ func (sensorCtx *SensorContext) listenEvents(ctx context.Context) error {
 	sensor := sensorCtx.sensor
 
 	depMapping := make(map[string]v1alpha1.EventDependency)
+	// The schemas are compiled once and shared by the subscriptions of all the triggers.
+	depSchemas := make(map[string]*gojsonschema.Schema)
 	for _, d := range sensor.Spec.Dependencies {
 		depMapping[d.Name] = d
+		if d.Schema == "" {
+			continue
+		}
+		schema, err := sensordependencies.CompileSchema(d.Schema)
+		if err != nil {
+			return errors.Wrapf(err, "invalid schema of dependency %s", d.Name)
+		}
+		depSchemas[d.Name] = schema
 	}
 
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
+
+	// Trigger executions run with a separate context, so that the in-flight ones
+	// are not cut off as soon as the sensor starts shutting down.
+	triggerCtx, cancelTriggers := context.WithCancel(logging.WithLogger(context.Background(), logger))
+	defer cancelTriggers()
+	sensorCtx.triggerCtx = triggerCtx
+	state, err := newStateStore(sensor, sensorCtx.eventBusConfig)
+	if err != nil {
+		return errors.Wrap(err, "failed to create the state store")
+	}
+	defer func() {
+		if err := state.Close(); err != nil {
+			logger.Errorw("failed to close the state store", zap.Error(err))
+		}
+	}()
+	sensorCtx.state = state
+	store, err := newIdempotencyStore(sensor, sensorCtx.featureStore(maxIdempotencyKeys))
+	if err != nil {
+		return errors.Wrap(err, "failed to create the idempotency store")
+	}
+	if store != nil {
+		defer func() {
+			if err := store.Close(); err != nil {
+				logger.Errorw("failed to close the idempotency store", zap.Error(err))
+			}
+		}()
+	}
+	sensorCtx.idempotency = store
+	deadLetter, err := newDeadLetterSink(ctx, sensor, sensorCtx.eventBusConfig, sensorCtx.eventBusSubject, sensorCtx.hostname)
+	if err != nil {
+		return errors.Wrap(err, "failed to create the dead letter sink")
+	}
+	if deadLetter != nil {
+		defer func() {
+			if err := deadLetter.close(); err != nil {
+				logger.Errorw("failed to close the dead letter sink", zap.Error(err))
+			}
+		}()
+	}
+	sensorCtx.deadLetter = deadLetter
+	busPublisher, err := newEventBusPublisher(ctx, sensor, sensorCtx.eventBusConfig, sensorCtx.eventBusSubject, sensorCtx.hostname)
+	if err != nil {
+		return errors.Wrap(err, "failed to create the eventbus publisher")
+	}
+	if busPublisher != nil {
+		defer func() {
+			if err := busPublisher.close(); err != nil {
+				logger.Errorw("failed to close the eventbus publisher", zap.Error(err))
+			}
+		}()
+	}
+	sensorCtx.busPublisher = busPublisher
+	sensorCtx.executionLimiter = newExecutionLimiter(int(sensor.Spec.TriggerConcurrency))
+	sensorCtx.eventWindow = newEventWindow(int(sensor.Spec.MaxInFlightEvents))
+	if sensor.Spec.PartitionKey != "" {
+		program, err := sensortriggers.NewPartitionKey(sensor.Spec.PartitionKey)
+		if err != nil {
+			return errors.Wrap(err, "failed to compile the partition key")
+		}
+		sensorCtx.partitionKey = program
+		sensorCtx.partitioner = newPartitioner(int(sensor.Spec.MaxActivePartitions))
+	}
+
+	graph, err := sensortriggers.NewGraph(sensor.Spec.Triggers)
+	if err != nil {
+		return errors.Wrap(err, "invalid trigger dependencies")
+	}
+	sensorCtx.graph = graph
+	if err := sensorCtx.initTriggers(ctx, sensor.Spec.Triggers); err != nil {
+		return err
+	}
+
 	wg := &sync.WaitGroup{}
 	for _, t := range sensor.Spec.Triggers {
-		initRateLimiter(t)
+		if _, ok := sensorCtx.dependents[t.Template.Name]; ok {
+			continue
+		}
 		wg.Add(1)
 		go func(trigger v1alpha1.Trigger) {
 			defer wg.Done()
@@ -181,31 +336,48 @@ func (sensorCtx *SensorContext) listenEvents(ctx context.Context) error {
 				if !ok {
 					return false
 				}
-				if dep.Filters == nil {
+				sensorCtx.metrics.SensorEventReceived(sensor.Name, trigger.Template.Name)
+				schema, hasSchema := depSchemas[depName]
+				if dep.Filters == nil && !hasSchema {
 					return true
 				}
 				argoEvent := convertEvent(cloudEvent)
+				// The event is logged with its sensitive fields masked.
+				maskedEvent := sensortriggers.MaskEvent(sensor, depName, argoEvent)
+
+				if err := sensordependencies.ValidateSchema(argoEvent, schema); err != nil {
+					logger.Warnf("Event [%s] discarded due to schema validation: %s",
+						eventToString(maskedEvent), err.Error())
+					sensorCtx.metrics.ActionSchemaRejected(sensor.Name, trigger.Template.Name)
+					return false
+				}
 
 				result, err := sensordependencies.Filter(argoEvent, dep.Filters, dep.FiltersLogicalOperator)
 				if err != nil {
 					if !result {
 						logger.Warnf("Event [%s] discarded due to filtering error: %s",
-							eventToString(argoEvent), err.Error())
+							eventToString(maskedEvent), err.Error())
 					} else {
 						logger.Warnf("Event [%s] passed but with filtering error: %s",
-							eventToString(argoEvent), err.Error())
+							eventToString(maskedEvent), err.Error())
 					}
 				} else {
 					if !result {
-						logger.Warnf("Event [%s] discarded due to filtering", eventToString(argoEvent))
+						logger.Warnf("Event [%s] discarded due to filtering", eventToString(maskedEvent))
 					}
 				}
 				return result
 			}
 
-			actionFunc := func(events map[string]cloudevents.Event) {
-				if err := sensorCtx.triggerActions(ctx, sensor, events, trigger); err != nil {
+			actionFunc := func(events map[string]cloudevents.Event, done func(error)) {
+				if err := ctx.Err(); err != nil {
+					logger.Warnw("sensor is shutting down, not triggering actions", zap.String(logging.LabelTriggerName, trigger.Template.Name))
+					done(err)
+					return
+				}
+				if err := sensorCtx.triggerActions(triggerCtx, sensor, events, trigger, done); err != nil {
 					logger.Errorw("failed to trigger actions", zap.Error(err))
+					done(err)
 				}
 			}
 
@@ -271,7 +443,7 @@ func (sensorCtx *SensorContext) listenEvents(ctx context.Context) error {
 
 					logger.Infof("started subscribing to events for trigger %s with client %s", trigger.Template.Name, clientID)
 
-					err = ebDriver.SubscribeEventSources(ctx, conn, group, closeSubCh, resetConditionsCh, lastResetTime, depExpression, deps, transformFunc, filterFunc, actionFunc)
+					err = ebDriver.SubscribeEventSources(ctx, conn, group, closeSubCh, resetConditionsCh, lastResetTime, depExpression, deps, transformFunc, filterFunc, actionFunc, sensor.Spec.IsAtMostOnce())
 					if err != nil {
 						logger.Errorw("failed to subscribe to eventbus", zap.Any("clientID", clientID), zap.Error(err))
 						return
@@ -326,59 +498,309 @@ func (sensorCtx *SensorContext) listenEvents(ctx context.Context) error {
 	<-ctx.Done()
 	logger.Info("Shutting down...")
 	cancel()
+	// The executions waiting for a slot are not started.
+	sensorCtx.executionLimiter.close()
+	sensorCtx.eventWindow.close()
+	sensorCtx.partitioner.close()
 	wg.Wait()
+	sensorCtx.drainTriggers(logger, cancelTriggers)
 	return nil
 }
 
-func (sensorCtx *SensorContext) triggerActions(ctx context.Context, sensor *v1alpha1.Sensor, events map[string]cloudevents.Event, trigger v1alpha1.Trigger) error {
+// drainTriggers waits up to the drain timeout for the in-flight trigger executions to finish,
+// and cancels the ones still running after that.
+func (sensorCtx *SensorContext) drainTriggers(logger *zap.SugaredLogger, cancelTriggers context.CancelFunc) {
+	inFlight := atomic.LoadInt64(&sensorCtx.inFlightCount)
+	if inFlight == 0 {
+		return
+	}
+	logger.Infof("waiting up to %v for %d in-flight trigger executions to finish", sensorCtx.drainTimeout, inFlight)
+	done := make(chan struct{})
+	go func() {
+		sensorCtx.inFlightTriggers.Wait()
+		close(done)
+	}()
+	timer := time.NewTimer(sensorCtx.drainTimeout)
+	defer timer.Stop()
+	select {
+	case <-done:
+		logger.Infow("drained in-flight trigger executions", "drained", inFlight, "cancelled", 0)
+	case <-timer.C:
+		cancelled := atomic.LoadInt64(&sensorCtx.inFlightCount)
+		cancelTriggers()
+		logger.Warnw("drain timeout exceeded, cancelled in-flight trigger executions", "drained", inFlight-cancelled, "cancelled", cancelled)
+	}
+}
+
+// triggerActions executes the trigger for the events in the background, and calls done once the execution is done,
+// with an error if it is cancelled or doesn't start in the background, for the events to be redelivered. done isn't
+// called if it returns an error.
+func (sensorCtx *SensorContext) triggerActions(ctx context.Context, sensor *v1alpha1.Sensor, events map[string]cloudevents.Event, trigger v1alpha1.Trigger, done func(error)) error {
 	eventsMapping := make(map[string]*v1alpha1.Event)
 	depNames := make([]string, 0, len(events))
-	eventIDs := make([]string, 0, len(events))
 	for k, v := range events {
 		eventsMapping[k] = convertEvent(v)
 		depNames = append(depNames, k)
-		eventIDs = append(eventIDs, v.ID())
 	}
-	go sensorCtx.triggerWithRateLimit(ctx, sensor, trigger, eventsMapping, depNames, eventIDs)
+	// The dependencies are sorted for the correlation ID to be the same across the logs.
+	sort.Strings(depNames)
+	eventIDs := make([]string, 0, len(events))
+	for _, depName := range depNames {
+		eventIDs = append(eventIDs, events[depName].ID())
+	}
+	ctx = tracing.ExtractFromEvents(ctx, events)
+	// The logs of the execution, including the ones of the trigger implementation, carry the fields
+	// to correlate them with the events it was triggered by.
+	labels := []interface{}{
+		logging.LabelCorrelationID, strings.Join(eventIDs, ","),
+		logging.LabelTriggeredBy, depNames,
+		logging.LabelTriggeredByEvents, eventIDs,
+	}
+	eventsCtx := ctx
+	ctx = logging.WithLogger(ctx, logging.FromContext(ctx).With(append([]interface{}{logging.LabelTriggerName, trigger.Template.Name}, labels...)...))
+	// The subscription waits for room in the window and for a slot, for the events above the limits to
+	// queue on the eventbus.
+	if !sensorCtx.eventWindow.acquire(ctx, len(events)) {
+		return errors.New("sensor is shutting down, not triggering the actions")
+	}
+	// execute runs the execution once it has a slot, and then releases its slot and its room in the window.
+	execute := func() {
+		atomic.AddInt64(&sensorCtx.inFlightCount, 1)
+		sensorCtx.metrics.IncActionInFlight(sensor.Name, trigger.Template.Name)
+		sensorCtx.metrics.AddEventsInFlight(sensor.Name, len(events))
+		defer sensorCtx.inFlightTriggers.Done()
+		defer atomic.AddInt64(&sensorCtx.inFlightCount, -1)
+		defer sensorCtx.metrics.DecActionInFlight(sensor.Name, trigger.Template.Name)
+		defer sensorCtx.metrics.AddEventsInFlight(sensor.Name, -len(events))
+		defer sensorCtx.eventWindow.release(len(events))
+		defer sensorCtx.executionLimiter.release()
+		if lag, ok := processingLag(events, time.Now()); ok {
+			sensorCtx.metrics.SensorEventLag(sensor.Name, trigger.Template.Name, lag.Seconds())
+		}
+		defer sensorCtx.metrics.SensorEventsProcessed(sensor.Name, trigger.Template.Name, len(events))
+		// The outputs of the triggers of the execution are only referred to by the triggers depending on them.
+		outputs := triggerOutputs{}
+		succeeded := sensorCtx.triggerWithRedelivery(ctx, sensor, trigger, eventsMapping, eventIDs, outputs)
+		sensorCtx.triggerDependents(eventsCtx, sensor, trigger.Template.Name, succeeded, outputs, labels, func(ctx context.Context, dependent v1alpha1.Trigger, dependsOnOutputs map[string]*v1alpha1.Event) bool {
+			return sensorCtx.triggerWithRedelivery(ctx, sensor, dependent, withOutputs(eventsMapping, dependsOnOutputs), eventIDs, outputs)
+		})
+		done(ctx.Err())
+	}
+	if key, ok := sensorCtx.resolvePartitionKey(ctx, trigger, eventsMapping); ok {
+		// The execution waits for the previous ones of its partition, which hold their slots meanwhile.
+		sensorCtx.inFlightTriggers.Add(1)
+		if !sensorCtx.partitioner.submit(ctx, key, func() {
+			if ctx.Err() != nil || !sensorCtx.executionLimiter.acquire(ctx) {
+				logging.FromContext(ctx).Warn("sensor is shutting down, not triggering the actions")
+				sensorCtx.eventWindow.release(len(events))
+				sensorCtx.inFlightTriggers.Done()
+				done(errors.New("sensor is shutting down, not triggering the actions"))
+				return
+			}
+			execute()
+		}) {
+			sensorCtx.eventWindow.release(len(events))
+			sensorCtx.inFlightTriggers.Done()
+			return errors.New("sensor is shutting down, not triggering the actions")
+		}
+		return nil
+	}
+	if !sensorCtx.executionLimiter.acquire(ctx) {
+		sensorCtx.eventWindow.release(len(events))
+		return errors.New("sensor is shutting down, not triggering the actions")
+	}
+	sensorCtx.inFlightTriggers.Add(1)
+	go execute()
 	return nil
 }
 
-func (sensorCtx *SensorContext) triggerWithRateLimit(ctx context.Context, sensor *v1alpha1.Sensor, trigger v1alpha1.Trigger, eventsMapping map[string]*v1alpha1.Event, depNames, eventIDs []string) {
-	if rl, ok := rateLimiters[trigger.Template.Name]; ok {
-		rl.Take()
+// processingLag returns the time between the oldest of the events and now, if any of them has a time.
+func processingLag(events map[string]cloudevents.Event, now time.Time) (time.Duration, bool) {
+	var oldest time.Time
+	for _, event := range events {
+		if t := event.Time(); !t.IsZero() && (oldest.IsZero() || t.Before(oldest)) {
+			oldest = t
+		}
 	}
+	if oldest.IsZero() {
+		return 0, false
+	}
+	return now.Sub(oldest), true
+}
+
+// resolvePartitionKey returns the partition of the execution of the trigger for the events, the trigger name followed by
+// the partition key evaluated against the events, and false if the executions are not ordered or the key can't be evaluated.
+func (sensorCtx *SensorContext) resolvePartitionKey(ctx context.Context, trigger v1alpha1.Trigger, eventsMapping map[string]*v1alpha1.Event) (string, bool) {
+	if sensorCtx.partitionKey == nil {
+		return "", false
+	}
+	key, err := sensortriggers.EvaluatePartitionKey(sensorCtx.partitionKey, eventsMapping)
+	if err != nil {
+		logging.FromContext(ctx).Warnw("failed to evaluate the partition key, executing the trigger without ordering", zap.Error(err))
+		return "", false
+	}
+	return trigger.Template.Name + "/" + key, true
+}
 
+// triggerWithRateLimit executes the trigger unless it is skipped, deduplicated or rate limited. It returns
+// whether the trigger was executed successfully, and the error of a failed execution, for the events to be redelivered.
+func (sensorCtx *SensorContext) triggerWithRateLimit(ctx context.Context, sensor *v1alpha1.Sensor, trigger v1alpha1.Trigger, eventsMapping map[string]*v1alpha1.Event, eventIDs []string, outputs triggerOutputs) (bool, error) {
 	log := logging.FromContext(ctx)
-	if err := sensorCtx.triggerOne(ctx, sensor, trigger, eventsMapping, depNames, eventIDs, log); err != nil {
+
+	if group, ok := sensorCtx.canaryGroups[trigger.Template.Name]; ok && !group.selects(trigger.Template.Name, eventIDs) {
+		log.Debugw("another trigger of the canary group is selected for the events, skipping the execution", zap.String("canaryGroup", trigger.CanaryGroup))
+		sensorCtx.metrics.ActionSkipped(sensor.Name, trigger.Template.Name)
+		return false, nil
+	}
+
+	if program, ok := sensorCtx.conditions[trigger.Template.Name]; ok {
+		matched, err := sensortriggers.EvaluateCondition(program, eventsMapping)
+		if err != nil {
+			log.Errorw("failed to evaluate the trigger condition", zap.Error(err))
+			sensorCtx.metrics.ActionFailed(sensor.Name, trigger.Template.Name)
+			return false, nil
+		}
+		if !matched {
+			log.Info("trigger condition evaluated to false, skipping the execution")
+			sensorCtx.metrics.ActionSkipped(sensor.Name, trigger.Template.Name)
+			return false, nil
+		}
+	}
+
+	// recordExecution records the execution of the trigger for the events once it succeeds.
+	recordExecution := func() {}
+	if sensorCtx.idempotency != nil {
+		key := idempotencyKey(trigger.Template.Name, eventIDs)
+		exists, err := sensorCtx.idempotency.Exists(ctx, key)
+		if err != nil {
+			log.Warnw("failed to look up the executions of the trigger, executing the trigger", zap.Error(err))
+		} else if exists {
+			log.Info("trigger already executed for the events, skipping the execution")
+			sensorCtx.metrics.ActionDeduplicated(sensor.Name, trigger.Template.Name)
+			return false, nil
+		}
+		recordExecution = func() {
+			if err := sensorCtx.idempotency.Record(ctx, key); err != nil {
+				log.Errorw("failed to record the execution of the trigger", zap.Error(err))
+			}
+		}
+	}
+
+	// forgetDedupeKey lets the events be delivered again when the execution does not happen or fails.
+	forgetDedupeKey := func() {}
+	if dedupe, ok := sensorCtx.dedupes[trigger.Template.Name]; ok {
+		key, err := resolveDedupeKey(trigger, eventsMapping)
+		if err != nil {
+			log.Warnw("failed to resolve the dedupe key, executing the trigger without deduplication", zap.Error(err))
+		} else if key != "" {
+			added, err := dedupe.add(ctx, key)
+			if err != nil {
+				log.Warnw("failed to record the dedupe key, executing the trigger without deduplication", zap.Error(err))
+			} else if !added {
+				log.Infow("duplicate of a recent execution, skipping the execution", zap.String("dedupeKey", key))
+				sensorCtx.metrics.ActionDeduplicated(sensor.Name, trigger.Template.Name)
+				return false, nil
+			} else {
+				forgetDedupeKey = func() {
+					if err := dedupe.remove(ctx, key); err != nil {
+						log.Warnw("failed to forget the dedupe key", zap.String("dedupeKey", key), zap.Error(err))
+					}
+				}
+			}
+		}
+	}
+
+	if rl, ok := sensorCtx.rateLimiters[trigger.Template.Name]; ok {
+		if trigger.RateLimit != nil && trigger.RateLimit.GetOverflow() == v1alpha1.RateLimitOverflowDrop {
+			if !rl.Allow() {
+				log.Warn("trigger rate limit exceeded, dropping the execution")
+				sensorCtx.metrics.ActionRateLimited(sensor.Name, trigger.Template.Name)
+				forgetDedupeKey()
+				return false, nil
+			}
+		} else if err := rl.Wait(ctx); err != nil {
+			log.Warnw("stopped waiting for the trigger rate limit", zap.Error(err))
+			sensorCtx.metrics.ActionRateLimited(sensor.Name, trigger.Template.Name)
+			forgetDedupeKey()
+			return false, nil
+		}
+	}
+
+	cb, hasCircuitBreaker := sensorCtx.circuitBreakers[trigger.Template.Name]
+	if hasCircuitBreaker {
+		before := cb.snapshot()
+		allowed, state := cb.allow()
+		sensorCtx.saveCircuit(ctx, trigger.Template.Name, before, cb.snapshot(), log)
+		sensorCtx.metrics.ActionCircuitState(sensor.Name, trigger.Template.Name, float64(state))
+		if !allowed {
+			log.Warn("trigger circuit is open, failing the execution fast")
+			sensorCtx.metrics.ActionCircuitBroken(sensor.Name, trigger.Template.Name)
+			forgetDedupeKey()
+			return false, errCircuitOpen
+		}
+	}
+
+	err := sensorCtx.triggerOne(ctx, sensor, trigger, eventsMapping, eventIDs, outputs, log)
+	if err != nil {
 		// Log the error, and let it continue
-		log.Errorw("failed to execute a trigger", zap.Error(err), zap.String(logging.LabelTriggerName, trigger.Template.Name),
-			zap.Any("triggeredBy", depNames), zap.Any("triggeredByEvents", eventIDs))
+		log.Errorw("failed to execute a trigger", zap.Error(err))
 		sensorCtx.metrics.ActionFailed(sensor.Name, trigger.Template.Name)
+		forgetDedupeKey()
 	} else {
 		sensorCtx.metrics.ActionTriggered(sensor.Name, trigger.Template.Name)
+		recordExecution()
 	}
+	if hasCircuitBreaker {
+		before := cb.snapshot()
+		previous, state := cb.record(err)
+		sensorCtx.saveCircuit(ctx, trigger.Template.Name, before, cb.snapshot(), log)
+		if state != previous {
+			log.Infow("trigger circuit state changed", zap.Stringer("from", previous), zap.Stringer("to", state))
+		}
+		sensorCtx.metrics.ActionCircuitState(sensor.Name, trigger.Template.Name, float64(state))
+	}
+	return err == nil, err
 }
 
-func (sensorCtx *SensorContext) triggerOne(ctx context.Context, sensor *v1alpha1.Sensor, trigger v1alpha1.Trigger, eventsMapping map[string]*v1alpha1.Event, depNames, eventIDs []string, log *zap.SugaredLogger) error {
+// saveCircuit keeps the state of the circuit of the trigger in the state store once it changed. The circuit keeps
+// working from memory if the store fails, the failure is logged.
+func (sensorCtx *SensorContext) saveCircuit(ctx context.Context, triggerName string, before, after circuitSnapshot, log *zap.SugaredLogger) {
+	if before == after {
+		return
+	}
+	if err := saveCircuit(ctx, sensorCtx.state, triggerName, after); err != nil {
+		log.Warnw("failed to save the trigger circuit state", zap.Error(err))
+	}
+}
+
+func (sensorCtx *SensorContext) triggerOne(ctx context.Context, sensor *v1alpha1.Sensor, trigger v1alpha1.Trigger, eventsMapping map[string]*v1alpha1.Event, eventIDs []string, outputs triggerOutputs, logger *zap.SugaredLogger) (err error) {
 	defer func(start time.Time) {
 		sensorCtx.metrics.ActionDuration(sensor.Name, trigger.Template.Name, float64(time.Since(start)/time.Millisecond))
 	}(time.Now())
+	var triggerType apicommon.TriggerType
+	defer func() {
+		sensorCtx.recordTriggerEvent(sensor, trigger.Template.Name, triggerType, eventIDs, err)
+	}()
+
+	if trigger.Template.DryRun {
+		// A dry run only logs the execution, it is resolved from the events with their sensitive fields masked.
+		eventsMapping = sensortriggers.MaskEvents(sensor, eventsMapping)
+	}
 
 	if err := sensortriggers.ApplyTemplateParameters(eventsMapping, &trigger); err != nil {
-		log.Errorf("failed to apply template parameters, %v", err)
+		logger.Errorf("failed to apply template parameters, %v", err)
 		return err
 	}
 
-	logger := log.With(logging.LabelTriggerName, trigger.Template.Name)
-
 	logger.Debugw("resolving the trigger implementation")
 	triggerImpl := sensorCtx.GetTrigger(ctx, &trigger)
 	if triggerImpl == nil {
 		return errors.Errorf("invalid trigger %s, could not find an implementation", trigger.Template.Name)
 	}
 
-	logger = logger.With(logging.LabelTriggerType, triggerImpl.GetTriggerType())
-	log.Debug("fetching trigger resource if any")
+	triggerType = triggerImpl.GetTriggerType()
+	logger = logger.With(logging.LabelTriggerType, triggerType)
+	logger.Debug("fetching trigger resource if any")
 	obj, err := triggerImpl.FetchResource(ctx)
 	if err != nil {
 		return err
@@ -398,22 +820,58 @@ func (sensorCtx *SensorContext) triggerOne(ctx context.Context, sensor *v1alpha1
 	if retryStrategy == nil {
 		retryStrategy = &apicommon.Backoff{Steps: 1}
 	}
+	ctx, span := tracing.Tracer().Start(ctx, "trigger.execute", trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(
+		tracing.AttributeSensorName.String(sensor.Name),
+		tracing.AttributeTriggerName.String(trigger.Template.Name),
+		tracing.AttributeTriggerType.String(string(triggerImpl.GetTriggerType())),
+	))
 	var newObj interface{}
-	if err := common.Connect(retryStrategy, func() error {
+	// permanentErr stops the retries, retrying won't make the execution succeed.
+	var permanentErr error
+	timeout := trigger.Template.GetTimeout()
+	err = common.Connect(retryStrategy, func() error {
+		execCtx := ctx
+		if timeout > 0 {
+			var cancel context.CancelFunc
+			execCtx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
 		var e error
-		newObj, e = triggerImpl.Execute(ctx, eventsMapping, updatedObj)
+		newObj, e = triggerImpl.Execute(execCtx, eventsMapping, updatedObj)
+		if sensortriggers.IsPermanentError(e) {
+			permanentErr = e
+			return nil
+		}
 		return e
-	}); err != nil {
+	})
+	if permanentErr != nil {
+		logger.Warnw("the trigger execution failed permanently, not retrying", zap.Error(permanentErr))
+		err = permanentErr
+	}
+	if err != nil {
+		span.SetAttributes(tracing.AttributeOutcome.String("failure"))
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	} else {
+		span.SetAttributes(tracing.AttributeOutcome.String("success"))
+	}
+	span.End()
+	if err != nil {
 		return errors.Wrap(err, "failed to execute trigger")
 	}
 	logger.Debug("trigger resource successfully executed")
 
-	logger.Debug("applying trigger policy")
-	if err := triggerImpl.ApplyPolicy(ctx, newObj); err != nil {
-		return err
+	if trigger.Template.DryRun {
+		// There is no outcome of a dry run to apply the policy on.
+		logger.Info("dry run, skipping the trigger policy")
+	} else {
+		logger.Debug("applying trigger policy")
+		if err := triggerImpl.ApplyPolicy(ctx, newObj); err != nil {
+			return err
+		}
+		outputs.captureOutput(sensor, trigger.Template.Name, triggerImpl, newObj, eventIDs, logger)
 	}
-	logger.Infow("successfully processed the trigger",
-		zap.Any("triggeredBy", depNames), zap.Any("triggeredByEvents", eventIDs))
+	logger.Info("successfully processed the trigger")
 	return nil
 }
 
@@ -18,12 +18,18 @@ package sensors
 
 import (
 	"context"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	cloudevents "github.com/cloudevents/sdk-go/v2"
 	"github.com/stretchr/testify/assert"
+	"golang.org/x/time/rate"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/argoproj/argo-events/common/logging"
 	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	sensortriggers "github.com/argoproj/argo-events/sensors/triggers"
 )
 
 var (
@@ -146,3 +152,136 @@ func TestGetDependencyExpression(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func TestNewRateLimiter(t *testing.T) {
+	t.Run("no rate limit", func(t *testing.T) {
+		rl := newRateLimiter(nil)
+		assert.Equal(t, rate.Inf, rl.Limit())
+		for i := 0; i < 100; i++ {
+			assert.True(t, rl.Allow())
+		}
+	})
+
+	t.Run("burst", func(t *testing.T) {
+		rl := newRateLimiter(&v1alpha1.RateLimit{Unit: v1alpha1.Minute, RequestsPerUnit: 2, Burst: 3})
+		assert.Equal(t, rate.Every(30*time.Second), rl.Limit())
+		assert.True(t, rl.Allow())
+		assert.True(t, rl.Allow())
+		assert.True(t, rl.Allow())
+		assert.False(t, rl.Allow())
+	})
+
+	t.Run("wait bounded by context", func(t *testing.T) {
+		rl := newRateLimiter(&v1alpha1.RateLimit{Unit: v1alpha1.Hour, RequestsPerUnit: 1})
+		assert.True(t, rl.Allow())
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		assert.Error(t, rl.Wait(ctx))
+	})
+}
+
+func TestInitTriggers(t *testing.T) {
+	triggers := []v1alpha1.Trigger{
+		{
+			Template:       &v1alpha1.TriggerTemplate{Name: "charge", Condition: `events["dep"].body.amount > 0`, Log: &v1alpha1.LogTrigger{}},
+			Dedupe:         &v1alpha1.TriggerDedupe{},
+			CircuitBreaker: &v1alpha1.TriggerCircuitBreaker{},
+		},
+		{
+			Template:  &v1alpha1.TriggerTemplate{Name: "notify", Log: &v1alpha1.LogTrigger{}},
+			DependsOn: []string{"charge"},
+		},
+	}
+	graph, err := sensortriggers.NewGraph(triggers)
+	assert.NoError(t, err)
+	sensorCtx := &SensorContext{graph: graph}
+	assert.NoError(t, sensorCtx.initTriggers(context.Background(), triggers))
+	assert.Len(t, sensorCtx.rateLimiters, 2)
+	assert.Contains(t, sensorCtx.dedupes, "charge")
+	assert.NotContains(t, sensorCtx.dedupes, "notify")
+	assert.Contains(t, sensorCtx.circuitBreakers, "charge")
+	assert.NotContains(t, sensorCtx.circuitBreakers, "notify")
+	assert.Contains(t, sensorCtx.conditions, "charge")
+	assert.NotContains(t, sensorCtx.conditions, "notify")
+	assert.Contains(t, sensorCtx.dependents, "notify")
+	assert.NotContains(t, sensorCtx.dependents, "charge")
+
+	t.Run("invalid condition", func(t *testing.T) {
+		triggers := []v1alpha1.Trigger{{Template: &v1alpha1.TriggerTemplate{Name: "charge", Condition: "events[", Log: &v1alpha1.LogTrigger{}}}}
+		graph, err := sensortriggers.NewGraph(triggers)
+		assert.NoError(t, err)
+		sensorCtx := &SensorContext{graph: graph}
+		err = sensorCtx.initTriggers(context.Background(), triggers)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to compile the condition of trigger charge")
+	})
+}
+
+func TestDrainTriggers(t *testing.T) {
+	logger := logging.NewArgoEventsLogger()
+
+	startExecution := func(ctx context.Context, sensorCtx *SensorContext, duration time.Duration) *int32 {
+		var cancelled int32
+		sensorCtx.inFlightTriggers.Add(1)
+		atomic.AddInt64(&sensorCtx.inFlightCount, 1)
+		go func() {
+			defer sensorCtx.inFlightTriggers.Done()
+			defer atomic.AddInt64(&sensorCtx.inFlightCount, -1)
+			select {
+			case <-time.After(duration):
+			case <-ctx.Done():
+				atomic.StoreInt32(&cancelled, 1)
+			}
+		}()
+		return &cancelled
+	}
+
+	t.Run("no in-flight executions", func(t *testing.T) {
+		sensorCtx := &SensorContext{drainTimeout: time.Minute}
+		start := time.Now()
+		sensorCtx.drainTriggers(logger, func() { t.Fatal("should not cancel") })
+		assert.Less(t, time.Since(start), time.Second)
+	})
+
+	t.Run("drained within the timeout", func(t *testing.T) {
+		sensorCtx := &SensorContext{drainTimeout: 10 * time.Second}
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		cancelled := startExecution(ctx, sensorCtx, 50*time.Millisecond)
+		sensorCtx.drainTriggers(logger, cancel)
+		assert.Equal(t, int64(0), atomic.LoadInt64(&sensorCtx.inFlightCount))
+		assert.Equal(t, int32(0), atomic.LoadInt32(cancelled))
+	})
+
+	t.Run("cancelled after the timeout", func(t *testing.T) {
+		sensorCtx := &SensorContext{drainTimeout: 50 * time.Millisecond}
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		fast := startExecution(ctx, sensorCtx, 0)
+		slow := startExecution(ctx, sensorCtx, time.Hour)
+		sensorCtx.drainTriggers(logger, cancel)
+		sensorCtx.inFlightTriggers.Wait()
+		assert.Equal(t, int32(0), atomic.LoadInt32(fast))
+		assert.Equal(t, int32(1), atomic.LoadInt32(slow))
+	})
+}
+
+func TestProcessingLag(t *testing.T) {
+	now := time.Now()
+	newEvent := func(t time.Time) cloudevents.Event {
+		event := cloudevents.NewEvent()
+		event.SetTime(t)
+		return event
+	}
+
+	lag, ok := processingLag(map[string]cloudevents.Event{
+		"dep-a": newEvent(now.Add(-2 * time.Second)),
+		"dep-b": newEvent(now.Add(-5 * time.Second)),
+		"dep-c": cloudevents.NewEvent(),
+	}, now)
+	assert.True(t, ok)
+	assert.Equal(t, 5*time.Second, lag)
+
+	_, ok = processingLag(map[string]cloudevents.Event{"dep-a": cloudevents.NewEvent()}, now)
+	assert.False(t, ok)
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"go.uber.org/zap"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	sensortriggers "github.com/argoproj/argo-events/sensors/triggers"
+)
+
+// triggerOutputs holds the output events of the triggers of an execution, by trigger name, for the triggers
+// depending on them to refer to in their parameters. The triggers of an execution run one after the other.
+type triggerOutputs map[string]*v1alpha1.Event
+
+// captureOutput records the output of a successful execution of the trigger, the failures to capture it
+// are logged without failing the execution.
+func (outputs triggerOutputs) captureOutput(sensor *v1alpha1.Sensor, triggerName string, triggerImpl sensortriggers.Trigger, response interface{}, eventIDs []string, logger *zap.SugaredLogger) {
+	output, err := sensortriggers.NewOutput(triggerName, triggerImpl, response, eventIDs)
+	if err != nil {
+		logger.Warnw("failed to capture the trigger output", zap.Error(err))
+		return
+	}
+	event, err := output.Event(sensor.Name)
+	if err != nil {
+		logger.Warnw("failed to capture the trigger output", zap.Error(err))
+		return
+	}
+	outputs[triggerName] = event
+}
+
+// of returns the outputs of the triggers, by the keys the parameters refer to them by.
+func (outputs triggerOutputs) of(triggerNames []string) map[string]*v1alpha1.Event {
+	result := make(map[string]*v1alpha1.Event, len(triggerNames))
+	for _, name := range triggerNames {
+		if event, ok := outputs[name]; ok {
+			result[sensortriggers.OutputKey(name)] = event
+		}
+	}
+	return result
+}
+
+// withOutputs returns a copy of the events along with the outputs.
+func withOutputs(events map[string]*v1alpha1.Event, outputs map[string]*v1alpha1.Event) map[string]*v1alpha1.Event {
+	if len(outputs) == 0 {
+		return events
+	}
+	result := make(map[string]*v1alpha1.Event, len(events)+len(outputs))
+	for key, event := range outputs {
+		result[key] = event
+	}
+	for key, event := range events {
+		result[key] = event
+	}
+	return result
+}
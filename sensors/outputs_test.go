@@ -0,0 +1,91 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-events/common/logging"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	sensortriggers "github.com/argoproj/argo-events/sensors/triggers"
+)
+
+func TestTriggerOutputs(t *testing.T) {
+	events := map[string]*v1alpha1.Event{"dep": {Data: []byte(`{}`)}}
+	outputs := triggerOutputs{}
+	assert.Empty(t, outputs.of([]string{"trigger-a"}))
+	assert.Equal(t, events, withOutputs(events, outputs.of([]string{"trigger-a"})))
+
+	outputs["trigger-a"] = &v1alpha1.Event{Data: []byte(`{"trigger":"trigger-a"}`)}
+	outputs["trigger-b"] = &v1alpha1.Event{Data: []byte(`{"trigger":"trigger-b"}`)}
+
+	result := withOutputs(events, outputs.of([]string{"trigger-b", "trigger-c"}))
+	assert.Len(t, result, 2)
+	assert.Contains(t, result, "dep")
+	assert.Contains(t, result, sensortriggers.OutputKey("trigger-b"))
+	assert.NotContains(t, result, sensortriggers.OutputKey("trigger-a"))
+	// The events of the execution are left as is
+	assert.Len(t, events, 1)
+}
+
+// fakeOutputTrigger is a trigger executing into the response it fetched
+type fakeOutputTrigger struct{}
+
+func (t *fakeOutputTrigger) GetTriggerType() apicommon.TriggerType { return apicommon.HTTPTrigger }
+
+func (t *fakeOutputTrigger) FetchResource(context.Context) (interface{}, error) { return nil, nil }
+
+func (t *fakeOutputTrigger) ApplyResourceParameters(events map[string]*v1alpha1.Event, resource interface{}) (interface{}, error) {
+	return resource, nil
+}
+
+func (t *fakeOutputTrigger) Execute(ctx context.Context, events map[string]*v1alpha1.Event, resource interface{}) (interface{}, error) {
+	return resource, nil
+}
+
+func (t *fakeOutputTrigger) ApplyPolicy(ctx context.Context, resource interface{}) error { return nil }
+
+func TestCaptureOutput(t *testing.T) {
+	outputs := triggerOutputs{}
+	logger := logging.NewArgoEventsLogger()
+	response := &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(`{"id":"abc"}`))}
+	outputs.captureOutput(sensorObj, "trigger-a", &fakeOutputTrigger{}, response, []string{"1"}, logger)
+
+	event, ok := outputs["trigger-a"]
+	assert.True(t, ok)
+	assert.Equal(t, sensortriggers.OutputEventType, event.Context.Type)
+	assert.Equal(t, sensorObj.Name, event.Context.Source)
+	var output sensortriggers.Output
+	assert.NoError(t, json.Unmarshal(event.Data, &output))
+	assert.Equal(t, "trigger-a", output.Trigger)
+	assert.Equal(t, apicommon.HTTPTrigger, output.Type)
+	assert.Equal(t, http.StatusOK, output.StatusCode)
+	assert.JSONEq(t, `{"id":"abc"}`, string(output.Body))
+	assert.Equal(t, []string{"1"}, output.TriggeredByEvents)
+
+	// A response failing to be captured leaves the previous output
+	outputs.captureOutput(sensorObj, "trigger-a", &fakeOutputTrigger{}, func() {}, []string{"2"}, logger)
+	assert.Equal(t, event, outputs["trigger-a"])
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"context"
+	"sync"
+)
+
+// defaultMaxActivePartitions is the max number of active partitions of a sensor which doesn't set one
+const defaultMaxActivePartitions = 1000
+
+// partitioner runs the trigger executions of the same partition one at a time, in the order they are submitted,
+// while the executions of different partitions run in parallel. A partition is active while it has executions
+// queued or running, each active partition has a worker running its executions. Once the max number of active
+// partitions is reached, the executions of a new partition wait for one to complete, for the events to wait on
+// the eventbus rather than in memory.
+type partitioner struct {
+	lock       sync.Mutex
+	max        int
+	partitions map[string]*partition
+	closed     bool
+	// changed is closed and replaced each time a partition completes, or once the partitioner is closed.
+	changed chan struct{}
+}
+
+// partition holds the executions of a partition waiting for the running one to complete
+type partition struct {
+	queue []func()
+}
+
+// newPartitioner returns a partitioner of the given number of active partitions, the default one if it is not positive.
+func newPartitioner(max int) *partitioner {
+	if max <= 0 {
+		max = defaultMaxActivePartitions
+	}
+	return &partitioner{max: max, partitions: make(map[string]*partition), changed: make(chan struct{})}
+}
+
+// submit queues the execution in the partition of the key, after the executions already submitted to it. It waits
+// for a partition to complete if the partition of the key is not active and there are already max active ones, and
+// returns false if the context is done or the partitioner is closed first.
+func (p *partitioner) submit(ctx context.Context, key string, execute func()) bool {
+	for {
+		p.lock.Lock()
+		if p.closed {
+			p.lock.Unlock()
+			return false
+		}
+		if part, ok := p.partitions[key]; ok {
+			part.queue = append(part.queue, execute)
+			p.lock.Unlock()
+			return true
+		}
+		if len(p.partitions) < p.max {
+			part := &partition{queue: []func(){execute}}
+			p.partitions[key] = part
+			p.lock.Unlock()
+			go p.work(key, part)
+			return true
+		}
+		changed := p.changed
+		p.lock.Unlock()
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return false
+		}
+	}
+}
+
+// work runs the executions of the partition until its queue is empty, and then completes the partition.
+func (p *partitioner) work(key string, part *partition) {
+	for {
+		p.lock.Lock()
+		if len(part.queue) == 0 {
+			delete(p.partitions, key)
+			close(p.changed)
+			p.changed = make(chan struct{})
+			p.lock.Unlock()
+			return
+		}
+		execute := part.queue[0]
+		part.queue[0] = nil
+		part.queue = part.queue[1:]
+		p.lock.Unlock()
+		execute()
+	}
+}
+
+// active returns the number of active partitions
+func (p *partitioner) active() int {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return len(p.partitions)
+}
+
+// close stops the submissions waiting for a partition to complete, e.g. when the sensor shuts down. The executions
+// already submitted still run, in order.
+func (p *partitioner) close() {
+	if p == nil {
+		return
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if p.closed {
+		return
+	}
+	p.closed = true
+	close(p.changed)
+	p.changed = make(chan struct{})
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	sensortriggers "github.com/argoproj/argo-events/sensors/triggers"
+)
+
+func TestPartitioner(t *testing.T) {
+	t.Run("orders the executions of a key", func(t *testing.T) {
+		p := newPartitioner(0)
+		lock := sync.Mutex{}
+		executed := make(map[string][]int)
+		running := make(map[string]bool)
+		overlapped := false
+		wg := &sync.WaitGroup{}
+		// The events of the keys are interleaved.
+		for i := 0; i < 30; i++ {
+			key := fmt.Sprintf("key-%d", i%3)
+			i := i
+			wg.Add(1)
+			assert.True(t, p.submit(context.Background(), key, func() {
+				defer wg.Done()
+				lock.Lock()
+				overlapped = overlapped || running[key]
+				running[key] = true
+				lock.Unlock()
+				time.Sleep(time.Millisecond)
+				lock.Lock()
+				running[key] = false
+				executed[key] = append(executed[key], i)
+				lock.Unlock()
+			}))
+		}
+		wg.Wait()
+		assert.False(t, overlapped)
+		for k := 0; k < 3; k++ {
+			var expected []int
+			for i := k; i < 30; i += 3 {
+				expected = append(expected, i)
+			}
+			assert.Equal(t, expected, executed[fmt.Sprintf("key-%d", k)])
+		}
+		assert.Eventually(t, func() bool { return p.active() == 0 }, time.Second, time.Millisecond)
+	})
+
+	t.Run("runs the keys in parallel", func(t *testing.T) {
+		p := newPartitioner(0)
+		release := make(chan struct{})
+		started := make(chan string, 2)
+		for _, key := range []string{"a", "b"} {
+			key := key
+			assert.True(t, p.submit(context.Background(), key, func() {
+				started <- key
+				<-release
+			}))
+		}
+		// Both keys start before any completes.
+		assert.ElementsMatch(t, []string{"a", "b"}, []string{<-started, <-started})
+		assert.Equal(t, 2, p.active())
+		close(release)
+	})
+
+	t.Run("waits for a partition beyond the max active ones", func(t *testing.T) {
+		p := newPartitioner(1)
+		release := make(chan struct{})
+		assert.True(t, p.submit(context.Background(), "a", func() { <-release }))
+		// The executions of an active key are still queued.
+		assert.True(t, p.submit(context.Background(), "a", func() {}))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		assert.False(t, p.submit(ctx, "b", func() {}))
+
+		done := make(chan bool)
+		go func() {
+			done <- p.submit(context.Background(), "b", func() {})
+		}()
+		select {
+		case <-done:
+			t.Fatal("the submission of a new key should wait for the active partition to complete")
+		case <-time.After(20 * time.Millisecond):
+		}
+		close(release)
+		assert.True(t, <-done)
+	})
+
+	t.Run("closed", func(t *testing.T) {
+		p := newPartitioner(1)
+		release := make(chan struct{})
+		defer close(release)
+		assert.True(t, p.submit(context.Background(), "a", func() { <-release }))
+		done := make(chan bool)
+		go func() {
+			done <- p.submit(context.Background(), "b", func() {})
+		}()
+		p.close()
+		assert.False(t, <-done)
+		assert.False(t, p.submit(context.Background(), "a", func() {}))
+		var nilPartitioner *partitioner
+		nilPartitioner.close()
+	})
+}
+
+func TestResolvePartitionKey(t *testing.T) {
+	trigger := v1alpha1.Trigger{Template: &v1alpha1.TriggerTemplate{Name: "fake-trigger"}}
+	events := map[string]*v1alpha1.Event{
+		"dep": {Context: &v1alpha1.EventContext{ID: "1", DataContentType: "application/json"}, Data: []byte(`{"customer": "c-1"}`)},
+	}
+
+	sensorCtx := &SensorContext{}
+	_, ok := sensorCtx.resolvePartitionKey(context.Background(), trigger, events)
+	assert.False(t, ok)
+
+	program, err := sensortriggers.NewPartitionKey(`events["dep"].customer`)
+	assert.Nil(t, err)
+	sensorCtx.partitionKey = program
+	key, ok := sensorCtx.resolvePartitionKey(context.Background(), trigger, events)
+	assert.True(t, ok)
+	assert.Equal(t, "fake-trigger/c-1", key)
+
+	program, err = sensortriggers.NewPartitionKey(`events["dep"].missing`)
+	assert.Nil(t, err)
+	sensorCtx.partitionKey = program
+	_, ok = sensorCtx.resolvePartitionKey(context.Background(), trigger, events)
+	assert.False(t, ok)
+}
@@ -18,6 +18,8 @@ package policy
 
 import (
 	"context"
+	"strconv"
+	"strings"
 
 	"github.com/pkg/errors"
 )
@@ -28,6 +30,8 @@ type StatusPolicy struct {
 	Status int
 	// Statuses refers to list of response status allowed
 	Statuses []int
+	// Ranges are the ranges of response statuses allowed
+	Ranges []StatusRange
 }
 
 // NewStatusPolicy returns a new HTTP trigger policy
@@ -38,11 +42,90 @@ func NewStatusPolicy(status int, statuses []int) *StatusPolicy {
 	}
 }
 
+// NewStatusPolicyWithRanges returns a new HTTP trigger policy allowing the statuses and the ranges of statuses,
+// see ParseStatusRange.
+func NewStatusPolicyWithRanges(status int, statuses []int, ranges []string) (*StatusPolicy, error) {
+	parsed, err := ParseStatusRanges(ranges)
+	if err != nil {
+		return nil, err
+	}
+	return &StatusPolicy{
+		Status:   status,
+		Statuses: statuses,
+		Ranges:   parsed,
+	}, nil
+}
+
 func (hp *StatusPolicy) ApplyPolicy(ctx context.Context) error {
 	for _, status := range hp.Statuses {
 		if hp.Status == status {
 			return nil
 		}
 	}
+	for _, r := range hp.Ranges {
+		if r.Contains(hp.Status) {
+			return nil
+		}
+	}
 	return errors.Errorf("policy application resulted in failure. http response status %d is not allowed", hp.Status)
 }
+
+// StatusRange is a range of response statuses, bounds included
+type StatusRange struct {
+	Min int
+	Max int
+}
+
+// Contains tells if the status is in the range
+func (r StatusRange) Contains(status int) bool {
+	return status >= r.Min && status <= r.Max
+}
+
+// ParseStatusRange parses a range of response statuses, a single status, e.g. "404", a class of statuses, e.g.
+// "2xx", or a range of statuses, e.g. "200-204".
+func ParseStatusRange(s string) (StatusRange, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if len(s) == 3 && strings.HasSuffix(s, "xx") {
+		class, err := strconv.Atoi(s[:1])
+		if err != nil || class < 1 || class > 5 {
+			return StatusRange{}, errors.Errorf("invalid status class %q, expected 1xx to 5xx", s)
+		}
+		return StatusRange{Min: class * 100, Max: class*100 + 99}, nil
+	}
+	bounds := strings.SplitN(s, "-", 2)
+	min, err := parseStatus(bounds[0])
+	if err != nil {
+		return StatusRange{}, errors.Wrapf(err, "invalid status range %q", s)
+	}
+	max := min
+	if len(bounds) == 2 {
+		if max, err = parseStatus(bounds[1]); err != nil {
+			return StatusRange{}, errors.Wrapf(err, "invalid status range %q", s)
+		}
+	}
+	if max < min {
+		return StatusRange{}, errors.Errorf("invalid status range %q, the upper bound is less than the lower one", s)
+	}
+	return StatusRange{Min: min, Max: max}, nil
+}
+
+// ParseStatusRanges parses the ranges of response statuses, see ParseStatusRange
+func ParseStatusRanges(ranges []string) ([]StatusRange, error) {
+	result := make([]StatusRange, 0, len(ranges))
+	for _, s := range ranges {
+		r, err := ParseStatusRange(s)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, r)
+	}
+	return result, nil
+}
+
+func parseStatus(s string) (int, error) {
+	status, err := strconv.Atoi(strings.TrimSpace(s))
+	if err != nil || status < 100 || status > 599 {
+		return 0, errors.Errorf("%q is not a status between 100 and 599", s)
+	}
+	return status, nil
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseStatusRange(t *testing.T) {
+	tests := map[string]StatusRange{
+		"404":       {Min: 404, Max: 404},
+		"2xx":       {Min: 200, Max: 299},
+		"5XX":       {Min: 500, Max: 599},
+		"200-204":   {Min: 200, Max: 204},
+		" 500-503 ": {Min: 500, Max: 503},
+	}
+	for s, expected := range tests {
+		r, err := ParseStatusRange(s)
+		assert.NoError(t, err, s)
+		assert.Equal(t, expected, r, s)
+	}
+
+	for _, s := range []string{"", "6xx", "x", "20", "600", "204-200", "200-", "-200", "abc"} {
+		_, err := ParseStatusRange(s)
+		assert.Error(t, err, s)
+	}
+}
+
+func TestStatusPolicy(t *testing.T) {
+	assert.NoError(t, NewStatusPolicy(200, []int{200, 201}).ApplyPolicy(context.TODO()))
+	assert.Error(t, NewStatusPolicy(202, []int{200, 201}).ApplyPolicy(context.TODO()))
+
+	p, err := NewStatusPolicyWithRanges(204, []int{302}, []string{"2xx"})
+	assert.NoError(t, err)
+	assert.NoError(t, p.ApplyPolicy(context.TODO()))
+	p, err = NewStatusPolicyWithRanges(302, []int{302}, []string{"2xx"})
+	assert.NoError(t, err)
+	assert.NoError(t, p.ApplyPolicy(context.TODO()))
+	p, err = NewStatusPolicyWithRanges(500, []int{302}, []string{"2xx", "400-404"})
+	assert.NoError(t, err)
+	err = p.ApplyPolicy(context.TODO())
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "http response status 500 is not allowed")
+
+	_, err = NewStatusPolicyWithRanges(200, nil, []string{"2xy"})
+	assert.Error(t, err)
+}
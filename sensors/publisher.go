@@ -0,0 +1,98 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/eventbus"
+	eventbusdriver "github.com/argoproj/argo-events/eventbus/driver"
+	eventbusv1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+// eventBusPublisher publishes the events of the bus triggers to the EventBus of the sensor, connecting once
+// the first event is published, and again once the connection is lost.
+type eventBusPublisher struct {
+	lock        sync.Mutex
+	driver      eventbusdriver.Driver
+	conn        eventbusdriver.Connection
+	subject     string
+	compression eventbusv1alpha1.CompressionCodec
+}
+
+// newEventBusPublisher returns the publisher of the bus triggers of the sensor, or nil if it has none
+func newEventBusPublisher(ctx context.Context, sensor *v1alpha1.Sensor, busConfig *eventbusv1alpha1.BusConfig, busSubject, hostname string) (*eventBusPublisher, error) {
+	if !hasBusTrigger(sensor) {
+		return nil, nil
+	}
+	if busConfig == nil {
+		return nil, errors.New("the sensor has no eventbus to publish the events of the bus triggers to")
+	}
+	clientID := fmt.Sprintf("bus-trigger-%s", common.Hasher(sensor.Name+"-"+hostname))
+	driver, err := eventbus.GetDriver(ctx, *busConfig, busSubject, clientID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get the eventbus driver")
+	}
+	return &eventBusPublisher{driver: driver, subject: busSubject, compression: busConfig.Compression}, nil
+}
+
+// hasBusTrigger tells if a trigger of the sensor publishes to the EventBus
+func hasBusTrigger(sensor *v1alpha1.Sensor) bool {
+	for _, trigger := range sensor.Spec.Triggers {
+		if trigger.Template != nil && trigger.Template.Bus != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *eventBusPublisher) Subject() string {
+	return p.subject
+}
+
+func (p *eventBusPublisher) PublishAsync(subject string, message []byte, ackHandler func(err error)) error {
+	// The messages are compressed as the ones of the event sources, for the sensors to read them alike.
+	data, err := eventbusdriver.Compress(p.compression, message)
+	if err != nil {
+		return errors.Wrap(err, "failed to compress the message")
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if p.conn == nil || p.conn.IsClosed() {
+		conn, err := p.driver.Connect()
+		if err != nil {
+			return errors.Wrap(err, "failed to connect to the eventbus")
+		}
+		p.conn = conn
+	}
+	return p.conn.PublishAsync(subject, data, ackHandler)
+}
+
+func (p *eventBusPublisher) close() error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if p.conn == nil {
+		return nil
+	}
+	return p.conn.Close()
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/argoproj/argo-events/common/logging"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	sensortriggers "github.com/argoproj/argo-events/sensors/triggers"
+)
+
+// triggerWithRedelivery executes the trigger, and executes it again with the same events after it failed, on the
+// increasing schedule of the redelivery of the trigger if any. The eventbus acks the events once they are dispatched
+// to the triggers, so the events are redelivered from memory, the execution keeping its concurrency slot meanwhile.
+// The events of an execution which failed for good are forwarded to the dead letter sink of the sensor if any.
+// It returns whether the trigger was executed successfully, rather than skipped or failed, its output being added to the
+// outputs of the execution.
+func (sensorCtx *SensorContext) triggerWithRedelivery(ctx context.Context, sensor *v1alpha1.Sensor, trigger v1alpha1.Trigger, eventsMapping map[string]*v1alpha1.Event, eventIDs []string, outputs triggerOutputs) bool {
+	executed, err := sensorCtx.triggerWithRateLimit(ctx, sensor, trigger, eventsMapping, eventIDs, outputs)
+	if err == nil {
+		return executed
+	}
+	attempts := 1
+	if trigger.Redelivery != nil {
+		log := logging.FromContext(ctx)
+		err = redeliver(ctx, trigger.Redelivery, err, func(attempt int, delay time.Duration) error {
+			log.Infow("redelivering the events to the trigger", zap.Int("attempt", attempt), zap.Duration("delay", delay))
+			sensorCtx.metrics.ActionRedelivered(sensor.Name, trigger.Template.Name)
+			attempts++
+			executed, err = sensorCtx.triggerWithRateLimit(ctx, sensor, trigger, eventsMapping, eventIDs, outputs)
+			return err
+		})
+		switch {
+		case err == nil:
+			return executed
+		case sensortriggers.IsPermanentError(err):
+			log.Warnw("the trigger execution failed permanently, not redelivering the events", zap.Error(err))
+		case errors.Is(err, context.Canceled):
+			log.Warn("sensor is shutting down, not redelivering the events")
+			return false
+		case sensorCtx.deadLetter == nil:
+			log.Errorw("the redeliveries of the events are exhausted, dropping them", zap.Int("attempts", trigger.Redelivery.GetAttempts()), zap.Error(err))
+		default:
+			log.Errorw("the redeliveries of the events are exhausted", zap.Int("attempts", trigger.Redelivery.GetAttempts()), zap.Error(err))
+		}
+	}
+	sensorCtx.forwardDeadLetter(ctx, sensor, trigger, eventsMapping, eventIDs, err, attempts)
+	return false
+}
+
+// redeliver executes again after the failure of an execution, waiting for the delay of the redelivery before each
+// attempt, until an execution succeeds or fails permanently, the attempts are exhausted, or the context is done. It
+// returns the error of the last execution, or the error of the context.
+func redeliver(ctx context.Context, redelivery *v1alpha1.TriggerRedelivery, err error, execute func(attempt int, delay time.Duration) error) error {
+	for attempt := 1; err != nil && attempt <= redelivery.GetAttempts(); attempt++ {
+		if sensortriggers.IsPermanentError(err) {
+			return err
+		}
+		delay := redelivery.GetDelay(attempt)
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+		err = execute(attempt, delay)
+	}
+	return err
+}
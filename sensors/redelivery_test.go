@@ -0,0 +1,79 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	sensortriggers "github.com/argoproj/argo-events/sensors/triggers"
+)
+
+func TestRedeliver(t *testing.T) {
+	errFailed := errors.New("failed")
+	redelivery := &v1alpha1.TriggerRedelivery{Initial: "1ms", Max: "4ms", Attempts: 3}
+
+	t.Run("redelivers until success", func(t *testing.T) {
+		var delays []time.Duration
+		err := redeliver(context.TODO(), redelivery, errFailed, func(attempt int, delay time.Duration) error {
+			delays = append(delays, delay)
+			if attempt < 2 {
+				return errFailed
+			}
+			return nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, []time.Duration{time.Millisecond, 2 * time.Millisecond}, delays)
+	})
+
+	t.Run("stops once the attempts are exhausted", func(t *testing.T) {
+		var delays []time.Duration
+		err := redeliver(context.TODO(), redelivery, errFailed, func(attempt int, delay time.Duration) error {
+			delays = append(delays, delay)
+			return errFailed
+		})
+		assert.Equal(t, errFailed, err)
+		assert.Equal(t, []time.Duration{time.Millisecond, 2 * time.Millisecond, 4 * time.Millisecond}, delays)
+	})
+
+	t.Run("does not redeliver permanent failures", func(t *testing.T) {
+		calls := 0
+		err := redeliver(context.TODO(), redelivery, errFailed, func(attempt int, delay time.Duration) error {
+			calls++
+			return sensortriggers.NewPermanentError(errFailed)
+		})
+		assert.True(t, sensortriggers.IsPermanentError(err))
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("stops when the context is done", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.TODO())
+		cancel()
+		calls := 0
+		err := redeliver(ctx, &v1alpha1.TriggerRedelivery{Initial: "1m"}, errFailed, func(attempt int, delay time.Duration) error {
+			calls++
+			return nil
+		})
+		assert.True(t, errors.Is(err, context.Canceled))
+		assert.Equal(t, 0, calls)
+	})
+}
@@ -0,0 +1,354 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	natslib "github.com/nats-io/nats.go"
+	"github.com/pkg/errors"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/eventbus"
+	eventbusv1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+// StateStore keeps the state of the sensor features, e.g. deduplication, idempotency and circuit breakers.
+// The keys must be valid key-value keys, i.e. made of letters, digits and "-/_=." characters.
+type StateStore interface {
+	// Get returns the value of the key, and false if the key doesn't exist or expired.
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+	// Set sets the value of the key, expiring after the TTL, or never if the TTL is 0.
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	// Create sets the value of the key unless it exists and is not expired, and returns false if it does.
+	Create(ctx context.Context, key string, value []byte, ttl time.Duration) (bool, error)
+	// Delete deletes the key, if it exists.
+	Delete(ctx context.Context, key string) error
+	// Close releases the resources of the store.
+	Close() error
+}
+
+// maxStateKeys is the maximum number of keys kept by the in-memory stores, unless a feature sets its own
+const maxStateKeys = 100000
+
+// newStateStore returns the state store of the sensor, kept in a key-value bucket of the EventBus if it
+// is a JetStream one, and in memory otherwise.
+func newStateStore(sensor *v1alpha1.Sensor, busConfig *eventbusv1alpha1.BusConfig) (StateStore, error) {
+	if busConfig != nil && busConfig.JetStream != nil {
+		return newEventBusStateStore(busConfig.JetStream, stateBucket(sensor.Name), stateMaxAge(sensor))
+	}
+	return newMemoryStateStore(maxStateKeys), nil
+}
+
+// stateBucket returns the name of the key-value bucket of the state of the sensor.
+func stateBucket(sensorName string) string {
+	return fmt.Sprintf("sensor-%s-state", sensorName)
+}
+
+// stateMaxAge returns the longest time the features of the sensor keep a key for, the max age of the key-value
+// bucket of the state for the expired keys to be removed from it eventually, 0 if no feature keeps any.
+func stateMaxAge(sensor *v1alpha1.Sensor) time.Duration {
+	var maxAge time.Duration
+	longest := func(d time.Duration) {
+		if d > maxAge {
+			maxAge = d
+		}
+	}
+	if idempotency := sensor.Spec.Idempotency; idempotency != nil && idempotency.JetStream == nil {
+		longest(idempotency.GetTTL())
+	}
+	for _, t := range sensor.Spec.Triggers {
+		if t.Dedupe != nil {
+			longest(t.Dedupe.GetTTL())
+		}
+		if cb := t.CircuitBreaker; cb != nil {
+			longest(cb.GetWindow() + cb.GetCooldown())
+		}
+	}
+	return maxAge
+}
+
+// featureStore returns the store of the state of a feature keeping up to maxKeys keys in memory: the state store
+// of the sensor if it is kept in the EventBus, and a store of its own otherwise, for the features not to evict
+// the keys of each other.
+func (sensorCtx *SensorContext) featureStore(maxKeys int) StateStore {
+	if _, inMemory := sensorCtx.state.(*memoryStateStore); sensorCtx.state != nil && !inMemory {
+		return sensorCtx.state
+	}
+	return newMemoryStateStore(maxKeys)
+}
+
+// stateKey returns the key of the state of a feature, hashed for it to be a valid key whatever the characters of the names.
+func stateKey(feature string, names ...string) string {
+	sum := sha256.Sum256([]byte(strings.Join(names, "/")))
+	return feature + "." + hex.EncodeToString(sum[:])
+}
+
+// memoryStateStore keeps the state in memory, so it is lost when the sensor restarts. It is bounded in size, with
+// the least recently used keys evicted first.
+type memoryStateStore struct {
+	lock    sync.Mutex
+	maxKeys int
+	// entries are ordered from the most to the least recently used
+	entries *list.List
+	keys    map[string]*list.Element
+	now     func() time.Time
+}
+
+type stateEntry struct {
+	key   string
+	value []byte
+	// expiresAt is the zero time if the entry never expires.
+	expiresAt time.Time
+}
+
+func newMemoryStateStore(maxKeys int) *memoryStateStore {
+	return &memoryStateStore{maxKeys: maxKeys, entries: list.New(), keys: make(map[string]*list.Element), now: time.Now}
+}
+
+func (s *memoryStateStore) Get(_ context.Context, key string) ([]byte, bool, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	e, ok := s.get(key)
+	if !ok {
+		return nil, false, nil
+	}
+	return e.value, true, nil
+}
+
+// get returns the entry of the key and marks it as used, and false if it doesn't exist or expired.
+func (s *memoryStateStore) get(key string) (*stateEntry, bool) {
+	element, ok := s.keys[key]
+	if !ok {
+		return nil, false
+	}
+	e := element.Value.(*stateEntry)
+	if !e.expiresAt.IsZero() && !s.now().Before(e.expiresAt) {
+		s.entries.Remove(element)
+		delete(s.keys, key)
+		return nil, false
+	}
+	s.entries.MoveToFront(element)
+	return e, true
+}
+
+func (s *memoryStateStore) Set(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.set(key, value, ttl)
+	return nil
+}
+
+func (s *memoryStateStore) set(key string, value []byte, ttl time.Duration) {
+	e := &stateEntry{key: key, value: append([]byte(nil), value...)}
+	if ttl > 0 {
+		e.expiresAt = s.now().Add(ttl)
+	}
+	if element, ok := s.keys[key]; ok {
+		s.entries.Remove(element)
+	}
+	s.keys[key] = s.entries.PushFront(e)
+	for s.entries.Len() > s.maxKeys {
+		oldest := s.entries.Back()
+		s.entries.Remove(oldest)
+		delete(s.keys, oldest.Value.(*stateEntry).key)
+	}
+}
+
+func (s *memoryStateStore) Create(_ context.Context, key string, value []byte, ttl time.Duration) (bool, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if _, ok := s.get(key); ok {
+		return false, nil
+	}
+	s.set(key, value, ttl)
+	return true, nil
+}
+
+func (s *memoryStateStore) Delete(_ context.Context, key string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if element, ok := s.keys[key]; ok {
+		s.entries.Remove(element)
+		delete(s.keys, key)
+	}
+	return nil
+}
+
+func (s *memoryStateStore) Close() error {
+	return nil
+}
+
+// len returns the number of keys in the store, including the expired ones not evicted yet.
+func (s *memoryStateStore) len() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.entries.Len()
+}
+
+// jetStreamStateStore keeps the state in a JetStream key-value bucket. The max age of a bucket applies
+// to all its keys, so the expiry of each key is stored in front of its value.
+type jetStreamStateStore struct {
+	conn *natslib.Conn
+	kv   natslib.KeyValue
+	now  func() time.Time
+}
+
+// newEventBusStateStore returns a state store kept in a key-value bucket of the JetStream EventBus.
+func newEventBusStateStore(config *eventbusv1alpha1.JetStreamConfig, bucket string, maxAge time.Duration) (*jetStreamStateStore, error) {
+	opts := []natslib.Option{natslib.Name("argo-events-sensor-state")}
+	if config.TLS != nil {
+		tlsConfig, err := eventbus.GetTLSConfig(config.TLS)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get the eventbus tls config")
+		}
+		opts = append(opts, natslib.Secure(tlsConfig))
+	}
+	if config.Auth != nil && config.Auth.Token != nil {
+		token, err := common.GetSecretFromVolume(config.Auth.Token)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get the eventbus auth token")
+		}
+		opts = append(opts, natslib.Token(token))
+	}
+	return newJetStreamStateStore(config.URL, opts, bucket, "State of a sensor", maxAge)
+}
+
+// newJetStreamStateStore returns a state store kept in a key-value bucket of the NATS server, the bucket
+// being created with the max age if it doesn't exist.
+func newJetStreamStateStore(url string, opts []natslib.Option, bucket, description string, maxAge time.Duration) (*jetStreamStateStore, error) {
+	conn, err := natslib.Connect(url, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to connect to %s", url)
+	}
+	js, err := conn.JetStream()
+	if err != nil {
+		conn.Close()
+		return nil, errors.Wrap(err, "failed to get the jetstream context")
+	}
+	kv, err := js.KeyValue(bucket)
+	if errors.Is(err, natslib.ErrBucketNotFound) {
+		kv, err = js.CreateKeyValue(&natslib.KeyValueConfig{
+			Bucket:      bucket,
+			Description: description,
+			TTL:         maxAge,
+		})
+	}
+	if err != nil {
+		conn.Close()
+		return nil, errors.Wrapf(err, "failed to get the key-value bucket %s", bucket)
+	}
+	return &jetStreamStateStore{conn: conn, kv: kv, now: time.Now}, nil
+}
+
+func (s *jetStreamStateStore) Get(_ context.Context, key string) ([]byte, bool, error) {
+	entry, err := s.kv.Get(key)
+	if errors.Is(err, natslib.ErrKeyNotFound) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	value, expiresAt, err := decodeStateValue(entry.Value())
+	if err != nil {
+		return nil, false, errors.Wrapf(err, "invalid value of the key %s", key)
+	}
+	if !expiresAt.IsZero() && !s.now().Before(expiresAt) {
+		return nil, false, nil
+	}
+	return value, true, nil
+}
+
+func (s *jetStreamStateStore) Set(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	var expiresAt time.Time
+	if ttl > 0 {
+		expiresAt = s.now().Add(ttl)
+	}
+	_, err := s.kv.Put(key, encodeStateValue(value, expiresAt))
+	return err
+}
+
+func (s *jetStreamStateStore) Create(ctx context.Context, key string, value []byte, ttl time.Duration) (bool, error) {
+	var expiresAt time.Time
+	if ttl > 0 {
+		expiresAt = s.now().Add(ttl)
+	}
+	entry, err := s.kv.Get(key)
+	switch {
+	case errors.Is(err, natslib.ErrKeyNotFound):
+		_, err = s.kv.Create(key, encodeStateValue(value, expiresAt))
+	case err != nil:
+		return false, err
+	default:
+		if _, expiry, decodeErr := decodeStateValue(entry.Value()); decodeErr == nil && (expiry.IsZero() || s.now().Before(expiry)) {
+			return false, nil
+		}
+		// The expired key is replaced unless it was set again meanwhile.
+		_, err = s.kv.Update(key, encodeStateValue(value, expiresAt), entry.Revision())
+	}
+	if err != nil {
+		// The key may have been set meanwhile, in which case it is not created.
+		if _, exists, getErr := s.Get(ctx, key); getErr == nil && exists {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func (s *jetStreamStateStore) Delete(_ context.Context, key string) error {
+	err := s.kv.Delete(key)
+	if errors.Is(err, natslib.ErrKeyNotFound) {
+		return nil
+	}
+	return err
+}
+
+func (s *jetStreamStateStore) Close() error {
+	s.conn.Close()
+	return nil
+}
+
+// encodeStateValue prefixes the value with its expiry, in Unix nanoseconds, 0 if it never expires.
+func encodeStateValue(value []byte, expiresAt time.Time) []byte {
+	b := make([]byte, 8, 8+len(value))
+	if !expiresAt.IsZero() {
+		binary.BigEndian.PutUint64(b, uint64(expiresAt.UnixNano()))
+	}
+	return append(b, value...)
+}
+
+// decodeStateValue returns the value and the expiry encoded by encodeStateValue.
+func decodeStateValue(b []byte) ([]byte, time.Time, error) {
+	if len(b) < 8 {
+		return nil, time.Time{}, errors.New("value too short")
+	}
+	var expiresAt time.Time
+	if n := binary.BigEndian.Uint64(b[:8]); n != 0 {
+		expiresAt = time.Unix(0, int64(n))
+	}
+	return b[8:], expiresAt, nil
+}
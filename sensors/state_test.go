@@ -0,0 +1,165 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	eventbusv1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+func TestNewStateStore(t *testing.T) {
+	store, err := newStateStore(sensorObj, nil)
+	assert.NoError(t, err)
+	assert.IsType(t, &memoryStateStore{}, store)
+
+	store, err = newStateStore(sensorObj, &eventbusv1alpha1.BusConfig{NATS: &eventbusv1alpha1.NATSConfig{}})
+	assert.NoError(t, err)
+	assert.IsType(t, &memoryStateStore{}, store)
+}
+
+func TestStateBucket(t *testing.T) {
+	assert.Equal(t, "sensor-fake-sensor-state", stateBucket("fake-sensor"))
+}
+
+func TestStateMaxAge(t *testing.T) {
+	sensor := sensorObj.DeepCopy()
+	sensor.Spec.Triggers = []v1alpha1.Trigger{{Template: &v1alpha1.TriggerTemplate{Name: "fake-trigger"}}}
+	assert.Equal(t, time.Duration(0), stateMaxAge(sensor))
+
+	sensor.Spec.Triggers[0].Dedupe = &v1alpha1.TriggerDedupe{TTL: "30m"}
+	assert.Equal(t, 30*time.Minute, stateMaxAge(sensor))
+	sensor.Spec.Idempotency = &v1alpha1.Idempotency{TTL: "1h"}
+	assert.Equal(t, time.Hour, stateMaxAge(sensor))
+	// The records of an idempotency with its own bucket are not kept in the state of the sensor.
+	sensor.Spec.Idempotency.JetStream = &v1alpha1.JetStreamIdempotencyStore{URL: "nats://fake"}
+	assert.Equal(t, 30*time.Minute, stateMaxAge(sensor))
+}
+
+func TestFeatureStore(t *testing.T) {
+	sensorCtx := &SensorContext{}
+	assert.IsType(t, &memoryStateStore{}, sensorCtx.featureStore(10))
+
+	sensorCtx.state = newMemoryStateStore(maxStateKeys)
+	store := sensorCtx.featureStore(10)
+	assert.NotSame(t, sensorCtx.state, store)
+	assert.Equal(t, 10, store.(*memoryStateStore).maxKeys)
+
+	sensorCtx.state = &jetStreamStateStore{}
+	assert.Same(t, sensorCtx.state, sensorCtx.featureStore(10))
+}
+
+func TestStateKey(t *testing.T) {
+	key := stateKey("dedupe", "fake-trigger", "order 1")
+	assert.Regexp(t, `^dedupe\.[0-9a-f]{64}$`, key)
+	assert.NotEqual(t, key, stateKey("dedupe", "fake-trigger", "order 2"))
+	assert.NotEqual(t, key, stateKey("circuit", "fake-trigger", "order 1"))
+}
+
+func TestMemoryStateStore(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("ttl", func(t *testing.T) {
+		now := time.Now()
+		store := newMemoryStateStore(10)
+		store.now = func() time.Time { return now }
+
+		_, ok, err := store.Get(ctx, "a")
+		assert.NoError(t, err)
+		assert.False(t, ok)
+
+		assert.NoError(t, store.Set(ctx, "a", []byte("1"), time.Minute))
+		assert.NoError(t, store.Set(ctx, "b", []byte("2"), 0))
+		value, ok, err := store.Get(ctx, "a")
+		assert.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, []byte("1"), value)
+
+		now = now.Add(time.Minute)
+		_, ok, err = store.Get(ctx, "a")
+		assert.NoError(t, err)
+		assert.False(t, ok)
+		value, ok, err = store.Get(ctx, "b")
+		assert.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, []byte("2"), value)
+
+		assert.NoError(t, store.Delete(ctx, "b"))
+		assert.NoError(t, store.Delete(ctx, "c"))
+		_, ok, err = store.Get(ctx, "b")
+		assert.NoError(t, err)
+		assert.False(t, ok)
+		assert.NoError(t, store.Close())
+	})
+
+	t.Run("create", func(t *testing.T) {
+		now := time.Now()
+		store := newMemoryStateStore(10)
+		store.now = func() time.Time { return now }
+		created, err := store.Create(ctx, "a", nil, time.Minute)
+		assert.NoError(t, err)
+		assert.True(t, created)
+		created, err = store.Create(ctx, "a", nil, time.Minute)
+		assert.NoError(t, err)
+		assert.False(t, created)
+
+		// The TTL is counted from the creation.
+		now = now.Add(time.Minute)
+		created, err = store.Create(ctx, "a", nil, time.Minute)
+		assert.NoError(t, err)
+		assert.True(t, created)
+		assert.Equal(t, 1, store.len())
+	})
+
+	t.Run("lru eviction", func(t *testing.T) {
+		store := newMemoryStateStore(2)
+		assert.NoError(t, store.Set(ctx, "a", nil, 0))
+		assert.NoError(t, store.Set(ctx, "b", nil, 0))
+		// Use a, so that b is the least recently used.
+		_, ok, _ := store.Get(ctx, "a")
+		assert.True(t, ok)
+		assert.NoError(t, store.Set(ctx, "c", nil, 0))
+		assert.Equal(t, 2, store.len())
+		_, ok, _ = store.Get(ctx, "b")
+		assert.False(t, ok)
+		_, ok, _ = store.Get(ctx, "a")
+		assert.True(t, ok)
+		_, ok, _ = store.Get(ctx, "c")
+		assert.True(t, ok)
+	})
+}
+
+func TestStateValueEncoding(t *testing.T) {
+	expiresAt := time.Unix(0, time.Now().UnixNano())
+	value, decoded, err := decodeStateValue(encodeStateValue([]byte("fake"), expiresAt))
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("fake"), value)
+	assert.True(t, expiresAt.Equal(decoded))
+
+	value, decoded, err = decodeStateValue(encodeStateValue(nil, time.Time{}))
+	assert.NoError(t, err)
+	assert.Empty(t, value)
+	assert.True(t, decoded.IsZero())
+
+	_, _, err = decodeStateValue([]byte("fake"))
+	assert.Error(t, err)
+}
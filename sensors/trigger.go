@@ -17,139 +17,207 @@ package sensors
 
 import (
 	"context"
-
+	nethttp "net/http"
+	"time"
+
+	eventhub "github.com/Azure/azure-event-hubs-go/v3"
+	"github.com/apache/openwhisk-client-go/whisk"
+	pulsarlib "github.com/apache/pulsar-client-go/pulsar"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/sqs/sqsiface"
+	"github.com/go-redis/redis/v8"
+	natslib "github.com/nats-io/nats.go"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
 
 	"github.com/argoproj/argo-events/common/logging"
 	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
 	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	sensortriggers "github.com/argoproj/argo-events/sensors/triggers"
 	openwhisk "github.com/argoproj/argo-events/sensors/triggers/apache-openwhisk"
 	argoworkflow "github.com/argoproj/argo-events/sensors/triggers/argo-workflow"
 	awslambda "github.com/argoproj/argo-events/sensors/triggers/aws-lambda"
+	awssqs "github.com/argoproj/argo-events/sensors/triggers/aws-sqs"
 	eventhubs "github.com/argoproj/argo-events/sensors/triggers/azure-event-hubs"
+	// The bus trigger registers itself
+	_ "github.com/argoproj/argo-events/sensors/triggers/bus"
 	customtrigger "github.com/argoproj/argo-events/sensors/triggers/custom-trigger"
+	// The GCP Cloud Function trigger registers itself
+	_ "github.com/argoproj/argo-events/sensors/triggers/gcp-cloud-function"
 	"github.com/argoproj/argo-events/sensors/triggers/http"
 	"github.com/argoproj/argo-events/sensors/triggers/kafka"
 	logtrigger "github.com/argoproj/argo-events/sensors/triggers/log"
 	"github.com/argoproj/argo-events/sensors/triggers/nats"
 	"github.com/argoproj/argo-events/sensors/triggers/pulsar"
+	// The Pushgateway trigger registers itself
+	_ "github.com/argoproj/argo-events/sensors/triggers/pushgateway"
+	redisstream "github.com/argoproj/argo-events/sensors/triggers/redis-stream"
 	"github.com/argoproj/argo-events/sensors/triggers/slack"
 	standardk8s "github.com/argoproj/argo-events/sensors/triggers/standard-k8s"
 )
 
 // Trigger interface
-type Trigger interface {
-	GetTriggerType() apicommon.TriggerType
-	// FetchResource fetches the trigger resource from external source
-	FetchResource(context.Context) (interface{}, error)
-	// ApplyResourceParameters applies parameters to the trigger resource
-	ApplyResourceParameters(events map[string]*v1alpha1.Event, resource interface{}) (interface{}, error)
-	// Execute executes the trigger
-	Execute(ctx context.Context, events map[string]*v1alpha1.Event, resource interface{}) (interface{}, error)
-	// ApplyPolicy applies the policy on the trigger
-	ApplyPolicy(ctx context.Context, resource interface{}) error
-}
-
-// GetTrigger returns a trigger
-func (sensorCtx *SensorContext) GetTrigger(ctx context.Context, trigger *v1alpha1.Trigger) Trigger {
-	log := logging.FromContext(ctx).With(logging.LabelTriggerName, trigger.Template.Name)
-	if trigger.Template.K8s != nil {
-		return standardk8s.NewStandardK8sTrigger(sensorCtx.kubeClient, sensorCtx.dynamicClient, sensorCtx.sensor, trigger, log)
-	}
-
-	if trigger.Template.ArgoWorkflow != nil {
-		return argoworkflow.NewArgoWorkflowTrigger(sensorCtx.kubeClient, sensorCtx.dynamicClient, sensorCtx.sensor, trigger, log)
-	}
-
-	if trigger.Template.HTTP != nil {
-		result, err := http.NewHTTPTrigger(sensorCtx.httpClients, sensorCtx.sensor, trigger, log)
-		if err != nil {
-			log.Errorw("failed to new an HTTP trigger", zap.Error(err))
-			return nil
-		}
-		return result
-	}
+type Trigger = sensortriggers.Trigger
 
-	if trigger.Template.AWSLambda != nil {
-		result, err := awslambda.NewAWSLambdaTrigger(sensorCtx.awsLambdaClients, sensorCtx.sensor, trigger, log)
-		if err != nil {
-			log.Errorw("failed to new a Lambda trigger", zap.Error(err))
-			return nil
-		}
-		return result
-	}
-
-	if trigger.Template.AzureEventHubs != nil {
-		result, err := eventhubs.NewAzureEventHubsTrigger(sensorCtx.sensor, trigger, sensorCtx.azureEventHubsClients, log)
-		if err != nil {
-			log.Errorw("failed to new an Azure Event Hubs trigger", zap.Error(err))
-			return nil
-		}
-		return result
-	}
+// slackClients are the clients shared by the Slack triggers
+type slackClients struct {
+	httpClient *nethttp.Client
+	clients    map[string]*slack.Client
+}
 
-	if trigger.Template.Kafka != nil {
-		result, err := kafka.NewKafkaTrigger(sensorCtx.sensor, trigger, sensorCtx.kafkaProducers, log)
-		if err != nil {
-			log.Errorw("failed to new a Kafka trigger", zap.Error(err))
-			return nil
-		}
-		return result
-	}
+func init() {
+	sensortriggers.Register(apicommon.K8sTrigger, sensortriggers.Registration{
+		Matches: func(template *v1alpha1.TriggerTemplate) bool { return template.K8s != nil },
+		New: func(deps *sensortriggers.Dependencies, trigger *v1alpha1.Trigger, logger *zap.SugaredLogger) (sensortriggers.Trigger, error) {
+			return standardk8s.NewStandardK8sTrigger(deps.KubeClient, deps.DynamicClient, deps.Sensor, trigger, logger), nil
+		},
+	})
+	sensortriggers.Register(apicommon.ArgoWorkflowTrigger, sensortriggers.Registration{
+		Matches: func(template *v1alpha1.TriggerTemplate) bool { return template.ArgoWorkflow != nil },
+		New: func(deps *sensortriggers.Dependencies, trigger *v1alpha1.Trigger, logger *zap.SugaredLogger) (sensortriggers.Trigger, error) {
+			return argoworkflow.NewArgoWorkflowTrigger(deps.KubeClient, deps.DynamicClient, deps.Sensor, trigger, logger), nil
+		},
+	})
+	sensortriggers.Register(apicommon.HTTPTrigger, sensortriggers.Registration{
+		Matches: func(template *v1alpha1.TriggerTemplate) bool { return template.HTTP != nil },
+		New: func(deps *sensortriggers.Dependencies, trigger *v1alpha1.Trigger, logger *zap.SugaredLogger) (sensortriggers.Trigger, error) {
+			clients := deps.Clients.Get(apicommon.HTTPTrigger, func() interface{} {
+				return make(map[string]*nethttp.Client)
+			}).(map[string]*nethttp.Client)
+			return http.NewHTTPTrigger(clients, deps.Sensor, trigger, logger)
+		},
+	})
+	sensortriggers.Register(apicommon.LambdaTrigger, sensortriggers.Registration{
+		Matches: func(template *v1alpha1.TriggerTemplate) bool { return template.AWSLambda != nil },
+		New: func(deps *sensortriggers.Dependencies, trigger *v1alpha1.Trigger, logger *zap.SugaredLogger) (sensortriggers.Trigger, error) {
+			clients := deps.Clients.Get(apicommon.LambdaTrigger, func() interface{} {
+				return make(map[string]*lambda.Lambda)
+			}).(map[string]*lambda.Lambda)
+			return awslambda.NewAWSLambdaTrigger(clients, deps.Sensor, trigger, logger)
+		},
+	})
+	sensortriggers.Register(apicommon.AWSSQSTrigger, sensortriggers.Registration{
+		Matches: func(template *v1alpha1.TriggerTemplate) bool { return template.AWSSQS != nil },
+		New: func(deps *sensortriggers.Dependencies, trigger *v1alpha1.Trigger, logger *zap.SugaredLogger) (sensortriggers.Trigger, error) {
+			clients := deps.Clients.Get(apicommon.AWSSQSTrigger, func() interface{} {
+				return make(map[string]sqsiface.SQSAPI)
+			}).(map[string]sqsiface.SQSAPI)
+			return awssqs.NewAWSSQSTrigger(clients, deps.Sensor, trigger, logger)
+		},
+	})
+	sensortriggers.Register(apicommon.RedisStreamTrigger, sensortriggers.Registration{
+		Matches: func(template *v1alpha1.TriggerTemplate) bool { return template.RedisStream != nil },
+		New: func(deps *sensortriggers.Dependencies, trigger *v1alpha1.Trigger, logger *zap.SugaredLogger) (sensortriggers.Trigger, error) {
+			clients := deps.Clients.Get(apicommon.RedisStreamTrigger, func() interface{} {
+				return make(map[string]*redis.Client)
+			}).(map[string]*redis.Client)
+			return redisstream.NewRedisStreamTrigger(clients, deps.Sensor, trigger, logger)
+		},
+	})
+	sensortriggers.Register(apicommon.AzureEventHubsTrigger, sensortriggers.Registration{
+		Matches: func(template *v1alpha1.TriggerTemplate) bool { return template.AzureEventHubs != nil },
+		New: func(deps *sensortriggers.Dependencies, trigger *v1alpha1.Trigger, logger *zap.SugaredLogger) (sensortriggers.Trigger, error) {
+			clients := deps.Clients.Get(apicommon.AzureEventHubsTrigger, func() interface{} {
+				return make(map[string]*eventhub.Hub)
+			}).(map[string]*eventhub.Hub)
+			return eventhubs.NewAzureEventHubsTrigger(deps.Sensor, trigger, clients, logger)
+		},
+	})
+	sensortriggers.Register(apicommon.KafkaTrigger, sensortriggers.Registration{
+		Matches: func(template *v1alpha1.TriggerTemplate) bool { return template.Kafka != nil },
+		New: func(deps *sensortriggers.Dependencies, trigger *v1alpha1.Trigger, logger *zap.SugaredLogger) (sensortriggers.Trigger, error) {
+			producers := deps.Clients.Get(apicommon.KafkaTrigger, func() interface{} {
+				return make(map[string]*kafka.Producer)
+			}).(map[string]*kafka.Producer)
+			return kafka.NewKafkaTrigger(deps.Sensor, trigger, producers, logger)
+		},
+	})
+	sensortriggers.Register(apicommon.PulsarTrigger, sensortriggers.Registration{
+		Matches: func(template *v1alpha1.TriggerTemplate) bool { return template.Pulsar != nil },
+		New: func(deps *sensortriggers.Dependencies, trigger *v1alpha1.Trigger, logger *zap.SugaredLogger) (sensortriggers.Trigger, error) {
+			producers := deps.Clients.Get(apicommon.PulsarTrigger, func() interface{} {
+				return make(map[string]pulsarlib.Producer)
+			}).(map[string]pulsarlib.Producer)
+			return pulsar.NewPulsarTrigger(deps.Sensor, trigger, producers, logger)
+		},
+	})
+	sensortriggers.Register(apicommon.NATSTrigger, sensortriggers.Registration{
+		Matches: func(template *v1alpha1.TriggerTemplate) bool { return template.NATS != nil },
+		New: func(deps *sensortriggers.Dependencies, trigger *v1alpha1.Trigger, logger *zap.SugaredLogger) (sensortriggers.Trigger, error) {
+			connections := deps.Clients.Get(apicommon.NATSTrigger, func() interface{} {
+				return make(map[string]*natslib.Conn)
+			}).(map[string]*natslib.Conn)
+			return nats.NewNATSTrigger(deps.Sensor, trigger, connections, logger)
+		},
+	})
+	sensortriggers.Register(apicommon.SlackTrigger, sensortriggers.Registration{
+		Matches: func(template *v1alpha1.TriggerTemplate) bool { return template.Slack != nil },
+		New: func(deps *sensortriggers.Dependencies, trigger *v1alpha1.Trigger, logger *zap.SugaredLogger) (sensortriggers.Trigger, error) {
+			clients := deps.Clients.Get(apicommon.SlackTrigger, func() interface{} {
+				return &slackClients{
+					httpClient: &nethttp.Client{
+						Timeout: time.Minute * 5,
+					},
+					clients: make(map[string]*slack.Client),
+				}
+			}).(*slackClients)
+			return slack.NewSlackTrigger(deps.Sensor, trigger, logger, clients.httpClient, clients.clients)
+		},
+	})
+	sensortriggers.Register(apicommon.OpenWhiskTrigger, sensortriggers.Registration{
+		Matches: func(template *v1alpha1.TriggerTemplate) bool { return template.OpenWhisk != nil },
+		New: func(deps *sensortriggers.Dependencies, trigger *v1alpha1.Trigger, logger *zap.SugaredLogger) (sensortriggers.Trigger, error) {
+			clients := deps.Clients.Get(apicommon.OpenWhiskTrigger, func() interface{} {
+				return make(map[string]*whisk.Client)
+			}).(map[string]*whisk.Client)
+			return openwhisk.NewTriggerImpl(deps.Sensor, trigger, clients, logger)
+		},
+	})
+	sensortriggers.Register(apicommon.CustomTrigger, sensortriggers.Registration{
+		Matches: func(template *v1alpha1.TriggerTemplate) bool { return template.CustomTrigger != nil },
+		New: func(deps *sensortriggers.Dependencies, trigger *v1alpha1.Trigger, logger *zap.SugaredLogger) (sensortriggers.Trigger, error) {
+			clients := deps.Clients.Get(apicommon.CustomTrigger, func() interface{} {
+				return make(map[string]*grpc.ClientConn)
+			}).(map[string]*grpc.ClientConn)
+			return customtrigger.NewCustomTrigger(deps.Sensor, trigger, logger, clients)
+		},
+	})
+	sensortriggers.Register(apicommon.LogTrigger, sensortriggers.Registration{
+		Matches: func(template *v1alpha1.TriggerTemplate) bool { return template.Log != nil },
+		New: func(deps *sensortriggers.Dependencies, trigger *v1alpha1.Trigger, logger *zap.SugaredLogger) (sensortriggers.Trigger, error) {
+			return logtrigger.NewLogTrigger(deps.Sensor, trigger, logger)
+		},
+	})
+}
 
-	if trigger.Template.Pulsar != nil {
-		result, err := pulsar.NewPulsarTrigger(sensorCtx.sensor, trigger, sensorCtx.pulsarProducers, log)
-		if err != nil {
-			log.Errorw("failed to new a Pulsar trigger", zap.Error(err))
-			return nil
-		}
-		return result
+// GetTrigger returns a trigger, implemented by the factory registered for its type
+func (sensorCtx *SensorContext) GetTrigger(ctx context.Context, trigger *v1alpha1.Trigger) Trigger {
+	// The logger of the execution carries the trigger name along with the fields of the events.
+	log := logging.FromContext(ctx)
+	triggerType, err := sensortriggers.TypeOf(trigger.Template)
+	if err != nil {
+		log.Errorw("failed to resolve the trigger type", zap.Error(err))
+		return nil
 	}
-
-	if trigger.Template.NATS != nil {
-		result, err := nats.NewNATSTrigger(sensorCtx.sensor, trigger, sensorCtx.natsConnections, log)
-		if err != nil {
-			log.Errorw("failed to new a NATS trigger", zap.Error(err))
-			return nil
-		}
-		return result
+	registration, err := sensortriggers.Lookup(triggerType)
+	if err != nil {
+		log.Errorw("failed to resolve the trigger implementation", zap.Error(err))
+		return nil
 	}
-
-	if trigger.Template.Slack != nil {
-		result, err := slack.NewSlackTrigger(sensorCtx.sensor, trigger, log, sensorCtx.slackHTTPClient)
-		if err != nil {
-			log.Errorw("failed to new a Slack trigger", zap.Error(err))
-			return nil
-		}
-		return result
+	deps := &sensortriggers.Dependencies{
+		KubeClient:    sensorCtx.kubeClient,
+		DynamicClient: sensorCtx.dynamicClient,
+		Sensor:        sensorCtx.sensor,
+		Clients:       sensorCtx.triggerClients,
+		Dispatcher:    sensorCtx,
 	}
-
-	if trigger.Template.OpenWhisk != nil {
-		result, err := openwhisk.NewTriggerImpl(sensorCtx.sensor, trigger, sensorCtx.openwhiskClients, log)
-		if err != nil {
-			log.Errorw("failed to new an OpenWhisk trigger", zap.Error(err))
-			return nil
-		}
-		return result
+	if sensorCtx.busPublisher != nil {
+		deps.EventBus = sensorCtx.busPublisher
 	}
-
-	if trigger.Template.CustomTrigger != nil {
-		result, err := customtrigger.NewCustomTrigger(sensorCtx.sensor, trigger, log, sensorCtx.customTriggerClients)
-		if err != nil {
-			log.Errorw("failed to new a Custom trigger", zap.Error(err))
-			return nil
-		}
-		return result
+	result, err := registration.New(deps, trigger, log)
+	if err != nil {
+		log.Errorw("failed to new a trigger", zap.String(logging.LabelTriggerType, string(triggerType)), zap.Error(err))
+		return nil
 	}
-
-	if trigger.Template.Log != nil {
-		result, err := logtrigger.NewLogTrigger(sensorCtx.sensor, trigger, log)
-		if err != nil {
-			log.Errorw("failed to new a Log trigger", zap.Error(err))
-			return nil
-		}
-		return result
-	}
-
-	return nil
+	return result
 }
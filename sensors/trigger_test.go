@@ -0,0 +1,67 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	sensortriggers "github.com/argoproj/argo-events/sensors/triggers"
+)
+
+func TestGetTrigger(t *testing.T) {
+	sensorCtx := &SensorContext{sensor: sensorObj.DeepCopy(), triggerClients: sensortriggers.NewClientCache()}
+
+	t.Run("in-tree triggers", func(t *testing.T) {
+		trigger := sensorCtx.GetTrigger(context.TODO(), fakeTrigger.DeepCopy())
+		assert.NotNil(t, trigger)
+		assert.Equal(t, apicommon.K8sTrigger, trigger.GetTriggerType())
+
+		trigger = sensorCtx.GetTrigger(context.TODO(), &v1alpha1.Trigger{Template: &v1alpha1.TriggerTemplate{Name: "fake-log", Log: &v1alpha1.LogTrigger{}}})
+		assert.NotNil(t, trigger)
+		assert.Equal(t, apicommon.LogTrigger, trigger.GetTriggerType())
+	})
+
+	t.Run("self-registered triggers", func(t *testing.T) {
+		_, err := sensortriggers.Lookup(apicommon.GCPFunctionTrigger)
+		assert.NoError(t, err)
+
+		trigger := sensorCtx.GetTrigger(context.TODO(), &v1alpha1.Trigger{Template: &v1alpha1.TriggerTemplate{Name: "fake-pushgateway", Pushgateway: &v1alpha1.PushgatewayTrigger{}}})
+		assert.NotNil(t, trigger)
+		assert.Equal(t, apicommon.PushgatewayTrigger, trigger.GetTriggerType())
+	})
+
+	t.Run("bus trigger", func(t *testing.T) {
+		busTrigger := &v1alpha1.Trigger{Template: &v1alpha1.TriggerTemplate{Name: "fake-bus", Bus: &v1alpha1.BusTrigger{}}}
+		// The sensor is not connected to an eventbus.
+		assert.Nil(t, sensorCtx.GetTrigger(context.TODO(), busTrigger))
+
+		sensorCtx := &SensorContext{sensor: sensorObj.DeepCopy(), triggerClients: sensortriggers.NewClientCache(), busPublisher: &eventBusPublisher{subject: "eventbus-fake"}}
+		trigger := sensorCtx.GetTrigger(context.TODO(), busTrigger)
+		assert.NotNil(t, trigger)
+		assert.Equal(t, apicommon.BusTrigger, trigger.GetTriggerType())
+	})
+
+	t.Run("unknown trigger", func(t *testing.T) {
+		trigger := sensorCtx.GetTrigger(context.TODO(), &v1alpha1.Trigger{Template: &v1alpha1.TriggerTemplate{Name: "fake-unknown"}})
+		assert.Nil(t, trigger)
+	})
+}
@@ -61,7 +61,7 @@ func NewTriggerImpl(sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger, openWhis
 		config.Host = openwhisktrigger.Host
 
 		if openwhisktrigger.AuthToken != nil {
-			token, err := common.GetSecretFromVolume(openwhisktrigger.AuthToken)
+			token, err := common.GetSecret(openwhisktrigger.AuthToken)
 			if err != nil {
 				return nil, errors.Wrap(err, "failed to retrieve auth token")
 			}
@@ -149,10 +149,19 @@ func (t *TriggerImpl) Execute(ctx context.Context, events map[string]*v1alpha1.E
 		if err != nil {
 			return nil, err
 		}
+		payload, err = triggers.WrapCloudEvent(t.Sensor, t.Trigger, events, payload)
+		if err != nil {
+			return nil, err
+		}
 
 		t.Logger.Debugw("payload for the OpenWhisk action invocation", zap.Any("name", t.Trigger.Template.Name), zap.Any("payload", string(payload)))
 	}
 
+	if t.Trigger.Template.DryRun {
+		t.Logger.Infow("dry run, skipping the OpenWhisk action invocation", zap.String("actionName", openwhisktrigger.ActionName), zap.String("payload", string(payload)))
+		return nil, nil
+	}
+
 	response, status, err := t.OpenWhiskClient.Actions.Invoke(openwhisktrigger.ActionName, payload, true, true)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to invoke action %s", openwhisktrigger.ActionName)
@@ -165,7 +174,7 @@ func (t *TriggerImpl) Execute(ctx context.Context, events map[string]*v1alpha1.E
 
 // ApplyPolicy applies policy on the trigger
 func (t *TriggerImpl) ApplyPolicy(ctx context.Context, resource interface{}) error {
-	if t.Trigger.Policy == nil || t.Trigger.Policy.Status == nil || t.Trigger.Policy.Status.Allow == nil {
+	if t.Trigger.Policy == nil || t.Trigger.Policy.Status == nil || (t.Trigger.Policy.Status.Allow == nil && t.Trigger.Policy.Status.AllowRanges == nil) {
 		return nil
 	}
 	response, ok := resource.(*http.Response)
@@ -173,7 +182,10 @@ func (t *TriggerImpl) ApplyPolicy(ctx context.Context, resource interface{}) err
 		return errors.New("failed to interpret the trigger execution response")
 	}
 
-	p := policy.NewStatusPolicy(response.StatusCode, t.Trigger.Policy.Status.GetAllow())
+	p, err := policy.NewStatusPolicyWithRanges(response.StatusCode, t.Trigger.Policy.Status.GetAllow(), t.Trigger.Policy.Status.AllowRanges)
+	if err != nil {
+		return err
+	}
 
 	return p.ApplyPolicy(ctx)
 }
@@ -131,6 +131,12 @@ func (t *ArgoWorkflowTrigger) Execute(ctx context.Context, events map[string]*v1
 		namespace = t.Sensor.Namespace
 	}
 
+	if trigger.Template.DryRun {
+		t.Logger.Infow("dry run, skipping the workflow operation", zap.Any("operation", op), zap.String("namespace", namespace),
+			zap.String("name", name), zap.Strings("args", trigger.Template.ArgoWorkflow.Args), zap.Any("workflow", obj.Object))
+		return obj, nil
+	}
+
 	var cmd *exec.Cmd
 
 	switch op {
@@ -112,6 +112,16 @@ func (t *AWSLambdaTrigger) Execute(ctx context.Context, events map[string]*v1alp
 	if err != nil {
 		return nil, err
 	}
+	payload, err = triggers.WrapCloudEvent(t.Sensor, t.Trigger, events, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	if t.Trigger.Template.DryRun {
+		t.Logger.Infow("dry run, skipping the Lambda invocation", zap.String("functionName", trigger.FunctionName),
+			zap.Any("invocationType", trigger.InvocationType), zap.String("payload", string(payload)))
+		return nil, nil
+	}
 
 	response, err := t.LambdaClient.Invoke(&lambda.InvokeInput{
 		FunctionName:   &trigger.FunctionName,
@@ -127,7 +137,7 @@ func (t *AWSLambdaTrigger) Execute(ctx context.Context, events map[string]*v1alp
 
 // ApplyPolicy applies the policy on the trigger execution response
 func (t *AWSLambdaTrigger) ApplyPolicy(ctx context.Context, resource interface{}) error {
-	if t.Trigger.Policy == nil || t.Trigger.Policy.Status == nil || t.Trigger.Policy.Status.Allow == nil {
+	if t.Trigger.Policy == nil || t.Trigger.Policy.Status == nil || (t.Trigger.Policy.Status.Allow == nil && t.Trigger.Policy.Status.AllowRanges == nil) {
 		return nil
 	}
 
@@ -136,6 +146,9 @@ func (t *AWSLambdaTrigger) ApplyPolicy(ctx context.Context, resource interface{}
 		return errors.New("failed to interpret the trigger resource")
 	}
 
-	p := policy.NewStatusPolicy(int(*obj.StatusCode), t.Trigger.Policy.Status.GetAllow())
+	p, err := policy.NewStatusPolicyWithRanges(int(*obj.StatusCode), t.Trigger.Policy.Status.GetAllow(), t.Trigger.Policy.Status.AllowRanges)
+	if err != nil {
+		return err
+	}
 	return p.ApplyPolicy(ctx)
 }
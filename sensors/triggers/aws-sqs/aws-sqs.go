@@ -0,0 +1,180 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package aws_sqs
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/aws/aws-sdk-go/service/sqs/sqsiface"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/argoproj/argo-events/common/logging"
+	commonaws "github.com/argoproj/argo-events/eventsources/common/aws"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sensors/triggers"
+)
+
+// fifoQueueSuffix is the suffix of the names of the FIFO queues
+const fifoQueueSuffix = ".fifo"
+
+// clientsLock guards the clients cache, shared by the concurrent executions.
+var clientsLock sync.Mutex
+
+// AWSSQSTrigger refers to trigger that sends messages to AWS SQS queues
+type AWSSQSTrigger struct {
+	// Client is the AWS SQS client
+	Client sqsiface.SQSAPI
+	// Sensor object
+	Sensor *v1alpha1.Sensor
+	// Trigger definition
+	Trigger *v1alpha1.Trigger
+	// logger to log stuff
+	Logger *zap.SugaredLogger
+}
+
+// NewAWSSQSTrigger returns a new AWS SQS trigger context
+func NewAWSSQSTrigger(sqsClients map[string]sqsiface.SQSAPI, sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger, logger *zap.SugaredLogger) (*AWSSQSTrigger, error) {
+	sqsTrigger := trigger.Template.AWSSQS
+	logger = logger.With(logging.LabelTriggerType, apicommon.AWSSQSTrigger)
+
+	clientsLock.Lock()
+	defer clientsLock.Unlock()
+
+	client, ok := sqsClients[trigger.Template.Name]
+	if !ok {
+		awsSession, err := commonaws.CreateAWSSessionWithCredsInVolume(sqsTrigger.Region, sqsTrigger.RoleARN, sqsTrigger.AccessKey, sqsTrigger.SecretKey)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create a AWS session")
+		}
+		client = sqs.New(awsSession, &aws.Config{Region: &sqsTrigger.Region})
+		sqsClients[trigger.Template.Name] = client
+	}
+
+	return &AWSSQSTrigger{
+		Client:  client,
+		Sensor:  sensor,
+		Trigger: trigger,
+		Logger:  logger,
+	}, nil
+}
+
+// GetTriggerType returns the type of the trigger
+func (t *AWSSQSTrigger) GetTriggerType() apicommon.TriggerType {
+	return apicommon.AWSSQSTrigger
+}
+
+// FetchResource fetches the trigger resource
+func (t *AWSSQSTrigger) FetchResource(ctx context.Context) (interface{}, error) {
+	return t.Trigger.Template.AWSSQS, nil
+}
+
+// ApplyResourceParameters applies parameters to the trigger resource
+func (t *AWSSQSTrigger) ApplyResourceParameters(events map[string]*v1alpha1.Event, resource interface{}) (interface{}, error) {
+	resourceBytes, err := json.Marshal(resource)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal the aws sqs trigger resource")
+	}
+	parameters := t.Trigger.Template.AWSSQS.Parameters
+	if parameters != nil {
+		updatedResourceBytes, err := triggers.ApplyParams(resourceBytes, parameters, events)
+		if err != nil {
+			return nil, err
+		}
+		var st *v1alpha1.AWSSQSTrigger
+		if err := json.Unmarshal(updatedResourceBytes, &st); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal the updated aws sqs trigger resource after applying resource parameters")
+		}
+		return st, nil
+	}
+	return resource, nil
+}
+
+// Execute executes the trigger
+func (t *AWSSQSTrigger) Execute(ctx context.Context, events map[string]*v1alpha1.Event, resource interface{}) (interface{}, error) {
+	trigger, ok := resource.(*v1alpha1.AWSSQSTrigger)
+	if !ok {
+		return nil, errors.New("failed to interpret the trigger resource")
+	}
+
+	if trigger.Payload == nil {
+		return nil, errors.New("payload parameters are not specified")
+	}
+
+	payload, err := triggers.ConstructPayload(events, trigger.Payload)
+	if err != nil {
+		return nil, err
+	}
+	payload, err = triggers.WrapCloudEvent(t.Sensor, t.Trigger, events, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &sqs.SendMessageInput{
+		QueueUrl:    &trigger.QueueURL,
+		MessageBody: aws.String(string(payload)),
+	}
+	if isFIFOQueue(trigger.QueueURL) {
+		// The group ID is usually resolved from the event data, an empty one would be rejected by SQS.
+		if trigger.MessageGroupID == "" {
+			return nil, errors.Errorf("message group ID is required to send a message to FIFO queue %s", trigger.QueueURL)
+		}
+		input.MessageGroupId = &trigger.MessageGroupID
+		if trigger.MessageDeduplicationID != "" {
+			input.MessageDeduplicationId = &trigger.MessageDeduplicationID
+		}
+	} else if trigger.MessageGroupID != "" || trigger.MessageDeduplicationID != "" {
+		return nil, errors.Errorf("message group and deduplication IDs are only supported by FIFO queues, %s is not one", trigger.QueueURL)
+	}
+
+	if t.Trigger.Template.DryRun {
+		t.Logger.Infow("dry run, skipping sending the message", zap.String("queueURL", trigger.QueueURL),
+			zap.String("messageGroupID", trigger.MessageGroupID), zap.String("messageDeduplicationID", trigger.MessageDeduplicationID),
+			zap.String("payload", string(payload)))
+		return nil, nil
+	}
+
+	output, err := t.Client.SendMessageWithContext(ctx, input)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to send a message to queue %s", trigger.QueueURL)
+	}
+
+	t.Logger.Infow("sent a message to the queue", zap.String("queueURL", trigger.QueueURL), zap.String("messageID", aws.StringValue(output.MessageId)))
+	return output, nil
+}
+
+// ApplyPolicy applies the policy on the trigger execution response
+func (t *AWSSQSTrigger) ApplyPolicy(ctx context.Context, resource interface{}) error {
+	obj, ok := resource.(*sqs.SendMessageOutput)
+	if !ok {
+		return errors.New("failed to interpret the trigger resource")
+	}
+	if aws.StringValue(obj.MessageId) == "" {
+		return errors.New("the message was not acknowledged by SQS, no message ID was returned")
+	}
+	return nil
+}
+
+// isFIFOQueue returns true if the queue URL refers to a FIFO queue
+func isFIFOQueue(queueURL string) bool {
+	return strings.HasSuffix(queueURL, fifoQueueSuffix)
+}
@@ -0,0 +1,215 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package aws_sqs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/aws/aws-sdk-go/service/sqs/sqsiface"
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-events/common/logging"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+var sensorObj = &v1alpha1.Sensor{
+	ObjectMeta: metav1.ObjectMeta{
+		Name:      "fake-sensor",
+		Namespace: "fake",
+	},
+	Spec: v1alpha1.SensorSpec{
+		Triggers: []v1alpha1.Trigger{
+			{
+				Template: &v1alpha1.TriggerTemplate{
+					Name: "fake-trigger",
+					AWSSQS: &v1alpha1.AWSSQSTrigger{
+						QueueURL: "https://sqs.us-east-1.amazonaws.com/123456789012/fake-queue.fifo",
+						Region:   "us-east-1",
+						Payload: []v1alpha1.TriggerParameter{
+							{
+								Src: &v1alpha1.TriggerParameterSource{
+									DependencyName: "fake-dependency",
+									DataKey:        "name",
+								},
+								Dest: "name",
+							},
+						},
+						Parameters: []v1alpha1.TriggerParameter{
+							{
+								Src: &v1alpha1.TriggerParameterSource{
+									DependencyName: "fake-dependency",
+									DataKey:        "orderId",
+								},
+								Dest: "messageGroupID",
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+var testEvents = map[string]*v1alpha1.Event{
+	"fake-dependency": {
+		Context: &v1alpha1.EventContext{
+			ID:              "1",
+			Type:            "webhook",
+			Source:          "webhook-gateway",
+			DataContentType: "application/json",
+			SpecVersion:     cloudevents.VersionV1,
+			Subject:         "example-1",
+		},
+		Data: []byte(`{"name": "fake-name", "orderId": "order-1"}`),
+	},
+}
+
+// fakeSQSClient records the messages it is asked to send.
+type fakeSQSClient struct {
+	sqsiface.SQSAPI
+	inputs []*sqs.SendMessageInput
+	err    error
+}
+
+func (c *fakeSQSClient) SendMessageWithContext(ctx aws.Context, input *sqs.SendMessageInput, opts ...request.Option) (*sqs.SendMessageOutput, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	c.inputs = append(c.inputs, input)
+	return &sqs.SendMessageOutput{MessageId: aws.String("fake-message-id")}, nil
+}
+
+func getFakeAWSSQSTrigger(client sqsiface.SQSAPI) *AWSSQSTrigger {
+	sensor := sensorObj.DeepCopy()
+	return &AWSSQSTrigger{
+		Client:  client,
+		Sensor:  sensor,
+		Trigger: &sensor.Spec.Triggers[0],
+		Logger:  logging.NewArgoEventsLogger(),
+	}
+}
+
+func TestNewAWSSQSTrigger(t *testing.T) {
+	client := &fakeSQSClient{}
+	sensor := sensorObj.DeepCopy()
+	trigger, err := NewAWSSQSTrigger(map[string]sqsiface.SQSAPI{"fake-trigger": client}, sensor, &sensor.Spec.Triggers[0], logging.NewArgoEventsLogger())
+	assert.Nil(t, err)
+	assert.Equal(t, client, trigger.Client)
+}
+
+func TestAWSSQSTrigger_FetchResource(t *testing.T) {
+	trigger := getFakeAWSSQSTrigger(&fakeSQSClient{})
+	resource, err := trigger.FetchResource(context.TODO())
+	assert.Nil(t, err)
+	assert.NotNil(t, resource)
+
+	st, ok := resource.(*v1alpha1.AWSSQSTrigger)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, "https://sqs.us-east-1.amazonaws.com/123456789012/fake-queue.fifo", st.QueueURL)
+}
+
+func TestAWSSQSTrigger_ApplyResourceParameters(t *testing.T) {
+	trigger := getFakeAWSSQSTrigger(&fakeSQSClient{})
+	resource, err := trigger.ApplyResourceParameters(testEvents, trigger.Trigger.Template.AWSSQS)
+	assert.Nil(t, err)
+	assert.NotNil(t, resource)
+
+	st, ok := resource.(*v1alpha1.AWSSQSTrigger)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, "order-1", st.MessageGroupID)
+}
+
+func TestAWSSQSTrigger_Execute(t *testing.T) {
+	t.Run("fifo queue", func(t *testing.T) {
+		client := &fakeSQSClient{}
+		trigger := getFakeAWSSQSTrigger(client)
+		resource, err := trigger.ApplyResourceParameters(testEvents, trigger.Trigger.Template.AWSSQS)
+		assert.Nil(t, err)
+		resource.(*v1alpha1.AWSSQSTrigger).MessageDeduplicationID = "event-1"
+
+		output, err := trigger.Execute(context.TODO(), testEvents, resource)
+		assert.Nil(t, err)
+		assert.Equal(t, "fake-message-id", aws.StringValue(output.(*sqs.SendMessageOutput).MessageId))
+		assert.Len(t, client.inputs, 1)
+		assert.Equal(t, `{"name":"fake-name"}`, aws.StringValue(client.inputs[0].MessageBody))
+		assert.Equal(t, "order-1", aws.StringValue(client.inputs[0].MessageGroupId))
+		assert.Equal(t, "event-1", aws.StringValue(client.inputs[0].MessageDeduplicationId))
+	})
+
+	t.Run("fifo queue without message group", func(t *testing.T) {
+		client := &fakeSQSClient{}
+		trigger := getFakeAWSSQSTrigger(client)
+		_, err := trigger.Execute(context.TODO(), testEvents, trigger.Trigger.Template.AWSSQS)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "message group ID is required")
+		assert.Empty(t, client.inputs)
+	})
+
+	t.Run("standard queue", func(t *testing.T) {
+		client := &fakeSQSClient{}
+		trigger := getFakeAWSSQSTrigger(client)
+		trigger.Trigger.Template.AWSSQS.QueueURL = "https://sqs.us-east-1.amazonaws.com/123456789012/fake-queue"
+		_, err := trigger.Execute(context.TODO(), testEvents, trigger.Trigger.Template.AWSSQS)
+		assert.Nil(t, err)
+		assert.Len(t, client.inputs, 1)
+		assert.Nil(t, client.inputs[0].MessageGroupId)
+		assert.Nil(t, client.inputs[0].MessageDeduplicationId)
+
+		trigger.Trigger.Template.AWSSQS.MessageGroupID = "order-1"
+		_, err = trigger.Execute(context.TODO(), testEvents, trigger.Trigger.Template.AWSSQS)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("send failure", func(t *testing.T) {
+		trigger := getFakeAWSSQSTrigger(&fakeSQSClient{err: errors.New("access denied")})
+		trigger.Trigger.Template.AWSSQS.MessageGroupID = "order-1"
+		_, err := trigger.Execute(context.TODO(), testEvents, trigger.Trigger.Template.AWSSQS)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "access denied")
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		client := &fakeSQSClient{}
+		trigger := getFakeAWSSQSTrigger(client)
+		trigger.Trigger.Template.DryRun = true
+		trigger.Trigger.Template.AWSSQS.MessageGroupID = "order-1"
+		_, err := trigger.Execute(context.TODO(), testEvents, trigger.Trigger.Template.AWSSQS)
+		assert.Nil(t, err)
+		assert.Empty(t, client.inputs)
+	})
+}
+
+func TestAWSSQSTrigger_ApplyPolicy(t *testing.T) {
+	trigger := getFakeAWSSQSTrigger(&fakeSQSClient{})
+	err := trigger.ApplyPolicy(context.TODO(), &sqs.SendMessageOutput{MessageId: aws.String("fake-message-id")})
+	assert.Nil(t, err)
+
+	err = trigger.ApplyPolicy(context.TODO(), &sqs.SendMessageOutput{})
+	assert.NotNil(t, err)
+}
+
+func TestAWSSQSTrigger_GetTriggerType(t *testing.T) {
+	trigger := getFakeAWSSQSTrigger(&fakeSQSClient{})
+	assert.Equal(t, apicommon.AWSSQSTrigger, trigger.GetTriggerType())
+}
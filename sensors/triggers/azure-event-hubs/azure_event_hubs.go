@@ -56,11 +56,11 @@ func NewAzureEventHubsTrigger(sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger
 		fqdn := azureEventHubsTrigger.FQDN
 		hubName := azureEventHubsTrigger.HubName
 
-		sharedAccessKeyName, err := common.GetSecretFromVolume(azureEventHubsTrigger.SharedAccessKeyName)
+		sharedAccessKeyName, err := common.GetSecret(azureEventHubsTrigger.SharedAccessKeyName)
 		if err != nil {
 			return nil, err
 		}
-		sharedAccessKey, err := common.GetSecretFromVolume(azureEventHubsTrigger.SharedAccessKey)
+		sharedAccessKey, err := common.GetSecret(azureEventHubsTrigger.SharedAccessKey)
 		if err != nil {
 			return nil, err
 		}
@@ -137,6 +137,15 @@ func (t *AzureEventHubsTrigger) Execute(ctx context.Context, events map[string]*
 	if err != nil {
 		return nil, err
 	}
+	payload, err = triggers.WrapCloudEvent(t.Sensor, t.Trigger, events, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	if t.Trigger.Template.DryRun {
+		t.Logger.Infow("dry run, skipping sending the event to Azure Event Hubs", zap.String("hubName", trigger.HubName), zap.String("payload", string(payload)))
+		return nil, nil
+	}
 
 	if err := t.Hub.Send(ctx, eventhub.NewEvent(payload)); err != nil {
 		return nil, err
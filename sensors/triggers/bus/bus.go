@@ -0,0 +1,247 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package bus
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sort"
+	"strings"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/argoproj/argo-events/common/logging"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sensors/triggers"
+)
+
+// The destinations of the parameters templating the event source and event names
+const (
+	eventSourceNameDest = "eventSourceName"
+	eventNameDest       = "eventName"
+)
+
+func init() {
+	triggers.Register(apicommon.BusTrigger, triggers.Registration{
+		Matches: func(template *v1alpha1.TriggerTemplate) bool {
+			return template.Bus != nil
+		},
+		New: func(deps *triggers.Dependencies, trigger *v1alpha1.Trigger, logger *zap.SugaredLogger) (triggers.Trigger, error) {
+			return NewBusTrigger(deps.EventBus, deps.Sensor, trigger, logger)
+		},
+	})
+}
+
+// BusTrigger refers to trigger that publishes an event to the EventBus the sensor is connected to
+type BusTrigger struct {
+	// Publisher publishes to the EventBus of the sensor
+	Publisher triggers.EventBusPublisher
+	// Sensor object
+	Sensor *v1alpha1.Sensor
+	// Trigger definition
+	Trigger *v1alpha1.Trigger
+	// logger to log stuff
+	Logger *zap.SugaredLogger
+}
+
+// PublishAck is the outcome of the publication of an event, acknowledged or not by the EventBus
+type PublishAck struct {
+	// Subject the event is published to
+	Subject string `json:"subject"`
+	// EventID is the ID of the published event
+	EventID string `json:"eventId"`
+	// ack receives the error of the acknowledgement, nil once the event is acknowledged
+	ack chan error
+}
+
+// NewBusTrigger returns a new bus trigger context
+func NewBusTrigger(publisher triggers.EventBusPublisher, sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger, logger *zap.SugaredLogger) (*BusTrigger, error) {
+	if publisher == nil {
+		return nil, errors.New("the sensor is not connected to an eventbus")
+	}
+	return &BusTrigger{
+		Publisher: publisher,
+		Sensor:    sensor,
+		Trigger:   trigger,
+		Logger:    logger.With(logging.LabelTriggerType, apicommon.BusTrigger),
+	}, nil
+}
+
+// GetTriggerType returns the type of the trigger
+func (t *BusTrigger) GetTriggerType() apicommon.TriggerType {
+	return apicommon.BusTrigger
+}
+
+// FetchResource fetches the trigger resource
+func (t *BusTrigger) FetchResource(ctx context.Context) (interface{}, error) {
+	return t.Trigger.Template.Bus, nil
+}
+
+// ApplyResourceParameters applies parameters to the trigger resource
+func (t *BusTrigger) ApplyResourceParameters(events map[string]*v1alpha1.Event, resource interface{}) (interface{}, error) {
+	fetchedResource, ok := resource.(*v1alpha1.BusTrigger)
+	if !ok {
+		return nil, errors.New("failed to interpret the fetched trigger resource")
+	}
+
+	resourceBytes, err := json.Marshal(fetchedResource)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal the bus trigger resource")
+	}
+	parameters := fetchedResource.Parameters
+	if parameters != nil {
+		updatedResourceBytes, err := triggers.ApplyParams(resourceBytes, parameters, events)
+		if err != nil {
+			return nil, err
+		}
+		var bt *v1alpha1.BusTrigger
+		if err := json.Unmarshal(updatedResourceBytes, &bt); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal the updated bus trigger resource after applying resource parameters")
+		}
+		return bt, nil
+	}
+	return resource, nil
+}
+
+// Execute publishes the event without waiting for its acknowledgement, which ApplyPolicy waits for
+func (t *BusTrigger) Execute(ctx context.Context, events map[string]*v1alpha1.Event, resource interface{}) (interface{}, error) {
+	trigger, ok := resource.(*v1alpha1.BusTrigger)
+	if !ok {
+		return nil, errors.New("failed to interpret the trigger resource")
+	}
+
+	if trigger.Payload == nil {
+		return nil, triggers.NewPermanentError(errors.New("payload parameters are not specified"))
+	}
+	payload, err := triggers.ConstructPayload(events, trigger.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	event := cloudevents.NewEvent()
+	event.SetID(eventID(t.Sensor, t.Trigger.Template.Name, events))
+	event.SetType(triggers.DefaultCloudEventType)
+	event.SetSource(trigger.EventSourceName)
+	event.SetSubject(trigger.EventName)
+	event.SetTime(time.Now())
+	if err := event.SetData(cloudevents.ApplicationJSON, payload); err != nil {
+		return nil, errors.Wrap(err, "failed to set the data of the event")
+	}
+	if err := event.Validate(); err != nil {
+		// The names are resolved from the events, publishing again won't resolve them any better.
+		return nil, triggers.NewPermanentError(errors.Wrap(err, "invalid event"))
+	}
+	body, err := json.Marshal(event)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal the event")
+	}
+
+	subject := trigger.Subject
+	if subject == "" {
+		subject = t.Publisher.Subject()
+	}
+
+	if t.Trigger.Template.DryRun {
+		t.Logger.Infow("dry run, skipping publishing the event", zap.String("subject", subject), zap.String("event", string(body)))
+		return nil, nil
+	}
+
+	result := &PublishAck{Subject: subject, EventID: event.ID(), ack: make(chan error, 1)}
+	if err := t.Publisher.PublishAsync(subject, body, func(err error) {
+		result.ack <- err
+	}); err != nil {
+		return nil, errors.Wrapf(err, "failed to publish the event to %s", subject)
+	}
+	t.Logger.Infow("published the event", zap.String("subject", subject), zap.String("eventID", event.ID()))
+	return result, nil
+}
+
+// ApplyPolicy waits for the EventBus to acknowledge the published event
+func (t *BusTrigger) ApplyPolicy(ctx context.Context, resource interface{}) error {
+	if resource == nil {
+		// dry run
+		return nil
+	}
+	result, ok := resource.(*PublishAck)
+	if !ok {
+		return errors.New("failed to interpret the trigger execution response")
+	}
+	select {
+	case err := <-result.ack:
+		if err != nil {
+			return errors.Wrapf(err, "the eventbus did not acknowledge the event %s published to %s", result.EventID, result.Subject)
+		}
+		return nil
+	case <-ctx.Done():
+		return errors.Wrapf(ctx.Err(), "stopped waiting for the acknowledgement of the event %s published to %s", result.EventID, result.Subject)
+	}
+}
+
+// eventID returns the ID of the published event, the same for the same trigger and events, for the
+// downstream sensors to discard the event published again once the events are redelivered.
+func eventID(sensor *v1alpha1.Sensor, triggerName string, events map[string]*v1alpha1.Event) string {
+	ids := make([]string, 0, len(events))
+	for _, event := range events {
+		if event != nil && event.Context != nil {
+			ids = append(ids, event.Context.ID)
+		}
+	}
+	sort.Strings(ids)
+	keys := []string{triggerName}
+	if sensor != nil {
+		keys = append([]string{sensor.Namespace, sensor.Name}, keys...)
+	}
+	sum := sha256.Sum256([]byte(strings.Join(append(keys, ids...), ",")))
+	return hex.EncodeToString(sum[:16])
+}
+
+// ValidateTrigger checks the bus trigger, the names templated by its parameters are only checked once resolved
+func ValidateTrigger(trigger *v1alpha1.BusTrigger) error {
+	if strings.ContainsAny(trigger.Subject, "*> \t") {
+		return errors.Errorf("invalid subject %q, it can't have wildcards or whitespaces", trigger.Subject)
+	}
+	if trigger.EventSourceName == "" && !hasParameter(trigger.Parameters, eventSourceNameDest) {
+		return errors.New("event source name is not specified")
+	}
+	if trigger.EventName == "" && !hasParameter(trigger.Parameters, eventNameDest) {
+		return errors.New("event name is not specified")
+	}
+	if len(trigger.Payload) == 0 {
+		return errors.New("payload parameters are not specified")
+	}
+	return nil
+}
+
+// HasTemplatedNames tells if the parameters of the trigger template the event source name or the event name
+func HasTemplatedNames(trigger *v1alpha1.BusTrigger) bool {
+	return hasParameter(trigger.Parameters, eventSourceNameDest) || hasParameter(trigger.Parameters, eventNameDest)
+}
+
+// hasParameter tells if a parameter templates the destination
+func hasParameter(parameters []v1alpha1.TriggerParameter, dest string) bool {
+	for _, parameter := range parameters {
+		if parameter.Dest == dest {
+			return true
+		}
+	}
+	return false
+}
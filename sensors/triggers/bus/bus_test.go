@@ -0,0 +1,222 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package bus
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-events/common/logging"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+type fakePublisher struct {
+	subject  string
+	messages [][]byte
+	// ackErr is the error the messages are acknowledged with, they are not acknowledged if ack is false.
+	ack    bool
+	ackErr error
+}
+
+func (p *fakePublisher) Subject() string {
+	return "eventbus-fake"
+}
+
+func (p *fakePublisher) PublishAsync(subject string, message []byte, ackHandler func(err error)) error {
+	p.subject = subject
+	p.messages = append(p.messages, message)
+	if p.ack {
+		ackHandler(p.ackErr)
+	}
+	return nil
+}
+
+var sensorObj = &v1alpha1.Sensor{
+	ObjectMeta: metav1.ObjectMeta{
+		Name:      "fake-sensor",
+		Namespace: "fake",
+	},
+	Spec: v1alpha1.SensorSpec{
+		Triggers: []v1alpha1.Trigger{
+			{
+				Template: &v1alpha1.TriggerTemplate{
+					Name: "fake-trigger",
+					Bus: &v1alpha1.BusTrigger{
+						EventSourceName: "orders",
+						EventName:       "order-paid",
+						Payload: []v1alpha1.TriggerParameter{
+							{Src: &v1alpha1.TriggerParameterSource{DependencyName: "payment", DataKey: "order"}, Dest: "order"},
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+var events = map[string]*v1alpha1.Event{
+	"payment": {
+		Context: &v1alpha1.EventContext{ID: "1", DataContentType: "application/json"},
+		Data:    []byte(`{"order": "o-1", "amount": 42}`),
+	},
+}
+
+func getBusTrigger(t *testing.T, publisher *fakePublisher) *BusTrigger {
+	t.Helper()
+	bt, err := NewBusTrigger(publisher, sensorObj, sensorObj.Spec.Triggers[0].DeepCopy(), logging.NewArgoEventsLogger())
+	assert.Nil(t, err)
+	return bt
+}
+
+func TestNewBusTrigger(t *testing.T) {
+	_, err := NewBusTrigger(nil, sensorObj, sensorObj.Spec.Triggers[0].DeepCopy(), logging.NewArgoEventsLogger())
+	assert.NotNil(t, err)
+}
+
+func TestBusTrigger_Execute(t *testing.T) {
+	publisher := &fakePublisher{ack: true}
+	trigger := getBusTrigger(t, publisher)
+	resource, err := trigger.FetchResource(context.TODO())
+	assert.Nil(t, err)
+	resource, err = trigger.ApplyResourceParameters(events, resource)
+	assert.Nil(t, err)
+	result, err := trigger.Execute(context.TODO(), events, resource)
+	assert.Nil(t, err)
+	assert.Nil(t, trigger.ApplyPolicy(context.TODO(), result))
+
+	assert.Equal(t, "eventbus-fake", publisher.subject)
+	assert.Len(t, publisher.messages, 1)
+	var event cloudevents.Event
+	assert.Nil(t, json.Unmarshal(publisher.messages[0], &event))
+	assert.Equal(t, "orders", event.Source())
+	assert.Equal(t, "order-paid", event.Subject())
+	assert.Equal(t, result.(*PublishAck).EventID, event.ID())
+	assert.JSONEq(t, `{"order": "o-1"}`, string(event.Data()))
+
+	t.Run("same id for the same events", func(t *testing.T) {
+		result, err := trigger.Execute(context.TODO(), events, resource)
+		assert.Nil(t, err)
+		assert.Equal(t, event.ID(), result.(*PublishAck).EventID)
+	})
+
+	t.Run("custom subject", func(t *testing.T) {
+		bt := trigger.Trigger.Template.Bus.DeepCopy()
+		bt.Subject = "eventbus-downstream"
+		_, err := trigger.Execute(context.TODO(), events, bt)
+		assert.Nil(t, err)
+		assert.Equal(t, "eventbus-downstream", publisher.subject)
+	})
+
+	t.Run("templated event name", func(t *testing.T) {
+		trigger := getBusTrigger(t, publisher)
+		trigger.Trigger.Template.Bus.Parameters = []v1alpha1.TriggerParameter{
+			{Src: &v1alpha1.TriggerParameterSource{DependencyName: "payment", DataTemplate: "order-{{ .Input.order }}"}, Dest: "eventName"},
+		}
+		resource, err := trigger.ApplyResourceParameters(events, trigger.Trigger.Template.Bus)
+		assert.Nil(t, err)
+		result, err := trigger.Execute(context.TODO(), events, resource)
+		assert.Nil(t, err)
+		assert.Nil(t, trigger.ApplyPolicy(context.TODO(), result))
+		var event cloudevents.Event
+		assert.Nil(t, json.Unmarshal(publisher.messages[len(publisher.messages)-1], &event))
+		assert.Equal(t, "order-o-1", event.Subject())
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		publisher := &fakePublisher{ack: true}
+		trigger := getBusTrigger(t, publisher)
+		trigger.Trigger.Template.DryRun = true
+		result, err := trigger.Execute(context.TODO(), events, trigger.Trigger.Template.Bus)
+		assert.Nil(t, err)
+		assert.Nil(t, result)
+		assert.Nil(t, trigger.ApplyPolicy(context.TODO(), result))
+		assert.Empty(t, publisher.messages)
+	})
+}
+
+func TestBusTrigger_ApplyPolicy(t *testing.T) {
+	t.Run("not acknowledged", func(t *testing.T) {
+		trigger := getBusTrigger(t, &fakePublisher{ack: true, ackErr: errors.New("nats: timeout")})
+		result, err := trigger.Execute(context.TODO(), events, trigger.Trigger.Template.Bus)
+		assert.Nil(t, err)
+		err = trigger.ApplyPolicy(context.TODO(), result)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "the eventbus did not acknowledge the event")
+		assert.Contains(t, err.Error(), "nats: timeout")
+	})
+
+	t.Run("cancelled", func(t *testing.T) {
+		trigger := getBusTrigger(t, &fakePublisher{})
+		result, err := trigger.Execute(context.TODO(), events, trigger.Trigger.Template.Bus)
+		assert.Nil(t, err)
+		ctx, cancel := context.WithCancel(context.TODO())
+		cancel()
+		err = trigger.ApplyPolicy(ctx, result)
+		assert.NotNil(t, err)
+		assert.True(t, errors.Is(err, context.Canceled))
+	})
+}
+
+func TestEventID(t *testing.T) {
+	id := eventID(sensorObj, "fake-trigger", events)
+	assert.Len(t, id, 32)
+	assert.NotEqual(t, id, eventID(sensorObj, "other-trigger", events))
+	assert.NotEqual(t, id, eventID(sensorObj, "fake-trigger", map[string]*v1alpha1.Event{
+		"payment": {Context: &v1alpha1.EventContext{ID: "2"}},
+	}))
+}
+
+func TestValidateTrigger(t *testing.T) {
+	newTrigger := func() *v1alpha1.BusTrigger {
+		return sensorObj.Spec.Triggers[0].Template.Bus.DeepCopy()
+	}
+	assert.Nil(t, ValidateTrigger(newTrigger()))
+
+	tests := []struct {
+		name   string
+		mutate func(trigger *v1alpha1.BusTrigger)
+		err    string
+	}{
+		{"wildcard subject", func(trigger *v1alpha1.BusTrigger) { trigger.Subject = "eventbus-*" }, "it can't have wildcards"},
+		{"no event source name", func(trigger *v1alpha1.BusTrigger) { trigger.EventSourceName = "" }, "event source name is not specified"},
+		{"no event name", func(trigger *v1alpha1.BusTrigger) { trigger.EventName = "" }, "event name is not specified"},
+		{"no payload", func(trigger *v1alpha1.BusTrigger) { trigger.Payload = nil }, "payload parameters are not specified"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			trigger := newTrigger()
+			test.mutate(trigger)
+			err := ValidateTrigger(trigger)
+			assert.NotNil(t, err)
+			assert.Contains(t, err.Error(), test.err)
+		})
+	}
+
+	t.Run("templated event name", func(t *testing.T) {
+		trigger := newTrigger()
+		trigger.EventName = ""
+		assert.False(t, HasTemplatedNames(trigger))
+		trigger.Parameters = []v1alpha1.TriggerParameter{{Dest: "eventName"}}
+		assert.Nil(t, ValidateTrigger(trigger))
+		assert.True(t, HasTemplatedNames(trigger))
+	})
+}
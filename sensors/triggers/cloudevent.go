@@ -0,0 +1,166 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package triggers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+// CloudEventContentType is the media type of the payloads wrapped in a CloudEvents structured JSON envelope
+const CloudEventContentType = "application/cloudevents+json"
+
+// DefaultCloudEventType is the type of the CloudEvents wrapping a payload constructed from several events
+const DefaultCloudEventType = "io.argoproj.sensor.trigger"
+
+// WrapCloudEvent wraps the payload in a CloudEvents 1.0 structured JSON envelope if the trigger has a cloud
+// event, and returns it as is otherwise. The payload is the data of the CloudEvent, as is if it is JSON, base64
+// encoded otherwise. The attributes the trigger doesn't specify are taken from the event the payload is
+// constructed from, or from the sensor if there are several events.
+func WrapCloudEvent(sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger, events map[string]*v1alpha1.Event, payload []byte) ([]byte, error) {
+	if trigger == nil || trigger.Template == nil || trigger.Template.CloudEvent == nil {
+		return payload, nil
+	}
+	envelope := trigger.Template.CloudEvent
+	contexts := eventContexts(events)
+
+	event := cloudevents.NewEvent(cloudevents.VersionV1)
+	event.SetID(cloudEventID(contexts))
+	event.SetTime(cloudEventTime(contexts))
+	event.SetType(envelope.Type)
+	event.SetSource(envelope.Source)
+	event.SetSubject(envelope.Subject)
+	if len(contexts) == 1 {
+		if envelope.Type == "" {
+			event.SetType(contexts[0].Type)
+		}
+		if envelope.Source == "" {
+			event.SetSource(contexts[0].Source)
+		}
+		if envelope.Subject == "" {
+			event.SetSubject(contexts[0].Subject)
+		}
+	}
+	if event.Type() == "" {
+		event.SetType(DefaultCloudEventType)
+	}
+	if event.Source() == "" && sensor != nil {
+		event.SetSource(fmt.Sprintf("/namespaces/%s/sensors/%s", sensor.Namespace, sensor.Name))
+	}
+	for name, value := range envelope.Extensions {
+		event.SetExtension(name, value)
+	}
+	if len(payload) > 0 {
+		isJSON := json.Valid(payload)
+		contentType := cloudevents.ApplicationJSON
+		if !isJSON {
+			contentType = "application/octet-stream"
+		}
+		if err := event.SetData(contentType, payload); err != nil {
+			return nil, fmt.Errorf("failed to set the data of the cloud event, %w", err)
+		}
+		// The JSON data is embedded as is in the envelope, the rest is base64 encoded.
+		event.DataBase64 = !isJSON
+	}
+	if err := event.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid cloud event, %w", err)
+	}
+	return json.Marshal(event)
+}
+
+// ValidateCloudEvent checks the attributes of the cloud event of a trigger, the templated ones are only checked
+// once resolved.
+func ValidateCloudEvent(envelope *v1alpha1.CloudEventEnvelope) error {
+	if envelope == nil {
+		return nil
+	}
+	for name := range envelope.Extensions {
+		if err := validateExtensionName(name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateExtensionName checks the name of an extension attribute is lowercase alphanumeric and not one of
+// the attributes of the envelope, as required by the CloudEvents specification.
+func validateExtensionName(name string) error {
+	if name == "" {
+		return fmt.Errorf("the name of an extension can't be empty")
+	}
+	for _, c := range name {
+		if (c < 'a' || c > 'z') && (c < '0' || c > '9') {
+			return fmt.Errorf("invalid extension name %q, it must be lowercase alphanumeric", name)
+		}
+	}
+	switch name {
+	case "id", "source", "specversion", "type", "datacontenttype", "dataschema", "subject", "time", "data", "data_base64":
+		return fmt.Errorf("invalid extension name %q, it is a context attribute", name)
+	}
+	return nil
+}
+
+// eventContexts returns the contexts of the events sorted by their IDs
+func eventContexts(events map[string]*v1alpha1.Event) []*v1alpha1.EventContext {
+	result := make([]*v1alpha1.EventContext, 0, len(events))
+	for _, event := range events {
+		if event != nil && event.Context != nil {
+			result = append(result, event.Context)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ID < result[j].ID
+	})
+	return result
+}
+
+// cloudEventID returns the ID of the event if there is only one, a digest of the IDs of the events otherwise,
+// for the redelivered events to be wrapped in a CloudEvent with the same ID.
+func cloudEventID(contexts []*v1alpha1.EventContext) string {
+	if len(contexts) == 1 && contexts[0].ID != "" {
+		return contexts[0].ID
+	}
+	ids := make([]string, 0, len(contexts))
+	for _, c := range contexts {
+		ids = append(ids, c.ID)
+	}
+	sum := sha256.Sum256([]byte(strings.Join(ids, ",")))
+	return hex.EncodeToString(sum[:16])
+}
+
+// cloudEventTime returns the time of the latest event, or the current time without any.
+func cloudEventTime(contexts []*v1alpha1.EventContext) time.Time {
+	var result time.Time
+	for _, c := range contexts {
+		if c.Time.Time.After(result) {
+			result = c.Time.Time
+		}
+	}
+	if result.IsZero() {
+		return time.Now().UTC()
+	}
+	return result
+}
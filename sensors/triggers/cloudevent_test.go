@@ -0,0 +1,136 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package triggers
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+func TestWrapCloudEvent(t *testing.T) {
+	sensor := &v1alpha1.Sensor{ObjectMeta: metav1.ObjectMeta{Name: "fake-sensor", Namespace: "fake-namespace"}}
+	eventTime := metav1.NewTime(time.Date(2022, 6, 1, 12, 0, 0, 0, time.UTC))
+	events := map[string]*v1alpha1.Event{
+		"dep": {
+			Context: &v1alpha1.EventContext{ID: "event-1", Source: "webhook", Type: "webhook", Subject: "example", Time: eventTime},
+			Data:    []byte(`{"name": "foo"}`),
+		},
+	}
+	newTrigger := func(envelope *v1alpha1.CloudEventEnvelope) *v1alpha1.Trigger {
+		return &v1alpha1.Trigger{Template: &v1alpha1.TriggerTemplate{Name: "fake-trigger", CloudEvent: envelope}}
+	}
+	// unwrap parses the envelope with the SDK, which validates it against the CloudEvents spec.
+	unwrap := func(t *testing.T, wrapped []byte) cloudevents.Event {
+		t.Helper()
+		event := cloudevents.NewEvent()
+		assert.Nil(t, json.Unmarshal(wrapped, &event))
+		assert.Nil(t, event.Validate())
+		return event
+	}
+
+	t.Run("without cloud event", func(t *testing.T) {
+		payload := []byte(`{"name": "foo"}`)
+		wrapped, err := WrapCloudEvent(sensor, newTrigger(nil), events, payload)
+		assert.Nil(t, err)
+		assert.Equal(t, payload, wrapped)
+	})
+
+	t.Run("attributes of the event", func(t *testing.T) {
+		wrapped, err := WrapCloudEvent(sensor, newTrigger(&v1alpha1.CloudEventEnvelope{}), events, []byte(`{"name":"foo"}`))
+		assert.Nil(t, err)
+		event := unwrap(t, wrapped)
+		assert.Equal(t, cloudevents.VersionV1, event.SpecVersion())
+		assert.Equal(t, "event-1", event.ID())
+		assert.Equal(t, "webhook", event.Source())
+		assert.Equal(t, "webhook", event.Type())
+		assert.Equal(t, "example", event.Subject())
+		assert.True(t, eventTime.Time.Equal(event.Time()))
+		assert.Equal(t, cloudevents.ApplicationJSON, event.DataContentType())
+		assert.JSONEq(t, `{"name":"foo"}`, string(event.Data()))
+
+		var envelope map[string]interface{}
+		assert.Nil(t, json.Unmarshal(wrapped, &envelope))
+		assert.Equal(t, map[string]interface{}{"name": "foo"}, envelope["data"])
+	})
+
+	t.Run("attributes of the trigger", func(t *testing.T) {
+		wrapped, err := WrapCloudEvent(sensor, newTrigger(&v1alpha1.CloudEventEnvelope{
+			Type:       "com.example.order.created",
+			Source:     "/orders",
+			Subject:    "order-1",
+			Extensions: map[string]string{"tenant": "acme"},
+		}), events, []byte(`{"name":"foo"}`))
+		assert.Nil(t, err)
+		event := unwrap(t, wrapped)
+		assert.Equal(t, "com.example.order.created", event.Type())
+		assert.Equal(t, "/orders", event.Source())
+		assert.Equal(t, "order-1", event.Subject())
+		assert.Equal(t, "acme", event.Extensions()["tenant"])
+	})
+
+	t.Run("several events", func(t *testing.T) {
+		later := metav1.NewTime(eventTime.Add(time.Minute))
+		several := map[string]*v1alpha1.Event{
+			"dep": events["dep"],
+			"other": {
+				Context: &v1alpha1.EventContext{ID: "event-2", Source: "calendar", Type: "calendar", Time: later},
+			},
+		}
+		wrapped, err := WrapCloudEvent(sensor, newTrigger(&v1alpha1.CloudEventEnvelope{}), several, []byte(`{}`))
+		assert.Nil(t, err)
+		event := unwrap(t, wrapped)
+		assert.Equal(t, DefaultCloudEventType, event.Type())
+		assert.Equal(t, "/namespaces/fake-namespace/sensors/fake-sensor", event.Source())
+		assert.True(t, later.Time.Equal(event.Time()))
+		assert.NotEqual(t, "event-1", event.ID())
+
+		again, err := WrapCloudEvent(sensor, newTrigger(&v1alpha1.CloudEventEnvelope{}), several, []byte(`{}`))
+		assert.Nil(t, err)
+		assert.Equal(t, event.ID(), unwrap(t, again).ID())
+	})
+
+	t.Run("data which is not JSON", func(t *testing.T) {
+		wrapped, err := WrapCloudEvent(sensor, newTrigger(&v1alpha1.CloudEventEnvelope{}), events, []byte("plain text"))
+		assert.Nil(t, err)
+		var envelope map[string]interface{}
+		assert.Nil(t, json.Unmarshal(wrapped, &envelope))
+		assert.Nil(t, envelope["data"])
+		assert.Equal(t, base64.StdEncoding.EncodeToString([]byte("plain text")), envelope["data_base64"])
+		assert.Equal(t, "plain text", string(unwrap(t, wrapped).Data()))
+	})
+}
+
+func TestValidateCloudEvent(t *testing.T) {
+	assert.Nil(t, ValidateCloudEvent(nil))
+	assert.Nil(t, ValidateCloudEvent(&v1alpha1.CloudEventEnvelope{Extensions: map[string]string{"tenant": "acme", "region1": "eu"}}))
+
+	err := ValidateCloudEvent(&v1alpha1.CloudEventEnvelope{Extensions: map[string]string{"Tenant-ID": "acme"}})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "lowercase alphanumeric")
+
+	err = ValidateCloudEvent(&v1alpha1.CloudEventEnvelope{Extensions: map[string]string{"source": "acme"}})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "context attribute")
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package triggers
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/checker/decls"
+	"github.com/pkg/errors"
+	"github.com/tidwall/gjson"
+	exprpb "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+// conditionEventsVariable is the name of the variable holding the events in a trigger condition
+const conditionEventsVariable = "events"
+
+// responseVariable is the name of the variable holding the response in a response condition
+const responseVariable = "response"
+
+var (
+	// responseConditionsLock guards the compiled response conditions, shared by the concurrent executions.
+	responseConditionsLock sync.Mutex
+	// responseConditions are the compiled response conditions, keyed by their expressions.
+	responseConditions = make(map[string]cel.Program)
+)
+
+// newEventsEnv returns the CEL environment of the expressions evaluated against the events.
+func newEventsEnv() (*cel.Env, error) {
+	env, err := cel.NewEnv(
+		cel.Declarations(decls.NewVar(conditionEventsVariable, decls.NewMapType(decls.String, decls.Dyn))),
+		cel.CrossTypeNumericComparisons(true),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create the CEL environment")
+	}
+	return env, nil
+}
+
+// NewCondition compiles the CEL expression of a trigger condition, which must evaluate to a bool.
+func NewCondition(expression string) (cel.Program, error) {
+	env, err := newEventsEnv()
+	if err != nil {
+		return nil, err
+	}
+	ast, issues := env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, issues.Err()
+	}
+	// A dyn result, e.g. events["dep"].body.enabled, can only be checked when evaluated.
+	if resultType := ast.ResultType(); resultType.GetPrimitive() != exprpb.Type_BOOL && resultType.GetDyn() == nil {
+		return nil, errors.Errorf("the expression must evaluate to a bool, got %v", resultType)
+	}
+	return env.Program(ast)
+}
+
+// EvaluateCondition evaluates the condition against the data of the events, keyed by their dependency names.
+func EvaluateCondition(program cel.Program, events map[string]*v1alpha1.Event) (bool, error) {
+	out, _, err := program.Eval(map[string]interface{}{
+		conditionEventsVariable: eventsData(events),
+	})
+	if err != nil {
+		return false, err
+	}
+	result, ok := out.Value().(bool)
+	if !ok {
+		return false, errors.Errorf("the condition evaluated to %v instead of a bool", out.Value())
+	}
+	return result, nil
+}
+
+// NewPartitionKey compiles the CEL expression of the partition key of a sensor, which must evaluate to a
+// string, a number or a bool.
+func NewPartitionKey(expression string) (cel.Program, error) {
+	env, err := newEventsEnv()
+	if err != nil {
+		return nil, err
+	}
+	ast, issues := env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, issues.Err()
+	}
+	if resultType := ast.ResultType(); resultType.GetDyn() == nil {
+		switch resultType.GetPrimitive() {
+		case exprpb.Type_STRING, exprpb.Type_INT64, exprpb.Type_UINT64, exprpb.Type_DOUBLE, exprpb.Type_BOOL:
+		default:
+			return nil, errors.Errorf("the expression must evaluate to a string, a number or a bool, got %v", resultType)
+		}
+	}
+	return env.Program(ast)
+}
+
+// EvaluatePartitionKey evaluates the partition key against the data of the events, keyed by their dependency names.
+func EvaluatePartitionKey(program cel.Program, events map[string]*v1alpha1.Event) (string, error) {
+	out, _, err := program.Eval(map[string]interface{}{
+		conditionEventsVariable: eventsData(events),
+	})
+	if err != nil {
+		return "", err
+	}
+	switch value := out.Value().(type) {
+	case string:
+		return value, nil
+	case int64, uint64, float64, bool:
+		return fmt.Sprint(value), nil
+	default:
+		return "", errors.Errorf("the partition key evaluated to %v instead of a string, a number or a bool", out.Value())
+	}
+}
+
+// NewResponseCondition compiles the CEL expression of a condition on the response of a trigger, which must
+// evaluate to a bool, e.g. `response.status == 500 && response.body.retryable == true`.
+func NewResponseCondition(expression string) (cel.Program, error) {
+	env, err := cel.NewEnv(
+		cel.Declarations(decls.NewVar(responseVariable, decls.NewMapType(decls.String, decls.Dyn))),
+		cel.CrossTypeNumericComparisons(true),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create the CEL environment")
+	}
+	ast, issues := env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, issues.Err()
+	}
+	if resultType := ast.ResultType(); resultType.GetPrimitive() != exprpb.Type_BOOL && resultType.GetDyn() == nil {
+		return nil, errors.Errorf("the expression must evaluate to a bool, got %v", resultType)
+	}
+	return env.Program(ast)
+}
+
+// EvaluateResponseCondition evaluates the condition against the status and the body of a response, the body
+// being decoded if it is JSON, and a string otherwise. The conditions are compiled once, on their first evaluation.
+func EvaluateResponseCondition(expression string, status int, body []byte) (bool, error) {
+	program, err := getResponseCondition(expression)
+	if err != nil {
+		return false, errors.Wrap(err, "invalid response condition")
+	}
+	var decoded interface{} = string(body)
+	if gjson.ValidBytes(body) {
+		decoded = gjson.ParseBytes(body).Value()
+	}
+	out, _, err := program.Eval(map[string]interface{}{
+		responseVariable: map[string]interface{}{"status": int64(status), "body": decoded},
+	})
+	if err != nil {
+		return false, err
+	}
+	result, ok := out.Value().(bool)
+	if !ok {
+		return false, errors.Errorf("the response condition evaluated to %v instead of a bool", out.Value())
+	}
+	return result, nil
+}
+
+func getResponseCondition(expression string) (cel.Program, error) {
+	responseConditionsLock.Lock()
+	defer responseConditionsLock.Unlock()
+	if program, ok := responseConditions[expression]; ok {
+		return program, nil
+	}
+	program, err := NewResponseCondition(expression)
+	if err != nil {
+		return nil, err
+	}
+	responseConditions[expression] = program
+	return program, nil
+}
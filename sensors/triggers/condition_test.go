@@ -0,0 +1,164 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package triggers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+func TestNewCondition(t *testing.T) {
+	t.Run("test valid", func(t *testing.T) {
+		_, err := NewCondition(`events["dep"].body.amount > 1000 && events["dep"].body.currency == "USD"`)
+		assert.Nil(t, err)
+		_, err = NewCondition(`events["dep"].body.enabled`)
+		assert.Nil(t, err)
+	})
+
+	t.Run("test syntax error", func(t *testing.T) {
+		_, err := NewCondition(`events["dep"].body.amount >`)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("test undeclared variable", func(t *testing.T) {
+		_, err := NewCondition(`dep.body.amount > 1000`)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("test not a bool", func(t *testing.T) {
+		_, err := NewCondition(`"amount"`)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "must evaluate to a bool")
+	})
+}
+
+func TestEvaluateCondition(t *testing.T) {
+	events := map[string]*v1alpha1.Event{
+		"dep": {
+			Context: &v1alpha1.EventContext{
+				ID:              "1",
+				DataContentType: common.MediaTypeJSON,
+			},
+			Data: []byte(`{"body": {"amount": 1500, "enabled": "yes"}}`),
+		},
+	}
+
+	tests := []struct {
+		expression string
+		matched    bool
+		hasError   bool
+	}{
+		{expression: `events["dep"].body.amount > 1000`, matched: true},
+		{expression: `events["dep"].body.amount > 2000`, matched: false},
+		{expression: `events.dep.body.amount >= 1500.0`, matched: true},
+		{expression: `"other" in events && events["other"].body.amount > 1000`, matched: false},
+		{expression: `events["other"].body.amount > 1000`, hasError: true},
+		{expression: `events["dep"].body.enabled`, hasError: true},
+	}
+	for _, test := range tests {
+		program, err := NewCondition(test.expression)
+		assert.Nil(t, err, test.expression)
+		matched, err := EvaluateCondition(program, events)
+		if test.hasError {
+			assert.NotNil(t, err, test.expression)
+			continue
+		}
+		assert.Nil(t, err, test.expression)
+		assert.Equal(t, test.matched, matched, test.expression)
+	}
+}
+
+func TestPartitionKey(t *testing.T) {
+	events := map[string]*v1alpha1.Event{
+		"dep": {
+			Context: &v1alpha1.EventContext{
+				ID:              "1",
+				DataContentType: common.MediaTypeJSON,
+			},
+			Data: []byte(`{"body": {"customer": "c-1", "account": 1500, "vip": true, "tags": ["a"]}}`),
+		},
+	}
+
+	tests := []struct {
+		expression string
+		key        string
+		hasError   bool
+	}{
+		{expression: `events["dep"].body.customer`, key: "c-1"},
+		{expression: `events["dep"].body.account`, key: "1500"},
+		{expression: `events["dep"].body.vip`, key: "true"},
+		{expression: `events["dep"].body.customer + "/" + string(events["dep"].body.account)`, key: "c-1/1500"},
+		{expression: `events["dep"].body.tags`, hasError: true},
+		{expression: `events["dep"].body.missing`, hasError: true},
+	}
+	for _, test := range tests {
+		program, err := NewPartitionKey(test.expression)
+		assert.Nil(t, err, test.expression)
+		key, err := EvaluatePartitionKey(program, events)
+		if test.hasError {
+			assert.NotNil(t, err, test.expression)
+			continue
+		}
+		assert.Nil(t, err, test.expression)
+		assert.Equal(t, test.key, key, test.expression)
+	}
+
+	_, err := NewPartitionKey(`["a"]`)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "must evaluate to a string, a number or a bool")
+	_, err = NewPartitionKey(`events["dep"].body.customer ==`)
+	assert.NotNil(t, err)
+}
+
+func TestEvaluateResponseCondition(t *testing.T) {
+	tests := []struct {
+		expression string
+		status     int
+		body       string
+		matched    bool
+		hasError   bool
+	}{
+		{expression: `response.body.retryable == true`, status: 200, body: `{"retryable": true}`, matched: true},
+		{expression: `response.body.retryable == true`, status: 200, body: `{"retryable": false}`, matched: false},
+		{expression: `has(response.body.retryable) && response.body.retryable`, status: 200, body: `{}`, matched: false},
+		{expression: `response.status >= 500 && response.body.error.code == "UNAVAILABLE"`, status: 503, body: `{"error": {"code": "UNAVAILABLE"}}`, matched: true},
+		{expression: `response.body.contains("try again")`, status: 500, body: `please try again later`, matched: true},
+		{expression: `response.body.retryable`, status: 200, body: `{}`, hasError: true},
+		{expression: `response.body.retryable`, status: 200, body: `{"retryable": "yes"}`, hasError: true},
+	}
+	for _, test := range tests {
+		matched, err := EvaluateResponseCondition(test.expression, test.status, []byte(test.body))
+		if test.hasError {
+			assert.NotNil(t, err, test.expression)
+			continue
+		}
+		assert.Nil(t, err, test.expression)
+		assert.Equal(t, test.matched, matched, test.expression)
+	}
+
+	t.Run("test invalid expression", func(t *testing.T) {
+		_, err := NewResponseCondition(`events["dep"].body.retryable`)
+		assert.NotNil(t, err)
+		_, err = EvaluateResponseCondition(`response.body.retryable ==`, 200, nil)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "invalid response condition")
+	})
+}
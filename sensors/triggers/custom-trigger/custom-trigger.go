@@ -194,10 +194,19 @@ func (ct *CustomTrigger) Execute(ctx context.Context, events map[string]*v1alpha
 		if err != nil {
 			return nil, err
 		}
+		payload, err = triggers.WrapCloudEvent(ct.Sensor, ct.Trigger, events, payload)
+		if err != nil {
+			return nil, err
+		}
 
 		ct.Logger.Debugw("payload for the trigger execution", zap.Any("payload", string(payload)))
 	}
 
+	if ct.Trigger.Template.DryRun {
+		ct.Logger.Infow("dry run, skipping the custom trigger execution", zap.String("resource", string(obj)), zap.String("payload", string(payload)))
+		return nil, nil
+	}
+
 	result, err := ct.triggerClient.Execute(context.Background(), &triggers.ExecuteRequest{
 		Resource: obj,
 		Payload:  payload,
@@ -0,0 +1,81 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package triggers
+
+import (
+	"github.com/pkg/errors"
+)
+
+// ErrPayloadMissing is returned by the triggers requiring a payload when it is not specified
+var ErrPayloadMissing = NewPermanentError(errors.New("payload parameters are not specified"))
+
+// RetryableError is a transient failure of a trigger execution, e.g. the target being throttled
+// or unavailable, which may succeed if the execution is retried.
+type RetryableError struct {
+	err error
+}
+
+// NewRetryableError marks the error as retryable, nil if the error is nil
+func NewRetryableError(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &RetryableError{err: err}
+}
+
+func (e *RetryableError) Error() string {
+	return e.err.Error()
+}
+
+func (e *RetryableError) Unwrap() error {
+	return e.err
+}
+
+// PermanentError is a failure of a trigger execution which retrying won't fix, e.g. a missing
+// payload or a request rejected by the target. It is not retried, and does not count as a failure
+// of the target for the circuit breaker.
+type PermanentError struct {
+	err error
+}
+
+// NewPermanentError marks the error as permanent, nil if the error is nil
+func NewPermanentError(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &PermanentError{err: err}
+}
+
+func (e *PermanentError) Error() string {
+	return e.err.Error()
+}
+
+func (e *PermanentError) Unwrap() error {
+	return e.err
+}
+
+// IsRetryableError returns true if the error, or an error it wraps, is a RetryableError
+func IsRetryableError(err error) bool {
+	var retryableErr *RetryableError
+	return errors.As(err, &retryableErr)
+}
+
+// IsPermanentError returns true if the error, or an error it wraps, is a PermanentError
+func IsPermanentError(err error) bool {
+	var permanentErr *PermanentError
+	return errors.As(err, &permanentErr)
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package triggers
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClassifiedErrors(t *testing.T) {
+	cause := errors.New("fake error")
+
+	t.Run("retryable", func(t *testing.T) {
+		err := errors.Wrap(NewRetryableError(cause), "failed to execute trigger")
+		assert.True(t, IsRetryableError(err))
+		assert.False(t, IsPermanentError(err))
+		assert.True(t, errors.Is(err, cause))
+		assert.Equal(t, "failed to execute trigger: fake error", err.Error())
+	})
+
+	t.Run("permanent", func(t *testing.T) {
+		err := errors.Wrap(NewPermanentError(cause), "failed to execute trigger")
+		assert.True(t, IsPermanentError(err))
+		assert.False(t, IsRetryableError(err))
+		assert.True(t, errors.Is(err, cause))
+	})
+
+	t.Run("payload missing", func(t *testing.T) {
+		err := errors.Wrap(ErrPayloadMissing, "failed to execute trigger")
+		assert.True(t, errors.Is(err, ErrPayloadMissing))
+		assert.True(t, IsPermanentError(err))
+	})
+
+	t.Run("unclassified", func(t *testing.T) {
+		assert.False(t, IsRetryableError(cause))
+		assert.False(t, IsPermanentError(cause))
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		assert.Nil(t, NewRetryableError(nil))
+		assert.Nil(t, NewPermanentError(nil))
+	})
+}
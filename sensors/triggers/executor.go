@@ -0,0 +1,159 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package triggers
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/common/tracing"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	"github.com/argoproj/argo-events/sensors/policy"
+)
+
+// DefaultRetryOn are the response statuses the requests of the HTTP based triggers are retried on by default,
+// i.e. throttling and the transient server side errors.
+var DefaultRetryOn = []string{"429", "500", "502", "503", "504"}
+
+// HTTPRequest is a request of an HTTP based trigger
+type HTTPRequest struct {
+	Method string
+	URL    string
+	Header http.Header
+	Body   []byte
+}
+
+// HTTPExecutor sends the requests of the HTTP based triggers, e.g. the HTTP trigger and the 2nd gen Cloud
+// Functions, and reads their responses.
+type HTTPExecutor struct {
+	// Client sends the requests
+	Client *http.Client
+	// MaxResponseSize is the max size of the response bodies, see ReadResponse
+	MaxResponseSize int64
+	// Backoff is the backoff of the retries of a request, nil for a single attempt
+	Backoff *wait.Backoff
+	// RetryOn are the response statuses the requests are retried on
+	RetryOn []policy.StatusRange
+	// Logger logs the retries
+	Logger *zap.SugaredLogger
+}
+
+// NewHTTPExecutor returns the executor of the requests with the client, retrying them with the retry strategy
+// on the network errors and on the response statuses of retryOn, DefaultRetryOn if empty. A nil retry strategy
+// makes a single attempt.
+func NewHTTPExecutor(client *http.Client, maxResponseSize int64, retryStrategy *apicommon.Backoff, retryOn []string, logger *zap.SugaredLogger) (*HTTPExecutor, error) {
+	e := &HTTPExecutor{Client: client, MaxResponseSize: maxResponseSize, Logger: logger}
+	if retryStrategy != nil {
+		backoff, err := common.Convert2WaitBackoff(retryStrategy)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid retry strategy")
+		}
+		e.Backoff = backoff
+	}
+	if len(retryOn) == 0 {
+		retryOn = DefaultRetryOn
+	}
+	ranges, err := policy.ParseStatusRanges(retryOn)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid retry statuses")
+	}
+	e.RetryOn = ranges
+	return e, nil
+}
+
+// Do sends the request, with the tracing headers of the context, and reads its response with ReadResponse for its
+// body to be read again. The network errors, but the ones of the context, and the responses with a status to retry
+// on are retried with the backoff. The response of the last attempt is returned whatever its status, for the policy
+// of the trigger to be applied to it. A response exceeding the max response size is returned, without its body, with
+// a ResponseTooLargeError, which is not retried.
+func (e *HTTPExecutor) Do(ctx context.Context, req *HTTPRequest) (*http.Response, error) {
+	backoff := wait.Backoff{Steps: 1}
+	if e.Backoff != nil {
+		backoff = *e.Backoff
+	}
+	var response *http.Response
+	var doErr error
+	waitErr := wait.ExponentialBackoffWithContext(ctx, backoff, func() (bool, error) {
+		response, doErr = e.do(ctx, req)
+		switch {
+		case doErr != nil && (IsResponseTooLargeError(doErr) || ctx.Err() != nil):
+			return false, doErr
+		case doErr != nil:
+			e.logRetry(req, zap.Error(doErr))
+			return false, nil
+		case e.retryOn(response.StatusCode):
+			e.logRetry(req, zap.Int("statusCode", response.StatusCode))
+			return false, nil
+		default:
+			return true, nil
+		}
+	})
+	switch {
+	case waitErr == nil:
+		return response, nil
+	case doErr != nil:
+		return response, doErr
+	case response != nil && !errors.Is(waitErr, context.Canceled) && !errors.Is(waitErr, context.DeadlineExceeded):
+		// The retries are exhausted, the last response is the outcome of the request.
+		return response, nil
+	default:
+		return nil, errors.Wrapf(waitErr, "failed to send the request to %s", req.URL)
+	}
+}
+
+// do makes an attempt of the request
+func (e *HTTPExecutor) do(ctx context.Context, req *HTTPRequest) (*http.Response, error) {
+	request, err := http.NewRequestWithContext(ctx, req.Method, req.URL, bytes.NewReader(req.Body))
+	if err != nil {
+		return nil, NewPermanentError(errors.Wrapf(err, "failed to construct the request for %s", req.URL))
+	}
+	for key, values := range req.Header {
+		request.Header[key] = values
+	}
+	tracing.InjectHeaders(ctx, request.Header)
+	response, err := e.Client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := ReadResponse(response, e.MaxResponseSize); err != nil {
+		return response, err
+	}
+	return response, nil
+}
+
+// retryOn tells if the requests are retried on the response status
+func (e *HTTPExecutor) retryOn(status int) bool {
+	for _, r := range e.RetryOn {
+		if r.Contains(status) {
+			return true
+		}
+	}
+	return false
+}
+
+func (e *HTTPExecutor) logRetry(req *HTTPRequest, fields ...interface{}) {
+	if e.Logger == nil || e.Backoff == nil {
+		return
+	}
+	e.Logger.Warnw("the request failed, retrying", append([]interface{}{zap.String("url", req.URL)}, fields...)...)
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package triggers
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	"github.com/argoproj/argo-events/sensors/policy"
+)
+
+func TestNewHTTPExecutor(t *testing.T) {
+	e, err := NewHTTPExecutor(http.DefaultClient, 0, nil, nil, nil)
+	assert.Nil(t, err)
+	assert.Nil(t, e.Backoff)
+	assert.Equal(t, []policy.StatusRange{{Min: 429, Max: 429}, {Min: 500, Max: 500}, {Min: 502, Max: 502}, {Min: 503, Max: 503}, {Min: 504, Max: 504}}, e.RetryOn)
+
+	e, err = NewHTTPExecutor(http.DefaultClient, 0, &apicommon.Backoff{Steps: 4}, []string{"5xx"}, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 4, e.Backoff.Steps)
+	assert.Equal(t, []policy.StatusRange{{Min: 500, Max: 599}}, e.RetryOn)
+
+	_, err = NewHTTPExecutor(http.DefaultClient, 0, nil, []string{"abc"}, nil)
+	assert.NotNil(t, err)
+}
+
+func TestHTTPExecutor_Do(t *testing.T) {
+	attempts := 0
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		body, _ := ioutil.ReadAll(r.Body)
+		w.Header().Set("X-Method", r.Method)
+		w.WriteHeader(status)
+		_, _ = w.Write(body)
+	}))
+	defer server.Close()
+
+	backoff := &wait.Backoff{Steps: 3, Duration: time.Millisecond}
+	retryOn := []policy.StatusRange{{Min: 500, Max: 599}}
+	req := &HTTPRequest{Method: http.MethodPut, URL: server.URL, Header: http.Header{"Content-Type": []string{"text/plain"}}, Body: []byte("hello")}
+
+	t.Run("success", func(t *testing.T) {
+		attempts = 0
+		e := &HTTPExecutor{Client: server.Client(), Backoff: backoff, RetryOn: retryOn}
+		resp, err := e.Do(context.TODO(), req)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, attempts)
+		assert.Equal(t, http.MethodPut, resp.Header.Get("X-Method"))
+		// The body is sent again on each attempt, and the response can be read again.
+		body, _ := ioutil.ReadAll(resp.Body)
+		assert.Equal(t, "hello", string(body))
+	})
+
+	t.Run("retries exhausted", func(t *testing.T) {
+		attempts = 0
+		status = http.StatusBadGateway
+		e := &HTTPExecutor{Client: server.Client(), Backoff: backoff, RetryOn: retryOn}
+		resp, err := e.Do(context.TODO(), req)
+		assert.Nil(t, err)
+		assert.Equal(t, 3, attempts)
+		assert.Equal(t, http.StatusBadGateway, resp.StatusCode)
+		body, _ := ioutil.ReadAll(resp.Body)
+		assert.Equal(t, "hello", string(body))
+	})
+
+	t.Run("single attempt", func(t *testing.T) {
+		attempts = 0
+		e := &HTTPExecutor{Client: server.Client(), RetryOn: retryOn}
+		resp, err := e.Do(context.TODO(), req)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, attempts)
+		assert.Equal(t, http.StatusBadGateway, resp.StatusCode)
+	})
+
+	t.Run("response too large", func(t *testing.T) {
+		attempts = 0
+		e := &HTTPExecutor{Client: server.Client(), Backoff: backoff, RetryOn: retryOn, MaxResponseSize: 2}
+		resp, err := e.Do(context.TODO(), req)
+		assert.True(t, IsResponseTooLargeError(err))
+		assert.Equal(t, 1, attempts)
+		assert.Equal(t, http.StatusBadGateway, resp.StatusCode)
+	})
+
+	t.Run("network errors", func(t *testing.T) {
+		e := &HTTPExecutor{Client: server.Client(), Backoff: backoff, RetryOn: retryOn}
+		resp, err := e.Do(context.TODO(), &HTTPRequest{Method: http.MethodGet, URL: "http://127.0.0.1:1"})
+		assert.NotNil(t, err)
+		assert.Nil(t, resp)
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.TODO())
+		cancel()
+		e := &HTTPExecutor{Client: server.Client(), Backoff: backoff, RetryOn: retryOn}
+		_, err := e.Do(ctx, req)
+		assert.NotNil(t, err)
+		assert.True(t, strings.Contains(err.Error(), "canceled"))
+	})
+}
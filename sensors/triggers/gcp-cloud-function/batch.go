@@ -0,0 +1,124 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package gcp_cloud_function
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+// flushFunc calls the function once for the events of all the executions of a batch.
+type flushFunc func(ctx context.Context, batch []map[string]*v1alpha1.Event) (interface{}, error)
+
+// batcher accumulates the executions into batches, flushed once full or after a while.
+type batcher struct {
+	lock    sync.Mutex
+	maxSize int
+	maxWait time.Duration
+	// current is the batch accepting executions, nil until the next execution
+	current *batch
+}
+
+// batch is a set of executions sharing the outcome of a single call.
+type batch struct {
+	// ctx is the context of the first execution, the call is made with
+	ctx    context.Context
+	flush  flushFunc
+	events []map[string]*v1alpha1.Event
+	timer  *time.Timer
+	// done is closed once the response and err are set
+	done     chan struct{}
+	response interface{}
+	err      error
+}
+
+// getBatcher returns the batcher of the key, created with the batch config if there is none yet.
+func (c *clientCache) getBatcher(key string, config *v1alpha1.GCPCloudFunctionBatch) *batcher {
+	c.batchersLock.Lock()
+	defer c.batchersLock.Unlock()
+	b, ok := c.batchers[key]
+	if !ok {
+		b = &batcher{maxSize: config.GetMaxSize(), maxWait: config.GetMaxWait()}
+		c.batchers[key] = b
+	}
+	return b
+}
+
+// add adds the events of an execution to the current batch, and waits for the batch to be flushed.
+// The first execution of a batch provides the function it is flushed with. The execution gives up
+// waiting once its context is done, the call is still made for the other executions of the batch.
+func (b *batcher) add(ctx context.Context, events map[string]*v1alpha1.Event, flush flushFunc) (interface{}, error) {
+	b.lock.Lock()
+	current := b.current
+	if current == nil {
+		current = &batch{ctx: ctx, flush: flush, done: make(chan struct{})}
+		current.timer = time.AfterFunc(b.maxWait, func() { b.flushOnTimeout(current) })
+		b.current = current
+	}
+	current.events = append(current.events, events)
+	full := len(current.events) >= b.maxSize
+	if full {
+		current.timer.Stop()
+		b.current = nil
+	}
+	b.lock.Unlock()
+
+	if full {
+		current.run()
+	}
+	select {
+	case <-current.done:
+		return current.response, current.err
+	case <-ctx.Done():
+		return nil, errors.Wrap(ctx.Err(), "stopped waiting for the batch to be flushed")
+	}
+}
+
+// flushOnTimeout flushes the batch once it has waited for long enough, unless it was full already.
+func (b *batcher) flushOnTimeout(current *batch) {
+	b.lock.Lock()
+	if b.current != current {
+		b.lock.Unlock()
+		return
+	}
+	b.current = nil
+	b.lock.Unlock()
+	current.run()
+}
+
+// run calls the function for the batch, and releases the executions waiting for it.
+func (c *batch) run() {
+	c.response, c.err = c.flush(c.ctx, c.events)
+	close(c.done)
+}
+
+// batchEventIDs returns the IDs of the events of the executions of the batch.
+func batchEventIDs(batch []map[string]*v1alpha1.Event) []string {
+	var ids []string
+	for _, events := range batch {
+		for _, event := range events {
+			if event != nil && event.Context != nil {
+				ids = append(ids, event.Context.ID)
+			}
+		}
+	}
+	return ids
+}
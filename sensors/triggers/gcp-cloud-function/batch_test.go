@@ -0,0 +1,162 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package gcp_cloud_function
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/api/cloudfunctions/v1"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+// batchEvents returns the events of an execution of the trigger, the name being the payload.
+func batchEvents(id, name string) map[string]*v1alpha1.Event {
+	return map[string]*v1alpha1.Event{
+		"fake-dependency": {
+			Context: &v1alpha1.EventContext{ID: id, DataContentType: "application/json"},
+			Data:    []byte(`{"name": "` + name + `"}`),
+		},
+	}
+}
+
+// executeConcurrently executes the trigger for each of the events at once, and returns the errors.
+func executeConcurrently(trigger *GCPCloudFunctionTrigger, events ...map[string]*v1alpha1.Event) []error {
+	errs := make([]error, len(events))
+	var wg sync.WaitGroup
+	for i := range events {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, errs[i] = trigger.Execute(context.TODO(), events[i], trigger.Trigger.Template.GCPCloudFunction)
+		}(i)
+	}
+	wg.Wait()
+	return errs
+}
+
+func TestGCPCloudFunctionTrigger_ExecuteInBatch(t *testing.T) {
+	t.Run("flushes a full batch", func(t *testing.T) {
+		var calls int32
+		var data string
+		trigger := getFakeGCPCloudFunctionTrigger(t, func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			var req cloudfunctions.CallFunctionRequest
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			data = req.Data
+			_, _ = w.Write([]byte(`{"executionId": "fake-id"}`))
+		})
+		trigger.Trigger.Template.GCPCloudFunction.Batch = &v1alpha1.GCPCloudFunctionBatch{MaxSize: 3, MaxWait: "1h"}
+		errs := executeConcurrently(trigger, batchEvents("1", "a"), batchEvents("2", "a"), batchEvents("3", "a"))
+		for _, err := range errs {
+			assert.Nil(t, err)
+		}
+		assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+		assert.Equal(t, `[{"name":"a"},{"name":"a"},{"name":"a"}]`, data)
+	})
+
+	t.Run("flushes after the max wait", func(t *testing.T) {
+		var calls int32
+		trigger := getFakeGCPCloudFunctionTrigger(t, func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			_, _ = w.Write([]byte(`{"executionId": "fake-id"}`))
+		})
+		trigger.Trigger.Template.GCPCloudFunction.Batch = &v1alpha1.GCPCloudFunctionBatch{MaxSize: 10, MaxWait: "50ms"}
+		start := time.Now()
+		errs := executeConcurrently(trigger, batchEvents("1", "a"), batchEvents("2", "b"))
+		for _, err := range errs {
+			assert.Nil(t, err)
+		}
+		assert.True(t, time.Since(start) >= 50*time.Millisecond)
+		assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("fails the whole batch", func(t *testing.T) {
+		var calls int32
+		trigger := getFakeGCPCloudFunctionTrigger(t, func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			w.WriteHeader(http.StatusBadRequest)
+		})
+		trigger.Trigger.Template.GCPCloudFunction.Batch = &v1alpha1.GCPCloudFunctionBatch{MaxSize: 2, MaxWait: "1h"}
+		errs := executeConcurrently(trigger, batchEvents("1", "a"), batchEvents("2", "b"))
+		for _, err := range errs {
+			assert.NotNil(t, err)
+			assert.Contains(t, err.Error(), "failed to call function")
+		}
+		assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		var calls int32
+		trigger := getFakeGCPCloudFunctionTrigger(t, func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+		})
+		trigger.Trigger.Template.DryRun = true
+		trigger.Trigger.Template.GCPCloudFunction.Batch = &v1alpha1.GCPCloudFunctionBatch{MaxSize: 1}
+		response, err := trigger.Execute(context.TODO(), batchEvents("1", "a"), trigger.Trigger.Template.GCPCloudFunction)
+		assert.Nil(t, err)
+		assert.Equal(t, "dry-run", response.(*cloudfunctions.CallFunctionResponse).ExecutionId)
+		assert.Equal(t, int32(0), atomic.LoadInt32(&calls))
+	})
+}
+
+func TestBatcher(t *testing.T) {
+	t.Run("stops waiting once the context is done", func(t *testing.T) {
+		var flushed int32
+		b := &batcher{maxSize: 10, maxWait: 100 * time.Millisecond}
+		flush := func(ctx context.Context, batch []map[string]*v1alpha1.Event) (interface{}, error) {
+			atomic.AddInt32(&flushed, int32(len(batch)))
+			return "ok", nil
+		}
+		ctx, cancel := context.WithCancel(context.TODO())
+		cancel()
+		_, err := b.add(ctx, batchEvents("1", "a"), flush)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "stopped waiting for the batch to be flushed")
+
+		// The batch is still flushed for the other executions.
+		response, err := b.add(context.TODO(), batchEvents("2", "b"), flush)
+		assert.Nil(t, err)
+		assert.Equal(t, "ok", response)
+		assert.Equal(t, int32(2), atomic.LoadInt32(&flushed))
+	})
+
+	t.Run("starts a new batch once flushed", func(t *testing.T) {
+		var batches int32
+		b := &batcher{maxSize: 1, maxWait: time.Hour}
+		flush := func(ctx context.Context, batch []map[string]*v1alpha1.Event) (interface{}, error) {
+			atomic.AddInt32(&batches, 1)
+			return nil, nil
+		}
+		for i := 0; i < 3; i++ {
+			_, err := b.add(context.TODO(), batchEvents("1", "a"), flush)
+			assert.Nil(t, err)
+		}
+		assert.Equal(t, int32(3), atomic.LoadInt32(&batches))
+	})
+}
+
+func TestBatchEventIDs(t *testing.T) {
+	assert.Equal(t, []string{"1", "2"}, batchEventIDs([]map[string]*v1alpha1.Event{batchEvents("1", "a"), batchEvents("2", "b")}))
+	assert.Nil(t, batchEventIDs(nil))
+}
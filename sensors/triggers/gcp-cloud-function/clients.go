@@ -0,0 +1,278 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package gcp_cloud_function
+
+import (
+	"context"
+	"net/http"
+	"sync"
+
+	"cloud.google.com/go/pubsub"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"golang.org/x/oauth2"
+	"google.golang.org/api/cloudfunctions/v1"
+	"google.golang.org/api/option"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/common/tracing"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+// maxAuthFailures is the number of consecutive executions failing to authenticate after which
+// the client is rebuilt, e.g. to pick up rotated credentials.
+const maxAuthFailures = 3
+
+// clientCache holds the clients of the triggers of the sensor, and the state shared by their executions. It is
+// kept in the client cache of the sensor, for the triggers built again with the same names to reuse it.
+type clientCache struct {
+	// lock guards the clients and the auth failures, shared by the concurrent executions.
+	lock sync.Mutex
+	// clients are the clients of the triggers, by trigger name and credentials key.
+	clients map[string]*gcpClient
+	// authFailures counts the consecutive executions of each client failing to authenticate.
+	authFailures map[string]int
+	// batchersLock guards the batchers.
+	batchersLock sync.Mutex
+	// batchers accumulate the executions of each trigger and function.
+	batchers map[string]*batcher
+}
+
+// newClientCache returns an empty cache of clients
+func newClientCache() *clientCache {
+	return &clientCache{
+		clients:      make(map[string]*gcpClient),
+		authFailures: make(map[string]int),
+		batchers:     make(map[string]*batcher),
+	}
+}
+
+// FunctionCaller calls the 1st gen functions, and gets them for the health checks. It is implemented
+// by the Cloud Functions API client, and faked by the tests.
+type FunctionCaller interface {
+	// Call calls the function with the request.
+	Call(ctx context.Context, name string, req *cloudfunctions.CallFunctionRequest) (*cloudfunctions.CallFunctionResponse, error)
+	// Get gets the function.
+	Get(ctx context.Context, name string) (*cloudfunctions.CloudFunction, error)
+}
+
+// serviceCaller is the FunctionCaller of the Cloud Functions API client.
+type serviceCaller struct {
+	service *cloudfunctions.Service
+}
+
+// Call calls the function through the Cloud Functions API, propagating the trace of the execution.
+func (c *serviceCaller) Call(ctx context.Context, name string, req *cloudfunctions.CallFunctionRequest) (*cloudfunctions.CallFunctionResponse, error) {
+	call := c.service.Projects.Locations.Functions.Call(name, req)
+	tracing.InjectHeaders(ctx, call.Header())
+	return call.Context(ctx).Do()
+}
+
+// Get gets the function through the Cloud Functions API.
+func (c *serviceCaller) Get(ctx context.Context, name string) (*cloudfunctions.CloudFunction, error) {
+	return c.service.Projects.Locations.Functions.Get(name).Context(ctx).Do()
+}
+
+// gcpClient is the cached client of a trigger, either the caller of the 1st gen functions,
+// the HTTP client calling the 2nd gen ones, or the topic triggering the Pub/Sub triggered ones.
+type gcpClient struct {
+	caller     FunctionCaller
+	httpClient *http.Client
+	topic      *pubsub.Topic
+	// tokenSource authorizes the publishing to the topic, it is checked by the health checks.
+	tokenSource oauth2.TokenSource
+	// stopWatching stops watching the credentials file of the client, if any.
+	stopWatching context.CancelFunc
+}
+
+// close stops watching the credentials file of the client, once it is dropped from the cache.
+func (c *gcpClient) close() {
+	if c.stopWatching != nil {
+		c.stopWatching()
+	}
+}
+
+// withClient returns a copy of the trigger calling the function with the client, built with the credentials
+// of the key if any.
+func (t *GCPCloudFunctionTrigger) withClient(client *gcpClient, credentialsKey string) *GCPCloudFunctionTrigger {
+	result := *t
+	result.Caller = client.caller
+	result.HTTPClient = client.httpClient
+	result.Topic = client.topic
+	result.tokenSource = client.tokenSource
+	result.credentialsKey = credentialsKey
+	return &result
+}
+
+// clientKey returns the key of the client of the trigger in the cache, the trigger name followed by the
+// credentials key if the credentials are selected by key.
+func clientKey(triggerName, credentialsKey string) string {
+	if credentialsKey == "" {
+		return triggerName
+	}
+	return triggerName + "/" + credentialsKey
+}
+
+// getClient returns the cached client of the trigger for the credentials key, built if it is not cached yet.
+// The credentials key is ignored unless the trigger has named credentials.
+func (c *clientCache) getClient(ctx context.Context, kubeClient kubernetes.Interface, sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger, credentialsKey string, logger *zap.SugaredLogger) (*gcpClient, error) {
+	functionTrigger, err := selectCredentials(trigger.Template.GCPCloudFunction, credentialsKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(trigger.Template.GCPCloudFunction.Credentials) == 0 {
+		credentialsKey = ""
+	}
+	key := clientKey(trigger.Template.Name, credentialsKey)
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	client, ok := c.clients[key]
+	if !ok {
+		selected := *trigger
+		selected.Template = trigger.Template.DeepCopy()
+		selected.Template.GCPCloudFunction = functionTrigger
+		client, err = newGCPClient(ctx, kubeClient, sensor, &selected, logger)
+		if err != nil {
+			return nil, err
+		}
+		c.clients[key] = client
+		c.watchCredentials(client, trigger, logger)
+	}
+	return client, nil
+}
+
+// watchCredentials drops the client from the cache once its credentials file changes, e.g. when the mounted
+// secret holding it is rotated, for the next execution to rebuild the client with the new credentials. The
+// credentials secret is read through the Kubernetes API, only the credentials path is watched. The clients
+// which can't be watched still get rebuilt after failing to authenticate persistently.
+func (c *clientCache) watchCredentials(client *gcpClient, trigger *v1alpha1.Trigger, logger *zap.SugaredLogger) {
+	functionTrigger := trigger.Template.GCPCloudFunction
+	if len(functionTrigger.Credentials) > 0 || functionTrigger.CredentialsSecret != nil || functionTrigger.CredentialsPath == "" {
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	err := common.WatchFile(ctx, functionTrigger.CredentialsPath, func([]byte) {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+		// Another execution may have rebuilt the client already.
+		if c.clients[trigger.Template.Name] == client {
+			logger.Infow("the credentials file changed, rebuilding the GCP client on the next execution", zap.String("credentialsPath", functionTrigger.CredentialsPath))
+			delete(c.clients, trigger.Template.Name)
+		}
+		client.close()
+	})
+	if err != nil {
+		cancel()
+		logger.Warnw("failed to watch the credentials file, the rotated credentials are picked up once the client fails to authenticate", zap.Error(err))
+		return
+	}
+	client.stopWatching = cancel
+}
+
+// newGCPClient returns the client calling the function of the trigger, through the Cloud Functions API
+// for the 1st gen functions, over HTTP with an identity token for the 2nd gen ones, or the topic
+// triggering the function if it is invoked through Pub/Sub.
+func newGCPClient(ctx context.Context, kubeClient kubernetes.Interface, sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger, logger *zap.SugaredLogger) (*gcpClient, error) {
+	functionTrigger := trigger.Template.GCPCloudFunction
+	// The token is refreshed once expired, the failures to do so are recorded. They are not logged here,
+	// the client outlives the execution it is created by, but are returned to the execution refreshing it.
+	observe := func(tokenSource oauth2.TokenSource) oauth2.TokenSource {
+		return oauth2.ReuseTokenSource(nil, &observedTokenSource{
+			source: tokenSource,
+			onFailure: func(err error) {
+				common.ObserveTokenRefreshFailure(sensor.Name, trigger.Template.Name, apicommon.GCPFunctionTrigger)
+			},
+		})
+	}
+
+	if functionTrigger.GetInvocation() == v1alpha1.GCPCloudFunctionInvocationPubSub {
+		project, topicID, err := parseTopic(functionTrigger.Topic)
+		if err != nil {
+			return nil, err
+		}
+		tokenSource, err := newTokenSource(ctx, kubeClient, sensor.Namespace, functionTrigger, logger)
+		if err != nil {
+			return nil, err
+		}
+		tokenSource = observe(tokenSource)
+		client, err := pubsub.NewClient(ctx, project, option.WithTokenSource(tokenSource), option.WithUserAgent(userAgent(sensor, trigger)))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create a GCP Pub/Sub client")
+		}
+		return &gcpClient{topic: client.TopicInProject(topicID, project), tokenSource: tokenSource}, nil
+	}
+
+	if functionTrigger.GetGeneration() == 2 {
+		base, err := newTriggerTransport(ctx, kubeClient, sensor.Namespace, functionTrigger)
+		if err != nil {
+			return nil, err
+		}
+		// The identity tokens are minted through the same proxy as the calls.
+		ctx = context.WithValue(ctx, oauth2.HTTPClient, &http.Client{Transport: base})
+		tokenSource, err := newIDTokenSource(ctx, kubeClient, sensor.Namespace, functionTrigger, logger)
+		if err != nil {
+			return nil, err
+		}
+		return &gcpClient{
+			httpClient: &http.Client{Transport: &oauth2.Transport{Source: observe(tokenSource), Base: base}},
+		}, nil
+	}
+
+	tokenSource, err := newTokenSource(ctx, kubeClient, sensor.Namespace, functionTrigger, logger)
+	if err != nil {
+		return nil, err
+	}
+	opt, err := httpClientOption(ctx, kubeClient, sensor.Namespace, functionTrigger, serviceOptions(sensor, trigger, observe(tokenSource)))
+	if err != nil {
+		return nil, err
+	}
+	service, err := cloudfunctions.NewService(ctx, opt)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create a GCP Cloud Functions client")
+	}
+	return &gcpClient{caller: &serviceCaller{service: service}}, nil
+}
+
+// checkAuthentication tracks the consecutive executions failing to authenticate, and drops the client
+// from the cache once they reach maxAuthFailures, so that the next execution rebuilds it.
+func (t *GCPCloudFunctionTrigger) checkAuthentication(err error) {
+	c := t.cache
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	name := clientKey(t.Trigger.Template.Name, t.credentialsKey)
+	if err == nil {
+		delete(c.authFailures, name)
+		return
+	}
+	if !isAuthError(err) {
+		return
+	}
+	c.authFailures[name]++
+	if c.authFailures[name] < maxAuthFailures {
+		return
+	}
+	delete(c.authFailures, name)
+	// Another execution may have rebuilt the client already.
+	if client, ok := c.clients[name]; ok && client.caller == t.Caller && client.httpClient == t.HTTPClient && client.topic == t.Topic {
+		t.Logger.Warnw("persistent authentication failures, rebuilding the GCP client on the next execution", zap.Int("failures", maxAuthFailures), zap.Error(err))
+		delete(c.clients, name)
+		client.close()
+	}
+}
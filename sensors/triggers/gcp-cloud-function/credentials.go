@@ -0,0 +1,261 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package gcp_cloud_function
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
+	"google.golang.org/api/cloudfunctions/v1"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/idtoken"
+	"google.golang.org/api/impersonate"
+	"google.golang.org/api/option"
+	htransport "google.golang.org/api/transport/http"
+	"google.golang.org/grpc/codes"
+	"k8s.io/client-go/kubernetes"
+
+	argoevents "github.com/argoproj/argo-events"
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+// The sources of the tokens of the impersonated service accounts, replaced by the tests.
+var (
+	impersonateCredentials = impersonate.CredentialsTokenSource
+	impersonateIDToken     = impersonate.IDTokenSource
+)
+
+// selectCredentials returns the trigger with the named credentials of the key as its credentials secret, or the
+// trigger as is if it has no named credentials. The key must be one of the names of the credentials.
+func selectCredentials(trigger *v1alpha1.GCPCloudFunctionTrigger, credentialsKey string) (*v1alpha1.GCPCloudFunctionTrigger, error) {
+	if len(trigger.Credentials) == 0 {
+		return trigger, nil
+	}
+	secret, ok := trigger.Credentials[credentialsKey]
+	if !ok {
+		return nil, errors.Errorf("unknown credentials key %q, expected one of %q", credentialsKey, credentialsKeys(trigger))
+	}
+	selected := trigger.DeepCopy()
+	selected.CredentialsSecret = &secret
+	selected.CredentialsPath = ""
+	return selected, nil
+}
+
+// credentialsKeys returns the sorted names of the credentials of the trigger.
+func credentialsKeys(trigger *v1alpha1.GCPCloudFunctionTrigger) []string {
+	keys := make([]string, 0, len(trigger.Credentials))
+	for key := range trigger.Credentials {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// isCredentialsKeyTemplated returns true if the credentials key is templated by a parameter.
+func isCredentialsKeyTemplated(trigger *v1alpha1.GCPCloudFunctionTrigger) bool {
+	for _, parameter := range trigger.Parameters {
+		if parameter.Dest == credentialsKeyDest {
+			return true
+		}
+	}
+	return false
+}
+
+// serviceOptions returns the options of the client of the Cloud Functions API, authorized by the token source and
+// calling with the User-Agent of the trigger.
+func serviceOptions(sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger, tokenSource oauth2.TokenSource) []option.ClientOption {
+	return []option.ClientOption{option.WithTokenSource(tokenSource), option.WithUserAgent(userAgent(sensor, trigger))}
+}
+
+// userAgent returns the User-Agent of the calls of the trigger, identifying Argo Events, the sensor and the trigger
+// for the calls to be traced back to them, followed by the User-Agent of the trigger if any.
+func userAgent(sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger) string {
+	userAgent := fmt.Sprintf("argo-events/%s (sensor %s/%s; trigger %s)", argoevents.GetVersion(), sensor.Namespace, sensor.Name, trigger.Template.Name)
+	if functionTrigger := trigger.Template.GCPCloudFunction; functionTrigger != nil && functionTrigger.UserAgent != "" {
+		userAgent += " " + functionTrigger.UserAgent
+	}
+	return userAgent
+}
+
+// loadCredentials returns the service account JSON key of the trigger, or nil if the Application
+// Default Credentials are to be used.
+func loadCredentials(ctx context.Context, kubeClient kubernetes.Interface, namespace string, trigger *v1alpha1.GCPCloudFunctionTrigger, logger *zap.SugaredLogger) ([]byte, error) {
+	switch {
+	case trigger.CredentialsSecret != nil:
+		if trigger.CredentialsPath != "" {
+			logger.Warn("both credentialsSecret and credentialsPath are specified, using credentialsSecret")
+		}
+		credentials, err := common.GetSecretValue(ctx, kubeClient, namespace, trigger.CredentialsSecret)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to retrieve the credentials secret")
+		}
+		return []byte(credentials), nil
+	case trigger.CredentialsPath != "":
+		credentials, err := ioutil.ReadFile(trigger.CredentialsPath)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read the credentials file %s", trigger.CredentialsPath)
+		}
+		return credentials, nil
+	default:
+		return nil, nil
+	}
+}
+
+// newTokenSource returns the source of the tokens to call the function with, those of the impersonated
+// service account if any.
+func newTokenSource(ctx context.Context, kubeClient kubernetes.Interface, namespace string, trigger *v1alpha1.GCPCloudFunctionTrigger, logger *zap.SugaredLogger) (oauth2.TokenSource, error) {
+	credentialsJSON, err := loadCredentials(ctx, kubeClient, namespace, trigger, logger)
+	if err != nil {
+		return nil, err
+	}
+	var credentials *google.Credentials
+	if credentialsJSON == nil {
+		// Application default credentials, e.g. workload identity.
+		credentials, err = google.FindDefaultCredentials(ctx, cloudfunctions.CloudPlatformScope)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to find the default credentials")
+		}
+	} else {
+		credentials, err = google.CredentialsFromJSON(ctx, credentialsJSON, cloudfunctions.CloudPlatformScope)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse the credentials")
+		}
+	}
+	if trigger.ImpersonateServiceAccount == "" {
+		return credentials.TokenSource, nil
+	}
+	// The base credentials only need to be allowed to create tokens for the impersonated service account.
+	tokenSource, err := impersonateCredentials(ctx, impersonate.CredentialsConfig{
+		TargetPrincipal: trigger.ImpersonateServiceAccount,
+		Scopes:          []string{cloudfunctions.CloudPlatformScope},
+		Delegates:       trigger.ImpersonationDelegates,
+	}, option.WithTokenSource(credentials.TokenSource))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to impersonate the service account %s", trigger.ImpersonateServiceAccount)
+	}
+	return tokenSource, nil
+}
+
+// newIDTokenSource returns the source of the identity tokens to call a 2nd gen function with, the
+// audience of the tokens is the URL of the function.
+func newIDTokenSource(ctx context.Context, kubeClient kubernetes.Interface, namespace string, trigger *v1alpha1.GCPCloudFunctionTrigger, logger *zap.SugaredLogger) (oauth2.TokenSource, error) {
+	credentialsJSON, err := loadCredentials(ctx, kubeClient, namespace, trigger, logger)
+	if err != nil {
+		return nil, err
+	}
+	var opts []option.ClientOption
+	if credentialsJSON != nil {
+		opts = append(opts, option.WithCredentialsJSON(credentialsJSON))
+	}
+	if trigger.ImpersonateServiceAccount != "" {
+		tokenSource, err := impersonateIDToken(ctx, impersonate.IDTokenConfig{
+			Audience:        trigger.URL,
+			TargetPrincipal: trigger.ImpersonateServiceAccount,
+			Delegates:       trigger.ImpersonationDelegates,
+			IncludeEmail:    true,
+		}, opts...)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to impersonate the service account %s", trigger.ImpersonateServiceAccount)
+		}
+		return tokenSource, nil
+	}
+	tokenSource, err := idtoken.NewTokenSource(ctx, trigger.URL, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create the identity token source")
+	}
+	return tokenSource, nil
+}
+
+// observedTokenSource calls back on the failures to get a token from the source. Wrapped in a
+// reuse token source, the source is only asked for a token when the cached one has expired.
+type observedTokenSource struct {
+	source    oauth2.TokenSource
+	onFailure func(error)
+}
+
+// Token returns a token from the source, the error is marked as a token error.
+func (s *observedTokenSource) Token() (*oauth2.Token, error) {
+	token, err := s.source.Token()
+	if err != nil {
+		s.onFailure(err)
+		return nil, &tokenError{err: err}
+	}
+	return token, nil
+}
+
+// tokenError is the error of a failure to get an access or identity token.
+type tokenError struct {
+	err error
+}
+
+func (e *tokenError) Error() string {
+	return "failed to get a token: " + e.err.Error()
+}
+
+func (e *tokenError) Unwrap() error {
+	return e.err
+}
+
+// httpClientOption returns the option to call the function with the HTTP client of common.NewHTTPTransport, trusting
+// the CA certificate and going through the proxy of the trigger. The client is authorized with the given credentials
+// options, since they are ignored by the GCP client once a custom HTTP client is provided.
+func httpClientOption(ctx context.Context, kubeClient kubernetes.Interface, namespace string, trigger *v1alpha1.GCPCloudFunctionTrigger, credentials []option.ClientOption) (option.ClientOption, error) {
+	base, err := newTriggerTransport(ctx, kubeClient, namespace, trigger)
+	if err != nil {
+		return nil, err
+	}
+	transport, err := htransport.NewTransport(ctx, base, credentials...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create the authorized transport")
+	}
+	return option.WithHTTPClient(&http.Client{Transport: transport}), nil
+}
+
+// newTriggerTransport returns the HTTP transport trusting the CA certificate and going through the proxy of the trigger,
+// or the proxy of the environment if the trigger has none.
+func newTriggerTransport(ctx context.Context, kubeClient kubernetes.Interface, namespace string, trigger *v1alpha1.GCPCloudFunctionTrigger) (*http.Transport, error) {
+	opts := common.HTTPClientOptions{ProxyURL: trigger.ProxyURL}
+	if trigger.CACertificate != nil {
+		caCert, err := common.GetSecretValue(ctx, kubeClient, namespace, trigger.CACertificate)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to retrieve the CA certificate secret")
+		}
+		opts.CABundle = []byte(caCert)
+	}
+	return common.NewHTTPTransport(opts)
+}
+
+// isAuthError returns true if the function call failed to authenticate, either because no access
+// token could be obtained, or because GCP rejected it.
+func isAuthError(err error) bool {
+	var tokenErr *tokenError
+	if errors.As(err, &tokenErr) {
+		return true
+	}
+	if st, ok := grpcStatus(err); ok {
+		return st.Code() == codes.Unauthenticated
+	}
+	var apiErr *googleapi.Error
+	return errors.As(err, &apiErr) && apiErr.Code == http.StatusUnauthorized
+}
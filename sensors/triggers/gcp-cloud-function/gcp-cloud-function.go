@@ -0,0 +1,241 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package gcp_cloud_function
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"cloud.google.com/go/pubsub"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"golang.org/x/oauth2"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/argoproj/argo-events/common/logging"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sensors/triggers"
+)
+
+// functionNameDest is the destination of the parameters templating the function name
+const functionNameDest = "functionName"
+
+// urlDest is the destination of the parameters templating the URL, which is rejected
+const urlDest = "url"
+
+// locationDest is the destination of the parameters templating the location
+const locationDest = "location"
+
+// topicDest is the destination of the parameters templating the topic, which is rejected
+const topicDest = "topic"
+
+// credentialsKeyDest is the destination of the parameters templating the credentials key
+const credentialsKeyDest = "credentialsKey"
+
+// EventIDsHeader is the header of the calls to the 2nd gen functions, and the attribute of the messages published
+// for the pubsub invocation, holding the IDs of the events the call is made for.
+const EventIDsHeader = "X-Argo-Events-Event-Ids"
+
+func init() {
+	triggers.Register(apicommon.GCPFunctionTrigger, triggers.Registration{
+		Matches: func(template *v1alpha1.TriggerTemplate) bool {
+			return template.GCPCloudFunction != nil
+		},
+		New: func(deps *triggers.Dependencies, trigger *v1alpha1.Trigger, logger *zap.SugaredLogger) (triggers.Trigger, error) {
+			cache := deps.Clients.Get(apicommon.GCPFunctionTrigger, func() interface{} {
+				return newClientCache()
+			}).(*clientCache)
+			t, err := NewGCPCloudFunctionTrigger(deps.KubeClient, cache, deps.Sensor, trigger, logger)
+			if err != nil {
+				return nil, err
+			}
+			t.Dispatcher = deps.Dispatcher
+			return t, nil
+		},
+	})
+}
+
+// GCPCloudFunctionTrigger refers to trigger that calls GCP Cloud Functions
+type GCPCloudFunctionTrigger struct {
+	// Caller calls the 1st gen functions, through the GCP Cloud Functions service client
+	Caller FunctionCaller
+	// HTTPClient is the client calling the 2nd gen functions, authorized with identity tokens
+	HTTPClient *http.Client
+	// Topic is the Pub/Sub topic the Pub/Sub triggered functions are invoked through
+	Topic *pubsub.Topic
+	// Sensor object
+	Sensor *v1alpha1.Sensor
+	// Trigger definition
+	Trigger *v1alpha1.Trigger
+	// logger to log stuff
+	Logger *zap.SugaredLogger
+	// cache is the cache the client is taken from, the client is dropped from it once its
+	// authentication fails persistently.
+	cache *clientCache
+	// tokenSource authorizes the publishing to the topic.
+	tokenSource oauth2.TokenSource
+	// Dispatcher runs the asynchronous calls in the background, they are made before the execution
+	// returns without it.
+	Dispatcher triggers.Dispatcher
+	// kubeClient reads the credentials of the clients built by the executions.
+	kubeClient kubernetes.Interface
+	// credentialsKey is the key of the credentials the client was built with, if any.
+	credentialsKey string
+}
+
+// NewGCPCloudFunctionTrigger returns a new GCP Cloud Function trigger context
+func NewGCPCloudFunctionTrigger(kubeClient kubernetes.Interface, cache *clientCache, sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger, logger *zap.SugaredLogger) (*GCPCloudFunctionTrigger, error) {
+	logger = logger.With(logging.LabelTriggerType, apicommon.GCPFunctionTrigger)
+
+	// A malformed function name is reported on startup, rather than rejected by GCP on every execution.
+	if err := checkFunctionName(trigger.Template.GCPCloudFunction); err != nil {
+		return nil, err
+	}
+	if err := validateImpersonation(trigger.Template.GCPCloudFunction); err != nil {
+		return nil, err
+	}
+
+	functionTrigger := trigger.Template.GCPCloudFunction
+	t := &GCPCloudFunctionTrigger{
+		Sensor:     sensor,
+		Trigger:    trigger,
+		Logger:     logger,
+		cache:      cache,
+		kubeClient: kubeClient,
+	}
+	if len(functionTrigger.Credentials) > 0 && isCredentialsKeyTemplated(functionTrigger) {
+		// The credentials are selected by the executions, their clients are built on the first one.
+		return t, nil
+	}
+	client, err := cache.getClient(context.Background(), kubeClient, sensor, trigger, functionTrigger.CredentialsKey, logger)
+	if err != nil {
+		return nil, err
+	}
+	return t.withClient(client, functionTrigger.CredentialsKey), nil
+}
+
+// GetTriggerType returns the type of the trigger
+func (t *GCPCloudFunctionTrigger) GetTriggerType() apicommon.TriggerType {
+	return apicommon.GCPFunctionTrigger
+}
+
+// FetchResource fetches the trigger resource
+func (t *GCPCloudFunctionTrigger) FetchResource(ctx context.Context) (interface{}, error) {
+	return t.Trigger.Template.GCPCloudFunction, nil
+}
+
+// ApplyResourceParameters applies parameters to the trigger resource
+func (t *GCPCloudFunctionTrigger) ApplyResourceParameters(events map[string]*v1alpha1.Event, resource interface{}) (interface{}, error) {
+	resourceBytes, err := json.Marshal(resource)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal the gcp cloud function trigger resource")
+	}
+	parameters := t.Trigger.Template.GCPCloudFunction.Parameters
+	if parameters != nil {
+		updatedResourceBytes, err := triggers.ApplyParams(resourceBytes, parameters, events)
+		if err != nil {
+			return nil, err
+		}
+		var ft *v1alpha1.GCPCloudFunctionTrigger
+		if err := json.Unmarshal(updatedResourceBytes, &ft); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal the updated gcp cloud function trigger resource after applying resource parameters")
+		}
+		return ft, nil
+	}
+	return resource, nil
+}
+
+// Execute executes the trigger. The failures are classified as triggers.PermanentError, e.g. a missing payload
+// or a call rejected by GCP, or triggers.RetryableError, e.g. GCP being throttled or unavailable.
+func (t *GCPCloudFunctionTrigger) Execute(ctx context.Context, events map[string]*v1alpha1.Event, resource interface{}) (interface{}, error) {
+	trigger, ok := resource.(*v1alpha1.GCPCloudFunctionTrigger)
+	if !ok {
+		return nil, triggers.NewPermanentError(errors.New("failed to interpret the trigger resource"))
+	}
+
+	if trigger.Payload == nil && trigger.Transform == "" {
+		return nil, triggers.ErrPayloadMissing
+	}
+
+	if trigger.GetInvocation() == v1alpha1.GCPCloudFunctionInvocationCall {
+		if trigger.Location != "" {
+			// The resource is the trigger of the sensor if there are no parameters.
+			trigger = trigger.DeepCopy()
+			trigger.FunctionName = resolveFunctionName(trigger)
+		}
+		if err := validateFunctionName(trigger.FunctionName); err != nil {
+			return nil, triggers.NewPermanentError(err)
+		}
+	}
+
+	if len(trigger.Credentials) > 0 && trigger.CredentialsKey != t.credentialsKey {
+		// The resource is the trigger with its credentials key resolved from the events.
+		client, err := t.cache.getClient(ctx, t.kubeClient, t.Sensor, t.Trigger, trigger.CredentialsKey, t.Logger)
+		if err != nil {
+			return nil, triggers.NewPermanentError(errors.Wrap(err, "failed to get the GCP client"))
+		}
+		t = t.withClient(client, trigger.CredentialsKey)
+	}
+
+	if trigger.Batch != nil {
+		return t.executeInBatch(ctx, events, trigger)
+	}
+
+	payload, err := t.constructPayload(events, trigger)
+	if err != nil {
+		return nil, triggers.NewPermanentError(err)
+	}
+	return t.callFunction(ctx, trigger, payload, batchEventIDs([]map[string]*v1alpha1.Event{events}))
+}
+
+// CheckHealth checks the function can be reached without calling it, by getting the function through
+// the Cloud Functions API for the 1st gen functions, an identity token for the 2nd gen ones, or an
+// access token for the ones invoked through Pub/Sub. A function name templated from the events is
+// not known ahead of the executions, and is not checked.
+func (t *GCPCloudFunctionTrigger) CheckHealth(ctx context.Context) error {
+	trigger := t.Trigger.Template.GCPCloudFunction
+	if t.Caller == nil && t.HTTPClient == nil && t.Topic == nil {
+		// The client is built by the first execution, with the credentials it selects.
+		return nil
+	}
+	if trigger.GetInvocation() == v1alpha1.GCPCloudFunctionInvocationPubSub {
+		if t.tokenSource == nil {
+			return nil
+		}
+		_, err := t.tokenSource.Token()
+		return err
+	}
+	if trigger.GetGeneration() == 2 {
+		transport, ok := t.HTTPClient.Transport.(*oauth2.Transport)
+		if !ok {
+			return nil
+		}
+		_, err := transport.Source.Token()
+		return err
+	}
+	for _, parameter := range trigger.Parameters {
+		if parameter.Dest == functionNameDest || parameter.Dest == locationDest {
+			return nil
+		}
+	}
+	functionName := resolveFunctionName(trigger)
+	if _, err := t.Caller.Get(ctx, functionName); err != nil {
+		return errors.Wrapf(err, "failed to get function %s", functionName)
+	}
+	return nil
+}
@@ -0,0 +1,1923 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package gcp_cloud_function
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/pubsub"
+	"cloud.google.com/go/pubsub/pstest"
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/oauth2"
+	"google.golang.org/api/cloudfunctions/v1"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/impersonate"
+	"google.golang.org/api/option"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-events/common/logging"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sensors/triggers"
+)
+
+var sensorObj = &v1alpha1.Sensor{
+	ObjectMeta: metav1.ObjectMeta{
+		Name:      "fake-sensor",
+		Namespace: "fake",
+	},
+	Spec: v1alpha1.SensorSpec{
+		Triggers: []v1alpha1.Trigger{
+			{
+				Template: &v1alpha1.TriggerTemplate{
+					Name: "fake-trigger",
+					GCPCloudFunction: &v1alpha1.GCPCloudFunctionTrigger{
+						FunctionName: "projects/fake-project/locations/us-central1/functions/fake-function",
+						Payload: []v1alpha1.TriggerParameter{
+							{
+								Src: &v1alpha1.TriggerParameterSource{
+									DependencyName: "fake-dependency",
+									DataKey:        "name",
+								},
+								Dest: "name",
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+var testEvents = map[string]*v1alpha1.Event{
+	"fake-dependency": {
+		Context: &v1alpha1.EventContext{
+			ID:              "1",
+			Type:            "webhook",
+			Source:          "webhook-gateway",
+			DataContentType: "application/json",
+			SpecVersion:     cloudevents.VersionV1,
+			Subject:         "example-1",
+		},
+		Data: []byte(`{"name": "real-function"}`),
+	},
+}
+
+func getFakeGCPCloudFunctionTrigger(t *testing.T, handler http.HandlerFunc) *GCPCloudFunctionTrigger {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	service, err := cloudfunctions.NewService(context.TODO(), option.WithEndpoint(server.URL), option.WithoutAuthentication(), option.WithHTTPClient(server.Client()))
+	assert.Nil(t, err)
+	sensor := sensorObj.DeepCopy()
+	return &GCPCloudFunctionTrigger{
+		Caller:  &serviceCaller{service: service},
+		Sensor:  sensor,
+		Trigger: &sensor.Spec.Triggers[0],
+		Logger:  logging.NewArgoEventsLogger(),
+		cache:   newClientCache(),
+	}
+}
+
+// newCachedClientCache returns a cache holding a client of the first trigger of the sensor, for the trigger
+// not to build one.
+func newCachedClientCache(sensor *v1alpha1.Sensor) *clientCache {
+	cache := newClientCache()
+	cache.clients[sensor.Spec.Triggers[0].Template.Name] = &gcpClient{}
+	return cache
+}
+
+// fakeFunctionCaller records the calls, and responds to them with the response or the error.
+type fakeFunctionCaller struct {
+	names    []string
+	requests []*cloudfunctions.CallFunctionRequest
+	response *cloudfunctions.CallFunctionResponse
+	err      error
+}
+
+func (c *fakeFunctionCaller) Call(ctx context.Context, name string, req *cloudfunctions.CallFunctionRequest) (*cloudfunctions.CallFunctionResponse, error) {
+	c.names = append(c.names, name)
+	c.requests = append(c.requests, req)
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.response, nil
+}
+
+func (c *fakeFunctionCaller) Get(ctx context.Context, name string) (*cloudfunctions.CloudFunction, error) {
+	c.names = append(c.names, name)
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &cloudfunctions.CloudFunction{Name: name}, nil
+}
+
+// fakeDispatcher runs the work in the background if it accepts it.
+type fakeDispatcher struct {
+	accept bool
+	wg     sync.WaitGroup
+}
+
+func (d *fakeDispatcher) Dispatch(work func(ctx context.Context)) bool {
+	if !d.accept {
+		return false
+	}
+	d.wg.Add(1)
+	go func() {
+		defer d.wg.Done()
+		work(context.TODO())
+	}()
+	return true
+}
+
+// executeWithRetries executes the trigger as the sensor does, executing it again after the failures which are
+// not permanent, up to the attempts.
+func executeWithRetries(trigger *GCPCloudFunctionTrigger, attempts int) (interface{}, error) {
+	var response interface{}
+	var err error
+	for i := 0; i < attempts; i++ {
+		response, err = trigger.Execute(context.TODO(), testEvents, trigger.Trigger.Template.GCPCloudFunction)
+		if err == nil || triggers.IsPermanentError(err) {
+			break
+		}
+	}
+	return response, err
+}
+
+func getFakeCallerGCPCloudFunctionTrigger(caller *fakeFunctionCaller) *GCPCloudFunctionTrigger {
+	sensor := sensorObj.DeepCopy()
+	return &GCPCloudFunctionTrigger{
+		Caller:  caller,
+		Sensor:  sensor,
+		Trigger: &sensor.Spec.Triggers[0],
+		Logger:  logging.NewArgoEventsLogger(),
+		cache:   newClientCache(),
+	}
+}
+
+func getFakePubSubGCPCloudFunctionTrigger(t *testing.T) (*GCPCloudFunctionTrigger, *pstest.Server) {
+	t.Helper()
+	server := pstest.NewServer()
+	t.Cleanup(func() { _ = server.Close() })
+	conn, err := grpc.Dial(server.Addr, grpc.WithInsecure())
+	assert.Nil(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	client, err := pubsub.NewClient(context.TODO(), "fake-project", option.WithGRPCConn(conn))
+	assert.Nil(t, err)
+	topic, err := client.CreateTopic(context.TODO(), "fake-topic")
+	assert.Nil(t, err)
+	t.Cleanup(topic.Stop)
+	sensor := sensorObj.DeepCopy()
+	sensor.Spec.Triggers[0].Template.GCPCloudFunction.Invocation = v1alpha1.GCPCloudFunctionInvocationPubSub
+	sensor.Spec.Triggers[0].Template.GCPCloudFunction.Topic = "projects/fake-project/topics/fake-topic"
+	return &GCPCloudFunctionTrigger{
+		Topic:   topic,
+		Sensor:  sensor,
+		Trigger: &sensor.Spec.Triggers[0],
+		Logger:  logging.NewArgoEventsLogger(),
+		cache:   newClientCache(),
+	}, server
+}
+
+func TestGCPCloudFunctionTrigger_FetchResource(t *testing.T) {
+	trigger := getFakeGCPCloudFunctionTrigger(t, nil)
+	resource, err := trigger.FetchResource(context.TODO())
+	assert.Nil(t, err)
+	assert.NotNil(t, resource)
+
+	ft, ok := resource.(*v1alpha1.GCPCloudFunctionTrigger)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, "projects/fake-project/locations/us-central1/functions/fake-function", ft.FunctionName)
+}
+
+func TestGCPCloudFunctionTrigger_ApplyResourceParameters(t *testing.T) {
+	trigger := getFakeGCPCloudFunctionTrigger(t, nil)
+	defaultValue := "default"
+	trigger.Trigger.Template.GCPCloudFunction.Parameters = []v1alpha1.TriggerParameter{
+		{
+			Src: &v1alpha1.TriggerParameterSource{
+				DependencyName: "fake-dependency",
+				DataTemplate:   "projects/fake-project/locations/us-central1/functions/{{ .Input.name }}",
+				Value:          &defaultValue,
+			},
+			Dest: "functionName",
+		},
+	}
+
+	response, err := trigger.ApplyResourceParameters(testEvents, trigger.Trigger.Template.GCPCloudFunction)
+	assert.Nil(t, err)
+	assert.NotNil(t, response)
+
+	updatedObj, ok := response.(*v1alpha1.GCPCloudFunctionTrigger)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, "projects/fake-project/locations/us-central1/functions/real-function", updatedObj.FunctionName)
+}
+
+func TestGCPCloudFunctionTrigger_ApplyResourceParameters_ProjectAndLocation(t *testing.T) {
+	trigger := getFakeGCPCloudFunctionTrigger(t, nil)
+	trigger.Trigger.Template.GCPCloudFunction.Parameters = []v1alpha1.TriggerParameter{
+		{
+			Src: &v1alpha1.TriggerParameterSource{
+				DependencyName: "fake-dependency",
+				DataTemplate:   "projects/{{ .Input.project }}/locations/{{ .Input.location }}/functions/fake-function",
+			},
+			Dest: "functionName",
+		},
+	}
+	events := map[string]*v1alpha1.Event{
+		"fake-dependency": {
+			Context: testEvents["fake-dependency"].Context,
+			Data:    []byte(`{"project": "real-project", "location": "europe-west1"}`),
+		},
+	}
+
+	response, err := trigger.ApplyResourceParameters(events, trigger.Trigger.Template.GCPCloudFunction)
+	assert.Nil(t, err)
+	updatedObj, ok := response.(*v1alpha1.GCPCloudFunctionTrigger)
+	assert.True(t, ok)
+	assert.Equal(t, "projects/real-project/locations/europe-west1/functions/fake-function", updatedObj.FunctionName)
+}
+
+func TestGCPCloudFunctionTrigger_Location(t *testing.T) {
+	t.Setenv("FAKE_REGION", "europe-west1")
+	var path string
+	trigger := getFakeGCPCloudFunctionTrigger(t, func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		_, _ = w.Write([]byte(`{"executionId": "fake-execution", "result": "{}"}`))
+	})
+	trigger.Trigger.Template.GCPCloudFunction.Parameters = []v1alpha1.TriggerParameter{
+		{
+			Src:  &v1alpha1.TriggerParameterSource{Env: "FAKE_REGION"},
+			Dest: "location",
+		},
+	}
+
+	resource, err := trigger.ApplyResourceParameters(testEvents, trigger.Trigger.Template.GCPCloudFunction)
+	assert.Nil(t, err)
+	assert.Equal(t, "europe-west1", resource.(*v1alpha1.GCPCloudFunctionTrigger).Location)
+
+	_, err = trigger.Execute(context.TODO(), testEvents, resource)
+	assert.Nil(t, err)
+	assert.Contains(t, path, "projects/fake-project/locations/europe-west1/functions/fake-function")
+
+	t.Run("invalid location", func(t *testing.T) {
+		resource := trigger.Trigger.Template.GCPCloudFunction.DeepCopy()
+		resource.Location = "europe/west1"
+		_, err := trigger.Execute(context.TODO(), testEvents, resource)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "invalid function name")
+	})
+}
+
+func TestEncodePayload(t *testing.T) {
+	payload := []byte(`{"name":"real-function"}`)
+
+	t.Run("none", func(t *testing.T) {
+		for _, encoding := range []v1alpha1.GCPCloudFunctionEncoding{"", v1alpha1.GCPCloudFunctionEncodingNone} {
+			encoded, err := encodePayload(payload, encoding)
+			assert.Nil(t, err)
+			assert.Equal(t, payload, encoded)
+		}
+	})
+
+	t.Run("base64", func(t *testing.T) {
+		encoded, err := encodePayload(payload, v1alpha1.GCPCloudFunctionEncodingBase64)
+		assert.Nil(t, err)
+		assert.Equal(t, "eyJuYW1lIjoicmVhbC1mdW5jdGlvbiJ9", string(encoded))
+	})
+
+	t.Run("gzip", func(t *testing.T) {
+		encoded, err := encodePayload(payload, v1alpha1.GCPCloudFunctionEncodingGzip)
+		assert.Nil(t, err)
+		compressed, err := base64.StdEncoding.DecodeString(string(encoded))
+		assert.Nil(t, err)
+		r, err := gzip.NewReader(bytes.NewReader(compressed))
+		assert.Nil(t, err)
+		decompressed, err := ioutil.ReadAll(r)
+		assert.Nil(t, err)
+		assert.Equal(t, payload, decompressed)
+	})
+
+	t.Run("gzip shrinks repetitive payloads under the limit", func(t *testing.T) {
+		large := []byte(`{"name":"` + strings.Repeat("a", maxCallDataSize) + `"}`)
+		encoded, err := encodePayload(large, v1alpha1.GCPCloudFunctionEncodingGzip)
+		assert.Nil(t, err)
+		assert.Less(t, len(encoded), maxCallDataSize)
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		_, err := encodePayload(payload, "zstd")
+		assert.NotNil(t, err)
+	})
+}
+
+func TestWrapPayload(t *testing.T) {
+	payload := []byte(`{"name":"real-function"}`)
+
+	t.Run("json", func(t *testing.T) {
+		envelope, err := wrapPayload(payload, payload, "")
+		assert.Nil(t, err)
+		assert.JSONEq(t, `{"contentType":"application/json","encoding":"none","size":24,"data":"{\"name\":\"real-function\"}"}`, string(envelope))
+	})
+
+	t.Run("text", func(t *testing.T) {
+		envelope, err := wrapPayload([]byte("hello"), []byte("aGVsbG8="), v1alpha1.GCPCloudFunctionEncodingBase64)
+		assert.Nil(t, err)
+		assert.JSONEq(t, `{"contentType":"text/plain","encoding":"base64","size":5,"data":"aGVsbG8="}`, string(envelope))
+	})
+}
+
+func TestFunctionNameRegex(t *testing.T) {
+	assert.True(t, functionNameRegex.MatchString("projects/p/locations/us-central1/functions/f"))
+	assert.False(t, functionNameRegex.MatchString("projects//locations/us-central1/functions/f"))
+	assert.False(t, functionNameRegex.MatchString("projects/p/locations/us-central1/functions/f/extra"))
+	assert.False(t, functionNameRegex.MatchString("f"))
+}
+
+// getFakeGen2GCPCloudFunctionTrigger returns a trigger calling a 2nd gen function served by the handler
+func getFakeGen2GCPCloudFunctionTrigger(t *testing.T, handler http.HandlerFunc) *GCPCloudFunctionTrigger {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	sensor := sensorObj.DeepCopy()
+	sensor.Spec.Triggers[0].Template.GCPCloudFunction.Generation = 2
+	sensor.Spec.Triggers[0].Template.GCPCloudFunction.URL = server.URL
+	return &GCPCloudFunctionTrigger{
+		HTTPClient: &http.Client{Transport: &oauth2.Transport{Source: &fakeTokenSource{}, Base: server.Client().Transport}},
+		Sensor:     sensor,
+		Trigger:    &sensor.Spec.Triggers[0],
+		Logger:     logging.NewArgoEventsLogger(),
+		cache:      newClientCache(),
+	}
+}
+
+func TestGCPCloudFunctionTrigger_Execute(t *testing.T) {
+	t.Run("succeeds", func(t *testing.T) {
+		trigger := getFakeGCPCloudFunctionTrigger(t, func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"executionId": "fake-id", "result": "ok"}`))
+		})
+		response, err := trigger.Execute(context.TODO(), testEvents, trigger.Trigger.Template.GCPCloudFunction)
+		assert.Nil(t, err)
+		result, ok := response.(*cloudfunctions.CallFunctionResponse)
+		assert.True(t, ok)
+		assert.Equal(t, "fake-id", result.ExecutionId)
+	})
+
+	t.Run("calls once on retryable status code", func(t *testing.T) {
+		var calls int32
+		trigger := getFakeGCPCloudFunctionTrigger(t, func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt32(&calls, 1) < 3 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			_, _ = w.Write([]byte(`{"executionId": "fake-id"}`))
+		})
+		duration := apicommon.FromString("1ms")
+		trigger.Trigger.Template.GCPCloudFunction.RetryStrategy = &apicommon.Backoff{Steps: 3, Duration: &duration}
+		// The sensor retries the execution, not the trigger.
+		_, err := trigger.Execute(context.TODO(), testEvents, trigger.Trigger.Template.GCPCloudFunction)
+		assert.NotNil(t, err)
+		assert.True(t, triggers.IsRetryableError(err))
+		assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+
+		_, err = executeWithRetries(trigger, 3)
+		assert.Nil(t, err)
+		assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("does not retry on client error", func(t *testing.T) {
+		var calls int32
+		trigger := getFakeGCPCloudFunctionTrigger(t, func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			w.WriteHeader(http.StatusNotFound)
+		})
+		_, err := executeWithRetries(trigger, 3)
+		assert.NotNil(t, err)
+		assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("encodes the payload", func(t *testing.T) {
+		var data string
+		trigger := getFakeGCPCloudFunctionTrigger(t, func(w http.ResponseWriter, r *http.Request) {
+			var req cloudfunctions.CallFunctionRequest
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			data = req.Data
+			_, _ = w.Write([]byte(`{"executionId": "fake-id"}`))
+		})
+		trigger.Trigger.Template.GCPCloudFunction.Encoding = v1alpha1.GCPCloudFunctionEncodingBase64
+		_, err := trigger.Execute(context.TODO(), testEvents, trigger.Trigger.Template.GCPCloudFunction)
+		assert.Nil(t, err)
+		decoded, err := base64.StdEncoding.DecodeString(data)
+		assert.Nil(t, err)
+		assert.Equal(t, `{"name":"real-function"}`, string(decoded))
+	})
+
+	t.Run("sends the raw payload by default", func(t *testing.T) {
+		var data string
+		trigger := getFakeGCPCloudFunctionTrigger(t, func(w http.ResponseWriter, r *http.Request) {
+			var req cloudfunctions.CallFunctionRequest
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			data = req.Data
+			_, _ = w.Write([]byte(`{"executionId": "fake-id"}`))
+		})
+		_, err := trigger.Execute(context.TODO(), testEvents, trigger.Trigger.Template.GCPCloudFunction)
+		assert.Nil(t, err)
+		assert.Equal(t, `{"name":"real-function"}`, data)
+	})
+
+	t.Run("wraps the payload in a cloud event", func(t *testing.T) {
+		var data string
+		trigger := getFakeGCPCloudFunctionTrigger(t, func(w http.ResponseWriter, r *http.Request) {
+			var req cloudfunctions.CallFunctionRequest
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			data = req.Data
+			_, _ = w.Write([]byte(`{"executionId": "fake-id"}`))
+		})
+		trigger.Trigger.Template.CloudEvent = &v1alpha1.CloudEventEnvelope{Type: "com.example.function.called"}
+		_, err := trigger.Execute(context.TODO(), testEvents, trigger.Trigger.Template.GCPCloudFunction)
+		assert.Nil(t, err)
+		var envelope map[string]interface{}
+		assert.Nil(t, json.Unmarshal([]byte(data), &envelope))
+		assert.Equal(t, "1.0", envelope["specversion"])
+		assert.Equal(t, "1", envelope["id"])
+		assert.Equal(t, "webhook-gateway", envelope["source"])
+		assert.Equal(t, "com.example.function.called", envelope["type"])
+		assert.Equal(t, map[string]interface{}{"name": "real-function"}, envelope["data"])
+	})
+
+	t.Run("wraps the payload in an envelope", func(t *testing.T) {
+		var data string
+		trigger := getFakeGCPCloudFunctionTrigger(t, func(w http.ResponseWriter, r *http.Request) {
+			var req cloudfunctions.CallFunctionRequest
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			data = req.Data
+			_, _ = w.Write([]byte(`{"executionId": "fake-id"}`))
+		})
+		trigger.Trigger.Template.GCPCloudFunction.Encoding = v1alpha1.GCPCloudFunctionEncodingBase64
+		trigger.Trigger.Template.GCPCloudFunction.Envelope = true
+		_, err := trigger.Execute(context.TODO(), testEvents, trigger.Trigger.Template.GCPCloudFunction)
+		assert.Nil(t, err)
+		var envelope payloadEnvelope
+		assert.Nil(t, json.Unmarshal([]byte(data), &envelope))
+		assert.Equal(t, "application/json", envelope.ContentType)
+		assert.Equal(t, v1alpha1.GCPCloudFunctionEncodingBase64, envelope.Encoding)
+		assert.Equal(t, 24, envelope.Size)
+		decoded, err := base64.StdEncoding.DecodeString(envelope.Data)
+		assert.Nil(t, err)
+		assert.Equal(t, `{"name":"real-function"}`, string(decoded))
+	})
+
+	t.Run("rejects an oversize payload", func(t *testing.T) {
+		var calls int32
+		trigger := getFakeGCPCloudFunctionTrigger(t, func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+		})
+		events := map[string]*v1alpha1.Event{
+			"fake-dependency": {
+				Context: testEvents["fake-dependency"].Context,
+				Data:    []byte(`{"name": "` + strings.Repeat("a", maxCallDataSize) + `"}`),
+			},
+		}
+		_, err := trigger.Execute(context.TODO(), events, trigger.Trigger.Template.GCPCloudFunction)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "exceeds the call limit")
+		assert.Equal(t, int32(0), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("malformed function name", func(t *testing.T) {
+		var calls int32
+		trigger := getFakeGCPCloudFunctionTrigger(t, func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+		})
+		trigger.Trigger.Template.GCPCloudFunction.FunctionName = "projects//locations/us-central1/functions/fake-function"
+		_, err := trigger.Execute(context.TODO(), testEvents, trigger.Trigger.Template.GCPCloudFunction)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "invalid function name")
+		assert.Equal(t, int32(0), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		var calls int32
+		trigger := getFakeGCPCloudFunctionTrigger(t, func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+		})
+		trigger.Trigger.Template.DryRun = true
+		response, err := trigger.Execute(context.TODO(), testEvents, trigger.Trigger.Template.GCPCloudFunction)
+		assert.Nil(t, err)
+		result, ok := response.(*cloudfunctions.CallFunctionResponse)
+		assert.True(t, ok)
+		assert.Equal(t, "dry-run", result.ExecutionId)
+		assert.Equal(t, int32(0), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("respects the context deadline", func(t *testing.T) {
+		trigger := getFakeGCPCloudFunctionTrigger(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		})
+		ctx, cancel := context.WithCancel(context.TODO())
+		cancel()
+		_, err := trigger.Execute(ctx, testEvents, trigger.Trigger.Template.GCPCloudFunction)
+		assert.NotNil(t, err)
+		assert.False(t, IsTimeoutError(err))
+	})
+
+	t.Run("times out", func(t *testing.T) {
+		trigger := getFakeGCPCloudFunctionTrigger(t, func(w http.ResponseWriter, r *http.Request) {
+			<-r.Context().Done()
+		})
+		ctx, cancel := context.WithTimeout(context.TODO(), 100*time.Millisecond)
+		defer cancel()
+		_, err := trigger.Execute(ctx, testEvents, trigger.Trigger.Template.GCPCloudFunction)
+		assert.NotNil(t, err)
+		assert.True(t, IsTimeoutError(err))
+		assert.True(t, errors.Is(err, context.DeadlineExceeded))
+		assert.Contains(t, err.Error(), "timed out calling function")
+	})
+}
+
+func TestGCPCloudFunctionTrigger_FakeCaller(t *testing.T) {
+	t.Run("succeeds", func(t *testing.T) {
+		caller := &fakeFunctionCaller{response: &cloudfunctions.CallFunctionResponse{ExecutionId: "fake-id", Result: "ok"}}
+		trigger := getFakeCallerGCPCloudFunctionTrigger(caller)
+		response, err := trigger.Execute(context.TODO(), testEvents, trigger.Trigger.Template.GCPCloudFunction)
+		assert.Nil(t, err)
+		assert.Equal(t, caller.response, response)
+		assert.Equal(t, []string{"projects/fake-project/locations/us-central1/functions/fake-function"}, caller.names)
+		assert.Equal(t, `{"name":"real-function"}`, caller.requests[0].Data)
+	})
+
+	t.Run("payload missing", func(t *testing.T) {
+		caller := &fakeFunctionCaller{}
+		trigger := getFakeCallerGCPCloudFunctionTrigger(caller)
+		trigger.Trigger.Template.GCPCloudFunction.Payload = nil
+		_, err := trigger.Execute(context.TODO(), testEvents, trigger.Trigger.Template.GCPCloudFunction)
+		assert.True(t, errors.Is(err, triggers.ErrPayloadMissing))
+		assert.Empty(t, caller.names)
+	})
+
+	t.Run("call error", func(t *testing.T) {
+		caller := &fakeFunctionCaller{err: &googleapi.Error{Code: http.StatusForbidden}}
+		trigger := getFakeCallerGCPCloudFunctionTrigger(caller)
+		_, err := trigger.Execute(context.TODO(), testEvents, trigger.Trigger.Template.GCPCloudFunction)
+		assert.NotNil(t, err)
+		assert.True(t, triggers.IsPermanentError(err))
+		assert.Equal(t, 1, len(caller.names))
+	})
+
+	t.Run("templated function name", func(t *testing.T) {
+		caller := &fakeFunctionCaller{response: &cloudfunctions.CallFunctionResponse{ExecutionId: "fake-id"}}
+		trigger := getFakeCallerGCPCloudFunctionTrigger(caller)
+		trigger.Trigger.Template.GCPCloudFunction.Parameters = []v1alpha1.TriggerParameter{
+			{
+				Src: &v1alpha1.TriggerParameterSource{
+					DependencyName: "fake-dependency",
+					DataTemplate:   "projects/fake-project/locations/us-central1/functions/{{ .Input.name }}",
+				},
+				Dest: "functionName",
+			},
+		}
+		resource, err := trigger.ApplyResourceParameters(testEvents, trigger.Trigger.Template.GCPCloudFunction)
+		assert.Nil(t, err)
+		_, err = trigger.Execute(context.TODO(), testEvents, resource)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"projects/fake-project/locations/us-central1/functions/real-function"}, caller.names)
+	})
+
+	t.Run("health check", func(t *testing.T) {
+		caller := &fakeFunctionCaller{}
+		trigger := getFakeCallerGCPCloudFunctionTrigger(caller)
+		assert.Nil(t, trigger.CheckHealth(context.TODO()))
+		caller.err = &googleapi.Error{Code: http.StatusNotFound}
+		assert.NotNil(t, trigger.CheckHealth(context.TODO()))
+	})
+}
+
+func TestGCPCloudFunctionTrigger_Async(t *testing.T) {
+	t.Run("dispatches the call", func(t *testing.T) {
+		caller := &fakeFunctionCaller{response: &cloudfunctions.CallFunctionResponse{ExecutionId: "fake-id"}}
+		dispatcher := &fakeDispatcher{accept: true}
+		trigger := getFakeCallerGCPCloudFunctionTrigger(caller)
+		trigger.Dispatcher = dispatcher
+		trigger.Trigger.Template.GCPCloudFunction.Async = true
+		response, err := trigger.Execute(context.TODO(), testEvents, trigger.Trigger.Template.GCPCloudFunction)
+		assert.Nil(t, err)
+		assert.Equal(t, &DispatchResponse{Accepted: true}, response)
+		assert.Nil(t, trigger.ApplyPolicy(context.TODO(), response))
+		dispatcher.wg.Wait()
+		assert.Equal(t, 1, len(caller.names))
+	})
+
+	t.Run("does not fail the execution", func(t *testing.T) {
+		caller := &fakeFunctionCaller{err: &googleapi.Error{Code: http.StatusBadRequest}}
+		dispatcher := &fakeDispatcher{accept: true}
+		trigger := getFakeCallerGCPCloudFunctionTrigger(caller)
+		trigger.Dispatcher = dispatcher
+		trigger.Trigger.Template.GCPCloudFunction.Async = true
+		_, err := trigger.Execute(context.TODO(), testEvents, trigger.Trigger.Template.GCPCloudFunction)
+		assert.Nil(t, err)
+		dispatcher.wg.Wait()
+		assert.Equal(t, 1, len(caller.names))
+	})
+
+	t.Run("retries the call in the background", func(t *testing.T) {
+		caller := &fakeFunctionCaller{err: &googleapi.Error{Code: http.StatusServiceUnavailable}}
+		dispatcher := &fakeDispatcher{accept: true}
+		trigger := getFakeCallerGCPCloudFunctionTrigger(caller)
+		trigger.Dispatcher = dispatcher
+		trigger.Trigger.Template.GCPCloudFunction.Async = true
+		duration := apicommon.FromString("1ms")
+		trigger.Trigger.RetryStrategy = &apicommon.Backoff{Steps: 2, Duration: &duration}
+		_, err := trigger.Execute(context.TODO(), testEvents, trigger.Trigger.Template.GCPCloudFunction)
+		assert.Nil(t, err)
+		dispatcher.wg.Wait()
+		assert.Equal(t, 2, len(caller.names))
+
+		// The retry strategy of the function trigger takes precedence.
+		caller.names = nil
+		trigger.Trigger.Template.GCPCloudFunction.RetryStrategy = &apicommon.Backoff{Steps: 3, Duration: &duration}
+		_, err = trigger.Execute(context.TODO(), testEvents, trigger.Trigger.Template.GCPCloudFunction)
+		assert.Nil(t, err)
+		dispatcher.wg.Wait()
+		assert.Equal(t, 3, len(caller.names))
+	})
+
+	t.Run("calls before returning if not dispatched", func(t *testing.T) {
+		caller := &fakeFunctionCaller{response: &cloudfunctions.CallFunctionResponse{ExecutionId: "fake-id"}}
+		trigger := getFakeCallerGCPCloudFunctionTrigger(caller)
+		trigger.Dispatcher = &fakeDispatcher{}
+		trigger.Trigger.Template.GCPCloudFunction.Async = true
+		response, err := trigger.Execute(context.TODO(), testEvents, trigger.Trigger.Template.GCPCloudFunction)
+		assert.Nil(t, err)
+		assert.Equal(t, caller.response, response)
+		assert.Equal(t, 1, len(caller.names))
+	})
+
+	t.Run("not dispatched", func(t *testing.T) {
+		trigger := getFakeCallerGCPCloudFunctionTrigger(&fakeFunctionCaller{})
+		assert.NotNil(t, trigger.ApplyPolicy(context.TODO(), &DispatchResponse{}))
+	})
+}
+
+func TestGCPCloudFunctionTrigger_ExecuteErrors(t *testing.T) {
+	respondWith := func(statusCode int) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(statusCode)
+		}
+	}
+
+	t.Run("invalid resource", func(t *testing.T) {
+		trigger := getFakeGCPCloudFunctionTrigger(t, respondWith(http.StatusOK))
+		_, err := trigger.Execute(context.TODO(), testEvents, "fake")
+		assert.True(t, triggers.IsPermanentError(err))
+	})
+
+	t.Run("payload missing", func(t *testing.T) {
+		trigger := getFakeGCPCloudFunctionTrigger(t, respondWith(http.StatusOK))
+		trigger.Trigger.Template.GCPCloudFunction.Payload = nil
+		_, err := trigger.Execute(context.TODO(), testEvents, trigger.Trigger.Template.GCPCloudFunction)
+		assert.True(t, errors.Is(err, triggers.ErrPayloadMissing))
+		assert.True(t, triggers.IsPermanentError(err))
+	})
+
+	t.Run("malformed function name", func(t *testing.T) {
+		trigger := getFakeGCPCloudFunctionTrigger(t, respondWith(http.StatusOK))
+		trigger.Trigger.Template.GCPCloudFunction.FunctionName = "fake-function"
+		_, err := trigger.Execute(context.TODO(), testEvents, trigger.Trigger.Template.GCPCloudFunction)
+		assert.True(t, triggers.IsPermanentError(err))
+	})
+
+	t.Run("oversize payload", func(t *testing.T) {
+		trigger := getFakeGCPCloudFunctionTrigger(t, respondWith(http.StatusOK))
+		events := map[string]*v1alpha1.Event{
+			"fake-dependency": {
+				Context: testEvents["fake-dependency"].Context,
+				Data:    []byte(`{"name": "` + strings.Repeat("a", maxCallDataSize) + `"}`),
+			},
+		}
+		_, err := trigger.Execute(context.TODO(), events, trigger.Trigger.Template.GCPCloudFunction)
+		assert.True(t, triggers.IsPermanentError(err))
+	})
+
+	t.Run("unknown encoding", func(t *testing.T) {
+		trigger := getFakeGCPCloudFunctionTrigger(t, respondWith(http.StatusOK))
+		trigger.Trigger.Template.GCPCloudFunction.Encoding = "zstd"
+		_, err := trigger.Execute(context.TODO(), testEvents, trigger.Trigger.Template.GCPCloudFunction)
+		assert.True(t, triggers.IsPermanentError(err))
+	})
+
+	for _, statusCode := range []int{http.StatusBadRequest, http.StatusForbidden, http.StatusNotFound} {
+		t.Run("rejected with "+http.StatusText(statusCode), func(t *testing.T) {
+			trigger := getFakeGCPCloudFunctionTrigger(t, respondWith(statusCode))
+			_, err := trigger.Execute(context.TODO(), testEvents, trigger.Trigger.Template.GCPCloudFunction)
+			assert.True(t, triggers.IsPermanentError(err))
+			assert.False(t, triggers.IsRetryableError(err))
+		})
+	}
+
+	for _, statusCode := range []int{http.StatusUnauthorized, http.StatusTooManyRequests, http.StatusServiceUnavailable} {
+		t.Run("failed with "+http.StatusText(statusCode), func(t *testing.T) {
+			trigger := getFakeGCPCloudFunctionTrigger(t, respondWith(statusCode))
+			_, err := trigger.Execute(context.TODO(), testEvents, trigger.Trigger.Template.GCPCloudFunction)
+			assert.True(t, triggers.IsRetryableError(err))
+			assert.False(t, triggers.IsPermanentError(err))
+		})
+	}
+
+	t.Run("timed out", func(t *testing.T) {
+		trigger := getFakeGCPCloudFunctionTrigger(t, func(w http.ResponseWriter, r *http.Request) {
+			<-r.Context().Done()
+		})
+		ctx, cancel := context.WithTimeout(context.TODO(), 100*time.Millisecond)
+		defer cancel()
+		_, err := trigger.Execute(ctx, testEvents, trigger.Trigger.Template.GCPCloudFunction)
+		assert.True(t, triggers.IsRetryableError(err))
+		assert.True(t, IsTimeoutError(err))
+	})
+}
+
+func TestGCPCloudFunctionTrigger_ExecuteGen2(t *testing.T) {
+	t.Run("succeeds", func(t *testing.T) {
+		var authorization, contentType, userAgent, eventIDs string
+		var body []byte
+		trigger := getFakeGen2GCPCloudFunctionTrigger(t, func(w http.ResponseWriter, r *http.Request) {
+			authorization = r.Header.Get("Authorization")
+			contentType = r.Header.Get("Content-Type")
+			userAgent = r.Header.Get("User-Agent")
+			eventIDs = r.Header.Get(EventIDsHeader)
+			body, _ = ioutil.ReadAll(r.Body)
+			w.WriteHeader(http.StatusAccepted)
+			_, _ = w.Write([]byte("ok"))
+		})
+		response, err := trigger.Execute(context.TODO(), testEvents, trigger.Trigger.Template.GCPCloudFunction)
+		assert.Nil(t, err)
+		result, ok := response.(*http.Response)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusAccepted, result.StatusCode)
+		responseBody, err := ioutil.ReadAll(result.Body)
+		assert.Nil(t, err)
+		assert.Equal(t, "ok", string(responseBody))
+		assert.Equal(t, "Bearer fake-token", authorization)
+		assert.Equal(t, "application/json", contentType)
+		assert.True(t, strings.HasPrefix(userAgent, "argo-events/"))
+		assert.Contains(t, userAgent, "(sensor fake/fake-sensor; trigger fake-trigger)")
+		assert.Equal(t, "1", eventIDs)
+		assert.Equal(t, `{"name":"real-function"}`, string(body))
+	})
+
+	t.Run("retries on retryable status code", func(t *testing.T) {
+		var calls int32
+		trigger := getFakeGen2GCPCloudFunctionTrigger(t, func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt32(&calls, 1) < 3 {
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		})
+		_, err := executeWithRetries(trigger, 3)
+		assert.Nil(t, err)
+		assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("decodes the compressed response", func(t *testing.T) {
+		trigger := getFakeGen2GCPCloudFunctionTrigger(t, func(w http.ResponseWriter, r *http.Request) {
+			// The client only decodes the gzip responses it requested itself.
+			var buf bytes.Buffer
+			zw := zlib.NewWriter(&buf)
+			_, _ = zw.Write([]byte(`{"result": "ok"}`))
+			_ = zw.Close()
+			w.Header().Set("Content-Encoding", "deflate")
+			_, _ = w.Write(buf.Bytes())
+		})
+		response, err := trigger.Execute(context.TODO(), testEvents, trigger.Trigger.Template.GCPCloudFunction)
+		assert.Nil(t, err)
+		responseBody, err := ioutil.ReadAll(response.(*http.Response).Body)
+		assert.Nil(t, err)
+		assert.Equal(t, `{"result": "ok"}`, string(responseBody))
+	})
+
+	t.Run("fails on oversized response", func(t *testing.T) {
+		var calls int32
+		status := http.StatusOK
+		trigger := getFakeGen2GCPCloudFunctionTrigger(t, func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			w.WriteHeader(status)
+			_, _ = w.Write([]byte(strings.Repeat("x", 32)))
+		})
+		trigger.Trigger.Template.GCPCloudFunction.MaxResponseSize = 16
+		_, err := executeWithRetries(trigger, 3)
+		assert.NotNil(t, err)
+		assert.True(t, triggers.IsResponseTooLargeError(err))
+		assert.True(t, triggers.IsPermanentError(err))
+		assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+
+		// A failed call is retried on its status code, its oversized body is dropped.
+		status = http.StatusServiceUnavailable
+		_, err = executeWithRetries(trigger, 3)
+		assert.NotNil(t, err)
+		assert.False(t, triggers.IsResponseTooLargeError(err))
+		var apiErr *googleapi.Error
+		assert.True(t, errors.As(err, &apiErr))
+		assert.Equal(t, http.StatusServiceUnavailable, apiErr.Code)
+		assert.Empty(t, apiErr.Body)
+		assert.Equal(t, int32(4), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("wraps the payload in an envelope", func(t *testing.T) {
+		var contentType string
+		var body []byte
+		trigger := getFakeGen2GCPCloudFunctionTrigger(t, func(w http.ResponseWriter, r *http.Request) {
+			contentType = r.Header.Get("Content-Type")
+			body, _ = ioutil.ReadAll(r.Body)
+			w.WriteHeader(http.StatusOK)
+		})
+		trigger.Trigger.Template.GCPCloudFunction.Encoding = v1alpha1.GCPCloudFunctionEncodingGzip
+		trigger.Trigger.Template.GCPCloudFunction.Envelope = true
+		_, err := trigger.Execute(context.TODO(), testEvents, trigger.Trigger.Template.GCPCloudFunction)
+		assert.Nil(t, err)
+		assert.Equal(t, "application/json", contentType)
+		var envelope payloadEnvelope
+		assert.Nil(t, json.Unmarshal(body, &envelope))
+		assert.Equal(t, v1alpha1.GCPCloudFunctionEncodingGzip, envelope.Encoding)
+		assert.Equal(t, 24, envelope.Size)
+	})
+
+	t.Run("sends the templated headers", func(t *testing.T) {
+		var header http.Header
+		trigger := getFakeGen2GCPCloudFunctionTrigger(t, func(w http.ResponseWriter, r *http.Request) {
+			header = r.Header
+			w.WriteHeader(http.StatusOK)
+		})
+		trigger.Trigger.Template.GCPCloudFunction.Headers = map[string]string{"X-Source": "argo-events", "X-Tenant": "unknown"}
+		trigger.Trigger.Template.GCPCloudFunction.Parameters = []v1alpha1.TriggerParameter{
+			{
+				Src: &v1alpha1.TriggerParameterSource{
+					DependencyName: "fake-dependency",
+					DataKey:        "name",
+				},
+				Dest: "headers.X-Tenant",
+			},
+		}
+		resource, err := trigger.ApplyResourceParameters(testEvents, trigger.Trigger.Template.GCPCloudFunction)
+		assert.Nil(t, err)
+		_, err = trigger.Execute(context.TODO(), testEvents, resource)
+		assert.Nil(t, err)
+		assert.Equal(t, "argo-events", header.Get("X-Source"))
+		assert.Equal(t, "real-function", header.Get("X-Tenant"))
+		assert.Equal(t, "application/json", header.Get("Content-Type"))
+		assert.Equal(t, "Bearer fake-token", header.Get("Authorization"))
+	})
+
+	t.Run("transforms the events", func(t *testing.T) {
+		var body []byte
+		trigger := getFakeGen2GCPCloudFunctionTrigger(t, func(w http.ResponseWriter, r *http.Request) {
+			body, _ = ioutil.ReadAll(r.Body)
+			w.WriteHeader(http.StatusOK)
+		})
+		trigger.Trigger.Template.GCPCloudFunction.Transform = `{"function": events["fake-dependency"].name, "source": "argo-events"}`
+		trigger.Trigger.Template.GCPCloudFunction.Payload = []v1alpha1.TriggerParameter{
+			{
+				Src: &v1alpha1.TriggerParameterSource{
+					DependencyName: "fake-dependency",
+					ContextKey:     "id",
+				},
+				Dest: "eventId",
+			},
+		}
+		_, err := trigger.Execute(context.TODO(), testEvents, trigger.Trigger.Template.GCPCloudFunction)
+		assert.Nil(t, err)
+		assert.JSONEq(t, `{"function":"real-function","source":"argo-events","eventId":"1"}`, string(body))
+	})
+
+	t.Run("fails on a transform error", func(t *testing.T) {
+		var calls int32
+		trigger := getFakeGen2GCPCloudFunctionTrigger(t, func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+		})
+		trigger.Trigger.Template.GCPCloudFunction.Transform = `{"function": events["fake-dependency"].missing}`
+		trigger.Trigger.Template.GCPCloudFunction.Payload = nil
+		_, err := trigger.Execute(context.TODO(), testEvents, trigger.Trigger.Template.GCPCloudFunction)
+		assert.NotNil(t, err)
+		assert.True(t, triggers.IsPermanentError(err))
+		assert.Contains(t, err.Error(), "failed to transform the events")
+		assert.Equal(t, int32(0), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("headers override the content type", func(t *testing.T) {
+		var contentType string
+		trigger := getFakeGen2GCPCloudFunctionTrigger(t, func(w http.ResponseWriter, r *http.Request) {
+			contentType = r.Header.Get("Content-Type")
+			w.WriteHeader(http.StatusOK)
+		})
+		trigger.Trigger.Template.GCPCloudFunction.Headers = map[string]string{"content-type": "application/cloudevents+json"}
+		_, err := trigger.Execute(context.TODO(), testEvents, trigger.Trigger.Template.GCPCloudFunction)
+		assert.Nil(t, err)
+		assert.Equal(t, "application/cloudevents+json", contentType)
+	})
+
+	t.Run("fails on a missing secure header", func(t *testing.T) {
+		var calls int32
+		trigger := getFakeGen2GCPCloudFunctionTrigger(t, func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+		})
+		trigger.Trigger.Template.GCPCloudFunction.SecureHeaders = []*apicommon.SecureHeader{
+			{
+				Name: "X-Api-Key",
+				ValueFrom: &apicommon.ValueFromSource{
+					SecretKeyRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "missing"}, Key: "key"},
+				},
+			},
+		}
+		_, err := trigger.Execute(context.TODO(), testEvents, trigger.Trigger.Template.GCPCloudFunction)
+		assert.NotNil(t, err)
+		assert.True(t, triggers.IsPermanentError(err))
+		assert.Contains(t, err.Error(), "secure header X-Api-Key")
+		assert.Equal(t, int32(0), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("fails on unauthorized", func(t *testing.T) {
+		trigger := getFakeGen2GCPCloudFunctionTrigger(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+		})
+		_, err := trigger.Execute(context.TODO(), testEvents, trigger.Trigger.Template.GCPCloudFunction)
+		assert.NotNil(t, err)
+		assert.True(t, isAuthError(err))
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		var calls int32
+		trigger := getFakeGen2GCPCloudFunctionTrigger(t, func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+		})
+		trigger.Trigger.Template.DryRun = true
+		response, err := trigger.Execute(context.TODO(), testEvents, trigger.Trigger.Template.GCPCloudFunction)
+		assert.Nil(t, err)
+		result, ok := response.(*http.Response)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusOK, result.StatusCode)
+		assert.Equal(t, int32(0), atomic.LoadInt32(&calls))
+	})
+}
+
+func TestGCPCloudFunctionTrigger_RetryOnResponse(t *testing.T) {
+	retryOnResponse := `has(response.body.retryable) && response.body.retryable`
+
+	t.Run("retries on retryable body until it succeeds", func(t *testing.T) {
+		var calls int32
+		trigger := getFakeGen2GCPCloudFunctionTrigger(t, func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt32(&calls, 1) < 3 {
+				// A status which is not retried by default.
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"error": "lock held", "retryable": true}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"result": "ok"}`))
+		})
+		trigger.Trigger.Template.GCPCloudFunction.RetryOnResponse = retryOnResponse
+		response, err := executeWithRetries(trigger, 3)
+		assert.Nil(t, err)
+		assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+		// The body evaluated by the condition can still be read.
+		responseBody, err := ioutil.ReadAll(response.(*http.Response).Body)
+		assert.Nil(t, err)
+		assert.Equal(t, `{"result": "ok"}`, string(responseBody))
+	})
+
+	t.Run("terminal body is not retried", func(t *testing.T) {
+		var calls int32
+		trigger := getFakeGen2GCPCloudFunctionTrigger(t, func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			// A status which is retried by default.
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte(`{"error": "invalid order", "retryable": false}`))
+		})
+		trigger.Trigger.Template.GCPCloudFunction.RetryOnResponse = retryOnResponse
+		_, err := executeWithRetries(trigger, 3)
+		assert.NotNil(t, err)
+		assert.True(t, triggers.IsPermanentError(err))
+		assert.Contains(t, err.Error(), "invalid order")
+		assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("body without the field is classified by its status", func(t *testing.T) {
+		var calls int32
+		trigger := getFakeGen2GCPCloudFunctionTrigger(t, func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt32(&calls, 1) < 2 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				_, _ = w.Write([]byte(`unavailable`))
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		})
+		trigger.Trigger.Template.GCPCloudFunction.RetryOnResponse = `response.body.retryable == true`
+		_, err := executeWithRetries(trigger, 3)
+		assert.Nil(t, err)
+		assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("retries exhausted on the result of a 1st gen function", func(t *testing.T) {
+		caller := &fakeFunctionCaller{response: &cloudfunctions.CallFunctionResponse{
+			ExecutionId:    "fake-id",
+			Result:         `{"retryable": true}`,
+			ServerResponse: googleapi.ServerResponse{HTTPStatusCode: http.StatusOK},
+		}}
+		trigger := getFakeCallerGCPCloudFunctionTrigger(caller)
+		trigger.Trigger.Template.GCPCloudFunction.RetryOnResponse = retryOnResponse
+		_, err := executeWithRetries(trigger, 3)
+		assert.NotNil(t, err)
+		var responseErr *RetryableResponseError
+		assert.True(t, errors.As(err, &responseErr))
+		assert.Equal(t, http.StatusOK, responseErr.StatusCode)
+		assert.True(t, triggers.IsRetryableError(err))
+		assert.Equal(t, 3, len(caller.names))
+	})
+
+	t.Run("api errors of a 1st gen function are classified by their status", func(t *testing.T) {
+		caller := &fakeFunctionCaller{err: &googleapi.Error{Code: http.StatusForbidden, Body: `{"retryable": true}`}}
+		trigger := getFakeCallerGCPCloudFunctionTrigger(caller)
+		trigger.Trigger.Template.GCPCloudFunction.RetryOnResponse = retryOnResponse
+		_, err := executeWithRetries(trigger, 3)
+		assert.True(t, triggers.IsPermanentError(err))
+		assert.Equal(t, 1, len(caller.names))
+	})
+}
+
+func TestGCPCloudFunctionTrigger_ExecutePubSub(t *testing.T) {
+	t.Run("publishes the payload", func(t *testing.T) {
+		trigger, server := getFakePubSubGCPCloudFunctionTrigger(t)
+		response, err := trigger.Execute(context.TODO(), testEvents, trigger.Trigger.Template.GCPCloudFunction)
+		assert.Nil(t, err)
+		result, ok := response.(*PublishResponse)
+		assert.True(t, ok)
+		messages := server.Messages()
+		assert.Equal(t, 1, len(messages))
+		assert.Equal(t, messages[0].ID, result.MessageID)
+		assert.Equal(t, `{"name":"real-function"}`, string(messages[0].Data))
+	})
+
+	t.Run("publishes the headers as attributes", func(t *testing.T) {
+		trigger, server := getFakePubSubGCPCloudFunctionTrigger(t)
+		trigger.Trigger.Template.GCPCloudFunction.Headers = map[string]string{"X-Tenant": "fake-tenant"}
+		_, err := trigger.Execute(context.TODO(), testEvents, trigger.Trigger.Template.GCPCloudFunction)
+		assert.Nil(t, err)
+		messages := server.Messages()
+		assert.Equal(t, 1, len(messages))
+		assert.Equal(t, "fake-tenant", messages[0].Attributes["X-Tenant"])
+		assert.Equal(t, "1", messages[0].Attributes[EventIDsHeader])
+	})
+
+	t.Run("ignores the function name", func(t *testing.T) {
+		trigger, server := getFakePubSubGCPCloudFunctionTrigger(t)
+		trigger.Trigger.Template.GCPCloudFunction.FunctionName = ""
+		_, err := trigger.Execute(context.TODO(), testEvents, trigger.Trigger.Template.GCPCloudFunction)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(server.Messages()))
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		trigger, server := getFakePubSubGCPCloudFunctionTrigger(t)
+		trigger.Trigger.Template.DryRun = true
+		response, err := trigger.Execute(context.TODO(), testEvents, trigger.Trigger.Template.GCPCloudFunction)
+		assert.Nil(t, err)
+		assert.Equal(t, &PublishResponse{MessageID: "dry-run"}, response)
+		assert.Equal(t, 0, len(server.Messages()))
+	})
+}
+
+func TestGCPCloudFunctionTrigger_ApplyPolicy(t *testing.T) {
+	trigger := getFakeGCPCloudFunctionTrigger(t, nil)
+	response := &cloudfunctions.CallFunctionResponse{
+		ExecutionId: "fake-id",
+		Result:      "ok",
+		ServerResponse: googleapi.ServerResponse{
+			HTTPStatusCode: http.StatusOK,
+		},
+	}
+
+	err := trigger.ApplyPolicy(context.TODO(), response)
+	assert.Nil(t, err)
+
+	trigger.Trigger.Policy = &v1alpha1.TriggerPolicy{
+		Status: &v1alpha1.StatusPolicy{Allow: []int32{200}},
+	}
+	err = trigger.ApplyPolicy(context.TODO(), response)
+	assert.Nil(t, err)
+
+	trigger.Trigger.Policy.Status.Allow = []int32{202}
+	err = trigger.ApplyPolicy(context.TODO(), response)
+	assert.NotNil(t, err)
+
+	response.Error = "function crashed"
+	trigger.Trigger.Policy = nil
+	err = trigger.ApplyPolicy(context.TODO(), response)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "function crashed")
+
+	trigger.Trigger.Policy = &v1alpha1.TriggerPolicy{
+		Status: &v1alpha1.StatusPolicy{Allow: []int32{200}},
+	}
+	err = trigger.ApplyPolicy(context.TODO(), &http.Response{StatusCode: http.StatusOK})
+	assert.Nil(t, err)
+	err = trigger.ApplyPolicy(context.TODO(), &http.Response{StatusCode: http.StatusAccepted})
+	assert.NotNil(t, err)
+
+	err = trigger.ApplyPolicy(context.TODO(), &PublishResponse{MessageID: "fake-id"})
+	assert.Nil(t, err)
+	err = trigger.ApplyPolicy(context.TODO(), &PublishResponse{})
+	assert.NotNil(t, err)
+
+	err = trigger.ApplyPolicy(context.TODO(), "fake")
+	assert.NotNil(t, err)
+}
+
+func TestGCPCloudFunctionTrigger_Output(t *testing.T) {
+	trigger := getFakeGCPCloudFunctionTrigger(t, nil)
+	statusCode, body, err := trigger.Output(&cloudfunctions.CallFunctionResponse{
+		ExecutionId:    "fake-id",
+		Result:         `{"id":"abc"}`,
+		ServerResponse: googleapi.ServerResponse{HTTPStatusCode: http.StatusOK},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.Equal(t, `{"id":"abc"}`, string(body))
+
+	statusCode, body, err = trigger.Output(&PublishResponse{MessageID: "fake-id"})
+	assert.Nil(t, err)
+	assert.Equal(t, 0, statusCode)
+	assert.Equal(t, `{"messageId":"fake-id"}`, string(body))
+
+	_, _, err = trigger.Output("fake")
+	assert.NotNil(t, err)
+}
+
+func TestGCPCloudFunctionTrigger_CheckHealth(t *testing.T) {
+	t.Run("function found", func(t *testing.T) {
+		trigger := getFakeGCPCloudFunctionTrigger(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodGet, r.Method)
+			assert.Equal(t, "/v1/projects/fake-project/locations/us-central1/functions/fake-function", r.URL.Path)
+			_, _ = w.Write([]byte(`{"name": "projects/fake-project/locations/us-central1/functions/fake-function"}`))
+		})
+		assert.Nil(t, trigger.CheckHealth(context.TODO()))
+	})
+
+	t.Run("function not found", func(t *testing.T) {
+		trigger := getFakeGCPCloudFunctionTrigger(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error": {"code": 404, "message": "function not found"}}`))
+		})
+		err := trigger.CheckHealth(context.TODO())
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "fake-function")
+	})
+
+	t.Run("location", func(t *testing.T) {
+		trigger := getFakeGCPCloudFunctionTrigger(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/v1/projects/fake-project/locations/europe-west1/functions/fake-function", r.URL.Path)
+			_, _ = w.Write([]byte(`{}`))
+		})
+		trigger.Trigger.Template.GCPCloudFunction.Location = "europe-west1"
+		assert.Nil(t, trigger.CheckHealth(context.TODO()))
+	})
+
+	t.Run("templated function name", func(t *testing.T) {
+		trigger := getFakeGCPCloudFunctionTrigger(t, func(w http.ResponseWriter, r *http.Request) {
+			t.Error("the function name is only known from the events")
+		})
+		trigger.Trigger.Template.GCPCloudFunction.Parameters = []v1alpha1.TriggerParameter{
+			{
+				Src: &v1alpha1.TriggerParameterSource{
+					DependencyName: "fake-dependency",
+					DataKey:        "name",
+				},
+				Dest: "functionName",
+			},
+		}
+		assert.Nil(t, trigger.CheckHealth(context.TODO()))
+	})
+
+	t.Run("gen2", func(t *testing.T) {
+		trigger := getFakeGen2GCPCloudFunctionTrigger(t, func(w http.ResponseWriter, r *http.Request) {
+			t.Error("the 2nd gen function must not be called")
+		})
+		assert.Nil(t, trigger.CheckHealth(context.TODO()))
+
+		trigger.HTTPClient.Transport.(*oauth2.Transport).Source = &fakeTokenSource{err: errors.New("fake error")}
+		assert.NotNil(t, trigger.CheckHealth(context.TODO()))
+	})
+}
+
+func TestNewTokenSource(t *testing.T) {
+	credentialsJSON := []byte(`{"type": "service_account", "client_email": "fake@fake-project.iam.gserviceaccount.com"}`)
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "gcp-credentials",
+			Namespace: "fake",
+		},
+		Data: map[string][]byte{
+			"key.json": credentialsJSON,
+		},
+	}
+	kubeClient := fake.NewSimpleClientset(secret)
+	logger := logging.NewArgoEventsLogger()
+	credentialsPath := filepath.Join(t.TempDir(), "key.json")
+	assert.Nil(t, ioutil.WriteFile(credentialsPath, credentialsJSON, 0600))
+
+	t.Run("default credentials", func(t *testing.T) {
+		t.Setenv("GOOGLE_APPLICATION_CREDENTIALS", credentialsPath)
+		tokenSource, err := newTokenSource(context.TODO(), kubeClient, "fake", &v1alpha1.GCPCloudFunctionTrigger{}, logger)
+		assert.Nil(t, err)
+		assert.NotNil(t, tokenSource)
+	})
+
+	t.Run("credentials secret over path", func(t *testing.T) {
+		tokenSource, err := newTokenSource(context.TODO(), kubeClient, "fake", &v1alpha1.GCPCloudFunctionTrigger{
+			CredentialsPath: "/fake/key.json",
+			CredentialsSecret: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "gcp-credentials"},
+				Key:                  "key.json",
+			},
+		}, logger)
+		assert.Nil(t, err)
+		assert.NotNil(t, tokenSource)
+	})
+
+	t.Run("credentials path", func(t *testing.T) {
+		tokenSource, err := newTokenSource(context.TODO(), kubeClient, "fake", &v1alpha1.GCPCloudFunctionTrigger{
+			CredentialsPath: credentialsPath,
+		}, logger)
+		assert.Nil(t, err)
+		assert.NotNil(t, tokenSource)
+
+		_, err = newTokenSource(context.TODO(), kubeClient, "fake", &v1alpha1.GCPCloudFunctionTrigger{
+			CredentialsPath: "/fake/key.json",
+		}, logger)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("missing secret key", func(t *testing.T) {
+		_, err := newTokenSource(context.TODO(), kubeClient, "fake", &v1alpha1.GCPCloudFunctionTrigger{
+			CredentialsSecret: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "gcp-credentials"},
+				Key:                  "missing",
+			},
+		}, logger)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestNewTokenSource_Impersonation(t *testing.T) {
+	credentialsPath := filepath.Join(t.TempDir(), "key.json")
+	assert.Nil(t, ioutil.WriteFile(credentialsPath, []byte(`{"type": "service_account", "client_email": "sensor@fake-project.iam.gserviceaccount.com"}`), 0600))
+	functionTrigger := &v1alpha1.GCPCloudFunctionTrigger{
+		CredentialsPath:           credentialsPath,
+		URL:                       "https://fake-function-abc-uc.a.run.app",
+		ImpersonateServiceAccount: "invoker@tenant-project.iam.gserviceaccount.com",
+		ImpersonationDelegates:    []string{"delegate@fake-project.iam.gserviceaccount.com"},
+	}
+	impersonated := &fakeTokenSource{}
+	credentials, idToken := impersonateCredentials, impersonateIDToken
+	defer func() {
+		impersonateCredentials, impersonateIDToken = credentials, idToken
+	}()
+
+	t.Run("access tokens", func(t *testing.T) {
+		var config impersonate.CredentialsConfig
+		var opts []option.ClientOption
+		impersonateCredentials = func(_ context.Context, c impersonate.CredentialsConfig, o ...option.ClientOption) (oauth2.TokenSource, error) {
+			config, opts = c, o
+			return impersonated, nil
+		}
+		tokenSource, err := newTokenSource(context.TODO(), fake.NewSimpleClientset(), "fake", functionTrigger, logging.NewArgoEventsLogger())
+		assert.Nil(t, err)
+		assert.Same(t, impersonated, tokenSource)
+		assert.Equal(t, "invoker@tenant-project.iam.gserviceaccount.com", config.TargetPrincipal)
+		assert.Equal(t, []string{"delegate@fake-project.iam.gserviceaccount.com"}, config.Delegates)
+		assert.Equal(t, []string{cloudfunctions.CloudPlatformScope}, config.Scopes)
+		// The base credentials are those of the trigger.
+		assert.Len(t, opts, 1)
+	})
+
+	t.Run("identity tokens", func(t *testing.T) {
+		var config impersonate.IDTokenConfig
+		impersonateIDToken = func(_ context.Context, c impersonate.IDTokenConfig, _ ...option.ClientOption) (oauth2.TokenSource, error) {
+			config = c
+			return impersonated, nil
+		}
+		tokenSource, err := newIDTokenSource(context.TODO(), fake.NewSimpleClientset(), "fake", functionTrigger, logging.NewArgoEventsLogger())
+		assert.Nil(t, err)
+		assert.Same(t, impersonated, tokenSource)
+		assert.Equal(t, "https://fake-function-abc-uc.a.run.app", config.Audience)
+		assert.Equal(t, "invoker@tenant-project.iam.gserviceaccount.com", config.TargetPrincipal)
+		assert.Equal(t, []string{"delegate@fake-project.iam.gserviceaccount.com"}, config.Delegates)
+	})
+
+	t.Run("impersonation failure", func(t *testing.T) {
+		impersonateCredentials = func(context.Context, impersonate.CredentialsConfig, ...option.ClientOption) (oauth2.TokenSource, error) {
+			return nil, errors.New("permission denied")
+		}
+		_, err := newTokenSource(context.TODO(), fake.NewSimpleClientset(), "fake", functionTrigger, logging.NewArgoEventsLogger())
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "failed to impersonate the service account invoker@tenant-project.iam.gserviceaccount.com")
+	})
+}
+
+func TestValidateCredentials(t *testing.T) {
+	secret := corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "credentials"}, Key: "key.json"}
+	templated := []v1alpha1.TriggerParameter{{Dest: "credentialsKey"}}
+	tests := []struct {
+		name        string
+		credentials map[string]corev1.SecretKeySelector
+		key         string
+		parameters  []v1alpha1.TriggerParameter
+		wantErr     string
+	}{
+		{name: "none"},
+		{name: "fixed key", credentials: map[string]corev1.SecretKeySelector{"a": secret}, key: "a"},
+		{name: "templated key", credentials: map[string]corev1.SecretKeySelector{"a": secret}, parameters: templated},
+		{name: "unknown key", credentials: map[string]corev1.SecretKeySelector{"a": secret}, key: "b", wantErr: `unknown credentials key "b"`},
+		{name: "missing key", credentials: map[string]corev1.SecretKeySelector{"a": secret}, wantErr: `unknown credentials key ""`},
+		{name: "key without credentials", key: "a", wantErr: "credentialsKey requires credentials"},
+		{name: "templated key without credentials", parameters: templated, wantErr: "credentialsKey requires credentials"},
+		{name: "empty name", credentials: map[string]corev1.SecretKeySelector{"": secret}, parameters: templated, wantErr: "credentials key can't be empty"},
+		{name: "missing secret", credentials: map[string]corev1.SecretKeySelector{"a": {}}, parameters: templated, wantErr: "credentials a must reference a key of a secret"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateCredentials(&v1alpha1.GCPCloudFunctionTrigger{Credentials: tt.credentials, CredentialsKey: tt.key, Parameters: tt.parameters})
+			if tt.wantErr != "" {
+				assert.NotNil(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			assert.Nil(t, err)
+		})
+	}
+}
+
+func TestValidateImpersonation(t *testing.T) {
+	tests := []struct {
+		name      string
+		account   string
+		delegates []string
+		wantErr   bool
+	}{
+		{name: "none"},
+		{name: "service account", account: "invoker@tenant-project.iam.gserviceaccount.com"},
+		{name: "default compute service account", account: "123456789-compute@developer.gserviceaccount.com"},
+		{name: "delegates", account: "invoker@p.iam.gserviceaccount.com", delegates: []string{"delegate@p.iam.gserviceaccount.com"}},
+		{name: "user email", account: "someone@example.com", wantErr: true},
+		{name: "not an email", account: "invoker", wantErr: true},
+		{name: "invalid delegate", account: "invoker@p.iam.gserviceaccount.com", delegates: []string{"delegate"}, wantErr: true},
+		{name: "delegates without account", delegates: []string{"delegate@p.iam.gserviceaccount.com"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateImpersonation(&v1alpha1.GCPCloudFunctionTrigger{ImpersonateServiceAccount: tt.account, ImpersonationDelegates: tt.delegates})
+			if tt.wantErr {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+		})
+	}
+
+	// The impersonated service account is checked when the trigger is constructed.
+	sensor := sensorObj.DeepCopy()
+	sensor.Spec.Triggers[0].Template.GCPCloudFunction.ImpersonateServiceAccount = "invoker"
+	_, err := NewGCPCloudFunctionTrigger(fake.NewSimpleClientset(), newCachedClientCache(sensor), sensor, &sensor.Spec.Triggers[0], logging.NewArgoEventsLogger())
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "invalid service account")
+}
+
+type fakeTokenSource struct {
+	err error
+}
+
+func (s *fakeTokenSource) Token() (*oauth2.Token, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &oauth2.Token{AccessToken: "fake-token", Expiry: time.Now().Add(time.Hour)}, nil
+}
+
+func TestObservedTokenSource(t *testing.T) {
+	source := &fakeTokenSource{err: errors.New("token expired")}
+	var failures int
+	tokenSource := oauth2.ReuseTokenSource(nil, &observedTokenSource{
+		source:    source,
+		onFailure: func(error) { failures++ },
+	})
+
+	_, err := tokenSource.Token()
+	assert.NotNil(t, err)
+	assert.True(t, isAuthError(err))
+	assert.Equal(t, 1, failures)
+
+	source.err = nil
+	token, err := tokenSource.Token()
+	assert.Nil(t, err)
+	assert.Equal(t, "fake-token", token.AccessToken)
+	_, err = tokenSource.Token()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, failures)
+}
+
+func TestGCPCloudFunctionTrigger_CheckAuthentication(t *testing.T) {
+	var unauthorized int32 = 1
+	trigger := getFakeGCPCloudFunctionTrigger(t, func(w http.ResponseWriter, r *http.Request) {
+		if atomic.LoadInt32(&unauthorized) == 1 {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"executionId": "fake-id"}`))
+	})
+	name := trigger.Trigger.Template.Name
+	trigger.cache.clients[name] = &gcpClient{caller: trigger.Caller}
+
+	for i := 0; i < maxAuthFailures-1; i++ {
+		_, err := trigger.Execute(context.TODO(), testEvents, trigger.Trigger.Template.GCPCloudFunction)
+		assert.NotNil(t, err)
+	}
+	// A successful execution resets the failures.
+	atomic.StoreInt32(&unauthorized, 0)
+	_, err := trigger.Execute(context.TODO(), testEvents, trigger.Trigger.Template.GCPCloudFunction)
+	assert.Nil(t, err)
+	atomic.StoreInt32(&unauthorized, 1)
+	for i := 0; i < maxAuthFailures-1; i++ {
+		_, err := trigger.Execute(context.TODO(), testEvents, trigger.Trigger.Template.GCPCloudFunction)
+		assert.NotNil(t, err)
+	}
+	assert.Contains(t, trigger.cache.clients, name)
+
+	_, err = trigger.Execute(context.TODO(), testEvents, trigger.Trigger.Template.GCPCloudFunction)
+	assert.NotNil(t, err)
+	assert.NotContains(t, trigger.cache.clients, name)
+}
+
+func TestNewGCPCloudFunctionTrigger_CredentialsRotation(t *testing.T) {
+	credentialsPath := filepath.Join(t.TempDir(), "key.json")
+	assert.Nil(t, ioutil.WriteFile(credentialsPath, []byte(`{"type": "service_account", "client_email": "fake@fake-project.iam.gserviceaccount.com"}`), 0600))
+	sensor := sensorObj.DeepCopy()
+	sensor.Spec.Triggers[0].Template.GCPCloudFunction.CredentialsPath = credentialsPath
+	trigger := &sensor.Spec.Triggers[0]
+	cache := newClientCache()
+	newTrigger := func() *GCPCloudFunctionTrigger {
+		ft, err := NewGCPCloudFunctionTrigger(fake.NewSimpleClientset(), cache, sensor, trigger, logging.NewArgoEventsLogger())
+		assert.Nil(t, err)
+		return ft
+	}
+	clientCount := func() int {
+		cache.lock.Lock()
+		defer cache.lock.Unlock()
+		return len(cache.clients)
+	}
+
+	first := newTrigger()
+	assert.Same(t, first.Caller, newTrigger().Caller)
+	assert.NotNil(t, cache.clients[trigger.Template.Name].stopWatching)
+
+	assert.Nil(t, ioutil.WriteFile(credentialsPath, []byte(`{"type": "service_account", "client_email": "rotated@fake-project.iam.gserviceaccount.com"}`), 0600))
+	assert.Eventually(t, func() bool {
+		return clientCount() == 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	rebuilt := newTrigger()
+	assert.NotSame(t, first.Caller, rebuilt.Caller)
+	cache.lock.Lock()
+	cache.clients[trigger.Template.Name].close()
+	cache.lock.Unlock()
+}
+
+func TestGCPCloudFunctionTrigger_Credentials(t *testing.T) {
+	newSecret := func(name, email string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "fake"},
+			Data:       map[string][]byte{"key.json": []byte(`{"type": "service_account", "client_email": "` + email + `"}`)},
+		}
+	}
+	kubeClient := fake.NewSimpleClientset(
+		newSecret("tenant-a-credentials", "invoker@tenant-a.iam.gserviceaccount.com"),
+		newSecret("tenant-b-credentials", "invoker@tenant-b.iam.gserviceaccount.com"),
+	)
+	newSensor := func() *v1alpha1.Sensor {
+		sensor := sensorObj.DeepCopy()
+		sensor.Spec.Triggers[0].Template.DryRun = true
+		sensor.Spec.Triggers[0].Template.GCPCloudFunction.Credentials = map[string]corev1.SecretKeySelector{
+			"tenant-a": {LocalObjectReference: corev1.LocalObjectReference{Name: "tenant-a-credentials"}, Key: "key.json"},
+			"tenant-b": {LocalObjectReference: corev1.LocalObjectReference{Name: "tenant-b-credentials"}, Key: "key.json"},
+		}
+		return sensor
+	}
+
+	t.Run("selected by the events", func(t *testing.T) {
+		sensor := newSensor()
+		trigger := &sensor.Spec.Triggers[0]
+		trigger.Template.GCPCloudFunction.Parameters = []v1alpha1.TriggerParameter{
+			{Src: &v1alpha1.TriggerParameterSource{DependencyName: "fake-dependency", DataKey: "tenant"}, Dest: "credentialsKey"},
+		}
+		cache := newClientCache()
+		ft, err := NewGCPCloudFunctionTrigger(kubeClient, cache, sensor, trigger, logging.NewArgoEventsLogger())
+		assert.Nil(t, err)
+		// The clients are built by the executions.
+		assert.Empty(t, cache.clients)
+		assert.Nil(t, ft.CheckHealth(context.TODO()))
+
+		execute := func(tenant string) error {
+			events := map[string]*v1alpha1.Event{
+				"fake-dependency": {
+					Context: testEvents["fake-dependency"].Context,
+					Data:    []byte(`{"name": "real-function", "tenant": "` + tenant + `"}`),
+				},
+			}
+			resource, err := ft.ApplyResourceParameters(events, trigger.Template.GCPCloudFunction)
+			assert.Nil(t, err)
+			_, err = ft.Execute(context.TODO(), events, resource)
+			return err
+		}
+
+		assert.Nil(t, execute("tenant-a"))
+		assert.Len(t, cache.clients, 1)
+		tenantA := cache.clients["fake-trigger/tenant-a"]
+		assert.NotNil(t, tenantA)
+
+		// The client of the credentials is cached.
+		assert.Nil(t, execute("tenant-a"))
+		assert.Len(t, cache.clients, 1)
+		assert.Same(t, tenantA, cache.clients["fake-trigger/tenant-a"])
+
+		assert.Nil(t, execute("tenant-b"))
+		assert.Len(t, cache.clients, 2)
+		assert.NotSame(t, tenantA.caller, cache.clients["fake-trigger/tenant-b"].caller)
+
+		err = execute("tenant-c")
+		assert.NotNil(t, err)
+		assert.True(t, triggers.IsPermanentError(err))
+		assert.Contains(t, err.Error(), `unknown credentials key "tenant-c", expected one of ["tenant-a" "tenant-b"]`)
+		assert.Len(t, cache.clients, 2)
+	})
+
+	t.Run("fixed key", func(t *testing.T) {
+		sensor := newSensor()
+		trigger := &sensor.Spec.Triggers[0]
+		trigger.Template.GCPCloudFunction.CredentialsKey = "tenant-b"
+		cache := newClientCache()
+		ft, err := NewGCPCloudFunctionTrigger(kubeClient, cache, sensor, trigger, logging.NewArgoEventsLogger())
+		assert.Nil(t, err)
+		assert.Len(t, cache.clients, 1)
+		assert.Same(t, cache.clients["fake-trigger/tenant-b"].caller, ft.Caller)
+
+		trigger.Template.GCPCloudFunction.CredentialsKey = "tenant-c"
+		_, err = NewGCPCloudFunctionTrigger(kubeClient, cache, sensor, trigger, logging.NewArgoEventsLogger())
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), `unknown credentials key "tenant-c"`)
+	})
+}
+
+func TestNewGCPCloudFunctionTrigger_FunctionName(t *testing.T) {
+	tests := []struct {
+		name         string
+		functionName string
+		location     string
+		parameters   []v1alpha1.TriggerParameter
+		invocation   v1alpha1.GCPCloudFunctionInvocation
+		wantErr      bool
+	}{
+		{name: "full name", functionName: "projects/p/locations/us-central1/functions/f"},
+		{name: "full name with location", functionName: "projects/p/locations/us-central1/functions/f", location: "europe-west1"},
+		{name: "short name", functionName: "f", wantErr: true},
+		{name: "empty name", functionName: "", wantErr: true},
+		{name: "missing project", functionName: "projects//locations/us-central1/functions/f", wantErr: true},
+		{name: "missing location", functionName: "projects/p/functions/f", wantErr: true},
+		{name: "extra segment", functionName: "projects/p/locations/us-central1/functions/f/extra", wantErr: true},
+		{name: "misspelled collection", functionName: "project/p/locations/us-central1/functions/f", wantErr: true},
+		{name: "short name with location", functionName: "f", location: "europe-west1", wantErr: true},
+		{
+			name:         "templated name",
+			functionName: "f",
+			parameters:   []v1alpha1.TriggerParameter{{Src: &v1alpha1.TriggerParameterSource{DependencyName: "fake-dependency", Value: new(string)}, Dest: "functionName"}},
+		},
+		{name: "pubsub invocation", functionName: "", invocation: v1alpha1.GCPCloudFunctionInvocationPubSub},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sensor := sensorObj.DeepCopy()
+			functionTrigger := sensor.Spec.Triggers[0].Template.GCPCloudFunction
+			functionTrigger.FunctionName = tt.functionName
+			functionTrigger.Location = tt.location
+			functionTrigger.Parameters = tt.parameters
+			functionTrigger.Invocation = tt.invocation
+			// The client is cached, only the function name is checked.
+			_, err := NewGCPCloudFunctionTrigger(fake.NewSimpleClientset(), newCachedClientCache(sensor), sensor, &sensor.Spec.Triggers[0], logging.NewArgoEventsLogger())
+			if tt.wantErr {
+				assert.NotNil(t, err)
+				assert.Contains(t, err.Error(), "projects/{project}/locations/{location}/functions/{function}")
+				return
+			}
+			assert.Nil(t, err)
+		})
+	}
+}
+
+func TestNewTriggerTransport(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	caBundle := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	kubeClient := fake.NewSimpleClientset(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "proxy-ca", Namespace: "fake"},
+		Data:       map[string][]byte{"ca.pem": caBundle},
+	})
+
+	transport, err := newTriggerTransport(context.TODO(), kubeClient, "fake", &v1alpha1.GCPCloudFunctionTrigger{
+		ProxyURL: "http://proxy.example.com:3128",
+		CACertificate: &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "proxy-ca"},
+			Key:                  "ca.pem",
+		},
+	})
+	assert.Nil(t, err)
+	req, err := http.NewRequest(http.MethodPost, "https://cloudfunctions.googleapis.com", nil)
+	assert.Nil(t, err)
+	proxy, err := transport.Proxy(req)
+	assert.Nil(t, err)
+	assert.Equal(t, &url.URL{Scheme: "http", Host: "proxy.example.com:3128"}, proxy)
+
+	transport.Proxy = nil
+	resp, err := (&http.Client{Transport: transport}).Get(server.URL)
+	assert.Nil(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestHTTPClientOption(t *testing.T) {
+	kubeClient := fake.NewSimpleClientset()
+	_, err := httpClientOption(context.TODO(), kubeClient, "fake", &v1alpha1.GCPCloudFunctionTrigger{
+		CACertificate: &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "missing"},
+			Key:                  "ca.pem",
+		},
+	}, nil)
+	assert.NotNil(t, err)
+}
+
+func TestServiceOptions(t *testing.T) {
+	sensor := sensorObj.DeepCopy()
+	trigger := &sensor.Spec.Triggers[0]
+	trigger.Template.GCPCloudFunction.UserAgent = "billing-team/1.0"
+	opts := serviceOptions(sensor, trigger, &fakeTokenSource{})
+	assert.Contains(t, opts, option.WithUserAgent(userAgent(sensor, trigger)))
+
+	var header http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header
+		_, _ = w.Write([]byte(`{"executionId": "fake-execution"}`))
+	}))
+	defer server.Close()
+	opt, err := httpClientOption(context.TODO(), fake.NewSimpleClientset(), "fake", trigger.Template.GCPCloudFunction, opts)
+	assert.Nil(t, err)
+	service, err := cloudfunctions.NewService(context.TODO(), opt, option.WithEndpoint(server.URL))
+	assert.Nil(t, err)
+	_, err = (&serviceCaller{service: service}).Call(context.TODO(), trigger.Template.GCPCloudFunction.FunctionName, &cloudfunctions.CallFunctionRequest{Data: "{}"})
+	assert.Nil(t, err)
+	assert.True(t, strings.HasPrefix(header.Get("User-Agent"), "argo-events/"))
+	assert.True(t, strings.HasSuffix(header.Get("User-Agent"), "(sensor fake/fake-sensor; trigger fake-trigger) billing-team/1.0"))
+	assert.Equal(t, "Bearer fake-token", header.Get("Authorization"))
+}
+
+func TestIsAuthError(t *testing.T) {
+	assert.True(t, isAuthError(&googleapi.Error{Code: http.StatusUnauthorized}))
+	assert.True(t, isAuthError(errors.Wrap(&tokenError{err: errors.New("invalid_grant")}, "failed to call function")))
+	assert.False(t, isAuthError(&googleapi.Error{Code: http.StatusForbidden}))
+	assert.False(t, isAuthError(errors.New("connection refused")))
+	assert.True(t, isAuthError(status.Error(codes.Unauthenticated, "invalid token")))
+	assert.False(t, isAuthError(status.Error(codes.PermissionDenied, "forbidden")))
+}
+
+func TestValidateTrigger(t *testing.T) {
+	trigger := sensorObj.Spec.Triggers[0].Template.GCPCloudFunction.DeepCopy()
+	assert.Nil(t, ValidateTrigger(trigger))
+
+	trigger.FunctionName = "projects/fake-project/functions/fake-function"
+	err := ValidateTrigger(trigger)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "invalid function name")
+
+	trigger.FunctionName = "projects/fake-project/locations/us-central1/functions/fake-function"
+	trigger.Location = "europe/west1"
+	err = ValidateTrigger(trigger)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "invalid function name")
+
+	trigger.Parameters = []v1alpha1.TriggerParameter{{Dest: "location"}}
+	assert.Nil(t, ValidateTrigger(trigger))
+	trigger.Location = ""
+
+	trigger.FunctionName = "projects/fake-project/functions/fake-function"
+	trigger.Parameters = []v1alpha1.TriggerParameter{{Dest: "functionName"}}
+	assert.Nil(t, ValidateTrigger(trigger))
+
+	trigger.Batch = &v1alpha1.GCPCloudFunctionBatch{MaxSize: 10, MaxWait: "-1s"}
+	err = ValidateTrigger(trigger)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "max wait must be positive")
+
+	trigger.Batch.MaxWait = "500ms"
+	assert.Nil(t, ValidateTrigger(trigger))
+
+	trigger.MaxResponseSize = -1
+	err = ValidateTrigger(trigger)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "max response size can't be negative")
+	trigger.MaxResponseSize = 0
+
+	trigger.Payload = nil
+	err = ValidateTrigger(trigger)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "payload parameters are not specified")
+
+	t.Run("generation", func(t *testing.T) {
+		trigger := sensorObj.Spec.Triggers[0].Template.GCPCloudFunction.DeepCopy()
+		trigger.Generation = 3
+		err := ValidateTrigger(trigger)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "unsupported generation 3")
+
+		trigger.Generation = 2
+		err = ValidateTrigger(trigger)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "url is required")
+
+		trigger.URL = "fake-function-abc123-uc.a.run.app"
+		err = ValidateTrigger(trigger)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "must be an absolute http(s) url")
+
+		trigger.URL = "https://fake-function-abc123-uc.a.run.app"
+		assert.Nil(t, ValidateTrigger(trigger))
+
+		trigger.Parameters = []v1alpha1.TriggerParameter{{Dest: "url"}}
+		err = ValidateTrigger(trigger)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "url can't be templated")
+	})
+
+	t.Run("invocation", func(t *testing.T) {
+		trigger := sensorObj.Spec.Triggers[0].Template.GCPCloudFunction.DeepCopy()
+		trigger.Invocation = "http"
+		err := ValidateTrigger(trigger)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "unknown invocation")
+
+		trigger.Invocation = v1alpha1.GCPCloudFunctionInvocationPubSub
+		trigger.FunctionName = ""
+		err = ValidateTrigger(trigger)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "topic is required")
+
+		trigger.Topic = "projects/fake-project/subscriptions/fake-topic"
+		err = ValidateTrigger(trigger)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "invalid topic")
+
+		trigger.Topic = "projects/fake-project/topics/fake-topic"
+		assert.Nil(t, ValidateTrigger(trigger))
+
+		trigger.ProxyURL = "http://proxy:3128"
+		err = ValidateTrigger(trigger)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "not supported by the pubsub invocation")
+		trigger.ProxyURL = ""
+
+		trigger.Parameters = []v1alpha1.TriggerParameter{{Dest: "topic"}}
+		err = ValidateTrigger(trigger)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "topic can't be templated")
+	})
+
+	t.Run("headers", func(t *testing.T) {
+		trigger := sensorObj.Spec.Triggers[0].Template.GCPCloudFunction.DeepCopy()
+		trigger.Headers = map[string]string{"X-Tenant": "fake-tenant"}
+		err := ValidateTrigger(trigger)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "not supported by the calls to 1st gen functions")
+
+		trigger.Generation = 2
+		trigger.URL = "https://fake-function-abc123-uc.a.run.app"
+		assert.Nil(t, ValidateTrigger(trigger))
+
+		trigger.SecureHeaders = []*apicommon.SecureHeader{{Name: "X-Api-Key"}}
+		err = ValidateTrigger(trigger)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "must reference either a secret or a configmap")
+
+		trigger.SecureHeaders[0].ValueFrom = &apicommon.ValueFromSource{
+			SecretKeyRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "fake-secret"}, Key: "key"},
+		}
+		assert.Nil(t, ValidateTrigger(trigger))
+	})
+
+	t.Run("transform", func(t *testing.T) {
+		trigger := sensorObj.Spec.Triggers[0].Template.GCPCloudFunction.DeepCopy()
+		trigger.Payload = nil
+		trigger.Transform = `events["fake-dependency"]`
+		assert.Nil(t, ValidateTrigger(trigger))
+
+		trigger.Transform = `"fake"`
+		err := ValidateTrigger(trigger)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "invalid transform")
+	})
+
+	t.Run("response logging", func(t *testing.T) {
+		trigger := sensorObj.Spec.Triggers[0].Template.GCPCloudFunction.DeepCopy()
+		trigger.ResponseLogging = &v1alpha1.GCPCloudFunctionResponseLogging{Level: "verbose"}
+		err := ValidateTrigger(trigger)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "unknown log level verbose")
+
+		trigger.ResponseLogging.Level = v1alpha1.LogLevelInfo
+		trigger.ResponseLogging.Redact = []string{""}
+		err = ValidateTrigger(trigger)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "redacted path can't be empty")
+
+		trigger.ResponseLogging.Redact = []string{"user.email"}
+		assert.Nil(t, ValidateTrigger(trigger))
+	})
+
+	t.Run("retry on response", func(t *testing.T) {
+		trigger := sensorObj.Spec.Triggers[0].Template.GCPCloudFunction.DeepCopy()
+		trigger.RetryOnResponse = `response.body.retryable == true`
+		assert.Nil(t, ValidateTrigger(trigger))
+
+		trigger.RetryOnResponse = `response.body.retryable ==`
+		err := ValidateTrigger(trigger)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "invalid retry on response")
+
+		trigger.RetryOnResponse = `"retry"`
+		err = ValidateTrigger(trigger)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "must evaluate to a bool")
+
+		trigger.RetryOnResponse = `response.body.retryable == true`
+		trigger.Invocation = v1alpha1.GCPCloudFunctionInvocationPubSub
+		trigger.Topic = "projects/fake-project/topics/fake-topic"
+		err = ValidateTrigger(trigger)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "have no response")
+	})
+}
+
+func TestIsTimeoutError(t *testing.T) {
+	err := errors.Wrap(&TimeoutError{FunctionName: "fake-function", err: context.DeadlineExceeded}, "failed to execute trigger")
+	assert.True(t, IsTimeoutError(err))
+	assert.False(t, IsTimeoutError(context.DeadlineExceeded))
+	assert.False(t, IsTimeoutError(&googleapi.Error{Code: http.StatusGatewayTimeout}))
+}
+
+func TestIsRetryableError(t *testing.T) {
+	assert.True(t, isRetryableError(&googleapi.Error{Code: http.StatusTooManyRequests}))
+	assert.True(t, isRetryableError(&googleapi.Error{Code: http.StatusServiceUnavailable}))
+	assert.False(t, isRetryableError(&googleapi.Error{Code: http.StatusBadRequest}))
+	assert.False(t, isRetryableError(context.Canceled))
+	assert.True(t, isRetryableError(status.Error(codes.Unavailable, "unavailable")))
+	assert.False(t, isRetryableError(status.Error(codes.NotFound, "topic not found")))
+	assert.True(t, triggers.IsPermanentError(classifyCallError(status.Error(codes.NotFound, "topic not found"))))
+}
@@ -0,0 +1,404 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package gcp_cloud_function
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+
+	"cloud.google.com/go/pubsub"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"google.golang.org/api/cloudfunctions/v1"
+	"google.golang.org/api/googleapi"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/common/tracing"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sensors/triggers"
+)
+
+// TimeoutError is the error of a function call which did not complete before the deadline of the
+// execution, as opposed to a call the function failed. The function may still have run.
+type TimeoutError struct {
+	FunctionName string
+	err          error
+}
+
+func (e *TimeoutError) Error() string {
+	return "timed out calling function " + e.FunctionName + ": " + e.err.Error()
+}
+
+func (e *TimeoutError) Unwrap() error {
+	return e.err
+}
+
+// Timeout marks the error as a timeout, as net.Error does.
+func (e *TimeoutError) Timeout() bool {
+	return true
+}
+
+// IsTimeoutError returns true if the function call timed out.
+func IsTimeoutError(err error) bool {
+	var timeoutErr *TimeoutError
+	return errors.As(err, &timeoutErr)
+}
+
+// executeInBatch adds the execution to the current batch of the function, and returns the outcome
+// of the call made for the whole batch.
+func (t *GCPCloudFunctionTrigger) executeInBatch(ctx context.Context, events map[string]*v1alpha1.Event, trigger *v1alpha1.GCPCloudFunctionTrigger) (interface{}, error) {
+	functionName := targetName(trigger)
+	// The executions with different credentials are not batched together.
+	b := t.cache.getBatcher(clientKey(t.Trigger.Template.Name, t.credentialsKey)+"/"+functionName, trigger.Batch)
+	return b.add(ctx, events, func(ctx context.Context, batch []map[string]*v1alpha1.Event) (interface{}, error) {
+		logger := t.Logger.With(zap.String("functionName", functionName), zap.Int("batchSize", len(batch)), zap.Strings("batchEvents", batchEventIDs(batch)))
+		payload, err := triggers.ConstructBatch(batch, func(events map[string]*v1alpha1.Event) ([]byte, error) {
+			return t.constructPayload(events, trigger)
+		})
+		if err != nil {
+			logger.Errorw("failed to construct the payload of the batch, failing all its executions", zap.Error(err))
+			return nil, triggers.NewPermanentError(err)
+		}
+		response, err := t.callFunction(ctx, trigger, payload, batchEventIDs(batch))
+		if err != nil {
+			logger.Errorw("failed to call the function with the batch, failing all its executions", zap.Error(err))
+			return nil, err
+		}
+		logger.Info("called the function with the batch")
+		return response, nil
+	})
+}
+
+// callFunction encodes the payload and calls the function with it, for the events of the IDs.
+func (t *GCPCloudFunctionTrigger) callFunction(ctx context.Context, trigger *v1alpha1.GCPCloudFunctionTrigger, payload []byte, eventIDs []string) (interface{}, error) {
+	data, err := encodePayload(payload, trigger.Encoding)
+	if err != nil {
+		return nil, triggers.NewPermanentError(err)
+	}
+	if trigger.Envelope {
+		if data, err = wrapPayload(payload, data, trigger.Encoding); err != nil {
+			return nil, triggers.NewPermanentError(err)
+		}
+	}
+	payload = data
+	if len(payload) > maxCallDataSize {
+		return nil, triggers.NewPermanentError(errors.Errorf("the payload of %d bytes after encoding exceeds the call limit of %d bytes", len(payload), maxCallDataSize))
+	}
+
+	header, err := resolveHeaders(trigger)
+	if err != nil {
+		return nil, triggers.NewPermanentError(err)
+	}
+	if len(eventIDs) > 0 && header.Get(EventIDsHeader) == "" {
+		// The 1st gen calls have no room for the IDs, the API only takes the data of the call.
+		sort.Strings(eventIDs)
+		header.Set(EventIDsHeader, strings.Join(eventIDs, ","))
+	}
+
+	if t.Trigger.Template.DryRun {
+		// Only the names of the headers are logged, the secure ones hold credentials.
+		t.Logger.Infow("dry run, skipping the function call", zap.String("functionName", targetName(trigger)), zap.String("payload", string(payload)), zap.Strings("headers", headerNames(header)))
+		if trigger.GetInvocation() == v1alpha1.GCPCloudFunctionInvocationPubSub {
+			return &PublishResponse{MessageID: "dry-run"}, nil
+		}
+		if trigger.GetGeneration() == 2 {
+			return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(bytes.NewReader(nil))}, nil
+		}
+		return &cloudfunctions.CallFunctionResponse{
+			ExecutionId:    "dry-run",
+			ServerResponse: googleapi.ServerResponse{HTTPStatusCode: http.StatusOK},
+		}, nil
+	}
+
+	if trigger.Async && t.Dispatcher != nil {
+		backoff, err := asyncBackoff(t.Trigger, trigger)
+		if err != nil {
+			return nil, triggers.NewPermanentError(errors.Wrap(err, "invalid retry strategy"))
+		}
+		// The call outlives the execution, it keeps the trace of the execution but not its deadline.
+		spanContext := trace.SpanContextFromContext(ctx)
+		if t.Dispatcher.Dispatch(func(ctx context.Context) {
+			t.callAsync(trace.ContextWithSpanContext(ctx, spanContext), trigger, payload, header, backoff)
+		}) {
+			return &DispatchResponse{Accepted: true}, nil
+		}
+		t.Logger.Debugw("the asynchronous call was not dispatched, calling the function before returning", zap.String("functionName", targetName(trigger)))
+	}
+
+	// The call is made once, the sensor retries the execution with the retry strategy of the trigger.
+	return common.ObserveTriggerExecution(t.Sensor.Name, t.Trigger.Template.Name, apicommon.GCPFunctionTrigger, func() (interface{}, error) {
+		response, err := t.call(ctx, trigger, payload, header)
+		t.checkAuthentication(err)
+		if err != nil {
+			return nil, classifyCallError(err)
+		}
+		return response, nil
+	})
+}
+
+// asyncBackoff returns the backoff of the retries of an asynchronous call, the retry strategy of the function
+// trigger, or the one of the trigger if it has none.
+func asyncBackoff(trigger *v1alpha1.Trigger, functionTrigger *v1alpha1.GCPCloudFunctionTrigger) (*wait.Backoff, error) {
+	retryStrategy := functionTrigger.RetryStrategy
+	if retryStrategy == nil {
+		retryStrategy = trigger.RetryStrategy
+	}
+	if retryStrategy == nil {
+		retryStrategy = &apicommon.Backoff{Steps: 1}
+	}
+	return common.Convert2WaitBackoff(retryStrategy)
+}
+
+// resolveHeaders returns the headers of the trigger, with the values of the secure ones read from
+// the secrets and configmaps mounted in the sensor pod.
+func resolveHeaders(trigger *v1alpha1.GCPCloudFunctionTrigger) (http.Header, error) {
+	header := make(http.Header, len(trigger.Headers)+len(trigger.SecureHeaders))
+	for name, value := range trigger.Headers {
+		header.Set(name, value)
+	}
+	for _, secure := range trigger.SecureHeaders {
+		var value string
+		var err error
+		if secure.ValueFrom.SecretKeyRef != nil {
+			value, err = common.GetSecret(secure.ValueFrom.SecretKeyRef)
+		} else {
+			value, err = common.GetConfigMapFromVolume(secure.ValueFrom.ConfigMapKeyRef)
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to retrieve the value of secure header %s", secure.Name)
+		}
+		header.Set(secure.Name, value)
+	}
+	return header, nil
+}
+
+// headerNames returns the sorted names of the headers, for them to be logged without their values.
+func headerNames(header http.Header) []string {
+	names := make([]string, 0, len(header))
+	for name := range header {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// DispatchResponse is the response of an execution dispatching the function call in the background.
+type DispatchResponse struct {
+	// Accepted is true if the call was dispatched
+	Accepted bool `json:"accepted"`
+}
+
+// callAsync calls the function in the background, once the execution returned. The call is retried with the
+// backoff, the sensor can't retry the execution. The policy of the trigger is applied to the outcome of the
+// call. The failures can't fail the execution anymore, they are logged and counted by the metrics of the
+// trigger executions.
+func (t *GCPCloudFunctionTrigger) callAsync(ctx context.Context, trigger *v1alpha1.GCPCloudFunctionTrigger, payload []byte, header http.Header, backoff *wait.Backoff) {
+	logger := t.Logger.With(zap.String("functionName", targetName(trigger)))
+	_, err := common.ObserveTriggerExecution(t.Sensor.Name, t.Trigger.Template.Name, apicommon.GCPFunctionTrigger, func() (interface{}, error) {
+		response, err := t.callWithRetries(ctx, trigger, payload, header, backoff)
+		t.checkAuthentication(err)
+		if err != nil {
+			return nil, err
+		}
+		if err := t.ApplyPolicy(ctx, response); err != nil {
+			return nil, err
+		}
+		return response, nil
+	})
+	if err != nil {
+		logger.Errorw("the asynchronous function call failed", zap.Error(err))
+		return
+	}
+	logger.Debug("the asynchronous function call succeeded")
+}
+
+// callWithRetries calls the function until the call succeeds, fails permanently or the retries of the backoff
+// are exhausted.
+func (t *GCPCloudFunctionTrigger) callWithRetries(ctx context.Context, trigger *v1alpha1.GCPCloudFunctionTrigger, payload []byte, header http.Header, backoff *wait.Backoff) (interface{}, error) {
+	var response interface{}
+	var callErr error
+	waitErr := wait.ExponentialBackoffWithContext(ctx, *backoff, func() (bool, error) {
+		response, callErr = t.call(ctx, trigger, payload, header)
+		if callErr == nil {
+			return true, nil
+		}
+		if triggers.IsPermanentError(classifyCallError(callErr)) {
+			return false, callErr
+		}
+		t.Logger.Warnw("failed to call the function, retrying", zap.String("functionName", targetName(trigger)), zap.Error(callErr))
+		return false, nil
+	})
+	if waitErr != nil {
+		if callErr != nil {
+			return nil, callErr
+		}
+		return nil, errors.Wrapf(waitErr, "failed to call function %s", targetName(trigger))
+	}
+	return response, nil
+}
+
+// call calls the function once. The response is a *cloudfunctions.CallFunctionResponse for the 1st gen
+// functions, a *http.Response for the 2nd gen ones, and a *PublishResponse for the functions invoked through
+// Pub/Sub. The calls the retry condition of the trigger evaluates to true for fail with a triggers.RetryableError,
+// and the failed ones it evaluates to false for with a triggers.PermanentError.
+func (t *GCPCloudFunctionTrigger) call(ctx context.Context, trigger *v1alpha1.GCPCloudFunctionTrigger, payload []byte, header http.Header) (interface{}, error) {
+	functionName := targetName(trigger)
+	var response interface{}
+	var err error
+	trace.SpanFromContext(ctx).SetAttributes(tracing.AttributeFunctionName.String(functionName))
+	switch {
+	case trigger.GetInvocation() == v1alpha1.GCPCloudFunctionInvocationPubSub:
+		response, err = t.publish(ctx, payload, header)
+	case trigger.GetGeneration() == 2:
+		response, err = t.post(ctx, trigger, payload, header)
+	default:
+		response, err = t.Caller.Call(ctx, functionName, &cloudfunctions.CallFunctionRequest{
+			Data: string(payload),
+		})
+	}
+	if trigger.RetryOnResponse != "" {
+		if statusCode, body, ok := functionResponse(trigger, response, err); ok {
+			retry, conditionErr := triggers.EvaluateResponseCondition(trigger.RetryOnResponse, statusCode, body)
+			switch {
+			case conditionErr != nil:
+				t.Logger.Warnw("failed to evaluate the retry condition on the response, classifying it by its status", zap.String("functionName", functionName), zap.Error(conditionErr))
+			case retry:
+				t.Logger.Warnw("the function responded with a retryable response", zap.String("functionName", functionName), zap.Int("statusCode", statusCode))
+				if err == nil {
+					err = &RetryableResponseError{StatusCode: statusCode}
+				}
+				err = triggers.NewRetryableError(err)
+			case err != nil:
+				// The function told the failure is not worth retrying, whatever its status.
+				err = triggers.NewPermanentError(err)
+			}
+		}
+	}
+	if err != nil {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return nil, &TimeoutError{FunctionName: functionName, err: err}
+		}
+		return nil, errors.Wrapf(err, "failed to call function %s", functionName)
+	}
+	t.logResponse(trigger, response)
+	return response, nil
+}
+
+// RetryableResponseError is the error of a call the function responded to successfully, with a response the
+// retry condition of the trigger evaluated to true for.
+type RetryableResponseError struct {
+	// StatusCode is the status of the response
+	StatusCode int
+}
+
+func (e *RetryableResponseError) Error() string {
+	return fmt.Sprintf("the function responded with status %d and a response matching the retry condition", e.StatusCode)
+}
+
+// functionResponse returns the status and the body of the response of a call, the retry condition of the trigger is
+// evaluated against. They are the ones of the result of a 1st gen function, and of the response of a 2nd gen one,
+// which is an error out of the 2xx range. The errors of the Cloud Functions API are not responses of the function,
+// and the functions invoked through Pub/Sub have no response.
+func functionResponse(trigger *v1alpha1.GCPCloudFunctionTrigger, response interface{}, callErr error) (int, []byte, bool) {
+	if callErr != nil {
+		var apiErr *googleapi.Error
+		if trigger.GetGeneration() == 2 && errors.As(callErr, &apiErr) {
+			return apiErr.Code, []byte(apiErr.Body), true
+		}
+		return 0, nil, false
+	}
+	switch obj := response.(type) {
+	case *cloudfunctions.CallFunctionResponse:
+		return obj.HTTPStatusCode, []byte(obj.Result), true
+	case *http.Response:
+		// The body was read by the call already, it is kept in memory.
+		body, _ := ioutil.ReadAll(obj.Body)
+		obj.Body = ioutil.NopCloser(bytes.NewReader(body))
+		return obj.StatusCode, body, true
+	default:
+		return 0, nil, false
+	}
+}
+
+// targetName returns the name the function is invoked through, i.e. the topic triggering it
+// for the pubsub invocation, the name of the function otherwise.
+func targetName(trigger *v1alpha1.GCPCloudFunctionTrigger) string {
+	if trigger.GetInvocation() == v1alpha1.GCPCloudFunctionInvocationPubSub {
+		return trigger.Topic
+	}
+	return trigger.FunctionName
+}
+
+// PublishResponse is the response of the invocation of a function through its Pub/Sub topic.
+type PublishResponse struct {
+	// MessageID is the ID of the message published to the topic
+	MessageID string `json:"messageId"`
+}
+
+// publish publishes the payload to the topic triggering the function, and waits for Pub/Sub to
+// acknowledge it. The headers of the trigger are published as the attributes of the message.
+// The function is triggered asynchronously, its outcome is not known.
+func (t *GCPCloudFunctionTrigger) publish(ctx context.Context, payload []byte, header http.Header) (*PublishResponse, error) {
+	header = header.Clone()
+	tracing.InjectHeaders(ctx, header)
+	attributes := make(map[string]string, len(header))
+	for key := range header {
+		attributes[key] = header.Get(key)
+	}
+	messageID, err := t.Topic.Publish(ctx, &pubsub.Message{Data: payload, Attributes: attributes}).Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &PublishResponse{MessageID: messageID}, nil
+}
+
+// post calls a 2nd gen function with the payload over HTTP. The responses out of the 2xx range are
+// returned as GCP API errors, for them to be retried and tracked the same as the 1st gen ones.
+// The headers of the trigger override the default Content-Type and User-Agent, but not the tracing headers.
+func (t *GCPCloudFunctionTrigger) post(ctx context.Context, trigger *v1alpha1.GCPCloudFunctionTrigger, payload []byte, header http.Header) (*http.Response, error) {
+	contentType := "application/json"
+	if !trigger.Envelope && trigger.Encoding != "" && trigger.Encoding != v1alpha1.GCPCloudFunctionEncodingNone {
+		contentType = "text/plain"
+	}
+	reqHeader := http.Header{"Content-Type": []string{contentType}, "User-Agent": []string{userAgent(t.Sensor, t.Trigger)}}
+	for key, values := range header {
+		reqHeader[key] = values
+	}
+	// The executor makes a single attempt, the calls are retried with the retry strategy of the trigger.
+	executor := &triggers.HTTPExecutor{Client: t.HTTPClient, MaxResponseSize: trigger.MaxResponseSize}
+	resp, err := executor.Do(ctx, &triggers.HTTPRequest{Method: http.MethodPost, URL: trigger.URL, Header: reqHeader, Body: payload})
+	if resp == nil {
+		return nil, err
+	}
+	failed := resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices
+	// The failures are returned with their status code, the body of an oversized one is dropped.
+	if err != nil && !(failed && triggers.IsResponseTooLargeError(err)) {
+		return nil, err
+	}
+	if failed {
+		body, _ := ioutil.ReadAll(resp.Body)
+		return nil, &googleapi.Error{Code: resp.StatusCode, Body: string(body), Header: resp.Header}
+	}
+	return resp, nil
+}
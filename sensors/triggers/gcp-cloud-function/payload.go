@@ -0,0 +1,110 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package gcp_cloud_function
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sensors/triggers"
+)
+
+// maxCallDataSize is the limit of the data GCP accepts in a function call
+const maxCallDataSize = 10 * 1024 * 1024
+
+// constructPayload constructs the payload of the events, wrapped in a CloudEvent if the trigger has one,
+// the data of the call.
+func (t *GCPCloudFunctionTrigger) constructPayload(events map[string]*v1alpha1.Event, trigger *v1alpha1.GCPCloudFunctionTrigger) ([]byte, error) {
+	payload, err := constructPayload(events, trigger)
+	if err != nil {
+		return nil, err
+	}
+	return triggers.WrapCloudEvent(t.Sensor, t.Trigger, events, payload)
+}
+
+// constructPayload constructs the payload of the events, from the object the transform of the trigger
+// evaluates to if any, with the payload parameters applied on top of it.
+func constructPayload(events map[string]*v1alpha1.Event, trigger *v1alpha1.GCPCloudFunctionTrigger) ([]byte, error) {
+	if trigger.Transform == "" {
+		return triggers.ConstructPayload(events, trigger.Payload)
+	}
+	payload, err := triggers.ApplyTransform(trigger.Transform, events)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to transform the events")
+	}
+	return triggers.ApplyParams(payload, trigger.Payload, events)
+}
+
+// encodePayload applies the encoding to the payload.
+func encodePayload(payload []byte, encoding v1alpha1.GCPCloudFunctionEncoding) ([]byte, error) {
+	switch encoding {
+	case "", v1alpha1.GCPCloudFunctionEncodingNone:
+		return payload, nil
+	case v1alpha1.GCPCloudFunctionEncodingBase64:
+		return []byte(base64.StdEncoding.EncodeToString(payload)), nil
+	case v1alpha1.GCPCloudFunctionEncodingGzip:
+		// The call data is a string, so the compressed payload is base64 encoded as well.
+		var buf bytes.Buffer
+		w := gzip.NewWriter(&buf)
+		if _, err := w.Write(payload); err != nil {
+			return nil, errors.Wrap(err, "failed to compress the payload")
+		}
+		if err := w.Close(); err != nil {
+			return nil, errors.Wrap(err, "failed to compress the payload")
+		}
+		return []byte(base64.StdEncoding.EncodeToString(buf.Bytes())), nil
+	default:
+		return nil, errors.Errorf("unknown payload encoding %q", encoding)
+	}
+}
+
+// payloadEnvelope is the envelope the payload is wrapped in when the envelope option is set.
+type payloadEnvelope struct {
+	// ContentType of the payload before encoding, application/json or text/plain
+	ContentType string `json:"contentType"`
+	// Encoding applied to the payload, none, base64 or gzip
+	Encoding v1alpha1.GCPCloudFunctionEncoding `json:"encoding"`
+	// Size of the payload in bytes before encoding
+	Size int `json:"size"`
+	// Data is the encoded payload
+	Data string `json:"data"`
+}
+
+// wrapPayload wraps the encoded payload in an envelope describing how to decode it.
+func wrapPayload(payload, encoded []byte, encoding v1alpha1.GCPCloudFunctionEncoding) ([]byte, error) {
+	contentType := "text/plain"
+	if json.Valid(payload) {
+		contentType = "application/json"
+	}
+	if encoding == "" {
+		encoding = v1alpha1.GCPCloudFunctionEncodingNone
+	}
+	envelope, err := json.Marshal(&payloadEnvelope{
+		ContentType: contentType,
+		Encoding:    encoding,
+		Size:        len(payload),
+		Data:        string(encoded),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to wrap the payload in the envelope")
+	}
+	return envelope, nil
+}
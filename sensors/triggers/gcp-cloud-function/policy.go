@@ -0,0 +1,154 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package gcp_cloud_function
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/pkg/errors"
+	"google.golang.org/api/cloudfunctions/v1"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/argoproj/argo-events/sensors/policy"
+	"github.com/argoproj/argo-events/sensors/triggers"
+)
+
+// ApplyPolicy applies the policy on the trigger execution response
+func (t *GCPCloudFunctionTrigger) ApplyPolicy(ctx context.Context, resource interface{}) error {
+	var statusCode int
+	switch obj := resource.(type) {
+	case *cloudfunctions.CallFunctionResponse:
+		// The call API responds with 200 even if the function itself failed, in which case the
+		// error message is populated and the result holds whatever the function returned.
+		if obj.Error != "" {
+			return errors.Errorf("function execution %s failed with error %q, result: %q", obj.ExecutionId, obj.Error, obj.Result)
+		}
+		statusCode = obj.HTTPStatusCode
+	case *http.Response:
+		// A 2nd gen function responds with its own status code.
+		statusCode = obj.StatusCode
+	case *PublishResponse:
+		// A Pub/Sub triggered function has no response, there is no status to apply the policy on.
+		if obj.MessageID == "" {
+			return errors.New("the message was not acknowledged by Pub/Sub")
+		}
+		return nil
+	case *DispatchResponse:
+		// The policy is applied to the outcome of the asynchronous call once it completes.
+		if !obj.Accepted {
+			return errors.New("the asynchronous call was not dispatched")
+		}
+		return nil
+	default:
+		return errors.New("failed to interpret the trigger resource")
+	}
+
+	if t.Trigger.Policy == nil || t.Trigger.Policy.Status == nil || (t.Trigger.Policy.Status.Allow == nil && t.Trigger.Policy.Status.AllowRanges == nil) {
+		return nil
+	}
+
+	p, err := policy.NewStatusPolicyWithRanges(statusCode, t.Trigger.Policy.Status.GetAllow(), t.Trigger.Policy.Status.AllowRanges)
+	if err != nil {
+		return err
+	}
+	return p.ApplyPolicy(ctx)
+}
+
+// Output returns the status code and the result of a 1st gen function call, the response of a
+// 2nd gen function is an HTTP response the output of which is captured as is. The output of a
+// function invoked through Pub/Sub is the ID of the message published, and the output of an
+// asynchronous call whether it was dispatched, with no status code.
+func (t *GCPCloudFunctionTrigger) Output(response interface{}) (int, []byte, error) {
+	switch obj := response.(type) {
+	case *cloudfunctions.CallFunctionResponse:
+		return obj.HTTPStatusCode, []byte(obj.Result), nil
+	case *PublishResponse:
+		body, err := json.Marshal(obj)
+		if err != nil {
+			return 0, nil, errors.Wrap(err, "failed to marshal the publish response")
+		}
+		return 0, body, nil
+	case *DispatchResponse:
+		body, err := json.Marshal(obj)
+		if err != nil {
+			return 0, nil, errors.Wrap(err, "failed to marshal the dispatch response")
+		}
+		return 0, body, nil
+	default:
+		return 0, nil, errors.New("failed to interpret the trigger resource")
+	}
+}
+
+// classifyCallError classifies the error of a function call. The calls GCP rejected are permanent
+// failures, unless they are worth retrying or failed to authenticate, which the rotation of the
+// credentials may fix, and so are the responses exceeding the max response size. The other failures,
+// e.g. network errors or timeouts, are transient.
+func classifyCallError(err error) error {
+	if triggers.IsPermanentError(err) || triggers.IsRetryableError(err) {
+		// The retry condition of the trigger classified the response already.
+		return err
+	}
+	if triggers.IsResponseTooLargeError(err) {
+		return triggers.NewPermanentError(err)
+	}
+	var apiErr *googleapi.Error
+	_, isStatusErr := grpcStatus(err)
+	if (errors.As(err, &apiErr) || isStatusErr) && !isRetryableError(err) && !isAuthError(err) {
+		return triggers.NewPermanentError(err)
+	}
+	return triggers.NewRetryableError(err)
+}
+
+// grpcStatus returns the status of the error of a publishing to Pub/Sub, if it is a gRPC one.
+func grpcStatus(err error) (*status.Status, bool) {
+	var statusErr interface{ GRPCStatus() *status.Status }
+	// The GCP API errors may wrap an error with no gRPC status.
+	if !errors.As(err, &statusErr) || statusErr.GRPCStatus() == nil {
+		return nil, false
+	}
+	return statusErr.GRPCStatus(), true
+}
+
+// isRetryableError returns true if the function call failed with an error worth retrying,
+// i.e. throttling, server side errors, or errors not coming from the GCP API at all.
+func isRetryableError(err error) bool {
+	if triggers.IsResponseTooLargeError(err) {
+		// The function was called, calling it again won't make its response any smaller.
+		return false
+	}
+	if st, ok := grpcStatus(err); ok {
+		switch st.Code() {
+		case codes.Unavailable, codes.ResourceExhausted, codes.Internal, codes.DeadlineExceeded, codes.Aborted:
+			return true
+		default:
+			return false
+		}
+	}
+	var apiErr *googleapi.Error
+	if !errors.As(err, &apiErr) {
+		return !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+	}
+	switch apiErr.Code {
+	case http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package gcp_cloud_function
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/tidwall/gjson"
+	"go.uber.org/zap"
+	"google.golang.org/api/cloudfunctions/v1"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sensors/triggers"
+)
+
+// logResponse logs the response of the function if the response logging of the trigger is enabled.
+// The functions invoked through Pub/Sub don't respond, there is nothing to log.
+func (t *GCPCloudFunctionTrigger) logResponse(trigger *v1alpha1.GCPCloudFunctionTrigger, response interface{}) {
+	logging := trigger.ResponseLogging
+	if logging == nil {
+		return
+	}
+	fields := []interface{}{zap.String("functionName", targetName(trigger))}
+	var body []byte
+	switch obj := response.(type) {
+	case *cloudfunctions.CallFunctionResponse:
+		fields = append(fields, zap.Int("statusCode", obj.HTTPStatusCode), zap.String("executionId", obj.ExecutionId))
+		body = []byte(obj.Result)
+	case *http.Response:
+		fields = append(fields, zap.Int("statusCode", obj.StatusCode))
+		// The body was read by the call already, it is kept in memory.
+		body, _ = ioutil.ReadAll(obj.Body)
+		obj.Body = ioutil.NopCloser(bytes.NewReader(body))
+	default:
+		return
+	}
+	fields = append(fields, responseFields(body, logging.Redact)...)
+	switch logging.GetLevel() {
+	case v1alpha1.LogLevelInfo:
+		t.Logger.Infow("function responded", fields...)
+	case v1alpha1.LogLevelWarn:
+		t.Logger.Warnw("function responded", fields...)
+	case v1alpha1.LogLevelError:
+		t.Logger.Errorw("function responded", fields...)
+	default:
+		t.Logger.Debugw("function responded", fields...)
+	}
+}
+
+// responseFields returns the fields logging the body of a response, with the values at the redacted paths
+// replaced. A body which can't be redacted, not being JSON, is never logged as is if there are paths to
+// redact, only its size and its hash are.
+func responseFields(body []byte, redact []string) []interface{} {
+	if len(redact) == 0 {
+		return []interface{}{zap.ByteString("response", body)}
+	}
+	if gjson.ValidBytes(body) {
+		if redacted, err := triggers.RedactJSON(body, redact); err == nil {
+			return []interface{}{zap.ByteString("response", redacted)}
+		}
+	}
+	sum := sha256.Sum256(body)
+	return []interface{}{zap.Int("responseSize", len(body)), zap.String("responseSha256", hex.EncodeToString(sum[:]))}
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package gcp_cloud_function
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+	"google.golang.org/api/cloudfunctions/v1"
+	"google.golang.org/api/googleapi"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+func TestResponseFields(t *testing.T) {
+	fieldsMap := func(fields []interface{}) map[string]interface{} {
+		enc := zapcore.NewMapObjectEncoder()
+		for _, f := range fields {
+			f.(zapcore.Field).AddTo(enc)
+		}
+		return enc.Fields
+	}
+
+	t.Run("logs the response as is without redaction", func(t *testing.T) {
+		fields := fieldsMap(responseFields([]byte("not json"), nil))
+		assert.Equal(t, "not json", fields["response"])
+	})
+
+	t.Run("redacts the fields", func(t *testing.T) {
+		body := []byte(`{"user":{"name":"fake","email":"fake@example.com"},"items":[{"ssn":"123"}],"status":"ok"}`)
+		fields := fieldsMap(responseFields(body, []string{"user.email", "items.0.ssn", "missing.field"}))
+		assert.JSONEq(t, `{"user":{"name":"fake","email":"[REDACTED]"},"items":[{"ssn":"[REDACTED]"}],"status":"ok"}`, fields["response"].(string))
+	})
+
+	t.Run("summarizes the responses which are not json", func(t *testing.T) {
+		body := []byte("email=fake@example.com")
+		fields := fieldsMap(responseFields(body, []string{"email"}))
+		_, ok := fields["response"]
+		assert.False(t, ok)
+		sum := sha256.Sum256(body)
+		assert.Equal(t, int64(len(body)), fields["responseSize"])
+		assert.Equal(t, hex.EncodeToString(sum[:]), fields["responseSha256"])
+	})
+}
+
+func TestGCPCloudFunctionTrigger_LogResponse(t *testing.T) {
+	newTrigger := func(level zapcore.Level) (*GCPCloudFunctionTrigger, *observer.ObservedLogs) {
+		core, logs := observer.New(level)
+		return &GCPCloudFunctionTrigger{Logger: zap.New(core).Sugar()}, logs
+	}
+	trigger := &v1alpha1.GCPCloudFunctionTrigger{
+		FunctionName:    "projects/fake-project/locations/us-central1/functions/fake-function",
+		ResponseLogging: &v1alpha1.GCPCloudFunctionResponseLogging{Level: v1alpha1.LogLevelInfo, Redact: []string{"token"}},
+	}
+
+	t.Run("1st gen response", func(t *testing.T) {
+		ft, logs := newTrigger(zapcore.InfoLevel)
+		ft.logResponse(trigger, &cloudfunctions.CallFunctionResponse{
+			ExecutionId:    "fake-execution",
+			Result:         `{"token":"secret","ok":true}`,
+			ServerResponse: googleapi.ServerResponse{HTTPStatusCode: http.StatusOK},
+		})
+		assert.Equal(t, 1, logs.Len())
+		entry := logs.All()[0]
+		assert.Equal(t, zapcore.InfoLevel, entry.Level)
+		fields := entry.ContextMap()
+		assert.Equal(t, "fake-execution", fields["executionId"])
+		assert.JSONEq(t, `{"token":"[REDACTED]","ok":true}`, fields["response"].(string))
+	})
+
+	t.Run("2nd gen response keeps its body", func(t *testing.T) {
+		ft, logs := newTrigger(zapcore.InfoLevel)
+		response := &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(bytes.NewReader([]byte(`{"token":"secret"}`)))}
+		ft.logResponse(trigger, response)
+		assert.Equal(t, 1, logs.Len())
+		assert.JSONEq(t, `{"token":"[REDACTED]"}`, logs.All()[0].ContextMap()["response"].(string))
+		body, err := ioutil.ReadAll(response.Body)
+		assert.Nil(t, err)
+		assert.Equal(t, `{"token":"secret"}`, string(body))
+	})
+
+	t.Run("debug by default", func(t *testing.T) {
+		ft, logs := newTrigger(zapcore.InfoLevel)
+		trigger := trigger.DeepCopy()
+		trigger.ResponseLogging.Level = ""
+		ft.logResponse(trigger, &cloudfunctions.CallFunctionResponse{Result: "{}"})
+		assert.Equal(t, 0, logs.Len())
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		ft, logs := newTrigger(zapcore.DebugLevel)
+		trigger := trigger.DeepCopy()
+		trigger.ResponseLogging = nil
+		ft.logResponse(trigger, &cloudfunctions.CallFunctionResponse{Result: "{}"})
+		assert.Equal(t, 0, logs.Len())
+	})
+}
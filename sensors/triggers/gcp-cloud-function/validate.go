@@ -0,0 +1,279 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package gcp_cloud_function
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sensors/triggers"
+)
+
+// functionNameRegex matches the full resource name of a function, with each segment
+// possibly templated from the event data through the trigger parameters.
+var functionNameRegex = regexp.MustCompile(`^projects/[^/]+/locations/[^/]+/functions/[^/]+$`)
+
+// topicRegex matches the full resource name of the topic triggering a function.
+var topicRegex = regexp.MustCompile(`^projects/([^/]+)/topics/([^/]+)$`)
+
+// serviceAccountRegex matches the email of a service account the trigger impersonates.
+var serviceAccountRegex = regexp.MustCompile(`^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.gserviceaccount\.com$`)
+
+// ValidateTrigger checks the structure of the trigger ahead of its executions, e.g. at admission.
+// The function name is only checked once resolved if it is templated by a parameter.
+func ValidateTrigger(trigger *v1alpha1.GCPCloudFunctionTrigger) error {
+	if trigger.Payload == nil && trigger.Transform == "" {
+		return triggers.ErrPayloadMissing
+	}
+	if trigger.Transform != "" {
+		if _, err := triggers.NewTransform(trigger.Transform); err != nil {
+			return errors.Wrap(err, "invalid transform")
+		}
+	}
+	if err := validateBatch(trigger.Batch); err != nil {
+		return errors.Wrap(err, "invalid batch")
+	}
+	if err := validateHeaders(trigger); err != nil {
+		return errors.Wrap(err, "invalid headers")
+	}
+	if err := validateResponseLogging(trigger.ResponseLogging); err != nil {
+		return errors.Wrap(err, "invalid response logging")
+	}
+	if err := validateImpersonation(trigger); err != nil {
+		return errors.Wrap(err, "invalid impersonation")
+	}
+	if err := validateCredentials(trigger); err != nil {
+		return errors.Wrap(err, "invalid credentials")
+	}
+	if trigger.MaxResponseSize < 0 {
+		return errors.New("max response size can't be negative")
+	}
+	if trigger.RetryOnResponse != "" {
+		if trigger.GetInvocation() == v1alpha1.GCPCloudFunctionInvocationPubSub {
+			return errors.New("invalid retry on response, the functions invoked through pubsub have no response")
+		}
+		if _, err := triggers.NewResponseCondition(trigger.RetryOnResponse); err != nil {
+			return errors.Wrap(err, "invalid retry on response")
+		}
+	}
+	switch trigger.GetInvocation() {
+	case v1alpha1.GCPCloudFunctionInvocationCall:
+	case v1alpha1.GCPCloudFunctionInvocationPubSub:
+		if err := validateTopic(trigger); err != nil {
+			return errors.Wrap(err, "invalid topic")
+		}
+		// The function is not called, but triggered by the messages published to the topic.
+		return nil
+	default:
+		return errors.Errorf("unknown invocation %q, it must be call or pubsub", trigger.Invocation)
+	}
+	switch trigger.GetGeneration() {
+	case 1:
+	case 2:
+		if err := validateURL(trigger); err != nil {
+			return errors.Wrap(err, "invalid url")
+		}
+	default:
+		return errors.Errorf("unsupported generation %d, it must be 1 or 2", trigger.Generation)
+	}
+	return checkFunctionName(trigger)
+}
+
+// checkFunctionName validates the function name of a trigger calling the function, with its location
+// resolved. The function name is not checked if it is templated by a parameter.
+func checkFunctionName(trigger *v1alpha1.GCPCloudFunctionTrigger) error {
+	if trigger.GetInvocation() != v1alpha1.GCPCloudFunctionInvocationCall {
+		return nil
+	}
+	for _, parameter := range trigger.Parameters {
+		if parameter.Dest == functionNameDest || parameter.Dest == locationDest {
+			return nil
+		}
+	}
+	return validateFunctionName(resolveFunctionName(trigger))
+}
+
+// resolveFunctionName returns the function name of the trigger, with its location replaced by the
+// location of the trigger if any. A function name not in the expected format is returned as is.
+func resolveFunctionName(trigger *v1alpha1.GCPCloudFunctionTrigger) string {
+	if trigger.Location == "" {
+		return trigger.FunctionName
+	}
+	segments := strings.Split(trigger.FunctionName, "/")
+	if len(segments) != 6 || segments[2] != "locations" {
+		return trigger.FunctionName
+	}
+	segments[3] = trigger.Location
+	return strings.Join(segments, "/")
+}
+
+func validateFunctionName(functionName string) error {
+	if !functionNameRegex.MatchString(functionName) {
+		return errors.Errorf("invalid function name %q, it must be in the format of projects/{project}/locations/{location}/functions/{function}", functionName)
+	}
+	return nil
+}
+
+// validateURL validates the URL of a 2nd gen function, which is fixed as the audience of its identity tokens.
+func validateURL(trigger *v1alpha1.GCPCloudFunctionTrigger) error {
+	if trigger.URL == "" {
+		return errors.New("url is required for the 2nd gen functions")
+	}
+	u, err := url.Parse(trigger.URL)
+	if err != nil {
+		return errors.Wrapf(err, "failed to parse the url %q", trigger.URL)
+	}
+	if (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		return errors.Errorf("url %q must be an absolute http(s) url", trigger.URL)
+	}
+	for _, parameter := range trigger.Parameters {
+		if parameter.Dest == urlDest {
+			return errors.New("url can't be templated, it is the audience of the identity token")
+		}
+	}
+	return nil
+}
+
+// validateTopic validates the topic a Pub/Sub triggered function is invoked through, which is
+// published to over gRPC, with the client cached ahead of the executions.
+func validateTopic(trigger *v1alpha1.GCPCloudFunctionTrigger) error {
+	if trigger.Topic == "" {
+		return errors.New("topic is required for the pubsub invocation")
+	}
+	if _, _, err := parseTopic(trigger.Topic); err != nil {
+		return err
+	}
+	for _, parameter := range trigger.Parameters {
+		if parameter.Dest == topicDest {
+			return errors.New("topic can't be templated, the client publishing to it is created ahead of the executions")
+		}
+	}
+	if trigger.CACertificate != nil || trigger.ProxyURL != "" {
+		return errors.New("caCertificate and proxyURL are not supported by the pubsub invocation")
+	}
+	return nil
+}
+
+// parseTopic returns the project and the ID of the topic from its full resource name.
+func parseTopic(topic string) (string, string, error) {
+	matches := topicRegex.FindStringSubmatch(topic)
+	if matches == nil {
+		return "", "", errors.Errorf("invalid topic %q, it must be in the format of projects/{project}/topics/{topic}", topic)
+	}
+	return matches[1], matches[2], nil
+}
+
+// validateHeaders validates the headers of the trigger, they can't be set on the calls to 1st gen functions
+// made through the GCP API.
+func validateHeaders(trigger *v1alpha1.GCPCloudFunctionTrigger) error {
+	if len(trigger.Headers) == 0 && len(trigger.SecureHeaders) == 0 {
+		return nil
+	}
+	if trigger.GetInvocation() == v1alpha1.GCPCloudFunctionInvocationCall && trigger.GetGeneration() == 1 {
+		return errors.New("headers are not supported by the calls to 1st gen functions")
+	}
+	for _, secure := range trigger.SecureHeaders {
+		if secure == nil || secure.Name == "" {
+			return errors.New("secure header name is required")
+		}
+		if secure.ValueFrom == nil || (secure.ValueFrom.SecretKeyRef == nil && secure.ValueFrom.ConfigMapKeyRef == nil) {
+			return errors.Errorf("secure header %s must reference either a secret or a configmap", secure.Name)
+		}
+	}
+	return nil
+}
+
+// validateCredentials validates the named credentials of the trigger, and the credentials key unless it is
+// templated, in which case it is checked by the executions.
+func validateCredentials(trigger *v1alpha1.GCPCloudFunctionTrigger) error {
+	if len(trigger.Credentials) == 0 {
+		if trigger.CredentialsKey != "" || isCredentialsKeyTemplated(trigger) {
+			return errors.New("credentialsKey requires credentials")
+		}
+		return nil
+	}
+	for key, secret := range trigger.Credentials {
+		if key == "" {
+			return errors.New("credentials key can't be empty")
+		}
+		if secret.Name == "" || secret.Key == "" {
+			return errors.Errorf("credentials %s must reference a key of a secret", key)
+		}
+	}
+	if isCredentialsKeyTemplated(trigger) {
+		return nil
+	}
+	_, err := selectCredentials(trigger, trigger.CredentialsKey)
+	return err
+}
+
+// validateImpersonation validates the emails of the impersonated service account and of its delegates.
+func validateImpersonation(trigger *v1alpha1.GCPCloudFunctionTrigger) error {
+	if trigger.ImpersonateServiceAccount == "" {
+		if len(trigger.ImpersonationDelegates) > 0 {
+			return errors.New("impersonation delegates require impersonateServiceAccount")
+		}
+		return nil
+	}
+	for _, email := range append([]string{trigger.ImpersonateServiceAccount}, trigger.ImpersonationDelegates...) {
+		if !serviceAccountRegex.MatchString(email) {
+			return errors.Errorf("invalid service account %q, it must be the email of a service account, e.g. invoker@{project}.iam.gserviceaccount.com", email)
+		}
+	}
+	return nil
+}
+
+func validateResponseLogging(logging *v1alpha1.GCPCloudFunctionResponseLogging) error {
+	if logging == nil {
+		return nil
+	}
+	switch logging.Level {
+	case "", v1alpha1.LogLevelDebug, v1alpha1.LogLevelInfo, v1alpha1.LogLevelWarn, v1alpha1.LogLevelError:
+	default:
+		return errors.Errorf("unknown log level %s", logging.Level)
+	}
+	for _, path := range logging.Redact {
+		if path == "" {
+			return errors.New("redacted path can't be empty")
+		}
+	}
+	return nil
+}
+
+// validateBatch validates the batch config of the trigger
+func validateBatch(batch *v1alpha1.GCPCloudFunctionBatch) error {
+	if batch == nil {
+		return nil
+	}
+	if batch.MaxSize < 0 {
+		return errors.New("max size can't be negative")
+	}
+	if batch.MaxWait != "" {
+		maxWait, err := time.ParseDuration(batch.MaxWait)
+		if err != nil {
+			return errors.Wrapf(err, "failed to parse the max wait %s", batch.MaxWait)
+		}
+		if maxWait <= 0 {
+			return errors.New("max wait must be positive")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package triggers
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+// Graph is the graph of the triggers of a sensor, a trigger depending on the triggers of its dependsOn. Each
+// connected part of the graph starts from a single root trigger, the only one of the part subscribing to the
+// events, the other triggers being executed for the events of the root.
+type Graph struct {
+	// dependsOn are the triggers each trigger depends on
+	dependsOn map[string][]string
+	// descendants are the triggers depending on each root trigger, directly or not, in topological order
+	descendants map[string][]string
+}
+
+// NewGraph returns the graph of the triggers. The dependencies on an unknown trigger, the cycles, and the triggers
+// depending on the triggers of more than one root are rejected.
+func NewGraph(triggers []v1alpha1.Trigger) (*Graph, error) {
+	g := &Graph{dependsOn: make(map[string][]string, len(triggers)), descendants: make(map[string][]string)}
+	names := make([]string, 0, len(triggers))
+	for _, t := range triggers {
+		name := t.Template.Name
+		if _, ok := g.dependsOn[name]; ok {
+			return nil, errors.Errorf("trigger %s is defined more than once", name)
+		}
+		g.dependsOn[name] = t.DependsOn
+		names = append(names, name)
+	}
+	dependents := make(map[string][]string, len(names))
+	remaining := make(map[string]int, len(names))
+	for _, name := range names {
+		seen := make(map[string]bool, len(g.dependsOn[name]))
+		for _, dep := range g.dependsOn[name] {
+			if _, ok := g.dependsOn[dep]; !ok {
+				return nil, errors.Errorf("trigger %s depends on trigger %s which doesn't exist", name, dep)
+			}
+			if dep == name {
+				return nil, errors.Errorf("trigger %s depends on itself", name)
+			}
+			if seen[dep] {
+				return nil, errors.Errorf("trigger %s depends on trigger %s more than once", name, dep)
+			}
+			seen[dep] = true
+			dependents[dep] = append(dependents[dep], name)
+			remaining[name]++
+		}
+	}
+
+	// The triggers are sorted in the order of the sensor, a trigger coming after the triggers it depends on.
+	roots := make(map[string]string, len(names))
+	ready := make([]string, 0, len(names))
+	for _, name := range names {
+		if remaining[name] == 0 {
+			ready = append(ready, name)
+			roots[name] = name
+		}
+	}
+	index := make(map[string]int, len(names))
+	for i, name := range names {
+		index[name] = i
+	}
+	sorted := 0
+	for len(ready) > 0 {
+		name := ready[0]
+		ready = ready[1:]
+		sorted++
+		if root := roots[name]; root != name {
+			g.descendants[root] = append(g.descendants[root], name)
+		}
+		var next []string
+		for _, dependent := range dependents[name] {
+			if root, ok := roots[dependent]; ok && root != roots[name] {
+				return nil, errors.Errorf("trigger %s depends on the triggers of both %s and %s, the triggers depending on others must start from a single trigger", dependent, root, roots[name])
+			}
+			roots[dependent] = roots[name]
+			if remaining[dependent]--; remaining[dependent] == 0 {
+				next = append(next, dependent)
+			}
+		}
+		ready = append(ready, next...)
+		sort.SliceStable(ready, func(i, j int) bool { return index[ready[i]] < index[ready[j]] })
+	}
+	if sorted < len(names) {
+		var cycle []string
+		for _, name := range names {
+			if remaining[name] > 0 {
+				cycle = append(cycle, name)
+			}
+		}
+		return nil, errors.Errorf("there is a cycle in the dependencies of triggers %s", strings.Join(cycle, ", "))
+	}
+	return g, nil
+}
+
+// IsRoot tells if the trigger depends on no other trigger, subscribing to the events
+func (g *Graph) IsRoot(name string) bool {
+	return g == nil || len(g.dependsOn[name]) == 0
+}
+
+// DependsOn returns the triggers the trigger depends on
+func (g *Graph) DependsOn(name string) []string {
+	if g == nil {
+		return nil
+	}
+	return g.dependsOn[name]
+}
+
+// Descendants returns the triggers depending on the root trigger, directly or not, in the order they are
+// executed, a trigger coming after the triggers it depends on.
+func (g *Graph) Descendants(root string) []string {
+	if g == nil {
+		return nil
+	}
+	return g.descendants[root]
+}
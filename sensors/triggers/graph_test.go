@@ -0,0 +1,93 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package triggers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+func newGraphTrigger(name string, dependsOn ...string) v1alpha1.Trigger {
+	return v1alpha1.Trigger{Template: &v1alpha1.TriggerTemplate{Name: name}, DependsOn: dependsOn}
+}
+
+func TestNewGraph(t *testing.T) {
+	t.Run("test no dependencies", func(t *testing.T) {
+		g, err := NewGraph([]v1alpha1.Trigger{newGraphTrigger("a"), newGraphTrigger("b")})
+		assert.Nil(t, err)
+		assert.True(t, g.IsRoot("a"))
+		assert.True(t, g.IsRoot("b"))
+		assert.Empty(t, g.Descendants("a"))
+	})
+
+	t.Run("test topological order", func(t *testing.T) {
+		// d depends on b and c, which both depend on a, and are defined before the triggers they depend on.
+		g, err := NewGraph([]v1alpha1.Trigger{
+			newGraphTrigger("d", "c", "b"),
+			newGraphTrigger("c", "a"),
+			newGraphTrigger("b", "a"),
+			newGraphTrigger("a"),
+			newGraphTrigger("e"),
+		})
+		assert.Nil(t, err)
+		assert.True(t, g.IsRoot("a"))
+		assert.False(t, g.IsRoot("d"))
+		assert.True(t, g.IsRoot("e"))
+		assert.Equal(t, []string{"c", "b", "d"}, g.Descendants("a"))
+		assert.Equal(t, []string{"c", "b"}, g.DependsOn("d"))
+		assert.Empty(t, g.Descendants("e"))
+	})
+
+	t.Run("test cycle", func(t *testing.T) {
+		_, err := NewGraph([]v1alpha1.Trigger{newGraphTrigger("a"), newGraphTrigger("b", "a", "c"), newGraphTrigger("c", "b")})
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "cycle in the dependencies of triggers b, c")
+	})
+
+	t.Run("test invalid dependencies", func(t *testing.T) {
+		_, err := NewGraph([]v1alpha1.Trigger{newGraphTrigger("a", "unknown")})
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "doesn't exist")
+
+		_, err = NewGraph([]v1alpha1.Trigger{newGraphTrigger("a", "a")})
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "depends on itself")
+
+		_, err = NewGraph([]v1alpha1.Trigger{newGraphTrigger("a"), newGraphTrigger("b", "a", "a")})
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "more than once")
+
+		_, err = NewGraph([]v1alpha1.Trigger{newGraphTrigger("a"), newGraphTrigger("a")})
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "defined more than once")
+	})
+
+	t.Run("test multiple roots", func(t *testing.T) {
+		_, err := NewGraph([]v1alpha1.Trigger{newGraphTrigger("a"), newGraphTrigger("b"), newGraphTrigger("c", "a", "b")})
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "single trigger")
+	})
+
+	t.Run("test nil graph", func(t *testing.T) {
+		var g *Graph
+		assert.True(t, g.IsRoot("a"))
+		assert.Empty(t, g.Descendants("a"))
+	})
+}
@@ -16,8 +16,8 @@ limitations under the License.
 package http
 
 import (
-	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"net/http"
 	"time"
@@ -139,16 +139,20 @@ func (t *HTTPTrigger) Execute(ctx context.Context, events map[string]*v1alpha1.E
 		if err != nil {
 			return nil, err
 		}
+		payload, err = triggers.WrapCloudEvent(t.Sensor, t.Trigger, events, payload)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	request, err := http.NewRequest(trigger.Method, trigger.URL, bytes.NewReader(payload))
-	if err != nil {
-		return nil, errors.Wrapf(err, "failed to construct request for %s", trigger.URL)
+	header := http.Header{}
+	if trigger.Payload != nil && t.Trigger.Template.CloudEvent != nil {
+		header.Set("Content-Type", triggers.CloudEventContentType)
 	}
 
 	if trigger.Headers != nil {
 		for name, value := range trigger.Headers {
-			request.Header[name] = []string{value}
+			header[name] = []string{value}
 		}
 	}
 
@@ -157,14 +161,14 @@ func (t *HTTPTrigger) Execute(ctx context.Context, events map[string]*v1alpha1.E
 			var value string
 			var err error
 			if secure.ValueFrom.SecretKeyRef != nil {
-				value, err = common.GetSecretFromVolume(secure.ValueFrom.SecretKeyRef)
+				value, err = common.GetSecret(secure.ValueFrom.SecretKeyRef)
 			} else {
 				value, err = common.GetConfigMapFromVolume(secure.ValueFrom.ConfigMapKeyRef)
 			}
 			if err != nil {
 				return nil, errors.Wrap(err, "failed to retrieve the value for secureHeader")
 			}
-			request.Header[secure.Name] = []string{value}
+			header[secure.Name] = []string{value}
 		}
 	}
 
@@ -175,30 +179,54 @@ func (t *HTTPTrigger) Execute(ctx context.Context, events map[string]*v1alpha1.E
 		password := ""
 
 		if basicAuth.Username != nil {
-			username, err = common.GetSecretFromVolume(basicAuth.Username)
+			username, err = common.GetSecret(basicAuth.Username)
 			if err != nil {
 				return nil, errors.Wrap(err, "failed to retrieve the username")
 			}
 		}
 
 		if basicAuth.Password != nil {
-			password, err = common.GetSecretFromVolume(basicAuth.Password)
-			if !ok {
+			password, err = common.GetSecret(basicAuth.Password)
+			if err != nil {
 				return nil, errors.Wrap(err, "failed to retrieve the password")
 			}
 		}
 
-		request.SetBasicAuth(username, password)
+		header.Set("Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(username+":"+password)))
+	}
+
+	if t.Trigger.Template.DryRun {
+		headers := make([]string, 0, len(header))
+		for name := range header {
+			headers = append(headers, name)
+		}
+		t.Logger.Infow("dry run, skipping the http request", zap.String("method", trigger.Method), zap.String("url", trigger.URL),
+			zap.Strings("headers", headers), zap.String("payload", string(payload)))
+		return nil, nil
+	}
+
+	executor, err := triggers.NewHTTPExecutor(t.Client, trigger.MaxResponseSize, trigger.RetryStrategy, trigger.RetryOn, t.Logger)
+	if err != nil {
+		return nil, triggers.NewPermanentError(err)
 	}
 
 	t.Logger.Infow("making a http request...", zap.Any("url", trigger.URL))
 
-	return t.Client.Do(request)
+	// The body is read for the connection to be reused, and kept for the output of the trigger.
+	response, err := executor.Do(ctx, &triggers.HTTPRequest{Method: trigger.Method, URL: trigger.URL, Header: header, Body: payload})
+	if err != nil {
+		if triggers.IsResponseTooLargeError(err) {
+			// The request was made, retrying it won't make the response any smaller.
+			return nil, triggers.NewPermanentError(err)
+		}
+		return nil, err
+	}
+	return response, nil
 }
 
 // ApplyPolicy applies policy on the trigger
 func (t *HTTPTrigger) ApplyPolicy(ctx context.Context, resource interface{}) error {
-	if t.Trigger.Policy == nil || t.Trigger.Policy.Status == nil || t.Trigger.Policy.Status.Allow == nil {
+	if t.Trigger.Policy == nil || t.Trigger.Policy.Status == nil || (t.Trigger.Policy.Status.Allow == nil && t.Trigger.Policy.Status.AllowRanges == nil) {
 		return nil
 	}
 	response, ok := resource.(*http.Response)
@@ -206,7 +234,10 @@ func (t *HTTPTrigger) ApplyPolicy(ctx context.Context, resource interface{}) err
 		return errors.New("failed to interpret the trigger execution response")
 	}
 
-	p := policy.NewStatusPolicy(response.StatusCode, t.Trigger.Policy.Status.GetAllow())
+	p, err := policy.NewStatusPolicyWithRanges(response.StatusCode, t.Trigger.Policy.Status.GetAllow(), t.Trigger.Policy.Status.AllowRanges)
+	if err != nil {
+		return err
+	}
 
 	return p.ApplyPolicy(ctx)
 }
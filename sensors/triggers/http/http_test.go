@@ -16,13 +16,19 @@ limitations under the License.
 package http
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
+	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/argoproj/argo-events/common/logging"
 	"github.com/argoproj/argo-events/pkg/apis/common"
 	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sensors/triggers"
 	cloudevents "github.com/cloudevents/sdk-go/v2"
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
@@ -145,4 +151,102 @@ func TestHTTPTrigger_ApplyPolicy(t *testing.T) {
 	}
 	err = trigger.ApplyPolicy(context.TODO(), response)
 	assert.NotNil(t, err)
+
+	trigger.Trigger.Policy = &v1alpha1.TriggerPolicy{
+		Status: &v1alpha1.StatusPolicy{AllowRanges: []string{"2xx", "404"}},
+	}
+	err = trigger.ApplyPolicy(context.TODO(), &http.Response{StatusCode: 204})
+	assert.Nil(t, err)
+	err = trigger.ApplyPolicy(context.TODO(), &http.Response{StatusCode: 404})
+	assert.Nil(t, err)
+	err = trigger.ApplyPolicy(context.TODO(), &http.Response{StatusCode: 500})
+	assert.NotNil(t, err)
+}
+
+func TestHTTPTrigger_Execute_Response(t *testing.T) {
+	content := `{"result": "` + strings.Repeat("x", 64) + `"}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var buf bytes.Buffer
+		gw := gzip.NewWriter(&buf)
+		_, _ = gw.Write([]byte(content))
+		_ = gw.Close()
+		w.Header().Set("Content-Encoding", "gzip")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(buf.Bytes())
+	}))
+	defer server.Close()
+
+	trigger := getFakeHTTPTrigger()
+	trigger.Client = server.Client()
+	resource := trigger.Trigger.Template.HTTP.DeepCopy()
+	resource.URL = server.URL
+	// The client decodes the responses it requested compressed itself, not the ones requested by the trigger.
+	resource.Headers = map[string]string{"Accept-Encoding": "gzip"}
+
+	result, err := trigger.Execute(context.TODO(), nil, resource)
+	assert.Nil(t, err)
+	response, ok := result.(*http.Response)
+	assert.True(t, ok)
+	body, err := ioutil.ReadAll(response.Body)
+	assert.Nil(t, err)
+	assert.Equal(t, content, string(body))
+
+	resource.MaxResponseSize = 10
+	_, err = trigger.Execute(context.TODO(), nil, resource)
+	assert.NotNil(t, err)
+	assert.True(t, triggers.IsResponseTooLargeError(err))
+	assert.True(t, triggers.IsPermanentError(err))
+}
+
+func TestHTTPTrigger_Execute_Retries(t *testing.T) {
+	attempts := 0
+	statuses := []int{http.StatusServiceUnavailable, http.StatusTooManyRequests, http.StatusOK}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		status := statuses[attempts%len(statuses)]
+		attempts++
+		assert.Equal(t, "events", r.Header.Get("X-Team"))
+		body, _ := ioutil.ReadAll(r.Body)
+		w.WriteHeader(status)
+		_, _ = w.Write(append([]byte("attempt "), body...))
+	}))
+	defer server.Close()
+
+	trigger := getFakeHTTPTrigger()
+	trigger.Client = server.Client()
+	resource := trigger.Trigger.Template.HTTP.DeepCopy()
+	resource.URL = server.URL
+	resource.Headers = map[string]string{"X-Team": "events"}
+	duration := common.FromString("1ms")
+	resource.RetryStrategy = &common.Backoff{Steps: 3, Duration: &duration}
+
+	result, err := trigger.Execute(context.TODO(), nil, resource)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, attempts)
+	response := result.(*http.Response)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	// The response of the last attempt is captured.
+	body, err := ioutil.ReadAll(response.Body)
+	assert.Nil(t, err)
+	assert.Equal(t, "attempt ", string(body))
+
+	// The retries exhausted, the last response is returned for the policy to be applied to it.
+	attempts = 0
+	resource.RetryStrategy = &common.Backoff{Steps: 2, Duration: &duration}
+	result, err = trigger.Execute(context.TODO(), nil, resource)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, attempts)
+	assert.Equal(t, http.StatusTooManyRequests, result.(*http.Response).StatusCode)
+
+	// The statuses not to retry on are not retried.
+	attempts = 0
+	resource.RetryOn = []string{"500-502"}
+	result, err = trigger.Execute(context.TODO(), nil, resource)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, attempts)
+	assert.Equal(t, http.StatusServiceUnavailable, result.(*http.Response).StatusCode)
+
+	resource.RetryOn = []string{"6xx"}
+	_, err = trigger.Execute(context.TODO(), nil, resource)
+	assert.NotNil(t, err)
+	assert.True(t, triggers.IsPermanentError(err))
 }
@@ -39,13 +39,78 @@ type KafkaTrigger struct {
 	// Trigger reference
 	Trigger *v1alpha1.Trigger
 	// Kafka async producer
-	Producer sarama.AsyncProducer
+	Producer *Producer
 	// Logger to log stuff
 	Logger *zap.SugaredLogger
 }
 
+// Producer is the async producer of a trigger, cached by trigger name, which delivers the outcome of the
+// production of each message to the execution which produced it.
+type Producer struct {
+	sarama.AsyncProducer
+	// Acks is the acknowledgement level the messages are produced with
+	Acks sarama.RequiredAcks
+}
+
+// NewProducer returns the producer producing the messages with the acknowledgement level. The producer must
+// return its successes and its errors, which are read until it is closed.
+func NewProducer(producer sarama.AsyncProducer, acks sarama.RequiredAcks, logger *zap.SugaredLogger) *Producer {
+	go func() {
+		successes, errs := producer.Successes(), producer.Errors()
+		for successes != nil || errs != nil {
+			select {
+			case msg, ok := <-successes:
+				if !ok {
+					successes = nil
+					continue
+				}
+				deliver(msg, nil)
+			case perr, ok := <-errs:
+				if !ok {
+					errs = nil
+					continue
+				}
+				if !deliver(perr.Msg, perr.Err) {
+					logger.Errorw("Error happened in kafka producer", zap.Error(perr.Err))
+				}
+			}
+		}
+	}()
+	return &Producer{AsyncProducer: producer, Acks: acks}
+}
+
+// deliver delivers the outcome of the production of the message to the execution waiting for it, if any
+func deliver(msg *sarama.ProducerMessage, err error) bool {
+	if msg == nil {
+		return false
+	}
+	done, ok := msg.Metadata.(chan error)
+	if !ok {
+		return false
+	}
+	done <- err
+	return true
+}
+
+// ProduceResult is the outcome of the production of a message, acknowledged by the broker
+type ProduceResult struct {
+	Topic     string `json:"topic"`
+	Partition int32  `json:"partition"`
+	Offset    int64  `json:"offset"`
+	// Acks is the acknowledgement level the message was produced with
+	Acks sarama.RequiredAcks `json:"acks"`
+}
+
+// requiredAcks returns the acknowledgement level of the messages of the trigger
+func requiredAcks(trigger *v1alpha1.KafkaTrigger) sarama.RequiredAcks {
+	if trigger.RequiredAcks == 0 || trigger.Idempotent {
+		return sarama.WaitForAll
+	}
+	return sarama.RequiredAcks(trigger.RequiredAcks)
+}
+
 // NewKafkaTrigger returns a new kafka trigger context.
-func NewKafkaTrigger(sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger, kafkaProducers map[string]sarama.AsyncProducer, logger *zap.SugaredLogger) (*KafkaTrigger, error) {
+func NewKafkaTrigger(sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger, kafkaProducers map[string]*Producer, logger *zap.SugaredLogger) (*KafkaTrigger, error) {
 	kafkatrigger := trigger.Template.Kafka
 	triggerLogger := logger.With(logging.LabelTriggerType, apicommon.KafkaTrigger)
 
@@ -68,13 +133,13 @@ func NewKafkaTrigger(sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger, kafkaPr
 			config.Net.SASL.Enable = true
 			config.Net.SASL.Mechanism = sarama.SASLMechanism(kafkatrigger.SASL.GetMechanism())
 
-			user, err := common.GetSecretFromVolume(kafkatrigger.SASL.UserSecret)
+			user, err := common.GetSecret(kafkatrigger.SASL.UserSecret)
 			if err != nil {
 				return nil, errors.Wrap(err, "Error getting user value from secret")
 			}
 			config.Net.SASL.User = user
 
-			password, err := common.GetSecretFromVolume(kafkatrigger.SASL.PasswordSecret)
+			password, err := common.GetSecret(kafkatrigger.SASL.PasswordSecret)
 			if err != nil {
 				return nil, errors.Wrap(err, "Error getting password value from secret")
 			}
@@ -101,24 +166,24 @@ func NewKafkaTrigger(sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger, kafkaPr
 		}
 		config.Producer.Flush.Frequency = time.Duration(ff)
 
-		ra := sarama.WaitForAll
-		if kafkatrigger.RequiredAcks != 0 {
-			ra = sarama.RequiredAcks(kafkatrigger.RequiredAcks)
+		config.Producer.RequiredAcks = requiredAcks(kafkatrigger)
+		// The executions wait for their messages to be acknowledged.
+		config.Producer.Return.Successes = true
+
+		if kafkatrigger.Idempotent {
+			// The idempotent producer keeps the order of the messages it retries with a single request in flight.
+			config.Producer.Idempotent = true
+			config.Net.MaxOpenRequests = 1
 		}
-		config.Producer.RequiredAcks = ra
 
 		urls := strings.Split(kafkatrigger.URL, ",")
-		producer, err = sarama.NewAsyncProducer(urls, config)
+		asyncProducer, err := sarama.NewAsyncProducer(urls, config)
 		if err != nil {
 			return nil, err
 		}
 
-		// must read from the Errors() channel or the async producer will deadlock.
-		go func() {
-			for err := range producer.Errors() {
-				triggerLogger.Errorf("Error happened in kafka producer", err)
-			}
-		}()
+		// must read from the Successes() and Errors() channels or the async producer will deadlock.
+		producer = NewProducer(asyncProducer, config.Producer.RequiredAcks, triggerLogger)
 
 		kafkaProducers[trigger.Template.Name] = producer
 	}
@@ -183,26 +248,81 @@ func (t *KafkaTrigger) Execute(ctx context.Context, events map[string]*v1alpha1.
 	if err != nil {
 		return nil, err
 	}
+	payload, err = triggers.WrapCloudEvent(t.Sensor, t.Trigger, events, payload)
+	if err != nil {
+		return nil, err
+	}
 
-	pk := trigger.PartitioningKey
-	if pk == "" {
-		pk = trigger.URL
+	pk, err := partitioningKey(trigger, events)
+	if err != nil {
+		return nil, err
 	}
 
-	t.Producer.Input() <- &sarama.ProducerMessage{
+	if t.Trigger.Template.DryRun {
+		t.Logger.Infow("dry run, skipping producing the message", zap.String("topic", trigger.Topic), zap.Int32("partition", trigger.Partition),
+			zap.String("partitioningKey", pk), zap.String("payload", string(payload)))
+		return nil, nil
+	}
+
+	done := make(chan error, 1)
+	msg := &sarama.ProducerMessage{
 		Topic:     trigger.Topic,
 		Key:       sarama.StringEncoder(pk),
 		Value:     sarama.ByteEncoder(payload),
 		Partition: trigger.Partition,
 		Timestamp: time.Now().UTC(),
+		Metadata:  done,
+	}
+	select {
+	case t.Producer.Input() <- msg:
+	case <-ctx.Done():
+		return nil, errors.Wrap(ctx.Err(), "failed to produce the message")
+	}
+	// The producer sets the partition and the offset of the message before returning it as a success, which
+	// delivers it to done, so they are the ones of the produced message once done is received from.
+	select {
+	case err := <-done:
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to produce the message")
+		}
+	case <-ctx.Done():
+		// The message may still be produced, the idempotent producer doesn't deduplicate the retries of the sensor.
+		return nil, errors.Wrap(ctx.Err(), "failed to wait for the acknowledgement of the message")
 	}
 
-	t.Logger.Infow("successfully produced a message", zap.Any("topic", trigger.Topic), zap.Any("partition", trigger.Partition))
+	t.Logger.Infow("successfully produced a message", zap.Any("topic", msg.Topic), zap.Any("partition", msg.Partition), zap.Int64("offset", msg.Offset))
 
-	return nil, nil
+	return &ProduceResult{Topic: msg.Topic, Partition: msg.Partition, Offset: msg.Offset, Acks: t.Producer.Acks}, nil
 }
 
-// ApplyPolicy applies policy on the trigger
+// partitioningKey returns the partitioning key of the message, from the template of the trigger if it has one,
+// and defaulting to the broker url
+func partitioningKey(trigger *v1alpha1.KafkaTrigger, events map[string]*v1alpha1.Event) (string, error) {
+	pk := trigger.PartitioningKey
+	if trigger.PartitioningKeyTemplate != "" {
+		var err error
+		pk, err = triggers.ExecuteParameterTemplate(trigger.PartitioningKeyTemplate, events)
+		if err != nil {
+			return "", errors.Wrap(err, "failed to execute the partitioning key template")
+		}
+	}
+	if pk == "" {
+		pk = trigger.URL
+	}
+	return pk, nil
+}
+
+// ApplyPolicy verifies the message was acknowledged by the broker, unless it was produced without waiting for a response
 func (t *KafkaTrigger) ApplyPolicy(ctx context.Context, resource interface{}) error {
+	if resource == nil {
+		return nil
+	}
+	result, ok := resource.(*ProduceResult)
+	if !ok {
+		return errors.New("failed to interpret the trigger execution response")
+	}
+	if result.Acks != sarama.NoResponse && result.Offset < 0 {
+		return errors.Errorf("the message produced to partition %d of topic %s was not acknowledged", result.Partition, result.Topic)
+	}
 	return nil
 }
@@ -22,6 +22,7 @@ import (
 	"github.com/Shopify/sarama"
 	"github.com/Shopify/sarama/mocks"
 	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -61,13 +62,21 @@ var sensorObj = &v1alpha1.Sensor{
 	},
 }
 
-func getFakeKafkaTrigger(producers map[string]sarama.AsyncProducer) (*KafkaTrigger, error) {
+func getFakeKafkaTrigger(producers map[string]*Producer) (*KafkaTrigger, error) {
 	return NewKafkaTrigger(sensorObj.DeepCopy(), sensorObj.Spec.Triggers[0].DeepCopy(), producers, logging.NewArgoEventsLogger())
 }
 
+// newFakeProducer returns a mock producer returning its successes, as the producers of the triggers
+func newFakeProducer(t *testing.T) (*mocks.AsyncProducer, *Producer) {
+	config := sarama.NewConfig()
+	config.Producer.Return.Successes = true
+	mock := mocks.NewAsyncProducer(t, config)
+	return mock, NewProducer(mock, sarama.WaitForAll, logging.NewArgoEventsLogger())
+}
+
 func TestNewKafkaTrigger(t *testing.T) {
-	producer := mocks.NewAsyncProducer(t, nil)
-	producers := map[string]sarama.AsyncProducer{
+	_, producer := newFakeProducer(t)
+	producers := map[string]*Producer{
 		"fake-trigger": producer,
 	}
 	trigger, err := NewKafkaTrigger(sensorObj.DeepCopy(), sensorObj.Spec.Triggers[0].DeepCopy(), producers, logging.NewArgoEventsLogger())
@@ -78,8 +87,8 @@ func TestNewKafkaTrigger(t *testing.T) {
 }
 
 func TestKafkaTrigger_FetchResource(t *testing.T) {
-	producer := mocks.NewAsyncProducer(t, nil)
-	trigger, err := getFakeKafkaTrigger(map[string]sarama.AsyncProducer{
+	_, producer := newFakeProducer(t)
+	trigger, err := getFakeKafkaTrigger(map[string]*Producer{
 		"fake-trigger": producer,
 	})
 	assert.Nil(t, err)
@@ -92,8 +101,8 @@ func TestKafkaTrigger_FetchResource(t *testing.T) {
 }
 
 func TestKafkaTrigger_ApplyResourceParameters(t *testing.T) {
-	producer := mocks.NewAsyncProducer(t, nil)
-	trigger, err := getFakeKafkaTrigger(map[string]sarama.AsyncProducer{
+	_, producer := newFakeProducer(t)
+	trigger, err := getFakeKafkaTrigger(map[string]*Producer{
 		"fake-trigger": producer,
 	})
 	assert.Nil(t, err)
@@ -136,8 +145,8 @@ func TestKafkaTrigger_ApplyResourceParameters(t *testing.T) {
 }
 
 func TestKafkaTrigger_Execute(t *testing.T) {
-	producer := mocks.NewAsyncProducer(t, nil)
-	trigger, err := getFakeKafkaTrigger(map[string]sarama.AsyncProducer{
+	mock, producer := newFakeProducer(t)
+	trigger, err := getFakeKafkaTrigger(map[string]*Producer{
 		"fake-trigger": producer,
 	})
 	assert.Nil(t, err)
@@ -168,9 +177,66 @@ func TestKafkaTrigger_Execute(t *testing.T) {
 		},
 	}
 
-	producer.ExpectInputAndSucceed()
+	mock.ExpectInputAndSucceed()
 
 	result, err := trigger.Execute(context.TODO(), testEvents, trigger.Trigger.Template.Kafka)
 	assert.Nil(t, err)
-	assert.Nil(t, result)
+	produced, ok := result.(*ProduceResult)
+	assert.True(t, ok)
+	assert.Equal(t, "fake-topic", produced.Topic)
+	assert.Equal(t, sarama.WaitForAll, produced.Acks)
+	assert.Equal(t, int64(1), produced.Offset)
+	assert.Nil(t, trigger.ApplyPolicy(context.TODO(), result))
+
+	t.Run("failed production", func(t *testing.T) {
+		mock.ExpectInputAndFail(sarama.ErrNotEnoughReplicas)
+		result, err := trigger.Execute(context.TODO(), testEvents, trigger.Trigger.Template.Kafka)
+		assert.Nil(t, result)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "failed to produce the message")
+		assert.True(t, errors.Is(err, sarama.ErrNotEnoughReplicas))
+	})
+}
+
+func TestKafkaTrigger_ApplyPolicy(t *testing.T) {
+	_, producer := newFakeProducer(t)
+	trigger, err := getFakeKafkaTrigger(map[string]*Producer{
+		"fake-trigger": producer,
+	})
+	assert.Nil(t, err)
+
+	assert.Nil(t, trigger.ApplyPolicy(context.TODO(), nil))
+	assert.Nil(t, trigger.ApplyPolicy(context.TODO(), &ProduceResult{Topic: "fake-topic", Offset: 10, Acks: sarama.WaitForAll}))
+	assert.Nil(t, trigger.ApplyPolicy(context.TODO(), &ProduceResult{Topic: "fake-topic", Offset: -1, Acks: sarama.NoResponse}))
+
+	err = trigger.ApplyPolicy(context.TODO(), &ProduceResult{Topic: "fake-topic", Offset: -1, Acks: sarama.WaitForAll})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "was not acknowledged")
+}
+
+func TestPartitioningKey(t *testing.T) {
+	events := map[string]*v1alpha1.Event{
+		"order": {
+			Context: &v1alpha1.EventContext{DataContentType: "application/json"},
+			Data:    []byte(`{"customerId": "customer-1"}`),
+		},
+	}
+	trigger := &v1alpha1.KafkaTrigger{URL: "fake-kafka-url"}
+	key, err := partitioningKey(trigger, events)
+	assert.Nil(t, err)
+	assert.Equal(t, "fake-kafka-url", key)
+
+	trigger.PartitioningKey = "fixed"
+	key, err = partitioningKey(trigger, events)
+	assert.Nil(t, err)
+	assert.Equal(t, "fixed", key)
+
+	trigger.PartitioningKeyTemplate = "{{ .order.customerId }}"
+	key, err = partitioningKey(trigger, events)
+	assert.Nil(t, err)
+	assert.Equal(t, "customer-1", key)
+
+	trigger.PartitioningKeyTemplate = "{{ .order.missing.id }}"
+	_, err = partitioningKey(trigger, events)
+	assert.NotNil(t, err)
 }
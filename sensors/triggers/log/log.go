@@ -2,7 +2,10 @@ package log
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"sync"
 	"time"
 
 	"go.uber.org/zap"
@@ -10,13 +13,20 @@ import (
 	"github.com/argoproj/argo-events/common/logging"
 	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
 	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sensors/triggers"
+)
+
+var (
+	// lastLogTimesLock guards the last log times, shared by the concurrent executions.
+	lastLogTimesLock sync.Mutex
+	// lastLogTimes is when each trigger last logged, the trigger is created anew for each execution.
+	lastLogTimes = make(map[string]time.Time)
 )
 
 type LogTrigger struct {
-	Sensor      *v1alpha1.Sensor
-	Trigger     *v1alpha1.Trigger
-	Logger      *zap.SugaredLogger
-	LastLogTime time.Time
+	Sensor  *v1alpha1.Sensor
+	Trigger *v1alpha1.Trigger
+	Logger  *zap.SugaredLogger
 }
 
 func NewLogTrigger(sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger, logger *zap.SugaredLogger) (*LogTrigger, error) {
@@ -32,8 +42,25 @@ func (t *LogTrigger) FetchResource(ctx context.Context) (interface{}, error) {
 	return t.Trigger.Template.Log, nil
 }
 
-func (t *LogTrigger) ApplyResourceParameters(_ map[string]*v1alpha1.Event, resource interface{}) (interface{}, error) {
-	return resource, nil
+// ApplyResourceParameters applies parameters to the trigger resource
+func (t *LogTrigger) ApplyResourceParameters(events map[string]*v1alpha1.Event, resource interface{}) (interface{}, error) {
+	parameters := t.Trigger.Template.Log.Parameters
+	if parameters == nil {
+		return resource, nil
+	}
+	resourceBytes, err := json.Marshal(resource)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal the log trigger resource, %w", err)
+	}
+	updatedResourceBytes, err := triggers.ApplyParams(resourceBytes, parameters, events)
+	if err != nil {
+		return nil, err
+	}
+	var log *v1alpha1.LogTrigger
+	if err := json.Unmarshal(updatedResourceBytes, &log); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal the updated log trigger resource after applying resource parameters, %w", err)
+	}
+	return log, nil
 }
 
 func (t *LogTrigger) Execute(ctx context.Context, events map[string]*v1alpha1.Event, resource interface{}) (interface{}, error) {
@@ -41,21 +68,78 @@ func (t *LogTrigger) Execute(ctx context.Context, events map[string]*v1alpha1.Ev
 	if !ok {
 		return nil, errors.New("failed to interpret the fetched trigger resource")
 	}
-	if t.shouldLog(log) {
+	lastLogTimesLock.Lock()
+	if !t.shouldLog(log) {
+		lastLogTimesLock.Unlock()
+		return nil, nil
+	}
+	lastLogTimes[t.Trigger.Template.Name] = time.Now()
+	lastLogTimesLock.Unlock()
+
+	// The trigger only logs, the events are logged and resolved with their sensitive fields masked.
+	events = triggers.MaskEvents(t.Sensor, events)
+
+	if log.Payload == nil {
 		for dependencyName, event := range events {
-			t.Logger.Infow(
-				event.DataString(),
+			t.logw(log.Level, event.DataString(),
 				zap.String("dependencyName", dependencyName),
 				zap.Any("eventContext", event.Context),
 			)
 		}
-		t.LastLogTime = time.Now()
+		return nil, nil
+	}
+	// The payload is constructed the same as by the other triggers, for the entry to be diffed with what they send.
+	payload, err := triggers.ConstructPayload(events, log.Payload)
+	if err != nil {
+		return nil, err
 	}
+	payload, err = triggers.WrapCloudEvent(t.Sensor, t.Trigger, events, payload)
+	if err != nil {
+		return nil, err
+	}
+	t.logw(log.Level, "resolved trigger execution",
+		zap.Reflect("events", resolvedEvents(events)),
+		zap.Reflect("payload", json.RawMessage(payload)),
+	)
 	return nil, nil
 }
 
+// shouldLog tells if the interval has elapsed since the trigger last logged, it is called with the lock held.
 func (t *LogTrigger) shouldLog(log *v1alpha1.LogTrigger) bool {
-	return time.Now().After(t.LastLogTime.Add(log.GetInterval()))
+	return time.Now().After(lastLogTimes[t.Trigger.Template.Name].Add(log.GetInterval()))
+}
+
+// logw logs the message with the fields at the level
+func (t *LogTrigger) logw(level v1alpha1.LogLevel, msg string, fields ...interface{}) {
+	switch level {
+	case v1alpha1.LogLevelDebug:
+		t.Logger.Debugw(msg, fields...)
+	case v1alpha1.LogLevelWarn:
+		t.Logger.Warnw(msg, fields...)
+	case v1alpha1.LogLevelError:
+		t.Logger.Errorw(msg, fields...)
+	default:
+		t.Logger.Infow(msg, fields...)
+	}
+}
+
+// resolvedEvent is how an event is logged, with its data as is if it is JSON
+type resolvedEvent struct {
+	Context *v1alpha1.EventContext `json:"context"`
+	Data    interface{}            `json:"data"`
+}
+
+// resolvedEvents returns the events to log by dependency name
+func resolvedEvents(events map[string]*v1alpha1.Event) map[string]resolvedEvent {
+	result := make(map[string]resolvedEvent, len(events))
+	for dependencyName, event := range events {
+		var data interface{} = string(event.Data)
+		if json.Valid(event.Data) {
+			data = json.RawMessage(event.Data)
+		}
+		result[dependencyName] = resolvedEvent{Context: event.Context, Data: data}
+	}
+	return result
 }
 
 func (t *LogTrigger) ApplyPolicy(context.Context, interface{}) error {
@@ -2,11 +2,15 @@ package log
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
 	"go.uber.org/zap/zaptest"
+	"go.uber.org/zap/zaptest/observer"
 
 	sv1 "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
 )
@@ -28,3 +32,90 @@ func TestLogTrigger(t *testing.T) {
 	assert.True(t, l.shouldLog(&sv1.LogTrigger{}))
 	assert.True(t, l.shouldLog(&sv1.LogTrigger{IntervalSeconds: 1}))
 }
+
+func TestLogTrigger_Throttle(t *testing.T) {
+	trigger := &sv1.Trigger{Template: &sv1.TriggerTemplate{Name: "fake-throttled", Log: &sv1.LogTrigger{IntervalSeconds: 60}}}
+	core, logs := observer.New(zapcore.InfoLevel)
+	events := map[string]*sv1.Event{"my-event": {Data: []byte(`{}`)}}
+
+	// The trigger is created anew for each execution, as by the sensor
+	for i := 0; i < 3; i++ {
+		l, err := NewLogTrigger(nil, trigger, zap.New(core).Sugar())
+		assert.NoError(t, err)
+		_, err = l.Execute(context.TODO(), events, trigger.Template.Log)
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, 1, logs.Len())
+}
+
+func TestLogTrigger_Payload(t *testing.T) {
+	trigger := &sv1.Trigger{Template: &sv1.TriggerTemplate{Name: "fake-payload", Log: &sv1.LogTrigger{
+		Level: sv1.LogLevelWarn,
+		Payload: []sv1.TriggerParameter{
+			{
+				Src:  &sv1.TriggerParameterSource{DependencyName: "my-event", DataKey: "name"},
+				Dest: "name",
+			},
+		},
+	}}}
+	core, logs := observer.New(zapcore.DebugLevel)
+	l, err := NewLogTrigger(nil, trigger, zap.New(core).Sugar())
+	assert.NoError(t, err)
+	events := map[string]*sv1.Event{
+		"my-event": {Context: &sv1.EventContext{ID: "1"}, Data: []byte(`{"name":"fake"}`)},
+	}
+	_, err = l.Execute(context.TODO(), events, trigger.Template.Log)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 1, logs.Len())
+	entry := logs.All()[0]
+	assert.Equal(t, zapcore.WarnLevel, entry.Level)
+	fields := entry.ContextMap()
+	payload, err := json.Marshal(fields["payload"])
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"name":"fake"}`, string(payload))
+	resolved, ok := fields["events"].(map[string]resolvedEvent)
+	assert.True(t, ok)
+	assert.Equal(t, "1", resolved["my-event"].Context.ID)
+	data, err := json.Marshal(resolved["my-event"].Data)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"name":"fake"}`, string(data))
+}
+
+func TestLogTrigger_ApplyResourceParameters(t *testing.T) {
+	trigger := &sv1.Trigger{Template: &sv1.TriggerTemplate{Name: "fake-parameters", Log: &sv1.LogTrigger{
+		Level: sv1.LogLevelInfo,
+		Parameters: []sv1.TriggerParameter{
+			{
+				Src:  &sv1.TriggerParameterSource{DependencyName: "my-event", DataKey: "level"},
+				Dest: "level",
+			},
+		},
+	}}}
+	l, err := NewLogTrigger(nil, trigger, zaptest.NewLogger(t).Sugar())
+	assert.NoError(t, err)
+	events := map[string]*sv1.Event{"my-event": {Data: []byte(`{"level":"debug"}`)}}
+	resource, err := l.ApplyResourceParameters(events, trigger.Template.Log)
+	assert.NoError(t, err)
+	assert.Equal(t, sv1.LogLevelDebug, resource.(*sv1.LogTrigger).Level)
+}
+
+func TestLogTrigger_SensitiveFields(t *testing.T) {
+	trigger := &sv1.Trigger{Template: &sv1.TriggerTemplate{Name: "fake-sensitive", Log: &sv1.LogTrigger{}}}
+	sensor := &sv1.Sensor{Spec: sv1.SensorSpec{
+		Dependencies: []sv1.EventDependency{{Name: "my-event", SensitiveFields: []string{"card.number"}}},
+	}}
+	core, logs := observer.New(zapcore.DebugLevel)
+	l, err := NewLogTrigger(sensor, trigger, zap.New(core).Sugar())
+	assert.NoError(t, err)
+	events := map[string]*sv1.Event{
+		"my-event": {Context: &sv1.EventContext{ID: "1", DataContentType: "application/json"}, Data: []byte(`{"card":{"number":"4111111111111111","brand":"visa"}}`)},
+	}
+	_, err = l.Execute(context.TODO(), events, trigger.Template.Log)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 1, logs.Len())
+	assert.JSONEq(t, `{"card":{"number":"[REDACTED]","brand":"visa"}}`, logs.All()[0].Message)
+	// The events the other triggers are executed with are left as is.
+	assert.JSONEq(t, `{"card":{"number":"4111111111111111","brand":"visa"}}`, string(events["my-event"].Data))
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package triggers
+
+import (
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+// RedactedValue replaces the values of the masked fields of what is logged
+const RedactedValue = "[REDACTED]"
+
+// RedactJSON replaces the values at the paths of the JSON document, the paths it doesn't have are ignored.
+func RedactJSON(body []byte, paths []string) ([]byte, error) {
+	for _, path := range paths {
+		if !gjson.GetBytes(body, path).Exists() {
+			continue
+		}
+		var err error
+		if body, err = sjson.SetBytes(body, path, RedactedValue); err != nil {
+			return nil, err
+		}
+	}
+	return body, nil
+}
+
+// SensitiveFields returns the paths of the sensitive fields of the events of the dependency, the ones of the
+// sensor followed by the ones of the dependency.
+func SensitiveFields(sensor *v1alpha1.Sensor, dependencyName string) []string {
+	if sensor == nil {
+		return nil
+	}
+	paths := sensor.Spec.SensitiveFields
+	for _, dep := range sensor.Spec.Dependencies {
+		if dep.Name == dependencyName && len(dep.SensitiveFields) > 0 {
+			paths = append(append([]string{}, paths...), dep.SensitiveFields...)
+			break
+		}
+	}
+	return paths
+}
+
+// MaskEvent returns the event of the dependency to be logged, a copy with the values of its sensitive fields
+// replaced, or the event itself if it has none. The data which is not JSON can't be masked, it is replaced
+// as a whole. The event is left as is, for the triggers to be executed with it.
+func MaskEvent(sensor *v1alpha1.Sensor, dependencyName string, event *v1alpha1.Event) *v1alpha1.Event {
+	paths := SensitiveFields(sensor, dependencyName)
+	if event == nil || len(paths) == 0 || len(event.Data) == 0 {
+		return event
+	}
+	masked := &v1alpha1.Event{Context: event.Context, Data: []byte(RedactedValue)}
+	if gjson.ValidBytes(event.Data) {
+		// sjson copies the document before setting a path, the data of the event is not modified.
+		if data, err := RedactJSON(event.Data, paths); err == nil {
+			masked.Data = data
+		}
+	}
+	return masked
+}
+
+// MaskEvents returns the events to be logged by dependency name, with their sensitive fields masked.
+func MaskEvents(sensor *v1alpha1.Sensor, events map[string]*v1alpha1.Event) map[string]*v1alpha1.Event {
+	masked := make(map[string]*v1alpha1.Event, len(events))
+	for dependencyName, event := range events {
+		masked[dependencyName] = MaskEvent(sensor, dependencyName, event)
+	}
+	return masked
+}
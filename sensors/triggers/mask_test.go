@@ -0,0 +1,67 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package triggers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+func TestMaskEvents(t *testing.T) {
+	sensor := &v1alpha1.Sensor{
+		Spec: v1alpha1.SensorSpec{
+			SensitiveFields: []string{"password"},
+			Dependencies: []v1alpha1.EventDependency{
+				{Name: "user", SensitiveFields: []string{"user.ssn", "missing"}},
+				{Name: "order"},
+			},
+		},
+	}
+	events := map[string]*v1alpha1.Event{
+		"user":  {Context: &v1alpha1.EventContext{ID: "1"}, Data: []byte(`{"user":{"name":"jane","ssn":"123-45-6789"},"password":"secret"}`)},
+		"order": {Context: &v1alpha1.EventContext{ID: "2"}, Data: []byte(`{"id":"o-1","password":"secret","user":{"ssn":"123-45-6789"}}`)},
+		"text":  {Context: &v1alpha1.EventContext{ID: "3"}, Data: []byte(`password=secret`)},
+	}
+
+	t.Run("test masked events", func(t *testing.T) {
+		masked := MaskEvents(sensor, events)
+		assert.JSONEq(t, `{"user":{"name":"jane","ssn":"[REDACTED]"},"password":"[REDACTED]"}`, string(masked["user"].Data))
+		// The fields of the other dependencies are not masked.
+		assert.JSONEq(t, `{"id":"o-1","password":"[REDACTED]","user":{"ssn":"123-45-6789"}}`, string(masked["order"].Data))
+		assert.Equal(t, "[REDACTED]", string(masked["text"].Data))
+		assert.Equal(t, "1", masked["user"].Context.ID)
+	})
+
+	t.Run("test payload intact", func(t *testing.T) {
+		_ = MaskEvents(sensor, events)
+		assert.JSONEq(t, `{"user":{"name":"jane","ssn":"123-45-6789"},"password":"secret"}`, string(events["user"].Data))
+		payload, err := ConstructPayload(events, []v1alpha1.TriggerParameter{
+			{Src: &v1alpha1.TriggerParameterSource{DependencyName: "user", DataKey: "user.ssn"}, Dest: "ssn"},
+		})
+		assert.Nil(t, err)
+		assert.JSONEq(t, `{"ssn":"123-45-6789"}`, string(payload))
+	})
+
+	t.Run("test no sensitive fields", func(t *testing.T) {
+		event := events["user"]
+		assert.Same(t, event, MaskEvent(&v1alpha1.Sensor{}, "user", event))
+		assert.Same(t, event, MaskEvent(nil, "user", event))
+	})
+}
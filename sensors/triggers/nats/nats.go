@@ -130,6 +130,15 @@ func (t *NATSTrigger) Execute(ctx context.Context, events map[string]*v1alpha1.E
 	if err != nil {
 		return nil, err
 	}
+	payload, err = triggers.WrapCloudEvent(t.Sensor, t.Trigger, events, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	if t.Trigger.Template.DryRun {
+		t.Logger.Infow("dry run, skipping publishing the message", zap.String("subject", trigger.Subject), zap.String("payload", string(payload)))
+		return nil, nil
+	}
 
 	if err := t.Conn.Publish(t.Trigger.Template.NATS.Subject, payload); err != nil {
 		return nil, err
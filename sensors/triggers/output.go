@@ -0,0 +1,138 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package triggers
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+// OutputEventType is the type of the events holding the outputs of the triggers
+const OutputEventType = "trigger-output"
+
+// MaxOutputBodySize is the size above which the body of an output is dropped
+const MaxOutputBodySize = 1024 * 1024
+
+// OutputKey returns the key under which the output of a trigger is found in the events,
+// i.e. the dependency name the parameters of the other triggers refer to it by.
+func OutputKey(triggerName string) string {
+	return "triggers." + triggerName + ".output"
+}
+
+// Output is the captured output of a trigger execution, the data of its output event.
+type Output struct {
+	// Trigger is the name of the trigger
+	Trigger string `json:"trigger"`
+	// Type is the type of the trigger
+	Type apicommon.TriggerType `json:"type"`
+	// StatusCode is the status code of the response, if the trigger has one
+	StatusCode int `json:"statusCode,omitempty"`
+	// Body is the output of the execution, the response body as is if it is JSON, a JSON string otherwise
+	Body json.RawMessage `json:"body,omitempty"`
+	// Truncated is true if the body was dropped for exceeding MaxOutputBodySize
+	Truncated bool `json:"truncated,omitempty"`
+	// TriggeredByEvents are the IDs of the events the execution was triggered by
+	TriggeredByEvents []string `json:"triggeredByEvents"`
+	// Time is when the execution completed
+	Time metav1.Time `json:"time"`
+}
+
+// OutputProvider is implemented by the triggers whose execution response needs interpreting
+// to capture its output. The output of the others is the JSON encoding of the response.
+type OutputProvider interface {
+	// Output returns the status code, 0 if none, and the body of the execution response
+	Output(response interface{}) (int, []byte, error)
+}
+
+// NewOutput captures the output of an execution of the trigger from the response returned by Execute.
+func NewOutput(name string, trigger Trigger, response interface{}, eventIDs []string) (*Output, error) {
+	var statusCode int
+	var body []byte
+	var err error
+	switch r := response.(type) {
+	case nil:
+	case *http.Response:
+		if r != nil {
+			statusCode = r.StatusCode
+			body, err = readResponseBody(r)
+		}
+	default:
+		if provider, ok := trigger.(OutputProvider); ok {
+			statusCode, body, err = provider.Output(response)
+		} else {
+			body, err = json.Marshal(response)
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to capture the output of trigger %s, %w", name, err)
+	}
+	output := &Output{
+		Trigger:           name,
+		Type:              trigger.GetTriggerType(),
+		StatusCode:        statusCode,
+		TriggeredByEvents: eventIDs,
+		Time:              metav1.Now(),
+	}
+	switch {
+	case len(body) > MaxOutputBodySize:
+		output.Truncated = true
+	case len(body) == 0:
+	case json.Valid(body):
+		output.Body = body
+	default:
+		output.Body, _ = json.Marshal(string(body))
+	}
+	return output, nil
+}
+
+// readResponseBody reads and closes the body of the response, up to one byte over MaxOutputBodySize.
+func readResponseBody(response *http.Response) ([]byte, error) {
+	if response.Body == nil {
+		return nil, nil
+	}
+	defer response.Body.Close()
+	return ioutil.ReadAll(io.LimitReader(response.Body, MaxOutputBodySize+1))
+}
+
+// Event returns the output event, the parameters of the other triggers refer to it by OutputKey.
+func (o *Output) Event(sensorName string) (*v1alpha1.Event, error) {
+	data, err := json.Marshal(o)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal the output of trigger %s, %w", o.Trigger, err)
+	}
+	return &v1alpha1.Event{
+		Context: &v1alpha1.EventContext{
+			ID:              fmt.Sprintf("%s-%d", o.Trigger, o.Time.UnixNano()),
+			Source:          sensorName,
+			Type:            OutputEventType,
+			Subject:         o.Trigger,
+			DataContentType: "application/json",
+			SpecVersion:     cloudevents.VersionV1,
+			Time:            o.Time,
+		},
+		Data: data,
+	}, nil
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package triggers
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+type fakeOutputTrigger struct {
+	fakeTrigger
+	err error
+}
+
+func (t *fakeOutputTrigger) Output(response interface{}) (int, []byte, error) {
+	return http.StatusOK, []byte(response.(string)), t.err
+}
+
+func TestNewOutput(t *testing.T) {
+	eventIDs := []string{"1", "2"}
+
+	t.Run("http response", func(t *testing.T) {
+		response := &http.Response{StatusCode: http.StatusCreated, Body: ioutil.NopCloser(bytes.NewReader([]byte(`{"id":"abc"}`)))}
+		output, err := NewOutput("fake-trigger", &fakeTrigger{}, response, eventIDs)
+		assert.NoError(t, err)
+		assert.Equal(t, "fake-trigger", output.Trigger)
+		assert.Equal(t, fakeTriggerType, output.Type)
+		assert.Equal(t, http.StatusCreated, output.StatusCode)
+		assert.JSONEq(t, `{"id":"abc"}`, string(output.Body))
+		assert.Equal(t, eventIDs, output.TriggeredByEvents)
+	})
+
+	t.Run("non JSON body", func(t *testing.T) {
+		response := &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader("done"))}
+		output, err := NewOutput("fake-trigger", &fakeTrigger{}, response, eventIDs)
+		assert.NoError(t, err)
+		assert.Equal(t, `"done"`, string(output.Body))
+	})
+
+	t.Run("oversize body", func(t *testing.T) {
+		response := &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(strings.Repeat("a", MaxOutputBodySize+1)))}
+		output, err := NewOutput("fake-trigger", &fakeTrigger{}, response, eventIDs)
+		assert.NoError(t, err)
+		assert.True(t, output.Truncated)
+		assert.Nil(t, output.Body)
+	})
+
+	t.Run("output provider", func(t *testing.T) {
+		output, err := NewOutput("fake-trigger", &fakeOutputTrigger{}, `{"result":"ok"}`, eventIDs)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, output.StatusCode)
+		assert.JSONEq(t, `{"result":"ok"}`, string(output.Body))
+
+		_, err = NewOutput("fake-trigger", &fakeOutputTrigger{err: errors.New("bad response")}, "", eventIDs)
+		assert.Error(t, err)
+	})
+
+	t.Run("JSON encoded response", func(t *testing.T) {
+		output, err := NewOutput("fake-trigger", &fakeTrigger{}, map[string]string{"name": "fake"}, eventIDs)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, output.StatusCode)
+		assert.JSONEq(t, `{"name":"fake"}`, string(output.Body))
+	})
+}
+
+func TestOutputEvent(t *testing.T) {
+	response := &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(`{"result":{"id":"abc"}}`))}
+	output, err := NewOutput("fake-trigger", &fakeTrigger{}, response, []string{"1"})
+	assert.NoError(t, err)
+	event, err := output.Event("fake-sensor")
+	assert.NoError(t, err)
+	assert.Equal(t, OutputEventType, event.Context.Type)
+	assert.Equal(t, "fake-sensor", event.Context.Source)
+	assert.Equal(t, "fake-trigger", event.Context.Subject)
+
+	// The parameters of the other triggers refer to the output by its key
+	events := map[string]*v1alpha1.Event{OutputKey("fake-trigger"): event}
+	value, err := ResolveParamValue(&v1alpha1.TriggerParameterSource{
+		DependencyName: "triggers.fake-trigger.output",
+		DataKey:        "body.result.id",
+	}, events)
+	assert.NoError(t, err)
+	assert.Equal(t, "abc", *value)
+
+	value, err = ResolveParamValue(&v1alpha1.TriggerParameterSource{
+		DependencyName: "triggers.fake-trigger.output",
+		DataKey:        "statusCode",
+	}, events)
+	assert.NoError(t, err)
+	assert.Equal(t, "200", *value)
+}
@@ -20,15 +20,18 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"os"
 	"text/template"
 
 	sprig "github.com/Masterminds/sprig/v3"
 	"github.com/ghodss/yaml"
+	"github.com/pkg/errors"
 	"github.com/tidwall/gjson"
 	"github.com/tidwall/sjson"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/common/logging"
 	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
 )
 
@@ -37,7 +40,7 @@ func ConstructPayload(events map[string]*v1alpha1.Event, parameters []v1alpha1.T
 	var payload []byte
 
 	for _, parameter := range parameters {
-		value, err := ResolveParamValue(parameter.Src, events)
+		value, err := resolveParameterValue(parameter, events)
 		if err != nil {
 			return nil, err
 		}
@@ -51,6 +54,30 @@ func ConstructPayload(events map[string]*v1alpha1.Event, parameters []v1alpha1.T
 	return payload, nil
 }
 
+// ConstructBatchPayload constructs the payload of each item of the batch, and returns them as a JSON array.
+func ConstructBatchPayload(batch []map[string]*v1alpha1.Event, parameters []v1alpha1.TriggerParameter) ([]byte, error) {
+	return ConstructBatch(batch, func(events map[string]*v1alpha1.Event) ([]byte, error) {
+		return ConstructPayload(events, parameters)
+	})
+}
+
+// ConstructBatch constructs the payload of each item of the batch with the construct function, and returns
+// them as a JSON array.
+func ConstructBatch(batch []map[string]*v1alpha1.Event, construct func(events map[string]*v1alpha1.Event) ([]byte, error)) ([]byte, error) {
+	items := make([]json.RawMessage, 0, len(batch))
+	for i, events := range batch {
+		payload, err := construct(events)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to construct the payload of item %d of the batch", i)
+		}
+		if payload == nil {
+			payload = []byte("null")
+		}
+		items = append(items, payload)
+	}
+	return json.Marshal(items)
+}
+
 // ApplyTemplateParameters applies parameters to trigger template
 func ApplyTemplateParameters(events map[string]*v1alpha1.Event, trigger *v1alpha1.Trigger) error {
 	if trigger.Parameters != nil && len(trigger.Parameters) > 0 {
@@ -94,7 +121,7 @@ func ApplyResourceParameters(events map[string]*v1alpha1.Event, parameters []v1a
 func ApplyParams(jsonObj []byte, params []v1alpha1.TriggerParameter, events map[string]*v1alpha1.Event) ([]byte, error) {
 	for _, param := range params {
 		// let's grab the param value
-		value, err := ResolveParamValue(param.Src, events)
+		value, err := resolveParameterValue(param, events)
 		if err != nil {
 			return nil, err
 		}
@@ -159,6 +186,53 @@ func renderEventDataAsJSON(event *v1alpha1.Event) ([]byte, error) {
 	}
 }
 
+// resolveParameterValue resolves the value of the parameter from its template if set, from its src otherwise
+func resolveParameterValue(parameter v1alpha1.TriggerParameter, events map[string]*v1alpha1.Event) (*string, error) {
+	if parameter.Template == "" {
+		return ResolveParamValue(parameter.Src, events)
+	}
+	value, err := ExecuteParameterTemplate(parameter.Template, events)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to execute the template of the parameter with destination %s", parameter.Dest)
+	}
+	return &value, nil
+}
+
+// ExecuteParameterTemplate executes the template against the data of the events, keyed by their dependency names.
+// Referencing a missing dependency or key is an error.
+func ExecuteParameterTemplate(templString string, events map[string]*v1alpha1.Event) (string, error) {
+	tpl, err := template.New("parameter").Funcs(sprig.HermeticTxtFuncMap()).Option("missingkey=error").Parse(templString)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	if err := tpl.Execute(&buf, eventsData(events)); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// eventsData returns the data of the events keyed by their dependency names, decoded from JSON
+// or YAML, and as a string otherwise.
+func eventsData(events map[string]*v1alpha1.Event) map[string]interface{} {
+	result := make(map[string]interface{}, len(events))
+	for dependencyName, event := range events {
+		if event == nil {
+			continue
+		}
+		data, err := renderEventDataAsJSON(event)
+		if err != nil {
+			result[dependencyName] = string(event.Data)
+			continue
+		}
+		result[dependencyName] = gjson.ParseBytes(data).Value()
+	}
+	return result
+}
+
+// getSecretValue returns the value of a secret mounted in the sensor pod
+var getSecretValue = common.GetSecretFromVolume
+
 // helper method to resolve the parameter's value from the src
 // returns an error if the Path is invalid/not found and the default value is nil OR if the eventDependency event doesn't exist and default value is nil
 func ResolveParamValue(src *v1alpha1.TriggerParameterSource, events map[string]*v1alpha1.Event) (*string, error) {
@@ -168,9 +242,28 @@ func ResolveParamValue(src *v1alpha1.TriggerParameterSource, events map[string]*
 	var tmplt string
 	var resultValue string
 
+	if src.Secret != nil {
+		value, err := getSecretValue(src.Secret)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get the value of the secret %s", src.Secret.Name)
+		}
+		logging.Redact(value)
+		return &value, nil
+	}
+
+	// The value of the environment variable replaces the default value.
+	defaultValue := src.Value
+	if src.Env != "" {
+		if v, ok := os.LookupEnv(src.Env); ok {
+			defaultValue = &v
+		}
+	}
+	hasKey := src.ContextKey != "" || src.DataKey != "" || src.DataTemplate != "" || src.ContextTemplate != ""
+
 	event, eventExists := events[src.DependencyName]
 	switch {
-	case eventExists:
+	// Without a key, the event is only used if there is no environment variable
+	case eventExists && (hasKey || src.Env == ""):
 		// If context or data keys are not set, return the event payload as is
 		if src.ContextKey == "" && src.DataKey == "" && src.DataTemplate == "" && src.ContextTemplate == "" {
 			eventPayload, err = json.Marshal(&event)
@@ -187,10 +280,12 @@ func ResolveParamValue(src *v1alpha1.TriggerParameterSource, events map[string]*
 			tmplt = src.DataTemplate
 			eventPayload, err = renderEventDataAsJSON(event)
 		}
-	case src.Value != nil:
+	case defaultValue != nil:
 		// Use the default value set by the user in case the event is missing
-		resultValue = *src.Value
+		resultValue = *defaultValue
 		return &resultValue, nil
+	case src.Env != "":
+		return nil, fmt.Errorf("environment variable %s is not set", src.Env)
 	default:
 		// The parameter doesn't have a default value and is referencing a dependency that is
 		// missing in the received events. This is not an error and may happen with || conditions.
@@ -198,9 +293,9 @@ func ResolveParamValue(src *v1alpha1.TriggerParameterSource, events map[string]*
 	}
 
 	if err != nil {
-		if src.Value != nil {
+		if defaultValue != nil {
 			fmt.Printf("failed to parse the event data, using default value. err: %+v\n", err)
-			resultValue = *src.Value
+			resultValue = *defaultValue
 			return &resultValue, nil
 		}
 		return nil, err
@@ -221,8 +316,8 @@ func ResolveParamValue(src *v1alpha1.TriggerParameterSource, events map[string]*
 			}
 			fmt.Printf("Failed to get value by key: %+v\n", err)
 		}
-		if src.Value != nil {
-			resultValue = *src.Value
+		if defaultValue != nil {
+			resultValue = *defaultValue
 			return &resultValue, nil
 		}
 
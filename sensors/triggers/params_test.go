@@ -17,16 +17,22 @@ limitations under the License.
 package triggers
 
 import (
+	"bytes"
 	"encoding/json"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/ghodss/yaml"
 	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/common/logging"
 	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
 	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
 )
@@ -145,6 +151,165 @@ func TestConstructPayload(t *testing.T) {
 	assert.Equal(t, "bar", p.LastName)
 }
 
+func TestConstructPayloadWithSecret(t *testing.T) {
+	getSecretValue = func(selector *corev1.SecretKeySelector) (string, error) {
+		if selector.Name != "function-token" || selector.Key != "token" {
+			return "", fmt.Errorf("secret %s not found", selector.Name)
+		}
+		return "fake-shared-token", nil
+	}
+	defer func() { getSecretValue = common.GetSecretFromVolume }()
+
+	events := map[string]*v1alpha1.Event{
+		"fake-dependency": {
+			Context: &v1alpha1.EventContext{DataContentType: common.MediaTypeJSON},
+			Data:    []byte(`{"name": "fake-user"}`),
+		},
+	}
+	parameters := []v1alpha1.TriggerParameter{
+		{
+			Src:  &v1alpha1.TriggerParameterSource{DependencyName: "fake-dependency", DataKey: "name"},
+			Dest: "name",
+		},
+		{
+			Src: &v1alpha1.TriggerParameterSource{
+				Secret: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "function-token"}, Key: "token"},
+			},
+			Dest: "token",
+		},
+	}
+	payload, err := ConstructPayload(events, parameters)
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{"name": "fake-user", "token": "fake-shared-token"}`, string(payload))
+
+	var buf bytes.Buffer
+	encoder := zapcore.NewJSONEncoder(zap.NewProductionEncoderConfig())
+	logger := zap.New(zapcore.NewCore(encoder, logging.NewRedactingWriteSyncer(zapcore.AddSync(&buf)), zapcore.DebugLevel)).Sugar()
+	logger.Debugw("payload for the trigger execution", zap.String("payload", string(payload)))
+	assert.NotContains(t, buf.String(), "fake-shared-token")
+	assert.Contains(t, buf.String(), logging.RedactedPlaceholder)
+	assert.Contains(t, buf.String(), "fake-user")
+
+	parameters[1].Src.Secret.Name = "missing"
+	_, err = ConstructPayload(events, parameters)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "failed to get the value of the secret missing")
+}
+
+func TestConstructBatchPayload(t *testing.T) {
+	event := func(id, name string) map[string]*v1alpha1.Event {
+		return map[string]*v1alpha1.Event{
+			"fake-dependency": {
+				Context: &v1alpha1.EventContext{
+					ID:              id,
+					DataContentType: common.MediaTypeJSON,
+				},
+				Data: []byte(`{"firstName": "` + name + `"}`),
+			},
+		}
+	}
+	parameters := []v1alpha1.TriggerParameter{
+		{
+			Src: &v1alpha1.TriggerParameterSource{
+				DependencyName: "fake-dependency",
+				DataKey:        "firstName",
+			},
+			Dest: "firstName",
+		},
+	}
+
+	payloadBytes, err := ConstructBatchPayload([]map[string]*v1alpha1.Event{event("1", "fake"), event("2", "faker")}, parameters)
+	assert.Nil(t, err)
+	assert.Equal(t, `[{"firstName":"fake"},{"firstName":"faker"}]`, string(payloadBytes))
+
+	payloadBytes, err = ConstructBatchPayload(nil, parameters)
+	assert.Nil(t, err)
+	assert.Equal(t, `[]`, string(payloadBytes))
+
+	parameters[0].Template = "{{ .unknown.firstName }}"
+	_, err = ConstructBatchPayload([]map[string]*v1alpha1.Event{event("1", "fake")}, parameters)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "item 0 of the batch")
+}
+
+func TestConstructPayloadWithTemplate(t *testing.T) {
+	testEvents := map[string]*v1alpha1.Event{
+		"input": {
+			Context: &v1alpha1.EventContext{
+				ID:              "1",
+				Type:            "webhook",
+				Source:          "webhook-gateway",
+				DataContentType: common.MediaTypeJSON,
+				Subject:         "example-1",
+			},
+			Data: []byte("{\"body\": {\"firstName\": \"fake\", \"street\": \"main street\"}}"),
+		},
+		"another-fake-dependency": {
+			Context: &v1alpha1.EventContext{
+				ID:              "2",
+				Type:            "calendar",
+				Source:          "calendar-gateway",
+				DataContentType: common.MediaTypeJSON,
+				Subject:         "example-1",
+			},
+			Data: []byte("{\"lastName\": \"foo\"}"),
+		},
+	}
+
+	t.Run("test templates", func(t *testing.T) {
+		parameters := []v1alpha1.TriggerParameter{
+			{
+				Template: "{{ .input.body.firstName | upper }}",
+				Dest:     "firstName",
+			},
+			{
+				Template: `{{ index . "another-fake-dependency" "lastName" | b64enc }}`,
+				Dest:     "lastName",
+			},
+			{
+				Src: &v1alpha1.TriggerParameterSource{
+					DependencyName: "input",
+					DataKey:        "body.street",
+				},
+				Dest: "details.street",
+			},
+		}
+
+		payloadBytes, err := ConstructPayload(testEvents, parameters)
+		assert.Nil(t, err)
+
+		var p *Payload
+		err = json.Unmarshal(payloadBytes, &p)
+		assert.Nil(t, err)
+		assert.Equal(t, "FAKE", p.FirstName)
+		assert.Equal(t, "Zm9v", p.LastName)
+		assert.Equal(t, "main street", p.Details.Street)
+	})
+
+	t.Run("test missing key", func(t *testing.T) {
+		parameters := []v1alpha1.TriggerParameter{
+			{
+				Template: "{{ .input.body.unknown }}",
+				Dest:     "firstName",
+			},
+		}
+		_, err := ConstructPayload(testEvents, parameters)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "failed to execute the template of the parameter with destination firstName")
+	})
+
+	t.Run("test missing dependency", func(t *testing.T) {
+		parameters := []v1alpha1.TriggerParameter{
+			{
+				Template: "{{ .unknown.body }}",
+				Dest:     "firstName",
+			},
+		}
+		_, err := ConstructPayload(testEvents, parameters)
+		assert.NotNil(t, err)
+	})
+}
+
 func TestResolveParamValue(t *testing.T) {
 	event := &v1alpha1.Event{
 		Context: &v1alpha1.EventContext{
@@ -281,6 +446,59 @@ func TestResolveParamValue(t *testing.T) {
 	}
 }
 
+func TestResolveParamValueFromEnv(t *testing.T) {
+	t.Setenv("FAKE_REGION", "europe-west1")
+	events := map[string]*v1alpha1.Event{
+		"fake-dependency": {
+			Context: &v1alpha1.EventContext{DataContentType: common.MediaTypeJSON},
+			Data:    []byte(`{"region": "us-east1"}`),
+		},
+	}
+	defaultValue := "us-central1"
+
+	t.Run("env only", func(t *testing.T) {
+		result, err := ResolveParamValue(&v1alpha1.TriggerParameterSource{Env: "FAKE_REGION"}, events)
+		assert.Nil(t, err)
+		assert.Equal(t, "europe-west1", *result)
+	})
+
+	t.Run("env takes precedence over the event without a key", func(t *testing.T) {
+		result, err := ResolveParamValue(&v1alpha1.TriggerParameterSource{DependencyName: "fake-dependency", Env: "FAKE_REGION"}, events)
+		assert.Nil(t, err)
+		assert.Equal(t, "europe-west1", *result)
+	})
+
+	t.Run("event key takes precedence over env", func(t *testing.T) {
+		result, err := ResolveParamValue(&v1alpha1.TriggerParameterSource{DependencyName: "fake-dependency", DataKey: "region", Env: "FAKE_REGION"}, events)
+		assert.Nil(t, err)
+		assert.Equal(t, "us-east1", *result)
+	})
+
+	t.Run("env falls back from a missing event key", func(t *testing.T) {
+		result, err := ResolveParamValue(&v1alpha1.TriggerParameterSource{DependencyName: "fake-dependency", DataKey: "zone", Env: "FAKE_REGION", Value: &defaultValue}, events)
+		assert.Nil(t, err)
+		assert.Equal(t, "europe-west1", *result)
+	})
+
+	t.Run("env takes precedence over value", func(t *testing.T) {
+		result, err := ResolveParamValue(&v1alpha1.TriggerParameterSource{Env: "FAKE_REGION", Value: &defaultValue}, events)
+		assert.Nil(t, err)
+		assert.Equal(t, "europe-west1", *result)
+	})
+
+	t.Run("value falls back from an unset env", func(t *testing.T) {
+		result, err := ResolveParamValue(&v1alpha1.TriggerParameterSource{Env: "FAKE_MISSING", Value: &defaultValue}, events)
+		assert.Nil(t, err)
+		assert.Equal(t, "us-central1", *result)
+	})
+
+	t.Run("unset env", func(t *testing.T) {
+		_, err := ResolveParamValue(&v1alpha1.TriggerParameterSource{Env: "FAKE_MISSING"}, events)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "FAKE_MISSING is not set")
+	})
+}
+
 func TestRenderDataAsJSON(t *testing.T) {
 	event := &v1alpha1.Event{
 		Context: &v1alpha1.EventContext{
@@ -18,6 +18,7 @@ package pulsar
 import (
 	"context"
 	"encoding/json"
+	"sync"
 
 	"github.com/apache/pulsar-client-go/pulsar"
 	"github.com/pkg/errors"
@@ -30,6 +31,9 @@ import (
 	"github.com/argoproj/argo-events/sensors/triggers"
 )
 
+// producersLock guards the producers cache, shared by the concurrent executions.
+var producersLock sync.Mutex
+
 // PulsarTrigger describes the trigger to place messages on Pulsar topic using a producer
 type PulsarTrigger struct {
 	// Sensor object
@@ -46,6 +50,9 @@ type PulsarTrigger struct {
 func NewPulsarTrigger(sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger, pulsarProducers map[string]pulsar.Producer, logger *zap.SugaredLogger) (*PulsarTrigger, error) {
 	pulsarTrigger := trigger.Template.Pulsar
 
+	producersLock.Lock()
+	defer producersLock.Unlock()
+
 	producer, ok := pulsarProducers[trigger.Template.Name]
 	if !ok {
 		var err error
@@ -65,7 +72,7 @@ func NewPulsarTrigger(sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger, pulsar
 		}
 
 		if pulsarTrigger.AuthTokenSecret != nil {
-			token, err := common.GetSecretFromVolume(pulsarTrigger.AuthTokenSecret)
+			token, err := common.GetSecret(pulsarTrigger.AuthTokenSecret)
 			if err != nil {
 				logger.Errorw("failed to get AuthTokenSecret from the volume", "error", err)
 				return nil, err
@@ -177,9 +184,19 @@ func (t *PulsarTrigger) Execute(ctx context.Context, events map[string]*v1alpha1
 	if err != nil {
 		return nil, err
 	}
+	payload, err = triggers.WrapCloudEvent(t.Sensor, t.Trigger, events, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	if t.Trigger.Template.DryRun {
+		t.Logger.Infow("dry run, skipping producing the message", zap.String("topic", trigger.Topic), zap.String("payload", string(payload)))
+		return nil, nil
+	}
 
-	_, err = t.Producer.Send(ctx, &pulsar.ProducerMessage{
-		Payload: payload,
+	messageID, err := t.Producer.Send(ctx, &pulsar.ProducerMessage{
+		Payload:    payload,
+		Properties: trigger.Properties,
 	})
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to send message to pulsar")
@@ -187,10 +204,13 @@ func (t *PulsarTrigger) Execute(ctx context.Context, events map[string]*v1alpha1
 
 	t.Logger.Infow("successfully produced a message", zap.Any("topic", trigger.Topic))
 
-	return nil, nil
+	return messageID, nil
 }
 
-// ApplyPolicy applies policy on the trigger
+// ApplyPolicy verifies the message was acknowledged by the broker, i.e. Execute returned its message ID
 func (t *PulsarTrigger) ApplyPolicy(ctx context.Context, resource interface{}) error {
+	if _, ok := resource.(pulsar.MessageID); !ok {
+		return errors.New("the message was not acknowledged by the broker")
+	}
 	return nil
 }
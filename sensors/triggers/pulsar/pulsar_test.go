@@ -33,6 +33,8 @@ type mockPulsarProducer struct {
 	topic    string
 	name     string
 	expected bool
+	// sent is the last message sent
+	sent *pulsar.ProducerMessage
 }
 
 func (m *mockPulsarProducer) ExpectInputAndSucceed() {
@@ -44,10 +46,11 @@ func (m *mockPulsarProducer) Topic() string {
 func (m *mockPulsarProducer) Name() string {
 	return m.name
 }
-func (m *mockPulsarProducer) Send(context.Context, *pulsar.ProducerMessage) (pulsar.MessageID, error) {
+func (m *mockPulsarProducer) Send(_ context.Context, msg *pulsar.ProducerMessage) (pulsar.MessageID, error) {
 	if m.expected {
 		m.expected = false
-		return nil, nil
+		m.sent = msg
+		return pulsar.EarliestMessageID(), nil
 	}
 	return nil, errors.New("input not expected")
 }
@@ -209,5 +212,42 @@ func TestPulsarTrigger_Execute(t *testing.T) {
 
 	result, err := trigger.Execute(context.TODO(), testEvents, trigger.Trigger.Template.Pulsar)
 	assert.Nil(t, err)
-	assert.Nil(t, result)
+	assert.NotNil(t, result)
+	assert.Equal(t, `{"message":"world"}`, string(producer.sent.Payload))
+	assert.Nil(t, trigger.ApplyPolicy(context.TODO(), result))
+
+	t.Run("properties templated from the events", func(t *testing.T) {
+		trigger.Trigger.Template.Pulsar.Properties = map[string]string{"source": "argo-events"}
+		trigger.Trigger.Template.Pulsar.Parameters = []v1alpha1.TriggerParameter{
+			{
+				Src: &v1alpha1.TriggerParameterSource{
+					DependencyName: "fake-dependency",
+					DataKey:        "message",
+				},
+				Dest: "properties.greeting",
+			},
+		}
+		resource, err := trigger.ApplyResourceParameters(testEvents, trigger.Trigger.Template.Pulsar)
+		assert.Nil(t, err)
+
+		producer.ExpectInputAndSucceed()
+		result, err := trigger.Execute(context.TODO(), testEvents, resource)
+		assert.Nil(t, err)
+		assert.NotNil(t, result)
+		assert.Equal(t, map[string]string{"source": "argo-events", "greeting": "world"}, producer.sent.Properties)
+	})
+
+	t.Run("send fails", func(t *testing.T) {
+		_, err := trigger.Execute(context.TODO(), testEvents, trigger.Trigger.Template.Pulsar)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestPulsarTrigger_ApplyPolicy(t *testing.T) {
+	trigger, err := getFakePulsarTrigger(map[string]pulsar.Producer{
+		"fake-trigger": &mockPulsarProducer{},
+	})
+	assert.Nil(t, err)
+	assert.Nil(t, trigger.ApplyPolicy(context.TODO(), pulsar.EarliestMessageID()))
+	assert.NotNil(t, trigger.ApplyPolicy(context.TODO(), nil))
 }
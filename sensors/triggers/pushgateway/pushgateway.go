@@ -0,0 +1,394 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package pushgateway
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/common/expfmt"
+	"github.com/prometheus/common/model"
+	"go.uber.org/zap"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/common/logging"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sensors/policy"
+	"github.com/argoproj/argo-events/sensors/triggers"
+)
+
+// pushTimeout is the timeout of the pushes to the Pushgateway
+const pushTimeout = 60 * time.Second
+
+// jobLabel is the label of the grouping key holding the job
+const jobLabel = "job"
+
+// jobDest is the destination of the parameters templating the job
+const jobDest = "job"
+
+// acceptedStatuses are the statuses of the successful pushes, 200 for the Pushgateway versions
+// before 1.0, 202 for the later ones.
+var acceptedStatuses = []int{http.StatusOK, http.StatusAccepted}
+
+func init() {
+	triggers.Register(apicommon.PushgatewayTrigger, triggers.Registration{
+		Matches: func(template *v1alpha1.TriggerTemplate) bool {
+			return template.Pushgateway != nil
+		},
+		New: func(deps *triggers.Dependencies, trigger *v1alpha1.Trigger, logger *zap.SugaredLogger) (triggers.Trigger, error) {
+			httpClients := deps.Clients.Get(apicommon.PushgatewayTrigger, func() interface{} {
+				return make(map[string]*http.Client)
+			}).(map[string]*http.Client)
+			return NewPushgatewayTrigger(httpClients, deps.Sensor, trigger, logger)
+		},
+	})
+}
+
+// PushgatewayTrigger refers to trigger that pushes metrics to a Prometheus Pushgateway
+type PushgatewayTrigger struct {
+	// Client is the HTTP client the metrics are pushed with
+	Client *http.Client
+	// Sensor object
+	Sensor *v1alpha1.Sensor
+	// Trigger definition
+	Trigger *v1alpha1.Trigger
+	// logger to log stuff
+	Logger *zap.SugaredLogger
+}
+
+// NewPushgatewayTrigger returns a new Pushgateway trigger context
+func NewPushgatewayTrigger(httpClients map[string]*http.Client, sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger, logger *zap.SugaredLogger) (*PushgatewayTrigger, error) {
+	logger = logger.With(logging.LabelTriggerType, apicommon.PushgatewayTrigger)
+
+	client, ok := httpClients[trigger.Template.Name]
+	if !ok {
+		client = &http.Client{Timeout: pushTimeout}
+		httpClients[trigger.Template.Name] = client
+	}
+
+	return &PushgatewayTrigger{
+		Client:  client,
+		Sensor:  sensor,
+		Trigger: trigger,
+		Logger:  logger,
+	}, nil
+}
+
+// GetTriggerType returns the type of the trigger
+func (t *PushgatewayTrigger) GetTriggerType() apicommon.TriggerType {
+	return apicommon.PushgatewayTrigger
+}
+
+// FetchResource fetches the trigger resource
+func (t *PushgatewayTrigger) FetchResource(ctx context.Context) (interface{}, error) {
+	return t.Trigger.Template.Pushgateway, nil
+}
+
+// ApplyResourceParameters applies parameters to the trigger resource
+func (t *PushgatewayTrigger) ApplyResourceParameters(events map[string]*v1alpha1.Event, resource interface{}) (interface{}, error) {
+	resourceBytes, err := json.Marshal(resource)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal the pushgateway trigger resource")
+	}
+	parameters := t.Trigger.Template.Pushgateway.Parameters
+	if parameters != nil {
+		updatedResourceBytes, err := triggers.ApplyParams(resourceBytes, parameters, events)
+		if err != nil {
+			return nil, err
+		}
+		var pt *v1alpha1.PushgatewayTrigger
+		if err := json.Unmarshal(updatedResourceBytes, &pt); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal the updated pushgateway trigger resource after applying resource parameters")
+		}
+		return pt, nil
+	}
+	return resource, nil
+}
+
+// Execute executes the trigger
+func (t *PushgatewayTrigger) Execute(ctx context.Context, events map[string]*v1alpha1.Event, resource interface{}) (interface{}, error) {
+	trigger, ok := resource.(*v1alpha1.PushgatewayTrigger)
+	if !ok {
+		return nil, errors.New("failed to interpret the trigger resource")
+	}
+
+	body, err := encodeMetrics(trigger.Metrics, events)
+	if err != nil {
+		// The metrics are resolved from the events, pushing them again won't resolve them any better.
+		return nil, triggers.NewPermanentError(err)
+	}
+	pushURL, err := groupingURL(trigger.URL, trigger.Job, trigger.Grouping)
+	if err != nil {
+		return nil, triggers.NewPermanentError(err)
+	}
+	// A PUT replaces all the metrics of the grouping key, a POST only the ones with the same names.
+	method := http.MethodPost
+	if trigger.Replace {
+		method = http.MethodPut
+	}
+
+	header := http.Header{}
+	header.Set("Content-Type", string(expfmt.FmtText))
+	if basicAuth := trigger.BasicAuth; basicAuth != nil {
+		username := ""
+		password := ""
+		if basicAuth.Username != nil {
+			username, err = common.GetSecret(basicAuth.Username)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to retrieve the username")
+			}
+		}
+		if basicAuth.Password != nil {
+			password, err = common.GetSecret(basicAuth.Password)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to retrieve the password")
+			}
+		}
+		header.Set("Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(username+":"+password)))
+	}
+
+	if t.Trigger.Template.DryRun {
+		t.Logger.Infow("dry run, skipping pushing the metrics", zap.String("method", method), zap.String("url", pushURL), zap.String("metrics", string(body)))
+		return nil, nil
+	}
+
+	t.Logger.Infow("pushing the metrics...", zap.String("url", pushURL), zap.Int("metrics", len(trigger.Metrics)))
+
+	// The retries are the ones of the retry strategy of the trigger.
+	executor := &triggers.HTTPExecutor{Client: t.Client, Logger: t.Logger}
+	response, err := executor.Do(ctx, &triggers.HTTPRequest{Method: method, URL: pushURL, Header: header, Body: body})
+	if err != nil {
+		if triggers.IsResponseTooLargeError(err) {
+			return nil, triggers.NewPermanentError(err)
+		}
+		return nil, errors.Wrapf(err, "failed to push the metrics to %s", pushURL)
+	}
+	return response, nil
+}
+
+// ApplyPolicy checks the Pushgateway accepted the metrics, the trigger policy allowing
+// other statuses if any.
+func (t *PushgatewayTrigger) ApplyPolicy(ctx context.Context, resource interface{}) error {
+	if resource == nil {
+		// dry run
+		return nil
+	}
+	response, ok := resource.(*http.Response)
+	if !ok {
+		return errors.New("failed to interpret the trigger execution response")
+	}
+
+	allowed := acceptedStatuses
+	var ranges []string
+	if t.Trigger.Policy != nil && t.Trigger.Policy.Status != nil {
+		allowed = append(append([]int{}, allowed...), t.Trigger.Policy.Status.GetAllow()...)
+		ranges = t.Trigger.Policy.Status.AllowRanges
+	}
+	p, err := policy.NewStatusPolicyWithRanges(response.StatusCode, allowed, ranges)
+	if err != nil {
+		return err
+	}
+	return p.ApplyPolicy(ctx)
+}
+
+// encodeMetrics returns the gauges of the metrics in the text exposition format, their values resolved
+// from the events.
+func encodeMetrics(metrics []v1alpha1.PushgatewayMetric, events map[string]*v1alpha1.Event) ([]byte, error) {
+	registry := prometheus.NewRegistry()
+	for _, metric := range metrics {
+		value, err := metricValue(metric.Value, events)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid value of metric %s", metric.Name)
+		}
+		gauge := prometheus.NewGauge(prometheus.GaugeOpts{
+			Name:        metric.Name,
+			Help:        help(metric),
+			ConstLabels: metric.Labels,
+		})
+		gauge.Set(value)
+		if err := registry.Register(gauge); err != nil {
+			return nil, errors.Wrapf(err, "failed to register metric %s", metric.Name)
+		}
+	}
+	families, err := registry.Gather()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to gather the metrics")
+	}
+	var buf bytes.Buffer
+	encoder := expfmt.NewEncoder(&buf, expfmt.FmtText)
+	for _, family := range families {
+		if err := encoder.Encode(family); err != nil {
+			return nil, errors.Wrapf(err, "failed to encode metric %s", family.GetName())
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// metricValue returns the value of a metric, a number or a Go template resolving to a number from the events
+func metricValue(value string, events map[string]*v1alpha1.Event) (float64, error) {
+	if isTemplate(value) {
+		resolved, err := triggers.ExecuteParameterTemplate(value, events)
+		if err != nil {
+			return 0, errors.Wrap(err, "failed to execute the template")
+		}
+		value = resolved
+	}
+	f, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	if err != nil {
+		return 0, errors.Errorf("%q is not a number", value)
+	}
+	return f, nil
+}
+
+// isTemplate tells if the value of a metric is a Go template
+func isTemplate(value string) bool {
+	return strings.Contains(value, "{{")
+}
+
+// help returns the help of a metric, which the gauges can't go without
+func help(metric v1alpha1.PushgatewayMetric) string {
+	if metric.Help != "" {
+		return metric.Help
+	}
+	return "Metric " + metric.Name + " pushed by Argo Events"
+}
+
+// groupingURL returns the URL of the grouping key of the job and the labels, with the values the path
+// can't hold, i.e. the empty ones and the ones with a slash, base64 encoded.
+func groupingURL(pushgatewayURL, job string, grouping map[string]string) (string, error) {
+	if job == "" {
+		return "", errors.New("job is not specified")
+	}
+	u, err := url.Parse(pushgatewayURL)
+	if err != nil {
+		return "", errors.Wrap(err, "invalid url")
+	}
+	names := make([]string, 0, len(grouping))
+	for name := range grouping {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	// The path is kept along with its escaped form, for the values to be escaped once.
+	path := strings.TrimSuffix(u.Path, "/") + "/metrics"
+	rawPath := strings.TrimSuffix(u.EscapedPath(), "/") + "/metrics"
+	for _, name := range append([]string{jobLabel}, names...) {
+		value := job
+		if name != jobLabel {
+			value = grouping[name]
+		}
+		segment, escaped := groupingSegment(name, value)
+		path += "/" + segment
+		rawPath += "/" + escaped
+	}
+	u.Path = path
+	u.RawPath = rawPath
+	return u.String(), nil
+}
+
+// groupingSegment returns the segment of the path of a label of the grouping key, and its escaped form
+func groupingSegment(name, value string) (string, string) {
+	if value == "" || strings.Contains(value, "/") {
+		// The padding of an empty value is what the Pushgateway expects, for the segment not to be empty.
+		encoded := base64.RawURLEncoding.EncodeToString([]byte(value))
+		if encoded == "" {
+			encoded = "="
+		}
+		segment := name + "@base64/" + encoded
+		return segment, segment
+	}
+	return name + "/" + value, name + "/" + url.PathEscape(value)
+}
+
+// ValidateTrigger validates the grouping key and the metrics of a Pushgateway trigger
+func ValidateTrigger(trigger *v1alpha1.PushgatewayTrigger) error {
+	if trigger.URL == "" {
+		return errors.New("url is not specified")
+	}
+	u, err := url.Parse(trigger.URL)
+	if err != nil {
+		return errors.Wrap(err, "invalid url")
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return errors.Errorf("invalid url %q, the scheme must be http or https", trigger.URL)
+	}
+	if trigger.Job == "" && !hasParameter(trigger.Parameters, jobDest) {
+		return errors.New("job is not specified")
+	}
+	groupingLabels := map[string]bool{jobLabel: true}
+	for name := range trigger.Grouping {
+		if err := validateLabelName(name); err != nil {
+			return errors.Wrap(err, "invalid grouping")
+		}
+		if name == jobLabel {
+			return errors.New("invalid grouping, the job is the job of the trigger")
+		}
+		groupingLabels[name] = true
+	}
+	if len(trigger.Metrics) == 0 {
+		return errors.New("metrics are not specified")
+	}
+	for i, metric := range trigger.Metrics {
+		if !model.IsValidMetricName(model.LabelValue(metric.Name)) {
+			return errors.Errorf("metric index: %d. invalid metric name %q", i, metric.Name)
+		}
+		for name := range metric.Labels {
+			if err := validateLabelName(name); err != nil {
+				return errors.Wrapf(err, "metric %s", metric.Name)
+			}
+			if groupingLabels[name] {
+				return errors.Errorf("metric %s has label %s of the grouping key", metric.Name, name)
+			}
+		}
+		if metric.Value == "" {
+			return errors.Errorf("metric %s has no value", metric.Name)
+		}
+		if !isTemplate(metric.Value) {
+			if _, err := strconv.ParseFloat(strings.TrimSpace(metric.Value), 64); err != nil {
+				return errors.Errorf("value of metric %s is neither a number nor a template", metric.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// validateLabelName validates the name of a label, the ones starting with __ are reserved
+func validateLabelName(name string) error {
+	if !model.LabelName(name).IsValid() || strings.HasPrefix(name, "__") {
+		return errors.Errorf("invalid label name %q", name)
+	}
+	return nil
+}
+
+// hasParameter tells if one of the parameters has the destination
+func hasParameter(parameters []v1alpha1.TriggerParameter, dest string) bool {
+	for _, parameter := range parameters {
+		if parameter.Dest == dest {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,198 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package pushgateway
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-events/common/logging"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+var sensorObj = &v1alpha1.Sensor{
+	ObjectMeta: metav1.ObjectMeta{
+		Name:      "fake-sensor",
+		Namespace: "fake",
+	},
+	Spec: v1alpha1.SensorSpec{
+		Triggers: []v1alpha1.Trigger{
+			{
+				Template: &v1alpha1.TriggerTemplate{
+					Name: "fake-trigger",
+					Pushgateway: &v1alpha1.PushgatewayTrigger{
+						URL:      "http://pushgateway:9091",
+						Job:      "nightly-export",
+						Grouping: map[string]string{"instance": "batch-1"},
+						Metrics: []v1alpha1.PushgatewayMetric{
+							{Name: "export_duration_seconds", Help: "Duration of the export.", Value: "{{ .job.duration }}"},
+							{Name: "export_rows", Labels: map[string]string{"table": "orders"}, Value: "{{ .job.rows }}"},
+							{Name: "export_success", Value: "1"},
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+var events = map[string]*v1alpha1.Event{
+	"job": {
+		Context: &v1alpha1.EventContext{ID: "1", DataContentType: "application/json"},
+		Data:    []byte(`{"duration": 42.5, "rows": 1200, "instance": "batch-2"}`),
+	},
+}
+
+func getPushgatewayTrigger(t *testing.T, url string) *PushgatewayTrigger {
+	t.Helper()
+	trigger := sensorObj.Spec.Triggers[0].DeepCopy()
+	trigger.Template.Pushgateway.URL = url
+	pt, err := NewPushgatewayTrigger(make(map[string]*http.Client), sensorObj, trigger, logging.NewArgoEventsLogger())
+	assert.Nil(t, err)
+	return pt
+}
+
+func TestPushgatewayTrigger_Execute(t *testing.T) {
+	var method, path, contentType, body string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, path, contentType = r.Method, r.URL.EscapedPath(), r.Header.Get("Content-Type")
+		b, _ := ioutil.ReadAll(r.Body)
+		body = string(b)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	trigger := getPushgatewayTrigger(t, server.URL)
+	trigger.Trigger.Template.Pushgateway.Parameters = []v1alpha1.TriggerParameter{
+		{Src: &v1alpha1.TriggerParameterSource{DependencyName: "job", DataKey: "instance"}, Dest: "grouping.instance"},
+	}
+	resource, err := trigger.FetchResource(context.TODO())
+	assert.Nil(t, err)
+	resource, err = trigger.ApplyResourceParameters(events, resource)
+	assert.Nil(t, err)
+	result, err := trigger.Execute(context.TODO(), events, resource)
+	assert.Nil(t, err)
+	assert.Nil(t, trigger.ApplyPolicy(context.TODO(), result))
+
+	assert.Equal(t, http.MethodPost, method)
+	assert.Equal(t, "/metrics/job/nightly-export/instance/batch-2", path)
+	assert.Contains(t, contentType, "text/plain")
+	assert.Contains(t, body, "# HELP export_duration_seconds Duration of the export.\n# TYPE export_duration_seconds gauge\nexport_duration_seconds 42.5\n")
+	assert.Contains(t, body, `export_rows{table="orders"} 1200`)
+	assert.Contains(t, body, "export_success 1")
+
+	t.Run("replace", func(t *testing.T) {
+		trigger := getPushgatewayTrigger(t, server.URL)
+		trigger.Trigger.Template.Pushgateway.Replace = true
+		_, err := trigger.Execute(context.TODO(), events, trigger.Trigger.Template.Pushgateway)
+		assert.Nil(t, err)
+		assert.Equal(t, http.MethodPut, method)
+		assert.Equal(t, "/metrics/job/nightly-export/instance/batch-1", path)
+	})
+
+	t.Run("invalid value", func(t *testing.T) {
+		trigger := getPushgatewayTrigger(t, server.URL)
+		pt := trigger.Trigger.Template.Pushgateway
+		pt.Metrics = []v1alpha1.PushgatewayMetric{{Name: "export_rows", Value: "{{ .job.instance }}"}}
+		_, err := trigger.Execute(context.TODO(), events, pt)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), `invalid value of metric export_rows: "batch-2" is not a number`)
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		method = ""
+		trigger := getPushgatewayTrigger(t, server.URL)
+		trigger.Trigger.Template.DryRun = true
+		result, err := trigger.Execute(context.TODO(), events, trigger.Trigger.Template.Pushgateway)
+		assert.Nil(t, err)
+		assert.Nil(t, result)
+		assert.Nil(t, trigger.ApplyPolicy(context.TODO(), result))
+		assert.Empty(t, method)
+	})
+}
+
+func TestPushgatewayTrigger_ApplyPolicy(t *testing.T) {
+	trigger := getPushgatewayTrigger(t, "http://pushgateway:9091")
+	assert.Nil(t, trigger.ApplyPolicy(context.TODO(), &http.Response{StatusCode: http.StatusOK}))
+	assert.Nil(t, trigger.ApplyPolicy(context.TODO(), &http.Response{StatusCode: http.StatusAccepted}))
+	err := trigger.ApplyPolicy(context.TODO(), &http.Response{StatusCode: http.StatusBadRequest})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "status 400 is not allowed")
+
+	trigger.Trigger.Policy = &v1alpha1.TriggerPolicy{Status: &v1alpha1.StatusPolicy{Allow: []int32{400}}}
+	assert.Nil(t, trigger.ApplyPolicy(context.TODO(), &http.Response{StatusCode: http.StatusBadRequest}))
+	assert.NotNil(t, trigger.ApplyPolicy(context.TODO(), &http.Response{StatusCode: http.StatusInternalServerError}))
+}
+
+func TestGroupingURL(t *testing.T) {
+	u, err := groupingURL("https://pushgateway:9091/prefix/", "backup", map[string]string{"path": "/var/tmp", "instance": "", "zone": "eu 1"})
+	assert.Nil(t, err)
+	assert.Equal(t, "https://pushgateway:9091/prefix/metrics/job/backup/instance@base64/=/path@base64/L3Zhci90bXA/zone/eu%201", u)
+
+	u, err = groupingURL("http://pushgateway:9091", "a/b", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "http://pushgateway:9091/metrics/job@base64/YS9i", u)
+
+	_, err = groupingURL("http://pushgateway:9091", "", nil)
+	assert.NotNil(t, err)
+}
+
+func TestValidateTrigger(t *testing.T) {
+	newTrigger := func() *v1alpha1.PushgatewayTrigger {
+		return sensorObj.Spec.Triggers[0].Template.Pushgateway.DeepCopy()
+	}
+	assert.Nil(t, ValidateTrigger(newTrigger()))
+
+	tests := []struct {
+		name   string
+		mutate func(trigger *v1alpha1.PushgatewayTrigger)
+		err    string
+	}{
+		{"no url", func(trigger *v1alpha1.PushgatewayTrigger) { trigger.URL = "" }, "url is not specified"},
+		{"invalid url", func(trigger *v1alpha1.PushgatewayTrigger) { trigger.URL = "pushgateway:9091" }, "the scheme must be http or https"},
+		{"no job", func(trigger *v1alpha1.PushgatewayTrigger) { trigger.Job = "" }, "job is not specified"},
+		{"job in grouping", func(trigger *v1alpha1.PushgatewayTrigger) { trigger.Grouping["job"] = "export" }, "the job is the job of the trigger"},
+		{"invalid grouping", func(trigger *v1alpha1.PushgatewayTrigger) { trigger.Grouping["batch-id"] = "1" }, `invalid label name "batch-id"`},
+		{"no metrics", func(trigger *v1alpha1.PushgatewayTrigger) { trigger.Metrics = nil }, "metrics are not specified"},
+		{"invalid metric name", func(trigger *v1alpha1.PushgatewayTrigger) { trigger.Metrics[0].Name = "export-duration" }, `invalid metric name "export-duration"`},
+		{"reserved label", func(trigger *v1alpha1.PushgatewayTrigger) { trigger.Metrics[1].Labels["__name__"] = "x" }, `invalid label name "__name__"`},
+		{"grouping label", func(trigger *v1alpha1.PushgatewayTrigger) { trigger.Metrics[1].Labels["instance"] = "x" }, "label instance of the grouping key"},
+		{"no value", func(trigger *v1alpha1.PushgatewayTrigger) { trigger.Metrics[2].Value = "" }, "metric export_success has no value"},
+		{"invalid value", func(trigger *v1alpha1.PushgatewayTrigger) { trigger.Metrics[2].Value = "yes" }, "neither a number nor a template"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			trigger := newTrigger()
+			test.mutate(trigger)
+			err := ValidateTrigger(trigger)
+			assert.NotNil(t, err)
+			assert.Contains(t, err.Error(), test.err)
+		})
+	}
+
+	t.Run("templated job", func(t *testing.T) {
+		trigger := newTrigger()
+		trigger.Job = ""
+		trigger.Parameters = []v1alpha1.TriggerParameter{{Dest: "job"}}
+		assert.Nil(t, ValidateTrigger(trigger))
+	})
+}
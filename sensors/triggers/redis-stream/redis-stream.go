@@ -0,0 +1,187 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package redis_stream
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/common/logging"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sensors/triggers"
+)
+
+// RedisStreamTrigger refers to trigger that adds entries to a Redis stream
+type RedisStreamTrigger struct {
+	// Client is the Redis client
+	Client *redis.Client
+	// Sensor object
+	Sensor *v1alpha1.Sensor
+	// Trigger definition
+	Trigger *v1alpha1.Trigger
+	// logger to log stuff
+	Logger *zap.SugaredLogger
+}
+
+// NewRedisStreamTrigger returns a new Redis stream trigger context
+func NewRedisStreamTrigger(redisClients map[string]*redis.Client, sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger, logger *zap.SugaredLogger) (*RedisStreamTrigger, error) {
+	streamTrigger := trigger.Template.RedisStream
+	logger = logger.With(logging.LabelTriggerType, apicommon.RedisStreamTrigger)
+
+	client, ok := redisClients[trigger.Template.Name]
+	if !ok {
+		opt := &redis.Options{
+			Addr: streamTrigger.HostAddress,
+			DB:   int(streamTrigger.DB),
+		}
+		if streamTrigger.Password != nil {
+			password, err := common.GetSecret(streamTrigger.Password)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to find the secret password %s", streamTrigger.Password.Name)
+			}
+			opt.Password = password
+		}
+		if streamTrigger.TLS != nil {
+			tlsConfig, err := common.GetTLSConfig(streamTrigger.TLS)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to get the tls configuration")
+			}
+			opt.TLSConfig = tlsConfig
+		}
+		client = redis.NewClient(opt)
+		redisClients[trigger.Template.Name] = client
+	}
+
+	return &RedisStreamTrigger{
+		Client:  client,
+		Sensor:  sensor,
+		Trigger: trigger,
+		Logger:  logger,
+	}, nil
+}
+
+// GetTriggerType returns the type of the trigger
+func (t *RedisStreamTrigger) GetTriggerType() apicommon.TriggerType {
+	return apicommon.RedisStreamTrigger
+}
+
+// FetchResource fetches the trigger resource
+func (t *RedisStreamTrigger) FetchResource(ctx context.Context) (interface{}, error) {
+	return t.Trigger.Template.RedisStream, nil
+}
+
+// ApplyResourceParameters applies parameters to the trigger resource
+func (t *RedisStreamTrigger) ApplyResourceParameters(events map[string]*v1alpha1.Event, resource interface{}) (interface{}, error) {
+	resourceBytes, err := json.Marshal(resource)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal the redis stream trigger resource")
+	}
+	parameters := t.Trigger.Template.RedisStream.Parameters
+	if parameters != nil {
+		updatedResourceBytes, err := triggers.ApplyParams(resourceBytes, parameters, events)
+		if err != nil {
+			return nil, err
+		}
+		var st *v1alpha1.RedisStreamTrigger
+		if err := json.Unmarshal(updatedResourceBytes, &st); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal the updated redis stream trigger resource after applying resource parameters")
+		}
+		return st, nil
+	}
+	return resource, nil
+}
+
+// Execute executes the trigger
+func (t *RedisStreamTrigger) Execute(ctx context.Context, events map[string]*v1alpha1.Event, resource interface{}) (interface{}, error) {
+	trigger, ok := resource.(*v1alpha1.RedisStreamTrigger)
+	if !ok {
+		return nil, errors.New("failed to interpret the trigger resource")
+	}
+
+	if trigger.Payload == nil {
+		return nil, errors.New("payload parameters are not specified")
+	}
+
+	payload, err := triggers.ConstructPayload(events, trigger.Payload)
+	if err != nil {
+		return nil, err
+	}
+	payload, err = triggers.WrapCloudEvent(t.Sensor, t.Trigger, events, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := entryValues(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	if t.Trigger.Template.DryRun {
+		t.Logger.Infow("dry run, skipping adding the entry to the stream", zap.String("stream", trigger.Stream), zap.Int64("maxLen", trigger.MaxLen), zap.Any("values", values))
+		return nil, nil
+	}
+
+	id, err := t.Client.XAdd(ctx, &redis.XAddArgs{
+		Stream: trigger.Stream,
+		MaxLen: trigger.MaxLen,
+		Values: values,
+	}).Result()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to add an entry to stream %s", trigger.Stream)
+	}
+
+	t.Logger.Infow("added an entry to the stream", zap.String("stream", trigger.Stream), zap.String("id", id))
+	return id, nil
+}
+
+// ApplyPolicy applies the policy on the trigger execution response
+func (t *RedisStreamTrigger) ApplyPolicy(ctx context.Context, resource interface{}) error {
+	return nil
+}
+
+// entryValues maps the top level keys of the payload to the fields of a stream entry,
+// in a stable order. Non-string values are JSON encoded.
+func entryValues(payload []byte) ([]interface{}, error) {
+	var fields map[string]interface{}
+	if err := json.Unmarshal(payload, &fields); err != nil {
+		return nil, errors.Wrap(err, "the payload must be a JSON object to be added to a stream")
+	}
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	values := make([]interface{}, 0, 2*len(keys))
+	for _, k := range keys {
+		value, ok := fields[k].(string)
+		if !ok {
+			b, err := json.Marshal(fields[k])
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to marshal the value of field %s", k)
+			}
+			value = string(b)
+		}
+		values = append(values, k, value)
+	}
+	return values, nil
+}
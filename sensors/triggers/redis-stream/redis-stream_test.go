@@ -0,0 +1,261 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package redis_stream
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/go-redis/redis/v8"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-events/common/logging"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+var sensorObj = &v1alpha1.Sensor{
+	ObjectMeta: metav1.ObjectMeta{
+		Name:      "fake-sensor",
+		Namespace: "fake",
+	},
+	Spec: v1alpha1.SensorSpec{
+		Triggers: []v1alpha1.Trigger{
+			{
+				Template: &v1alpha1.TriggerTemplate{
+					Name: "fake-trigger",
+					RedisStream: &v1alpha1.RedisStreamTrigger{
+						HostAddress: "localhost:6379",
+						Stream:      "fake-stream",
+						Payload: []v1alpha1.TriggerParameter{
+							{
+								Src: &v1alpha1.TriggerParameterSource{
+									DependencyName: "fake-dependency",
+									DataKey:        "name",
+								},
+								Dest: "name",
+							},
+							{
+								Src: &v1alpha1.TriggerParameterSource{
+									DependencyName: "fake-dependency",
+									DataKey:        "labels",
+								},
+								Dest: "labels",
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+var testEvents = map[string]*v1alpha1.Event{
+	"fake-dependency": {
+		Context: &v1alpha1.EventContext{
+			ID:              "1",
+			Type:            "webhook",
+			Source:          "webhook-gateway",
+			DataContentType: "application/json",
+			SpecVersion:     cloudevents.VersionV1,
+			Subject:         "example-1",
+		},
+		Data: []byte(`{"name": "fake-name", "stream": "real-stream", "labels": {"app": "fake"}}`),
+	},
+}
+
+// fakeRedisServer records the commands it receives and replies to each one with a stream entry ID.
+type fakeRedisServer struct {
+	listener net.Listener
+	lock     sync.Mutex
+	commands [][]string
+}
+
+func newFakeRedisServer(t *testing.T) *fakeRedisServer {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	s := &fakeRedisServer{listener: l}
+	t.Cleanup(func() { _ = l.Close() })
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn)
+		}
+	}()
+	return s
+}
+
+func (s *fakeRedisServer) serve(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	for {
+		command, err := readCommand(r)
+		if err != nil {
+			return
+		}
+		s.lock.Lock()
+		s.commands = append(s.commands, command)
+		s.lock.Unlock()
+		if _, err := conn.Write([]byte("$3\r\n1-0\r\n")); err != nil {
+			return
+		}
+	}
+}
+
+func (s *fakeRedisServer) lastCommand() []string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if len(s.commands) == 0 {
+		return nil
+	}
+	return s.commands[len(s.commands)-1]
+}
+
+// readCommand reads a RESP array of bulk strings.
+func readCommand(r *bufio.Reader) ([]string, error) {
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	n, err := strconv.Atoi(strings.TrimSpace(strings.TrimPrefix(line, "*")))
+	if err != nil {
+		return nil, err
+	}
+	command := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		size, err := strconv.Atoi(strings.TrimSpace(strings.TrimPrefix(line, "$")))
+		if err != nil {
+			return nil, err
+		}
+		buf := make([]byte, size+2)
+		if _, err := io.ReadFull(r, buf); err != nil {
+			return nil, err
+		}
+		command = append(command, string(buf[:size]))
+	}
+	return command, nil
+}
+
+func getFakeRedisStreamTrigger(t *testing.T) (*RedisStreamTrigger, *fakeRedisServer) {
+	t.Helper()
+	server := newFakeRedisServer(t)
+	client := redis.NewClient(&redis.Options{Addr: server.listener.Addr().String()})
+	t.Cleanup(func() { _ = client.Close() })
+	sensor := sensorObj.DeepCopy()
+	return &RedisStreamTrigger{
+		Client:  client,
+		Sensor:  sensor,
+		Trigger: sensor.Spec.Triggers[0].DeepCopy(),
+		Logger:  logging.NewArgoEventsLogger(),
+	}, server
+}
+
+func TestRedisStreamTrigger_FetchResource(t *testing.T) {
+	trigger, _ := getFakeRedisStreamTrigger(t)
+	resource, err := trigger.FetchResource(context.TODO())
+	assert.Nil(t, err)
+	assert.NotNil(t, resource)
+
+	st, ok := resource.(*v1alpha1.RedisStreamTrigger)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, "fake-stream", st.Stream)
+}
+
+func TestRedisStreamTrigger_ApplyResourceParameters(t *testing.T) {
+	trigger, _ := getFakeRedisStreamTrigger(t)
+	trigger.Trigger.Template.RedisStream.Parameters = []v1alpha1.TriggerParameter{
+		{
+			Src: &v1alpha1.TriggerParameterSource{
+				DependencyName: "fake-dependency",
+				DataKey:        "stream",
+			},
+			Dest: "stream",
+		},
+	}
+
+	resource, err := trigger.ApplyResourceParameters(testEvents, trigger.Trigger.Template.RedisStream)
+	assert.Nil(t, err)
+	assert.NotNil(t, resource)
+
+	st, ok := resource.(*v1alpha1.RedisStreamTrigger)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, "real-stream", st.Stream)
+}
+
+func TestRedisStreamTrigger_Execute(t *testing.T) {
+	t.Run("add an entry", func(t *testing.T) {
+		trigger, server := getFakeRedisStreamTrigger(t)
+		result, err := trigger.Execute(context.TODO(), testEvents, trigger.Trigger.Template.RedisStream)
+		assert.Nil(t, err)
+		assert.Equal(t, "1-0", result)
+		assert.Equal(t, []string{"xadd", "fake-stream", "*", "labels", `{"app": "fake"}`, "name", "fake-name"}, server.lastCommand())
+	})
+
+	t.Run("trim the stream", func(t *testing.T) {
+		trigger, server := getFakeRedisStreamTrigger(t)
+		trigger.Trigger.Template.RedisStream.MaxLen = 100
+		_, err := trigger.Execute(context.TODO(), testEvents, trigger.Trigger.Template.RedisStream)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"xadd", "fake-stream", "maxlen", "100", "*", "labels", `{"app": "fake"}`, "name", "fake-name"}, server.lastCommand())
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		trigger, server := getFakeRedisStreamTrigger(t)
+		trigger.Trigger.Template.DryRun = true
+		result, err := trigger.Execute(context.TODO(), testEvents, trigger.Trigger.Template.RedisStream)
+		assert.Nil(t, err)
+		assert.Nil(t, result)
+		assert.Nil(t, server.lastCommand())
+	})
+
+	t.Run("no payload", func(t *testing.T) {
+		trigger, _ := getFakeRedisStreamTrigger(t)
+		trigger.Trigger.Template.RedisStream.Payload = nil
+		_, err := trigger.Execute(context.TODO(), testEvents, trigger.Trigger.Template.RedisStream)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestRedisStreamTrigger_GetTriggerType(t *testing.T) {
+	trigger, _ := getFakeRedisStreamTrigger(t)
+	assert.Equal(t, apicommon.RedisStreamTrigger, trigger.GetTriggerType())
+}
+
+func TestEntryValues(t *testing.T) {
+	values, err := entryValues([]byte(`{"b": 1, "a": "x", "c": {"d": true}}`))
+	assert.Nil(t, err)
+	assert.Equal(t, []interface{}{"a", "x", "b", "1", "c", `{"d":true}`}, values)
+
+	_, err = entryValues([]byte(`"not an object"`))
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "JSON object")
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package triggers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"go.uber.org/zap"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+// Trigger is the implementation of a trigger type
+type Trigger interface {
+	GetTriggerType() apicommon.TriggerType
+	// FetchResource fetches the trigger resource from external source
+	FetchResource(context.Context) (interface{}, error)
+	// ApplyResourceParameters applies parameters to the trigger resource
+	ApplyResourceParameters(events map[string]*v1alpha1.Event, resource interface{}) (interface{}, error)
+	// Execute executes the trigger
+	Execute(ctx context.Context, events map[string]*v1alpha1.Event, resource interface{}) (interface{}, error)
+	// ApplyPolicy applies the policy on the trigger
+	ApplyPolicy(ctx context.Context, resource interface{}) error
+}
+
+// HealthChecker is implemented by the triggers able to check they can reach their target, e.g.
+// that the function to call exists, without executing the trigger.
+type HealthChecker interface {
+	// CheckHealth returns an error if the target of the trigger can't be reached
+	CheckHealth(ctx context.Context) error
+}
+
+// Dispatcher runs the work of the triggers completing after their executions returned, e.g. the
+// asynchronous calls, within the concurrency limit of the executions and drained as they are on shutdown.
+type Dispatcher interface {
+	// Dispatch runs the work in the background, with a context cancelled once the drain timeout of the
+	// sensor is exceeded. It returns false if the work is not accepted, e.g. no execution slot is free
+	// or the sensor is shutting down, for the trigger to do it before returning instead.
+	Dispatch(work func(ctx context.Context)) bool
+}
+
+// EventBusPublisher publishes to the EventBus the sensor is connected to, e.g. the events of the triggers
+// triggering other sensors.
+type EventBusPublisher interface {
+	// Subject returns the subject the sensors of the namespace subscribe to
+	Subject() string
+	// PublishAsync publishes the message to the subject without waiting for its acknowledgement, the ack
+	// handler is called once the EventBus acknowledges it, with the error if it doesn't.
+	PublishAsync(subject string, message []byte, ackHandler func(err error)) error
+}
+
+// Dependencies are what the sensor provides to the factories of the triggers
+type Dependencies struct {
+	KubeClient    kubernetes.Interface
+	DynamicClient dynamic.Interface
+	Sensor        *v1alpha1.Sensor
+	// Clients holds the clients of the triggers, shared by the executions of the sensor
+	Clients *ClientCache
+	// Dispatcher runs the work of the triggers in the background
+	Dispatcher Dispatcher
+	// EventBus publishes to the EventBus of the sensor, nil if the sensor has no trigger publishing to it
+	EventBus EventBusPublisher
+}
+
+// Factory returns the implementation of a trigger
+type Factory func(deps *Dependencies, trigger *v1alpha1.Trigger, logger *zap.SugaredLogger) (Trigger, error)
+
+// Registration is how a trigger type is recognized and implemented
+type Registration struct {
+	// Matches tells if a trigger template is of the trigger type
+	Matches func(template *v1alpha1.TriggerTemplate) bool
+	// New returns the implementation of a trigger of the trigger type
+	New Factory
+}
+
+var (
+	registryLock sync.RWMutex
+	registry     = make(map[apicommon.TriggerType]Registration)
+)
+
+// Register makes a trigger type available to the sensors, it is meant to be called from the
+// init function of the package implementing the trigger. It panics if the trigger type is
+// registered twice, or if the registration is incomplete.
+func Register(triggerType apicommon.TriggerType, registration Registration) {
+	registryLock.Lock()
+	defer registryLock.Unlock()
+	if registration.Matches == nil || registration.New == nil {
+		panic(fmt.Sprintf("incomplete registration of trigger type %s", triggerType))
+	}
+	if _, ok := registry[triggerType]; ok {
+		panic(fmt.Sprintf("trigger type %s is registered twice", triggerType))
+	}
+	registry[triggerType] = registration
+}
+
+// Lookup returns the registration of a trigger type
+func Lookup(triggerType apicommon.TriggerType) (Registration, error) {
+	registryLock.RLock()
+	defer registryLock.RUnlock()
+	registration, ok := registry[triggerType]
+	if !ok {
+		return Registration{}, fmt.Errorf("unknown trigger type %s", triggerType)
+	}
+	return registration, nil
+}
+
+// TypeOf returns the registered trigger type a trigger template is of, a template matching several types is an error
+func TypeOf(template *v1alpha1.TriggerTemplate) (apicommon.TriggerType, error) {
+	registryLock.RLock()
+	defer registryLock.RUnlock()
+	var types []string
+	for triggerType, registration := range registry {
+		if registration.Matches(template) {
+			types = append(types, string(triggerType))
+		}
+	}
+	switch len(types) {
+	case 0:
+		return "", fmt.Errorf("trigger template %s is of no registered trigger type", template.Name)
+	case 1:
+		return apicommon.TriggerType(types[0]), nil
+	default:
+		sort.Strings(types)
+		return "", fmt.Errorf("trigger template %s is of several trigger types %s", template.Name, strings.Join(types, ", "))
+	}
+}
+
+// ClientCache holds the clients of each trigger type, usually a map of the clients by trigger name
+type ClientCache struct {
+	lock    sync.Mutex
+	clients map[apicommon.TriggerType]interface{}
+}
+
+// NewClientCache returns an empty cache of clients
+func NewClientCache() *ClientCache {
+	return &ClientCache{clients: make(map[apicommon.TriggerType]interface{})}
+}
+
+// Get returns the clients of a trigger type, initialized with init the first time.
+func (c *ClientCache) Get(triggerType apicommon.TriggerType, init func() interface{}) interface{} {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	clients, ok := c.clients[triggerType]
+	if !ok {
+		clients = init()
+		c.clients[triggerType] = clients
+	}
+	return clients
+}
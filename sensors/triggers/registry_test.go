@@ -0,0 +1,118 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package triggers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+const fakeTriggerType apicommon.TriggerType = "FakeRegistered"
+
+type fakeTrigger struct {
+	name string
+}
+
+func (t *fakeTrigger) GetTriggerType() apicommon.TriggerType { return fakeTriggerType }
+
+func (t *fakeTrigger) FetchResource(context.Context) (interface{}, error) { return t.name, nil }
+
+func (t *fakeTrigger) ApplyResourceParameters(events map[string]*v1alpha1.Event, resource interface{}) (interface{}, error) {
+	return resource, nil
+}
+
+func (t *fakeTrigger) Execute(ctx context.Context, events map[string]*v1alpha1.Event, resource interface{}) (interface{}, error) {
+	return resource, nil
+}
+
+func (t *fakeTrigger) ApplyPolicy(ctx context.Context, resource interface{}) error { return nil }
+
+func TestRegistry(t *testing.T) {
+	Register(fakeTriggerType, Registration{
+		Matches: func(template *v1alpha1.TriggerTemplate) bool {
+			return template.Name == "fake-registered-trigger"
+		},
+		New: func(deps *Dependencies, trigger *v1alpha1.Trigger, logger *zap.SugaredLogger) (Trigger, error) {
+			return &fakeTrigger{name: trigger.Template.Name}, nil
+		},
+	})
+
+	t.Run("lookup", func(t *testing.T) {
+		triggerType, err := TypeOf(&v1alpha1.TriggerTemplate{Name: "fake-registered-trigger"})
+		assert.NoError(t, err)
+		assert.Equal(t, fakeTriggerType, triggerType)
+		registration, err := Lookup(triggerType)
+		assert.NoError(t, err)
+		trigger, err := registration.New(&Dependencies{Clients: NewClientCache()}, &v1alpha1.Trigger{Template: &v1alpha1.TriggerTemplate{Name: "fake-registered-trigger"}}, zap.NewNop().Sugar())
+		assert.NoError(t, err)
+		assert.Equal(t, fakeTriggerType, trigger.GetTriggerType())
+	})
+
+	t.Run("unknown type", func(t *testing.T) {
+		_, err := Lookup("Unknown")
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "unknown trigger type Unknown")
+		_, err = TypeOf(&v1alpha1.TriggerTemplate{Name: "unknown-trigger"})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "trigger template unknown-trigger is of no registered trigger type")
+	})
+
+	t.Run("several types", func(t *testing.T) {
+		for _, triggerType := range []apicommon.TriggerType{"FakeAmbiguous", "FakeAmbiguousToo"} {
+			Register(triggerType, Registration{
+				Matches: func(template *v1alpha1.TriggerTemplate) bool { return template.Name == "ambiguous-trigger" },
+				New: func(deps *Dependencies, trigger *v1alpha1.Trigger, logger *zap.SugaredLogger) (Trigger, error) {
+					return nil, nil
+				},
+			})
+		}
+		_, err := TypeOf(&v1alpha1.TriggerTemplate{Name: "ambiguous-trigger"})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "trigger template ambiguous-trigger is of several trigger types FakeAmbiguous, FakeAmbiguousToo")
+	})
+
+	t.Run("registered twice", func(t *testing.T) {
+		assert.Panics(t, func() {
+			Register(fakeTriggerType, Registration{
+				Matches: func(template *v1alpha1.TriggerTemplate) bool { return false },
+				New: func(deps *Dependencies, trigger *v1alpha1.Trigger, logger *zap.SugaredLogger) (Trigger, error) {
+					return nil, nil
+				},
+			})
+		})
+	})
+
+	t.Run("incomplete registration", func(t *testing.T) {
+		assert.Panics(t, func() {
+			Register("Incomplete", Registration{})
+		})
+	})
+}
+
+func TestClientCache(t *testing.T) {
+	cache := NewClientCache()
+	clients := cache.Get(fakeTriggerType, func() interface{} { return make(map[string]string) }).(map[string]string)
+	clients["fake"] = "client"
+	again := cache.Get(fakeTriggerType, func() interface{} { return make(map[string]string) }).(map[string]string)
+	assert.Equal(t, "client", again["fake"])
+}
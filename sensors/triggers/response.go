@@ -0,0 +1,124 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package triggers
+
+import (
+	"bufio"
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// DefaultMaxResponseSize is the max size of the response bodies read by the HTTP based triggers, unless
+// the trigger sets its own.
+const DefaultMaxResponseSize int64 = 10 * 1024 * 1024
+
+// ResponseTooLargeError is returned when the body of a response, once decoded, exceeds the max response
+// size of the trigger. The request was made, the status code of its response is kept for the policy of
+// the trigger to be applied on.
+type ResponseTooLargeError struct {
+	// StatusCode is the status code of the response
+	StatusCode int
+	// Limit is the max response size, in bytes
+	Limit int64
+}
+
+func (e *ResponseTooLargeError) Error() string {
+	return fmt.Sprintf("the body of the response with status code %d exceeds the max response size of %d bytes", e.StatusCode, e.Limit)
+}
+
+// IsResponseTooLargeError returns true if the error, or an error it wraps, is a ResponseTooLargeError
+func IsResponseTooLargeError(err error) bool {
+	var tooLargeErr *ResponseTooLargeError
+	return errors.As(err, &tooLargeErr)
+}
+
+// ReadResponse reads and closes the body of the response, decoding it if its Content-Encoding is gzip
+// or deflate, and replaces it with the decoded body for it to be read again, e.g. to capture the output
+// of the trigger. The decoded body is read up to maxSize bytes, DefaultMaxResponseSize if maxSize is not
+// positive, a bigger one fails with a ResponseTooLargeError without being kept.
+func ReadResponse(response *http.Response, maxSize int64) ([]byte, error) {
+	if response.Body == nil {
+		return nil, nil
+	}
+	defer response.Body.Close()
+	if maxSize <= 0 {
+		maxSize = DefaultMaxResponseSize
+	}
+	reader, err := decodeBody(response.Body, response.Header.Get("Content-Encoding"))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode the response")
+	}
+	body, err := ioutil.ReadAll(io.LimitReader(reader, maxSize+1))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read the response")
+	}
+	if int64(len(body)) > maxSize {
+		response.Body = http.NoBody
+		return nil, &ResponseTooLargeError{StatusCode: response.StatusCode, Limit: maxSize}
+	}
+	if reader != response.Body {
+		// The body is no longer encoded.
+		response.Header.Del("Content-Encoding")
+		response.Header.Del("Content-Length")
+		response.Uncompressed = true
+	}
+	response.ContentLength = int64(len(body))
+	response.Body = ioutil.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
+
+// decodeBody returns the reader of the body decoded from the content encoding. The bodies of the other
+// encodings are returned as is.
+func decodeBody(body io.Reader, contentEncoding string) (io.Reader, error) {
+	switch strings.ToLower(strings.TrimSpace(contentEncoding)) {
+	case "gzip", "x-gzip":
+		r, err := gzip.NewReader(body)
+		if err == io.EOF {
+			// The response has no body, e.g. a 204.
+			return bytes.NewReader(nil), nil
+		}
+		return r, err
+	case "deflate":
+		// The deflate encoding is the zlib format, but some servers send raw deflate data.
+		r := bufio.NewReader(body)
+		header, err := r.Peek(2)
+		switch {
+		case len(header) == 0 && err == io.EOF:
+			return bytes.NewReader(nil), nil
+		case err == nil && isZlibHeader(header):
+			return zlib.NewReader(r)
+		default:
+			return flate.NewReader(r), nil
+		}
+	default:
+		return body, nil
+	}
+}
+
+// isZlibHeader returns true if the bytes are a zlib header, deflate compressed with a valid check sum
+func isZlibHeader(header []byte) bool {
+	return header[0]&0x0f == 8 && (uint16(header[0])<<8|uint16(header[1]))%31 == 0
+}
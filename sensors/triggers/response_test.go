@@ -0,0 +1,135 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package triggers
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func compress(t *testing.T, encoding, content string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	var w io.WriteCloser
+	switch encoding {
+	case "gzip":
+		w = gzip.NewWriter(&buf)
+	case "zlib":
+		w = zlib.NewWriter(&buf)
+	default:
+		var err error
+		w, err = flate.NewWriter(&buf, flate.DefaultCompression)
+		assert.Nil(t, err)
+	}
+	_, err := w.Write([]byte(content))
+	assert.Nil(t, err)
+	assert.Nil(t, w.Close())
+	return buf.Bytes()
+}
+
+func newResponse(contentEncoding string, body []byte) *http.Response {
+	header := http.Header{}
+	if contentEncoding != "" {
+		header.Set("Content-Encoding", contentEncoding)
+	}
+	return &http.Response{StatusCode: http.StatusOK, Header: header, Body: ioutil.NopCloser(bytes.NewReader(body))}
+}
+
+func TestReadResponse(t *testing.T) {
+	content := `{"result": "` + strings.Repeat("x", 64) + `"}`
+	tests := []struct {
+		name     string
+		encoding string
+		body     []byte
+	}{
+		{name: "plain", body: []byte(content)},
+		{name: "gzip", encoding: "gzip", body: compress(t, "gzip", content)},
+		{name: "deflate", encoding: "deflate", body: compress(t, "zlib", content)},
+		{name: "raw deflate", encoding: "Deflate", body: compress(t, "flate", content)},
+		{name: "unknown encoding", encoding: "identity", body: []byte(content)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response := newResponse(tt.encoding, tt.body)
+			body, err := ReadResponse(response, 0)
+			assert.Nil(t, err)
+			assert.Equal(t, content, string(body))
+			assert.Empty(t, response.Header.Get("Content-Encoding"))
+			assert.Equal(t, int64(len(content)), response.ContentLength)
+			// The decoded body can be read again.
+			again, err := ioutil.ReadAll(response.Body)
+			assert.Nil(t, err)
+			assert.Equal(t, content, string(again))
+		})
+	}
+
+	t.Run("empty compressed body", func(t *testing.T) {
+		for _, encoding := range []string{"gzip", "deflate"} {
+			body, err := ReadResponse(newResponse(encoding, nil), 0)
+			assert.Nil(t, err)
+			assert.Empty(t, body)
+		}
+	})
+
+	t.Run("corrupted compressed body", func(t *testing.T) {
+		_, err := ReadResponse(newResponse("gzip", []byte("not gzip")), 0)
+		assert.NotNil(t, err)
+		assert.False(t, IsResponseTooLargeError(err))
+	})
+
+	t.Run("no body", func(t *testing.T) {
+		body, err := ReadResponse(&http.Response{StatusCode: http.StatusNoContent}, 0)
+		assert.Nil(t, err)
+		assert.Nil(t, body)
+	})
+}
+
+func TestReadResponse_TooLarge(t *testing.T) {
+	content := strings.Repeat("x", 100)
+
+	body, err := ReadResponse(newResponse("", []byte(content)), 100)
+	assert.Nil(t, err)
+	assert.Len(t, body, 100)
+
+	// The limit applies to the decoded body, not to the compressed one.
+	compressed := compress(t, "gzip", content+"x")
+	assert.Less(t, len(compressed), 100)
+	response := newResponse("gzip", compressed)
+	response.StatusCode = http.StatusAccepted
+	_, err = ReadResponse(response, 100)
+	assert.NotNil(t, err)
+	assert.True(t, IsResponseTooLargeError(errors.Wrap(err, "failed")))
+	var tooLargeErr *ResponseTooLargeError
+	assert.True(t, errors.As(err, &tooLargeErr))
+	assert.Equal(t, http.StatusAccepted, tooLargeErr.StatusCode)
+	assert.Equal(t, int64(100), tooLargeErr.Limit)
+	assert.Equal(t, "the body of the response with status code 202 exceeds the max response size of 100 bytes", err.Error())
+	// The oversized body is not kept.
+	again, err := ioutil.ReadAll(response.Body)
+	assert.Nil(t, err)
+	assert.Empty(t, again)
+}
@@ -20,6 +20,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
@@ -33,6 +34,25 @@ import (
 	"github.com/argoproj/argo-events/sensors/triggers"
 )
 
+// clientsLock guards the Slack clients shared by the triggers of the sensor
+var clientsLock sync.Mutex
+
+// Client posts the messages of a Slack trigger, it is cached by trigger name.
+type Client struct {
+	api        *slack.Client
+	httpClient *http.Client
+	token      string
+	apiURL     string
+}
+
+// PostMessageResponse is the response of the Slack API to a message posted to a channel.
+type PostMessageResponse struct {
+	OK        bool   `json:"ok"`
+	Error     string `json:"error,omitempty"`
+	Channel   string `json:"channel,omitempty"`
+	Timestamp string `json:"ts,omitempty"`
+}
+
 type SlackTrigger struct {
 	// Sensor refer to the sensor object
 	Sensor *v1alpha1.Sensor
@@ -42,18 +62,43 @@ type SlackTrigger struct {
 	Logger *zap.SugaredLogger
 	// http client to invoke function.
 	httpClient *http.Client
+	// client posts the messages of the trigger.
+	client *Client
 }
 
 // NewSlackTrigger returns a new Slack trigger context
-func NewSlackTrigger(sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger, logger *zap.SugaredLogger, httpClient *http.Client) (*SlackTrigger, error) {
+func NewSlackTrigger(sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger, logger *zap.SugaredLogger, httpClient *http.Client, slackClients map[string]*Client) (*SlackTrigger, error) {
+	clientsLock.Lock()
+	defer clientsLock.Unlock()
+
+	client, ok := slackClients[trigger.Template.Name]
+	if !ok {
+		slackToken, err := common.GetSecret(trigger.Template.Slack.SlackToken)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to retrieve the slack token")
+		}
+		client = newClient(slackToken, slack.APIURL, httpClient)
+		slackClients[trigger.Template.Name] = client
+	}
+
 	return &SlackTrigger{
 		Sensor:     sensor,
 		Trigger:    trigger,
 		Logger:     logger.With(logging.LabelTriggerType, apicommon.SlackTrigger),
 		httpClient: httpClient,
+		client:     client,
 	}, nil
 }
 
+func newClient(token, apiURL string, httpClient *http.Client) *Client {
+	return &Client{
+		api:        slack.New(token, slack.OptionDebug(false), slack.OptionHTTPClient(httpClient), slack.OptionAPIURL(apiURL)),
+		httpClient: httpClient,
+		token:      token,
+		apiURL:     apiURL,
+	}
+}
+
 // GetTriggerType returns the type of the trigger
 func (t *SlackTrigger) GetTriggerType() apicommon.TriggerType {
 	return apicommon.SlackTrigger
@@ -63,6 +108,8 @@ func (t *SlackTrigger) FetchResource(ctx context.Context) (interface{}, error) {
 	return t.Trigger.Template.Slack, nil
 }
 
+// ApplyResourceParameters applies the parameters to the trigger resource, the ones with a destination
+// prefixed with "blocks." are applied to its blocks.
 func (t *SlackTrigger) ApplyResourceParameters(events map[string]*v1alpha1.Event, resource interface{}) (interface{}, error) {
 	resourceBytes, err := json.Marshal(resource)
 	if err != nil {
@@ -71,7 +118,17 @@ func (t *SlackTrigger) ApplyResourceParameters(events map[string]*v1alpha1.Event
 	parameters := t.Trigger.Template.Slack.Parameters
 
 	if parameters != nil {
-		updatedResourceBytes, err := triggers.ApplyParams(resourceBytes, t.Trigger.Template.Slack.Parameters, events)
+		var resourceParameters, blocksParameters []v1alpha1.TriggerParameter
+		for _, parameter := range parameters {
+			if strings.HasPrefix(parameter.Dest, "blocks.") {
+				parameter.Dest = strings.TrimPrefix(parameter.Dest, "blocks.")
+				blocksParameters = append(blocksParameters, parameter)
+				continue
+			}
+			resourceParameters = append(resourceParameters, parameter)
+		}
+
+		updatedResourceBytes, err := triggers.ApplyParams(resourceBytes, resourceParameters, events)
 		if err != nil {
 			return nil, err
 		}
@@ -81,6 +138,18 @@ func (t *SlackTrigger) ApplyResourceParameters(events map[string]*v1alpha1.Event
 			return nil, errors.Wrap(err, "failed to unmarshal the updated Slack trigger resource after applying resource parameters")
 		}
 
+		if len(blocksParameters) > 0 {
+			blocks := st.Blocks
+			if blocks == "" {
+				blocks = "[]"
+			}
+			updatedBlocks, err := triggers.ApplyParams([]byte(blocks), blocksParameters, events)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to apply the parameters to the Slack blocks")
+			}
+			st.Blocks = string(updatedBlocks)
+		}
+
 		return st, nil
 	}
 
@@ -90,13 +159,11 @@ func (t *SlackTrigger) ApplyResourceParameters(events map[string]*v1alpha1.Event
 // Execute executes the trigger
 func (t *SlackTrigger) Execute(ctx context.Context, events map[string]*v1alpha1.Event, resource interface{}) (interface{}, error) {
 	t.Logger.Info("executing SlackTrigger")
-	_, ok := resource.(*v1alpha1.SlackTrigger)
+	slacktrigger, ok := resource.(*v1alpha1.SlackTrigger)
 	if !ok {
 		return nil, errors.New("failed to marshal the Slack trigger resource")
 	}
 
-	slacktrigger := t.Trigger.Template.Slack
-
 	channel := slacktrigger.Channel
 	if channel == "" {
 		return nil, errors.New("no slack channel provided")
@@ -104,79 +171,137 @@ func (t *SlackTrigger) Execute(ctx context.Context, events map[string]*v1alpha1.
 	channel = strings.TrimPrefix(channel, "#")
 
 	message := slacktrigger.Message
-	if message == "" {
+	if message == "" && slacktrigger.Blocks == "" {
 		return nil, errors.New("no slack message to post")
 	}
 
-	slackToken, err := common.GetSecretFromVolume(slacktrigger.SlackToken)
+	options, err := messageOptions(slacktrigger)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to retrieve the slack token")
+		return nil, err
+	}
+
+	if t.Trigger.Template.DryRun {
+		t.Logger.Infow("dry run, skipping posting the message", zap.String("channelName", channel), zap.String("message", message), zap.String("threadTs", slacktrigger.ThreadTS))
+		return nil, nil
 	}
 
-	api := slack.New(slackToken, slack.OptionDebug(false))
+	api := t.client.api
 
 	t.Logger.Infow("posting to channel...", zap.Any("channelName", channel))
 	for {
-		channelID, timestamp, err := api.PostMessage(channel, slack.MsgOptionText(message, false))
+		response, err := t.client.postMessage(ctx, channel, options...)
 		if err != nil {
-			if err.Error() == "not_in_channel" {
-				isPrivateChannel := false
-				params := &slack.GetConversationsParameters{
-					Limit:           200,
-					Types:           []string{"public_channel", "private_channel"},
-					ExcludeArchived: true,
-				}
+			t.Logger.Errorw("unable to post to channel...", zap.Any("channelName", channel), zap.Error(err))
+			return nil, errors.Wrapf(err, "failed to post to channel %s", channel)
+		}
+		if response.Error == "not_in_channel" {
+			channelID := ""
+			isPrivateChannel := false
+			params := &slack.GetConversationsParameters{
+				Limit:           200,
+				Types:           []string{"public_channel", "private_channel"},
+				ExcludeArchived: true,
+			}
 
-				for {
-					channels, nextCursor, err := api.GetConversations(params)
-					if err != nil {
-						switch e := err.(type) {
-						case *slack.RateLimitedError:
-							<-time.After(e.RetryAfter)
-							continue
-						default:
-							t.Logger.Errorw("unable to list channels", zap.Error(err))
-							return nil, errors.Wrapf(err, "failed to list channels")
-						}
-					}
-					for _, c := range channels {
-						if c.Name == channel {
-							channelID = c.ID
-							isPrivateChannel = c.IsPrivate
-							break
-						}
+			for {
+				channels, nextCursor, err := api.GetConversationsContext(ctx, params)
+				if err != nil {
+					switch e := err.(type) {
+					case *slack.RateLimitedError:
+						<-time.After(e.RetryAfter)
+						continue
+					default:
+						t.Logger.Errorw("unable to list channels", zap.Error(err))
+						return nil, errors.Wrapf(err, "failed to list channels")
 					}
-					if nextCursor == "" || channelID != "" {
+				}
+				for _, c := range channels {
+					if c.Name == channel {
+						channelID = c.ID
+						isPrivateChannel = c.IsPrivate
 						break
 					}
-					params.Cursor = nextCursor
-				}
-				if channelID == "" {
-					return nil, errors.Errorf("failed to get channelID of %s", channel)
 				}
-				if isPrivateChannel {
-					return nil, errors.Errorf("cannot join private channel %s", channel)
+				if nextCursor == "" || channelID != "" {
+					break
 				}
+				params.Cursor = nextCursor
+			}
+			if channelID == "" {
+				return nil, errors.Errorf("failed to get channelID of %s", channel)
+			}
+			if isPrivateChannel {
+				return nil, errors.Errorf("cannot join private channel %s", channel)
+			}
 
-				c, _, _, err := api.JoinConversation(channelID)
-				if err != nil {
-					t.Logger.Errorw("unable to join channel...", zap.Any("channelName", channel), zap.Any("channelID", channelID), zap.Error(err))
-					return nil, errors.Wrapf(err, "failed to join channel %s", channel)
-				}
-				t.Logger.Debugw("successfully joined channel", zap.Any("channel", c))
-				continue
-			} else {
-				t.Logger.Errorw("unable to post to channel...", zap.Any("channelName", channel), zap.Error(err))
-				return nil, errors.Wrapf(err, "failed to post to channel %s", channel)
+			c, _, _, err := api.JoinConversationContext(ctx, channelID)
+			if err != nil {
+				t.Logger.Errorw("unable to join channel...", zap.Any("channelName", channel), zap.Any("channelID", channelID), zap.Error(err))
+				return nil, errors.Wrapf(err, "failed to join channel %s", channel)
 			}
+			t.Logger.Debugw("successfully joined channel", zap.Any("channel", c))
+			continue
 		}
-		t.Logger.Infow("message successfully sent to channelID with timestamp", zap.Any("message", message), zap.Any("channelID", channelID), zap.Any("timestamp", timestamp))
+		t.Logger.Infow("message posted to channel", zap.Any("message", message), zap.Any("channelID", response.Channel), zap.Any("timestamp", response.Timestamp), zap.Bool("ok", response.OK))
 		t.Logger.Info("finished executing SlackTrigger")
-		return nil, nil
+		return response, nil
+	}
+}
+
+// messageOptions returns the options of the message to post, its text, blocks and thread.
+func messageOptions(slacktrigger *v1alpha1.SlackTrigger) ([]slack.MsgOption, error) {
+	var options []slack.MsgOption
+	if slacktrigger.Message != "" {
+		options = append(options, slack.MsgOptionText(slacktrigger.Message, false))
+	}
+	if slacktrigger.Blocks != "" {
+		var blocks slack.Blocks
+		if err := json.Unmarshal([]byte(slacktrigger.Blocks), &blocks); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal the slack blocks")
+		}
+		options = append(options, slack.MsgOptionBlocks(blocks.BlockSet...))
+	}
+	if slacktrigger.ThreadTS != "" {
+		options = append(options, slack.MsgOptionTS(slacktrigger.ThreadTS))
 	}
+	return options, nil
 }
 
-// No Policies for SlackTrigger
+// postMessage posts a message to the channel, and returns the response of the Slack API as is,
+// whether it is ok or not.
+func (c *Client) postMessage(ctx context.Context, channel string, options ...slack.MsgOption) (*PostMessageResponse, error) {
+	endpoint, values, err := slack.UnsafeApplyMsgOptions(c.token, channel, c.apiURL, options...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to build the message")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(values.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("slack API responded with status %s", resp.Status)
+	}
+	response := &PostMessageResponse{}
+	if err := json.NewDecoder(resp.Body).Decode(response); err != nil {
+		return nil, errors.Wrap(err, "failed to decode the response of the slack API")
+	}
+	return response, nil
+}
+
+// ApplyPolicy verifies the Slack API accepted the message
 func (t *SlackTrigger) ApplyPolicy(ctx context.Context, resource interface{}) error {
+	response, ok := resource.(*PostMessageResponse)
+	if !ok {
+		return errors.New("failed to interpret the response of the slack API")
+	}
+	if !response.OK {
+		return errors.Errorf("slack API failed to post the message: %s", response.Error)
+	}
 	return nil
 }
@@ -17,7 +17,9 @@ package slack
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -118,3 +120,97 @@ func TestSlackTrigger_ApplyResourceParameters(t *testing.T) {
 	assert.Equal(t, "real-channel", ot.Channel)
 	assert.Equal(t, "real-message", ot.Message)
 }
+
+func TestSlackTrigger_ApplyResourceParametersToBlocks(t *testing.T) {
+	trigger := getSlackTrigger()
+	trigger.Trigger.Template.Slack.Blocks = `[{"type": "section", "text": {"type": "mrkdwn", "text": "placeholder"}}]`
+
+	testEvents := map[string]*v1alpha1.Event{
+		"fake-dependency": {
+			Context: &v1alpha1.EventContext{
+				ID:              "1",
+				Type:            "webhook",
+				Source:          "webhook-gateway",
+				DataContentType: "application/json",
+				SpecVersion:     "1.0",
+				Subject:         "example-1",
+			},
+			Data: []byte(`{"status": "failed", "ts": "1650000000.000100"}`),
+		},
+	}
+
+	trigger.Trigger.Template.Slack.Parameters = []v1alpha1.TriggerParameter{
+		{
+			Src: &v1alpha1.TriggerParameterSource{
+				DependencyName: "fake-dependency",
+				DataTemplate:   "Build *{{ .Input.status }}*",
+			},
+			Dest: "blocks.0.text.text",
+		},
+		{
+			Src: &v1alpha1.TriggerParameterSource{
+				DependencyName: "fake-dependency",
+				DataKey:        "ts",
+			},
+			Dest: "threadTs",
+		},
+	}
+
+	resource, err := trigger.ApplyResourceParameters(testEvents, trigger.Trigger.Template.Slack)
+	assert.Nil(t, err)
+	ot, ok := resource.(*v1alpha1.SlackTrigger)
+	assert.True(t, ok)
+	assert.Equal(t, "1650000000.000100", ot.ThreadTS)
+	assert.JSONEq(t, `[{"type": "section", "text": {"type": "mrkdwn", "text": "Build *failed*"}}]`, ot.Blocks)
+	assert.Equal(t, "fake-message", ot.Message)
+}
+
+func TestSlackTrigger_Execute(t *testing.T) {
+	var form map[string][]string
+	response := `{"ok": true, "channel": "C1", "ts": "1650000000.000200"}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/chat.postMessage", r.URL.Path)
+		assert.NoError(t, r.ParseForm())
+		form = r.PostForm
+		_, _ = w.Write([]byte(response))
+	}))
+	defer server.Close()
+
+	trigger := getSlackTrigger()
+	trigger.client = newClient("fake-token", server.URL+"/", server.Client())
+	slacktrigger := trigger.Trigger.Template.Slack
+	slacktrigger.Blocks = `[{"type": "section", "text": {"type": "mrkdwn", "text": "Build *failed*"}}]`
+	slacktrigger.ThreadTS = "1650000000.000100"
+
+	result, err := trigger.Execute(context.TODO(), nil, slacktrigger)
+	assert.NoError(t, err)
+	assert.NoError(t, trigger.ApplyPolicy(context.TODO(), result))
+	assert.Equal(t, []string{"fake-channel"}, form["channel"])
+	assert.Equal(t, []string{"fake-message"}, form["text"])
+	assert.Equal(t, []string{"1650000000.000100"}, form["thread_ts"])
+	var blocks []map[string]interface{}
+	assert.NoError(t, json.Unmarshal([]byte(form["blocks"][0]), &blocks))
+	assert.Equal(t, "section", blocks[0]["type"])
+
+	response = `{"ok": false, "error": "invalid_blocks"}`
+	result, err = trigger.Execute(context.TODO(), nil, slacktrigger)
+	assert.NoError(t, err)
+	err = trigger.ApplyPolicy(context.TODO(), result)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid_blocks")
+}
+
+func TestSlackTrigger_ApplyPolicy(t *testing.T) {
+	trigger := getSlackTrigger()
+	assert.NoError(t, trigger.ApplyPolicy(context.TODO(), &PostMessageResponse{OK: true}))
+	assert.Error(t, trigger.ApplyPolicy(context.TODO(), &PostMessageResponse{OK: false, Error: "channel_not_found"}))
+	assert.Error(t, trigger.ApplyPolicy(context.TODO(), nil))
+}
+
+func TestNewSlackTrigger_CachedClient(t *testing.T) {
+	client := newClient("fake-token", "http://localhost/", &http.Client{})
+	clients := map[string]*Client{"fake-trigger": client}
+	trigger, err := NewSlackTrigger(sensorObj.DeepCopy(), sensorObj.Spec.Triggers[0].DeepCopy(), logging.NewArgoEventsLogger(), &http.Client{}, clients)
+	assert.NoError(t, err)
+	assert.Equal(t, client, trigger.client)
+}
@@ -149,6 +149,11 @@ func (k8sTrigger *StandardK8sTrigger) Execute(ctx context.Context, events map[st
 		op = trigger.Template.K8s.Operation
 	}
 
+	if trigger.Template.DryRun {
+		k8sTrigger.Logger.Infow("dry run, skipping the operation on the object", zap.Any("operation", op), zap.String("namespace", namespace), zap.Any("object", obj.Object))
+		return obj, nil
+	}
+
 	// We might have a client from FetchResource() already, or we might not have one yet.
 	if k8sTrigger.namespableDynamicClient == nil {
 		k8sTrigger.namespableDynamicClient = k8sTrigger.DynamicClient.Resource(gvr)
@@ -0,0 +1,88 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package triggers
+
+import (
+	"reflect"
+	"sync"
+
+	"github.com/google/cel-go/cel"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+var (
+	// transformsLock guards the compiled transforms, shared by the concurrent executions.
+	transformsLock sync.Mutex
+	// transforms are the compiled transforms, keyed by their expressions.
+	transforms = make(map[string]cel.Program)
+)
+
+// NewTransform compiles the CEL expression of a payload transform, which must evaluate to a map,
+// e.g. `{"user": events["dep"].body.user.name}`.
+func NewTransform(expression string) (cel.Program, error) {
+	env, err := newEventsEnv()
+	if err != nil {
+		return nil, err
+	}
+	ast, issues := env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, issues.Err()
+	}
+	// A dyn result, e.g. events["dep"].body, can only be checked when evaluated.
+	if resultType := ast.ResultType(); resultType.GetMapType() == nil && resultType.GetDyn() == nil {
+		return nil, errors.Errorf("the expression must evaluate to a map, got %v", resultType)
+	}
+	return env.Program(ast)
+}
+
+// ApplyTransform evaluates the transform against the data of the events, keyed by their dependency names,
+// and returns the JSON object it evaluates to. The transforms are compiled once, on their first evaluation.
+func ApplyTransform(expression string, events map[string]*v1alpha1.Event) ([]byte, error) {
+	program, err := getTransform(expression)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid transform")
+	}
+	out, _, err := program.Eval(map[string]interface{}{
+		conditionEventsVariable: eventsData(events),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to evaluate the transform")
+	}
+	result, err := out.ConvertToNative(reflect.TypeOf(&structpb.Struct{}))
+	if err != nil {
+		return nil, errors.Errorf("the transform evaluated to %v instead of a map with string keys", out.Value())
+	}
+	return protojson.Marshal(result.(*structpb.Struct))
+}
+
+func getTransform(expression string) (cel.Program, error) {
+	transformsLock.Lock()
+	defer transformsLock.Unlock()
+	if program, ok := transforms[expression]; ok {
+		return program, nil
+	}
+	program, err := NewTransform(expression)
+	if err != nil {
+		return nil, err
+	}
+	transforms[expression] = program
+	return program, nil
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2022 The Argoproj Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package triggers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+func TestNewTransform(t *testing.T) {
+	t.Run("test valid", func(t *testing.T) {
+		_, err := NewTransform(`{"user": events["dep"].body.name}`)
+		assert.Nil(t, err)
+		_, err = NewTransform(`events["dep"].body`)
+		assert.Nil(t, err)
+	})
+
+	t.Run("test syntax error", func(t *testing.T) {
+		_, err := NewTransform(`{"user": events["dep"].body.name`)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("test not a map", func(t *testing.T) {
+		_, err := NewTransform(`"user"`)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "must evaluate to a map")
+	})
+}
+
+func TestApplyTransform(t *testing.T) {
+	events := map[string]*v1alpha1.Event{
+		"order": {
+			Context: &v1alpha1.EventContext{ID: "1", DataContentType: common.MediaTypeJSON},
+			Data:    []byte(`{"body": {"user": {"first": "fake", "last": "user"}, "amount": 10}}`),
+		},
+		"refund": {
+			Context: &v1alpha1.EventContext{ID: "2", DataContentType: common.MediaTypeJSON},
+			Data:    []byte(`{"body": {"amount": 2.5, "reason": null}}`),
+		},
+	}
+
+	t.Run("merges the dependencies", func(t *testing.T) {
+		payload, err := ApplyTransform(`{
+			"name": events["order"].body.user.first + " " + events["order"].body.user.last,
+			"total": events["order"].body.amount - events["refund"].body.amount,
+			"refund": events["refund"].body
+		}`, events)
+		assert.Nil(t, err)
+		assert.JSONEq(t, `{"name": "fake user", "total": 7.5, "refund": {"amount": 2.5, "reason": null}}`, string(payload))
+	})
+
+	t.Run("fails on a missing field", func(t *testing.T) {
+		_, err := ApplyTransform(`{"name": events["order"].body.user.middle}`, events)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "failed to evaluate the transform")
+	})
+
+	t.Run("fails on a result which is not an object", func(t *testing.T) {
+		_, err := ApplyTransform(`events["order"].body.amount`, events)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "instead of a map")
+	})
+
+	t.Run("fails on an invalid transform", func(t *testing.T) {
+		_, err := ApplyTransform(`{"name": `, events)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "invalid transform")
+	})
+}
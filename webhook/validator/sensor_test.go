@@ -31,3 +31,26 @@ func TestValidateSensor(t *testing.T) {
 		assert.True(t, r.Allowed)
 	}
 }
+
+func TestValidateSensorGCPCloudFunctionTrigger(t *testing.T) {
+	content, err := ioutil.ReadFile("../../examples/sensors/gcp-cloud-function-trigger.yaml")
+	assert.Nil(t, err)
+	var sensor *v1alpha1.Sensor
+	err = yaml.Unmarshal(content, &sensor)
+	assert.Nil(t, err)
+	sensor.Namespace = testNamespace
+	trigger := sensor.Spec.Triggers[0].Template.GCPCloudFunction
+	trigger.FunctionName = "hello"
+	trigger.Parameters = nil
+
+	v := NewSensorValidator(fakeK8sClient, fakeEventBusClient, fakeEventSourceClient, fakeSensorClient, nil, sensor)
+	r := v.ValidateCreate(contextWithLogger(t))
+	assert.False(t, r.Allowed)
+	assert.Contains(t, r.Result.Message, "invalid function name")
+
+	trigger.FunctionName = "projects/my-project/locations/us-central1/functions/hello"
+	trigger.Payload = nil
+	r = v.ValidateCreate(contextWithLogger(t))
+	assert.False(t, r.Allowed)
+	assert.Contains(t, r.Result.Message, "payload parameters are not specified")
+}